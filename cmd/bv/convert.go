@@ -0,0 +1,120 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+)
+
+const convertUsage = `Usage: bv convert [--from FORMAT] [--to FORMAT] [IN [OUT]]
+
+Convert issues between beads JSONL and bv's canonical interchange format.
+IN defaults to the project's beads file and OUT to stdout; "-" means stdin
+or stdout.
+
+Formats:
+  beads   one bare issue per line, as bd writes .beads/*.jsonl
+  json    canonical envelope: {format, version, exported_at, data_hash, issue_count, issues}
+  jsonl   canonical header line followed by one issue per line
+  auto    (--from only) detect from the input
+
+Issues are written in canonical order (by ID; labels, dependencies and
+comments sorted; timestamps in UTC). Fields bv does not model are kept under
+custom_fields and flattened back when writing beads JSONL.
+
+Flags:
+`
+
+// runConvert implements `bv convert` and returns the process exit code
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	from := fs.String("from", "auto", "Input format: auto, beads, json or jsonl")
+	to := fs.String("to", "json", "Output format: beads, json or jsonl")
+	fs.Usage = func() {
+		fmt.Fprint(stderr, convertUsage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+	if fs.NArg() > 2 {
+		fs.Usage()
+		return 2
+	}
+
+	fromFormat, err := export.ParseInterchangeFormat(*from)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: --from: %v\n", err)
+		return 2
+	}
+	toFormat, err := export.ParseInterchangeFormat(*to)
+	if err != nil || toFormat == export.FormatAuto {
+		fmt.Fprintf(stderr, "Error: --to must be beads, json or jsonl (got %q)\n", *to)
+		return 2
+	}
+
+	inPath := fs.Arg(0)
+	if inPath == "" {
+		beadsDir, err := loader.GetBeadsDir("")
+		if err == nil {
+			inPath, err = loader.FindJSONLPath(beadsDir)
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "Error finding beads file: %v\n", err)
+			return 1
+		}
+	}
+	in := stdin
+	if inPath != "-" {
+		f, err := os.Open(inPath)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error opening input: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		in = f
+	}
+
+	res, err := export.ReadInterchange(in, fromFormat)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading %s: %v\n", inPath, err)
+		return 1
+	}
+	for _, w := range res.Warnings {
+		fmt.Fprintf(stderr, "Warning: %s\n", w)
+	}
+
+	outPath := fs.Arg(1)
+	if outPath == "" || outPath == "-" {
+		if err := export.WriteInterchange(stdout, res.Issues, toFormat, time.Now()); err != nil {
+			fmt.Fprintf(stderr, "Error writing output: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+	f, err := os.Create(outPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error creating output: %v\n", err)
+		return 1
+	}
+	if err := export.WriteInterchange(f, res.Issues, toFormat, time.Now()); err != nil {
+		f.Close()
+		fmt.Fprintf(stderr, "Error writing output: %v\n", err)
+		return 1
+	}
+	if err := f.Close(); err != nil {
+		fmt.Fprintf(stderr, "Error writing output: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(stderr, "Converted %d issues (%s -> %s) to %s\n", len(res.Issues), res.Format, toFormat, outPath)
+	return 0
+}
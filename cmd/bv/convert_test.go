@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunConvert(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "issues.jsonl")
+	beads := `{"id":"b","title":"B","status":"open","issue_type":"task","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","team":"core"}
+{"id":"a","title":"A","status":"open","issue_type":"bug","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}
+`
+	if err := os.WriteFile(in, []byte(beads), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	out := filepath.Join(dir, "issues.canonical.jsonl")
+	var stderr bytes.Buffer
+	if code := runConvert([]string{"--to", "jsonl", in, out}, nil, nil, &stderr); code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+	data, _ := os.ReadFile(out)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[0], `"format":"beads_viewer.issues"`) ||
+		!strings.Contains(lines[1], `"id":"a"`) || !strings.Contains(lines[2], `"custom_fields":{"team":"core"}`) {
+		t.Fatalf("unexpected canonical JSONL:\n%s", data)
+	}
+
+	// And back to beads through stdin/stdout.
+	var stdout bytes.Buffer
+	if code := runConvert([]string{"--to", "beads", "-"}, bytes.NewReader(data), &stdout, &stderr); code != 0 {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), `"team":"core"}`) || strings.Count(stdout.String(), "\n") != 2 {
+		t.Fatalf("unexpected beads output:\n%s", stdout.String())
+	}
+
+	if code := runConvert([]string{"--to", "auto", in}, nil, nil, &stderr); code != 2 {
+		t.Errorf("--to auto should be a usage error, got exit %d", code)
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/hooks"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/limits"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/metrics"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
@@ -46,47 +47,94 @@ import (
 )
 
 func main() {
+	// Subcommands are dispatched before the global flag set sees their flags.
+	if len(os.Args) > 1 && os.Args[1] == "convert" {
+		os.Exit(runConvert(os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+	}
+
 	cpuProfile := flag.String("cpu-profile", "", "Write CPU profile to file")
 	help := flag.Bool("help", false, "Show help")
 	versionFlag := flag.Bool("version", false, "Show version")
+	quietFlag := flag.Bool("quiet", false, "Suppress progress and status messages on stderr (warnings and errors still print)")
+	noProgressFlag := flag.Bool("no-progress", false, "Suppress step-by-step progress messages on stderr")
+	colorFlag := flag.String("color", "auto", "Color output: auto, always, never, 16, 256 or truecolor (env NO_COLOR, CLICOLOR_FORCE, BV_COLOR)")
+	plainFlag := flag.Bool("plain", false, "Screen-reader friendly TUI: plain indented text with field labels, no color, box drawing or emoji (env BV_PLAIN=1)")
+	// Resource guardrails; flags default to the BV_MAX_* environment
+	limitsErr := limits.LoadEnv()
+	maxWorkers := flag.Int("max-workers", limits.Current().MaxWorkers, "Cap goroutines per parallel analysis/export step (0 = per-step default; env BV_MAX_WORKERS)")
+	maxMemoryMB := flag.Int("max-memory-mb", limits.Current().MaxMemoryMB, "Refuse artifacts estimated above N MB, e.g. huge PNG graphs (0 = no limit; env BV_MAX_MEMORY_MB)")
+	maxRenderNodes := flag.Int("max-render-nodes", limits.Current().MaxRenderNodes, "Largest graph drawn in image exports and markdown Mermaid (0 = no limit; env BV_MAX_RENDER_NODES)")
 	// Update flags (bv-182)
 	updateFlag := flag.Bool("update", false, "Update bv to the latest version")
 	checkUpdateFlag := flag.Bool("check-update", false, "Check if a new version is available")
 	rollbackFlag := flag.Bool("rollback", false, "Rollback to the previous version (from backup)")
 	yesFlag := flag.Bool("yes", false, "Skip confirmation prompts (use with --update)")
 	exportFile := flag.String("export-md", "", "Export issues to a Markdown file (e.g., report.md)")
+	mdTemplate := flag.String("md-template", "", "Go text/template file that lays out the --export-md report")
+	mdTemplateDefault := flag.Bool("md-template-default", false, "Print the built-in --export-md report template and exit")
+	mdOmit := flag.String("md-omit", "", "Comma-separated --export-md sections to leave out: toc, mermaid, comments, closed")
+	mdMaxDesc := flag.Int("md-max-desc", 0, "Truncate descriptions in --export-md to N characters (0 = no limit)")
+	mdSort := flag.String("md-sort", "status", "Issue order for --export-md: status, priority, id, updated, none")
+	mdGroup := flag.String("md-group", "none", "Nest --export-md issues under epic sections with progress bars: none or epic")
+	mdAnalysis := flag.Bool("md-analysis", false, "Append an Analysis section to --export-md: top PageRank, top blockers, most at-risk, most likely to slip, cycles, execution plan")
+	mdSlug := flag.String("md-slug", "github", "Anchor style for --export-md TOC links: github, gitlab or azure")
+	mdASCII := flag.Bool("md-ascii", false, "Use bracketed tags ([OPEN], [BUG], [P0]) instead of emoji in --export-md")
+	mdLocale := flag.String("md-locale", "en", "Language of --export-md headings, labels and status names: en, de or ja")
+	mdSplit := flag.Int("md-split", 0, "Split --export-md into part files of N issues plus an index (0 = one file)")
+	mdMilestone := flag.String("md-milestone", "", "Add a Deadline Risk burn-up section to --export-md for this date (YYYY-MM-DD)")
+	exportMDTree := flag.String("export-md-tree", "", "Export one Markdown file per issue into a directory (e.g., docs/beads)")
+	mdTreeGroup := flag.String("md-tree-group", "epic", "Directory layout for --export-md-tree: epic, label, or flat")
+	exportOrg := flag.String("export-org", "", "Export issues to an Emacs org-mode file (e.g., beads.org)")
+	exportObsidian := flag.String("export-obsidian", "", "Export issues as an Obsidian vault with wikilinks (e.g., ./vault)")
+	exportSite := flag.String("export-site", "", "Export a multi-page static HTML site with client-side search (e.g., ./site)")
+	siteTitle := flag.String("site-title", "", "Title for --export-site (default: project name)")
 	robotHelp := flag.Bool("robot-help", false, "Show AI agent help")
 	outputFormat := flag.String("format", "", "Structured output format for --robot-* commands: json or toon (env: BV_OUTPUT_FORMAT, TOON_DEFAULT_FORMAT)")
 	toonStats := flag.Bool("stats", false, "Show JSON vs TOON token estimates on stderr (env: TOON_STATS=1)")
 	robotInsights := flag.Bool("robot-insights", false, "Output graph analysis and insights as JSON for AI agents")
 	robotPlan := flag.Bool("robot-plan", false, "Output dependency-respecting execution plan as JSON for AI agents")
+	wipLimit := flag.Int("wip-limit", 0, "Most items one assignee may have in progress or scheduled in --robot-plan (0 = no limit)")
+	wipLimits := flag.String("wip-limits", "", "Per-assignee --robot-plan WIP limits overriding --wip-limit, e.g. ann=2,bob=1")
+	globalWIPLimit := flag.Int("global-wip-limit", 0, "Most items the whole team may have going at once; plan items past it are marked over_wip (0 = no limit)")
+	maxTrackSize := flag.Int("max-track-size", 0, "Split execution-plan tracks into batches of at most N items (0 = keep tracks whole)")
 	robotPriority := flag.Bool("robot-priority", false, "Output priority recommendations as JSON for AI agents")
 	robotTriage := flag.Bool("robot-triage", false, "Output unified triage as JSON (the mega-command for AI agents)")
 	robotTriageByTrack := flag.Bool("robot-triage-by-track", false, "Group triage recommendations by execution track (bv-87)")
 	robotTriageByLabel := flag.Bool("robot-triage-by-label", false, "Group triage recommendations by label (bv-87)")
 	robotNext := flag.Bool("robot-next", false, "Output only the top pick recommendation as JSON (minimal triage)")
+	pickIssue := flag.Bool("pick", false, "Pick a next issue at random, weighted by priority, unblock impact and staleness")
+	robotPick := flag.Bool("robot-pick", false, "Output a weighted random pick as JSON (see --pick)")
+	pickUser := flag.String("pick-user", "", "Only pick issues assigned to this user or unassigned (default: $BV_USER, then $USER)")
+	pickSeed := flag.Int64("pick-seed", 0, "Seed for --pick / --robot-pick (0 = random)")
 	robotDiff := flag.Bool("robot-diff", false, "Output diff as JSON (use with --diff-since)")
 	robotRecipes := flag.Bool("robot-recipes", false, "Output available recipes as JSON for AI agents")
 	robotLabelHealth := flag.Bool("robot-label-health", false, "Output label health metrics as JSON for AI agents")
 	robotLabelFlow := flag.Bool("robot-label-flow", false, "Output cross-label dependency flow as JSON for AI agents")
 	robotLabelAttention := flag.Bool("robot-label-attention", false, "Output attention-ranked labels as JSON for AI agents")
 	attentionLimit := flag.Int("attention-limit", 5, "Limit number of labels in --robot-label-attention output")
+	robotLabelTaxonomy := flag.Bool("robot-label-taxonomy", false, "Output label co-occurrence matrix and suggested label merges as JSON for AI agents")
+	exportLabelHeatmap := flag.String("export-label-heatmap", "", "Export label co-occurrence heatmap as SVG or PNG (e.g., labels.svg)")
 	robotAlerts := flag.Bool("robot-alerts", false, "Output alerts (drift + proactive) as JSON for AI agents")
 	robotMetrics := flag.Bool("robot-metrics", false, "Output performance metrics (timing, cache, memory) as JSON")
 	// Smart suggestions (bv-180)
-	robotSuggest := flag.Bool("robot-suggest", false, "Output smart suggestions (duplicates, dependencies, labels, cycles) as JSON")
-	suggestType := flag.String("suggest-type", "", "Filter suggestions by type: duplicate, dependency, label, cycle")
+	robotSuggest := flag.Bool("robot-suggest", false, "Output smart suggestions (duplicates, dependencies, labels, cycles, related links) as JSON")
+	suggestType := flag.String("suggest-type", "", "Filter suggestions by type: duplicate, dependency, label, cycle, related")
 	suggestConfidence := flag.Float64("suggest-confidence", 0.0, "Minimum confidence for suggestions (0.0-1.0)")
 	suggestBead := flag.String("suggest-bead", "", "Filter suggestions for specific bead ID")
 	// Graph export (bv-136)
 	robotGraph := flag.Bool("robot-graph", false, "Output dependency graph as JSON/DOT/Mermaid for AI agents")
-	graphFormat := flag.String("graph-format", "json", "Graph output format: json, dot, mermaid")
+	graphFormat := flag.String("graph-format", "json", "Graph output format: json, dot, mermaid, ascii")
 	graphRoot := flag.String("graph-root", "", "Subgraph from specific root issue ID")
 	graphDepth := flag.Int("graph-depth", 0, "Max depth for subgraph (0 = unlimited)")
 	// Graph snapshot export (bv-94)
 	exportGraph := flag.String("export-graph", "", "Export graph: .html for interactive, .png/.svg for static (auto-names if empty)")
+	exportAnalysis := flag.String("export-analysis", "", "Export all computed metrics, cycles, critical path and plan as versioned JSON (use - for stdout)")
 	graphPreset := flag.String("graph-preset", "compact", "Graph layout preset: compact (default) or roomy")
 	graphTitle := flag.String("graph-title", "", "Title for graph export (default: project name)")
+	graphASCII := flag.Bool("graph-ascii", false, "Use plain ASCII connectors for text graph export (.txt or -)")
+	graphSuggestions := flag.Bool("graph-suggestions", false, "Overlay suggested related links as dashed edges in static graph export")
+	graphCriticalPath := flag.Bool("graph-critical-path", false, "Outline the longest chain of open blocking dependencies in static graph export")
+	graphReduce := flag.Bool("graph-reduce", false, "Hide dependency edges already implied by a longer path (transitive reduction) in --robot-graph and --export-graph")
 	// Robot output filters (bv-84)
 	robotMinConf := flag.Float64("robot-min-confidence", 0.0, "Filter robot outputs by minimum confidence (0.0-1.0)")
 	robotMaxResults := flag.Int("robot-max-results", 0, "Limit robot output count (0 = use defaults)")
@@ -106,17 +154,22 @@ func main() {
 	searchPreset := flag.String("search-preset", "", "Hybrid preset name (default: BV_SEARCH_PRESET or default)")
 	searchWeights := flag.String("search-weights", "", "Hybrid weights JSON (overrides preset; keys: text,pagerank,status,impact,priority,recency)")
 	diffSince := flag.String("diff-since", "", "Show changes since historical point (commit SHA, branch, tag, or date)")
+	exportDiff := flag.String("export-diff", "", "Export --diff-since changes as a Markdown changelog (e.g., weekly.md)")
 	asOf := flag.String("as-of", "", "View state at point in time (commit SHA, branch, tag, or date)")
 	forceFullAnalysis := flag.Bool("force-full-analysis", false, "Compute all metrics regardless of graph size (may be slow for large graphs)")
 	profileStartup := flag.Bool("profile-startup", false, "Output detailed startup timing profile for diagnostics")
 	profileJSON := flag.Bool("profile-json", false, "Output profile in JSON format (use with --profile-startup)")
 	noHooks := flag.Bool("no-hooks", false, "Skip running hooks during export")
 	workspaceConfig := flag.String("workspace", "", "Load issues from workspace config file (.bv/workspace.yaml)")
+	var datasets datasetFlags
+	flag.Var(&datasets, "dataset", "Load a beads file or repo as a named workspace, name=path (repeatable)")
 	repoFilter := flag.String("repo", "", "Filter issues by repository prefix (e.g., 'api-' or 'api')")
 	saveBaseline := flag.String("save-baseline", "", "Save current metrics as baseline with optional description")
 	baselineInfo := flag.Bool("baseline-info", false, "Show information about the current baseline")
 	checkDrift := flag.Bool("check-drift", false, "Check for drift from baseline (exit codes: 0=OK, 1=critical, 2=warning)")
 	robotDriftCheck := flag.Bool("robot-drift", false, "Output drift check as JSON (use with --check-drift)")
+	lintIssues := flag.Bool("lint", false, "Check issues for data problems (exit codes: 0=OK, 1=errors)")
+	robotLint := flag.Bool("robot-lint", false, "Output lint problems as JSON")
 	robotHistory := flag.Bool("robot-history", false, "Output bead-to-commit correlations as JSON")
 	beadHistory := flag.String("bead-history", "", "Show history for specific bead ID")
 	historySince := flag.String("history-since", "", "Limit history to commits after this date/ref (e.g., '30 days ago', '2024-01-01')")
@@ -163,10 +216,26 @@ func main() {
 	forecastLabel := flag.String("forecast-label", "", "Filter forecast by label")
 	forecastSprint := flag.String("forecast-sprint", "", "Filter forecast by sprint ID")
 	forecastAgents := flag.Int("forecast-agents", 1, "Number of parallel agents for capacity calculation")
+	robotMonteCarlo := flag.String("robot-montecarlo", "", "Output Monte Carlo P50/P85/P95 completion dates as JSON for 'epics' or comma-separated milestone IDs")
+	mcTrials := flag.Int("mc-trials", 1000, "Number of simulated schedules for --robot-montecarlo")
+	robotBusFactor := flag.Bool("robot-bus-factor", false, "Output single-owner blocking chains and assignee concentration per epic and track as JSON")
+	robotRisk := flag.Bool("robot-risk", false, "Output open issues ranked by risk of slipping as JSON (weights from --recipe)")
 	// Capacity simulation flags (bv-160)
 	robotCapacity := flag.Bool("robot-capacity", false, "Output capacity simulation and completion projection as JSON")
 	capacityAgents := flag.Int("agents", 1, "Number of parallel agents for capacity simulation")
 	capacityLabel := flag.String("capacity-label", "", "Filter capacity simulation by label")
+	// Release cut-line flags
+	robotCutLine := flag.Bool("robot-cutline", false, "Output release cut-line plan as JSON (use with --cut-target and/or --cut-date)")
+	exportCutLine := flag.String("export-cutline", "", "Export release cut-line plan as Markdown (e.g., cutline.md)")
+	cutTarget := flag.String("cut-target", "", "Release issue ID for cut-line planning")
+	cutDate := flag.String("cut-date", "", "Release date for cut-line planning (YYYY-MM-DD)")
+	// Deadline-risk burn-up flags
+	exportBurnUp := flag.String("export-burnup", "", "Export deadline-risk burn-up chart as SVG or PNG (use with --cut-date, optional --cut-target)")
+	robotBurnUp := flag.Bool("robot-burnup", false, "Output deadline-risk burn-up and Monte Carlo forecast as JSON (use with --cut-date)")
+	// Terminal Gantt flags
+	ganttChart := flag.Bool("gantt", false, "Print a Gantt chart of the projected schedule to the terminal")
+	ganttGroup := flag.String("gantt-group", "track", "Gantt sections: track (per agent lane), milestone (per epic) or layer (per dependency depth)")
+	ganttASCII := flag.Bool("gantt-ascii", false, "Draw the Gantt chart with plain ASCII characters")
 	// Burndown flags (bv-159)
 	robotBurndown := flag.String("robot-burndown", "", "Output burndown data for sprint ID, or 'current' for active sprint")
 	// Action script emission flags (bv-89)
@@ -198,6 +267,32 @@ func main() {
 	backgroundMode := flag.Bool("background-mode", false, "Enable experimental background snapshot loading (TUI only)")
 	noBackgroundMode := flag.Bool("no-background-mode", false, "Disable experimental background snapshot loading (TUI only)")
 	flag.Parse()
+	quietOutput = *quietFlag
+	noProgress = *noProgressFlag
+	if !ui.ValidColorMode(*colorFlag) {
+		fmt.Fprintf(os.Stderr, "Invalid --color %q (expected %s)\n", *colorFlag, strings.Join(ui.ColorModes, ", "))
+		os.Exit(2)
+	}
+	if !strings.EqualFold(*colorFlag, "auto") {
+		// The flag takes BV_COLOR's place
+		ui.SetCapabilities(ui.DetectCapabilities(func(key string) string {
+			if key == "BV_COLOR" {
+				return *colorFlag
+			}
+			return os.Getenv(key)
+		}))
+	}
+	if *plainFlag {
+		ui.SetCapabilities(ui.TermCapabilities().WithPlain())
+	}
+	if *maxWorkers < 0 || *maxMemoryMB < 0 || *maxRenderNodes < 0 {
+		fmt.Fprintln(os.Stderr, "Invalid limit: --max-workers, --max-memory-mb and --max-render-nodes must be 0 or more")
+		os.Exit(2)
+	}
+	limits.Set(limits.Limits{MaxWorkers: *maxWorkers, MaxMemoryMB: *maxMemoryMB, MaxRenderNodes: *maxRenderNodes})
+	if limitsErr != nil {
+		warnf("Warning: %v\n", limitsErr)
+	}
 
 	// CPU profiling support
 	if *cpuProfile != "" {
@@ -247,17 +342,20 @@ func main() {
 		*robotTriageByTrack ||
 		*robotTriageByLabel ||
 		*robotNext ||
+		*robotPick ||
 		*robotDiff ||
 		*robotRecipes ||
 		*robotLabelHealth ||
 		*robotLabelFlow ||
 		*robotLabelAttention ||
+		*robotLabelTaxonomy ||
 		*robotAlerts ||
 		*robotMetrics ||
 		*robotSuggest ||
 		*robotGraph ||
 		*robotSearch ||
 		*robotDriftCheck ||
+		*robotLint ||
 		*robotHistory ||
 		*robotFileBeads != "" ||
 		*fileHotspots ||
@@ -270,10 +368,15 @@ func main() {
 		*robotSprintList ||
 		*robotSprintShow != "" ||
 		*robotForecast != "" ||
+		*robotMonteCarlo != "" ||
+		*robotBusFactor ||
+		*robotRisk ||
 		*robotBurndown != "" ||
 		*robotByLabel != "" ||
 		*robotByAssignee != "" ||
 		*robotCapacity ||
+		*robotCutLine ||
+		*robotBurnUp ||
 		// When stdout is non-TTY, --diff-since auto-enables JSON output. Mark this
 		// as robot mode early so parsers keep stdout JSON clean.
 		(*diffSince != "" && !stdoutIsTTY)
@@ -300,6 +403,7 @@ func main() {
 
 	if *help {
 		fmt.Println("Usage: bv [options]")
+		fmt.Println("       bv convert [--from FORMAT] [--to FORMAT] [IN [OUT]]")
 		fmt.Println("\nA TUI viewer for beads issue tracker.")
 		flag.PrintDefaults()
 		os.Exit(0)
@@ -363,6 +467,15 @@ func main() {
 		fmt.Println("      Output includes: id, title, score, reasons, claim_command, show_command")
 		fmt.Println("      Use when you just need to know \"what should I work on next?\"")
 		fmt.Println("")
+		fmt.Println("  --pick / --robot-pick")
+		fmt.Println("      Weighted random pick among actionable issues: higher priority, more")
+		fmt.Println("      issues unblocked and longer untouched all raise the odds. Prints the")
+		fmt.Println("      pick with the reasons it was likely. Unlike --robot-next, repeated")
+		fmt.Println("      runs spread work across the list.")
+		fmt.Println("      --pick-user NAME   Only issues assigned to NAME or unassigned")
+		fmt.Println("                         (default: $BV_USER, then $USER)")
+		fmt.Println("      --pick-seed N      Reproducible draw")
+		fmt.Println("")
 		fmt.Println("  --search \"query\" [--robot-search]")
 		fmt.Println("      Semantic vector search over issue titles/descriptions.")
 		fmt.Println("      Builds/updates a local on-disk vector index on first run.")
@@ -501,6 +614,26 @@ func main() {
 		fmt.Println("      Example: bv --robot-forecast all --forecast-label=backend")
 		fmt.Println("      Example: bv --robot-forecast all --forecast-agents=2")
 		fmt.Println("")
+		fmt.Println("  --robot-montecarlo <epics|ID,ID...>")
+		fmt.Println("      Simulates the open work many times with randomized durations and reports")
+		fmt.Println("      P50/P85/P95 completion dates per milestone (open epics, or the given issues")
+		fmt.Println("      with their children and dependencies) plus all open work.")
+		fmt.Println("      Estimated issues vary their estimate; unestimated ones resample recent")
+		fmt.Println("      creation-to-close times when at least 5 exist.")
+		fmt.Println("        --mc-trials=N         Simulated schedules (default: 1000)")
+		fmt.Println("        --forecast-agents=N   Parallel agents (default: 1)")
+		fmt.Println("      Example: bv --robot-montecarlo epics --forecast-agents=3")
+		fmt.Println("")
+		fmt.Println("  --robot-bus-factor")
+		fmt.Println("      Flags blocking chains of open issues all owned by one assignee, and")
+		fmt.Println("      reports assignee shares and bus factor (fewest people holding half the")
+		fmt.Println("      assigned work) per epic and per execution plan track.")
+		fmt.Println("")
+		fmt.Println("  --robot-risk [--recipe NAME] [--robot-max-results=N]")
+		fmt.Println("      Ranks open issues by risk of slipping, blending blocker depth, staleness,")
+		fmt.Println("      priority and bus factor, with each issue's factors and main driver.")
+		fmt.Println("      Weights come from the recipe's risk block (defaults otherwise).")
+		fmt.Println("")
 		fmt.Println("  --robot-capacity [--agents=N] [--capacity-label=X]")
 		fmt.Println("      Outputs capacity simulation and completion projection as JSON.")
 		fmt.Println("      Analyzes work remaining, parallelizability, and bottlenecks.")
@@ -516,6 +649,29 @@ func main() {
 		fmt.Println("      Example: bv --robot-capacity --agents=3")
 		fmt.Println("      Example: bv --robot-capacity --capacity-label=backend")
 		fmt.Println("")
+		fmt.Println("  --robot-cutline --cut-target=ID | --cut-date=YYYY-MM-DD [--agents=N]")
+		fmt.Println("      Partitions open work into in_cut / after_cut for a release.")
+		fmt.Println("      With --cut-target, the cut is the target and its transitive blockers;")
+		fmt.Println("      with --cut-date, it is whatever is scheduled to finish by that date.")
+		fmt.Println("      line_ids lists the chain whose slip would move the projected line.")
+		fmt.Println("      Use --export-cutline=FILE.md for a Markdown report instead.")
+		fmt.Println("      Example: bv --robot-cutline --cut-target=bv-100 --agents=2")
+		fmt.Println("")
+		fmt.Println("  --export-burnup=FILE.svg|.png --cut-date=YYYY-MM-DD [--cut-target=ID]")
+		fmt.Println("      Draws a burn-up chart for the milestone: scope line, completed line, and")
+		fmt.Println("      a p10-p90 forecast cone from 1000 Monte Carlo runs over the last 30")
+		fmt.Println("      days of daily closes. The header gives the on-time chance and risk.")
+		fmt.Println("      --cut-target limits scope to the issue and its transitive blockers.")
+		fmt.Println("      --robot-burnup emits the same data as JSON.")
+		fmt.Println("      Example: bv --export-burnup risk.svg --cut-date=2026-12-01")
+		fmt.Println("")
+		fmt.Println("  --gantt [--gantt-group=track|milestone|layer] [--agents=N] [--gantt-ascii]")
+		fmt.Println("      Prints a Gantt chart of the projected schedule sized to the terminal:")
+		fmt.Println("      bars per issue, a today marker, due-date diamonds, and dependency arrows.")
+		fmt.Println("      --gantt-group=milestone sections bars by parent epic instead of agent lane;")
+		fmt.Println("      --gantt-group=layer by dependency depth (work within a layer can run in parallel).")
+		fmt.Println("      Example: bv --gantt --agents=2 --gantt-group=milestone")
+		fmt.Println("")
 		fmt.Println("  --emit-script [--script-limit=N] [--script-format=bash|fish|zsh]")
 		fmt.Println("      Emits a shell script for top-N priority recommendations.")
 		fmt.Println("      Useful for agent workflows and automation.")
@@ -535,9 +691,71 @@ func main() {
 		fmt.Println("      Generates a readable status report with Mermaid.js visualizations.")
 		fmt.Println("      Runs pre-export and post-export hooks if configured in .bv/hooks.yaml")
 		fmt.Println("")
+		fmt.Println("  --export-md <file> --md-template <file.tmpl>")
+		fmt.Println("      Lays the report out with a Go text/template instead of the built-in one.")
+		fmt.Println("      Data: .Title .GeneratedAt .Stats .Issues (issue fields + .Slug .Heading")
+		fmt.Println("      .Commands), .Mermaid .QuickActions .DeadlineRisk, and lazily computed .Graph/.Insights/.Analysis.")
+		fmt.Println("      Funcs: statusEmoji typeEmoji priorityLabel depEmoji cell quote join")
+		fmt.Println("      truncate lower upper. Start from: bv --md-template-default > report.tmpl")
+		fmt.Println("")
+		fmt.Println("  --export-md <file> [--md-omit=toc,mermaid,comments,closed] [--md-max-desc=N]")
+		fmt.Println("                    [--md-sort=status|priority|id|updated|none] [--md-group=none|epic]")
+		fmt.Println("      Shapes the report, from a slim executive summary to a full dump.")
+		fmt.Println("      --md-group=epic nests issues under an H2 section per epic with x/y closed.")
+		fmt.Println("      --md-analysis appends top PageRank, top blockers by transitive unblocks,")
+		fmt.Println("      the most at-risk (stalest) open issues, those most likely to slip")
+		fmt.Println("      (risk weights from --recipe), dependency cycles and the execution")
+		fmt.Println("      plan tracks.")
+		fmt.Println("      --md-milestone=YYYY-MM-DD adds a Deadline Risk section with burn-up odds;")
+		fmt.Println("      with --export-burnup the chart image is linked from it.")
+		fmt.Println("      --md-ascii swaps emoji for bracketed tags ([OPEN], [BUG], [P0]) for")
+		fmt.Println("      renderers and plain-text email that mangle emoji.")
+		fmt.Println("      --md-slug=gitlab|azure makes TOC links match the heading anchors GitLab")
+		fmt.Println("      or Azure DevOps wikis generate (default github).")
+		fmt.Println("      --md-locale=de|ja writes headings, labels, status and priority names in")
+		fmt.Println("      German or Japanese (default en); issue text is left as written.")
+		fmt.Println("      --md-split=N writes issues to <file>-part-01.md, ... in chunks of N;")
+		fmt.Println("      <file> becomes an index with the summary, Mermaid graph and linked TOC.")
+		fmt.Println("      Example: bv --export-md summary.md --md-omit=toc,mermaid,comments,closed --md-max-desc=200")
+		fmt.Println("")
+		fmt.Println("  --export-md-tree <dir> [--md-tree-group=epic|label|flat]")
+		fmt.Println("      Writes one Markdown file per issue plus index.md (summary + Mermaid graph).")
+		fmt.Println("      Issues are cross-linked with relative links; ideal for committing into docs/.")
+		fmt.Println("")
+		fmt.Println("  --export-org <file>")
+		fmt.Println("      Writes an Emacs org-mode document: status as TODO keywords, priority cookies,")
+		fmt.Println("      DEADLINE from due dates, and property drawers with IDs and dependency links.")
+		fmt.Println("")
+		fmt.Println("  --export-obsidian <dir>")
+		fmt.Println("      Writes an Obsidian vault: one note per issue with YAML frontmatter")
+		fmt.Println("      (status, priority, labels) and [[wikilinks]] for dependencies.")
+		fmt.Println("")
+		fmt.Println("  --export-site <dir> [--site-title=TITLE]")
+		fmt.Println("      Builds a lightweight static website: index with embedded SVG graph,")
+		fmt.Println("      one page per issue, and a prebuilt search index (no server needed).")
+		fmt.Println("      Publish the directory as-is to GitHub Pages.")
+		fmt.Println("")
 		fmt.Println("  --no-hooks")
 		fmt.Println("      Skip running hooks during export. Useful for CI or quick exports.")
 		fmt.Println("")
+		fmt.Println("  --quiet / --no-progress")
+		fmt.Println("      Data and artifacts go to stdout or files; progress, status and")
+		fmt.Println("      warnings go to stderr. --quiet drops progress and status lines")
+		fmt.Println("      (warnings and errors still print); --no-progress drops only the")
+		fmt.Println("      step-by-step progress. Example: bv --quiet --export-md report.md")
+		fmt.Println("")
+		fmt.Println("  Terminal capabilities")
+		fmt.Println("      Color depth, Unicode, inline images and hyperlinks are detected from")
+		fmt.Println("      TERM, COLORTERM, TERM_PROGRAM and the locale; without Unicode the TUI,")
+		fmt.Println("      --gantt and --export-graph - fall back to ASCII. NO_COLOR turns color")
+		fmt.Println("      off and CLICOLOR_FORCE colors pipes too; --color=auto|always|never|")
+		fmt.Println("      16|256|truecolor overrides both. 16-color terminals get an ANSI palette.")
+		fmt.Println("      Overrides: BV_COLOR (as --color)  BV_UNICODE=0|1")
+		fmt.Println("      BV_IMAGES=none|kitty|iterm2|sixel  BV_HYPERLINKS=0|1")
+		fmt.Println("      --plain (or BV_PLAIN=1) is a screen-reader mode: the list and details")
+		fmt.Println("      become plain indented text with field labels, and no view draws")
+		fmt.Println("      color, box drawing or emoji.")
+		fmt.Println("")
 		fmt.Println("  Hook Configuration (.bv/hooks.yaml)")
 		fmt.Println("      Configure hooks to automate export workflows:")
 		fmt.Println("      - pre-export: Validation, notifications (failure cancels export)")
@@ -557,6 +775,11 @@ func main() {
 		fmt.Println("      - resolved_cycles: Circular dependencies fixed")
 		fmt.Println("      - summary.health_trend: 'improving', 'degrading', or 'stable'")
 		fmt.Println("")
+		fmt.Println("  --export-diff <file> --diff-since <commit|date>")
+		fmt.Println("      Writes the changes as a Markdown changelog: new, closed, reopened and")
+		fmt.Println("      removed issues, status and priority transitions, dependency changes.")
+		fmt.Println("      Example: bv --diff-since 'HEAD@{7 days ago}' --export-diff weekly.md")
+		fmt.Println("")
 		fmt.Println("  --as-of <commit|date>")
 		fmt.Println("      View issue state at a point in time (works with all robot commands).")
 		fmt.Println("      Useful for historical analysis without modifying the working tree.")
@@ -565,8 +788,10 @@ func main() {
 		fmt.Println("")
 		fmt.Println("  --robot-diff")
 		fmt.Println("      Output diff as JSON (use with --diff-since).")
-		fmt.Println("      Fields: generated_at, resolved_revision, from_data_hash, to_data_hash, diff{...}")
+		fmt.Println("      Fields: generated_at, resolved_revision, from_data_hash, to_data_hash, diff{...}, graph_diff{...}")
 		fmt.Println("      Diff payload includes metric deltas, cycles introduced/resolved, and modified issues.")
+		fmt.Println("      graph_diff lists added/removed nodes and blocking edges, PageRank moves, and")
+		fmt.Println("      issues that became blocked or unblocked.")
 		fmt.Println("")
 		fmt.Println("  --robot-recipes")
 		fmt.Println("      Lists all available recipes as JSON.")
@@ -590,12 +815,18 @@ func main() {
 		fmt.Println("      Key fields: rank, label, attention_score, normalized_score, reason, blocked_count, stale_count.")
 		fmt.Println("      Use to identify which labels need the most focus based on centrality and health factors.")
 		fmt.Println("")
+		fmt.Println("  --robot-label-taxonomy [--export-label-heatmap=labels.svg]")
+		fmt.Println("      Outputs label co-occurrence and suggested merges as JSON.")
+		fmt.Println("      Key fields: labels[], counts[], matrix[i][j] (issues carrying both), pairs[{a,b,count,jaccard}],")
+		fmt.Println("                  merges[{from,into,similarity,reason}] for near-identical names (case, plural, typo).")
+		fmt.Println("      --export-label-heatmap writes the matrix as an SVG/PNG heatmap (top 30 labels).")
+		fmt.Println("")
 		fmt.Println("  --robot-alerts")
 		fmt.Println("      Outputs drift + proactive alerts as JSON (staleness, cascades, density, cycles).")
 		fmt.Println("      Filters: --severity=<info|warning|critical>, --alert-type=<type>, --alert-label=<label>")
 		fmt.Println("      Fields: type, severity, message, issue_id, label, detected_at, details[].")
 		fmt.Println("")
-		fmt.Println("  --robot-graph [--graph-format=json|dot|mermaid] [--graph-root=ID] [--graph-depth=N]")
+		fmt.Println("  --robot-graph [--graph-format=json|dot|mermaid|ascii] [--graph-root=ID] [--graph-depth=N]")
 		fmt.Println("      Outputs dependency graph in specified format (default: JSON adjacency).")
 		fmt.Println("      Formats:")
 		fmt.Println("        - json: Adjacency list with nodes[], edges[], metadata")
@@ -605,12 +836,15 @@ func main() {
 		fmt.Println("        --label LABEL: Filter to issues with specific label")
 		fmt.Println("        --graph-root ID: Extract subgraph starting from root issue")
 		fmt.Println("        --graph-depth N: Limit subgraph depth (0 = unlimited)")
-		fmt.Println("      Fields: format, graph (string for dot/mermaid), nodes, edges, filters_applied, explanation")
+		fmt.Println("        --graph-reduce: Drop edges implied by a longer path (A→C when A→B→C)")
+		fmt.Println("      Fields: format, graph (string for dot/mermaid/ascii), nodes, edges, redundant_edges,")
+		fmt.Println("              filters_applied, explanation")
 		fmt.Println("      Example: bv --robot-graph --graph-format=dot --label=api > api-deps.dot")
 		fmt.Println("")
 		fmt.Println("  --export-graph <path.png|path.svg> [--graph-style=force|grid] [--graph-preset=compact|roomy]")
 		fmt.Println("      Export dependency graph as PNG or SVG image (pure Go, no external dependencies).")
 		fmt.Println("      Format is inferred from file extension (.png or .svg).")
+		fmt.Println("      The header carries a QR stamp of the data hash and generation time.")
 		fmt.Println("")
 		fmt.Println("      Styles:")
 		fmt.Println("        --graph-style=force (default): Beautiful force-directed layout")
@@ -626,21 +860,45 @@ func main() {
 		fmt.Println("")
 		fmt.Println("      Options:")
 		fmt.Println("        --label LABEL: Filter to issues with specific label")
+		fmt.Println("        --graph-root ID [--graph-depth N]: Only ID and what it depends on")
 		fmt.Println("        --graph-preset: Layout spacing - 'compact' (default) or 'roomy'")
 		fmt.Println("        --graph-title: Custom title for the graph header")
+		fmt.Println("        --graph-suggestions: Overlay likely missing 'related' links as dashed edges")
+		fmt.Println("        --graph-critical-path: Outline the longest chain of open blocking deps")
+		fmt.Println("        --graph-reduce: Draw only direct ordering; edges implied by a longer")
+		fmt.Println("                        path are hidden (also for .html and text export)")
+		fmt.Println("        --diff-since REF: Mark nodes that moved since REF (priority raised,")
+		fmt.Println("                          unblocked, commented)")
 		fmt.Println("")
 		fmt.Println("      Example: bv --export-graph deps.svg --label=api --graph-title='API Dependencies'")
 		fmt.Println("      Example: bv --export-graph full.png --graph-style=force --graph-preset=roomy")
 		fmt.Println("")
+		fmt.Println("  --export-graph <path.txt|-> [--graph-ascii]")
+		fmt.Println("      Export dependency graph as layered box-drawing text (│ ├ └ connectors),")
+		fmt.Println("      ready to paste into PRs and chat. Use '-' to write to stdout.")
+		fmt.Println("        --graph-ascii: Use plain ASCII connectors (| +-- `--) instead of Unicode")
+		fmt.Println("      Example: bv --export-graph - --label=api")
+		fmt.Println("")
+		fmt.Println("  --export-analysis <file.json|-> [--force-full-analysis]")
+		fmt.Println("      Export the analysis as a stable, versioned JSON document for dashboards and CI:")
+		fmt.Println("      schema, version, graph{nodes,edges,density,modularity,acyclic}, metrics{<name>: status},")
+		fmt.Println("      issues[] sorted by id (pagerank, betweenness, eigenvector, hub, authority, critical_path,")
+		fmt.Println("      core_number, slack, articulation, community, degrees), cycles, critical_path, plan.")
+		fmt.Println("      The version only changes when a field is removed or changes meaning.")
+		fmt.Println("      Example: bv --export-analysis - | jq '.issues | sort_by(-.pagerank)[:5]'")
+		fmt.Println("")
 		fmt.Println("  --robot-insights")
 		fmt.Println("      Graph metrics JSON for agents.")
 		fmt.Println("      Top lists: Bottlenecks (betweenness), Keystones (critical path), Influencers (eigenvector),")
 		fmt.Println("                 Cores (k-core), Articulation points (cut vertices), Slack (parallelism headroom).")
-		fmt.Println("      Full maps (capped by BV_INSIGHTS_MAP_LIMIT): pagerank, betweenness, eigenvector, hubs/authorities, core_number, slack.")
+		fmt.Println("      Full maps (capped by BV_INSIGHTS_MAP_LIMIT): pagerank, priority_pagerank (P0 dependents count more), betweenness, eigenvector, hubs/authorities, core_number, slack,")
+		fmt.Println("      communities (Louvain; 0 is the largest), plus modularity (>0.3 means clearly separate work streams).")
+		fmt.Println("      structural_bottlenecks: cut-vertex issues and bridge dependencies, largest disconnected cluster first.")
 		fmt.Println("      status captures per-metric state: computed|approx|timeout|skipped with elapsed_ms and reasons.")
 		fmt.Println("      Shared fields: data_hash, analysis_config.")
 		fmt.Println("      Quick jq: jq '.full_stats.core_number | to_entries | sort_by(-.value)[:5]'   # top k-core nodes")
 		fmt.Println("                 jq '.Articulation'                                                  # structural cut points")
+		fmt.Println("                 jq '.full_stats.structural_bottlenecks.issues[:5]'                  # cut points by cluster size")
 		fmt.Println("                 jq '.Slack[:5]'                                                     # highest slack (parallel-friendly)")
 		fmt.Println("      advanced_insights: Canonical structure for advanced graph features:")
 		fmt.Println("        - topk_set: Best k issues for maximum downstream unlock (status: pending)")
@@ -653,9 +911,18 @@ func main() {
 		fmt.Println("        Config: caps for deterministic output (topk<=5, paths<=5, path_len<=50, etc.)")
 		fmt.Println("        Quick jq: jq '.advanced_insights.cycle_break'   # cycle break suggestions")
 		fmt.Println("")
-		fmt.Println("  --robot-plan")
+		fmt.Println("  --robot-plan [--wip-limit=N] [--wip-limits=ann=2,bob=1] [--global-wip-limit=N] [--max-track-size=N]")
 		fmt.Println("      Execution tracks grouped for parallel work. Includes data_hash, analysis_config, status.")
 		fmt.Println("      plan.tracks[].items[].unblocks shows what completes next; summary.highest_impact surfaces best unblocker.")
+		fmt.Println("      Unassigned items carry suggested_assignee from who has held issues with the same labels.")
+		fmt.Println("      When issues carry estimates, tracks are ordered by chain_minutes: the longest estimated")
+		fmt.Println("      chain of work they start (critical chain), with estimated_minutes per item and track.")
+		fmt.Println("      With WIP limits, items past an assignee's limit (counting in_progress work) move to")
+		fmt.Println("      plan.deferred, and plan.assignees reports each person's load and free capacity.")
+		fmt.Println("      --global-wip-limit caps the whole team: items past it (in_progress work counts first)")
+		fmt.Println("      stay in their tracks with over_wip=true, and plan.over_wip counts them.")
+		fmt.Println("      --max-track-size splits long tracks into batches (track-A, track-A/2, ...) with batch/batches.")
+		fmt.Println("      The TUI actionable view (a) uses the same options and flags over-WIP items in its header.")
 		fmt.Println("")
 		fmt.Println("  --robot-priority")
 		fmt.Println("      Priority recommendations with explanations. Includes data_hash, analysis_config, status.")
@@ -697,6 +964,13 @@ func main() {
 		fmt.Println("      Aggregates issues from multiple repositories with namespaced IDs.")
 		fmt.Println("      Example: bv --workspace .bv/workspace.yaml")
 		fmt.Println("")
+		fmt.Println("  --dataset NAME=PATH")
+		fmt.Println("      Load a beads file, .beads directory or repo as a named workspace.")
+		fmt.Println("      Repeat to load several; issues are namespaced as NAME-ID and W in")
+		fmt.Println("      the TUI switches between them and the merged \"all\" workspace.")
+		fmt.Println("      Combines with --workspace, adding to its repos.")
+		fmt.Println("      Example: bv --dataset api=../api --dataset web=../web/.beads/beads.jsonl")
+		fmt.Println("")
 		fmt.Println("  --repo PREFIX")
 		fmt.Println("      Filter issues by repository prefix.")
 		fmt.Println("      Use with --workspace to focus on one repo in a multi-repo view.")
@@ -725,6 +999,15 @@ func main() {
 		fmt.Println("      Output drift check as JSON (use with --check-drift).")
 		fmt.Println("      Output: {has_drift, exit_code, summary, alerts, baseline}")
 		fmt.Println("")
+		fmt.Println("  --lint")
+		fmt.Println("      Check loaded issues against the data model invariants:")
+		fmt.Println("      duplicate IDs, self or nil dependencies, unknown dependency")
+		fmt.Println("      targets, out-of-range priorities. Useful after importing from")
+		fmt.Println("      another tracker. Exit code 1 when any error is found.")
+		fmt.Println("")
+		fmt.Println("  --robot-lint")
+		fmt.Println("      Output lint problems as JSON: {generated_at, errors, warnings, problems}")
+		fmt.Println("")
 		fmt.Println("  Static Site Export & GitHub Pages (bv-7pu):")
 		fmt.Println("      --pages")
 		fmt.Println("          Launch interactive Pages deployment wizard.")
@@ -761,6 +1044,11 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *mdTemplateDefault {
+		fmt.Print(export.DefaultMarkdownTemplate)
+		os.Exit(0)
+	}
+
 	// Handle --check-update (bv-182)
 	if *checkUpdateFlag {
 		available, newVersion, releaseURL, err := updater.CheckUpdateAvailable()
@@ -851,7 +1139,7 @@ func main() {
 				fmt.Fprintf(os.Stderr, "Error saving feedback: %v\n", err)
 				os.Exit(1)
 			}
-			fmt.Println("Feedback data reset to defaults.")
+			statusf("Feedback data reset to defaults.\n")
 			os.Exit(0)
 		}
 
@@ -916,7 +1204,7 @@ func main() {
 				os.Exit(1)
 			}
 
-			fmt.Printf("Recorded %s feedback for %s (score: %.3f)\n", action, issueID, score)
+			statusf("Recorded %s feedback for %s (score: %.3f)\n", action, issueID, score)
 			fmt.Println(feedback.Summary())
 			os.Exit(0)
 		}
@@ -999,8 +1287,8 @@ func main() {
 	if *asOf != "" {
 		// Time-travel mode: load historical issues from git
 		// Note: --as-of takes precedence over --workspace (can't combine historical + multi-repo)
-		if *workspaceConfig != "" {
-			fmt.Fprintf(os.Stderr, "Warning: --workspace is ignored when --as-of is specified\n")
+		if *workspaceConfig != "" || len(datasets) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: --workspace and --dataset are ignored when --as-of is specified\n")
 		}
 		cwd, err := os.Getwd()
 		if err != nil {
@@ -1019,14 +1307,19 @@ func main() {
 		beadsPath = ""
 		if !envRobot {
 			if asOfResolved != "" {
-				fmt.Fprintf(os.Stderr, "Loaded %d issues from %s (%s)\n", len(issues), *asOf, asOfResolved[:min(7, len(asOfResolved))])
+				progressf("Loaded %d issues from %s (%s)\n", len(issues), *asOf, asOfResolved[:min(7, len(asOfResolved))])
 			} else {
-				fmt.Fprintf(os.Stderr, "Loaded %d issues from %s\n", len(issues), *asOf)
+				progressf("Loaded %d issues from %s\n", len(issues), *asOf)
 			}
 		}
-	} else if *workspaceConfig != "" {
-		// Load from workspace configuration
-		loadedIssues, results, err := workspace.LoadAllFromConfig(context.Background(), *workspaceConfig)
+	} else if *workspaceConfig != "" || len(datasets) > 0 {
+		// Load from workspace configuration and/or named datasets
+		wsConfig, wsRoot, err := loadWorkspaceConfig(*workspaceConfig, datasets)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading workspace: %v\n", err)
+			os.Exit(1)
+		}
+		loadedIssues, results, err := workspace.NewAggregateLoader(wsConfig, wsRoot).LoadAll(context.Background())
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading workspace: %v\n", err)
 			os.Exit(1)
@@ -1049,8 +1342,9 @@ func main() {
 
 		// Automatically ensure .bv/ is in .gitignore at workspace root
 		// Workspace config is typically at .bv/workspace.yaml, so project root is two levels up
-		workspaceRoot := filepath.Dir(filepath.Dir(*workspaceConfig))
-		_ = loader.EnsureBVInGitignore(workspaceRoot)
+		if *workspaceConfig != "" {
+			_ = loader.EnsureBVInGitignore(wsRoot)
+		}
 	} else {
 		// Load from single repo (original behavior)
 		var err error
@@ -1331,11 +1625,11 @@ func main() {
 		doExport := func(allIssues []model.Issue) error {
 			exportCount++
 			if exportCount > 1 {
-				fmt.Printf("\n[%s] Re-exporting (change #%d)...\n", time.Now().Format("15:04:05"), exportCount-1)
+				progressf("\n[%s] Re-exporting (change #%d)...\n", time.Now().Format("15:04:05"), exportCount-1)
 			} else {
-				fmt.Println("Exporting static site...")
+				progressf("Exporting static site...\n")
 			}
-			fmt.Printf("  → Loading %d issues\n", len(allIssues))
+			progressf("  → Loading %d issues\n", len(allIssues))
 
 			// Filter closed issues if not requested
 			exportIssues := allIssues
@@ -1347,7 +1641,7 @@ func main() {
 					}
 				}
 				exportIssues = openIssues
-				fmt.Printf("  → Filtering to %d open issues\n", len(exportIssues))
+				progressf("  → Filtering to %d open issues\n", len(exportIssues))
 			}
 
 			// Load and run pre-export hooks (bv-qjc.3)
@@ -1356,9 +1650,9 @@ func main() {
 			if !*noHooks {
 				hookLoader := hooks.NewLoader(hooks.WithProjectDir(cwd))
 				if err := hookLoader.Load(); err != nil {
-					fmt.Printf("  → Warning: failed to load hooks: %v\n", err)
+					warnf("  → Warning: failed to load hooks: %v\n", err)
 				} else if hookLoader.HasHooks() {
-					fmt.Println("  → Running pre-export hooks...")
+					progressf("  → Running pre-export hooks...\n")
 					ctx := hooks.ExportContext{
 						ExportPath:   *exportPages,
 						ExportFormat: "html",
@@ -1367,7 +1661,7 @@ func main() {
 					}
 					pagesExecutor = hooks.NewExecutor(hookLoader.Config(), ctx)
 					pagesExecutor.SetLogger(func(msg string) {
-						fmt.Printf("  → %s\n", msg)
+						progressf("  → %s\n", msg)
 					})
 
 					if err := pagesExecutor.RunPreExport(); err != nil {
@@ -1377,13 +1671,13 @@ func main() {
 			}
 
 			// Build graph and compute stats
-			fmt.Println("  → Running graph analysis...")
+			progressf("  → Running graph analysis...\n")
 			analyzer := analysis.NewAnalyzer(exportIssues)
 			stats := analyzer.AnalyzeAsync(context.Background())
 			stats.WaitForPhase2()
 
 			// Compute triage
-			fmt.Println("  → Generating triage data...")
+			progressf("  → Generating triage data...\n")
 			triage := analysis.ComputeTriage(exportIssues)
 
 			// Extract dependencies
@@ -1413,54 +1707,53 @@ func main() {
 			}
 
 			// Export SQLite database
-			fmt.Println("  → Writing database and JSON files...")
+			progressf("  → Writing database and JSON files...\n")
 			if err := exporter.Export(*exportPages); err != nil {
 				return fmt.Errorf("exporting: %w", err)
 			}
 
 			// Copy viewer assets
-			fmt.Println("  → Copying viewer assets...")
+			progressf("  → Copying viewer assets...\n")
 			if err := copyViewerAssets(*exportPages, *pagesTitle); err != nil {
 				return fmt.Errorf("copying assets: %w", err)
 			}
 
 			// Generate README.md with project stats (useful for GitHub Pages deployment)
-			fmt.Println("  → Generating README.md...")
+			progressf("  → Generating README.md...\n")
 			if err := generateREADME(*exportPages, *pagesTitle, "", exportIssues, &triage, stats); err != nil {
-				fmt.Printf("  → Warning: failed to generate README: %v\n", err)
+				warnf("  → Warning: failed to generate README: %v\n", err)
 			}
 
 			// Export history data for time-travel feature (bv-z38b)
 			if *pagesIncludeHistory {
-				fmt.Println("  → Generating time-travel history data...")
+				progressf("  → Generating time-travel history data...\n")
 				if historyReport, err := generateHistoryForExport(allIssues); err == nil && historyReport != nil {
 					historyPath := filepath.Join(*exportPages, "data", "history.json")
 					if historyJSON, err := json.MarshalIndent(historyReport, "", "  "); err == nil {
 						if err := os.WriteFile(historyPath, historyJSON, 0644); err != nil {
-							fmt.Printf("  → Warning: failed to write history.json: %v\n", err)
+							warnf("  → Warning: failed to write history.json: %v\n", err)
 						} else {
-							fmt.Printf("  → history.json (%d commits)\n", len(historyReport.Commits))
+							progressf("  → history.json (%d commits)\n", len(historyReport.Commits))
 						}
 					}
 				} else if err != nil {
-					fmt.Printf("  → Warning: failed to generate history: %v\n", err)
+					warnf("  → Warning: failed to generate history: %v\n", err)
 				}
 			}
 
 			// Run post-export hooks (bv-qjc.3)
 			if pagesExecutor != nil {
-				fmt.Println("  → Running post-export hooks...")
+				progressf("  → Running post-export hooks...\n")
 				if err := pagesExecutor.RunPostExport(); err != nil {
-					fmt.Printf("  → Warning: post-export hook failed: %v\n", err)
+					warnf("  → Warning: post-export hook failed: %v\n", err)
 				}
 
 				if len(pagesExecutor.Results()) > 0 {
-					fmt.Println("")
-					fmt.Println(pagesExecutor.Summary())
+					statusf("\n%s\n", pagesExecutor.Summary())
 				}
 			}
 
-			fmt.Printf("✓ Export complete [%s]\n", time.Now().Format("15:04:05"))
+			statusf("✓ Export complete [%s]\n", time.Now().Format("15:04:05"))
 			return nil
 		}
 
@@ -1475,19 +1768,17 @@ func main() {
 			cwd, _ := os.Getwd()
 			issuesFile := filepath.Join(cwd, ".beads", "issues.jsonl")
 
-			fmt.Println("")
-			fmt.Println("Watch mode enabled. Monitoring for changes...")
-			fmt.Printf("  → Watching: %s\n", issuesFile)
-			fmt.Println("  → Press Ctrl+C to stop")
-			fmt.Println("")
-			fmt.Println("To preview with auto-refresh, run in another terminal:")
-			fmt.Printf("  bv --preview-pages %s\n", *exportPages)
+			statusf("\nWatch mode enabled. Monitoring for changes...\n")
+			statusf("  → Watching: %s\n", issuesFile)
+			statusf("  → Press Ctrl+C to stop\n\n")
+			statusf("To preview with auto-refresh, run in another terminal:\n")
+			statusf("  bv --preview-pages %s\n", *exportPages)
 
 			// Create file watcher with 500ms debounce
 			w, err := watcher.NewWatcher(issuesFile,
 				watcher.WithDebounceDuration(500*time.Millisecond),
 				watcher.WithOnError(func(err error) {
-					fmt.Printf("  → Watch error: %v\n", err)
+					fmt.Fprintf(os.Stderr, "  → Watch error: %v\n", err)
 				}),
 			)
 			if err != nil {
@@ -1513,27 +1804,24 @@ func main() {
 					// Reload issues from disk
 					freshIssues, err := loader.LoadIssues("")
 					if err != nil {
-						fmt.Printf("  → Error reloading issues: %v\n", err)
+						fmt.Fprintf(os.Stderr, "  → Error reloading issues: %v\n", err)
 						continue
 					}
 					if err := doExport(freshIssues); err != nil {
-						fmt.Printf("  → Export error: %v\n", err)
+						fmt.Fprintf(os.Stderr, "  → Export error: %v\n", err)
 					}
 				case <-sigCh:
-					fmt.Println("\nStopping watch mode...")
+					statusf("\nStopping watch mode...\n")
 					os.Exit(0)
 				}
 			}
 		}
 
-		fmt.Println("")
-		fmt.Printf("✓ Static site exported to: %s\n", *exportPages)
-		fmt.Println("")
-		fmt.Println("To preview locally:")
-		fmt.Printf("  bv --preview-pages %s\n", *exportPages)
-		fmt.Println("")
-		fmt.Println("Or open in browser:")
-		fmt.Printf("  open %s/index.html\n", *exportPages)
+		statusf("\n✓ Static site exported to: %s\n\n", *exportPages)
+		statusf("To preview locally:\n")
+		statusf("  bv --preview-pages %s\n\n", *exportPages)
+		statusf("Or open in browser:\n")
+		statusf("  open %s/index.html\n", *exportPages)
 		os.Exit(0)
 	}
 
@@ -1681,6 +1969,48 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Handle --robot-label-taxonomy / --export-label-heatmap
+	if *robotLabelTaxonomy || *exportLabelHeatmap != "" {
+		taxonomy := analysis.ComputeLabelTaxonomy(issues)
+
+		if *exportLabelHeatmap != "" {
+			if err := export.SaveLabelHeatmap(export.LabelHeatmapOptions{
+				Path:     *exportLabelHeatmap,
+				Title:    *graphTitle,
+				Taxonomy: taxonomy,
+			}); err != nil {
+				fmt.Fprintf(os.Stderr, "Error exporting label heatmap: %v\n", err)
+				os.Exit(1)
+			}
+			if !*robotLabelTaxonomy {
+				statusf("✓ Label heatmap exported to %s (%d labels, %d merge suggestion(s))\n", *exportLabelHeatmap, len(taxonomy.Labels), len(taxonomy.Merges))
+				os.Exit(0)
+			}
+		}
+
+		output := struct {
+			GeneratedAt string                 `json:"generated_at"`
+			DataHash    string                 `json:"data_hash"`
+			Taxonomy    analysis.LabelTaxonomy `json:"taxonomy"`
+			UsageHints  []string               `json:"usage_hints"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			Taxonomy:    taxonomy,
+			UsageHints: []string{
+				"jq '.taxonomy.merges[] | {from,into,reason}' - labels to consolidate",
+				"jq '.taxonomy.pairs[:10]' - labels most often used together",
+				"jq '.taxonomy.matrix' - raw matrix (row/col align with .taxonomy.labels)",
+			},
+		}
+		encoder := newRobotEncoder(os.Stdout)
+		if err := encoder.Encode(output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding label taxonomy: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Handle --robot-graph (bv-136)
 	if *robotGraph {
 		analyzer := analysis.NewAnalyzer(issues)
@@ -1693,6 +2023,8 @@ func main() {
 			format = export.GraphFormatDOT
 		case "mermaid":
 			format = export.GraphFormatMermaid
+		case "ascii":
+			format = export.GraphFormatASCII
 		default:
 			format = export.GraphFormatJSON
 		}
@@ -1703,6 +2035,7 @@ func main() {
 			Root:     *graphRoot,
 			Depth:    *graphDepth,
 			DataHash: dataHash,
+			Reduce:   *graphReduce,
 		}
 
 		result, err := export.ExportGraph(issues, &stats, config)
@@ -1738,6 +2071,9 @@ func main() {
 			}
 			exportIssues = filtered
 		}
+		if *graphRoot != "" {
+			exportIssues = export.Subgraph(exportIssues, *graphRoot, *graphDepth)
+		}
 
 		if len(exportIssues) == 0 {
 			fmt.Fprintf(os.Stderr, "No issues to export (check filters)\n")
@@ -1748,6 +2084,30 @@ func main() {
 		cwd, _ := os.Getwd()
 		projectName := filepath.Base(cwd)
 
+		// Plain-text export: layered box-drawing graph for PRs and chat
+		if lower := strings.ToLower(*exportGraph); strings.HasSuffix(lower, ".txt") || lower == "-" {
+			asciiOpts := export.ASCIIGraphOptions{ASCII: *graphASCII, Reduce: *graphReduce}
+			if *exportGraph == "-" {
+				asciiOpts.ASCII = asciiOpts.ASCII || !ui.TermCapabilities().Unicode
+				if err := export.ExportASCIIGraph(os.Stdout, exportIssues, asciiOpts); err != nil {
+					fmt.Fprintf(os.Stderr, "Error exporting graph: %v\n", err)
+					os.Exit(1)
+				}
+				os.Exit(0)
+			}
+			var sb strings.Builder
+			if err := export.ExportASCIIGraph(&sb, exportIssues, asciiOpts); err != nil {
+				fmt.Fprintf(os.Stderr, "Error exporting graph: %v\n", err)
+				os.Exit(1)
+			}
+			if err := os.WriteFile(*exportGraph, []byte(sb.String()), 0644); err != nil {
+				fmt.Fprintf(os.Stderr, "Error exporting graph: %v\n", err)
+				os.Exit(1)
+			}
+			statusf("✓ Text graph exported to %s (%d nodes)\n", *exportGraph, len(exportIssues))
+			os.Exit(0)
+		}
+
 		// Check if HTML export requested (interactive graph)
 		if strings.HasSuffix(strings.ToLower(*exportGraph), ".html") || *exportGraph == "html" || *exportGraph == "interactive" {
 			title := *graphTitle
@@ -1767,6 +2127,7 @@ func main() {
 				DataHash:    dataHash,
 				Path:        *exportGraph,
 				ProjectName: projectName,
+				Reduce:      *graphReduce,
 			}
 			// Auto-generate filename if just "html" or "interactive"
 			if *exportGraph == "html" || *exportGraph == "interactive" {
@@ -1777,7 +2138,7 @@ func main() {
 				fmt.Fprintf(os.Stderr, "Error exporting interactive graph: %v\n", err)
 				os.Exit(1)
 			}
-			fmt.Printf("✓ Interactive graph exported to %s (%d nodes, %d edges)\n", outputPath, len(exportIssues), stats.EdgeCount)
+			statusf("✓ Interactive graph exported to %s (%d nodes, %d edges)\n", outputPath, len(exportIssues), stats.EdgeCount)
 			os.Exit(0)
 		}
 
@@ -1789,6 +2150,22 @@ func main() {
 			Issues:   exportIssues,
 			Stats:    &stats,
 			DataHash: dataHash,
+
+			GeneratedAt:     time.Now(),
+			ShowSuggestions: *graphSuggestions,
+			CriticalPath:    *graphCriticalPath,
+			Reduce:          *graphReduce,
+		}
+
+		// With --diff-since, annotate nodes with trend markers
+		if *diffSince != "" {
+			cwd, _ := os.Getwd()
+			historicalIssues, err := loader.NewGitLoader(cwd).LoadAt(*diffSince)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading issues at %s: %v\n", *diffSince, err)
+				os.Exit(1)
+			}
+			opts.Diff = analysis.CompareSnapshots(analysis.NewSnapshot(historicalIssues), analysis.NewSnapshot(issues))
 		}
 
 		err := export.SaveGraphSnapshot(opts)
@@ -1797,7 +2174,7 @@ func main() {
 			os.Exit(1)
 		}
 
-		fmt.Printf("✓ Graph exported to %s (%d nodes) - tip: use .html for interactive graphs\n", *exportGraph, len(exportIssues))
+		statusf("✓ Graph exported to %s (%d nodes) - tip: use .html for interactive graphs\n", *exportGraph, len(exportIssues))
 		os.Exit(0)
 	}
 
@@ -1949,10 +2326,12 @@ func main() {
 			config.FilterType = analysis.SuggestionLabelSuggestion
 		case "cycle", "cycles":
 			config.FilterType = analysis.SuggestionCycleWarning
+		case "related":
+			config.FilterType = analysis.SuggestionRelatedLink
 		case "":
 			// All types
 		default:
-			fmt.Fprintf(os.Stderr, "Invalid suggest-type: %s (use: duplicate, dependency, label, cycle)\n", *suggestType)
+			fmt.Fprintf(os.Stderr, "Invalid suggest-type: %s (use: duplicate, dependency, label, cycle, related)\n", *suggestType)
 			os.Exit(1)
 		}
 
@@ -2029,11 +2408,108 @@ func main() {
 			os.Exit(1)
 		}
 
-		fmt.Printf("Baseline saved to %s\n", baselinePath)
+		statusf("Baseline saved to %s\n", baselinePath)
 		fmt.Print(bl.Summary())
 		os.Exit(0)
 	}
 
+	// Handle --lint / --robot-lint
+	if *lintIssues || *robotLint {
+		problems := model.Lint(issues)
+		exitCode := 0
+		if model.HasLintErrors(problems) {
+			exitCode = 1
+		}
+		if *robotLint {
+			errCount := 0
+			for _, p := range problems {
+				if p.Severity == model.LintError {
+					errCount++
+				}
+			}
+			if problems == nil {
+				problems = []model.LintProblem{}
+			}
+			output := struct {
+				GeneratedAt string              `json:"generated_at"`
+				Errors      int                 `json:"errors"`
+				Warnings    int                 `json:"warnings"`
+				Problems    []model.LintProblem `json:"problems"`
+			}{
+				GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+				Errors:      errCount,
+				Warnings:    len(problems) - errCount,
+				Problems:    problems,
+			}
+			encoder := newRobotEncoder(os.Stdout)
+			if err := encoder.Encode(output); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding lint output: %v\n", err)
+				os.Exit(1)
+			}
+			os.Exit(exitCode)
+		}
+		if len(problems) == 0 {
+			fmt.Printf("No problems found in %d issues.\n", len(issues))
+			os.Exit(0)
+		}
+		for _, p := range problems {
+			fmt.Println(p.String())
+		}
+		fmt.Printf("\n%d problems in %d issues.\n", len(problems), len(issues))
+		os.Exit(exitCode)
+	}
+
+	// Handle --pick / --robot-pick
+	if *pickIssue || *robotPick {
+		user := strings.TrimSpace(*pickUser)
+		if user == "" {
+			user = strings.TrimSpace(os.Getenv("BV_USER"))
+		}
+		if user == "" {
+			user = strings.TrimSpace(os.Getenv("USER"))
+		}
+		pick, err := analysis.PickWeighted(issues, analysis.PickOptions{User: user, Seed: *pickSeed})
+		if *robotPick {
+			output := struct {
+				GeneratedAt string                 `json:"generated_at"`
+				DataHash    string                 `json:"data_hash"`
+				User        string                 `json:"user,omitempty"`
+				Pick        *analysis.WeightedPick `json:"pick"`
+				Message     string                 `json:"message,omitempty"`
+				ClaimCmd    string                 `json:"claim_command,omitempty"`
+				ShowCmd     string                 `json:"show_command,omitempty"`
+			}{
+				GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+				DataHash:    dataHash,
+				User:        user,
+			}
+			if err != nil {
+				output.Message = err.Error()
+			} else {
+				output.Pick = &pick
+				output.ClaimCmd = fmt.Sprintf("bd update %s --status=in_progress", pick.ID)
+				output.ShowCmd = fmt.Sprintf("bd show %s", pick.ID)
+			}
+			encoder := newRobotEncoder(os.Stdout)
+			if err := encoder.Encode(output); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding robot-pick: %v\n", err)
+				os.Exit(1)
+			}
+			os.Exit(0)
+		}
+		if err != nil {
+			fmt.Println(err.Error())
+			os.Exit(0)
+		}
+		fmt.Printf("🎲 %s  %s (P%d)\n\n", pick.ID, pick.Title, pick.Priority)
+		fmt.Println("Why:")
+		for _, r := range pick.Reasons {
+			fmt.Printf("  - %s\n", r)
+		}
+		fmt.Printf("\nClaim: bd update %s --status=in_progress\n", pick.ID)
+		os.Exit(0)
+	}
+
 	// Handle --check-drift
 	if *checkDrift {
 		if !baseline.Exists(baselinePath) {
@@ -2144,6 +2620,48 @@ func main() {
 		os.Exit(result.ExitCode())
 	}
 
+	// Plan options shared by --robot-plan, --export-analysis and the TUI
+	planOptions := analysis.PlanOptions{
+		WIPLimit:       *wipLimit,
+		GlobalWIPLimit: *globalWIPLimit,
+		MaxTrackSize:   *maxTrackSize,
+	}
+	if *wipLimits != "" {
+		limits, err := analysis.ParseWIPLimits(*wipLimits)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		planOptions.WIPLimits = limits
+	}
+
+	// Handle --export-analysis (versioned analysis JSON)
+	if *exportAnalysis != "" {
+		analyzer := analysis.NewAnalyzer(issues)
+		if *forceFullAnalysis {
+			cfg := analysis.FullAnalysisConfig()
+			analyzer.SetConfig(&cfg)
+		}
+		stats := analyzer.Analyze()
+		plan := analyzer.GetExecutionPlanWithOptions(planOptions)
+		data, err := analysis.MarshalReport(&stats, &plan)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding analysis: %v\n", err)
+			os.Exit(1)
+		}
+		data = append(data, '\n')
+		if *exportAnalysis == "-" {
+			_, _ = os.Stdout.Write(data)
+			os.Exit(0)
+		}
+		if err := os.WriteFile(*exportAnalysis, data, 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting analysis: %v\n", err)
+			os.Exit(1)
+		}
+		statusf("✓ Analysis exported to %s (%d issues, %d cycles, schema v%d)\n", *exportAnalysis, stats.NodeCount, len(stats.Cycles()), analysis.ReportSchemaVersion)
+		os.Exit(0)
+	}
+
 	if *robotInsights {
 		analyzer := analysis.NewAnalyzer(issues)
 		if *forceFullAnalysis {
@@ -2228,18 +2746,31 @@ func main() {
 			}
 		}
 
+		bottlenecks := stats.Bottlenecks()
+		if len(bottlenecks.Issues) > mapLimit {
+			bottlenecks.Issues = bottlenecks.Issues[:mapLimit]
+		}
+		if len(bottlenecks.Bridges) > mapLimit {
+			bottlenecks.Bridges = bottlenecks.Bridges[:mapLimit]
+		}
+
 		fullStats := struct {
-			PageRank          map[string]float64 `json:"pagerank"`
-			Betweenness       map[string]float64 `json:"betweenness"`
-			Eigenvector       map[string]float64 `json:"eigenvector"`
-			Hubs              map[string]float64 `json:"hubs"`
-			Authorities       map[string]float64 `json:"authorities"`
-			CriticalPathScore map[string]float64 `json:"critical_path_score"`
-			CoreNumber        map[string]int     `json:"core_number"`
-			Slack             map[string]float64 `json:"slack"`
-			Articulation      []string           `json:"articulation_points"`
+			PageRank          map[string]float64   `json:"pagerank"`
+			PriorityPageRank  map[string]float64   `json:"priority_pagerank"`
+			Betweenness       map[string]float64   `json:"betweenness"`
+			Eigenvector       map[string]float64   `json:"eigenvector"`
+			Hubs              map[string]float64   `json:"hubs"`
+			Authorities       map[string]float64   `json:"authorities"`
+			CriticalPathScore map[string]float64   `json:"critical_path_score"`
+			CoreNumber        map[string]int       `json:"core_number"`
+			Slack             map[string]float64   `json:"slack"`
+			Articulation      []string             `json:"articulation_points"`
+			Bottlenecks       analysis.Bottlenecks `json:"structural_bottlenecks"`
+			Communities       map[string]int       `json:"communities"`
+			Modularity        float64              `json:"modularity"`
 		}{
 			PageRank:          limitMaps(stats.PageRank(), mapLimit),
+			PriorityPageRank:  limitMaps(stats.PriorityPageRank(), mapLimit),
 			Betweenness:       limitMaps(stats.Betweenness(), mapLimit),
 			Eigenvector:       limitMaps(stats.Eigenvector(), mapLimit),
 			Hubs:              limitMaps(stats.Hubs(), mapLimit),
@@ -2248,6 +2779,9 @@ func main() {
 			CoreNumber:        limitMapInt(stats.CoreNumber(), mapLimit),
 			Slack:             limitMaps(stats.Slack(), mapLimit),
 			Articulation:      limitSlice(stats.ArticulationPoints(), mapLimit),
+			Bottlenecks:       bottlenecks,
+			Communities:       limitMapInt(stats.Communities(), mapLimit),
+			Modularity:        stats.Modularity(),
 		}
 
 		// Get top what-if deltas for issues with highest downstream impact (bv-83)
@@ -2329,7 +2863,7 @@ func main() {
 			cfg.CyclesSkipReason = skipReason
 		}
 
-		plan := analyzer.GetExecutionPlan()
+		plan := analyzer.GetExecutionPlanWithOptions(planOptions)
 
 		stats := analyzer.AnalyzeAsyncWithConfig(context.Background(), cfg)
 		stats.WaitForPhase2()
@@ -2678,7 +3212,7 @@ func main() {
 
 	// Handle --priority-brief flag (bv-96)
 	if *priorityBrief != "" {
-		fmt.Printf("Generating priority brief to %s...\n", *priorityBrief)
+		progressf("Generating priority brief to %s...\n", *priorityBrief)
 		triage := analysis.ComputeTriage(issues)
 
 		// Marshal triage to JSON for the export function
@@ -2703,13 +3237,13 @@ func main() {
 			os.Exit(1)
 		}
 
-		fmt.Printf("Done! Priority brief saved to %s\n", *priorityBrief)
+		statusf("Done! Priority brief saved to %s\n", *priorityBrief)
 		os.Exit(0)
 	}
 
 	// Handle --agent-brief flag (bv-131)
 	if *agentBrief != "" {
-		fmt.Printf("Generating agent brief bundle to %s/...\n", *agentBrief)
+		progressf("Generating agent brief bundle to %s/...\n", *agentBrief)
 
 		// Create output directory
 		if err := os.MkdirAll(*agentBrief, 0755); err != nil {
@@ -2728,7 +3262,7 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Error writing triage.json: %v\n", err)
 			os.Exit(1)
 		}
-		fmt.Println("  → triage.json")
+		progressf("  → triage.json\n")
 
 		// Generate insights
 		analyzer := analysis.NewAnalyzer(issues)
@@ -2743,7 +3277,7 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Error writing insights.json: %v\n", err)
 			os.Exit(1)
 		}
-		fmt.Println("  → insights.json")
+		progressf("  → insights.json\n")
 
 		// Generate priority brief
 		config := export.DefaultPriorityBriefConfig()
@@ -2757,7 +3291,7 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Error writing brief.md: %v\n", err)
 			os.Exit(1)
 		}
-		fmt.Println("  → brief.md")
+		progressf("  → brief.md\n")
 
 		// Generate jq helpers
 		helpers := generateJQHelpers()
@@ -2765,7 +3299,7 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Error writing helpers.md: %v\n", err)
 			os.Exit(1)
 		}
-		fmt.Println("  → helpers.md")
+		progressf("  → helpers.md\n")
 
 		// Generate meta.json with hash and config
 		meta := struct {
@@ -2786,9 +3320,9 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Error writing meta.json: %v\n", err)
 			os.Exit(1)
 		}
-		fmt.Println("  → meta.json")
+		progressf("  → meta.json\n")
 
-		fmt.Printf("\nDone! Agent brief bundle saved to %s/\n", *agentBrief)
+		statusf("\nDone! Agent brief bundle saved to %s/\n", *agentBrief)
 		os.Exit(0)
 	}
 
@@ -4114,6 +4648,203 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Handle --robot-cutline / --export-cutline (release cut-line planning)
+	if *robotCutLine || *exportCutLine != "" {
+		opts := analysis.CutLineOptions{
+			TargetID: *cutTarget,
+			Agents:   *capacityAgents,
+			Now:      time.Now(),
+		}
+		if *cutDate != "" {
+			d, err := time.ParseInLocation("2006-01-02", *cutDate, time.Local)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Invalid --cut-date %q (expected YYYY-MM-DD)\n", *cutDate)
+				os.Exit(2)
+			}
+			// The release day itself counts as in the cut.
+			opts.TargetDate = d.Add(24*time.Hour - time.Second)
+		}
+		plan, err := analysis.ComputeCutLine(issues, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error computing cut line: %v\n", err)
+			os.Exit(1)
+		}
+
+		if *exportCutLine != "" {
+			if err := export.SaveCutLineMarkdown(plan, *graphTitle, *exportCutLine); err != nil {
+				fmt.Fprintf(os.Stderr, "Error exporting cut line: %v\n", err)
+				os.Exit(1)
+			}
+			if !*robotCutLine {
+				statusf("✓ Cut line exported to %s (%d in, %d after)\n", *exportCutLine, len(plan.InCut), len(plan.AfterCut))
+				os.Exit(0)
+			}
+		}
+
+		output := struct {
+			GeneratedAt string               `json:"generated_at"`
+			DataHash    string               `json:"data_hash"`
+			Plan        analysis.CutLinePlan `json:"plan"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			Plan:        plan,
+		}
+		encoder := newRobotEncoder(os.Stdout)
+		if err := encoder.Encode(output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding cut line: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-montecarlo (per-milestone completion percentiles)
+	if *robotMonteCarlo != "" {
+		opts := analysis.MonteCarloOptions{Trials: *mcTrials, Agents: *forecastAgents}
+		if *robotMonteCarlo != "epics" {
+			for _, id := range strings.Split(*robotMonteCarlo, ",") {
+				if id = strings.TrimSpace(id); id != "" {
+					opts.Milestones = append(opts.Milestones, id)
+				}
+			}
+		}
+		forecast, err := analysis.ComputeMonteCarloForecast(issues, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		output := struct {
+			GeneratedAt string                      `json:"generated_at"`
+			DataHash    string                      `json:"data_hash"`
+			Forecast    analysis.MonteCarloForecast `json:"forecast"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			Forecast:    forecast,
+		}
+		encoder := newRobotEncoder(os.Stdout)
+		if err := encoder.Encode(output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding Monte Carlo forecast: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-bus-factor (single-person bottlenecks)
+	if *robotBusFactor {
+		output := struct {
+			GeneratedAt string                   `json:"generated_at"`
+			DataHash    string                   `json:"data_hash"`
+			BusFactor   analysis.BusFactorReport `json:"bus_factor"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			BusFactor:   analysis.ComputeBusFactor(issues),
+		}
+		encoder := newRobotEncoder(os.Stdout)
+		if err := encoder.Encode(output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding bus factor: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-risk (what's most likely to slip)
+	if *robotRisk {
+		weights := analysis.RecipeRiskScoreWeights(activeRecipe)
+		risks := analysis.RiskScores(issues, time.Now(), weights)
+		if *robotMaxResults > 0 && len(risks) > *robotMaxResults {
+			risks = risks[:*robotMaxResults]
+		}
+		output := struct {
+			GeneratedAt string                    `json:"generated_at"`
+			DataHash    string                    `json:"data_hash"`
+			Weights     analysis.RiskScoreWeights `json:"weights"`
+			Risks       []analysis.IssueRisk      `json:"risks"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			Weights:     weights,
+			Risks:       risks,
+		}
+		encoder := newRobotEncoder(os.Stdout)
+		if err := encoder.Encode(output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding risk scores: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-burnup / --export-burnup (deadline-risk burn-up)
+	if *robotBurnUp || (*exportBurnUp != "" && *exportFile == "") {
+		if *cutDate == "" {
+			fmt.Fprintln(os.Stderr, "Error: burn-up needs a milestone: --cut-date=YYYY-MM-DD")
+			os.Exit(2)
+		}
+		d, err := time.ParseInLocation("2006-01-02", *cutDate, time.Local)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --cut-date %q (expected YYYY-MM-DD)\n", *cutDate)
+			os.Exit(2)
+		}
+		chart, err := burnUpChartFor(issues, d, *cutTarget, *graphTitle, *exportBurnUp)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error computing burn-up: %v\n", err)
+			os.Exit(1)
+		}
+
+		if *exportBurnUp != "" {
+			if err := export.SaveBurnUpChart(chart); err != nil {
+				fmt.Fprintf(os.Stderr, "Error exporting burn-up chart: %v\n", err)
+				os.Exit(1)
+			}
+			if !*robotBurnUp {
+				b := chart.BurnUp
+				statusf("✓ Burn-up chart exported to %s (%.0f%% on time, %s risk)\n", *exportBurnUp, b.OnTimeProbability*100, b.Risk)
+				os.Exit(0)
+			}
+		}
+
+		output := struct {
+			GeneratedAt string          `json:"generated_at"`
+			DataHash    string          `json:"data_hash"`
+			BurnUp      analysis.BurnUp `json:"burnup"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			BurnUp:      chart.BurnUp,
+		}
+		encoder := newRobotEncoder(os.Stdout)
+		if err := encoder.Encode(output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding burn-up: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --gantt (terminal Gantt chart)
+	if *ganttChart {
+		group := strings.ToLower(*ganttGroup)
+		if group != export.GanttGroupTrack && group != export.GanttGroupMilestone && group != export.GanttGroupLayer {
+			fmt.Fprintf(os.Stderr, "Invalid --gantt-group %q (expected track, milestone or layer)\n", *ganttGroup)
+			os.Exit(2)
+		}
+		width := 0
+		if w, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+			width = w
+		}
+		sched := analysis.ComputeSchedule(issues, analysis.ScheduleOptions{Agents: *capacityAgents, Now: time.Now()})
+		if err := export.RenderTerminalGantt(os.Stdout, sched, issues, export.TerminalGanttOptions{
+			Width:   width,
+			GroupBy: group,
+			ASCII:   *ganttASCII || !ui.TermCapabilities().Unicode,
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "Error rendering Gantt chart: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Handle --robot-capacity flag (bv-160)
 	if *robotCapacity {
 		// Build graph stats for analysis
@@ -4330,6 +5061,11 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *exportDiff != "" && *diffSince == "" {
+		fmt.Fprintln(os.Stderr, "Error: --export-diff needs --diff-since <commit|date>")
+		os.Exit(2)
+	}
+
 	// Handle --diff-since flag
 	if *diffSince != "" {
 		// Auto-enable robot diff for non-interactive/agent contexts
@@ -4358,6 +5094,16 @@ func main() {
 			revision = *diffSince
 		}
 
+		if *exportDiff != "" {
+			opts := export.DiffMarkdownOptions{Title: *graphTitle, Since: *diffSince}
+			if err := export.SaveDiffMarkdown(historicalIssues, issues, opts, *exportDiff); err != nil {
+				fmt.Fprintf(os.Stderr, "Error exporting diff: %v\n", err)
+				os.Exit(1)
+			}
+			statusf("✓ Changelog since %s exported to %s\n", *diffSince, *exportDiff)
+			os.Exit(0)
+		}
+
 		// Create snapshots
 		fromSnapshot := analysis.NewSnapshotAt(historicalIssues, time.Time{}, revision)
 		toSnapshot := analysis.NewSnapshot(issues)
@@ -4375,6 +5121,7 @@ func main() {
 				FromDataHash     string                 `json:"from_data_hash"`
 				ToDataHash       string                 `json:"to_data_hash"`
 				Diff             *analysis.SnapshotDiff `json:"diff"`
+				Graph            *analysis.GraphDiff    `json:"graph_diff"`
 			}{
 				GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
 				ResolvedRevision: revision,
@@ -4383,6 +5130,7 @@ func main() {
 				FromDataHash:     analysis.ComputeDataHash(historicalIssues),
 				ToDataHash:       dataHash,
 				Diff:             diff,
+				Graph:            analysis.DiffGraphs(fromSnapshot, toSnapshot),
 			}
 
 			encoder := newRobotEncoder(os.Stdout)
@@ -4408,14 +5156,139 @@ func main() {
 		m := ui.NewModel(issues, activeRecipe, "")
 		defer m.Stop()
 		if err := runTUIProgram(m); err != nil {
-			fmt.Printf("Error running beads viewer: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Error running beads viewer: %v\n", err)
 			os.Exit(1)
 		}
 		return
 	}
 
+	if *exportMDTree != "" {
+		grouping, err := export.ParseMarkdownTreeGrouping(*mdTreeGroup)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if err := export.SaveMarkdownTree(issues, export.MarkdownTreeOptions{
+			Dir:     *exportMDTree,
+			GroupBy: grouping,
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting: %v\n", err)
+			os.Exit(1)
+		}
+		statusf("✓ Exported %d issues to %s\n", len(issues), *exportMDTree)
+		os.Exit(0)
+	}
+
+	if *exportOrg != "" {
+		if err := export.SaveOrgToFile(issues, *exportOrg); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting: %v\n", err)
+			os.Exit(1)
+		}
+		statusf("✓ Exported %d issues to %s\n", len(issues), *exportOrg)
+		os.Exit(0)
+	}
+
+	if *exportObsidian != "" {
+		if err := export.SaveObsidianVault(issues, export.ObsidianVaultOptions{Dir: *exportObsidian}); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting: %v\n", err)
+			os.Exit(1)
+		}
+		statusf("✓ Exported %d notes to Obsidian vault %s\n", len(issues), *exportObsidian)
+		os.Exit(0)
+	}
+
+	if *exportSite != "" {
+		title := *siteTitle
+		if title == "" {
+			cwd, _ := os.Getwd()
+			title = filepath.Base(cwd)
+		}
+		if err := export.BuildSite(export.SiteOptions{
+			Dir:      *exportSite,
+			Title:    title,
+			Issues:   issues,
+			DataHash: analysis.ComputeDataHash(issues),
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting: %v\n", err)
+			os.Exit(1)
+		}
+		statusf("✓ Static site with %d issue pages written to %s\n", len(issues), *exportSite)
+		os.Exit(0)
+	}
+
 	if *exportFile != "" {
-		fmt.Printf("Exporting to %s...\n", *exportFile)
+		mdOpts := export.DefaultMarkdownOptions()
+		mdOpts.TemplatePath = *mdTemplate
+		mdOpts.MaxDescriptionLength = *mdMaxDesc
+		mdOpts.IncludeAnalysis = *mdAnalysis
+		mdOpts.RiskWeights = analysis.RecipeRiskScoreWeights(activeRecipe)
+		mdOpts.ASCII = *mdASCII
+		if *mdSplit < 0 {
+			fmt.Fprintf(os.Stderr, "Invalid --md-split %d (must be 0 or more)\n", *mdSplit)
+			os.Exit(2)
+		}
+		mdOpts.SplitEvery = *mdSplit
+		order, err := export.ParseMarkdownSortOrder(*mdSort)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --md-sort: %v\n", err)
+			os.Exit(2)
+		}
+		mdOpts.SortOrder = order
+		group, err := export.ParseMarkdownGrouping(*mdGroup)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --md-group: %v\n", err)
+			os.Exit(2)
+		}
+		mdOpts.GroupBy = group
+		slugStyle, err := export.ParseMarkdownSlugStyle(*mdSlug)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --md-slug: %v\n", err)
+			os.Exit(2)
+		}
+		mdOpts.SlugStyle = slugStyle
+		locale, err := export.ParseMarkdownLocale(*mdLocale)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --md-locale: %v\n", err)
+			os.Exit(2)
+		}
+		mdOpts.Locale = locale
+		if *mdMilestone != "" {
+			d, err := time.ParseInLocation("2006-01-02", *mdMilestone, time.Local)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Invalid --md-milestone %q (expected YYYY-MM-DD)\n", *mdMilestone)
+				os.Exit(2)
+			}
+			mdOpts.Milestone = d
+			if *exportBurnUp != "" {
+				chart, err := burnUpChartFor(issues, d, "", *graphTitle, *exportBurnUp)
+				if err == nil {
+					err = export.SaveBurnUpChart(chart)
+				}
+				if err != nil {
+					warnf("Warning: burn-up chart not written: %v\n", err)
+				} else if rel, err := filepath.Rel(filepath.Dir(*exportFile), *exportBurnUp); err == nil {
+					mdOpts.BurnUpChartPath = filepath.ToSlash(rel)
+				}
+			}
+		}
+		for _, section := range strings.Split(*mdOmit, ",") {
+			switch strings.ToLower(strings.TrimSpace(section)) {
+			case "":
+			case "toc":
+				mdOpts.IncludeTOC = false
+			case "mermaid":
+				mdOpts.IncludeMermaid = false
+			case "comments":
+				mdOpts.IncludeComments = false
+			case "closed":
+				mdOpts.IncludeClosed = false
+			default:
+				fmt.Fprintf(os.Stderr, "Invalid --md-omit section %q (expected toc, mermaid, comments, closed)\n", section)
+				os.Exit(2)
+			}
+		}
+
+		progressf("Exporting to %s...\n", *exportFile)
 
 		// Load and run pre-export hooks
 		cwd, _ := os.Getwd()
@@ -4423,7 +5296,7 @@ func main() {
 		if !*noHooks {
 			hookLoader := hooks.NewLoader(hooks.WithProjectDir(cwd))
 			if err := hookLoader.Load(); err != nil {
-				fmt.Printf("Warning: failed to load hooks: %v\n", err)
+				warnf("Warning: failed to load hooks: %v\n", err)
 			} else if hookLoader.HasHooks() {
 				ctx := hooks.ExportContext{
 					ExportPath:   *exportFile,
@@ -4435,32 +5308,32 @@ func main() {
 
 				// Run pre-export hooks
 				if err := executor.RunPreExport(); err != nil {
-					fmt.Printf("Error: pre-export hook failed: %v\n", err)
+					fmt.Fprintf(os.Stderr, "Error: pre-export hook failed: %v\n", err)
 					os.Exit(1)
 				}
 			}
 		}
 
 		// Perform the export
-		if err := export.SaveMarkdownToFile(issues, *exportFile); err != nil {
-			fmt.Printf("Error exporting: %v\n", err)
+		if err := export.SaveMarkdownToFileWithOptions(issues, *exportFile, mdOpts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting: %v\n", err)
 			os.Exit(1)
 		}
 
 		// Run post-export hooks
 		if executor != nil {
 			if err := executor.RunPostExport(); err != nil {
-				fmt.Printf("Warning: post-export hook failed: %v\n", err)
+				warnf("Warning: post-export hook failed: %v\n", err)
 				// Don't exit, just warn
 			}
 
 			// Print hook summary if any hooks ran
 			if len(executor.Results()) > 0 {
-				fmt.Println(executor.Summary())
+				statusf("%s\n", executor.Summary())
 			}
 		}
 
-		fmt.Println("Done!")
+		statusf("Done!\n")
 		os.Exit(0)
 	}
 
@@ -4499,6 +5372,7 @@ func main() {
 	// Initial Model with live reload support
 	m := ui.NewModel(issues, activeRecipe, beadsPath)
 	defer m.Stop() // Clean up file watcher
+	m.SetPlanOptions(planOptions)
 
 	// Enable workspace mode if loading from workspace config
 	if workspaceInfo != nil {
@@ -4520,7 +5394,7 @@ func main() {
 
 	// Run Program
 	if err := runTUIProgram(m); err != nil {
-		fmt.Printf("Error running beads viewer: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error running beads viewer: %v\n", err)
 		os.Exit(1)
 	}
 }
@@ -4595,6 +5469,20 @@ func runTUIProgram(m ui.Model) error {
 }
 
 // countEdges counts blocking dependencies for config sizing
+// burnUpChartFor computes the burn-up for a milestone day (the whole day
+// counts as on time) and wraps it in chart export options.
+func burnUpChartFor(issues []model.Issue, milestone time.Time, targetID, title, path string) (export.BurnUpChartOptions, error) {
+	b, err := analysis.ComputeBurnUp(issues, analysis.BurnUpOptions{
+		Milestone: milestone.Add(24*time.Hour - time.Second),
+		TargetID:  targetID,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		return export.BurnUpChartOptions{}, err
+	}
+	return export.BurnUpChartOptions{Path: path, Title: title, BurnUp: b}, nil
+}
+
 func countEdges(issues []model.Issue) int {
 	count := 0
 	for _, issue := range issues {
@@ -4837,6 +5725,20 @@ func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 			}
 		}
 
+		// ExcludeStatus filter
+		if len(f.ExcludeStatus) > 0 {
+			excluded := false
+			for _, s := range f.ExcludeStatus {
+				if strings.EqualFold(string(issue.Status), s) {
+					excluded = true
+					break
+				}
+			}
+			if excluded {
+				continue
+			}
+		}
+
 		// Priority filter
 		if len(f.Priority) > 0 {
 			match := false
@@ -4850,6 +5752,9 @@ func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 				continue
 			}
 		}
+		if !f.InPriorityRange(issue.Priority) {
+			continue
+		}
 
 		// Tags filter (must have all)
 		if len(f.Tags) > 0 {
@@ -4891,6 +5796,47 @@ func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 			}
 		}
 
+		// Labels expression
+		if f.Labels != "" && !recipe.MatchLabelExpr(f.Labels, issue.Labels) {
+			continue
+		}
+
+		// Assignee filter (any of), and ExcludeAssignee
+		if len(f.Assignee) > 0 && !recipe.MatchAssignee(issue.Assignee, f.Assignee) {
+			continue
+		}
+		if recipe.MatchAssignee(issue.Assignee, f.ExcludeAssignee) {
+			continue
+		}
+
+		// Types filter (any of)
+		if len(f.Types) > 0 {
+			match := false
+			for _, t := range f.Types {
+				if strings.EqualFold(string(issue.IssueType), t) {
+					match = true
+					break
+				}
+			}
+			if !match {
+				continue
+			}
+		}
+
+		// ExcludeTypes filter
+		if len(f.ExcludeTypes) > 0 {
+			excluded := false
+			for _, t := range f.ExcludeTypes {
+				if strings.EqualFold(string(issue.IssueType), t) {
+					excluded = true
+					break
+				}
+			}
+			if excluded {
+				continue
+			}
+		}
+
 		// CreatedAfter filter
 		if f.CreatedAfter != "" {
 			threshold, err := recipe.ParseRelativeTime(f.CreatedAfter, now)
@@ -4958,6 +5904,11 @@ func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 			}
 		}
 
+		// Query: full text over title, description and notes
+		if f.Query != "" && !recipe.MatchText(issue, f.Query) {
+			continue
+		}
+
 		// IDPrefix filter
 		if f.IDPrefix != "" {
 			if !strings.HasPrefix(issue.ID, f.IDPrefix) {
@@ -4988,6 +5939,17 @@ func applyRecipeSort(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 	if (s.Field == "created" || s.Field == "updated") && s.Direction == "" {
 		ascending = false
 	}
+	// For staleness and risk, default to descending (most at-risk first)
+	var staleness, risk map[string]float64
+	switch s.Field {
+	case "staleness":
+		staleness = analysis.StalenessScores(issues, time.Now())
+	case "risk":
+		risk = analysis.RiskScoreMap(analysis.RiskScores(issues, time.Now(), analysis.RecipeRiskScoreWeights(r)))
+	}
+	if (s.Field == "staleness" || s.Field == "risk") && s.Direction == "" {
+		ascending = false
+	}
 
 	sort.SliceStable(issues, func(i, j int) bool {
 		var less bool
@@ -5005,6 +5967,10 @@ func applyRecipeSort(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 			less = naturalLess(issues[i].ID, issues[j].ID)
 		case "status":
 			less = issues[i].Status < issues[j].Status
+		case "staleness":
+			less = staleness[issues[i].ID] < staleness[issues[j].ID]
+		case "risk":
+			less = risk[issues[i].ID] < risk[issues[j].ID]
 		default:
 			// Unknown sort field, maintain order
 			return false
@@ -5247,6 +6213,48 @@ func generateProfileRecommendations(profile *analysis.StartupProfile, loadDurati
 // The filter matches issue IDs that start with the given prefix.
 // If the prefix doesn't end with a separator character, it normalizes by checking
 // common patterns (prefix-, prefix:, etc.).
+// datasetFlags collects repeated --dataset name=path flags
+type datasetFlags []string
+
+func (d *datasetFlags) String() string { return strings.Join(*d, ",") }
+
+func (d *datasetFlags) Set(v string) error {
+	*d = append(*d, v)
+	return nil
+}
+
+// loadWorkspaceConfig reads the --workspace config, if any, and adds the
+// --dataset repos to it. Returns the config and the root its relative
+// repo paths resolve against.
+func loadWorkspaceConfig(configPath string, datasets []string) (*workspace.Config, string, error) {
+	var config *workspace.Config
+	root := "."
+	if configPath != "" {
+		var err error
+		config, err = workspace.LoadConfig(configPath)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to load workspace config: %w", err)
+		}
+		root = filepath.Dir(filepath.Dir(configPath)) // .bv/workspace.yaml -> workspace root
+	}
+	if len(datasets) == 0 {
+		return config, root, nil
+	}
+
+	extra, err := workspace.DatasetConfig(datasets)
+	if err != nil {
+		return nil, "", err
+	}
+	if config == nil {
+		return extra, root, nil
+	}
+	config.Repos = append(config.Repos, extra.Repos...)
+	if err := config.Validate(); err != nil {
+		return nil, "", fmt.Errorf("invalid workspace config: %w", err)
+	}
+	return config, root, nil
+}
+
 func filterByRepo(issues []model.Issue, repoFilter string) []model.Issue {
 	if repoFilter == "" {
 		return issues
@@ -5859,17 +6867,17 @@ func runPagesWizard(issues []model.Issue, beadsPath string) error {
 	// Perform export
 	wizard.PerformExport(bundlePath)
 
-	fmt.Println("Exporting static site...")
-	fmt.Printf("  -> Loading %d issues\n", len(exportIssues))
+	progressf("Exporting static site...\n")
+	progressf("  -> Loading %d issues\n", len(exportIssues))
 
 	// Build graph and compute stats
-	fmt.Println("  -> Running graph analysis...")
+	progressf("  -> Running graph analysis...\n")
 	analyzer := analysis.NewAnalyzer(exportIssues)
 	stats := analyzer.AnalyzeAsync(context.Background())
 	stats.WaitForPhase2()
 
 	// Compute triage
-	fmt.Println("  -> Generating triage data...")
+	progressf("  -> Generating triage data...\n")
 	triage := analysis.ComputeTriage(exportIssues)
 
 	// Extract dependencies
@@ -5899,20 +6907,20 @@ func runPagesWizard(issues []model.Issue, beadsPath string) error {
 	}
 
 	// Export SQLite database
-	fmt.Println("  -> Writing database and JSON files...")
+	progressf("  -> Writing database and JSON files...\n")
 	if err := exporter.Export(bundlePath); err != nil {
 		return fmt.Errorf("export failed: %w", err)
 	}
 
 	// Copy viewer assets
-	fmt.Println("  -> Copying viewer assets...")
+	progressf("  -> Copying viewer assets...\n")
 	if err := copyViewerAssets(bundlePath, config.Title); err != nil {
 		return fmt.Errorf("failed to copy assets: %w", err)
 	}
 
 	// Generate README.md with project stats (for GitHub Pages)
 	if config.DeployTarget == "github" {
-		fmt.Println("  -> Generating README.md...")
+		progressf("  -> Generating README.md...\n")
 		// Compute the GitHub Pages URL from username and repo name
 		pagesURL := ""
 		if ghStatus, err := export.CheckGHStatus(); err == nil && ghStatus.Authenticated && ghStatus.Username != "" {
@@ -5935,28 +6943,28 @@ func runPagesWizard(issues []model.Issue, beadsPath string) error {
 			}
 		}
 		if err := generateREADME(bundlePath, config.Title, pagesURL, exportIssues, &triage, stats); err != nil {
-			fmt.Printf("  -> Warning: failed to generate README: %v\n", err)
+			warnf("  -> Warning: failed to generate README: %v\n", err)
 		}
 	}
 
 	// Export history data for time-travel feature if requested
 	if config.IncludeHistory {
-		fmt.Println("  -> Generating time-travel history data...")
+		progressf("  -> Generating time-travel history data...\n")
 		if historyReport, err := generateHistoryForExport(exportIssues); err == nil && historyReport != nil {
 			historyPath := filepath.Join(bundlePath, "data", "history.json")
 			if historyJSON, err := json.MarshalIndent(historyReport, "", "  "); err == nil {
 				if err := os.WriteFile(historyPath, historyJSON, 0644); err != nil {
-					fmt.Printf("  -> Warning: failed to write history.json: %v\n", err)
+					warnf("  -> Warning: failed to write history.json: %v\n", err)
 				} else {
-					fmt.Printf("  -> history.json (%d commits)\n", len(historyReport.Commits))
+					progressf("  -> history.json (%d commits)\n", len(historyReport.Commits))
 				}
 			}
 		} else if err != nil {
-			fmt.Printf("  -> Warning: failed to generate history: %v\n", err)
+			warnf("  -> Warning: failed to generate history: %v\n", err)
 		}
 	}
 
-	fmt.Printf("  -> Bundle created: %s\n", bundlePath)
+	progressf("  -> Bundle created: %s\n", bundlePath)
 	fmt.Println("")
 
 	// Offer preview and deploy (for GitHub and Cloudflare)
@@ -157,6 +157,54 @@ func TestApplyRecipeFilters_TagsAndDates(t *testing.T) {
 	}
 }
 
+func TestApplyRecipeFilters_LabelExpression(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "L1", Title: "Crash", Labels: []string{"backend", "regression"}},
+		{ID: "L2", Title: "Won't fix", Labels: []string{"backend", "urgent", "wontfix"}},
+		{ID: "L3", Title: "Calm", Labels: []string{"backend"}},
+		{ID: "L4", Title: "Styling", Labels: []string{"ui", "urgent"}},
+	}
+	r := &recipe.Recipe{Filters: recipe.FilterConfig{
+		Labels: "backend AND (urgent OR regression) AND NOT wontfix",
+	}}
+	got := applyRecipeFilters(issues, r)
+	if len(got) != 1 || got[0].ID != "L1" {
+		t.Fatalf("expected only L1 to match the label expression, got %#v", got)
+	}
+}
+
+func TestApplyRecipeFilters_TypesAndPriorityRange(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "B0", Title: "Outage", IssueType: model.TypeBug, Priority: 0},
+		{ID: "B1", Title: "Crash", IssueType: model.TypeBug, Priority: 1},
+		{ID: "B2", Title: "Typo", IssueType: model.TypeBug, Priority: 2},
+		{ID: "F1", Title: "Export", IssueType: model.TypeFeature, Priority: 1},
+	}
+	highest := 1
+	r := &recipe.Recipe{Filters: recipe.FilterConfig{
+		Types:       []string{"bug"},
+		PriorityMax: &highest,
+	}}
+	got := applyRecipeFilters(issues, r)
+	if len(got) != 2 || got[0].ID != "B0" || got[1].ID != "B1" {
+		t.Fatalf("expected the P0/P1 bugs, got %#v", got)
+	}
+}
+
+func TestApplyRecipeFilters_FullText(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "M1", Title: "Schema migration"},
+		{ID: "M2", Title: "Slow start", Description: "Every boot re-runs the MIGRATION"},
+		{ID: "M3", Title: "Release", Notes: "after the migraton lands"},
+		{ID: "M4", Title: "Dark mode"},
+	}
+	r := &recipe.Recipe{Filters: recipe.FilterConfig{Query: "migration"}}
+	got := applyRecipeFilters(issues, r)
+	if len(got) != 3 || got[2].ID != "M3" {
+		t.Fatalf("expected every issue mentioning migration, typo included, got %#v", got)
+	}
+}
+
 func TestApplyRecipeFilters_DatesBlockersAndPrefix(t *testing.T) {
 	now := time.Now()
 	early := now.Add(-72 * time.Hour)
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// CLI messages that are not the command's data. Data and artifacts go to
+// stdout or files; everything below goes to stderr so bv composes in shell
+// pipelines and cron jobs.
+var (
+	// quietOutput (--quiet) suppresses progress and status; warnings and
+	// errors still print.
+	quietOutput bool
+	// noProgress (--no-progress) suppresses step-by-step progress only.
+	noProgress bool
+	// diagOut receives progress, status and warnings.
+	diagOut io.Writer = os.Stderr
+)
+
+// progressf reports an intermediate step, e.g. "  → Running graph analysis...".
+func progressf(format string, args ...any) {
+	if quietOutput || noProgress {
+		return
+	}
+	fmt.Fprintf(diagOut, format, args...)
+}
+
+// statusf reports a completed action or a follow-up hint, e.g. "✓ Exported ...".
+func statusf(format string, args ...any) {
+	if quietOutput {
+		return
+	}
+	fmt.Fprintf(diagOut, format, args...)
+}
+
+// warnf reports a recoverable problem. It is shown even with --quiet.
+func warnf(format string, args ...any) {
+	fmt.Fprintf(diagOut, format, args...)
+}
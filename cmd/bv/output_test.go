@@ -0,0 +1,31 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestOutputHelpers_QuietAndNoProgress(t *testing.T) {
+	origOut, origQuiet, origNoProgress := diagOut, quietOutput, noProgress
+	t.Cleanup(func() { diagOut, quietOutput, noProgress = origOut, origQuiet, origNoProgress })
+
+	tests := []struct {
+		quiet, noProgress bool
+		want              string
+	}{
+		{false, false, "step\ndone\nwarn\n"},
+		{false, true, "done\nwarn\n"},
+		{true, false, "warn\n"},
+		{true, true, "warn\n"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		diagOut, quietOutput, noProgress = &buf, tt.quiet, tt.noProgress
+		progressf("step\n")
+		statusf("done\n")
+		warnf("warn\n")
+		if buf.String() != tt.want {
+			t.Errorf("quiet=%v no-progress=%v: got %q, want %q", tt.quiet, tt.noProgress, buf.String(), tt.want)
+		}
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/network"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/limits"
 )
 
 type denseIndex struct {
@@ -319,7 +321,7 @@ func ApproxBetweenness(g graph.Directed, sampleSize int, seed int64) Betweenness
 	var wg sync.WaitGroup
 
 	// Limit concurrency to avoid excessive goroutines
-	sem := make(chan struct{}, runtime.NumCPU())
+	sem := make(chan struct{}, limits.Current().Workers(runtime.NumCPU()))
 
 	for _, pivot := range pivots {
 		wg.Add(1)
@@ -0,0 +1,160 @@
+package analysis
+
+import (
+	"sort"
+)
+
+// BottleneckIssue is an articulation point: an issue that is the only link
+// between parts of the dependency graph
+type BottleneckIssue struct {
+	ID string `json:"id"`
+	// Disconnected is how many issues would be cut off from the largest
+	// remaining piece of its component if this issue were removed
+	Disconnected int `json:"disconnected"`
+	// Pieces is how many parts its component falls into without it
+	Pieces int `json:"pieces"`
+}
+
+// BridgeDependency is a bridge: a dependency whose removal splits its
+// component in two
+type BridgeDependency struct {
+	From string `json:"from"` // dependent issue
+	To   string `json:"to"`   // issue it depends on
+	// Disconnected is the size of the smaller side
+	Disconnected int `json:"disconnected"`
+}
+
+// Bottlenecks are the structural choke points of the dependency graph, on its
+// undirected view. Completing a bottleneck issue, or settling a bridge
+// dependency, is the only way the work on either side of it can connect, so
+// the ones with large Disconnected counts are where a slip holds up a whole
+// cluster. Both lists are sorted by Disconnected, largest first, then by ID.
+type Bottlenecks struct {
+	Issues  []BottleneckIssue  `json:"issues"`
+	Bridges []BridgeDependency `json:"bridges"`
+}
+
+// computeBottlenecks finds articulation points and bridges with one DFS per
+// component (Tarjan), sizing the pieces each would leave behind from the
+// DFS subtree sizes.
+func (a *Analyzer) computeBottlenecks(adj undirectedAdjacency) Bottlenecks {
+	out := Bottlenecks{Issues: []BottleneckIssue{}, Bridges: []BridgeDependency{}}
+	if len(adj.nodes) == 0 {
+		return out
+	}
+
+	disc := make([]int, len(adj.neighbors))
+	low := make([]int, len(adj.neighbors))
+	size := make([]int, len(adj.neighbors))
+	// cut[v] holds the sizes of child subtrees that detach when v goes
+	cut := make(map[int64][]int)
+	type bridge struct {
+		parent, child int64
+	}
+	var bridges []bridge
+	var timeIdx int
+
+	var dfs func(v, parent int64)
+	dfs = func(v, parent int64) {
+		timeIdx++
+		disc[v] = timeIdx
+		low[v] = timeIdx
+		size[v] = 1
+		for _, u := range adj.neighborsOf(v) {
+			if disc[u] == 0 {
+				dfs(u, v)
+				size[v] += size[u]
+				low[v] = min(low[v], low[u])
+				if low[u] >= disc[v] {
+					cut[v] = append(cut[v], size[u])
+				}
+				if low[u] > disc[v] {
+					bridges = append(bridges, bridge{parent: v, child: u})
+				}
+			} else if u != parent {
+				low[v] = min(low[v], disc[u])
+			}
+		}
+	}
+
+	const noParent int64 = -1
+	for _, root := range adj.nodes {
+		if disc[root] != 0 {
+			continue
+		}
+		dfs(root, noParent)
+		total := size[root]
+
+		for v, pieces := range cut {
+			// The root is a cut vertex only with two or more detached
+			// subtrees; anyone else also leaves the part holding its parent.
+			if v != root {
+				rest := total - 1
+				for _, p := range pieces {
+					rest -= p
+				}
+				pieces = append(pieces, rest)
+			}
+			if len(pieces) < 2 {
+				continue
+			}
+			largest, sum := 0, 0
+			for _, p := range pieces {
+				largest = max(largest, p)
+				sum += p
+			}
+			out.Issues = append(out.Issues, BottleneckIssue{
+				ID:           a.nodeToID[v],
+				Disconnected: sum - largest,
+				Pieces:       len(pieces),
+			})
+		}
+		clear(cut)
+
+		for _, b := range bridges {
+			from, to := b.child, b.parent
+			if a.g.HasEdgeFromTo(b.parent, b.child) {
+				from, to = b.parent, b.child
+			}
+			out.Bridges = append(out.Bridges, BridgeDependency{
+				From:         a.nodeToID[from],
+				To:           a.nodeToID[to],
+				Disconnected: min(size[b.child], total-size[b.child]),
+			})
+		}
+		bridges = bridges[:0]
+	}
+
+	sort.Slice(out.Issues, func(i, j int) bool {
+		if out.Issues[i].Disconnected != out.Issues[j].Disconnected {
+			return out.Issues[i].Disconnected > out.Issues[j].Disconnected
+		}
+		return out.Issues[i].ID < out.Issues[j].ID
+	})
+	sort.Slice(out.Bridges, func(i, j int) bool {
+		bi, bj := out.Bridges[i], out.Bridges[j]
+		if bi.Disconnected != bj.Disconnected {
+			return bi.Disconnected > bj.Disconnected
+		}
+		if bi.From != bj.From {
+			return bi.From < bj.From
+		}
+		return bi.To < bj.To
+	})
+	return out
+}
+
+// Bottlenecks returns the articulation points and bridges of the dependency
+// graph with the size of what each would disconnect. The zero value is
+// returned until Phase 2 completes or when articulation is not computed.
+func (s *GraphStats) Bottlenecks() Bottlenecks {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.bottlenecks == nil {
+		return Bottlenecks{}
+	}
+	return Bottlenecks{
+		Issues:  append([]BottleneckIssue{}, s.bottlenecks.Issues...),
+		Bridges: append([]BridgeDependency{}, s.bottlenecks.Bridges...),
+	}
+}
@@ -0,0 +1,58 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestGraphStatsBottlenecks(t *testing.T) {
+	blocks := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	// A cycle A-B-C hangs off C; D waits on C, and E and F wait on D. G is
+	// isolated.
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen, Dependencies: blocks("B")},
+		{ID: "B", Status: model.StatusOpen, Dependencies: blocks("C")},
+		{ID: "C", Status: model.StatusOpen, Dependencies: blocks("A")},
+		{ID: "D", Status: model.StatusOpen, Dependencies: blocks("C")},
+		{ID: "E", Status: model.StatusOpen, Dependencies: blocks("D")},
+		{ID: "F", Status: model.StatusOpen, Dependencies: blocks("D")},
+		{ID: "G", Status: model.StatusOpen},
+	}
+	stats := NewAnalyzer(issues).Analyze()
+	got := stats.Bottlenecks()
+
+	wantIssues := []BottleneckIssue{
+		{ID: "C", Disconnected: 2, Pieces: 2}, // {A,B} vs {D,E,F}
+		{ID: "D", Disconnected: 2, Pieces: 3}, // {A,B,C}, {E}, {F}
+	}
+	if !reflect.DeepEqual(got.Issues, wantIssues) {
+		t.Errorf("expected cut issues %+v, got %+v", wantIssues, got.Issues)
+	}
+	wantBridges := []BridgeDependency{
+		{From: "D", To: "C", Disconnected: 3},
+		{From: "E", To: "D", Disconnected: 1},
+		{From: "F", To: "D", Disconnected: 1},
+	}
+	if !reflect.DeepEqual(got.Bridges, wantBridges) {
+		t.Errorf("expected bridges %+v, got %+v", wantBridges, got.Bridges)
+	}
+
+	// Articulation points agree with the dedicated computation
+	var ids []string
+	for _, b := range got.Issues {
+		ids = append(ids, b.ID)
+	}
+	if art := stats.ArticulationPoints(); !reflect.DeepEqual(ids, art) {
+		t.Errorf("bottleneck issues %v disagree with articulation points %v", ids, art)
+	}
+
+	// The returned slices are copies
+	got.Issues[0].ID = "mutated"
+	if stats.Bottlenecks().Issues[0].ID != "C" {
+		t.Error("Bottlenecks should return a copy")
+	}
+}
@@ -0,0 +1,349 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// BurnUpOptions configures deadline-risk burn-up computation
+type BurnUpOptions struct {
+	// Milestone is the deadline the scope must be done by (required)
+	Milestone time.Time
+
+	// TargetID limits scope to the issue and everything it transitively
+	// depends on, open or closed; empty means every issue
+	TargetID string
+
+	// Trials is the number of Monte Carlo runs (default 1000)
+	Trials int
+
+	// HistoryDays is the throughput sampling window (default 30)
+	HistoryDays int
+
+	// Seed makes the forecast reproducible (default 1)
+	Seed int64
+
+	// Now anchors the chart (default time.Now())
+	Now time.Time
+}
+
+// BurnUpPoint is the scope and completed count at the end of a day
+type BurnUpPoint struct {
+	Date      time.Time `json:"date"`
+	Scope     int       `json:"scope"`
+	Completed int       `json:"completed"`
+}
+
+// BurnUpForecastPoint is the simulated completed count at the end of a future
+// day: Low/Median/High are the 10th, 50th and 90th percentiles across trials
+type BurnUpForecastPoint struct {
+	Date   time.Time `json:"date"`
+	Low    float64   `json:"p10"`
+	Median float64   `json:"p50"`
+	High   float64   `json:"p90"`
+}
+
+// Burn-up risk levels, from the chance of finishing by the milestone
+const (
+	BurnUpRiskLow    = "low"    // >= 85%
+	BurnUpRiskMedium = "medium" // >= 50%
+	BurnUpRiskHigh   = "high"
+)
+
+// BurnUp is a scope-vs-completed chart with a Monte Carlo forecast cone
+type BurnUp struct {
+	Milestone         time.Time             `json:"milestone"`
+	TargetID          string                `json:"target_id,omitempty"`
+	Scope             int                   `json:"scope"`
+	Completed         int                   `json:"completed"`
+	History           []BurnUpPoint         `json:"history"`
+	Forecast          []BurnUpForecastPoint `json:"forecast"`
+	Trials            int                   `json:"trials"`
+	DailyThroughput   float64               `json:"daily_throughput"` // mean closes per day in the sample window
+	OnTimeProbability float64               `json:"on_time_probability"`
+	FinishP50         *time.Time            `json:"finish_p50,omitempty"` // nil when not reached within the horizon
+	FinishP85         *time.Time            `json:"finish_p85,omitempty"`
+	Risk              string                `json:"risk"`
+}
+
+// burnUpMaxHistoryDays bounds how far back the chart starts
+const burnUpMaxHistoryDays = 90
+
+// burnUpMaxHorizonDays bounds how far past now the forecast runs
+const burnUpMaxHorizonDays = 365
+
+// ComputeBurnUp builds a burn-up chart for the scope and forecasts completion
+// by resampling the project's daily close counts over the recent window. Item
+// counts, not estimates, drive the forecast, so it needs no sizing data.
+func ComputeBurnUp(issues []model.Issue, opts BurnUpOptions) (BurnUp, error) {
+	if opts.Milestone.IsZero() {
+		return BurnUp{}, fmt.Errorf("burn-up needs a milestone date")
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	trials := opts.Trials
+	if trials <= 0 {
+		trials = 1000
+	}
+	window := opts.HistoryDays
+	if window <= 0 {
+		window = 30
+	}
+	seed := opts.Seed
+	if seed == 0 {
+		seed = 1
+	}
+
+	scope, err := burnUpScope(issues, opts.TargetID)
+	if err != nil {
+		return BurnUp{}, err
+	}
+
+	today := startOfDay(now)
+	b := BurnUp{Milestone: opts.Milestone, TargetID: opts.TargetID, Trials: trials, Scope: len(scope)}
+
+	// History: one point per day from the first scoped issue (capped) to today.
+	first := today
+	for _, iss := range scope {
+		if !iss.CreatedAt.IsZero() && iss.CreatedAt.Before(first) {
+			first = startOfDay(iss.CreatedAt)
+		}
+	}
+	if earliest := today.AddDate(0, 0, -burnUpMaxHistoryDays); first.Before(earliest) {
+		first = earliest
+	}
+	for day := first; !day.After(today); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1)
+		p := BurnUpPoint{Date: day}
+		for _, iss := range scope {
+			if iss.CreatedAt.IsZero() || iss.CreatedAt.Before(end) {
+				p.Scope++
+			}
+			if closed, ok := closedTime(iss); ok && closed.Before(end) {
+				p.Completed++
+			}
+		}
+		b.History = append(b.History, p)
+	}
+	for _, iss := range scope {
+		if _, ok := closedTime(iss); ok {
+			b.Completed++
+		}
+	}
+
+	// Throughput samples: closes per day over the window, across the project.
+	samples := make([]int, window)
+	since := today.AddDate(0, 0, -window+1)
+	for _, iss := range issues {
+		closed, ok := closedTime(iss)
+		if !ok || closed.Before(since) || !closed.Before(today.AddDate(0, 0, 1)) {
+			continue
+		}
+		samples[int(startOfDay(closed).Sub(since).Hours()/24+0.5)]++
+	}
+	total := 0
+	for _, s := range samples {
+		total += s
+	}
+	b.DailyThroughput = float64(total) / float64(window)
+
+	remaining := b.Scope - b.Completed
+	milestoneDay := int(math.Ceil(startOfDay(opts.Milestone).Sub(today).Hours() / 24))
+	horizon := milestoneDay
+	if horizon < 1 {
+		horizon = 1
+	}
+
+	// finish[t] is the day offset trial t completed, or -1 if it never did.
+	finish := make([]int, trials)
+	var perDay [][]int
+	if remaining <= 0 {
+		for t := range finish {
+			finish[t] = 0
+		}
+	} else if total == 0 {
+		for t := range finish {
+			finish[t] = -1
+		}
+	} else {
+		rng := rand.New(rand.NewSource(seed))
+		runs := make([][]int, trials)
+		longest := horizon
+		for t := 0; t < trials; t++ {
+			finish[t] = -1
+			done := 0
+			for day := 1; day <= burnUpMaxHorizonDays; day++ {
+				done += samples[rng.Intn(window)]
+				if done > remaining {
+					done = remaining
+				}
+				runs[t] = append(runs[t], done)
+				if done == remaining {
+					finish[t] = day
+					break
+				}
+			}
+			if finish[t] > longest {
+				longest = finish[t]
+			}
+		}
+		if longest > burnUpMaxHorizonDays {
+			longest = burnUpMaxHorizonDays
+		}
+		perDay = make([][]int, longest)
+		for day := range perDay {
+			perDay[day] = make([]int, trials)
+			for t, run := range runs {
+				v := remaining
+				if day < len(run) {
+					v = run[day]
+				}
+				perDay[day][t] = v
+			}
+		}
+	}
+
+	for day, vals := range perDay {
+		sort.Ints(vals)
+		b.Forecast = append(b.Forecast, BurnUpForecastPoint{
+			Date:   today.AddDate(0, 0, day+1),
+			Low:    float64(b.Completed + percentileInt(vals, 0.10)),
+			Median: float64(b.Completed + percentileInt(vals, 0.50)),
+			High:   float64(b.Completed + percentileInt(vals, 0.90)),
+		})
+	}
+
+	if b.Forecast == nil {
+		b.Forecast = []BurnUpForecastPoint{}
+	}
+
+	onTime := 0
+	for _, f := range finish {
+		if f >= 0 && f <= milestoneDay {
+			onTime++
+		}
+	}
+	b.OnTimeProbability = float64(onTime) / float64(trials)
+	b.FinishP50 = finishQuantile(finish, 0.50, today)
+	b.FinishP85 = finishQuantile(finish, 0.85, today)
+
+	switch {
+	case b.OnTimeProbability >= 0.85:
+		b.Risk = BurnUpRiskLow
+	case b.OnTimeProbability >= 0.5:
+		b.Risk = BurnUpRiskMedium
+	default:
+		b.Risk = BurnUpRiskHigh
+	}
+	return b, nil
+}
+
+// burnUpScope returns the non-tombstone issues in scope
+func burnUpScope(issues []model.Issue, targetID string) ([]model.Issue, error) {
+	var scope []model.Issue
+	if targetID == "" {
+		for _, iss := range issues {
+			if iss.Status != model.StatusTombstone {
+				scope = append(scope, iss)
+			}
+		}
+		return scope, nil
+	}
+
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	if _, ok := byID[targetID]; !ok {
+		return nil, fmt.Errorf("issue %q not found", targetID)
+	}
+	seen := make(map[string]bool)
+	stack := []string{targetID}
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		iss, ok := byID[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if iss.Status != model.StatusTombstone {
+			scope = append(scope, *iss)
+		}
+		for _, dep := range iss.Dependencies {
+			if dep != nil && dep.Type.IsBlocking() {
+				stack = append(stack, dep.DependsOnID)
+			}
+		}
+	}
+	return scope, nil
+}
+
+// closedTime returns when a closed issue was closed (UpdatedAt when ClosedAt
+// is missing, as in velocity estimation)
+func closedTime(iss model.Issue) (time.Time, bool) {
+	if iss.Status != model.StatusClosed {
+		return time.Time{}, false
+	}
+	if iss.ClosedAt != nil {
+		return *iss.ClosedAt, true
+	}
+	return iss.UpdatedAt, true
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// percentileInt picks the nearest-rank percentile of sorted values
+func percentileInt(sorted []int, q float64) int {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(q*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// percentileFloat picks the nearest-rank percentile of sorted values
+func percentileFloat(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(q*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// finishQuantile returns the date by which a q share of trials finished, or
+// nil when fewer than that finished within the horizon
+func finishQuantile(finish []int, q float64, today time.Time) *time.Time {
+	days := make([]int, 0, len(finish))
+	for _, f := range finish {
+		if f >= 0 {
+			days = append(days, f)
+		}
+	}
+	need := int(math.Ceil(q * float64(len(finish))))
+	if need < 1 {
+		need = 1
+	}
+	if len(days) < need {
+		return nil
+	}
+	sort.Ints(days)
+	d := today.AddDate(0, 0, days[need-1])
+	return &d
+}
@@ -0,0 +1,92 @@
+package analysis
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeBurnUp_ForecastAndRisk(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	// 40 issues created 60 days ago; one closed each day over the last 20
+	// days, the rest open
+	var issues []model.Issue
+	created := now.AddDate(0, 0, -60)
+	for i := 0; i < 40; i++ {
+		iss := model.Issue{ID: fmt.Sprintf("bu-%02d", i), Title: "t", Status: model.StatusOpen, IssueType: model.TypeTask, CreatedAt: created}
+		if i < 20 {
+			closed := now.AddDate(0, 0, -i)
+			iss.Status = model.StatusClosed
+			iss.ClosedAt = &closed
+		}
+		issues = append(issues, iss)
+	}
+
+	// 20 left at ~0.67/day (20 closes over a 30-day window): ~30 days.
+	late, err := ComputeBurnUp(issues, BurnUpOptions{Milestone: now.AddDate(0, 0, 10), Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if late.Scope != 40 || late.Completed != 20 {
+		t.Fatalf("scope/completed = %d/%d, want 40/20", late.Scope, late.Completed)
+	}
+	if late.Risk != BurnUpRiskHigh || late.OnTimeProbability > 0.05 {
+		t.Errorf("10-day milestone: risk %s p=%.2f, want high and ~0", late.Risk, late.OnTimeProbability)
+	}
+	if late.FinishP50 == nil || late.FinishP85 == nil || late.FinishP85.Before(*late.FinishP50) {
+		t.Errorf("finish quantiles out of order: %v %v", late.FinishP50, late.FinishP85)
+	}
+
+	early, err := ComputeBurnUp(issues, BurnUpOptions{Milestone: now.AddDate(0, 0, 60), Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if early.Risk != BurnUpRiskLow {
+		t.Errorf("60-day milestone: risk %s p=%.2f, want low", early.Risk, early.OnTimeProbability)
+	}
+
+	for i, f := range early.Forecast {
+		if f.Low > f.Median || f.Median > f.High || f.High > float64(early.Scope) {
+			t.Fatalf("forecast[%d] not ordered within scope: %+v", i, f)
+		}
+	}
+	last := early.History[len(early.History)-1]
+	if last.Scope != 40 || last.Completed != 20 {
+		t.Errorf("last history point = %+v, want scope 40 completed 20", last)
+	}
+
+	again, _ := ComputeBurnUp(issues, BurnUpOptions{Milestone: now.AddDate(0, 0, 60), Now: now})
+	if again.OnTimeProbability != early.OnTimeProbability || len(again.Forecast) != len(early.Forecast) {
+		t.Error("same seed should give the same forecast")
+	}
+}
+
+func TestComputeBurnUp_ScopeAndEdgeCases(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "rel", Status: model.StatusOpen, CreatedAt: now, Dependencies: []*model.Dependency{{DependsOnID: "a", Type: model.DepBlocks}}},
+		{ID: "a", Status: model.StatusOpen, CreatedAt: now},
+		{ID: "other", Status: model.StatusOpen, CreatedAt: now},
+	}
+
+	if _, err := ComputeBurnUp(issues, BurnUpOptions{Now: now}); err == nil {
+		t.Error("expected error without a milestone")
+	}
+	if _, err := ComputeBurnUp(issues, BurnUpOptions{Milestone: now, TargetID: "nope", Now: now}); err == nil {
+		t.Error("expected error for unknown target")
+	}
+
+	b, err := ComputeBurnUp(issues, BurnUpOptions{Milestone: now.AddDate(0, 0, 7), TargetID: "rel", Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Scope != 2 {
+		t.Errorf("scope = %d, want 2 (target and its blocker)", b.Scope)
+	}
+	// Nothing has ever closed: no forecast and no chance of finishing.
+	if len(b.Forecast) != 0 || b.OnTimeProbability != 0 || b.Risk != BurnUpRiskHigh || b.FinishP50 != nil {
+		t.Errorf("no throughput: got forecast=%d p=%.2f risk=%s", len(b.Forecast), b.OnTimeProbability, b.Risk)
+	}
+}
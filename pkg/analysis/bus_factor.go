@@ -0,0 +1,267 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// AssigneeShare is how much of a group's open work one assignee holds
+type AssigneeShare struct {
+	Assignee string  `json:"assignee"`
+	Issues   int     `json:"issues"`
+	Share    float64 `json:"share"` // of the group's open issues, unassigned included
+}
+
+// OwnershipConcentration describes who holds the open work of an epic or
+// execution track
+type OwnershipConcentration struct {
+	ID         string          `json:"id"` // epic ID or plan track ID
+	Title      string          `json:"title,omitempty"`
+	Open       int             `json:"open"`
+	Unassigned int             `json:"unassigned"`
+	Assignees  []AssigneeShare `json:"assignees"` // most issues first
+	TopShare   float64         `json:"top_share"` // Share of the first assignee
+
+	// BusFactor is the fewest assignees who together hold at least half of
+	// the assigned open issues: 1 means one person is carrying the group.
+	// 0 when nothing is assigned.
+	BusFactor int `json:"bus_factor"`
+}
+
+// SingleOwnerChain is a connected set of open issues, linked by blocking
+// dependencies, that all belong to one assignee. Nobody else can make
+// progress anywhere along it.
+type SingleOwnerChain struct {
+	Assignee string   `json:"assignee"`
+	IssueIDs []string `json:"issue_ids"` // sorted
+	Length   int      `json:"length"`    // issues on the longest blocking path through it
+}
+
+// BusFactorReport is the output of ComputeBusFactor
+type BusFactorReport struct {
+	Chains []SingleOwnerChain       `json:"chains"` // longest first
+	Epics  []OwnershipConcentration `json:"epics"`  // by ID
+	Tracks []OwnershipConcentration `json:"tracks"` // in execution plan order
+}
+
+// ComputeBusFactor looks for work that depends on a single person. Chains are
+// the blocking chains of open issues owned end to end by one assignee. Epics
+// report concentration over their open parent-child descendants; tracks over
+// the open issues of each execution plan track's community, so a track whose
+// actionable head is shared can still show a single owner behind it. Groups
+// without open issues are left out.
+func ComputeBusFactor(issues []model.Issue) BusFactorReport {
+	a := NewAnalyzer(issues)
+	report := BusFactorReport{
+		Chains: a.singleOwnerChains(),
+		Epics:  []OwnershipConcentration{},
+		Tracks: []OwnershipConcentration{},
+	}
+
+	epics := a.epicMembers()
+	epicIDs := make([]string, 0, len(epics))
+	for id := range epics {
+		epicIDs = append(epicIDs, id)
+	}
+	sort.Strings(epicIDs)
+	for _, id := range epicIDs {
+		if c, ok := a.ownershipConcentration(id, a.issueMap[id].Title, epics[id]); ok {
+			report.Epics = append(report.Epics, c)
+		}
+	}
+
+	communities := a.findCommunities()
+	rootOf := make(map[string]string, len(a.issueMap))
+	for root, members := range communities {
+		for _, id := range members {
+			rootOf[id] = root
+		}
+	}
+	for _, track := range a.GetExecutionPlan().Tracks {
+		if len(track.Items) == 0 {
+			continue
+		}
+		members := communities[rootOf[track.Items[0].ID]]
+		if c, ok := a.ownershipConcentration(track.TrackID, "", members); ok {
+			report.Tracks = append(report.Tracks, c)
+		}
+	}
+	return report
+}
+
+// epicMembers maps each epic to its parent-child descendants
+func (a *Analyzer) epicMembers() map[string][]string {
+	children := make(map[string][]string)
+	for _, iss := range a.issueMap {
+		for _, dep := range iss.Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				children[dep.DependsOnID] = append(children[dep.DependsOnID], iss.ID)
+			}
+		}
+	}
+
+	epics := make(map[string][]string)
+	for id, iss := range a.issueMap {
+		if iss.IssueType != model.TypeEpic {
+			continue
+		}
+		members := []string{}
+		seen := map[string]bool{id: true}
+		stack := append([]string(nil), children[id]...)
+		for len(stack) > 0 {
+			cur := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if seen[cur] {
+				continue
+			}
+			seen[cur] = true
+			members = append(members, cur)
+			stack = append(stack, children[cur]...)
+		}
+		epics[id] = members
+	}
+	return epics
+}
+
+// ownershipConcentration tallies assignees over the open issues among ids;
+// ok is false when none are open
+func (a *Analyzer) ownershipConcentration(id, title string, ids []string) (OwnershipConcentration, bool) {
+	c := OwnershipConcentration{ID: id, Title: title, Assignees: []AssigneeShare{}}
+	counts := make(map[string]int)
+	for _, member := range ids {
+		iss, ok := a.issueMap[member]
+		if !ok || isClosedLikeStatus(iss.Status) {
+			continue
+		}
+		c.Open++
+		if iss.Assignee == "" {
+			c.Unassigned++
+			continue
+		}
+		counts[iss.Assignee]++
+	}
+	if c.Open == 0 {
+		return c, false
+	}
+
+	for name, n := range counts {
+		c.Assignees = append(c.Assignees, AssigneeShare{Assignee: name, Issues: n, Share: float64(n) / float64(c.Open)})
+	}
+	sort.Slice(c.Assignees, func(i, j int) bool {
+		if c.Assignees[i].Issues != c.Assignees[j].Issues {
+			return c.Assignees[i].Issues > c.Assignees[j].Issues
+		}
+		return c.Assignees[i].Assignee < c.Assignees[j].Assignee
+	})
+	if len(c.Assignees) > 0 {
+		c.TopShare = c.Assignees[0].Share
+	}
+
+	assigned := c.Open - c.Unassigned
+	covered := 0
+	for _, s := range c.Assignees {
+		if 2*covered >= assigned {
+			break
+		}
+		covered += s.Issues
+		c.BusFactor++
+	}
+	return c, true
+}
+
+// singleOwnerChains groups open issues joined by blocking dependencies whose
+// two ends share an assignee, keeping groups of two or more
+func (a *Analyzer) singleOwnerChains() []SingleOwnerChain {
+	owner := func(nodeID int64) string {
+		iss := a.issueMap[a.nodeToID[nodeID]]
+		if isClosedLikeStatus(iss.Status) {
+			return ""
+		}
+		return iss.Assignee
+	}
+
+	// Edges u -> v (u depends on v) between open issues of the same owner
+	deps := make(map[int64][]int64)
+	parent := make(map[int64]int64)
+	var find func(int64) int64
+	find = func(x int64) int64 {
+		p, ok := parent[x]
+		if !ok || p == x {
+			return x
+		}
+		root := find(p)
+		parent[x] = root
+		return root
+	}
+	edges := a.g.Edges()
+	for edges.Next() {
+		u, v := edges.Edge().From().ID(), edges.Edge().To().ID()
+		who := owner(u)
+		if who == "" || who != owner(v) {
+			continue
+		}
+		deps[u] = append(deps[u], v)
+		if ru, rv := find(u), find(v); ru != rv {
+			parent[ru] = rv
+		}
+	}
+
+	groups := make(map[int64][]int64)
+	for node := range deps {
+		sort.Slice(deps[node], func(i, j int) bool { return a.nodeToID[deps[node][i]] < a.nodeToID[deps[node][j]] })
+		groups[find(node)] = append(groups[find(node)], node)
+		for _, v := range deps[node] {
+			groups[find(v)] = append(groups[find(v)], v)
+		}
+	}
+
+	// Longest path in issues, ignoring edges that close a cycle
+	depth := make(map[int64]int)
+	onStack := make(map[int64]bool)
+	var longest func(int64) int
+	longest = func(n int64) int {
+		if d, ok := depth[n]; ok {
+			return d
+		}
+		onStack[n] = true
+		best := 0
+		for _, v := range deps[n] {
+			if !onStack[v] {
+				best = max(best, longest(v))
+			}
+		}
+		onStack[n] = false
+		depth[n] = best + 1
+		return best + 1
+	}
+
+	chains := []SingleOwnerChain{}
+	for root, nodes := range groups {
+		// Visit in ID order so cycles are broken the same way every run
+		sort.Slice(nodes, func(i, j int) bool { return a.nodeToID[nodes[i]] < a.nodeToID[nodes[j]] })
+		seen := make(map[int64]bool, len(nodes))
+		chain := SingleOwnerChain{Assignee: owner(root)}
+		for _, n := range nodes {
+			if seen[n] {
+				continue
+			}
+			seen[n] = true
+			chain.IssueIDs = append(chain.IssueIDs, a.nodeToID[n])
+			chain.Length = max(chain.Length, longest(n))
+		}
+		sort.Strings(chain.IssueIDs)
+		chains = append(chains, chain)
+	}
+	sort.Slice(chains, func(i, j int) bool {
+		ci, cj := chains[i], chains[j]
+		if ci.Length != cj.Length {
+			return ci.Length > cj.Length
+		}
+		if len(ci.IssueIDs) != len(cj.IssueIDs) {
+			return len(ci.IssueIDs) > len(cj.IssueIDs)
+		}
+		return ci.IssueIDs[0] < cj.IssueIDs[0]
+	})
+	return chains
+}
@@ -0,0 +1,68 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeBusFactor(t *testing.T) {
+	dep := func(id string, typ model.DependencyType) *model.Dependency {
+		return &model.Dependency{DependsOnID: id, Type: typ}
+	}
+	issues := []model.Issue{
+		{ID: "E", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		// ann owns A <- B <- C end to end; D (bob) hangs off C
+		{ID: "A", Status: model.StatusOpen, Assignee: "ann", Dependencies: []*model.Dependency{dep("E", model.DepParentChild)}},
+		{ID: "B", Status: model.StatusOpen, Assignee: "ann", Dependencies: []*model.Dependency{dep("A", model.DepBlocks), dep("E", model.DepParentChild)}},
+		{ID: "C", Status: model.StatusOpen, Assignee: "ann", Dependencies: []*model.Dependency{dep("B", model.DepBlocks), dep("E", model.DepParentChild)}},
+		{ID: "D", Status: model.StatusOpen, Assignee: "bob", Dependencies: []*model.Dependency{dep("C", model.DepBlocks), dep("E", model.DepParentChild)}},
+		{ID: "F", Status: model.StatusOpen, Dependencies: []*model.Dependency{dep("E", model.DepParentChild)}},
+		// Closed issues neither join chains nor count toward concentration
+		{ID: "X", Status: model.StatusClosed, Assignee: "bob", Dependencies: []*model.Dependency{dep("E", model.DepParentChild)}},
+		{ID: "Y", Status: model.StatusOpen, Assignee: "bob", Dependencies: []*model.Dependency{dep("X", model.DepBlocks)}},
+	}
+
+	report := ComputeBusFactor(issues)
+
+	wantChains := []SingleOwnerChain{{Assignee: "ann", IssueIDs: []string{"A", "B", "C"}, Length: 3}}
+	if !reflect.DeepEqual(report.Chains, wantChains) {
+		t.Errorf("expected chains %+v, got %+v", wantChains, report.Chains)
+	}
+
+	if len(report.Epics) != 1 {
+		t.Fatalf("expected one epic, got %+v", report.Epics)
+	}
+	epic := report.Epics[0]
+	if epic.ID != "E" || epic.Open != 5 || epic.Unassigned != 1 || epic.BusFactor != 1 {
+		t.Errorf("unexpected epic concentration %+v", epic)
+	}
+	if len(epic.Assignees) != 2 || epic.Assignees[0].Assignee != "ann" || epic.Assignees[0].Issues != 3 || epic.TopShare != 0.6 {
+		t.Errorf("expected ann to hold 3 of 5, got %+v", epic.Assignees)
+	}
+
+	if len(report.Tracks) == 0 {
+		t.Fatal("expected track concentrations")
+	}
+	for _, tr := range report.Tracks {
+		if tr.Open == 0 || tr.BusFactor > len(tr.Assignees) {
+			t.Errorf("inconsistent track %+v", tr)
+		}
+	}
+}
+
+func TestOwnershipBusFactorSplitsEvenly(t *testing.T) {
+	var issues []model.Issue
+	for i, who := range []string{"a", "b", "c", "d"} {
+		issues = append(issues, model.Issue{ID: string(rune('1' + i)), Status: model.StatusOpen, Assignee: who})
+	}
+	a := NewAnalyzer(issues)
+	c, ok := a.ownershipConcentration("g", "", []string{"1", "2", "3", "4"})
+	if !ok || c.BusFactor != 2 || c.TopShare != 0.25 {
+		t.Errorf("expected bus factor 2 with even split, got %+v", c)
+	}
+	if _, ok := a.ownershipConcentration("none", "", nil); ok {
+		t.Error("expected no concentration for an empty group")
+	}
+}
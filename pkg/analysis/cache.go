@@ -610,7 +610,10 @@ func (ca *CachedAnalyzer) Analyze() GraphStats {
 		NodeCount:         stats.NodeCount,
 		EdgeCount:         stats.EdgeCount,
 		Config:            stats.Config,
+		dependsOn:         stats.dependsOn,
+		dependents:        stats.dependents,
 		pageRank:          stats.pageRank,
+		priorityPageRank:  stats.priorityPageRank,
 		betweenness:       stats.betweenness,
 		eigenvector:       stats.eigenvector,
 		hubs:              stats.hubs,
@@ -653,7 +656,10 @@ type graphStatsCacheBlob struct {
 	EdgeCount        int            `json:"edge_count"`
 	Config           AnalysisConfig `json:"config"`
 
+	DependsOn map[string][]string `json:"depends_on,omitempty"`
+
 	PageRank          map[string]float64 `json:"page_rank"`
+	PriorityPageRank  map[string]float64 `json:"priority_page_rank"`
 	Betweenness       map[string]float64 `json:"betweenness"`
 	Eigenvector       map[string]float64 `json:"eigenvector"`
 	Hubs              map[string]float64 `json:"hubs"`
@@ -661,7 +667,11 @@ type graphStatsCacheBlob struct {
 	CriticalPathScore map[string]float64 `json:"critical_path_score"`
 	CoreNumber        map[string]int     `json:"core_number"`
 	Articulation      []string           `json:"articulation"`
+	Bottlenecks       *Bottlenecks       `json:"bottlenecks,omitempty"`
+	SCCs              *Condensation      `json:"sccs,omitempty"`
 	Slack             map[string]float64 `json:"slack"`
+	Communities       map[string]int     `json:"communities"`
+	Modularity        float64            `json:"modularity"`
 	Cycles            [][]string         `json:"cycles"`
 	Status            MetricStatus       `json:"status"`
 }
@@ -676,17 +686,25 @@ func (b graphStatsCacheBlob) toGraphStats() *GraphStats {
 		EdgeCount:        b.EdgeCount,
 		Config:           b.Config,
 
+		dependsOn:  b.DependsOn,
+		dependents: invertAdjacency(b.DependsOn),
+
 		phase2Ready: true,
 		phase2Done:  make(chan struct{}),
 
 		pageRank:          b.PageRank,
+		priorityPageRank:  b.PriorityPageRank,
 		betweenness:       b.Betweenness,
 		eigenvector:       b.Eigenvector,
 		hubs:              b.Hubs,
 		authorities:       b.Authorities,
 		criticalPathScore: b.CriticalPathScore,
 		coreNumber:        b.CoreNumber,
+		bottlenecks:       b.Bottlenecks,
+		sccs:              b.SCCs,
 		slack:             b.Slack,
+		communities:       b.Communities,
+		modularity:        b.Modularity,
 		cycles:            b.Cycles,
 		status:            b.Status,
 	}
@@ -833,6 +851,12 @@ func getRobotDiskCachedStats(fullKey string) (*GraphStats, bool) {
 	pruneRobotDiskCacheEntries(now, cf.Entries)
 
 	entry, ok := cf.Entries[fullKey]
+	if ok && entry.Result.EdgeCount > 0 && entry.Result.DependsOn == nil {
+		// Written before adjacency was cached; reachability queries would come
+		// back empty, so recompute and overwrite.
+		delete(cf.Entries, fullKey)
+		ok = false
+	}
 	if !ok {
 		// Best-effort: persist prunes.
 		_ = writeRobotDiskCacheLocked(f, cf)
@@ -864,15 +888,21 @@ func putRobotDiskCachedStats(fullKey, dataHash, configHash string, stats *GraphS
 		NodeCount:        stats.NodeCount,
 		EdgeCount:        stats.EdgeCount,
 		Config:           stats.Config,
+		DependsOn:        stats.dependsOn,
 
 		PageRank:          stats.pageRank,
+		PriorityPageRank:  stats.priorityPageRank,
 		Betweenness:       stats.betweenness,
 		Eigenvector:       stats.eigenvector,
 		Hubs:              stats.hubs,
 		Authorities:       stats.authorities,
 		CriticalPathScore: stats.criticalPathScore,
 		CoreNumber:        stats.coreNumber,
+		Bottlenecks:       stats.bottlenecks,
+		SCCs:              stats.sccs,
 		Slack:             stats.slack,
+		Communities:       stats.communities,
+		Modularity:        stats.modularity,
 		Cycles:            stats.cycles,
 		Status:            stats.status,
 	}
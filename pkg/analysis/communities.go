@@ -0,0 +1,235 @@
+package analysis
+
+import (
+	"sort"
+)
+
+// louvainMaxPasses bounds the local-moving sweeps per Louvain level. Each
+// sweep only accepts moves that raise modularity, so this is a safety net
+// rather than a tuning knob.
+const louvainMaxPasses = 32
+
+// weightedGraph is the undirected, weighted graph Louvain works on. Level 0
+// is the dependency graph with unit weights; each later level collapses the
+// communities found so far into single nodes.
+type weightedGraph struct {
+	adj  [][]weightedEdge // neighbors, excluding self-loops
+	self []float64        // self-loop weight (edges inside a collapsed community)
+	deg  []float64        // weighted degree; a self-loop counts twice
+	m2   float64          // sum of degrees (twice the total edge weight)
+}
+
+type weightedEdge struct {
+	to int
+	w  float64
+}
+
+// computeCommunities partitions the undirected view of the dependency graph
+// with the Louvain method and returns each issue's community along with the
+// partition's modularity. Nodes are visited in issue-ID order and ties keep
+// the current community, so the result is deterministic. Communities are
+// numbered from 0 by decreasing size, then by their smallest issue ID.
+func (a *Analyzer) computeCommunities() (map[string]int, float64) {
+	adj := newUndirectedAdjacency(a.g)
+	n := len(adj.nodes)
+	if n == 0 {
+		return nil, 0
+	}
+
+	// Dense indexes in issue-ID order.
+	order := append([]int64(nil), adj.nodes...)
+	sort.Slice(order, func(i, j int) bool { return a.nodeToID[order[i]] < a.nodeToID[order[j]] })
+	index := make(map[int64]int, n)
+	for i, id := range order {
+		index[id] = i
+	}
+
+	g := weightedGraph{adj: make([][]weightedEdge, n), self: make([]float64, n), deg: make([]float64, n)}
+	for i, id := range order {
+		for _, nb := range adj.neighborsOf(id) {
+			g.adj[i] = append(g.adj[i], weightedEdge{to: index[nb], w: 1})
+		}
+		sort.Slice(g.adj[i], func(x, y int) bool { return g.adj[i][x].to < g.adj[i][y].to })
+		g.deg[i] = float64(len(g.adj[i]))
+		g.m2 += g.deg[i]
+	}
+
+	membership := make([]int, n)
+	for i := range membership {
+		membership[i] = i
+	}
+	if g.m2 > 0 {
+		level := g
+		for {
+			comm, moved := louvainLocalMoves(level)
+			if !moved {
+				break
+			}
+			comm, count := renumberCommunities(comm)
+			for i := range membership {
+				membership[i] = comm[membership[i]]
+			}
+			level = collapseCommunities(level, comm, count)
+		}
+	}
+
+	// Number communities by size, then by smallest member ID. Members are
+	// already in ID order, so the first member seen is the smallest.
+	type community struct{ first, size int }
+	var comms []community
+	seen := make(map[int]int)
+	for i, c := range membership {
+		k, ok := seen[c]
+		if !ok {
+			k = len(comms)
+			seen[c] = k
+			comms = append(comms, community{first: i})
+		}
+		comms[k].size++
+	}
+	ranked := make([]int, len(comms))
+	for i := range ranked {
+		ranked[i] = i
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		ci, cj := comms[ranked[i]], comms[ranked[j]]
+		if ci.size != cj.size {
+			return ci.size > cj.size
+		}
+		return ci.first < cj.first
+	})
+	label := make([]int, len(comms))
+	for rank, k := range ranked {
+		label[k] = rank
+	}
+
+	result := make(map[string]int, n)
+	for i, id := range order {
+		result[a.nodeToID[id]] = label[seen[membership[i]]]
+	}
+	return result, modularity(g, membership)
+}
+
+// louvainLocalMoves repeatedly moves each node to the neighboring community
+// with the largest modularity gain and reports whether any node moved.
+func louvainLocalMoves(g weightedGraph) ([]int, bool) {
+	n := len(g.adj)
+	comm := make([]int, n)
+	tot := make([]float64, n) // sum of degrees per community
+	for i := range comm {
+		comm[i] = i
+		tot[i] = g.deg[i]
+	}
+
+	moved := false
+	links := make(map[int]float64)
+	var candidates []int
+	for pass := 0; pass < louvainMaxPasses; pass++ {
+		changed := false
+		for i := 0; i < n; i++ {
+			own := comm[i]
+			clear(links)
+			candidates = candidates[:0]
+			for _, e := range g.adj[i] {
+				c := comm[e.to]
+				if _, ok := links[c]; !ok {
+					candidates = append(candidates, c)
+				}
+				links[c] += e.w
+			}
+			sort.Ints(candidates)
+
+			// Gain of joining c, up to a constant factor: links into c minus
+			// the links expected between i and c at random.
+			tot[own] -= g.deg[i]
+			gain := func(c int) float64 { return links[c] - tot[c]*g.deg[i]/g.m2 }
+			best, bestGain := own, gain(own)
+			for _, c := range candidates {
+				if gc := gain(c); gc > bestGain+1e-12 {
+					best, bestGain = c, gc
+				}
+			}
+			tot[best] += g.deg[i]
+			if best != own {
+				comm[i] = best
+				changed, moved = true, true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	return comm, moved
+}
+
+// renumberCommunities maps community labels onto 0..count-1 in order of
+// first appearance
+func renumberCommunities(comm []int) ([]int, int) {
+	ids := make(map[int]int)
+	out := make([]int, len(comm))
+	for i, c := range comm {
+		id, ok := ids[c]
+		if !ok {
+			id = len(ids)
+			ids[c] = id
+		}
+		out[i] = id
+	}
+	return out, len(ids)
+}
+
+// collapseCommunities builds the next Louvain level: one node per community,
+// edge weights summed, and internal weight kept as a self-loop.
+func collapseCommunities(g weightedGraph, comm []int, count int) weightedGraph {
+	next := weightedGraph{adj: make([][]weightedEdge, count), self: make([]float64, count), deg: make([]float64, count), m2: g.m2}
+	weights := make([]map[int]float64, count)
+	for c := range weights {
+		weights[c] = make(map[int]float64)
+	}
+	for i := range g.adj {
+		ci := comm[i]
+		next.self[ci] += g.self[i]
+		next.deg[ci] += g.deg[i]
+		for _, e := range g.adj[i] {
+			cj := comm[e.to]
+			if ci == cj {
+				next.self[ci] += e.w / 2 // each internal edge is seen from both ends
+				continue
+			}
+			weights[ci][cj] += e.w
+		}
+	}
+	for c, nbrs := range weights {
+		for to, w := range nbrs {
+			next.adj[c] = append(next.adj[c], weightedEdge{to: to, w: w})
+		}
+		sort.Slice(next.adj[c], func(x, y int) bool { return next.adj[c][x].to < next.adj[c][y].to })
+	}
+	return next
+}
+
+// modularity scores a partition of g: the fraction of edges inside
+// communities minus the fraction expected if edges were placed at random.
+// It ranges from -0.5 to 1; above roughly 0.3 indicates clear structure.
+func modularity(g weightedGraph, membership []int) float64 {
+	if g.m2 == 0 {
+		return 0
+	}
+	internal := make([]float64, len(g.adj))
+	tot := make([]float64, len(g.adj))
+	for i := range g.adj {
+		c := membership[i]
+		tot[c] += g.deg[i]
+		internal[c] += 2 * g.self[i]
+		for _, e := range g.adj[i] {
+			if membership[e.to] == c {
+				internal[c] += e.w
+			}
+		}
+	}
+	q := 0.0
+	for c, t := range tot {
+		q += internal[c]/g.m2 - (t/g.m2)*(t/g.m2)
+	}
+	return q
+}
@@ -0,0 +1,60 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// twoClusters builds two four-issue cliques joined by a single dependency
+// (a4 -> b1), so the graph is connected but clearly splits in two.
+func twoClusters() []model.Issue {
+	blocks := func(id string, on ...string) model.Issue {
+		iss := model.Issue{ID: id, Title: id, Status: model.StatusOpen}
+		for _, d := range on {
+			iss.Dependencies = append(iss.Dependencies, &model.Dependency{IssueID: id, DependsOnID: d, Type: model.DepBlocks})
+		}
+		return iss
+	}
+	return []model.Issue{
+		blocks("a1"), blocks("a2", "a1"), blocks("a3", "a1", "a2"), blocks("a4", "a1", "a2", "a3", "b1"),
+		blocks("b1"), blocks("b2", "b1"), blocks("b3", "b1", "b2"), blocks("b4", "b1", "b2", "b3"),
+		blocks("solo"),
+	}
+}
+
+func TestComputeCommunities(t *testing.T) {
+	stats := NewAnalyzer(twoClusters()).Analyze()
+	comm := stats.Communities()
+	if len(comm) != 9 {
+		t.Fatalf("Communities() = %v, want all 9 issues", comm)
+	}
+	for _, group := range [][]string{{"a1", "a2", "a3", "a4"}, {"b1", "b2", "b3", "b4"}} {
+		for _, id := range group[1:] {
+			if comm[id] != comm[group[0]] {
+				t.Errorf("%s in community %d, want %d with %s", id, comm[id], comm[group[0]], group[0])
+			}
+		}
+	}
+	// Equal sizes fall back to the smallest ID; the singleton comes last.
+	if comm["a1"] != 0 || comm["b1"] != 1 || comm["solo"] != 2 {
+		t.Errorf("community numbering = a:%d b:%d solo:%d, want 0, 1, 2", comm["a1"], comm["b1"], comm["solo"])
+	}
+	if q := stats.Modularity(); q < 0.3 || q > 0.5 {
+		t.Errorf("Modularity() = %.3f, want clear structure (0.3..0.5)", q)
+	}
+	if c, ok := stats.CommunityOf("b3"); !ok || c != 1 {
+		t.Errorf("CommunityOf(b3) = %d, %v", c, ok)
+	}
+
+	// Tracks follow communities: a1 and b1 are both actionable and connected,
+	// yet belong to separate work streams.
+	plan := NewAnalyzer(twoClusters()).GetExecutionPlan()
+	if len(plan.Tracks) != 3 {
+		t.Fatalf("plan tracks = %+v, want a1, b1 and solo apart", plan.Tracks)
+	}
+
+	if st := NewAnalyzer(nil).Analyze(); st.Communities() != nil || st.Modularity() != 0 {
+		t.Errorf("empty graph: communities %v, modularity %v", st.Communities(), st.Modularity())
+	}
+}
@@ -16,6 +16,7 @@ type AnalysisConfig struct {
 	BetweennessSkipReason  string          // Set when skipped, explains why
 	BetweennessMode        BetweennessMode // "exact", "approximate", or "skip"
 	BetweennessSampleSize  int             // Sample size for approximate mode
+	BetweennessSeed        int64           // Pivot sampling seed for approximate mode; 0 uses DefaultBetweennessSeed
 	BetweennessIsApproximate bool          // True if approximation was used (set after computation)
 
 	// PageRank
@@ -45,6 +46,7 @@ type AnalysisConfig struct {
 	ComputeKCore       bool // k-core decomposition
 	ComputeArticulation bool // Articulation points
 	ComputeSlack       bool // Scheduling slack
+	ComputeCommunities bool // Louvain community detection (execution plan tracks)
 }
 
 // DefaultConfig returns the default analysis configuration.
@@ -71,10 +73,20 @@ func DefaultConfig() AnalysisConfig {
 		ComputeKCore:       true,
 		ComputeArticulation: true,
 		ComputeSlack:       true,
+		ComputeCommunities: true,
 	}
 	return ApplyEnvOverrides(cfg)
 }
 
+// ApproxBetweennessNodeThreshold is the node count at which ConfigForSize
+// switches betweenness from exact Brandes to pivot sampling. Override it with
+// BV_BETWEENNESS_APPROX_NODES.
+const ApproxBetweennessNodeThreshold = 500
+
+// DefaultBetweennessSeed seeds pivot sampling when BetweennessSeed is unset,
+// so approximate scores are stable from run to run.
+const DefaultBetweennessSeed int64 = 1
+
 // ConfigForSize returns an appropriate configuration based on graph size.
 // Larger graphs get more aggressive timeouts and may use approximate algorithms.
 //
@@ -83,6 +95,9 @@ func DefaultConfig() AnalysisConfig {
 //   - Medium (100-500 nodes): Exact algorithms with standard timeouts
 //   - Large (500-2000 nodes): Approximate betweenness for sparse graphs, skip for dense
 //   - XL (>2000 nodes): Approximate betweenness, skip cycles and HITS for dense graphs
+//
+// The exact/approximate betweenness cutoff follows ApproxBetweennessNodeThreshold
+// rather than the tiers when BV_BETWEENNESS_APPROX_NODES is set.
 func ConfigForSize(nodeCount, edgeCount int) AnalysisConfig {
 	density := 0.0
 	if nodeCount > 1 {
@@ -114,6 +129,7 @@ func ConfigForSize(nodeCount, edgeCount int) AnalysisConfig {
 			ComputeKCore:       true,
 			ComputeArticulation: true,
 			ComputeSlack:       true,
+			ComputeCommunities: true,
 		}
 
 	case nodeCount < 500:
@@ -139,6 +155,7 @@ func ConfigForSize(nodeCount, edgeCount int) AnalysisConfig {
 			ComputeKCore:       true,
 			ComputeArticulation: true,
 			ComputeSlack:       true,
+			ComputeCommunities: true,
 		}
 
 	case nodeCount < 2000:
@@ -160,6 +177,7 @@ func ConfigForSize(nodeCount, edgeCount int) AnalysisConfig {
 			ComputeKCore:       true,
 			ComputeArticulation: true,
 			ComputeSlack:       true,
+			ComputeCommunities: true,
 		}
 
 		// Use approximate betweenness for large sparse graphs, skip for dense
@@ -196,6 +214,7 @@ func ConfigForSize(nodeCount, edgeCount int) AnalysisConfig {
 			ComputeKCore:       true,
 			ComputeArticulation: true,
 			ComputeSlack:       true,
+			ComputeCommunities: true,
 		}
 
 		// Only compute HITS for very sparse XL graphs
@@ -207,6 +226,18 @@ func ConfigForSize(nodeCount, edgeCount int) AnalysisConfig {
 			cfg.HITSSkipReason = "graph too large and dense"
 		}
 	}
+
+	if threshold, ok := envPositiveInt(EnvBetweennessApproxNodes); ok && cfg.ComputeBetweenness {
+		if nodeCount >= threshold {
+			cfg.BetweennessMode = BetweennessApproximate
+			if cfg.BetweennessSampleSize == 0 {
+				cfg.BetweennessSampleSize = RecommendSampleSize(nodeCount, edgeCount)
+			}
+		} else {
+			cfg.BetweennessMode = BetweennessExact
+			cfg.BetweennessSampleSize = 0
+		}
+	}
 	return ApplyEnvOverrides(cfg)
 }
 
@@ -234,13 +265,14 @@ func FullAnalysisConfig() AnalysisConfig {
 		ComputeKCore:       true,
 		ComputeArticulation: true,
 		ComputeSlack:       true,
+		ComputeCommunities: true,
 	}
 	return ApplyEnvOverrides(cfg)
 }
 
 // TriageConfig returns a minimal config optimized for triage operations.
 // Only computes PageRank and Betweenness which are needed for triage scoring.
-// Skips Eigenvector, HITS, Cycles, k-core, articulation, and slack for 50-200ms savings.
+// Skips Eigenvector, HITS, Cycles, k-core, articulation, slack, and communities for 50-200ms savings.
 // (bv-t1js optimization)
 func TriageConfig() AnalysisConfig {
 	cfg := AnalysisConfig{
@@ -260,6 +292,7 @@ func TriageConfig() AnalysisConfig {
 		ComputeKCore:        false,
 		ComputeArticulation: false,
 		ComputeSlack:        false,
+		ComputeCommunities:  false,
 	}
 	return ApplyEnvOverrides(cfg)
 }
@@ -275,7 +308,8 @@ func (c AnalysisConfig) AllPhase2Disabled() bool {
 		!c.ComputeCriticalPath &&
 		!c.ComputeKCore &&
 		!c.ComputeArticulation &&
-		!c.ComputeSlack
+		!c.ComputeSlack &&
+		!c.ComputeCommunities
 }
 
 // NoPhase2Config returns a config with all Phase 2 metrics disabled.
@@ -292,6 +326,7 @@ func NoPhase2Config() AnalysisConfig {
 		ComputeKCore:        false,
 		ComputeArticulation: false,
 		ComputeSlack:        false,
+		ComputeCommunities:  false,
 	}
 }
 
@@ -338,6 +373,12 @@ const (
 	EnvSkipPhase2 = "BV_SKIP_PHASE2"
 	// EnvPhase2TimeoutSeconds overrides per-metric Phase 2 timeouts when set (>0).
 	EnvPhase2TimeoutSeconds = "BV_PHASE2_TIMEOUT_S"
+	// EnvBetweennessApproxNodes overrides ApproxBetweennessNodeThreshold (>0).
+	EnvBetweennessApproxNodes = "BV_BETWEENNESS_APPROX_NODES"
+	// EnvBetweennessSamples overrides the pivot count of approximate betweenness (>0).
+	EnvBetweennessSamples = "BV_BETWEENNESS_SAMPLES"
+	// EnvBetweennessSeed overrides the pivot sampling seed (>0).
+	EnvBetweennessSeed = "BV_BETWEENNESS_SEED"
 )
 
 // ApplyEnvOverrides applies environment-variable tunables to the analysis config.
@@ -346,6 +387,10 @@ const (
 //   - BV_SKIP_PHASE2=1: skip expensive Phase 2 metrics (PageRank, Betweenness, HITS, Cycles,
 //     Eigenvector, Critical Path). (k-core/articulation/slack remain enabled.)
 //   - BV_PHASE2_TIMEOUT_S=N: override per-metric timeouts to N seconds (must be >0).
+//   - BV_BETWEENNESS_SAMPLES=N: sample N pivots when betweenness is approximate.
+//   - BV_BETWEENNESS_SEED=N: seed pivot sampling with N.
+//   - BV_BETWEENNESS_APPROX_NODES=N: approximate betweenness from N nodes up
+//     (ConfigForSize only, since the other configs don't know the graph size).
 func ApplyEnvOverrides(cfg AnalysisConfig) AnalysisConfig {
 	if envBool(EnvSkipPhase2) {
 		cfg.ComputeBetweenness = false
@@ -381,6 +426,13 @@ func ApplyEnvOverrides(cfg AnalysisConfig) AnalysisConfig {
 		}
 	}
 
+	if samples, ok := envPositiveInt(EnvBetweennessSamples); ok && cfg.BetweennessMode == BetweennessApproximate {
+		cfg.BetweennessSampleSize = samples
+	}
+	if seed, ok := envPositiveInt(EnvBetweennessSeed); ok {
+		cfg.BetweennessSeed = int64(seed)
+	}
+
 	return cfg
 }
 
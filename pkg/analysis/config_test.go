@@ -255,3 +255,34 @@ func TestDefaultConfig_EnvPhase2TimeoutInvalidIgnored(t *testing.T) {
 			EnvPhase2TimeoutSeconds, cfg.BetweennessTimeout, cfg.PageRankTimeout, cfg.HITSTimeout, cfg.CyclesTimeout)
 	}
 }
+
+func TestConfigForSize_EnvBetweennessApproxThreshold(t *testing.T) {
+	t.Setenv(EnvBetweennessApproxNodes, "200")
+
+	cfg := ConfigForSize(300, 600)
+	if cfg.BetweennessMode != BetweennessApproximate || cfg.BetweennessSampleSize != RecommendSampleSize(300, 600) {
+		t.Errorf("Expected approximate betweenness at 300 nodes with threshold 200, got mode=%q sample=%d", cfg.BetweennessMode, cfg.BetweennessSampleSize)
+	}
+
+	t.Setenv(EnvBetweennessApproxNodes, "5000")
+	cfg = ConfigForSize(1000, 2000)
+	if cfg.BetweennessMode != BetweennessExact || cfg.BetweennessSampleSize != 0 {
+		t.Errorf("Expected exact betweenness at 1000 nodes with threshold 5000, got mode=%q sample=%d", cfg.BetweennessMode, cfg.BetweennessSampleSize)
+	}
+}
+
+func TestConfigForSize_EnvBetweennessSamplesAndSeed(t *testing.T) {
+	t.Setenv(EnvBetweennessSamples, "25")
+	t.Setenv(EnvBetweennessSeed, "42")
+
+	cfg := ConfigForSize(1000, 2000)
+	if cfg.BetweennessSampleSize != 25 || cfg.BetweennessSeed != 42 {
+		t.Errorf("Expected sample=25 seed=42, got sample=%d seed=%d", cfg.BetweennessSampleSize, cfg.BetweennessSeed)
+	}
+
+	// Exact mode ignores the sample size
+	cfg = ConfigForSize(50, 100)
+	if cfg.BetweennessSampleSize != 0 {
+		t.Errorf("Expected sample size unset for exact betweenness, got %d", cfg.BetweennessSampleSize)
+	}
+}
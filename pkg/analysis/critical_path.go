@@ -0,0 +1,140 @@
+package analysis
+
+import (
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// CriticalPathOptions configures ComputeCriticalPathWithOptions
+type CriticalPathOptions struct {
+	// UseEstimates weights each issue by its estimated_minutes (the median
+	// estimate when missing) instead of counting every issue as 1.
+	UseEstimates bool
+}
+
+// CriticalPathResult is the longest chain of blocking dependencies among
+// open issues: the work that gates delivery no matter how many people help.
+type CriticalPathResult struct {
+	Path         []string    `json:"path"`                    // issue IDs, first blocker first
+	Length       int         `json:"length"`                  // issues on the path
+	Weighted     bool        `json:"weighted"`                // path chosen by estimated minutes
+	TotalMinutes int         `json:"total_minutes,omitempty"` // sum of estimates along the path (weighted only)
+	BrokenEdges  []CycleEdge `json:"broken_edges,omitempty"`  // dependencies ignored to break cycles
+}
+
+// Contains reports whether id is on the path
+func (r CriticalPathResult) Contains(id string) bool {
+	return r.Index(id) >= 0
+}
+
+// Index returns id's 0-based position on the path, or -1
+func (r CriticalPathResult) Index(id string) int {
+	for i, p := range r.Path {
+		if p == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// ComputeCriticalPath returns the longest chain of blocking dependencies among
+// non-closed issues, counting issues.
+func ComputeCriticalPath(issues []model.Issue) CriticalPathResult {
+	return ComputeCriticalPathWithOptions(issues, CriticalPathOptions{})
+}
+
+// ComputeCriticalPathWithOptions returns the longest chain of blocking dependencies
+// among non-closed issues. Dependencies on closed issues no longer gate
+// anything and are ignored. A longest path is undefined while cycles remain,
+// so the edges suggested by CycleBreakEdges are dropped first and reported.
+// Ties go to the lexically smallest IDs, keeping the result deterministic.
+func ComputeCriticalPathWithOptions(issues []model.Issue, opts CriticalPathOptions) CriticalPathResult {
+	result := CriticalPathResult{Weighted: opts.UseEstimates}
+
+	open := make([]model.Issue, 0, len(issues))
+	for _, iss := range issues {
+		if !isClosedLikeStatus(iss.Status) {
+			open = append(open, iss)
+		}
+	}
+	if len(open) == 0 {
+		return result
+	}
+
+	a := NewAnalyzer(open)
+	result.BrokenEdges = a.CycleBreakEdges()
+	ignored := make(map[[2]int64]bool, len(result.BrokenEdges))
+	for _, e := range result.BrokenEdges {
+		ignored[[2]int64{a.idToNode[e.From], a.idToNode[e.To]}] = true
+	}
+
+	median := computeMedianEstimatedMinutes(issues)
+	weight := func(iss model.Issue) int {
+		if !opts.UseEstimates {
+			return 1
+		}
+		if iss.EstimatedMinutes != nil && *iss.EstimatedMinutes > 0 {
+			return *iss.EstimatedMinutes
+		}
+		return median
+	}
+
+	// Kahn's algorithm over blocker -> dependent, so every issue is settled
+	// after all of its blockers. Edges in a.g run dependent -> blocker.
+	n := len(open)
+	dependents := make([][]int64, n)
+	pending := make([]int, n)
+	for u := int64(0); u < int64(n); u++ {
+		it := a.g.From(u)
+		for it.Next() {
+			v := it.Node().ID()
+			if ignored[[2]int64{u, v}] {
+				continue
+			}
+			dependents[v] = append(dependents[v], u)
+			pending[u]++
+		}
+	}
+
+	best := make([]int, n)
+	prev := make([]int64, n)
+	var queue []int64
+	for u := range pending {
+		prev[u] = -1
+		best[u] = weight(open[u])
+		if pending[u] == 0 {
+			queue = append(queue, int64(u))
+		}
+	}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, u := range dependents[v] {
+			if w := best[v] + weight(open[u]); w > best[u] ||
+				(w == best[u] && prev[u] >= 0 && open[v].ID < open[prev[u]].ID) {
+				best[u], prev[u] = w, v
+			}
+			pending[u]--
+			if pending[u] == 0 {
+				queue = append(queue, u)
+			}
+		}
+	}
+
+	end := int64(0)
+	for u := int64(1); u < int64(n); u++ {
+		if best[u] > best[end] || (best[u] == best[end] && open[u].ID < open[end].ID) {
+			end = u
+		}
+	}
+	for u := end; u >= 0; u = prev[u] {
+		result.Path = append(result.Path, open[u].ID)
+		if opts.UseEstimates {
+			result.TotalMinutes += weight(open[u])
+		}
+	}
+	for i, j := 0, len(result.Path)-1; i < j; i, j = i+1, j-1 {
+		result.Path[i], result.Path[j] = result.Path[j], result.Path[i]
+	}
+	result.Length = len(result.Path)
+	return result
+}
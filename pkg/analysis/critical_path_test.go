@@ -0,0 +1,57 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeCriticalPath(t *testing.T) {
+	minutes := func(m int) *int { return &m }
+	issue := func(id string, status model.Status, est *int, blockers ...string) model.Issue {
+		iss := model.Issue{ID: id, Status: status, EstimatedMinutes: est}
+		for _, b := range blockers {
+			iss.Dependencies = append(iss.Dependencies, &model.Dependency{IssueID: id, DependsOnID: b, Type: model.DepBlocks})
+		}
+		return iss
+	}
+	// done -> A -> B -> C is the longest chain once the closed blocker drops
+	// out; X -> C is shorter by count but carries a big estimate.
+	issues := []model.Issue{
+		issue("done", model.StatusClosed, nil),
+		issue("A", model.StatusOpen, minutes(30), "done"),
+		issue("B", model.StatusInProgress, minutes(30), "A"),
+		issue("C", model.StatusOpen, minutes(30), "B", "X"),
+		issue("X", model.StatusOpen, minutes(600)),
+		issue("lone", model.StatusOpen, nil),
+	}
+
+	got := ComputeCriticalPath(issues)
+	if !reflect.DeepEqual(got.Path, []string{"A", "B", "C"}) || got.Length != 3 || got.Weighted {
+		t.Errorf("ComputeCriticalPath = %+v, want A -> B -> C", got)
+	}
+	if !got.Contains("B") || got.Index("C") != 2 || got.Contains("done") {
+		t.Errorf("Contains/Index disagree with path %v", got.Path)
+	}
+
+	weighted := ComputeCriticalPathWithOptions(issues, CriticalPathOptions{UseEstimates: true})
+	if !reflect.DeepEqual(weighted.Path, []string{"X", "C"}) || weighted.TotalMinutes != 630 {
+		t.Errorf("weighted = %+v, want X -> C totalling 630 minutes", weighted)
+	}
+
+	// A cycle is broken rather than making the path undefined.
+	cyclic := []model.Issue{
+		issue("P", model.StatusOpen, nil, "Q"),
+		issue("Q", model.StatusOpen, nil, "P"),
+		issue("R", model.StatusOpen, nil, "Q"),
+	}
+	res := ComputeCriticalPath(cyclic)
+	if len(res.BrokenEdges) != 1 || res.Length != 3 {
+		t.Errorf("cyclic = %+v, want one broken edge and a 3-issue path", res)
+	}
+
+	if res := ComputeCriticalPath([]model.Issue{issue("done", model.StatusClosed, nil)}); res.Length != 0 || res.Path != nil {
+		t.Errorf("all-closed = %+v, want empty", res)
+	}
+}
@@ -0,0 +1,233 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// CutLineOptions configures release cut-line planning
+type CutLineOptions struct {
+	// TargetID is the release issue; it and everything it transitively
+	// depends on form the cut
+	TargetID string
+
+	// TargetDate is the release date; open work scheduled to finish by this
+	// date is in the cut. When combined with TargetID it only reports whether
+	// the target makes the date.
+	TargetDate time.Time
+
+	// Agents is the number of parallel workers (default 1)
+	Agents int
+
+	// Now anchors the schedule (default time.Now())
+	Now time.Time
+}
+
+// CutLineItem is one open issue placed relative to the cut line
+type CutLineItem struct {
+	ID               string     `json:"id"`
+	Title            string     `json:"title"`
+	Status           string     `json:"status"`
+	Priority         int        `json:"priority"`
+	EstimatedMinutes int        `json:"estimated_minutes"`
+	Start            *time.Time `json:"start,omitempty"`
+	Finish           *time.Time `json:"finish,omitempty"`
+	OnLine           bool       `json:"on_line,omitempty"` // a slip here moves the cut line
+	Reason           string     `json:"reason,omitempty"`  // why the item is after the cut
+}
+
+// CutLinePlan partitions open work into "in the cut" and "after the cut"
+type CutLinePlan struct {
+	TargetID              string        `json:"target_id,omitempty"`
+	TargetDate            *time.Time    `json:"target_date,omitempty"`
+	LineDate              *time.Time    `json:"line_date,omitempty"`  // projected finish of the last in-cut item
+	SlackDays             *float64      `json:"slack_days,omitempty"` // TargetDate - LineDate; negative means slipping
+	Agents                int           `json:"agents"`
+	VelocityMinutesPerDay float64       `json:"velocity_minutes_per_day"`
+	InCut                 []CutLineItem `json:"in_cut"`
+	AfterCut              []CutLineItem `json:"after_cut"`
+	LineIDs               []string      `json:"line_ids"` // chain that determines LineDate, in schedule order
+}
+
+// Cut-line reasons for items left after the cut
+const (
+	CutReasonNotRequired = "not required by target"
+	CutReasonTooLate     = "finishes after target date"
+	CutReasonCycle       = "blocked by dependency cycle"
+)
+
+// ComputeCutLine schedules open issues in dependency order across the given
+// number of agents and splits them at the cut line. Durations come from
+// estimated_minutes (median fallback) over the recent global velocity, the
+// same inputs EstimateETAForIssue uses. Items whose slip would move the line
+// are those on the chain of binding constraints (a blocker or the previous
+// task on the same agent) ending at the last in-cut item.
+func ComputeCutLine(issues []model.Issue, opts CutLineOptions) (CutLinePlan, error) {
+	if opts.TargetID == "" && opts.TargetDate.IsZero() {
+		return CutLinePlan{}, fmt.Errorf("cut line needs a target issue or a target date")
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	agents := opts.Agents
+	if agents <= 0 {
+		agents = 1
+	}
+
+	open := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		if !isClosedLikeStatus(issues[i].Status) {
+			open[issues[i].ID] = &issues[i]
+		}
+	}
+
+	plan := CutLinePlan{TargetID: opts.TargetID, Agents: agents}
+	if !opts.TargetDate.IsZero() {
+		d := opts.TargetDate
+		plan.TargetDate = &d
+	}
+
+	// Scope: the target's open blocker closure, or all open work for a date.
+	scope := make(map[string]bool, len(open))
+	if opts.TargetID != "" {
+		found := false
+		for _, iss := range issues {
+			if iss.ID == opts.TargetID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return CutLinePlan{}, fmt.Errorf("issue %q not found", opts.TargetID)
+		}
+		stack := []string{opts.TargetID}
+		for len(stack) > 0 {
+			id := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			iss, ok := open[id]
+			if !ok || scope[id] {
+				continue
+			}
+			scope[id] = true
+			for _, dep := range iss.Dependencies {
+				if dep != nil && dep.Type.IsBlocking() {
+					stack = append(stack, dep.DependsOnID)
+				}
+			}
+		}
+	} else {
+		for id := range open {
+			scope[id] = true
+		}
+	}
+
+	median := computeMedianEstimatedMinutes(issues)
+	velocity := scheduleVelocity(issues, now, median)
+	plan.VelocityMinutesPerDay = velocity
+
+	sched := listSchedule(open, scope, agents, velocity, median)
+	start, finish, binding := sched.start, sched.finish, sched.binding
+	minutes := func(iss *model.Issue) int {
+		if m, ok := sched.minutes[iss.ID]; ok {
+			return m
+		}
+		if iss.EstimatedMinutes != nil && *iss.EstimatedMinutes > 0 {
+			return *iss.EstimatedMinutes
+		}
+		return median
+	}
+
+	at := func(days float64) *time.Time {
+		t := now.Add(durationDays(days))
+		return &t
+	}
+	item := func(iss *model.Issue) CutLineItem {
+		it := CutLineItem{
+			ID:               iss.ID,
+			Title:            iss.Title,
+			Status:           string(iss.Status),
+			Priority:         iss.Priority,
+			EstimatedMinutes: minutes(iss),
+		}
+		if f, ok := finish[iss.ID]; ok {
+			it.Start = at(start[iss.ID])
+			it.Finish = at(f)
+		}
+		return it
+	}
+
+	lineID := ""
+	for id := range open {
+		it := item(open[id])
+		_, scheduled := finish[id]
+		switch {
+		case !scope[id]:
+			it.Reason = CutReasonNotRequired
+		case !scheduled:
+			it.Reason = CutReasonCycle
+		case opts.TargetID == "" && it.Finish.After(opts.TargetDate):
+			it.Reason = CutReasonTooLate
+		}
+		if it.Reason != "" {
+			plan.AfterCut = append(plan.AfterCut, it)
+			continue
+		}
+		plan.InCut = append(plan.InCut, it)
+		if opts.TargetID == "" && (lineID == "" || finish[id] > finish[lineID] || (finish[id] == finish[lineID] && id < lineID)) {
+			lineID = id
+		}
+	}
+	if opts.TargetID != "" {
+		if _, ok := finish[opts.TargetID]; ok {
+			lineID = opts.TargetID
+		}
+	}
+
+	onLine := make(map[string]bool)
+	for id := lineID; id != "" && !onLine[id]; id = binding[id] {
+		onLine[id] = true
+		plan.LineIDs = append([]string{id}, plan.LineIDs...)
+	}
+	for i := range plan.InCut {
+		plan.InCut[i].OnLine = onLine[plan.InCut[i].ID]
+	}
+	if lineID != "" {
+		plan.LineDate = at(finish[lineID])
+		if plan.TargetDate != nil {
+			slack := plan.TargetDate.Sub(*plan.LineDate).Hours() / 24
+			plan.SlackDays = &slack
+		}
+	}
+
+	sortCutItems(plan.InCut)
+	sortCutItems(plan.AfterCut)
+	if plan.InCut == nil {
+		plan.InCut = []CutLineItem{}
+	}
+	if plan.AfterCut == nil {
+		plan.AfterCut = []CutLineItem{}
+	}
+	return plan, nil
+}
+
+// sortCutItems orders scheduled items by finish, then unscheduled items by
+// priority, breaking ties by ID
+func sortCutItems(items []CutLineItem) {
+	sort.Slice(items, func(a, b int) bool {
+		fa, fb := items[a].Finish, items[b].Finish
+		if (fa == nil) != (fb == nil) {
+			return fa != nil
+		}
+		if fa != nil && !fa.Equal(*fb) {
+			return fa.Before(*fb)
+		}
+		if items[a].Priority != items[b].Priority {
+			return items[a].Priority < items[b].Priority
+		}
+		return items[a].ID < items[b].ID
+	})
+}
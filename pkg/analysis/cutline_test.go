@@ -0,0 +1,114 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func cutIDs(items []CutLineItem) []string {
+	ids := make([]string, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.ID)
+	}
+	return ids
+}
+
+func TestComputeCutLine(t *testing.T) {
+	est60, est120, est240, est480 := 60, 120, 240, 480
+	// release <- api (2d) <- schema (1d)
+	// release <- docs (0.5d)
+	// unrelated polish (1d), closed "old" is ignored
+	issues := []model.Issue{
+		{ID: "release", Status: model.StatusOpen, Priority: 0, EstimatedMinutes: &est60, Dependencies: []*model.Dependency{
+			{IssueID: "release", DependsOnID: "api", Type: model.DepBlocks},
+			{IssueID: "release", DependsOnID: "docs", Type: model.DepBlocks},
+		}},
+		{ID: "api", Status: model.StatusOpen, Priority: 1, EstimatedMinutes: &est480, Dependencies: []*model.Dependency{
+			{IssueID: "api", DependsOnID: "schema", Type: model.DepBlocks},
+		}},
+		{ID: "schema", Status: model.StatusOpen, Priority: 1, EstimatedMinutes: &est240},
+		{ID: "docs", Status: model.StatusOpen, Priority: 2, EstimatedMinutes: &est120},
+		{ID: "polish", Status: model.StatusOpen, Priority: 3, EstimatedMinutes: &est240},
+		{ID: "old", Status: model.StatusClosed, Priority: 1, Dependencies: []*model.Dependency{
+			{IssueID: "old", DependsOnID: "api", Type: model.DepBlocks},
+		}},
+	}
+
+	t.Run("target", func(t *testing.T) {
+		now := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+		plan, err := ComputeCutLine(issues, CutLineOptions{TargetID: "release", Agents: 2, Now: now})
+		if err != nil {
+			t.Fatalf("ComputeCutLine: %v", err)
+		}
+
+		if got := cutIDs(plan.AfterCut); len(got) != 1 || got[0] != "polish" {
+			t.Errorf("after cut = %v, want [polish]", got)
+		}
+		if plan.AfterCut[0].Reason != CutReasonNotRequired {
+			t.Errorf("polish reason = %q", plan.AfterCut[0].Reason)
+		}
+		if len(plan.InCut) != 4 {
+			t.Fatalf("in cut = %v, want 4 items", cutIDs(plan.InCut))
+		}
+
+		// docs runs in parallel with schema->api, so only that chain moves the line
+		want := []string{"schema", "api", "release"}
+		if len(plan.LineIDs) != len(want) {
+			t.Fatalf("line = %v, want %v", plan.LineIDs, want)
+		}
+		for i := range want {
+			if plan.LineIDs[i] != want[i] {
+				t.Fatalf("line = %v, want %v", plan.LineIDs, want)
+			}
+		}
+		for _, it := range plan.InCut {
+			if it.OnLine != (it.ID != "docs") {
+				t.Errorf("%s OnLine = %v", it.ID, it.OnLine)
+			}
+		}
+		if plan.LineDate == nil || !plan.LineDate.After(now) {
+			t.Errorf("expected line date after now, got %v", plan.LineDate)
+		}
+		if plan.SlackDays != nil {
+			t.Error("slack is only reported when a target date is given")
+		}
+	})
+
+	t.Run("date", func(t *testing.T) {
+		now := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+		median := computeMedianEstimatedMinutes(issues)
+		velocity := float64(median) / 5.0
+
+		// One agent: the in-cut chain must finish by the date; pick a date that
+		// leaves room for schema, api and release only.
+		days := float64(60+480+240) / velocity
+		date := now.Add(durationDays(days + 0.01))
+		plan, err := ComputeCutLine(issues, CutLineOptions{TargetDate: date, Now: now})
+		if err != nil {
+			t.Fatalf("ComputeCutLine: %v", err)
+		}
+
+		for _, it := range plan.AfterCut {
+			if it.Reason != CutReasonTooLate {
+				t.Errorf("%s reason = %q, want too late", it.ID, it.Reason)
+			}
+		}
+		if plan.SlackDays == nil || *plan.SlackDays < 0 {
+			t.Errorf("expected non-negative slack, got %v", plan.SlackDays)
+		}
+		if len(plan.LineIDs) == 0 || plan.LineIDs[len(plan.LineIDs)-1] != plan.InCut[len(plan.InCut)-1].ID {
+			t.Errorf("line should end at the last in-cut item: line=%v in=%v", plan.LineIDs, cutIDs(plan.InCut))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := ComputeCutLine(issues, CutLineOptions{}); err == nil {
+			t.Error("expected error without target")
+		}
+		if _, err := ComputeCutLine(issues, CutLineOptions{TargetID: "missing"}); err == nil {
+			t.Error("expected error for unknown target")
+		}
+	})
+}
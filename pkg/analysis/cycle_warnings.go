@@ -57,6 +57,10 @@ func DetectCycleWarnings(issues []model.Issue, config CycleWarningConfig) []Sugg
 		issueMap[issue.ID] = issue.Title
 	}
 
+	// Rank the cycle-breaking set once; each cycle's action removes the least
+	// disruptive of its edges that belongs to the set
+	arcs := analyzer.FeedbackArcSet()
+
 	var suggestions []Suggestion
 
 	for i, cycle := range cycles {
@@ -107,9 +111,14 @@ func DetectCycleWarnings(issues []model.Issue, config CycleWarningConfig) []Sugg
 
 		// Add action command to break the cycle
 		if cycleLen >= 2 {
-			// Suggest removing the last edge in the cycle
+			// Fall back to the closing edge if no ranked edge lies on the cycle
 			from := cycle[cycleLen-1]
 			to := cycle[0]
+			if arc, ok := leastDisruptiveArc(arcs, cycle); ok {
+				from, to = arc.From, arc.To
+				sug = sug.WithMetadata("break_disruption", arc.Disruption).
+					WithMetadata("break_reason", arc.Reason)
+			}
 			sug = sug.WithAction(fmt.Sprintf("bd dep remove %s %s", from, to))
 		}
 
@@ -124,6 +133,21 @@ func DetectCycleWarnings(issues []model.Issue, config CycleWarningConfig) []Sugg
 	return suggestions
 }
 
+// leastDisruptiveArc returns the first of the ranked arcs that lies on cycle,
+// given as a closed path (last element repeats the first)
+func leastDisruptiveArc(arcs []FeedbackArc, cycle []string) (FeedbackArc, bool) {
+	onCycle := make(map[GraphEdge]bool, len(cycle))
+	for j := 0; j+1 < len(cycle); j++ {
+		onCycle[GraphEdge{From: cycle[j], To: cycle[j+1]}] = true
+	}
+	for _, arc := range arcs {
+		if onCycle[GraphEdge{From: arc.From, To: arc.To}] {
+			return arc, true
+		}
+	}
+	return FeedbackArc{}, false
+}
+
 // formatCyclePath creates a readable cycle path string
 func formatCyclePath(cycle []string) string {
 	if len(cycle) == 0 {
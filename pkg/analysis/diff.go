@@ -322,6 +322,15 @@ func detectChanges(from, to model.Issue) []FieldChange {
 		})
 	}
 
+	// Comment bodies are not diffed; a count change is enough to flag activity
+	if len(from.Comments) != len(to.Comments) {
+		changes = append(changes, FieldChange{
+			Field:    "comments",
+			OldValue: fmt.Sprintf("%d", len(from.Comments)),
+			NewValue: fmt.Sprintf("%d", len(to.Comments)),
+		})
+	}
+
 	// Check for label changes
 	fromLabels := stringSet(from.Labels)
 	toLabels := stringSet(to.Labels)
@@ -0,0 +1,99 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// IssueTrend marks recent movement of a single issue between two snapshots
+type IssueTrend string
+
+const (
+	// TrendUnblocked means the issue had an open blocker before and has none now
+	TrendUnblocked IssueTrend = "unblocked"
+	// TrendCommented means the issue gained comments
+	TrendCommented IssueTrend = "commented"
+	// TrendPriorityRaised means the priority became more urgent (lower number)
+	TrendPriorityRaised IssueTrend = "priority_raised"
+)
+
+// IssueTrends derives per-issue movement markers from the diff. current is the
+// "to" side of the diff and is used to resolve blocker status; markers are
+// only reported for issues that are still open in current.
+func (d *SnapshotDiff) IssueTrends(current []model.Issue) map[string][]IssueTrend {
+	trends := make(map[string][]IssueTrend)
+	if d == nil {
+		return trends
+	}
+
+	byID := make(map[string]*model.Issue, len(current))
+	for i := range current {
+		byID[current[i].ID] = &current[i]
+	}
+	add := func(id string, t IssueTrend) {
+		iss, ok := byID[id]
+		if !ok || isClosedLikeStatus(iss.Status) {
+			return
+		}
+		for _, existing := range trends[id] {
+			if existing == t {
+				return
+			}
+		}
+		trends[id] = append(trends[id], t)
+	}
+
+	for _, mod := range d.ModifiedIssues {
+		if mod.NewIssue.Priority < mod.OldIssue.Priority {
+			add(mod.IssueID, TrendPriorityRaised)
+		}
+		if len(mod.NewIssue.Comments) > len(mod.OldIssue.Comments) {
+			add(mod.IssueID, TrendCommented)
+		}
+		if mod.OldIssue.Status == model.StatusBlocked && mod.NewIssue.Status != model.StatusBlocked &&
+			!hasOpenBlocker(mod.NewIssue, byID) {
+			add(mod.IssueID, TrendUnblocked)
+		}
+	}
+
+	// An issue whose last open blocker was closed in this window is unblocked
+	// even if its own record did not change.
+	closed := make(map[string]bool, len(d.ClosedIssues))
+	for _, iss := range d.ClosedIssues {
+		closed[iss.ID] = true
+	}
+	if len(closed) > 0 {
+		for _, iss := range current {
+			if hasOpenBlocker(iss, byID) {
+				continue
+			}
+			for _, dep := range iss.Dependencies {
+				if dep != nil && dep.Type.IsBlocking() && closed[dep.DependsOnID] {
+					add(iss.ID, TrendUnblocked)
+					break
+				}
+			}
+		}
+	}
+
+	for id := range trends {
+		sort.Slice(trends[id], func(a, b int) bool {
+			return trends[id][a] < trends[id][b]
+		})
+	}
+	return trends
+}
+
+// hasOpenBlocker reports whether any blocking dependency of iss is still open
+func hasOpenBlocker(iss model.Issue, byID map[string]*model.Issue) bool {
+	for _, dep := range iss.Dependencies {
+		if dep == nil || !dep.Type.IsBlocking() {
+			continue
+		}
+		if blocker, ok := byID[dep.DependsOnID]; ok && !isClosedLikeStatus(blocker.Status) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestIssueTrends(t *testing.T) {
+	blocks := func(from, to string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: from, DependsOnID: to, Type: model.DepBlocks}}
+	}
+	before := []model.Issue{
+		{ID: "blocker", Status: model.StatusOpen, Priority: 1},
+		{ID: "waiting", Status: model.StatusOpen, Priority: 2, Dependencies: blocks("waiting", "blocker")},
+		{ID: "flagged", Status: model.StatusBlocked, Priority: 3},
+		{ID: "chatty", Status: model.StatusOpen, Priority: 2},
+		{ID: "lowered", Status: model.StatusOpen, Priority: 1},
+		{ID: "done", Status: model.StatusOpen, Priority: 3},
+	}
+	after := []model.Issue{
+		{ID: "blocker", Status: model.StatusClosed, Priority: 1},
+		{ID: "waiting", Status: model.StatusOpen, Priority: 2, Dependencies: blocks("waiting", "blocker")},
+		{ID: "flagged", Status: model.StatusOpen, Priority: 1},
+		{ID: "chatty", Status: model.StatusOpen, Priority: 2, Comments: []*model.Comment{{ID: 1}}},
+		{ID: "lowered", Status: model.StatusOpen, Priority: 3},
+		{ID: "done", Status: model.StatusClosed, Priority: 0},
+	}
+
+	diff := CompareSnapshots(NewSnapshot(before), NewSnapshot(after))
+	got := diff.IssueTrends(after)
+
+	want := map[string][]IssueTrend{
+		"waiting": {TrendUnblocked},
+		"flagged": {TrendPriorityRaised, TrendUnblocked},
+		"chatty":  {TrendCommented},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IssueTrends() = %v, want %v", got, want)
+	}
+}
+
+func TestIssueTrends_NilDiff(t *testing.T) {
+	var diff *SnapshotDiff
+	if got := diff.IssueTrends(nil); len(got) != 0 {
+		t.Errorf("expected no trends for nil diff, got %v", got)
+	}
+}
+
+func TestDetectChanges_Comments(t *testing.T) {
+	from := model.Issue{ID: "A"}
+	to := model.Issue{ID: "A", Comments: []*model.Comment{{ID: 1}, {ID: 2}}}
+	changes := detectChanges(from, to)
+	if len(changes) != 1 || changes[0].Field != "comments" || changes[0].NewValue != "2" {
+		t.Errorf("expected comments change, got %+v", changes)
+	}
+}
@@ -0,0 +1,106 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+)
+
+// FeedbackArc is one dependency of the suggested cycle-breaking set, scored
+// by how much removing it would disturb the plan. Since the set is minimal,
+// the remaining graph always orders From before To once it is removed.
+type FeedbackArc struct {
+	CycleEdge
+	// Disruption runs from 0 (only the cycle changes) to 1
+	Disruption float64 `json:"disruption"`
+	// Settled: From or To is already closed, so removing the edge changes
+	// what is ready to work on for nobody
+	Settled bool `json:"settled"`
+	// PriorityAgrees: From is at least as urgent as To, so doing From first
+	// matches the priorities already set
+	PriorityAgrees bool `json:"priority_agrees"`
+	// Downstream counts the issues that wait on From, directly or not, in
+	// the graph with the whole set removed
+	Downstream int    `json:"downstream"`
+	Reason     string `json:"reason"`
+}
+
+// Disruption weights; an open edge matters most, since dropping it changes
+// what can be started today
+const (
+	fasOpenWeight       = 0.4
+	fasPriorityWeight   = 0.3
+	fasDownstreamWeight = 0.3
+)
+
+// FeedbackArcSet returns the dependencies suggested by CycleBreakEdges, whose
+// removal makes the graph acyclic, ranked least disruptive first: edges with a
+// closed end, whose dependent is at least as urgent as its blocker, and whose
+// dependent little else waits on come first. Ties go by From, then To.
+// Returns nil when the graph has no cycles.
+func (a *Analyzer) FeedbackArcSet() []FeedbackArc {
+	edges := a.CycleBreakEdges()
+	if len(edges) == 0 {
+		return nil
+	}
+
+	removed := make(map[GraphEdge]bool, len(edges))
+	for _, e := range edges {
+		removed[GraphEdge{From: e.From, To: e.To}] = true
+	}
+	dependents := make(map[string][]string)
+	it := a.g.Edges()
+	for it.Next() {
+		e := it.Edge()
+		from, to := a.nodeToID[e.From().ID()], a.nodeToID[e.To().ID()]
+		if !removed[GraphEdge{From: from, To: to}] {
+			dependents[to] = append(dependents[to], from)
+		}
+	}
+	for _, deps := range dependents {
+		sort.Strings(deps)
+	}
+
+	others := float64(len(a.issueMap) - 1)
+	out := make([]FeedbackArc, 0, len(edges))
+	for _, e := range edges {
+		arc := FeedbackArc{CycleEdge: e}
+		from, to := a.issueMap[e.From], a.issueMap[e.To]
+		arc.Settled = isClosedLikeStatus(from.Status) || isClosedLikeStatus(to.Status)
+		arc.PriorityAgrees = from.Priority <= to.Priority
+		arc.Downstream = len(walkAdjacency(dependents, e.From))
+
+		var reasons []string
+		if arc.Settled {
+			reasons = append(reasons, "one end is already closed")
+		} else {
+			arc.Disruption += fasOpenWeight
+		}
+		if arc.PriorityAgrees {
+			reasons = append(reasons, e.From+" is at least as urgent as "+e.To)
+		} else {
+			arc.Disruption += fasPriorityWeight
+		}
+		if others > 0 {
+			arc.Disruption += fasDownstreamWeight * float64(arc.Downstream) / others
+		}
+		if arc.Downstream == 0 {
+			reasons = append(reasons, "nothing else waits on "+e.From)
+		}
+		if len(reasons) == 0 {
+			reasons = append(reasons, "lets "+e.From+" start before "+e.To)
+		}
+		arc.Reason = strings.Join(reasons, "; ")
+		out = append(out, arc)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Disruption != out[j].Disruption {
+			return out[i].Disruption < out[j].Disruption
+		}
+		if out[i].From != out[j].From {
+			return out[i].From < out[j].From
+		}
+		return out[i].To < out[j].To
+	})
+	return out
+}
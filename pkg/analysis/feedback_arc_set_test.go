@@ -0,0 +1,60 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+)
+
+func TestFeedbackArcSet(t *testing.T) {
+	blocks := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	// Two independent two-issue cycles. Q is closed, so breaking P↔Q
+	// changes nothing for open work; X↔Y are both open.
+	issues := []model.Issue{
+		{ID: "P", Status: model.StatusOpen, Priority: 2, Dependencies: blocks("Q")},
+		{ID: "Q", Status: model.StatusClosed, Priority: 2, Dependencies: blocks("P")},
+		{ID: "X", Status: model.StatusOpen, Priority: 0, Dependencies: blocks("Y")},
+		{ID: "Y", Status: model.StatusOpen, Priority: 1, Dependencies: blocks("X")},
+	}
+	arcs := NewAnalyzer(issues).FeedbackArcSet()
+	if len(arcs) != 2 {
+		t.Fatalf("expected one edge per cycle, got %+v", arcs)
+	}
+
+	first, second := arcs[0], arcs[1]
+	if first.From != "P" || first.To != "Q" || !first.Settled || !first.PriorityAgrees || first.Downstream != 1 {
+		t.Errorf("expected settled P→Q first, got %+v", first)
+	}
+	if math.Abs(first.Disruption-0.1) > 1e-9 {
+		t.Errorf("expected disruption 0.1 for P→Q, got %f", first.Disruption)
+	}
+	if second.From != "X" || second.To != "Y" || second.Settled || !second.PriorityAgrees {
+		t.Errorf("expected open X→Y second, got %+v", second)
+	}
+	if second.Disruption <= first.Disruption {
+		t.Errorf("expected X→Y to be more disruptive, got %f <= %f", second.Disruption, first.Disruption)
+	}
+	if first.Reason == "" || second.Reason == "" {
+		t.Error("expected every arc to explain itself")
+	}
+
+	suggestions := DetectCycleWarnings(issues, DefaultCycleWarningConfig())
+	for _, sug := range suggestions {
+		if sug.TargetBead == "P" || sug.TargetBead == "Q" {
+			if sug.ActionCommand != "bd dep remove P Q" {
+				t.Errorf("expected the ranked edge as action, got %q", sug.ActionCommand)
+			}
+			if _, ok := sug.Metadata["break_disruption"]; !ok {
+				t.Errorf("expected break_disruption metadata, got %v", sug.Metadata)
+			}
+		}
+	}
+
+	if arcs := NewAnalyzer(testutil.QuickChain(4)).FeedbackArcSet(); arcs != nil {
+		t.Errorf("expected nil for an acyclic graph, got %+v", arcs)
+	}
+}
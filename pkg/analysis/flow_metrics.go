@@ -0,0 +1,169 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// IssueFlow is how long one closed issue took
+type IssueFlow struct {
+	ID            string   `json:"id"`
+	LeadTimeDays  float64  `json:"lead_time_days"`            // created -> closed
+	CycleTimeDays *float64 `json:"cycle_time_days,omitempty"` // in_progress -> closed; nil when the start is unknown
+	LoggedHours   *float64 `json:"logged_hours,omitempty"`    // work logged with the timer; nil when none was
+}
+
+// FlowStats summarizes durations, in days for lead and cycle time and in
+// hours for logged work. Percentiles are nearest-rank.
+type FlowStats struct {
+	Count int     `json:"count"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P85   float64 `json:"p85"`
+	P95   float64 `json:"p95"`
+}
+
+// FlowGroup is the lead and cycle time and logged work of the closed issues
+// sharing a label, type or assignee
+type FlowGroup struct {
+	Key       string    `json:"key"`
+	LeadTime  FlowStats `json:"lead_time"`
+	CycleTime FlowStats `json:"cycle_time"`
+	Logged    FlowStats `json:"logged_hours"`
+}
+
+// FlowMetrics reports lead time (created -> closed) and cycle time
+// (in_progress -> closed) over closed issues, overall and grouped. Groups are
+// sorted by key; issues without a label or assignee are left out of those
+// groupings rather than pooled under an empty key.
+type FlowMetrics struct {
+	Issues     []IssueFlow `json:"issues"` // sorted by ID
+	LeadTime   FlowStats   `json:"lead_time"`
+	CycleTime  FlowStats   `json:"cycle_time"`
+	Logged     FlowStats   `json:"logged_hours"`
+	ByLabel    []FlowGroup `json:"by_label"`
+	ByType     []FlowGroup `json:"by_type"`
+	ByAssignee []FlowGroup `json:"by_assignee"`
+}
+
+// ComputeFlowMetrics measures lead and cycle time for every closed issue.
+// Cycle time needs to know when work started, which the issue itself doesn't
+// record: it comes from the claim (status -> in_progress) in history, or
+// failing that from the first time entry logged on the issue, and is left
+// out for issues with neither. history may be nil. Logged work is the sum
+// of the issue's finished time entries. Issues closed before they were
+// created (clock skew, imports) are skipped.
+func ComputeFlowMetrics(issues []model.Issue, history *correlation.HistoryReport) FlowMetrics {
+	type sample struct {
+		lead   float64
+		cycle  *float64
+		logged *float64
+	}
+	var flows []IssueFlow
+	byLabel := make(map[string][]sample)
+	byType := make(map[string][]sample)
+	byAssignee := make(map[string][]sample)
+	var all []sample
+
+	for _, iss := range issues {
+		closed, ok := closedTime(iss)
+		if !ok || iss.CreatedAt.IsZero() || closed.Before(iss.CreatedAt) {
+			continue
+		}
+		s := sample{lead: closed.Sub(iss.CreatedAt).Hours() / 24}
+		var start *time.Time
+		if history != nil {
+			if h, ok := history.Histories[iss.ID]; ok && h.Milestones.Claimed != nil {
+				start = &h.Milestones.Claimed.Timestamp
+			}
+		}
+		if start == nil {
+			for _, e := range iss.TimeEntries {
+				if start == nil || e.Start.Before(*start) {
+					start = &e.Start
+				}
+			}
+		}
+		if start != nil && !start.IsZero() && !closed.Before(*start) {
+			days := closed.Sub(*start).Hours() / 24
+			s.cycle = &days
+		}
+		if logged := iss.LoggedTime(); logged > 0 {
+			hours := logged.Hours()
+			s.logged = &hours
+		}
+
+		flows = append(flows, IssueFlow{ID: iss.ID, LeadTimeDays: s.lead, CycleTimeDays: s.cycle, LoggedHours: s.logged})
+		all = append(all, s)
+		for _, label := range uniqueStrings(iss.Labels) {
+			if label != "" {
+				byLabel[label] = append(byLabel[label], s)
+			}
+		}
+		if iss.IssueType != "" {
+			byType[string(iss.IssueType)] = append(byType[string(iss.IssueType)], s)
+		}
+		if iss.Assignee != "" {
+			byAssignee[iss.Assignee] = append(byAssignee[iss.Assignee], s)
+		}
+	}
+	sort.Slice(flows, func(i, j int) bool { return flows[i].ID < flows[j].ID })
+
+	summarize := func(samples []sample) (lead, cycle, logged FlowStats) {
+		var leads, cycles, loggeds []float64
+		for _, s := range samples {
+			leads = append(leads, s.lead)
+			if s.cycle != nil {
+				cycles = append(cycles, *s.cycle)
+			}
+			if s.logged != nil {
+				loggeds = append(loggeds, *s.logged)
+			}
+		}
+		return flowStats(leads), flowStats(cycles), flowStats(loggeds)
+	}
+	groups := func(m map[string][]sample) []FlowGroup {
+		out := make([]FlowGroup, 0, len(m))
+		for key, samples := range m {
+			g := FlowGroup{Key: key}
+			g.LeadTime, g.CycleTime, g.Logged = summarize(samples)
+			out = append(out, g)
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+		return out
+	}
+
+	fm := FlowMetrics{
+		Issues:     flows,
+		ByLabel:    groups(byLabel),
+		ByType:     groups(byType),
+		ByAssignee: groups(byAssignee),
+	}
+	if fm.Issues == nil {
+		fm.Issues = []IssueFlow{}
+	}
+	fm.LeadTime, fm.CycleTime, fm.Logged = summarize(all)
+	return fm
+}
+
+// flowStats summarizes durations; values is reordered
+func flowStats(values []float64) FlowStats {
+	if len(values) == 0 {
+		return FlowStats{}
+	}
+	sort.Float64s(values)
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return FlowStats{
+		Count: len(values),
+		Mean:  sum / float64(len(values)),
+		P50:   percentileFloat(values, 0.50),
+		P85:   percentileFloat(values, 0.85),
+		P95:   percentileFloat(values, 0.95),
+	}
+}
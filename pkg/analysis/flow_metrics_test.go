@@ -0,0 +1,110 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeFlowMetrics(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	closedAt := func(d int) *time.Time {
+		c := base.Add(time.Duration(d) * day)
+		return &c
+	}
+
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusClosed, IssueType: model.TypeBug, Labels: []string{"api", "api"}, Assignee: "ann", CreatedAt: base, ClosedAt: closedAt(2)},
+		{ID: "B", Status: model.StatusClosed, IssueType: model.TypeBug, Labels: []string{"api"}, CreatedAt: base, ClosedAt: closedAt(4)},
+		{ID: "C", Status: model.StatusClosed, IssueType: model.TypeTask, Assignee: "ann", CreatedAt: base, ClosedAt: closedAt(10)},
+		{ID: "D", Status: model.StatusOpen, IssueType: model.TypeTask, CreatedAt: base},
+		// Closed before created: skipped
+		{ID: "E", Status: model.StatusClosed, IssueType: model.TypeTask, CreatedAt: base, ClosedAt: closedAt(-1)},
+	}
+	history := &correlation.HistoryReport{Histories: map[string]correlation.BeadHistory{
+		"A": {Milestones: correlation.BeadMilestones{Claimed: &correlation.BeadEvent{Timestamp: base.Add(day)}}},
+		"C": {Milestones: correlation.BeadMilestones{Claimed: &correlation.BeadEvent{Timestamp: base.Add(7 * day)}}},
+	}}
+
+	fm := ComputeFlowMetrics(issues, history)
+
+	if len(fm.Issues) != 3 || fm.Issues[0].ID != "A" || fm.Issues[2].ID != "C" {
+		t.Fatalf("expected flows for A, B, C; got %+v", fm.Issues)
+	}
+	if fm.Issues[0].LeadTimeDays != 2 || fm.Issues[0].CycleTimeDays == nil || *fm.Issues[0].CycleTimeDays != 1 {
+		t.Errorf("A: expected lead 2 cycle 1, got %+v", fm.Issues[0])
+	}
+	if fm.Issues[1].CycleTimeDays != nil {
+		t.Errorf("B has no history, expected no cycle time, got %v", *fm.Issues[1].CycleTimeDays)
+	}
+
+	if fm.LeadTime.Count != 3 || fm.LeadTime.P50 != 4 || fm.LeadTime.P95 != 10 {
+		t.Errorf("unexpected lead time stats %+v", fm.LeadTime)
+	}
+	if fm.CycleTime.Count != 2 || fm.CycleTime.Mean != 2 {
+		t.Errorf("unexpected cycle time stats %+v", fm.CycleTime)
+	}
+
+	if len(fm.ByLabel) != 1 || fm.ByLabel[0].Key != "api" || fm.ByLabel[0].LeadTime.Count != 2 {
+		t.Errorf("expected one api group with two issues, got %+v", fm.ByLabel)
+	}
+	if len(fm.ByType) != 2 || fm.ByType[0].Key != "bug" || fm.ByType[1].Key != "task" {
+		t.Errorf("expected bug and task groups, got %+v", fm.ByType)
+	}
+	if len(fm.ByAssignee) != 1 || fm.ByAssignee[0].Key != "ann" || fm.ByAssignee[0].CycleTime.Count != 2 {
+		t.Errorf("expected ann group with two cycle times, got %+v", fm.ByAssignee)
+	}
+
+	// Without history only lead time is available
+	if noHist := ComputeFlowMetrics(issues, nil); noHist.CycleTime.Count != 0 || noHist.LeadTime.Count != 3 {
+		t.Errorf("expected lead time only without history, got %+v / %+v", noHist.LeadTime, noHist.CycleTime)
+	}
+}
+
+func TestComputeFlowMetrics_TimeEntries(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	closed := base.Add(5 * 24 * time.Hour)
+	at := func(h int) *time.Time {
+		ts := base.Add(time.Duration(h) * time.Hour)
+		return &ts
+	}
+
+	issues := []model.Issue{
+		// No claim in history: cycle time starts at the first entry
+		{ID: "A", Status: model.StatusClosed, CreatedAt: base, ClosedAt: &closed, TimeEntries: []model.TimeEntry{
+			{Start: *at(72), End: at(74)},
+			{Start: *at(48), End: at(49)},
+		}},
+		// A claim in history wins over the entries
+		{ID: "B", Status: model.StatusClosed, CreatedAt: base, ClosedAt: &closed, TimeEntries: []model.TimeEntry{
+			{Start: *at(96), End: at(99)},
+			{Start: *at(100)}, // still running: not logged
+		}},
+		{ID: "C", Status: model.StatusClosed, CreatedAt: base, ClosedAt: &closed},
+	}
+	history := &correlation.HistoryReport{Histories: map[string]correlation.BeadHistory{
+		"B": {Milestones: correlation.BeadMilestones{Claimed: &correlation.BeadEvent{Timestamp: *at(24)}}},
+	}}
+
+	fm := ComputeFlowMetrics(issues, history)
+
+	a := fm.Issues[0]
+	if a.CycleTimeDays == nil || *a.CycleTimeDays != 3 {
+		t.Errorf("A: expected cycle time of 3 days from the first entry, got %v", a.CycleTimeDays)
+	}
+	if a.LoggedHours == nil || *a.LoggedHours != 3 {
+		t.Errorf("A: expected 3 logged hours, got %v", a.LoggedHours)
+	}
+	if b := fm.Issues[1]; b.CycleTimeDays == nil || *b.CycleTimeDays != 4 || b.LoggedHours == nil || *b.LoggedHours != 3 {
+		t.Errorf("B: expected cycle 4 days from the claim and 3 logged hours, got %+v", b)
+	}
+	if c := fm.Issues[2]; c.CycleTimeDays != nil || c.LoggedHours != nil {
+		t.Errorf("C: expected no cycle time or logged work, got %+v", c)
+	}
+	if fm.Logged.Count != 2 || fm.Logged.Mean != 3 {
+		t.Errorf("unexpected logged stats %+v", fm.Logged)
+	}
+}
@@ -0,0 +1,185 @@
+package analysis
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ForecastOptions configures Forecast
+type ForecastOptions struct {
+	// Milestones are the issues to forecast, scoped as in MonteCarloOptions.
+	// Empty means every open epic.
+	Milestones []string
+
+	// Confidence is the share of trials inside each interval (default 0.8,
+	// i.e. the 10th to 90th percentile)
+	Confidence float64
+
+	// HistoryDays is the throughput sampling window (default 30)
+	HistoryDays int
+
+	// Trials is the number of simulated futures (default 1000)
+	Trials int
+
+	// Seed makes the forecast reproducible (default 1)
+	Seed int64
+
+	// Now anchors the forecast (default time.Now())
+	Now time.Time
+}
+
+// MilestoneCompletion is one milestone's forecast completion date with its
+// confidence interval. Dates are nil when too few trials finish within the
+// forecast horizon.
+type MilestoneCompletion struct {
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	Remaining int        `json:"remaining"`          // open issues in its scope, itself included
+	Expected  *time.Time `json:"expected,omitempty"` // median
+	Earliest  *time.Time `json:"earliest,omitempty"` // lower bound of the interval
+	Latest    *time.Time `json:"latest,omitempty"`   // upper bound of the interval
+	Reason    string     `json:"reason,omitempty"`
+}
+
+// CompletionForecast holds throughput-based completion dates per milestone
+type CompletionForecast struct {
+	Now             time.Time             `json:"now"`
+	Trials          int                   `json:"trials"`
+	Confidence      float64               `json:"confidence"`
+	HistoryDays     int                   `json:"history_days"`
+	DailyThroughput float64               `json:"daily_throughput"` // mean closes per day in the window
+	Milestones      []MilestoneCompletion `json:"milestones"`
+}
+
+// Forecast predicts when each milestone completes by resampling the
+// project's daily close counts over the last HistoryDays, like the burn-up
+// forecast. Only item counts matter, so it needs no estimates, but it also
+// ignores dependency order and treats each milestone as if it had the team's
+// full throughput; ComputeMonteCarloForecast schedules the actual work.
+func Forecast(issues []model.Issue, opts ForecastOptions) (CompletionForecast, error) {
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	confidence := opts.Confidence
+	if confidence <= 0 || confidence >= 1 {
+		confidence = 0.8
+	}
+	window := opts.HistoryDays
+	if window <= 0 {
+		window = 30
+	}
+	trials := opts.Trials
+	if trials <= 0 {
+		trials = 1000
+	}
+	seed := opts.Seed
+	if seed == 0 {
+		seed = 1
+	}
+
+	byID := make(map[string]*model.Issue, len(issues))
+	children := make(map[string][]string)
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+		for _, dep := range issues[i].Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				children[dep.DependsOnID] = append(children[dep.DependsOnID], issues[i].ID)
+			}
+		}
+	}
+	milestones := opts.Milestones
+	if len(milestones) == 0 {
+		for id, iss := range byID {
+			if iss.IssueType == model.TypeEpic && !isClosedLikeStatus(iss.Status) {
+				milestones = append(milestones, id)
+			}
+		}
+		sort.Strings(milestones)
+	}
+	for _, id := range milestones {
+		if _, ok := byID[id]; !ok {
+			return CompletionForecast{}, fmt.Errorf("milestone %q not found", id)
+		}
+	}
+
+	// Throughput samples: closes per day over the window, across the project.
+	today := startOfDay(now)
+	samples := make([]int, window)
+	since := today.AddDate(0, 0, -window+1)
+	total := 0
+	for _, iss := range issues {
+		closed, ok := closedTime(iss)
+		if !ok || closed.Before(since) || !closed.Before(today.AddDate(0, 0, 1)) {
+			continue
+		}
+		samples[int(startOfDay(closed).Sub(since).Hours()/24+0.5)]++
+		total++
+	}
+
+	out := CompletionForecast{
+		Now:             now,
+		Trials:          trials,
+		Confidence:      confidence,
+		HistoryDays:     window,
+		DailyThroughput: float64(total) / float64(window),
+		Milestones:      make([]MilestoneCompletion, len(milestones)),
+	}
+	most := 0
+	for m, id := range milestones {
+		out.Milestones[m] = MilestoneCompletion{
+			ID:        id,
+			Title:     byID[id].Title,
+			Remaining: len(milestoneScope(byID, children, id)),
+		}
+		most = max(most, out.Milestones[m].Remaining)
+	}
+
+	// finish[m][t] is the day trial t closed milestone m's last item, or -1
+	// past the horizon. One cumulative run per trial serves every milestone,
+	// so bigger milestones never finish before smaller ones.
+	finish := make([][]int, len(milestones))
+	for m := range finish {
+		finish[m] = make([]int, trials)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	for t := 0; t < trials; t++ {
+		for m := range milestones {
+			finish[m][t] = -1
+			if out.Milestones[m].Remaining == 0 {
+				finish[m][t] = 0
+			}
+		}
+		if total == 0 {
+			continue
+		}
+		done := 0
+		for day := 1; day <= burnUpMaxHorizonDays && done < most; day++ {
+			done += samples[rng.Intn(window)]
+			for m := range milestones {
+				if finish[m][t] < 0 && done >= out.Milestones[m].Remaining {
+					finish[m][t] = day
+				}
+			}
+		}
+	}
+
+	tail := (1 - confidence) / 2
+	for m := range out.Milestones {
+		f := &out.Milestones[m]
+		f.Expected = finishQuantile(finish[m], 0.5, today)
+		f.Earliest = finishQuantile(finish[m], tail, today)
+		f.Latest = finishQuantile(finish[m], 1-tail, today)
+		switch {
+		case f.Remaining > 0 && total == 0:
+			f.Reason = fmt.Sprintf("no issues closed in the last %d days", window)
+		case f.Latest == nil:
+			f.Reason = "beyond the one-year forecast horizon"
+		}
+	}
+	return out, nil
+}
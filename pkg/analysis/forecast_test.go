@@ -0,0 +1,71 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestForecast(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	today := startOfDay(now)
+	dep := func(from, to string, typ model.DependencyType) []*model.Dependency {
+		return []*model.Dependency{{IssueID: from, DependsOnID: to, Type: typ}}
+	}
+	issues := []model.Issue{
+		{ID: "epic", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "child", Status: model.StatusOpen, Dependencies: dep("child", "epic", model.DepParentChild)},
+		{ID: "base", Status: model.StatusOpen},
+		{ID: "later", Status: model.StatusOpen, Dependencies: dep("later", "base", model.DepBlocks)},
+		{ID: "done-epic", Status: model.StatusClosed, IssueType: model.TypeEpic},
+	}
+	// Exactly one close per day over the window makes every trial identical.
+	for d := 0; d < 30; d++ {
+		closed := today.AddDate(0, 0, -d).Add(time.Hour)
+		issues = append(issues, model.Issue{ID: "c" + string(rune('a'+d)), Status: model.StatusClosed,
+			CreatedAt: closed.AddDate(0, 0, -1), ClosedAt: &closed})
+	}
+
+	got, err := Forecast(issues, ForecastOptions{Trials: 50, Now: now})
+	if err != nil {
+		t.Fatalf("Forecast: %v", err)
+	}
+	if len(got.Milestones) != 1 || got.Milestones[0].ID != "epic" || got.Milestones[0].Remaining != 2 {
+		t.Fatalf("milestones = %+v, want the open epic with its child", got.Milestones)
+	}
+	if got.DailyThroughput != 1 || got.Confidence != 0.8 {
+		t.Errorf("throughput %.2f, confidence %.2f; want 1 and the 0.8 default", got.DailyThroughput, got.Confidence)
+	}
+	want := today.AddDate(0, 0, 2)
+	f := got.Milestones[0]
+	for name, d := range map[string]*time.Time{"expected": f.Expected, "earliest": f.Earliest, "latest": f.Latest} {
+		if d == nil || !d.Equal(want) {
+			t.Errorf("%s = %v, want %v", name, d, want)
+		}
+	}
+
+	got, err = Forecast(issues, ForecastOptions{Milestones: []string{"later", "done-epic"}, Trials: 50, Now: now})
+	if err != nil {
+		t.Fatalf("Forecast: %v", err)
+	}
+	if f := got.Milestones[0]; f.Remaining != 2 || f.Expected == nil || !f.Expected.Equal(want) {
+		t.Errorf("later: %+v, want 2 remaining done in 2 days", f)
+	}
+	if f := got.Milestones[1]; f.Remaining != 0 || f.Expected == nil || !f.Expected.Equal(today) {
+		t.Errorf("done-epic: %+v, want nothing remaining, done today", f)
+	}
+
+	// Without recent closes there is nothing to project from.
+	got, err = Forecast(issues[:5], ForecastOptions{Trials: 50, Now: now})
+	if err != nil {
+		t.Fatalf("Forecast: %v", err)
+	}
+	if f := got.Milestones[0]; f.Expected != nil || f.Reason == "" {
+		t.Errorf("expected no dates and a reason without history, got %+v", f)
+	}
+
+	if _, err := Forecast(issues, ForecastOptions{Milestones: []string{"missing"}}); err == nil {
+		t.Error("expected an error for an unknown milestone")
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"runtime"
 	"sort"
 	"sync"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/limits"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"gonum.org/v1/gonum/graph"
@@ -48,6 +50,7 @@ type StartupProfile struct {
 	KCore         time.Duration `json:"kcore"`        // bv-85
 	Articulation  time.Duration `json:"articulation"` // bv-85
 	Slack         time.Duration `json:"slack"`        // bv-85
+	Communities   time.Duration `json:"communities"`
 	Phase2        time.Duration `json:"phase2_total"`
 
 	// Configuration used
@@ -71,6 +74,10 @@ type GraphStats struct {
 	NodeCount        int // Number of nodes in graph
 	EdgeCount        int // Number of edges in graph
 
+	// Direct blocking edges, sorted (read-only after init); see ReachableFrom
+	dependsOn  map[string][]string
+	dependents map[string][]string
+
 	// Configuration used for this analysis (read-only after init)
 	Config AnalysisConfig
 
@@ -78,7 +85,10 @@ type GraphStats struct {
 	mu                sync.RWMutex
 	phase2Ready       bool
 	phase2Done        chan struct{} // Closed when Phase 2 completes
+	analyzer          *Analyzer     // Source graph, kept so ComputeAll can rerun a cancelled Phase 2
+	computeMu         sync.Mutex    // Serializes ComputeAll reruns
 	pageRank          map[string]float64
+	priorityPageRank  map[string]float64
 	betweenness       map[string]float64
 	eigenvector       map[string]float64
 	hubs              map[string]float64
@@ -86,8 +96,12 @@ type GraphStats struct {
 	criticalPathScore map[string]float64
 	coreNumber        map[string]int
 	articulation      map[string]bool
+	bottlenecks       *Bottlenecks
 	slack             map[string]float64
+	communities       map[string]int
+	modularity        float64
 	cycles            [][]string
+	sccs              *Condensation
 
 	// Ranks (1-based, computed for UI optimization)
 	pageRankRank     map[string]int
@@ -101,6 +115,10 @@ type GraphStats struct {
 
 	// Phase 2 status flags for robot visibility
 	status MetricStatus
+
+	// Phase 2 progress: metrics started and finished in the current run
+	metricsTotal int
+	metricsDone  int
 }
 
 // metricStatus captures per-metric computation outcome for transparency.
@@ -114,6 +132,7 @@ type MetricStatus struct {
 	KCore        statusEntry // bv-85: k-core decomposition
 	Articulation statusEntry // bv-85: articulation points (cut vertices)
 	Slack        statusEntry // bv-85: longest-path slack per node
+	Communities  statusEntry // Louvain community detection
 }
 
 // statusEntry records computation state for a single metric.
@@ -150,6 +169,19 @@ func (s *GraphStats) IsPhase2Ready() bool {
 	return s.phase2Ready
 }
 
+// Progress reports how many of the Phase 2 metrics being computed have
+// finished. Per-issue scores (PageRank, betweenness, eigenvector, HITS and
+// critical path) can be read as soon as their metric finishes, before
+// Phase 2 is ready; ranks and the rest arrive with IsPhase2Ready.
+func (s *GraphStats) Progress() (done, total int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.phase2Ready {
+		return s.metricsTotal, s.metricsTotal
+	}
+	return s.metricsDone, s.metricsTotal
+}
+
 // Status returns a copy of metric status flags.
 func (s *GraphStats) Status() MetricStatus {
 	s.mu.RLock()
@@ -186,6 +218,34 @@ func (s *GraphStats) WaitForPhase2() {
 	}
 }
 
+// ComputeAll blocks until every Phase 2 metric is available or ctx is done,
+// returning ctx.Err() in the latter case. If the background run was cancelled
+// before finishing (for example because the TUI reloaded), the metrics are
+// computed again here, concurrently, under ctx.
+func (s *GraphStats) ComputeAll(ctx context.Context) error {
+	if s.phase2Done != nil {
+		select {
+		case <-s.phase2Done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if s.IsPhase2Ready() {
+		return nil
+	}
+	if s.analyzer == nil {
+		return fmt.Errorf("phase 2 metrics unavailable: stats have no source graph")
+	}
+
+	s.computeMu.Lock()
+	defer s.computeMu.Unlock()
+	if s.IsPhase2Ready() {
+		return nil
+	}
+	s.analyzer.computePhase2WithProfile(ctx, s, s.Config, &StartupProfile{})
+	return ctx.Err()
+}
+
 // GetPageRankScore returns the PageRank score for a single issue.
 // Returns 0 if Phase 2 is not yet complete or if the issue is not found.
 func (s *GraphStats) GetPageRankScore(id string) float64 {
@@ -275,6 +335,20 @@ func (s *GraphStats) PageRankValue(id string) (float64, bool) {
 	return v, ok
 }
 
+// PriorityPageRankValue returns the priority-weighted PageRank score for a single issue.
+// Returns (0, false) if the issue is not found or Phase 2 is not complete.
+// Time complexity: O(1)
+// Thread-safe: Yes (uses RLock)
+func (s *GraphStats) PriorityPageRankValue(id string) (float64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.priorityPageRank == nil {
+		return 0, false
+	}
+	v, ok := s.priorityPageRank[id]
+	return v, ok
+}
+
 // PageRankAll iterates over all PageRank scores.
 // The callback receives each (id, score) pair. Return false to stop iteration.
 // Time complexity: O(n) for full iteration
@@ -604,6 +678,22 @@ func (s *GraphStats) PageRank() map[string]float64 {
 	return cp
 }
 
+// PriorityPageRank returns a copy of the priority-weighted PageRank map, in
+// which rank from a P0 dependent counts for more than rank from a P4 one.
+// Returns nil if Phase 2 is not yet complete or PageRank was disabled.
+func (s *GraphStats) PriorityPageRank() map[string]float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.priorityPageRank == nil {
+		return nil
+	}
+	cp := make(map[string]float64, len(s.priorityPageRank))
+	for k, v := range s.priorityPageRank {
+		cp[k] = v
+	}
+	return cp
+}
+
 // Betweenness returns a copy of the Betweenness map. Safe for concurrent iteration.
 // Returns an empty map if Phase 2 is not yet complete.
 func (s *GraphStats) Betweenness() map[string]float64 {
@@ -724,6 +814,41 @@ func (s *GraphStats) Slack() map[string]float64 {
 	return cp
 }
 
+// Communities returns each issue's community from Louvain modularity
+// optimisation on the undirected view. Community 0 is the largest; isolated
+// issues form communities of one. Returns nil if Phase 2 is not yet complete
+// or community detection was skipped.
+func (s *GraphStats) Communities() map[string]int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.communities == nil {
+		return nil
+	}
+	cp := make(map[string]int, len(s.communities))
+	for k, v := range s.communities {
+		cp[k] = v
+	}
+	return cp
+}
+
+// CommunityOf returns the community of a single issue.
+// Returns (0, false) if Phase 2 is not complete or the issue is unknown.
+func (s *GraphStats) CommunityOf(id string) (int, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.communities[id]
+	return v, ok
+}
+
+// Modularity scores the community partition: the share of dependencies that
+// stay inside a community minus the share expected at random. Values above
+// about 0.3 mean the work splits into genuinely separate streams.
+func (s *GraphStats) Modularity() float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.modularity
+}
+
 // Ranks accessors
 
 func (s *GraphStats) PageRankRank() map[string]int {
@@ -831,6 +956,10 @@ func (s *GraphStats) OutDegreeRank() map[string]int {
 }
 
 // Cycles returns a copy of detected cycles. Safe for concurrent iteration.
+// Each cycle is a closed walk of issue IDs (first == last). Every cyclic
+// component contributes at least one cycle; the rest of the elementary cycles
+// follow, up to AnalysisConfig.MaxCyclesToStore. Analyzer.CycleBreakEdges
+// suggests which dependencies to remove.
 // Returns nil if Phase 2 is not yet complete.
 func (s *GraphStats) Cycles() [][]string {
 	s.mu.RLock()
@@ -1390,6 +1519,7 @@ func (a *Analyzer) AnalyzeAsyncWithConfig(ctx context.Context, config AnalysisCo
 			KCore:        statusEntry{State: "pending"},
 			Articulation: statusEntry{State: "pending"},
 			Slack:        statusEntry{State: "pending"},
+			Communities:  statusEntry{State: "pending"},
 		},
 	}
 
@@ -1408,6 +1538,7 @@ func (a *Analyzer) AnalyzeAsyncWithConfig(ctx context.Context, config AnalysisCo
 			KCore:        statusEntry{State: stateFromTiming(kcoreComputed, false)},
 			Articulation: statusEntry{State: stateFromTiming(articulationComputed, false)},
 			Slack:        statusEntry{State: stateFromTiming(slackComputed, false)},
+			Communities:  statusEntry{State: stateFromTiming(config.ComputeCommunities, false)},
 		}
 		stats.phase2Ready = true
 		close(stats.phase2Done)
@@ -1416,6 +1547,7 @@ func (a *Analyzer) AnalyzeAsyncWithConfig(ctx context.Context, config AnalysisCo
 
 	// Phase 1: Fast metrics (degree centrality, topo sort, density)
 	a.computePhase1(stats)
+	stats.analyzer = a
 
 	if incCacheKey != "" {
 		putIncrementalGraphStatsCache(incCacheKey, stats)
@@ -1435,6 +1567,7 @@ func (a *Analyzer) AnalyzeAsyncWithConfig(ctx context.Context, config AnalysisCo
 			KCore:        statusEntry{State: "skipped", Reason: "all phase 2 disabled"},
 			Articulation: statusEntry{State: "skipped", Reason: "all phase 2 disabled"},
 			Slack:        statusEntry{State: "skipped", Reason: "all phase 2 disabled"},
+			Communities:  statusEntry{State: "skipped", Reason: "all phase 2 disabled"},
 		}
 		stats.phase2Ready = true
 		close(stats.phase2Done)
@@ -1461,7 +1594,10 @@ func (a *Analyzer) Analyze() GraphStats {
 		NodeCount:         stats.NodeCount,
 		EdgeCount:         stats.EdgeCount,
 		Config:            stats.Config,
+		dependsOn:         stats.dependsOn,
+		dependents:        stats.dependents,
 		pageRank:          stats.pageRank,
+		priorityPageRank:  stats.priorityPageRank,
 		betweenness:       stats.betweenness,
 		eigenvector:       stats.eigenvector,
 		hubs:              stats.hubs,
@@ -1477,7 +1613,11 @@ func (a *Analyzer) Analyze() GraphStats {
 		outDegreeRank:     stats.outDegreeRank,
 		coreNumber:        stats.coreNumber,
 		articulation:      stats.articulation,
+		bottlenecks:       stats.bottlenecks,
+		sccs:              stats.sccs,
 		slack:             stats.slack,
+		communities:       stats.communities,
+		modularity:        stats.modularity,
 		cycles:            stats.cycles,
 		phase2Ready:       true,
 		status:            stats.status,
@@ -1496,7 +1636,10 @@ func (a *Analyzer) AnalyzeWithConfig(config AnalysisConfig) GraphStats {
 		NodeCount:         stats.NodeCount,
 		EdgeCount:         stats.EdgeCount,
 		Config:            stats.Config,
+		dependsOn:         stats.dependsOn,
+		dependents:        stats.dependents,
 		pageRank:          stats.pageRank,
+		priorityPageRank:  stats.priorityPageRank,
 		betweenness:       stats.betweenness,
 		eigenvector:       stats.eigenvector,
 		hubs:              stats.hubs,
@@ -1512,7 +1655,11 @@ func (a *Analyzer) AnalyzeWithConfig(config AnalysisConfig) GraphStats {
 		outDegreeRank:     stats.outDegreeRank,
 		coreNumber:        stats.coreNumber,
 		articulation:      stats.articulation,
+		bottlenecks:       stats.bottlenecks,
+		sccs:              stats.sccs,
 		slack:             stats.slack,
+		communities:       stats.communities,
+		modularity:        stats.modularity,
 		cycles:            stats.cycles,
 		phase2Ready:       true,
 		status:            stats.status,
@@ -1601,6 +1748,8 @@ func (a *Analyzer) computePhase1WithProfile(stats *GraphStats, profile *StartupP
 	}
 	profile.TopoSort = time.Since(topoStart)
 
+	a.fillAdjacency(stats)
+
 	// Density
 	n := float64(len(a.issueMap))
 	e := float64(a.g.Edges().Len())
@@ -1610,8 +1759,13 @@ func (a *Analyzer) computePhase1WithProfile(stats *GraphStats, profile *StartupP
 }
 
 // computePhase2WithProfile calculates expensive metrics with timing instrumentation.
+// The metrics are independent of each other, so they run concurrently (bounded
+// by limits.Workers), each writing only its own locals and profile fields.
+// Results are assigned under the lock once all are done; on cancellation it
+// returns without waiting for in-flight metrics and leaves stats untouched.
 func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphStats, config AnalysisConfig, profile *StartupProfile) {
 	localPageRank := make(map[string]float64)
+	localPriorityPageRank := make(map[string]float64)
 	localBetweenness := make(map[string]float64)
 	localEigenvector := make(map[string]float64)
 	localHubs := make(map[string]float64)
@@ -1619,39 +1773,93 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 	localCriticalPath := make(map[string]float64)
 	var localCore map[string]int
 	var localArticulation map[string]bool
+	var localBottlenecks *Bottlenecks
 	var localSlack map[string]float64
 	var localCycles [][]string
+	var localSCCs *Condensation
+
+	var localCommunities map[string]int
+	var localModularity float64
 
 	betweennessIsApprox := false
 	actualBetweennessSample := 0
 	cyclesTruncated := false
 
+	var wg sync.WaitGroup
+	var panicOnce sync.Once
+	var panicked any
+	sem := make(chan struct{}, limits.Current().Workers(runtime.NumCPU()))
+	stats.mu.Lock()
+	stats.metricsTotal, stats.metricsDone = 0, 0
+	stats.mu.Unlock()
+	// publish makes a finished metric's scores readable before the rest
+	publish := func(assign func()) {
+		stats.mu.Lock()
+		assign()
+		stats.mu.Unlock()
+	}
+	run := func(enabled bool, compute func()) {
+		if !enabled || ctx.Err() != nil {
+			return
+		}
+		stats.mu.Lock()
+		stats.metricsTotal++
+		stats.mu.Unlock()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Re-raised below so computePhase2's recover still sees it
+			defer func() {
+				if r := recover(); r != nil {
+					panicOnce.Do(func() { panicked = r })
+				}
+			}()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+			if ctx.Err() == nil {
+				compute()
+			}
+			if ctx.Err() == nil {
+				publish(func() { stats.metricsDone++ })
+			}
+		}()
+	}
+
 	// PageRank
-	if ctx.Err() == nil && config.ComputePageRank {
+	run(config.ComputePageRank, func() {
 		prStart := time.Now()
-		prDone := make(chan map[int64]float64, 1)
+		prDone := make(chan [2]map[int64]float64, 1)
 		go func() {
 			defer func() {
 				if r := recover(); r != nil {
 					// Panic -> implicitly causes timeout in parent
 				}
 			}()
-			prDone <- computePageRank(a.g, 0.85, 1e-6)
+			pr := computePageRank(a.g, 0.85, 1e-6)
+			prDone <- [2]map[int64]float64{pr, a.computePriorityPageRank(0.85, 1e-6)}
 		}()
 
 		timer := time.NewTimer(config.PageRankTimeout)
 		select {
 		case pr := <-prDone:
 			timer.Stop()
-			for id, score := range pr {
+			for id, score := range pr[0] {
 				localPageRank[a.nodeToID[id]] = score
 			}
+			for id, score := range pr[1] {
+				localPriorityPageRank[a.nodeToID[id]] = score
+			}
 		case <-timer.C:
 			profile.PageRankTO = true
 			if len(a.issueMap) > 0 {
 				uniform := 1.0 / float64(len(a.issueMap))
 				for id := range a.issueMap {
 					localPageRank[id] = uniform
+					localPriorityPageRank[id] = uniform
 				}
 			}
 		case <-ctx.Done():
@@ -1659,11 +1867,12 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 			// Abort immediately
 			return
 		}
+		publish(func() { stats.pageRank, stats.priorityPageRank = localPageRank, localPriorityPageRank })
 		profile.PageRank = time.Since(prStart)
-	}
+	})
 
 	// Betweenness
-	if ctx.Err() == nil && config.ComputeBetweenness {
+	run(config.ComputeBetweenness, func() {
 		bwStart := time.Now()
 		bwDone := make(chan BetweennessResult, 1)
 		go func() {
@@ -1674,7 +1883,11 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 			}()
 			// Choose algorithm based on mode
 			if config.BetweennessMode == BetweennessApproximate && config.BetweennessSampleSize > 0 {
-				bwDone <- ApproxBetweenness(a.g, config.BetweennessSampleSize, 1)
+				seed := config.BetweennessSeed
+				if seed == 0 {
+					seed = DefaultBetweennessSeed
+				}
+				bwDone <- ApproxBetweenness(a.g, config.BetweennessSampleSize, seed)
 			} else {
 				// Exact mode or mode not set (default to exact)
 				exact := network.Betweenness(a.g)
@@ -1704,20 +1917,22 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 			timer.Stop()
 			return
 		}
+		publish(func() { stats.betweenness = localBetweenness })
 		profile.Betweenness = time.Since(bwStart)
-	}
+	})
 
 	// Eigenvector
-	if ctx.Err() == nil && config.ComputeEigenvector {
+	run(config.ComputeEigenvector, func() {
 		evStart := time.Now()
 		for id, score := range computeEigenvector(a.g) {
 			localEigenvector[a.nodeToID[id]] = score
 		}
+		publish(func() { stats.eigenvector = localEigenvector })
 		profile.Eigenvector = time.Since(evStart)
-	}
+	})
 
 	// HITS
-	if ctx.Err() == nil && config.ComputeHITS && a.g.Edges().Len() > 0 {
+	run(config.ComputeHITS && a.g.Edges().Len() > 0, func() {
 		hitsStart := time.Now()
 		hitsDone := make(chan map[int64]network.HubAuthority, 1)
 		go func() {
@@ -1743,21 +1958,23 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 			timer.Stop()
 			return
 		}
+		publish(func() { stats.hubs, stats.authorities = localHubs, localAuthorities })
 		profile.HITS = time.Since(hitsStart)
-	}
+	})
 
 	// Critical Path
-	if ctx.Err() == nil && config.ComputeCriticalPath {
+	run(config.ComputeCriticalPath, func() {
 		cpStart := time.Now()
 		sorted, err := topo.Sort(a.g)
 		if err == nil {
 			localCriticalPath = a.computeHeights(sorted)
 		}
+		publish(func() { stats.criticalPathScore = localCriticalPath })
 		profile.CriticalPath = time.Since(cpStart)
-	}
+	})
 
 	// Cycles
-	if ctx.Err() == nil && config.ComputeCycles {
+	run(config.ComputeCycles, func() {
 		cyclesStart := time.Now()
 		maxCycles := config.MaxCyclesToStore
 		if maxCycles == 0 {
@@ -1765,6 +1982,7 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 		}
 
 		sccs := topo.TarjanSCC(a.g)
+		localSCCs = a.computeCondensation(sccs)
 		hasCycles := false
 		for _, scc := range sccs {
 			if len(scc) > 1 {
@@ -1817,26 +2035,44 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 			}
 		}
 		profile.Cycles = time.Since(cyclesStart)
-	}
-
-	// Check cancellation before advanced signals
-	if ctx.Err() != nil {
-		return
-	}
+	})
 
 	// Advanced graph signals: k-core, articulation points (undirected), slack (bv-85)
 	// These can be skipped for triage-only mode (bv-t1js optimization)
-	if config.ComputeKCore || config.ComputeArticulation {
+	run(config.ComputeKCore || config.ComputeArticulation, func() {
 		kcoreStart := time.Now()
-		localCore, localArticulation = a.computeCoreAndArticulation()
+		localCore, localArticulation, localBottlenecks = a.computeCoreAndArticulation()
 		profile.KCore = time.Since(kcoreStart)
 		profile.Articulation = 0 // Computed together with k-core
-	}
+	})
 
-	if config.ComputeSlack {
+	run(config.ComputeSlack, func() {
 		slackStart := time.Now()
 		localSlack = a.computeSlack(stats.TopologicalOrder)
 		profile.Slack = time.Since(slackStart)
+	})
+
+	run(config.ComputeCommunities, func() {
+		communitiesStart := time.Now()
+		localCommunities, localModularity = a.computeCommunities()
+		profile.Communities = time.Since(communitiesStart)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return
+	}
+	if panicked != nil {
+		panic(panicked)
+	}
+	if ctx.Err() != nil {
+		return
 	}
 
 	// Compute ranks (background optimization)
@@ -1850,6 +2086,7 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 	// Atomic assignment
 	stats.mu.Lock()
 	stats.pageRank = localPageRank
+	stats.priorityPageRank = localPriorityPageRank
 	stats.betweenness = localBetweenness
 	stats.eigenvector = localEigenvector
 	stats.hubs = localHubs
@@ -1857,8 +2094,12 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 	stats.criticalPathScore = localCriticalPath
 	stats.coreNumber = localCore
 	stats.articulation = localArticulation
+	stats.bottlenecks = localBottlenecks
 	stats.slack = localSlack
+	stats.communities = localCommunities
+	stats.modularity = localModularity
 	stats.cycles = localCycles
+	stats.sccs = localSCCs
 
 	// Assign ranks
 	stats.pageRankRank = localPageRankRank
@@ -1898,6 +2139,7 @@ func (a *Analyzer) computePhase2WithProfile(ctx context.Context, stats *GraphSta
 		KCore:        statusEntry{State: stateFromTiming(kcoreComputed, false), Elapsed: profile.KCore},
 		Articulation: statusEntry{State: stateFromTiming(articulationComputed, false), Elapsed: profile.Articulation},
 		Slack:        statusEntry{State: stateFromTiming(slackComputed, false), Elapsed: profile.Slack},
+		Communities:  statusEntry{State: stateFromTiming(config.ComputeCommunities, false), Elapsed: profile.Communities},
 	}
 	stats.mu.Unlock()
 }
@@ -1938,6 +2180,8 @@ func (a *Analyzer) computePhase1(stats *GraphStats) {
 		stats.Density = e / (n * (n - 1))
 	}
 
+	a.fillAdjacency(stats)
+
 	// Compute Phase 1 Ranks
 	stats.inDegreeRank = computeIntRanks(stats.InDegree)
 	stats.outDegreeRank = computeIntRanks(stats.OutDegree)
@@ -1969,6 +2213,7 @@ func (a *Analyzer) computePhase2(ctx context.Context, stats *GraphStats, config
 				KCore:        failEntry,
 				Articulation: failEntry,
 				Slack:        failEntry,
+				Communities:  failEntry,
 			}
 			stats.phase2Ready = true
 		}
@@ -2075,11 +2320,12 @@ func (a undirectedAdjacency) degree(id int64) int {
 	return len(a.neighborsOf(id))
 }
 
-// computeCoreAndArticulation builds an undirected view to derive k-core numbers and articulation points.
-func (a *Analyzer) computeCoreAndArticulation() (map[string]int, map[string]bool) {
+// computeCoreAndArticulation builds an undirected view to derive k-core numbers, articulation points and bottlenecks.
+func (a *Analyzer) computeCoreAndArticulation() (map[string]int, map[string]bool, *Bottlenecks) {
 	adj := newUndirectedAdjacency(a.g)
 	core := computeKCore(adj)
 	art := findArticulationPoints(adj)
+	bottlenecks := a.computeBottlenecks(adj)
 
 	coreByID := make(map[string]int, len(core))
 	artByID := make(map[string]bool, len(art))
@@ -2089,7 +2335,7 @@ func (a *Analyzer) computeCoreAndArticulation() (map[string]int, map[string]bool
 	for id := range art {
 		artByID[a.nodeToID[id]] = true
 	}
-	return coreByID, artByID
+	return coreByID, artByID, &bottlenecks
 }
 
 // computeSlack calculates longest-path slack per node (0 on critical path).
@@ -2574,6 +2820,37 @@ func (a *Analyzer) countBlockedBy(issueID string) int {
 // It uses a deterministic power iteration with damping factor damp and terminates
 // when the L2 norm of the delta is below tol (or after a hard iteration cap).
 func computePageRank(g graph.Directed, damp, tol float64) map[int64]float64 {
+	return computeWeightedPageRank(g, damp, tol, nil)
+}
+
+// priorityPageRankWeight is how much rank an issue of the given priority
+// hands to the issues it depends on: P0 counts five times as much as P4.
+func priorityPageRankWeight(priority int) float64 {
+	switch {
+	case priority <= 0:
+		return 5
+	case priority >= 4:
+		return 1
+	default:
+		return float64(5 - priority)
+	}
+}
+
+// computePriorityPageRank is PageRank with every dependency edge weighted by
+// the priority of the depending issue. Edges leaving one issue all share its
+// weight, so normalizing them per issue would cancel out; the weight is
+// applied to the random jump instead, injecting rank at high-priority issues
+// that then flows to what they depend on.
+func (a *Analyzer) computePriorityPageRank(damp, tol float64) map[int64]float64 {
+	return computeWeightedPageRank(a.g, damp, tol, func(id int64) float64 {
+		return priorityPageRankWeight(a.issueMap[a.nodeToID[id]].Priority)
+	})
+}
+
+// computeWeightedPageRank is computePageRank with the random jump (and the
+// rank of dangling nodes) distributed in proportion to weight instead of
+// uniformly. A nil weight gives plain PageRank.
+func computeWeightedPageRank(g graph.Directed, damp, tol float64, weight func(id int64) float64) map[int64]float64 {
 	nodes := graph.NodesOf(g.Nodes())
 	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID() < nodes[j].ID() })
 	if len(nodes) == 0 {
@@ -2637,11 +2914,33 @@ func computePageRank(g graph.Directed, damp, tol float64) map[int64]float64 {
 	}
 	next := make([]float64, len(nodes))
 
+	// jump[i] is node i's share of the random jump; nil means uniform.
+	var jump []float64
+	if weight != nil {
+		jump = make([]float64, len(nodes))
+		total := 0.0
+		for i, node := range nodes {
+			jump[i] = math.Max(weight(node.ID()), 0)
+			total += jump[i]
+		}
+		if total > 0 {
+			for i := range jump {
+				jump[i] /= total
+			}
+		} else {
+			jump = nil
+		}
+	}
+
 	base := (1 - damp) / n
 	const maxIterations = 1000
 	for iter := 0; iter < maxIterations; iter++ {
 		for i := range next {
-			next[i] = base
+			if jump != nil {
+				next[i] = (1 - damp) * jump[i]
+			} else {
+				next[i] = base
+			}
 		}
 
 		dangling := 0.0
@@ -2657,9 +2956,15 @@ func computePageRank(g graph.Directed, damp, tol float64) map[int64]float64 {
 			}
 		}
 		if dangling != 0 {
-			add := damp * dangling / n
-			for i := range next {
-				next[i] += add
+			if jump != nil {
+				for i := range next {
+					next[i] += damp * dangling * jump[i]
+				}
+			} else {
+				add := damp * dangling / n
+				for i := range next {
+					next[i] += add
+				}
 			}
 		}
 
@@ -1,17 +1,23 @@
 package analysis
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 
 	"gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/topo"
 )
 
-// findCyclesSafe finds a limited number of cycles in the graph without exponential blowup.
-// It uses Tarjan's SCC algorithm to identify cyclic components and extracts one cycle per component.
+// findCyclesSafe finds up to limit elementary cycles in the graph without exponential blowup.
+// It uses Tarjan's SCC algorithm to identify cyclic components and first extracts one cycle per
+// component, so every problem area is reported even under a small limit. Any remaining budget is
+// filled with the other elementary cycles of each component, enumerated with Johnson's algorithm.
 func findCyclesSafe(g graph.Directed, limit int) [][]graph.Node {
 	sccs := topo.TarjanSCC(g)
 	var cycles [][]graph.Node
+	var cyclic [][]graph.Node
+	seen := make(map[string]bool)
 
 	for _, scc := range sccs {
 		if len(cycles) >= limit {
@@ -23,6 +29,7 @@ func findCyclesSafe(g graph.Directed, limit int) [][]graph.Node {
 			n := scc[0]
 			if g.HasEdgeFromTo(n.ID(), n.ID()) {
 				cycles = append(cycles, []graph.Node{n, n})
+				seen[cycleKey(cycles[len(cycles)-1])] = true
 			}
 			continue
 		}
@@ -30,9 +37,32 @@ func findCyclesSafe(g graph.Directed, limit int) [][]graph.Node {
 		// Find a cycle within this non-trivial SCC
 		if cycle := findOneCycleInSCC(g, scc); len(cycle) > 0 {
 			cycles = append(cycles, cycle)
+			seen[cycleKey(cycle)] = true
+			cyclic = append(cyclic, scc)
 		}
 	}
 
+	for _, scc := range cyclic {
+		if len(cycles) >= limit {
+			break
+		}
+		// findOneCycleInSCC sorted the component by ID, and walks edges
+		// backwards (dependency to dependent); keep that orientation.
+		adj := sccAdjacency(scc, func(id int64) graph.Nodes { return g.To(id) })
+		johnsonCycles(adj, func(local []int) bool {
+			cycle := make([]graph.Node, 0, len(local)+1)
+			for _, i := range local {
+				cycle = append(cycle, scc[i])
+			}
+			cycle = append(cycle, scc[local[0]])
+			if key := cycleKey(cycle); !seen[key] {
+				seen[key] = true
+				cycles = append(cycles, cycle)
+			}
+			return len(cycles) < limit
+		})
+	}
+
 	// Sort cycles for determinism
 	// 1. By length (ascending - shortest cycles are more interesting/fixable)
 	// 2. By content (lexicographically for stability)
@@ -140,4 +170,264 @@ func findOneCycleInSCC(g graph.Directed, scc []graph.Node) []graph.Node {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// cycleKey identifies a closed cycle regardless of where the walk started.
+func cycleKey(cycle []graph.Node) string {
+	open := cycle[:len(cycle)-1]
+	start := 0
+	for i, n := range open {
+		if n.ID() < open[start].ID() {
+			start = i
+		}
+	}
+	var sb strings.Builder
+	for i := range open {
+		fmt.Fprintf(&sb, "%d,", open[(start+i)%len(open)].ID())
+	}
+	return sb.String()
+}
+
+// sccAdjacency maps a component to local indices (its position in scc) and
+// lists each node's neighbours inside the component in ascending order.
+func sccAdjacency(scc []graph.Node, next func(id int64) graph.Nodes) [][]int {
+	local := make(map[int64]int, len(scc))
+	for i, n := range scc {
+		local[n.ID()] = i
+	}
+	adj := make([][]int, len(scc))
+	for i, n := range scc {
+		it := next(n.ID())
+		for it.Next() {
+			if j, ok := local[it.Node().ID()]; ok {
+				adj[i] = append(adj[i], j)
+			}
+		}
+		sort.Ints(adj[i])
+	}
+	return adj
+}
+
+// johnsonCycles enumerates the elementary cycles of a graph given as local
+// adjacency lists (Johnson, 1975), calling emit with each cycle in walk order,
+// starting at its lowest index and without the closing node. Enumeration
+// stops as soon as emit returns false.
+func johnsonCycles(adj [][]int, emit func([]int) bool) {
+	n := len(adj)
+	radj := make([][]int, n)
+	for v, ws := range adj {
+		for _, w := range ws {
+			radj[w] = append(radj[w], v)
+		}
+	}
+
+	blocked := make([]bool, n)
+	blockedBy := make([]map[int]bool, n)
+	inComp := make([]bool, n)
+	var stack []int
+	stop := false
+
+	var unblock func(u int)
+	unblock = func(u int) {
+		blocked[u] = false
+		for w := range blockedBy[u] {
+			delete(blockedBy[u], w)
+			if blocked[w] {
+				unblock(w)
+			}
+		}
+	}
+
+	var circuit func(v, s int) bool
+	circuit = func(v, s int) bool {
+		found := false
+		stack = append(stack, v)
+		blocked[v] = true
+		for _, w := range adj[v] {
+			if stop {
+				break
+			}
+			if !inComp[w] {
+				continue
+			}
+			if w == s {
+				found = true
+				if !emit(append([]int(nil), stack...)) {
+					stop = true
+				}
+			} else if !blocked[w] && circuit(w, s) {
+				found = true
+			}
+		}
+		if found {
+			unblock(v)
+		} else {
+			for _, w := range adj[v] {
+				if inComp[w] {
+					if blockedBy[w] == nil {
+						blockedBy[w] = make(map[int]bool)
+					}
+					blockedBy[w][v] = true
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		return found
+	}
+
+	for s := 0; s < n && !stop; s++ {
+		// Cycles whose lowest node is s live in the strongly connected
+		// component of s within the subgraph of nodes >= s.
+		fwd := reachFrom(adj, s)
+		back := reachFrom(radj, s)
+		size := 0
+		for v := range inComp {
+			inComp[v] = v >= s && fwd[v] && back[v]
+			if inComp[v] {
+				size++
+				blocked[v] = false
+				blockedBy[v] = nil
+			}
+		}
+		if size == 1 && !hasInt(adj[s], s) {
+			continue
+		}
+		circuit(s, s)
+	}
+}
+
+// reachFrom marks the nodes >= s reachable from s through nodes >= s.
+func reachFrom(adj [][]int, s int) []bool {
+	seen := make([]bool, len(adj))
+	seen[s] = true
+	queue := []int{s}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, w := range adj[v] {
+			if w >= s && !seen[w] {
+				seen[w] = true
+				queue = append(queue, w)
+			}
+		}
+	}
+	return seen
+}
+
+func hasInt(xs []int, x int) bool {
+	for _, v := range xs {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+// CycleEdge is a blocking dependency suggested for removal: From depends on To.
+type CycleEdge struct {
+	From   string `json:"from"`   // the dependent issue
+	To     string `json:"to"`     // the issue it is blocked by
+	Cycles int    `json:"cycles"` // cycles through this edge when it was picked (sampled)
+}
+
+// cycleBreakSample caps how many cycles are counted per greedy round.
+const cycleBreakSample = 1000
+
+// CycleBreakEdges suggests blocking dependencies whose removal makes the
+// dependency graph acyclic. Edges are picked greedily, each round taking the
+// edge on the most remaining cycles, then pruned so that every suggested edge
+// is needed: putting any one back would restore a cycle. The set is minimal
+// in that sense; the smallest possible set (minimum feedback arc set) is
+// NP-hard to find. Returns nil when the graph has no cycles.
+func (a *Analyzer) CycleBreakEdges() []CycleEdge {
+	if a == nil || a.g == nil {
+		return nil
+	}
+	var result []CycleEdge
+	for _, scc := range topo.TarjanSCC(a.g) {
+		if len(scc) == 1 && !a.g.HasEdgeFromTo(scc[0].ID(), scc[0].ID()) {
+			continue
+		}
+		sort.Slice(scc, func(i, j int) bool { return scc[i].ID() < scc[j].ID() })
+		adj := sccAdjacency(scc, func(id int64) graph.Nodes { return a.g.From(id) })
+		result = append(result, breakComponentCycles(adj, func(i int) string { return a.nodeToID[scc[i].ID()] })...)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].From != result[j].From {
+			return result[i].From < result[j].From
+		}
+		return result[i].To < result[j].To
+	})
+	return result
+}
+
+// breakComponentCycles runs the greedy-then-prune search on one component.
+func breakComponentCycles(adj [][]int, name func(int) string) []CycleEdge {
+	type edge struct{ from, to int }
+	removed := make(map[edge]bool)
+	without := func() [][]int {
+		out := make([][]int, len(adj))
+		for v, ws := range adj {
+			for _, w := range ws {
+				if !removed[edge{v, w}] {
+					out[v] = append(out[v], w)
+				}
+			}
+		}
+		return out
+	}
+	hasCycle := func() bool {
+		found := false
+		johnsonCycles(without(), func([]int) bool {
+			found = true
+			return false
+		})
+		return found
+	}
+
+	var picked []edge
+	pickedCycles := make(map[edge]int)
+	counts := make(map[edge]int)
+	for {
+		clear(counts)
+		sampled := 0
+		johnsonCycles(without(), func(cycle []int) bool {
+			for i, v := range cycle {
+				counts[edge{v, cycle[(i+1)%len(cycle)]}]++
+			}
+			sampled++
+			return sampled < cycleBreakSample
+		})
+		if sampled == 0 {
+			break
+		}
+		var best edge
+		bestCount := 0
+		for e, c := range counts {
+			if c > bestCount || (c == bestCount && (name(e.from) < name(best.from) ||
+				(name(e.from) == name(best.from) && name(e.to) < name(best.to)))) {
+				best, bestCount = e, c
+			}
+		}
+		removed[best] = true
+		picked = append(picked, best)
+		pickedCycles[best] = bestCount
+	}
+
+	// Greedy choices made early can become redundant once later edges are
+	// gone; restore every edge the graph stays acyclic without.
+	for i := len(picked) - 1; i >= 0; i-- {
+		delete(removed, picked[i])
+		if hasCycle() {
+			removed[picked[i]] = true
+		}
+	}
+
+	var edges []CycleEdge
+	for _, e := range picked {
+		if removed[e] {
+			edges = append(edges, CycleEdge{From: name(e.from), To: name(e.to), Cycles: pickedCycles[e]})
+		}
+	}
+	return edges
+}
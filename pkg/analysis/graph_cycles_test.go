@@ -3,6 +3,7 @@ package analysis
 import (
 	"testing"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
 	graph "gonum.org/v1/gonum/graph"
 	"gonum.org/v1/gonum/graph/simple"
@@ -188,6 +189,61 @@ func TestFindCyclesSafe_Determinism(t *testing.T) {
 	}
 }
 
+func TestFindCyclesSafe_AllElementaryCycles(t *testing.T) {
+	// One component holding three elementary cycles:
+	// 0 <-> 1, 1 <-> 2 and 0 -> 1 -> 2 -> 0
+	g := buildTestGraph(3, [][2]int{{0, 1}, {1, 0}, {1, 2}, {2, 1}, {2, 0}})
+
+	cycles := findCyclesSafe(g, 10)
+	if len(cycles) != 3 {
+		t.Fatalf("expected 3 elementary cycles, got %d: %v", len(cycles), cycles)
+	}
+	seen := make(map[string]bool)
+	for _, c := range cycles {
+		if c[0].ID() != c[len(c)-1].ID() {
+			t.Errorf("cycle %v should close", c)
+		}
+		if seen[cycleKey(c)] {
+			t.Errorf("cycle %v reported twice", c)
+		}
+		seen[cycleKey(c)] = true
+	}
+
+	if got := findCyclesSafe(g, 1); len(got) != 1 {
+		t.Errorf("expected limit to cap enumeration at 1, got %d", len(got))
+	}
+}
+
+func TestCycleBreakEdges(t *testing.T) {
+	blocks := func(id string, on ...string) model.Issue {
+		issue := model.Issue{ID: id, Status: model.StatusOpen}
+		for _, dep := range on {
+			issue.Dependencies = append(issue.Dependencies, &model.Dependency{IssueID: id, DependsOnID: dep, Type: model.DepBlocks})
+		}
+		return issue
+	}
+	// A -> B is on both A -> B -> A and A -> B -> C -> A; D <-> E is separate.
+	issues := []model.Issue{
+		blocks("A", "B"), blocks("B", "A", "C"), blocks("C", "A"),
+		blocks("D", "E"), blocks("E", "D"), blocks("F", "A"),
+	}
+
+	edges := NewAnalyzer(issues).CycleBreakEdges()
+	want := []CycleEdge{{From: "A", To: "B", Cycles: 2}, {From: "D", To: "E", Cycles: 1}}
+	if len(edges) != len(want) {
+		t.Fatalf("CycleBreakEdges() = %+v, want %+v", edges, want)
+	}
+	for i := range want {
+		if edges[i] != want[i] {
+			t.Errorf("edge %d = %+v, want %+v", i, edges[i], want[i])
+		}
+	}
+
+	if edges := NewAnalyzer(testutil.QuickDiamond(3)).CycleBreakEdges(); edges != nil {
+		t.Errorf("expected no edges for a DAG, got %+v", edges)
+	}
+}
+
 func TestFindOneCycleInSCC_Empty(t *testing.T) {
 	g := simple.NewDirectedGraph()
 	cycle := findOneCycleInSCC(g, nil)
@@ -0,0 +1,229 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// GraphEdge is a blocking dependency: From depends on To
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// PageRankMove is one issue's PageRank change between two snapshots
+type PageRankMove struct {
+	ID      string  `json:"id"`
+	Title   string  `json:"title"`
+	Old     float64 `json:"old"`
+	New     float64 `json:"new"`
+	Delta   float64 `json:"delta"`
+	OldRank int     `json:"old_rank"` // 1 = highest PageRank
+	NewRank int     `json:"new_rank"`
+}
+
+// BlockedFlip is an open issue whose blocked state changed
+type BlockedFlip struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Blocked bool   `json:"blocked"` // true: newly blocked; false: unblocked
+	// Blockers are the open issues now blocking it (when Blocked)
+	Blockers []string `json:"blockers,omitempty"`
+}
+
+// GraphDiff is the structural difference between two dependency graphs
+type GraphDiff struct {
+	AddedNodes   []string    `json:"added_nodes"`
+	RemovedNodes []string    `json:"removed_nodes"`
+	AddedEdges   []GraphEdge `json:"added_edges"`
+	RemovedEdges []GraphEdge `json:"removed_edges"`
+
+	// PageRankMoves covers issues present in both snapshots whose score or
+	// rank moved, largest absolute change first
+	PageRankMoves []PageRankMove `json:"pagerank_moves"`
+
+	// BlockedFlips lists issues, open in the new snapshot, that gained their
+	// first open blocker or lost their last one
+	BlockedFlips []BlockedFlip `json:"blocked_flips"`
+}
+
+// NewlyBlocked returns the flips that became blocked
+func (d *GraphDiff) NewlyBlocked() []BlockedFlip {
+	var out []BlockedFlip
+	for _, f := range d.BlockedFlips {
+		if f.Blocked {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Unblocked returns the flips that lost their last open blocker
+func (d *GraphDiff) Unblocked() []BlockedFlip {
+	var out []BlockedFlip
+	for _, f := range d.BlockedFlips {
+		if !f.Blocked {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// IsEmpty reports whether the two graphs are structurally the same
+func (d *GraphDiff) IsEmpty() bool {
+	return len(d.AddedNodes) == 0 && len(d.RemovedNodes) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0 &&
+		len(d.PageRankMoves) == 0 && len(d.BlockedFlips) == 0
+}
+
+// DiffGraphs compares the dependency graphs of two snapshots, from the older
+// to the newer. Nodes are issues; edges are blocking dependencies between
+// issues that exist in the same snapshot, as in the analyzer's graph.
+// PageRank moves are only reported when both snapshots carry stats. All lists
+// are sorted by ID unless noted.
+func DiffGraphs(from, to *Snapshot) *GraphDiff {
+	diff := &GraphDiff{
+		AddedNodes:    []string{},
+		RemovedNodes:  []string{},
+		AddedEdges:    []GraphEdge{},
+		RemovedEdges:  []GraphEdge{},
+		PageRankMoves: []PageRankMove{},
+		BlockedFlips:  []BlockedFlip{},
+	}
+	if from == nil || to == nil {
+		return diff
+	}
+
+	oldByID := snapshotIssueMap(from.Issues)
+	newByID := snapshotIssueMap(to.Issues)
+
+	for id := range newByID {
+		if _, ok := oldByID[id]; !ok {
+			diff.AddedNodes = append(diff.AddedNodes, id)
+		}
+	}
+	for id := range oldByID {
+		if _, ok := newByID[id]; !ok {
+			diff.RemovedNodes = append(diff.RemovedNodes, id)
+		}
+	}
+	sort.Strings(diff.AddedNodes)
+	sort.Strings(diff.RemovedNodes)
+
+	oldEdges, newEdges := blockingEdges(oldByID), blockingEdges(newByID)
+	for e := range newEdges {
+		if !oldEdges[e] {
+			diff.AddedEdges = append(diff.AddedEdges, e)
+		}
+	}
+	for e := range oldEdges {
+		if !newEdges[e] {
+			diff.RemovedEdges = append(diff.RemovedEdges, e)
+		}
+	}
+	sortGraphEdges(diff.AddedEdges)
+	sortGraphEdges(diff.RemovedEdges)
+
+	if from.Stats != nil && to.Stats != nil {
+		oldPR, newPR := from.Stats.PageRank(), to.Stats.PageRank()
+		oldRank, newRank := from.Stats.PageRankRank(), to.Stats.PageRankRank()
+		for id, iss := range newByID {
+			before, ok1 := oldPR[id]
+			after, ok2 := newPR[id]
+			if !ok1 || !ok2 {
+				continue
+			}
+			move := PageRankMove{
+				ID:      id,
+				Title:   iss.Title,
+				Old:     before,
+				New:     after,
+				Delta:   after - before,
+				OldRank: oldRank[id],
+				NewRank: newRank[id],
+			}
+			if math.Abs(move.Delta) < 1e-12 && move.OldRank == move.NewRank {
+				continue
+			}
+			diff.PageRankMoves = append(diff.PageRankMoves, move)
+		}
+		sort.Slice(diff.PageRankMoves, func(i, j int) bool {
+			di, dj := math.Abs(diff.PageRankMoves[i].Delta), math.Abs(diff.PageRankMoves[j].Delta)
+			if di != dj {
+				return di > dj
+			}
+			return diff.PageRankMoves[i].ID < diff.PageRankMoves[j].ID
+		})
+	}
+
+	for id, iss := range newByID {
+		prev, ok := oldByID[id]
+		if !ok || isClosedLikeStatus(iss.Status) {
+			continue
+		}
+		wasBlocked := !isClosedLikeStatus(prev.Status) && hasOpenBlocker(*prev, oldByID)
+		blockers := openBlockers(*iss, newByID)
+		if wasBlocked == (len(blockers) > 0) {
+			continue
+		}
+		diff.BlockedFlips = append(diff.BlockedFlips, BlockedFlip{
+			ID:       id,
+			Title:    iss.Title,
+			Blocked:  len(blockers) > 0,
+			Blockers: blockers,
+		})
+	}
+	sort.Slice(diff.BlockedFlips, func(i, j int) bool { return diff.BlockedFlips[i].ID < diff.BlockedFlips[j].ID })
+
+	return diff
+}
+
+func snapshotIssueMap(issues []model.Issue) map[string]*model.Issue {
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	return byID
+}
+
+// blockingEdges collects the blocking dependencies whose target exists
+func blockingEdges(byID map[string]*model.Issue) map[GraphEdge]bool {
+	edges := make(map[GraphEdge]bool)
+	for id, iss := range byID {
+		for _, dep := range iss.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if _, ok := byID[dep.DependsOnID]; ok {
+				edges[GraphEdge{From: id, To: dep.DependsOnID}] = true
+			}
+		}
+	}
+	return edges
+}
+
+// openBlockers lists the open issues blocking iss, sorted
+func openBlockers(iss model.Issue, byID map[string]*model.Issue) []string {
+	var out []string
+	for _, dep := range iss.Dependencies {
+		if dep == nil || !dep.Type.IsBlocking() {
+			continue
+		}
+		if blocker, ok := byID[dep.DependsOnID]; ok && !isClosedLikeStatus(blocker.Status) {
+			out = append(out, dep.DependsOnID)
+		}
+	}
+	sort.Strings(out)
+	return uniqueStrings(out)
+}
+
+func sortGraphEdges(edges []GraphEdge) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+}
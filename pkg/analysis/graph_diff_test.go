@@ -0,0 +1,62 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestDiffGraphs(t *testing.T) {
+	blocks := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	from := NewSnapshot([]model.Issue{
+		{ID: "A", Title: "A", Status: model.StatusOpen, Dependencies: blocks("B")},
+		{ID: "B", Title: "B", Status: model.StatusOpen},
+		{ID: "C", Title: "C", Status: model.StatusOpen},
+		{ID: "gone", Title: "Gone", Status: model.StatusOpen},
+	})
+	to := NewSnapshot([]model.Issue{
+		{ID: "A", Title: "A", Status: model.StatusOpen, Dependencies: blocks("B")},
+		{ID: "B", Title: "B", Status: model.StatusClosed},
+		{ID: "C", Title: "C", Status: model.StatusOpen, Dependencies: blocks("D")},
+		{ID: "D", Title: "D", Status: model.StatusOpen},
+	})
+
+	diff := DiffGraphs(from, to)
+
+	if !reflect.DeepEqual(diff.AddedNodes, []string{"D"}) || !reflect.DeepEqual(diff.RemovedNodes, []string{"gone"}) {
+		t.Errorf("unexpected node changes: +%v -%v", diff.AddedNodes, diff.RemovedNodes)
+	}
+	if !reflect.DeepEqual(diff.AddedEdges, []GraphEdge{{From: "C", To: "D"}}) || len(diff.RemovedEdges) != 0 {
+		t.Errorf("unexpected edge changes: +%v -%v", diff.AddedEdges, diff.RemovedEdges)
+	}
+
+	want := []BlockedFlip{
+		{ID: "A", Title: "A", Blocked: false, Blockers: []string{}},
+		{ID: "C", Title: "C", Blocked: true, Blockers: []string{"D"}},
+	}
+	if !reflect.DeepEqual(diff.BlockedFlips, want) {
+		t.Errorf("expected flips %+v, got %+v", want, diff.BlockedFlips)
+	}
+	if len(diff.NewlyBlocked()) != 1 || len(diff.Unblocked()) != 1 {
+		t.Errorf("expected one flip each way, got %d blocked, %d unblocked", len(diff.NewlyBlocked()), len(diff.Unblocked()))
+	}
+
+	if len(diff.PageRankMoves) == 0 {
+		t.Fatal("expected PageRank moves")
+	}
+	for i, m := range diff.PageRankMoves {
+		if m.Delta != m.New-m.Old {
+			t.Errorf("%s: delta %f != %f - %f", m.ID, m.Delta, m.New, m.Old)
+		}
+		if i > 0 && abs(m.Delta) > abs(diff.PageRankMoves[i-1].Delta) {
+			t.Errorf("moves not sorted by magnitude at %d", i)
+		}
+	}
+
+	if same := DiffGraphs(to, to); !same.IsEmpty() {
+		t.Errorf("diff of a snapshot with itself should be empty, got %+v", same)
+	}
+}
@@ -130,3 +130,24 @@ func TestAnalyzerAnalyzeAsync_DoesNotReuseStatsWhenGraphChanges(t *testing.T) {
 		t.Fatalf("expected graph stats to NOT be reused when graph structure changes")
 	}
 }
+
+func TestAnalyzerAnalyzeAsync_ReportsProgress(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "P1", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "P2", Type: model.DepBlocks}}},
+		{ID: "P2", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "P3", Type: model.DepBlocks}}},
+		{ID: "P3", Status: model.StatusOpen},
+	}
+	stats := NewAnalyzer(issues).AnalyzeAsync(context.Background())
+	stats.WaitForPhase2()
+	done, total := stats.Progress()
+	if total == 0 || done != total {
+		t.Fatalf("progress after phase 2 = %d/%d", done, total)
+	}
+	if stats.GetPageRankScore("P3") <= stats.GetPageRankScore("P1") {
+		t.Errorf("the issue everything waits on should rank highest")
+	}
+
+	if done, total := NewAnalyzer(nil).AnalyzeAsync(context.Background()).Progress(); done != 0 || total != 0 {
+		t.Errorf("empty graph has no metrics to compute, got %d/%d", done, total)
+	}
+}
@@ -1,6 +1,7 @@
 package analysis_test
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"testing"
@@ -722,3 +723,70 @@ func TestGetBlockerChain(t *testing.T) {
 		}
 	})
 }
+
+func TestPriorityPageRank(t *testing.T) {
+	dep := func(from, to string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: from, DependsOnID: to, Type: model.DepBlocks}}
+	}
+	// Mirror-image graphs that differ only in who depends on X and Y.
+	issues := []model.Issue{
+		{ID: "urgent", Priority: 0, Status: model.StatusOpen, Dependencies: dep("urgent", "X")},
+		{ID: "backlog", Priority: 4, Status: model.StatusOpen, Dependencies: dep("backlog", "Y")},
+		{ID: "X", Priority: 2, Status: model.StatusOpen},
+		{ID: "Y", Priority: 2, Status: model.StatusOpen},
+	}
+	stats := analysis.NewAnalyzer(issues).Analyze()
+
+	pr := stats.PageRank()
+	if diff := pr["X"] - pr["Y"]; diff > 1e-9 || diff < -1e-9 {
+		t.Fatalf("plain PageRank should not see priority: X=%f Y=%f", pr["X"], pr["Y"])
+	}
+
+	weighted := stats.PriorityPageRank()
+	if weighted["X"] <= weighted["Y"] {
+		t.Errorf("P0 dependent should boost X above Y: X=%f Y=%f", weighted["X"], weighted["Y"])
+	}
+	total := 0.0
+	for _, v := range weighted {
+		total += v
+	}
+	if total < 0.999 || total > 1.001 {
+		t.Errorf("priority PageRank should sum to 1, got %f", total)
+	}
+	if v, ok := stats.PriorityPageRankValue("urgent"); !ok || v != weighted["urgent"] {
+		t.Errorf("PriorityPageRankValue(urgent) = %f, %v", v, ok)
+	}
+}
+
+func TestGraphStatsComputeAll(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "compute-all-a", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "compute-all-a", DependsOnID: "compute-all-b", Type: model.DepBlocks}}},
+		{ID: "compute-all-b", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "compute-all-b", DependsOnID: "compute-all-c", Type: model.DepBlocks}}},
+		{ID: "compute-all-c", Status: model.StatusOpen},
+	}
+
+	// A background run cancelled before it starts leaves Phase 2 unfinished.
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	stats := analysis.NewAnalyzer(issues).AnalyzeAsync(cancelled)
+	stats.WaitForPhase2()
+	if stats.IsPhase2Ready() {
+		t.Fatal("expected Phase 2 to stop when its context is cancelled")
+	}
+
+	if err := stats.ComputeAll(cancelled); err == nil {
+		t.Error("ComputeAll with a cancelled context should return its error")
+	}
+	if err := stats.ComputeAll(context.Background()); err != nil {
+		t.Fatalf("ComputeAll: %v", err)
+	}
+	if !stats.IsPhase2Ready() {
+		t.Fatal("expected Phase 2 ready after ComputeAll")
+	}
+	if _, ok := stats.PageRankValue("compute-all-c"); !ok {
+		t.Error("expected PageRank for compute-all-c")
+	}
+	if got := stats.CriticalPathScore()["compute-all-c"]; got != 3 {
+		t.Errorf("critical path score for compute-all-c = %v, want 3", got)
+	}
+}
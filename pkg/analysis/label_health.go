@@ -29,7 +29,7 @@ type LabelHealth struct {
 	HealthLevel string             `json:"health_level"`     // "healthy", "warning", "critical"
 	Velocity    VelocityMetrics    `json:"velocity"`         // Work completion rate
 	Freshness   FreshnessMetrics   `json:"freshness"`        // How recently updated
-	Flow        FlowMetrics        `json:"flow"`             // Cross-label dependencies
+	Flow        LabelFlowMetrics   `json:"flow"`             // Cross-label dependencies
 	Criticality CriticalityMetrics `json:"criticality"`      // Graph-based importance
 	Issues      []string           `json:"issues,omitempty"` // Issue IDs with this label
 }
@@ -79,8 +79,8 @@ type FreshnessMetrics struct {
 	FreshnessScore     int       `json:"freshness_score"`       // Normalized 0-100 score (higher = fresher)
 }
 
-// FlowMetrics captures cross-label dependency relationships
-type FlowMetrics struct {
+// LabelFlowMetrics captures cross-label dependency relationships
+type LabelFlowMetrics struct {
 	IncomingDeps      int      `json:"incoming_deps"`       // Other labels blocking this one
 	OutgoingDeps      int      `json:"outgoing_deps"`       // Labels this one blocks
 	IncomingLabels    []string `json:"incoming_labels"`     // Which labels block this one
@@ -505,7 +505,7 @@ func ComputeLabelHealthForLabel(label string, issues []model.Issue, cfg LabelHea
 	freshness := ComputeFreshnessMetrics(labeled, now, cfg.StaleThresholdDays)
 
 	// Flow: count cross-label deps
-	flow := FlowMetrics{}
+	flow := LabelFlowMetrics{}
 	seenIn := make(map[string]struct{})
 	seenOut := make(map[string]struct{})
 	for _, iss := range labeled {
@@ -768,7 +768,7 @@ func NewLabelHealth(label string) LabelHealth {
 			StaleThresholdDays: DefaultStaleThresholdDays,
 			FreshnessScore:     100,
 		},
-		Flow: FlowMetrics{
+		Flow: LabelFlowMetrics{
 			FlowScore: 100,
 		},
 		Criticality: CriticalityMetrics{
@@ -147,7 +147,7 @@ func TestLabelHealthTypes(t *testing.T) {
 		t.Errorf("FreshnessMetrics field mismatch")
 	}
 
-	flow := FlowMetrics{
+	flow := LabelFlowMetrics{
 		IncomingDeps:      3,
 		OutgoingDeps:      2,
 		IncomingLabels:    []string{"api", "core"},
@@ -158,7 +158,7 @@ func TestLabelHealthTypes(t *testing.T) {
 	}
 
 	if len(flow.IncomingLabels) != 2 {
-		t.Errorf("FlowMetrics labels mismatch")
+		t.Errorf("LabelFlowMetrics labels mismatch")
 	}
 
 	criticality := CriticalityMetrics{
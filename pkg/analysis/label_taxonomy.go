@@ -0,0 +1,204 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// LabelPair counts the issues two labels share
+type LabelPair struct {
+	A       string  `json:"a"`
+	B       string  `json:"b"`
+	Count   int     `json:"count"`   // issues carrying both
+	Jaccard float64 `json:"jaccard"` // Count over issues carrying either
+}
+
+// LabelMerge suggests folding a label into a near-identical spelling
+type LabelMerge struct {
+	From       string  `json:"from"` // label to retire
+	Into       string  `json:"into"` // label to keep, the more used one
+	FromCount  int     `json:"from_count"`
+	IntoCount  int     `json:"into_count"`
+	Shared     int     `json:"shared"`     // issues already carrying both
+	Similarity float64 `json:"similarity"` // 0-1, 1 = same name once normalized
+	Reason     string  `json:"reason"`
+}
+
+// LabelTaxonomy describes how a project's labels are used together and which
+// of them look like duplicates
+type LabelTaxonomy struct {
+	Labels []string     `json:"labels"` // sorted; indexes Counts and Matrix
+	Counts []int        `json:"counts"` // issues per label
+	Matrix [][]int      `json:"matrix"` // [i][j] issues carrying both; diagonal = Counts
+	Pairs  []LabelPair  `json:"pairs"`  // labels seen together, most shared first
+	Merges []LabelMerge `json:"merges"` // most similar first
+}
+
+// ComputeLabelTaxonomy builds the label co-occurrence matrix and suggests
+// merges for label names that differ only in case or separators, in singular
+// versus plural, or by a single-character typo. Names that differ in their
+// digits (phase-1, phase-2) are never suggested.
+func ComputeLabelTaxonomy(issues []model.Issue) LabelTaxonomy {
+	sets := make([][]string, 0, len(issues))
+	seen := make(map[string]bool)
+	for _, iss := range issues {
+		var set []string
+		for _, l := range iss.Labels {
+			if l != "" {
+				set = append(set, l)
+				seen[l] = true
+			}
+		}
+		if set = uniqueStrings(set); len(set) > 0 {
+			sets = append(sets, set)
+		}
+	}
+
+	t := LabelTaxonomy{Labels: make([]string, 0, len(seen)), Pairs: []LabelPair{}, Merges: []LabelMerge{}}
+	for l := range seen {
+		t.Labels = append(t.Labels, l)
+	}
+	sort.Strings(t.Labels)
+	index := make(map[string]int, len(t.Labels))
+	for i, l := range t.Labels {
+		index[l] = i
+	}
+	t.Counts = make([]int, len(t.Labels))
+	t.Matrix = make([][]int, len(t.Labels))
+	for i := range t.Matrix {
+		t.Matrix[i] = make([]int, len(t.Labels))
+	}
+	for _, set := range sets {
+		for _, a := range set {
+			i := index[a]
+			t.Counts[i]++
+			for _, b := range set {
+				t.Matrix[i][index[b]]++
+			}
+		}
+	}
+
+	for i := range t.Labels {
+		for j := i + 1; j < len(t.Labels); j++ {
+			if n := t.Matrix[i][j]; n > 0 {
+				t.Pairs = append(t.Pairs, LabelPair{
+					A:       t.Labels[i],
+					B:       t.Labels[j],
+					Count:   n,
+					Jaccard: float64(n) / float64(t.Counts[i]+t.Counts[j]-n),
+				})
+			}
+			if m, ok := labelMerge(t, i, j); ok {
+				t.Merges = append(t.Merges, m)
+			}
+		}
+	}
+	sort.SliceStable(t.Pairs, func(i, j int) bool {
+		if t.Pairs[i].Count != t.Pairs[j].Count {
+			return t.Pairs[i].Count > t.Pairs[j].Count
+		}
+		return t.Pairs[i].Jaccard > t.Pairs[j].Jaccard
+	})
+	sort.SliceStable(t.Merges, func(i, j int) bool {
+		if t.Merges[i].Similarity != t.Merges[j].Similarity {
+			return t.Merges[i].Similarity > t.Merges[j].Similarity
+		}
+		return t.Merges[i].Into < t.Merges[j].Into
+	})
+	return t
+}
+
+// labelMerge compares labels i and j of t, keeping the more used one (the
+// alphabetically first on ties)
+func labelMerge(t LabelTaxonomy, i, j int) (LabelMerge, bool) {
+	a, b := normalizeLabelName(t.Labels[i]), normalizeLabelName(t.Labels[j])
+	if a == "" || b == "" || labelDigits(a) != labelDigits(b) {
+		return LabelMerge{}, false
+	}
+
+	var m LabelMerge
+	switch {
+	case a == b:
+		m.Similarity = 1
+		m.Reason = "differ only in case or separators"
+	case singularLabel(a) == singularLabel(b):
+		m.Similarity = 0.95
+		m.Reason = "singular and plural forms"
+	case min(len(a), len(b)) >= 5 && editDistance(a, b) == 1:
+		m.Similarity = 1 - 1/float64(max(len(a), len(b)))
+		m.Reason = "one character apart, likely a typo"
+	default:
+		return LabelMerge{}, false
+	}
+
+	keep, drop := i, j
+	if t.Counts[j] > t.Counts[i] {
+		keep, drop = j, i
+	}
+	m.Into, m.IntoCount = t.Labels[keep], t.Counts[keep]
+	m.From, m.FromCount = t.Labels[drop], t.Counts[drop]
+	m.Shared = t.Matrix[i][j]
+	if m.Shared > 0 {
+		m.Reason += fmt.Sprintf("; %d issue(s) carry both", m.Shared)
+	}
+	return m, true
+}
+
+// normalizeLabelName lowercases a label and drops separators, so "Front-End",
+// "front_end" and "frontend" compare equal
+func normalizeLabelName(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func singularLabel(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies") && len(s) > 4:
+		return s[:len(s)-3] + "y"
+	case strings.HasSuffix(s, "ss"):
+		return s
+	case strings.HasSuffix(s, "s") && len(s) > 3:
+		return s[:len(s)-1]
+	}
+	return s
+}
+
+func labelDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// editDistance is the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
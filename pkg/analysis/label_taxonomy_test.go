@@ -0,0 +1,79 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeLabelTaxonomy_Matrix(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Labels: []string{"api", "backend"}},
+		{ID: "B", Labels: []string{"api", "backend", "api"}},
+		{ID: "C", Labels: []string{"api", "ui"}},
+		{ID: "D", Labels: []string{""}},
+	}
+	tax := ComputeLabelTaxonomy(issues)
+
+	if want := []string{"api", "backend", "ui"}; !reflect.DeepEqual(tax.Labels, want) {
+		t.Fatalf("Labels = %v, want %v", tax.Labels, want)
+	}
+	if want := []int{3, 2, 1}; !reflect.DeepEqual(tax.Counts, want) {
+		t.Errorf("Counts = %v, want %v (duplicate labels count once)", tax.Counts, want)
+	}
+	want := [][]int{{3, 2, 1}, {2, 2, 0}, {1, 0, 1}}
+	if !reflect.DeepEqual(tax.Matrix, want) {
+		t.Errorf("Matrix = %v, want %v", tax.Matrix, want)
+	}
+	if len(tax.Pairs) != 2 || tax.Pairs[0].A != "api" || tax.Pairs[0].B != "backend" || tax.Pairs[0].Count != 2 {
+		t.Fatalf("Pairs = %+v, want api+backend first", tax.Pairs)
+	}
+	if got := tax.Pairs[0].Jaccard; got < 0.66 || got > 0.67 {
+		t.Errorf("api+backend Jaccard = %v, want 2/3", got)
+	}
+	if len(tax.Merges) != 0 {
+		t.Errorf("Merges = %+v, want none", tax.Merges)
+	}
+}
+
+func TestComputeLabelTaxonomy_Merges(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "1", Labels: []string{"frontend"}},
+		{ID: "2", Labels: []string{"frontend", "Front-End"}},
+		{ID: "3", Labels: []string{"bugs"}},
+		{ID: "4", Labels: []string{"bug"}},
+		{ID: "5", Labels: []string{"bug"}},
+		{ID: "6", Labels: []string{"perfomance"}},
+		{ID: "7", Labels: []string{"performance"}},
+		{ID: "8", Labels: []string{"performance", "phase-1", "phase-2", "ui", "ux"}},
+	}
+	tax := ComputeLabelTaxonomy(issues)
+
+	got := make(map[string]LabelMerge)
+	for _, m := range tax.Merges {
+		got[m.From] = m
+	}
+	if len(got) != 3 {
+		t.Fatalf("Merges = %+v, want 3 (phase-1/phase-2 and ui/ux are distinct)", tax.Merges)
+	}
+	if m := got["Front-End"]; m.Into != "frontend" || m.Similarity != 1 || m.Shared != 1 {
+		t.Errorf("Front-End merge = %+v, want into frontend, similarity 1, shared 1", m)
+	}
+	if m := got["bugs"]; m.Into != "bug" || m.IntoCount != 2 || m.FromCount != 1 {
+		t.Errorf("bugs merge = %+v, want into the more used bug", m)
+	}
+	if m := got["perfomance"]; m.Into != "performance" || m.Reason != "one character apart, likely a typo" {
+		t.Errorf("typo merge = %+v", m)
+	}
+	if tax.Merges[0].From != "Front-End" {
+		t.Errorf("most similar merge first, got %+v", tax.Merges[0])
+	}
+}
+
+func TestComputeLabelTaxonomy_Empty(t *testing.T) {
+	tax := ComputeLabelTaxonomy(nil)
+	if len(tax.Labels) != 0 || tax.Pairs == nil || tax.Merges == nil {
+		t.Errorf("empty taxonomy = %+v, want empty non-nil slices", tax)
+	}
+}
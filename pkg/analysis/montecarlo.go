@@ -0,0 +1,284 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// MonteCarloOptions configures ComputeMonteCarloForecast
+type MonteCarloOptions struct {
+	// Milestones are the issues to forecast; each is done when it, its
+	// parent-child children and everything those transitively depend on are
+	// closed. Empty means every open epic.
+	Milestones []string
+
+	// Trials is the number of simulated schedules (default 1000)
+	Trials int
+
+	// Agents is the number of parallel workers (default 1)
+	Agents int
+
+	// HistoryDays is the cycle-time sampling window (default 90)
+	HistoryDays int
+
+	// Seed makes the forecast reproducible (default 1)
+	Seed int64
+
+	// Now anchors the forecast (default time.Now())
+	Now time.Time
+}
+
+// MilestoneForecast is the simulated completion of one milestone: the date by
+// which 50%, 85% and 95% of trials had it done. Dates are nil when its
+// remaining work is stuck behind a dependency cycle.
+type MilestoneForecast struct {
+	ID        string     `json:"id"`
+	Title     string     `json:"title"`
+	Remaining int        `json:"remaining"` // open issues in its scope, itself included
+	P50       *time.Time `json:"p50,omitempty"`
+	P85       *time.Time `json:"p85,omitempty"`
+	P95       *time.Time `json:"p95,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+}
+
+// MonteCarloForecast holds per-milestone forecasts plus one for all open work
+type MonteCarloForecast struct {
+	Now                   time.Time           `json:"now"`
+	Trials                int                 `json:"trials"`
+	Agents                int                 `json:"agents"`
+	VelocityMinutesPerDay float64             `json:"velocity_minutes_per_day"`
+	CycleTimeSamples      int                 `json:"cycle_time_samples"` // closed issues resampled for unestimated work; 0 means estimates only
+	Milestones            []MilestoneForecast `json:"milestones"`
+	Overall               MilestoneForecast   `json:"overall"`
+}
+
+// monteCarloMinCycleSamples is how many recent cycle times are needed before
+// unestimated issues are drawn from history instead of the median estimate
+const monteCarloMinCycleSamples = 5
+
+// Spread applied to estimate-based durations: a triangular distribution from
+// 0.75x to 2x the estimate, most likely at 1x, since work overruns far more
+// often and further than it underruns.
+const (
+	monteCarloLow  = 0.75
+	monteCarloMode = 1.0
+	monteCarloHigh = 2.0
+)
+
+// ComputeMonteCarloForecast runs Trials randomized list schedules of the open
+// work (see ComputeSchedule) and reports percentile completion dates per
+// milestone. Issues with estimated_minutes take that estimate over the recent
+// velocity, stretched or shrunk by a random overrun factor. Unestimated
+// issues resample the creation-to-close times of issues closed in the last
+// HistoryDays when there are enough of them, and otherwise use the median
+// estimate like estimated ones.
+func ComputeMonteCarloForecast(issues []model.Issue, opts MonteCarloOptions) (MonteCarloForecast, error) {
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	trials := opts.Trials
+	if trials <= 0 {
+		trials = 1000
+	}
+	agents := opts.Agents
+	if agents <= 0 {
+		agents = 1
+	}
+	window := opts.HistoryDays
+	if window <= 0 {
+		window = 90
+	}
+	seed := opts.Seed
+	if seed == 0 {
+		seed = 1
+	}
+
+	byID := make(map[string]*model.Issue, len(issues))
+	open := make(map[string]*model.Issue, len(issues))
+	scope := make(map[string]bool, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+		if !isClosedLikeStatus(issues[i].Status) {
+			open[issues[i].ID] = &issues[i]
+			scope[issues[i].ID] = true
+		}
+	}
+
+	milestones := opts.Milestones
+	if len(milestones) == 0 {
+		for id, iss := range open {
+			if iss.IssueType == model.TypeEpic {
+				milestones = append(milestones, id)
+			}
+		}
+		sort.Strings(milestones)
+	}
+	for _, id := range milestones {
+		if _, ok := byID[id]; !ok {
+			return MonteCarloForecast{}, fmt.Errorf("milestone %q not found", id)
+		}
+	}
+
+	median := computeMedianEstimatedMinutes(issues)
+	velocity := scheduleVelocity(issues, now, median)
+	cycleTimes := recentCycleTimes(issues, now, window)
+	if len(cycleTimes) < monteCarloMinCycleSamples {
+		cycleTimes = nil
+	}
+
+	children := make(map[string][]string)
+	for i := range issues {
+		for _, dep := range issues[i].Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				children[dep.DependsOnID] = append(children[dep.DependsOnID], issues[i].ID)
+			}
+		}
+	}
+
+	// Open work each milestone waits on, itself included
+	members := make([][]string, len(milestones))
+	for m, id := range milestones {
+		members[m] = milestoneScope(byID, children, id)
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	days := func(id string, minutes int) float64 {
+		iss := open[id]
+		if cycleTimes != nil && (iss.EstimatedMinutes == nil || *iss.EstimatedMinutes <= 0) {
+			return cycleTimes[rng.Intn(len(cycleTimes))]
+		}
+		return float64(minutes) / velocity * triangular(rng.Float64(), monteCarloLow, monteCarloMode, monteCarloHigh)
+	}
+
+	// finish[m][t] is milestone m's finish in trial t (days from now);
+	// the last row is all open work. NaN means never finished.
+	finish := make([][]float64, len(milestones)+1)
+	for m := range finish {
+		finish[m] = make([]float64, trials)
+	}
+	for t := 0; t < trials; t++ {
+		sched := listScheduleDays(open, scope, agents, median, days)
+		for m := range milestones {
+			finish[m][t] = latestFinish(sched, members[m])
+		}
+		overall := 0.0
+		if len(sched.order) < len(scope) {
+			overall = math.NaN()
+		}
+		for _, id := range sched.order {
+			overall = math.Max(overall, sched.finish[id])
+		}
+		finish[len(milestones)][t] = overall
+	}
+
+	out := MonteCarloForecast{
+		Now:                   now,
+		Trials:                trials,
+		Agents:                agents,
+		VelocityMinutesPerDay: velocity,
+		CycleTimeSamples:      len(cycleTimes),
+		Milestones:            []MilestoneForecast{},
+	}
+	for m, id := range milestones {
+		f := MilestoneForecast{ID: id, Title: byID[id].Title, Remaining: len(members[m])}
+		fillForecastPercentiles(&f, finish[m], now)
+		out.Milestones = append(out.Milestones, f)
+	}
+	out.Overall = MilestoneForecast{ID: "all", Title: "All open work", Remaining: len(open)}
+	fillForecastPercentiles(&out.Overall, finish[len(milestones)], now)
+	return out, nil
+}
+
+// milestoneScope returns id, its children, and every issue those transitively
+// depend on through blocking dependencies, keeping only open ones, sorted
+func milestoneScope(byID map[string]*model.Issue, children map[string][]string, id string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	stack := []string{id}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		iss, ok := byID[cur]
+		if !ok || seen[cur] {
+			continue
+		}
+		seen[cur] = true
+		if isClosedLikeStatus(iss.Status) {
+			continue
+		}
+		out = append(out, cur)
+		stack = append(stack, children[cur]...)
+		for _, dep := range iss.Dependencies {
+			if dep != nil && dep.Type.IsBlocking() {
+				stack = append(stack, dep.DependsOnID)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// latestFinish is when the last of ids finishes, 0 when none are open and
+// NaN when any was never scheduled
+func latestFinish(sched listScheduleResult, ids []string) float64 {
+	latest := 0.0
+	for _, id := range ids {
+		f, ok := sched.finish[id]
+		if !ok {
+			return math.NaN()
+		}
+		latest = math.Max(latest, f)
+	}
+	return latest
+}
+
+// recentCycleTimes returns creation-to-close durations in days of issues
+// closed within the window before now
+func recentCycleTimes(issues []model.Issue, now time.Time, windowDays int) []float64 {
+	since := now.AddDate(0, 0, -windowDays)
+	var out []float64
+	for _, iss := range issues {
+		closed, ok := closedTime(iss)
+		if !ok || closed.Before(since) || closed.After(now) || iss.CreatedAt.IsZero() || !closed.After(iss.CreatedAt) {
+			continue
+		}
+		out = append(out, closed.Sub(iss.CreatedAt).Hours()/24)
+	}
+	sort.Float64s(out)
+	return out
+}
+
+// triangular maps a uniform u in [0,1) onto a triangular distribution
+func triangular(u, low, mode, high float64) float64 {
+	split := (mode - low) / (high - low)
+	if u < split {
+		return low + math.Sqrt(u*(high-low)*(mode-low))
+	}
+	return high - math.Sqrt((1-u)*(high-low)*(high-mode))
+}
+
+// fillForecastPercentiles sets f's P50/P85/P95 from per-trial finish offsets
+func fillForecastPercentiles(f *MilestoneForecast, finish []float64, now time.Time) {
+	vals := make([]float64, 0, len(finish))
+	for _, v := range finish {
+		if !math.IsNaN(v) {
+			vals = append(vals, v)
+		}
+	}
+	if len(vals) < len(finish) {
+		f.Reason = CutReasonCycle
+		return
+	}
+	sort.Float64s(vals)
+	at := func(q float64) *time.Time {
+		t := now.Add(durationDays(percentileFloat(vals, q)))
+		return &t
+	}
+	f.P50, f.P85, f.P95 = at(0.50), at(0.85), at(0.95)
+}
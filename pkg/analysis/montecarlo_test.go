@@ -0,0 +1,74 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeMonteCarloForecast(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	minutes := func(m int) *int { return &m }
+	dep := func(from, to string, typ model.DependencyType) []*model.Dependency {
+		return []*model.Dependency{{IssueID: from, DependsOnID: to, Type: typ}}
+	}
+	issues := []model.Issue{
+		{ID: "epic", Status: model.StatusOpen, IssueType: model.TypeEpic, EstimatedMinutes: minutes(480)},
+		{ID: "child", Status: model.StatusOpen, EstimatedMinutes: minutes(480), Dependencies: dep("child", "epic", model.DepParentChild)},
+		{ID: "later", Status: model.StatusOpen, EstimatedMinutes: minutes(480), Dependencies: dep("later", "child", model.DepBlocks)},
+	}
+	opts := MonteCarloOptions{Trials: 300, Now: now}
+
+	got, err := ComputeMonteCarloForecast(issues, opts)
+	if err != nil {
+		t.Fatalf("ComputeMonteCarloForecast: %v", err)
+	}
+	if len(got.Milestones) != 1 || got.Milestones[0].ID != "epic" || got.Milestones[0].Remaining != 2 {
+		t.Fatalf("milestones = %+v, want the epic with itself and its child", got.Milestones)
+	}
+	if got.Overall.Remaining != 3 {
+		t.Errorf("overall remaining = %d, want 3", got.Overall.Remaining)
+	}
+
+	// One agent works 2-3 issues of 5 days each (480 min at 96 min/day),
+	// each stretched between 0.75x and 2x.
+	for _, f := range []MilestoneForecast{got.Milestones[0], got.Overall} {
+		if f.P50 == nil || f.P85 == nil || f.P95 == nil {
+			t.Fatalf("%s: missing percentiles: %+v", f.ID, f)
+		}
+		if f.P50.After(*f.P85) || f.P85.After(*f.P95) {
+			t.Errorf("%s: percentiles out of order: %v %v %v", f.ID, f.P50, f.P85, f.P95)
+		}
+		lo := now.Add(durationDays(3.75 * float64(f.Remaining)))
+		hi := now.Add(durationDays(10 * float64(f.Remaining)))
+		if f.P50.Before(lo) || f.P95.After(hi) {
+			t.Errorf("%s: P50 %v / P95 %v outside [%v, %v]", f.ID, f.P50, f.P95, lo, hi)
+		}
+	}
+
+	again, _ := ComputeMonteCarloForecast(issues, opts)
+	if !reflect.DeepEqual(got, again) {
+		t.Error("same seed should give the same forecast")
+	}
+
+	cyclic := append(issues,
+		model.Issue{ID: "p", Status: model.StatusOpen, Dependencies: dep("p", "q", model.DepBlocks)},
+		model.Issue{ID: "q", Status: model.StatusOpen, Dependencies: dep("q", "p", model.DepBlocks)},
+	)
+	res, err := ComputeMonteCarloForecast(cyclic, MonteCarloOptions{Milestones: []string{"p", "later"}, Trials: 10, Now: now})
+	if err != nil {
+		t.Fatalf("cyclic: %v", err)
+	}
+	if res.Milestones[0].P50 != nil || res.Milestones[0].Reason != CutReasonCycle {
+		t.Errorf("cycle milestone = %+v, want no dates and a cycle reason", res.Milestones[0])
+	}
+	if res.Milestones[1].P50 == nil || res.Overall.P50 != nil {
+		t.Errorf("want dates for 'later' but not for all open work: %+v / %+v", res.Milestones[1], res.Overall)
+	}
+
+	if _, err := ComputeMonteCarloForecast(issues, MonteCarloOptions{Milestones: []string{"nope"}}); err == nil {
+		t.Error("expected an error for an unknown milestone")
+	}
+}
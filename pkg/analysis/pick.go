@@ -0,0 +1,152 @@
+package analysis
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// PickOptions configures PickWeighted
+type PickOptions struct {
+	// User limits candidates to issues assigned to this user or unassigned;
+	// empty considers every actionable issue
+	User string
+
+	// Seed makes the draw reproducible; 0 draws a fresh one from the clock
+	Seed int64
+
+	// Now anchors staleness (default time.Now())
+	Now time.Time
+}
+
+// PickCandidate is an actionable issue with its sampling weight
+type PickCandidate struct {
+	ID          string  `json:"id"`
+	Title       string  `json:"title"`
+	Priority    int     `json:"priority"`
+	Assignee    string  `json:"assignee,omitempty"`
+	Unblocks    int     `json:"unblocks"`   // issues that become actionable once this closes
+	StaleDays   int     `json:"stale_days"` // days since last update
+	Weight      float64 `json:"weight"`
+	Probability float64 `json:"probability"` // Weight over the sum of all weights
+}
+
+// WeightedPick is the drawn issue and why it was likely to be drawn
+type WeightedPick struct {
+	PickCandidate
+	Reasons    []string `json:"reasons"`
+	Candidates int      `json:"candidates"`
+}
+
+// pickStaleCap bounds the staleness bonus: an issue untouched for 60+ days
+// weighs three times as much as one updated today
+const pickStaleCap = 60
+
+// PickWeighted draws one actionable issue at random, weighting each by
+// priority, how many issues it unblocks and how long it has sat untouched.
+// Unlike the triage top pick, repeated draws spread attention across the
+// list instead of always landing on the same item.
+func PickWeighted(issues []model.Issue, opts PickOptions) (WeightedPick, error) {
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	seed := opts.Seed
+	if seed == 0 {
+		seed = now.UnixNano()
+	}
+
+	analyzer := NewAnalyzer(issues)
+	var candidates []PickCandidate
+	total := 0.0
+	for _, iss := range analyzer.GetActionableIssues() {
+		if opts.User != "" && iss.Assignee != "" && !strings.EqualFold(iss.Assignee, opts.User) {
+			continue
+		}
+		c := PickCandidate{
+			ID:       iss.ID,
+			Title:    iss.Title,
+			Priority: iss.Priority,
+			Assignee: iss.Assignee,
+			Unblocks: len(analyzer.ComputeUnblocks(iss.ID)),
+		}
+		if !iss.UpdatedAt.IsZero() && now.After(iss.UpdatedAt) {
+			c.StaleDays = int(now.Sub(iss.UpdatedAt).Hours() / 24)
+		}
+		c.Weight = pickPriorityFactor(c.Priority) * pickUnblockFactor(c.Unblocks) * pickStaleFactor(c.StaleDays)
+		total += c.Weight
+		candidates = append(candidates, c)
+	}
+	if len(candidates) == 0 {
+		if opts.User != "" {
+			return WeightedPick{}, fmt.Errorf("no actionable issues for %s", opts.User)
+		}
+		return WeightedPick{}, fmt.Errorf("no actionable issues")
+	}
+
+	// Candidates come from GetActionableIssues in ID order, so a seed always
+	// draws the same issue for the same input.
+	r := rand.New(rand.NewSource(seed)).Float64() * total
+	chosen := candidates[len(candidates)-1]
+	for _, c := range candidates {
+		if r < c.Weight {
+			chosen = c
+			break
+		}
+		r -= c.Weight
+	}
+	chosen.Probability = chosen.Weight / total
+
+	pick := WeightedPick{PickCandidate: chosen, Candidates: len(candidates)}
+	pick.Reasons = append(pick.Reasons,
+		fmt.Sprintf("P%d priority (×%.1f)", chosen.Priority, pickPriorityFactor(chosen.Priority)))
+	switch chosen.Unblocks {
+	case 0:
+		pick.Reasons = append(pick.Reasons, "unblocks nothing directly (×1.0)")
+	case 1:
+		pick.Reasons = append(pick.Reasons, fmt.Sprintf("unblocks 1 issue (×%.1f)", pickUnblockFactor(1)))
+	default:
+		pick.Reasons = append(pick.Reasons,
+			fmt.Sprintf("unblocks %d issues (×%.1f)", chosen.Unblocks, pickUnblockFactor(chosen.Unblocks)))
+	}
+	if chosen.StaleDays > 0 {
+		pick.Reasons = append(pick.Reasons,
+			fmt.Sprintf("untouched for %d days (×%.1f)", chosen.StaleDays, pickStaleFactor(chosen.StaleDays)))
+	} else {
+		pick.Reasons = append(pick.Reasons, "updated today (×1.0)")
+	}
+	if len(candidates) == 1 {
+		pick.Reasons = append(pick.Reasons, "the only candidate")
+	} else {
+		pick.Reasons = append(pick.Reasons,
+			fmt.Sprintf("%.0f%% chance among %d candidates", chosen.Probability*100, len(candidates)))
+	}
+	return pick, nil
+}
+
+// pickPriorityFactor maps P0..P4 to 5..1, clamping out-of-range values
+func pickPriorityFactor(priority int) float64 {
+	if priority < model.PriorityCritical {
+		priority = model.PriorityCritical
+	}
+	if priority > model.PriorityBacklog {
+		priority = model.PriorityBacklog
+	}
+	return float64(model.PriorityBacklog - priority + 1)
+}
+
+// pickUnblockFactor adds half a point per issue this one unblocks
+func pickUnblockFactor(unblocks int) float64 {
+	return 1 + 0.5*float64(unblocks)
+}
+
+// pickStaleFactor grows linearly from 1 to 3 over pickStaleCap days
+func pickStaleFactor(days int) float64 {
+	if days > pickStaleCap {
+		days = pickStaleCap
+	}
+	return 1 + 2*float64(days)/pickStaleCap
+}
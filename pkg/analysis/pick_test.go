@@ -0,0 +1,72 @@
+package analysis
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestPickWeighted(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "a", Title: "Root", Status: model.StatusOpen, Priority: 0, UpdatedAt: now.AddDate(0, 0, -90)},
+		{ID: "b", Title: "Blocked", Status: model.StatusOpen, Priority: 2, UpdatedAt: now,
+			Dependencies: []*model.Dependency{{IssueID: "b", DependsOnID: "a", Type: model.DepBlocks}}},
+		{ID: "c", Title: "Mine", Status: model.StatusOpen, Priority: 4, Assignee: "alice", UpdatedAt: now},
+		{ID: "d", Title: "Theirs", Status: model.StatusInProgress, Priority: 1, Assignee: "bob", UpdatedAt: now},
+		{ID: "e", Title: "Done", Status: model.StatusClosed, Priority: 0, UpdatedAt: now},
+	}
+
+	t.Run("weights and reasons", func(t *testing.T) {
+		counts := make(map[string]int)
+		var rootPick WeightedPick
+		for seed := int64(1); seed <= 500; seed++ {
+			p, err := PickWeighted(issues, PickOptions{User: "alice", Seed: seed, Now: now})
+			if err != nil {
+				t.Fatal(err)
+			}
+			counts[p.ID]++
+			if p.ID == "a" {
+				rootPick = p
+			}
+		}
+		// b is blocked, d belongs to bob, e is closed.
+		if counts["b"]+counts["d"]+counts["e"] != 0 {
+			t.Fatalf("picked a non-candidate: %v", counts)
+		}
+		// a: 5 (P0) × 1.5 (unblocks b) × 3 (stale) = 22.5; c: 1 × 1 × 1 = 1.
+		if counts["a"] < 450 || counts["c"] == 0 {
+			t.Fatalf("draw distribution %v, want a ≈ 96%%", counts)
+		}
+		if rootPick.Candidates != 2 || rootPick.Unblocks != 1 || rootPick.StaleDays != 90 {
+			t.Fatalf("pick = %+v", rootPick)
+		}
+		if math.Abs(rootPick.Weight-22.5) > 1e-9 || math.Abs(rootPick.Probability-22.5/23.5) > 1e-9 {
+			t.Fatalf("weight/probability = %v/%v", rootPick.Weight, rootPick.Probability)
+		}
+		why := strings.Join(rootPick.Reasons, "; ")
+		for _, want := range []string{"P0 priority", "unblocks 1 issue", "untouched for 90 days", "96% chance among 2"} {
+			if !strings.Contains(why, want) {
+				t.Errorf("reasons %q missing %q", why, want)
+			}
+		}
+	})
+
+	t.Run("deterministic and empty", func(t *testing.T) {
+		first, _ := PickWeighted(issues, PickOptions{Seed: 7, Now: now})
+		again, _ := PickWeighted(issues, PickOptions{Seed: 7, Now: now})
+		if first.ID != again.ID {
+			t.Fatalf("same seed picked %s then %s", first.ID, again.ID)
+		}
+
+		if _, err := PickWeighted(issues[4:], PickOptions{Now: now}); err == nil {
+			t.Fatal("expected error with no actionable issues")
+		}
+		if _, err := PickWeighted(issues[3:4], PickOptions{User: "alice", Now: now}); err == nil || !strings.Contains(err.Error(), "alice") {
+			t.Fatalf("err = %v, want one naming the user", err)
+		}
+	})
+}
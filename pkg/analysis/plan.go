@@ -8,11 +8,17 @@ import (
 
 // PlanItem represents a single actionable item in the execution plan
 type PlanItem struct {
-	ID          string   `json:"id"`
-	Title       string   `json:"title"`
-	Priority    int      `json:"priority"`
-	Status      string   `json:"status"`
-	UnblocksIDs []string `json:"unblocks"` // Issues that become actionable when this is done
+	ID                 string   `json:"id"`
+	Title              string   `json:"title"`
+	Priority           int      `json:"priority"`
+	Status             string   `json:"status"`
+	Assignee           string   `json:"assignee,omitempty"`
+	SuggestedAssignee  string   `json:"suggested_assignee,omitempty"` // For unassigned items, from label history
+	UnblocksIDs        []string `json:"unblocks"`                     // Issues that become actionable when this is done
+	TransitiveUnblocks int      `json:"transitive_unblocks"`          // Including issues those free in turn (see ImpactScores)
+	OverWIP            bool     `json:"over_wip,omitempty"`           // Past PlanOptions.GlobalWIPLimit
+	EstimatedMinutes   int      `json:"estimated_minutes,omitempty"`  // Own estimate (median when missing); set when issues carry estimates
+	ChainMinutes       int      `json:"chain_minutes,omitempty"`      // Plus the longest estimated chain of open work it gates
 }
 
 // ExecutionTrack represents a group of related actionable items
@@ -20,6 +26,17 @@ type ExecutionTrack struct {
 	TrackID string     `json:"track_id"`
 	Items   []PlanItem `json:"items"`
 	Reason  string     `json:"reason"` // Why these are grouped
+
+	// EstimatedMinutes sums the items' estimates and ChainMinutes is the
+	// longest critical chain starting in the track; both are zero when no
+	// issue carries an estimate.
+	EstimatedMinutes int `json:"estimated_minutes,omitempty"`
+	ChainMinutes     int `json:"chain_minutes,omitempty"`
+
+	// Batch and Batches number the pieces of a track split by
+	// PlanOptions.MaxTrackSize (1-based); zero when the track is whole.
+	Batch   int `json:"batch,omitempty"`
+	Batches int `json:"batches,omitempty"`
 }
 
 // ExecutionPlan is the complete work plan with parallel tracks
@@ -28,6 +45,17 @@ type ExecutionPlan struct {
 	TotalActionable int              `json:"total_actionable"`
 	TotalBlocked    int              `json:"total_blocked"`
 	Summary         PlanSummary      `json:"summary"`
+
+	// Deferred holds actionable items left out of Tracks because their
+	// assignee is at the WIP limit; set only when PlanOptions has limits.
+	Deferred []PlanItem `json:"deferred,omitempty"`
+	// Assignees is each limited assignee's load, by name; set only when
+	// PlanOptions has limits.
+	Assignees []AssigneeLoad `json:"assignees,omitempty"`
+	// GlobalWIPLimit echoes PlanOptions.GlobalWIPLimit and OverWIP counts
+	// the track items marked past it; both are zero without a global limit.
+	GlobalWIPLimit int `json:"global_wip_limit,omitempty"`
+	OverWIP        int `json:"over_wip,omitempty"`
 }
 
 // PlanSummary provides quick insights about the plan
@@ -38,8 +66,19 @@ type PlanSummary struct {
 }
 
 // GetExecutionPlan generates a dependency-respecting execution plan
-// with parallel tracks identified for concurrent work.
+// with parallel tracks identified for concurrent work. When issues carry
+// estimates, the track whose critical chain (remaining estimated effort along
+// blocking chains) is longest comes first.
 func (a *Analyzer) GetExecutionPlan() ExecutionPlan {
+	return a.GetExecutionPlanWithOptions(PlanOptions{})
+}
+
+// GetExecutionPlanWithOptions is GetExecutionPlan with per-assignee WIP
+// limits: items past an assignee's limit move from Tracks to Deferred, and
+// unassigned items are offered to people with spare capacity. A global WIP
+// limit marks the items the team has no room for, and MaxTrackSize splits
+// long tracks into batches (see PlanOptions).
+func (a *Analyzer) GetExecutionPlanWithOptions(opts PlanOptions) ExecutionPlan {
 	actionable := a.GetActionableIssues()
 
 	// Build set of actionable IDs for quick lookup
@@ -54,12 +93,37 @@ func (a *Analyzer) GetExecutionPlan() ExecutionPlan {
 		unblocksMap[issue.ID] = a.computeUnblocks(issue.ID)
 	}
 
-	// Find connected components among all issues (not just actionable)
+	// Find communities among all issues (not just actionable)
 	// This groups actionable issues that belong to the same work stream
-	components := a.findConnectedComponents()
+	components := a.findCommunities()
+
+	// Hold back what assignees have no room for, then build tracks from
+	// components, filtering to scheduled actionable issues only
+	assignment := a.assignPlanItems(actionable, opts)
+	scheduledSet := actionableSet
+	if assignment.limited {
+		scheduledSet = make(map[string]bool, len(actionable))
+		for _, issue := range actionable {
+			if !assignment.deferred[issue.ID] {
+				scheduledSet[issue.ID] = true
+			}
+		}
+	}
+	tracks := a.buildTracks(components, scheduledSet, unblocksMap)
+	for t := range tracks {
+		for i := range tracks[t].Items {
+			tracks[t].Items[i].SuggestedAssignee = assignment.suggested[tracks[t].Items[i].ID]
+		}
+	}
+	a.orderTracksByChain(tracks)
 
-	// Build tracks from components, filtering to actionable issues only
-	tracks := a.buildTracks(components, actionableSet, unblocksMap)
+	overWIP := 0
+	if opts.GlobalWIPLimit > 0 {
+		overWIP = a.markOverWIP(tracks, opts.GlobalWIPLimit, actionableSet)
+	}
+	if opts.MaxTrackSize > 0 {
+		tracks = chunkTracks(tracks, opts.MaxTrackSize)
+	}
 
 	// Calculate totals
 	totalOpen := 0
@@ -72,12 +136,24 @@ func (a *Analyzer) GetExecutionPlan() ExecutionPlan {
 	// Find highest impact issue
 	summary := a.computePlanSummary(actionable, unblocksMap)
 
-	return ExecutionPlan{
+	plan := ExecutionPlan{
 		Tracks:          tracks,
 		TotalActionable: len(actionable),
 		TotalBlocked:    totalOpen - len(actionable),
 		Summary:         summary,
+		GlobalWIPLimit:  max(opts.GlobalWIPLimit, 0),
+		OverWIP:         overWIP,
 	}
+	if assignment.limited {
+		for _, issue := range actionable {
+			if assignment.deferred[issue.ID] {
+				plan.Deferred = append(plan.Deferred, a.planItem(issue, unblocksMap))
+			}
+		}
+		sortPlanItems(plan.Deferred)
+		plan.Assignees = assignment.loads
+	}
+	return plan
 }
 
 // computeUnblocks finds issues that would become actionable if the given issue is closed
@@ -144,72 +220,38 @@ func (a *Analyzer) ComputeUnblocks(issueID string) []string {
 	return a.computeUnblocks(issueID)
 }
 
-// findConnectedComponents uses union-find to group related issues
-func (a *Analyzer) findConnectedComponents() map[string][]string {
-	// Simple union-find
-	parent := make(map[string]string)
-
-	var find func(x string) string
-	find = func(x string) string {
-		if parent[x] == "" {
-			parent[x] = x
-		}
-		if parent[x] != x {
-			parent[x] = find(parent[x])
-		}
-		return parent[x]
-	}
-
-	union := func(x, y string) {
-		px, py := find(x), find(y)
-		if px != py {
-			// Deterministic merge: always attach larger ID to smaller ID (or vice versa)
-			// This ensures the root choice doesn't depend on visitation order,
-			// though finding the MST still requires stable iteration.
-			if px < py {
-				parent[py] = px
-			} else {
-				parent[px] = py
-			}
-		}
-	}
+// findCommunities groups issues by Louvain community (see GraphStats.Communities),
+// keyed by each community's smallest issue ID. Communities never span
+// disconnected parts of the graph, so a loosely coupled cluster inside one
+// large connected component still becomes a track of its own.
+func (a *Analyzer) findCommunities() map[string][]string {
+	communities, _ := a.computeCommunities()
 
-	// Initialize all issues
-	// Use sorted IDs for deterministic iteration order
 	var ids []string
 	for id := range a.issueMap {
 		ids = append(ids, id)
 	}
 	sort.Strings(ids)
 
+	roots := make(map[int]string)
+	groups := make(map[string][]string)
 	for _, id := range ids {
-		parent[id] = id
-	}
-
-	// Union issues connected by dependencies (ignoring direction)
-	// Iterate in sorted order to ensure deterministic tree structure
-	for _, id := range ids {
-		issue := a.issueMap[id]
-		for _, dep := range issue.Dependencies {
-			if dep != nil && dep.Type.IsBlocking() {
-				if _, exists := a.issueMap[dep.DependsOnID]; exists {
-					union(issue.ID, dep.DependsOnID)
-				}
-			}
+		c, ok := communities[id]
+		if !ok {
+			groups[id] = append(groups[id], id)
+			continue
 		}
+		root, seen := roots[c]
+		if !seen {
+			root = id
+			roots[c] = id
+		}
+		groups[root] = append(groups[root], id)
 	}
-
-	// Group by root
-	components := make(map[string][]string)
-	for _, id := range ids {
-		root := find(id)
-		components[root] = append(components[root], id)
-	}
-
-	return components
+	return groups
 }
 
-// buildTracks creates execution tracks from connected components
+// buildTracks creates execution tracks from communities
 func (a *Analyzer) buildTracks(components map[string][]string, actionableSet map[string]bool, unblocksMap map[string][]string) []ExecutionTrack {
 	var tracks []ExecutionTrack
 	trackNum := 1
@@ -236,32 +278,19 @@ func (a *Analyzer) buildTracks(components map[string][]string, actionableSet map
 			continue
 		}
 
-		// Sort by priority (ascending = higher priority first), then by ID
-		sort.Slice(actionableMembers, func(i, j int) bool {
-			if actionableMembers[i].Priority != actionableMembers[j].Priority {
-				return actionableMembers[i].Priority < actionableMembers[j].Priority
-			}
-			return actionableMembers[i].ID < actionableMembers[j].ID
-		})
-
-		// Build plan items
+		// Build plan items, by priority (ascending = higher priority first), then by ID
 		items := make([]PlanItem, len(actionableMembers))
 		for i, issue := range actionableMembers {
-			items[i] = PlanItem{
-				ID:          issue.ID,
-				Title:       issue.Title,
-				Priority:    issue.Priority,
-				Status:      string(issue.Status),
-				UnblocksIDs: unblocksMap[issue.ID],
-			}
+			items[i] = a.planItem(issue, unblocksMap)
 		}
+		sortPlanItems(items)
 
 		// Determine track reason
 		reason := "Independent work stream"
 		if len(actionableMembers) == 1 {
 			reason = "Single actionable item"
 		} else if len(components) == 1 {
-			reason = "All issues in connected graph"
+			reason = "All issues in one community"
 		}
 
 		tracks = append(tracks, ExecutionTrack{
@@ -275,6 +304,29 @@ func (a *Analyzer) buildTracks(components map[string][]string, actionableSet map
 	return tracks
 }
 
+// planItem builds the plan entry for an actionable issue
+func (a *Analyzer) planItem(issue model.Issue, unblocksMap map[string][]string) PlanItem {
+	return PlanItem{
+		ID:                 issue.ID,
+		Title:              issue.Title,
+		Priority:           issue.Priority,
+		Status:             string(issue.Status),
+		Assignee:           issue.Assignee,
+		UnblocksIDs:        unblocksMap[issue.ID],
+		TransitiveUnblocks: a.countTransitiveUnblocks(issue.ID),
+	}
+}
+
+// sortPlanItems orders items by priority (ascending = higher priority first), then by ID
+func sortPlanItems(items []PlanItem) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Priority != items[j].Priority {
+			return items[i].Priority < items[j].Priority
+		}
+		return items[i].ID < items[j].ID
+	})
+}
+
 // computePlanSummary finds the highest-impact actionable issue
 func (a *Analyzer) computePlanSummary(actionable []model.Issue, unblocksMap map[string][]string) PlanSummary {
 	if len(actionable) == 0 {
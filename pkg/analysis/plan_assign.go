@@ -0,0 +1,271 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// PlanOptions configures GetExecutionPlanWithOptions
+type PlanOptions struct {
+	// WIPLimit is the most items one assignee may have going at once: their
+	// in_progress issues plus what the plan schedules for them. 0 means no
+	// limit.
+	WIPLimit int
+
+	// WIPLimits overrides WIPLimit for individual assignees; 0 lifts the
+	// limit for that person.
+	WIPLimits map[string]int
+
+	// GlobalWIPLimit is the most items the whole team may have going at
+	// once. Track items past it stay in the plan but are marked OverWIP,
+	// in-progress work counting first. 0 means no limit.
+	GlobalWIPLimit int
+
+	// MaxTrackSize splits longer tracks into consecutive batches of at most
+	// this many items, highest priority first. 0 keeps tracks whole.
+	MaxTrackSize int
+}
+
+func (o PlanOptions) limited() bool {
+	return o.WIPLimit > 0 || len(o.WIPLimits) > 0
+}
+
+// LimitFor returns the assignee's WIP limit, 0 meaning unlimited
+func (o PlanOptions) LimitFor(assignee string) int {
+	if limit, ok := o.WIPLimits[assignee]; ok {
+		return limit
+	}
+	return o.WIPLimit
+}
+
+// ParseWIPLimits parses per-assignee limits written as "ann=2,bob=1"
+func ParseWIPLimits(s string) (map[string]int, error) {
+	limits := make(map[string]int)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.TrimSpace(name)
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if !ok || name == "" || err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid WIP limit %q (want assignee=N)", part)
+		}
+		limits[name] = n
+	}
+	return limits, nil
+}
+
+// AssigneeLoad is one assignee's share of a WIP-limited plan
+type AssigneeLoad struct {
+	Assignee   string `json:"assignee"`
+	WIPLimit   int    `json:"wip_limit"`   // 0 = unlimited
+	InProgress int    `json:"in_progress"` // in_progress issues the plan doesn't list (blocked ones)
+	Scheduled  int    `json:"scheduled"`   // items in Tracks owned or suggested for them
+	Deferred   int    `json:"deferred"`    // items held back by the limit
+	Capacity   int    `json:"capacity"`    // slots still free; -1 when unlimited
+}
+
+// planAssignment is the outcome of assignPlanItems
+type planAssignment struct {
+	limited   bool
+	deferred  map[string]bool
+	suggested map[string]string
+	loads     []AssigneeLoad
+}
+
+// assignPlanItems decides which actionable items fit their assignees' WIP
+// limits and suggests owners for unassigned ones. Items are taken in
+// priority order, with work already in progress first since it can't be
+// put back. An unassigned item is suggested to whoever has been assigned the
+// most issues sharing its labels, among people with a free slot; ties go to
+// the less loaded person, then by name. It stays unassigned when nobody
+// with room has touched those labels.
+func (a *Analyzer) assignPlanItems(actionable []model.Issue, opts PlanOptions) planAssignment {
+	out := planAssignment{
+		limited:   opts.limited(),
+		deferred:  make(map[string]bool),
+		suggested: make(map[string]string),
+	}
+
+	actionableSet := make(map[string]bool, len(actionable))
+	for _, issue := range actionable {
+		actionableSet[issue.ID] = true
+	}
+
+	// Label history: how many issues with each label each person has held
+	affinity := make(map[string]map[string]int)
+	loads := make(map[string]*AssigneeLoad)
+	load := func(name string) *AssigneeLoad {
+		l, ok := loads[name]
+		if !ok {
+			l = &AssigneeLoad{Assignee: name, WIPLimit: opts.LimitFor(name)}
+			loads[name] = l
+		}
+		return l
+	}
+	for _, issue := range a.issueMap {
+		if issue.Assignee == "" {
+			continue
+		}
+		for _, label := range uniqueStrings(issue.Labels) {
+			if affinity[label] == nil {
+				affinity[label] = make(map[string]int)
+			}
+			affinity[label][issue.Assignee]++
+		}
+		if issue.Status == model.StatusInProgress && !actionableSet[issue.ID] {
+			load(issue.Assignee).InProgress++
+		}
+	}
+	// peek reads a load without recording the person as involved
+	peek := func(name string) AssigneeLoad {
+		if l, ok := loads[name]; ok {
+			return *l
+		}
+		return AssigneeLoad{Assignee: name, WIPLimit: opts.LimitFor(name)}
+	}
+	hasRoom := func(l AssigneeLoad) bool {
+		return l.WIPLimit <= 0 || l.InProgress+l.Scheduled < l.WIPLimit
+	}
+
+	order := append([]model.Issue(nil), actionable...)
+	sort.SliceStable(order, func(i, j int) bool {
+		ii, jj := order[i].Status == model.StatusInProgress, order[j].Status == model.StatusInProgress
+		if ii != jj {
+			return ii
+		}
+		if order[i].Priority != order[j].Priority {
+			return order[i].Priority < order[j].Priority
+		}
+		return order[i].ID < order[j].ID
+	})
+
+	for _, issue := range order {
+		if issue.Assignee != "" {
+			l := load(issue.Assignee)
+			if issue.Status != model.StatusInProgress && !hasRoom(*l) {
+				out.deferred[issue.ID] = true
+				l.Deferred++
+				continue
+			}
+			l.Scheduled++
+			continue
+		}
+
+		scores := make(map[string]int)
+		for _, label := range uniqueStrings(issue.Labels) {
+			for name, n := range affinity[label] {
+				scores[name] += n
+			}
+		}
+		best := ""
+		for name, score := range scores {
+			l := peek(name)
+			if !hasRoom(l) {
+				continue
+			}
+			if best == "" || score > scores[best] {
+				best = name
+				continue
+			}
+			if score < scores[best] {
+				continue
+			}
+			lb := peek(best)
+			if busy, bestBusy := l.InProgress+l.Scheduled, lb.InProgress+lb.Scheduled; busy < bestBusy || (busy == bestBusy && name < best) {
+				best = name
+			}
+		}
+		if best != "" {
+			out.suggested[issue.ID] = best
+			load(best).Scheduled++
+		}
+	}
+
+	if out.limited {
+		out.loads = make([]AssigneeLoad, 0, len(loads))
+		for _, l := range loads {
+			l.Capacity = -1
+			if l.WIPLimit > 0 {
+				l.Capacity = max(0, l.WIPLimit-l.InProgress-l.Scheduled)
+			}
+			out.loads = append(out.loads, *l)
+		}
+		sort.Slice(out.loads, func(i, j int) bool { return out.loads[i].Assignee < out.loads[j].Assignee })
+	}
+	return out
+}
+
+// markOverWIP flags the track items that don't fit the team-wide WIP limit
+// and returns how many there are. Blocked in-progress issues the plan
+// doesn't list take their slots first; items are then counted in the same
+// order assignPlanItems uses.
+func (a *Analyzer) markOverWIP(tracks []ExecutionTrack, limit int, actionableSet map[string]bool) int {
+	inFlight := 0
+	for id, issue := range a.issueMap {
+		if issue.Status == model.StatusInProgress && !actionableSet[id] {
+			inFlight++
+		}
+	}
+
+	var items []*PlanItem
+	for t := range tracks {
+		for i := range tracks[t].Items {
+			items = append(items, &tracks[t].Items[i])
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		ii := items[i].Status == string(model.StatusInProgress)
+		jj := items[j].Status == string(model.StatusInProgress)
+		if ii != jj {
+			return ii
+		}
+		if items[i].Priority != items[j].Priority {
+			return items[i].Priority < items[j].Priority
+		}
+		return items[i].ID < items[j].ID
+	})
+
+	over := 0
+	for _, item := range items {
+		inFlight++
+		if inFlight > limit {
+			item.OverWIP = true
+			over++
+		}
+	}
+	return over
+}
+
+// chunkTracks splits tracks longer than size into batches. The first batch
+// keeps the track's ID; later ones are suffixed "/2", "/3" and so on.
+func chunkTracks(tracks []ExecutionTrack, size int) []ExecutionTrack {
+	out := make([]ExecutionTrack, 0, len(tracks))
+	for _, track := range tracks {
+		if len(track.Items) <= size {
+			out = append(out, track)
+			continue
+		}
+		batches := (len(track.Items) + size - 1) / size
+		for b := 0; b < batches; b++ {
+			batch := ExecutionTrack{
+				TrackID: track.TrackID,
+				Items:   track.Items[b*size : min((b+1)*size, len(track.Items))],
+				Reason:  fmt.Sprintf("%s (batch %d of %d)", track.Reason, b+1, batches),
+				Batch:   b + 1,
+				Batches: batches,
+			}
+			if b > 0 {
+				batch.TrackID = fmt.Sprintf("%s/%d", track.TrackID, b+1)
+			}
+			out = append(out, batch)
+		}
+	}
+	return out
+}
@@ -0,0 +1,162 @@
+package analysis
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func planItemIDs(plan ExecutionPlan) []string {
+	var ids []string
+	for _, track := range plan.Tracks {
+		for _, item := range track.Items {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+func TestExecutionPlanWIPLimits(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A1", Status: model.StatusOpen, Priority: 1, Assignee: "ann"},
+		{ID: "A2", Status: model.StatusOpen, Priority: 2, Assignee: "ann"},
+		{ID: "A3", Status: model.StatusInProgress, Priority: 3, Assignee: "ann"},
+		{ID: "B1", Status: model.StatusOpen, Priority: 1, Assignee: "bob"},
+		{ID: "B2", Status: model.StatusOpen, Priority: 2, Assignee: "bob"},
+		// bob is already busy on blocked work
+		{ID: "B3", Status: model.StatusInProgress, Priority: 2, Assignee: "bob",
+			Dependencies: []*model.Dependency{{DependsOnID: "X", Type: model.DepBlocks}}},
+		{ID: "X", Status: model.StatusOpen, Priority: 0},
+	}
+	a := NewAnalyzer(issues)
+
+	unlimited := a.GetExecutionPlan()
+	if len(unlimited.Deferred) != 0 || unlimited.Assignees != nil {
+		t.Fatalf("expected no deferrals without limits, got %+v", unlimited.Deferred)
+	}
+
+	plan := a.GetExecutionPlanWithOptions(PlanOptions{WIPLimit: 2, WIPLimits: map[string]int{"bob": 2}})
+
+	// ann: in-progress A3 keeps its slot, A1 takes the other; bob: B3 holds one, B1 the other
+	var deferred []string
+	for _, item := range plan.Deferred {
+		deferred = append(deferred, item.ID)
+	}
+	if want := []string{"A2", "B2"}; !reflect.DeepEqual(deferred, want) {
+		t.Errorf("expected deferred %v, got %v", want, deferred)
+	}
+	for _, id := range planItemIDs(plan) {
+		if id == "A2" || id == "B2" {
+			t.Errorf("deferred item %s still scheduled in tracks", id)
+		}
+	}
+	if plan.TotalActionable != unlimited.TotalActionable {
+		t.Errorf("limits should not change the actionable count: %d vs %d", plan.TotalActionable, unlimited.TotalActionable)
+	}
+
+	want := []AssigneeLoad{
+		{Assignee: "ann", WIPLimit: 2, Scheduled: 2, Deferred: 1, Capacity: 0},
+		{Assignee: "bob", WIPLimit: 2, InProgress: 1, Scheduled: 1, Deferred: 1, Capacity: 0},
+	}
+	if !reflect.DeepEqual(plan.Assignees, want) {
+		t.Errorf("expected loads %+v, got %+v", want, plan.Assignees)
+	}
+}
+
+func TestExecutionPlanSuggestsAssignees(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "H1", Status: model.StatusClosed, Assignee: "ann", Labels: []string{"api"}},
+		{ID: "H2", Status: model.StatusClosed, Assignee: "ann", Labels: []string{"api"}},
+		{ID: "H3", Status: model.StatusClosed, Assignee: "bob", Labels: []string{"api", "ui"}},
+		{ID: "H4", Status: model.StatusClosed, Assignee: "bob", Labels: []string{"ui"}},
+		{ID: "U1", Status: model.StatusOpen, Priority: 1, Labels: []string{"api"}},
+		{ID: "U2", Status: model.StatusOpen, Priority: 2, Labels: []string{"api"}},
+		{ID: "U3", Status: model.StatusOpen, Priority: 3, Labels: []string{"ui"}},
+		{ID: "U4", Status: model.StatusOpen, Priority: 3, Labels: []string{"docs"}},
+	}
+	suggestions := func(plan ExecutionPlan) map[string]string {
+		out := make(map[string]string)
+		for _, track := range plan.Tracks {
+			for _, item := range track.Items {
+				out[item.ID] = item.SuggestedAssignee
+			}
+		}
+		return out
+	}
+
+	got := suggestions(NewAnalyzer(issues).GetExecutionPlan())
+	if want := map[string]string{"U1": "ann", "U2": "ann", "U3": "bob", "U4": ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unlimited: expected %v, got %v", want, got)
+	}
+
+	// With one slot each, ann takes U1 and the next api item goes to bob,
+	// who then has no room left for U3
+	got = suggestions(NewAnalyzer(issues).GetExecutionPlanWithOptions(PlanOptions{WIPLimit: 1}))
+	if want := map[string]string{"U1": "ann", "U2": "bob", "U3": "", "U4": ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("limited: expected %v, got %v", want, got)
+	}
+}
+
+func TestParseWIPLimits(t *testing.T) {
+	got, err := ParseWIPLimits(" ann=2, bob=0,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]int{"ann": 2, "bob": 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	for _, bad := range []string{"ann", "=2", "ann=x", "ann=-1"} {
+		if _, err := ParseWIPLimits(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestExecutionPlanGlobalWIPAndBatches(t *testing.T) {
+	// A-E all block Z, so they form one work stream; Z is already in
+	// progress and takes a team slot while it waits.
+	var deps []*model.Dependency
+	issues := []model.Issue{}
+	for i, id := range []string{"A", "B", "C", "D", "E"} {
+		issues = append(issues, model.Issue{ID: id, Status: model.StatusOpen, Priority: i})
+		deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+	}
+	issues = append(issues, model.Issue{ID: "Z", Status: model.StatusInProgress, Dependencies: deps})
+	a := NewAnalyzer(issues)
+
+	plan := a.GetExecutionPlanWithOptions(PlanOptions{GlobalWIPLimit: 3, MaxTrackSize: 2})
+
+	var ids, batches []string
+	var over []string
+	for _, track := range plan.Tracks {
+		batches = append(batches, track.TrackID)
+		for _, item := range track.Items {
+			ids = append(ids, item.ID)
+			if item.OverWIP {
+				over = append(over, item.ID)
+			}
+		}
+	}
+	if want := []string{"track-A", "track-A/2", "track-A/3"}; !reflect.DeepEqual(batches, want) {
+		t.Fatalf("expected batches %v, got %v", want, batches)
+	}
+	if want := []string{"A", "B", "C", "D", "E"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("batches should keep priority order, got %v", ids)
+	}
+	if plan.Tracks[1].Batch != 2 || plan.Tracks[1].Batches != 3 || !strings.HasSuffix(plan.Tracks[2].Reason, "(batch 3 of 3)") {
+		t.Errorf("unexpected batch metadata: %+v", plan.Tracks)
+	}
+	if want := []string{"C", "D", "E"}; !reflect.DeepEqual(over, want) {
+		t.Errorf("expected %v over the team limit (Z holds one slot), got %v", want, over)
+	}
+	if plan.GlobalWIPLimit != 3 || plan.OverWIP != 3 {
+		t.Errorf("expected limit 3 with 3 over, got %d/%d", plan.GlobalWIPLimit, plan.OverWIP)
+	}
+
+	whole := a.GetExecutionPlan()
+	if len(whole.Tracks) != 1 || whole.Tracks[0].Batch != 0 || whole.OverWIP != 0 {
+		t.Errorf("without options the track stays whole and unflagged: %+v", whole.Tracks)
+	}
+}
@@ -0,0 +1,103 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// chainMinutes returns, for every open issue, its own estimate plus the
+// longest estimated chain of open issues waiting on it: the remaining
+// effort that finishing it first would start (the critical chain). Issues
+// without an estimate count as the median estimate. ok is false when no open
+// issue has an estimate, since chains would then only count issues.
+func (a *Analyzer) chainMinutes() (chains map[string]int, own map[string]int, ok bool) {
+	var open []string
+	for id, issue := range a.issueMap {
+		if isClosedLikeStatus(issue.Status) {
+			continue
+		}
+		open = append(open, id)
+		if issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0 {
+			ok = true
+		}
+	}
+	if !ok {
+		return nil, nil, false
+	}
+	sort.Strings(open)
+
+	all := make([]model.Issue, 0, len(a.issueMap))
+	for _, issue := range a.issueMap {
+		all = append(all, issue)
+	}
+	median := computeMedianEstimatedMinutes(all)
+	own = make(map[string]int, len(open))
+	for _, id := range open {
+		issue := a.issueMap[id]
+		own[id] = median
+		if issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0 {
+			own[id] = *issue.EstimatedMinutes
+		}
+	}
+
+	// Memoized walk over dependents; an issue met again on the current
+	// stack closes a cycle and adds nothing.
+	chains = make(map[string]int, len(open))
+	onStack := make(map[string]bool)
+	var walk func(id string) int
+	walk = func(id string) int {
+		if c, done := chains[id]; done {
+			return c
+		}
+		if onStack[id] {
+			return 0
+		}
+		onStack[id] = true
+		var deps []string
+		it := a.g.To(a.idToNode[id])
+		for it.Next() {
+			if dep := a.nodeToID[it.Node().ID()]; own[dep] > 0 {
+				deps = append(deps, dep)
+			}
+		}
+		sort.Strings(deps)
+		longest := 0
+		for _, dep := range deps {
+			longest = max(longest, walk(dep))
+		}
+		onStack[id] = false
+		chains[id] = own[id] + longest
+		return chains[id]
+	}
+	for _, id := range open {
+		walk(id)
+	}
+	return chains, own, true
+}
+
+// orderTracksByChain fills in each track's estimated effort and puts the
+// track with the longest critical chain first, renaming tracks to match
+// their new order. Ties keep the existing order.
+func (a *Analyzer) orderTracksByChain(tracks []ExecutionTrack) {
+	chains, own, ok := a.chainMinutes()
+	if !ok {
+		return
+	}
+	for t := range tracks {
+		track := &tracks[t]
+		for i := range track.Items {
+			item := &track.Items[i]
+			item.EstimatedMinutes = own[item.ID]
+			item.ChainMinutes = chains[item.ID]
+			track.EstimatedMinutes += item.EstimatedMinutes
+			track.ChainMinutes = max(track.ChainMinutes, item.ChainMinutes)
+		}
+	}
+	sort.SliceStable(tracks, func(i, j int) bool {
+		return tracks[i].ChainMinutes > tracks[j].ChainMinutes
+	})
+	for t := range tracks {
+		tracks[t].TrackID = generateTrackID(t + 1)
+	}
+}
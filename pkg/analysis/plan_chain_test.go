@@ -0,0 +1,53 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestExecutionPlanOrdersTracksByCriticalChain(t *testing.T) {
+	est := func(m int) *int { return &m }
+	blocks := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	// Two independent streams: A1 gates a short follow-up, B1 is quick but
+	// gates a long one. C has no estimate and counts as the median (60).
+	issues := []model.Issue{
+		{ID: "A1", Status: model.StatusOpen, EstimatedMinutes: est(60)},
+		{ID: "A2", Status: model.StatusOpen, EstimatedMinutes: est(60), Dependencies: blocks("A1")},
+		{ID: "B1", Status: model.StatusOpen, EstimatedMinutes: est(30)},
+		{ID: "B2", Status: model.StatusOpen, EstimatedMinutes: est(600), Dependencies: blocks("B1")},
+		{ID: "C", Status: model.StatusOpen},
+		{ID: "D", Status: model.StatusClosed, EstimatedMinutes: est(5000), Dependencies: blocks("A1")},
+	}
+	plan := NewAnalyzer(issues).GetExecutionPlan()
+
+	if len(plan.Tracks) != 3 {
+		t.Fatalf("expected 3 tracks, got %+v", plan.Tracks)
+	}
+	type want struct {
+		id, first       string
+		estimate, chain int
+	}
+	for i, w := range []want{
+		{"track-A", "B1", 30, 630},
+		{"track-B", "A1", 60, 120},
+		{"track-C", "C", 60, 60},
+	} {
+		tr := plan.Tracks[i]
+		if tr.TrackID != w.id || tr.Items[0].ID != w.first || tr.EstimatedMinutes != w.estimate || tr.ChainMinutes != w.chain {
+			t.Errorf("track %d: got %s %s est=%d chain=%d, want %+v",
+				i, tr.TrackID, tr.Items[0].ID, tr.EstimatedMinutes, tr.ChainMinutes, w)
+		}
+	}
+
+	// Without estimates, tracks keep their community order and carry no effort
+	for i := range issues {
+		issues[i].EstimatedMinutes = nil
+	}
+	plain := NewAnalyzer(issues).GetExecutionPlan()
+	if plain.Tracks[0].Items[0].ID != "A1" || plain.Tracks[0].ChainMinutes != 0 || plain.Tracks[0].Items[0].EstimatedMinutes != 0 {
+		t.Errorf("expected unweighted order, got %+v", plain.Tracks[0])
+	}
+}
@@ -0,0 +1,90 @@
+package analysis
+
+import "sort"
+
+// ReachedIssue is one issue found by a dependency walk, with the shortest
+// chain of blocking edges that leads to it
+type ReachedIssue struct {
+	ID    string   `json:"id"`
+	Depth int      `json:"depth"` // edges between the start issue and ID
+	Path  []string `json:"path"`  // start issue first, ID last
+}
+
+// fillAdjacency records each issue's direct blocking dependencies and
+// dependents, sorted, so walks don't need the source graph
+func (a *Analyzer) fillAdjacency(stats *GraphStats) {
+	stats.dependsOn = make(map[string][]string)
+	edges := a.g.Edges()
+	for edges.Next() {
+		e := edges.Edge()
+		from, to := a.nodeToID[e.From().ID()], a.nodeToID[e.To().ID()]
+		stats.dependsOn[from] = append(stats.dependsOn[from], to)
+	}
+	for _, deps := range stats.dependsOn {
+		sort.Strings(deps)
+	}
+	stats.dependents = invertAdjacency(stats.dependsOn)
+}
+
+func invertAdjacency(adj map[string][]string) map[string][]string {
+	inv := make(map[string][]string)
+	for from, tos := range adj {
+		for _, to := range tos {
+			inv[to] = append(inv[to], from)
+		}
+	}
+	for _, froms := range inv {
+		sort.Strings(froms)
+	}
+	return inv
+}
+
+// ReachableFrom returns every issue id transitively depends on, i.e. all that
+// must be done before id can be. BlockedBy walks the other way. Each result
+// carries a shortest path from id (ties broken by ID); results are ordered by
+// depth, then ID. Closed issues are included; nil means id is unknown or has
+// no dependencies.
+func (s *GraphStats) ReachableFrom(id string) []ReachedIssue {
+	return walkAdjacency(s.dependsOn, id)
+}
+
+// BlockedBy returns every issue transitively blocked by id: the issues that
+// depend on it directly or through a chain, i.e. everything finishing id
+// helps unblock. Paths run from id to the dependent; ordering matches
+// ReachableFrom.
+func (s *GraphStats) BlockedBy(id string) []ReachedIssue {
+	return walkAdjacency(s.dependents, id)
+}
+
+// walkAdjacency runs a breadth-first search from start, so the first path to
+// reach an issue is a shortest one
+func walkAdjacency(adj map[string][]string, start string) []ReachedIssue {
+	if len(adj[start]) == 0 {
+		return nil
+	}
+	parent := map[string]string{start: ""}
+	var out []ReachedIssue
+	frontier := []string{start}
+	for depth := 1; len(frontier) > 0; depth++ {
+		var next []string
+		for _, id := range frontier {
+			for _, nb := range adj[id] {
+				if _, seen := parent[nb]; seen {
+					continue
+				}
+				parent[nb] = id
+				next = append(next, nb)
+			}
+		}
+		sort.Strings(next)
+		for _, id := range next {
+			path := make([]string, depth+1)
+			for i, cur := depth, id; i >= 0; i, cur = i-1, parent[cur] {
+				path[i] = cur
+			}
+			out = append(out, ReachedIssue{ID: id, Depth: depth, Path: path})
+		}
+		frontier = next
+	}
+	return out
+}
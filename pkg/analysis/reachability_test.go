@@ -0,0 +1,76 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestGraphStatsReachability(t *testing.T) {
+	blocks := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	// A waits on B and C, both of which wait on D; D waits on E. The related
+	// link from E to A is not a blocking edge.
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen, Dependencies: blocks("C", "B")},
+		{ID: "B", Status: model.StatusOpen, Dependencies: blocks("D")},
+		{ID: "C", Status: model.StatusOpen, Dependencies: blocks("D")},
+		{ID: "D", Status: model.StatusOpen, Dependencies: blocks("E")},
+		{ID: "E", Status: model.StatusClosed,
+			Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepRelated}}},
+	}
+	stats := NewAnalyzer(issues).Analyze()
+
+	wantUp := []ReachedIssue{
+		{ID: "B", Depth: 1, Path: []string{"A", "B"}},
+		{ID: "C", Depth: 1, Path: []string{"A", "C"}},
+		{ID: "D", Depth: 2, Path: []string{"A", "B", "D"}},
+		{ID: "E", Depth: 3, Path: []string{"A", "B", "D", "E"}},
+	}
+	if got := stats.ReachableFrom("A"); !reflect.DeepEqual(got, wantUp) {
+		t.Errorf("ReachableFrom(A): expected %+v, got %+v", wantUp, got)
+	}
+
+	wantDown := []ReachedIssue{
+		{ID: "D", Depth: 1, Path: []string{"E", "D"}},
+		{ID: "B", Depth: 2, Path: []string{"E", "D", "B"}},
+		{ID: "C", Depth: 2, Path: []string{"E", "D", "C"}},
+		{ID: "A", Depth: 3, Path: []string{"E", "D", "B", "A"}},
+	}
+	if got := stats.BlockedBy("E"); !reflect.DeepEqual(got, wantDown) {
+		t.Errorf("BlockedBy(E): expected %+v, got %+v", wantDown, got)
+	}
+
+	if got := stats.ReachableFrom("E"); got != nil {
+		t.Errorf("expected no dependencies for E, got %+v", got)
+	}
+	if got := stats.BlockedBy("missing"); got != nil {
+		t.Errorf("expected nil for unknown ID, got %+v", got)
+	}
+
+	// Cached stats carry the adjacency without the source graph
+	var blob graphStatsCacheBlob
+	blob.DependsOn = stats.dependsOn
+	if got := blob.toGraphStats().BlockedBy("E"); !reflect.DeepEqual(got, wantDown) {
+		t.Errorf("cached BlockedBy(E): expected %+v, got %+v", wantDown, got)
+	}
+}
+
+func TestGraphStatsReachabilityCycle(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Dependencies: []*model.Dependency{{DependsOnID: "B", Type: model.DepBlocks}}},
+		{ID: "B", Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepBlocks}}},
+	}
+	stats := NewAnalyzer(issues).Analyze()
+
+	want := []ReachedIssue{{ID: "B", Depth: 1, Path: []string{"A", "B"}}}
+	if got := stats.ReachableFrom("A"); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected the walk to stop at the cycle, got %+v", got)
+	}
+}
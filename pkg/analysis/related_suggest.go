@@ -0,0 +1,257 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// RelatedSuggestionConfig configures "related" link suggestion generation
+type RelatedSuggestionConfig struct {
+	// LabelWeight is the weight of shared-label Jaccard similarity
+	// Default: 0.35
+	LabelWeight float64
+
+	// TitleWeight is the weight of title keyword Jaccard similarity
+	// Default: 0.4
+	TitleWeight float64
+
+	// NeighborWeight is the weight of common-neighbor Jaccard similarity
+	// Default: 0.25
+	NeighborWeight float64
+
+	// MinConfidence is the minimum combined score to report
+	// Default: 0.4
+	MinConfidence float64
+
+	// MaxSuggestions limits the number of suggestions
+	// Default: 20
+	MaxSuggestions int
+
+	// IncludeClosed considers closed issues as candidates
+	// Default: false
+	IncludeClosed bool
+}
+
+// DefaultRelatedSuggestionConfig returns sensible defaults
+func DefaultRelatedSuggestionConfig() RelatedSuggestionConfig {
+	return RelatedSuggestionConfig{
+		LabelWeight:    0.35,
+		TitleWeight:    0.4,
+		NeighborWeight: 0.25,
+		MinConfidence:  0.4,
+		MaxSuggestions: 20,
+		IncludeClosed:  false,
+	}
+}
+
+// RelatedMatch represents a likely missing "related" link between two issues
+type RelatedMatch struct {
+	From            string   `json:"from"`
+	To              string   `json:"to"`
+	Confidence      float64  `json:"confidence"`
+	SharedLabels    []string `json:"shared_labels,omitempty"`
+	SharedKeywords  []string `json:"shared_keywords,omitempty"`
+	CommonNeighbors []string `json:"common_neighbors,omitempty"`
+	Reason          string   `json:"reason"`
+}
+
+// FindRelatedLinks scores issue pairs by shared labels, title similarity and
+// common graph neighbors, returning pairs that are not linked yet.
+// Candidate pairs are generated from inverted indices so unrelated pairs are
+// never compared.
+func FindRelatedLinks(issues []model.Issue, config RelatedSuggestionConfig) []RelatedMatch {
+	if len(issues) < 2 {
+		return nil
+	}
+
+	idToIndex := make(map[string]int, len(issues))
+	for i, issue := range issues {
+		idToIndex[issue.ID] = i
+	}
+
+	labels := make([]map[string]bool, len(issues))
+	titles := make([]map[string]bool, len(issues))
+	neighbors := make([]map[string]bool, len(issues))
+	for i := range issues {
+		labels[i] = make(map[string]bool, len(issues[i].Labels))
+		for _, l := range issues[i].Labels {
+			labels[i][strings.ToLower(l)] = true
+		}
+		titles[i] = make(map[string]bool)
+		for _, w := range extractKeywords(issues[i].Title, "") {
+			titles[i][w] = true
+		}
+		neighbors[i] = make(map[string]bool)
+	}
+
+	// Neighbors are undirected over every dependency type; any existing
+	// link means the pair is already connected and needs no suggestion.
+	for i, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep == nil {
+				continue
+			}
+			j, ok := idToIndex[dep.DependsOnID]
+			if !ok || j == i {
+				continue
+			}
+			neighbors[i][issues[j].ID] = true
+			neighbors[j][issue.ID] = true
+		}
+	}
+
+	eligible := func(i int) bool {
+		if issues[i].Status.IsTombstone() {
+			return false
+		}
+		return config.IncludeClosed || !isClosedLikeStatus(issues[i].Status)
+	}
+
+	// Inverted index: feature -> issue indices
+	index := make(map[string][]int)
+	for i := range issues {
+		if !eligible(i) {
+			continue
+		}
+		for l := range labels[i] {
+			index["l:"+l] = append(index["l:"+l], i)
+		}
+		for w := range titles[i] {
+			index["t:"+w] = append(index["t:"+w], i)
+		}
+		for n := range neighbors[i] {
+			index["n:"+n] = append(index["n:"+n], i)
+		}
+	}
+
+	var matches []RelatedMatch
+	for i := range issues {
+		if !eligible(i) {
+			continue
+		}
+		candidates := make(map[int]bool)
+		visit := func(key string) {
+			for _, j := range index[key] {
+				if j > i {
+					candidates[j] = true
+				}
+			}
+		}
+		for l := range labels[i] {
+			visit("l:" + l)
+		}
+		for w := range titles[i] {
+			visit("t:" + w)
+		}
+		for n := range neighbors[i] {
+			visit("n:" + n)
+		}
+
+		for j := range candidates {
+			if neighbors[i][issues[j].ID] {
+				continue
+			}
+
+			sharedLabels := sharedSetKeys(labels[i], labels[j])
+			sharedKW := sharedSetKeys(titles[i], titles[j])
+			common := sharedSetKeys(neighbors[i], neighbors[j])
+
+			score := config.LabelWeight*setJaccard(labels[i], labels[j], len(sharedLabels)) +
+				config.TitleWeight*setJaccard(titles[i], titles[j], len(sharedKW)) +
+				config.NeighborWeight*setJaccard(neighbors[i], neighbors[j], len(common))
+			if score > 0.95 {
+				score = 0.95
+			}
+			if score < config.MinConfidence {
+				continue
+			}
+
+			var parts []string
+			if len(sharedLabels) > 0 {
+				parts = append(parts, fmt.Sprintf("%d shared labels", len(sharedLabels)))
+			}
+			if len(sharedKW) > 0 {
+				parts = append(parts, fmt.Sprintf("%d shared title keywords", len(sharedKW)))
+			}
+			if len(common) > 0 {
+				parts = append(parts, fmt.Sprintf("%d common neighbors", len(common)))
+			}
+
+			from, to := issues[i].ID, issues[j].ID
+			if to < from {
+				from, to = to, from
+			}
+			matches = append(matches, RelatedMatch{
+				From:            from,
+				To:              to,
+				Confidence:      score,
+				SharedLabels:    sharedLabels,
+				SharedKeywords:  sharedKW,
+				CommonNeighbors: common,
+				Reason:          strings.Join(parts, ", "),
+			})
+		}
+	}
+
+	sort.Slice(matches, func(a, b int) bool {
+		if matches[a].Confidence != matches[b].Confidence {
+			return matches[a].Confidence > matches[b].Confidence
+		}
+		if matches[a].From != matches[b].From {
+			return matches[a].From < matches[b].From
+		}
+		return matches[a].To < matches[b].To
+	})
+	if config.MaxSuggestions > 0 && len(matches) > config.MaxSuggestions {
+		matches = matches[:config.MaxSuggestions]
+	}
+	return matches
+}
+
+// DetectRelatedLinks suggests likely missing "related" links between issues
+func DetectRelatedLinks(issues []model.Issue, config RelatedSuggestionConfig) []Suggestion {
+	matches := FindRelatedLinks(issues, config)
+	suggestions := make([]Suggestion, 0, len(matches))
+	for _, match := range matches {
+		sug := NewSuggestion(
+			SuggestionRelatedLink,
+			match.From,
+			fmt.Sprintf("May be related to %s", match.To),
+			match.Reason,
+			match.Confidence,
+		).WithRelatedBead(match.To).
+			WithAction(fmt.Sprintf("bd dep add %s %s --type=related", match.From, match.To))
+
+		if len(match.SharedLabels) > 0 {
+			sug = sug.WithMetadata("shared_labels", match.SharedLabels)
+		}
+		if len(match.SharedKeywords) > 0 {
+			sug = sug.WithMetadata("shared_keywords", match.SharedKeywords)
+		}
+		if len(match.CommonNeighbors) > 0 {
+			sug = sug.WithMetadata("common_neighbors", match.CommonNeighbors)
+		}
+
+		suggestions = append(suggestions, sug)
+	}
+	return suggestions
+}
+
+// sharedSetKeys returns the sorted keys present in both sets
+func sharedSetKeys(a, b map[string]bool) []string {
+	shared := findSharedKeys(a, b)
+	sort.Strings(shared)
+	return shared
+}
+
+// setJaccard returns |A∩B| / |A∪B| given the precomputed intersection size
+func setJaccard(a, b map[string]bool, intersection int) float64 {
+	union := len(a) + len(b) - intersection
+	if union == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(union)
+}
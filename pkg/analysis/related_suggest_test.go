@@ -0,0 +1,86 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestFindRelatedLinks_SharedLabelsAndTitle(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Login session timeout", Status: model.StatusOpen, Labels: []string{"auth", "backend"}},
+		{ID: "B", Title: "Session timeout on login page", Status: model.StatusOpen, Labels: []string{"auth", "backend"}},
+		{ID: "C", Title: "Update billing invoices", Status: model.StatusOpen, Labels: []string{"billing"}},
+	}
+
+	matches := FindRelatedLinks(issues, DefaultRelatedSuggestionConfig())
+	if len(matches) != 1 {
+		t.Fatalf("expected 1 match, got %d: %+v", len(matches), matches)
+	}
+	m := matches[0]
+	if m.From != "A" || m.To != "B" {
+		t.Errorf("expected A-B, got %s-%s", m.From, m.To)
+	}
+	if len(m.SharedLabels) != 2 {
+		t.Errorf("expected 2 shared labels, got %v", m.SharedLabels)
+	}
+	if m.Confidence < 0.4 || m.Confidence > 0.95 {
+		t.Errorf("confidence out of range: %f", m.Confidence)
+	}
+}
+
+func TestFindRelatedLinks_CommonNeighbors(t *testing.T) {
+	blocks := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		{ID: "root", Title: "Shared schema", Status: model.StatusOpen},
+		{ID: "x", Title: "Importer", Status: model.StatusOpen, Labels: []string{"data"}, Dependencies: blocks("root")},
+		{ID: "y", Title: "Exporter", Status: model.StatusOpen, Labels: []string{"data"}, Dependencies: blocks("root")},
+	}
+
+	matches := FindRelatedLinks(issues, DefaultRelatedSuggestionConfig())
+	if len(matches) != 1 || matches[0].From != "x" || matches[0].To != "y" {
+		t.Fatalf("expected x-y suggestion, got %+v", matches)
+	}
+	if len(matches[0].CommonNeighbors) != 1 || matches[0].CommonNeighbors[0] != "root" {
+		t.Errorf("expected common neighbor root, got %v", matches[0].CommonNeighbors)
+	}
+}
+
+func TestFindRelatedLinks_SkipsLinkedAndClosed(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Cache invalidation bug", Status: model.StatusOpen, Labels: []string{"cache"}},
+		{ID: "B", Title: "Cache invalidation race", Status: model.StatusOpen, Labels: []string{"cache"},
+			Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepRelated}}},
+		{ID: "C", Title: "Cache invalidation cleanup", Status: model.StatusClosed, Labels: []string{"cache"}},
+	}
+
+	if matches := FindRelatedLinks(issues, DefaultRelatedSuggestionConfig()); len(matches) != 0 {
+		t.Fatalf("expected no matches, got %+v", matches)
+	}
+
+	config := DefaultRelatedSuggestionConfig()
+	config.IncludeClosed = true
+	if matches := FindRelatedLinks(issues, config); len(matches) == 0 {
+		t.Fatal("expected closed issue to be considered with IncludeClosed")
+	}
+}
+
+func TestDetectRelatedLinks_Suggestions(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Login session timeout", Status: model.StatusOpen, Labels: []string{"auth"}},
+		{ID: "B", Title: "Login session refresh", Status: model.StatusOpen, Labels: []string{"auth"}},
+	}
+
+	sugs := DetectRelatedLinks(issues, DefaultRelatedSuggestionConfig())
+	if len(sugs) != 1 {
+		t.Fatalf("expected 1 suggestion, got %d", len(sugs))
+	}
+	if sugs[0].Type != SuggestionRelatedLink {
+		t.Errorf("expected type %s, got %s", SuggestionRelatedLink, sugs[0].Type)
+	}
+	if sugs[0].ActionCommand != "bd dep add A B --type=related" {
+		t.Errorf("unexpected action: %s", sugs[0].ActionCommand)
+	}
+}
@@ -0,0 +1,162 @@
+package analysis
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// ReportSchemaVersion is the version of the AnalysisReport layout. It is
+// bumped when a field is removed or changes meaning; new fields keep it.
+const ReportSchemaVersion = 1
+
+// AnalysisReport is the stable JSON form of an analysis for dashboards and CI
+// checks. Issues are sorted by ID and every list is present (possibly empty),
+// so identical inputs marshal to identical bytes.
+type AnalysisReport struct {
+	Schema  string `json:"schema"` // always "beads_viewer.analysis"
+	Version int    `json:"version"`
+
+	Graph ReportGraph `json:"graph"`
+	// Metrics records whether each Phase 2 metric was computed, approximated,
+	// timed out or skipped (without timings, which vary run to run); per-issue
+	// values of a metric that was skipped or timed out are zero
+	Metrics map[string]statusEntry `json:"metrics"`
+	Issues  []ReportIssueMetrics   `json:"issues"`
+
+	Cycles [][]string `json:"cycles"`
+	// CriticalPath follows the critical-path scores from the deepest blocker
+	// to the last issue it gates (closed issues included; ComputeCriticalPath
+	// gives the open-work path)
+	CriticalPath []string `json:"critical_path"`
+
+	Plan *ExecutionPlan `json:"plan,omitempty"`
+}
+
+// ReportGraph summarizes the dependency graph
+type ReportGraph struct {
+	Nodes      int     `json:"nodes"`
+	Edges      int     `json:"edges"`
+	Density    float64 `json:"density"`
+	Modularity float64 `json:"modularity"`
+	Acyclic    bool    `json:"acyclic"` // a topological order exists
+}
+
+// ReportIssueMetrics is one issue's row of the report
+type ReportIssueMetrics struct {
+	ID           string  `json:"id"`
+	InDegree     int     `json:"in_degree"`  // issues that depend on it
+	OutDegree    int     `json:"out_degree"` // issues it depends on
+	PageRank     float64 `json:"pagerank"`
+	Betweenness  float64 `json:"betweenness"`
+	Eigenvector  float64 `json:"eigenvector"`
+	Hub          float64 `json:"hub"`
+	Authority    float64 `json:"authority"`
+	CriticalPath float64 `json:"critical_path"`
+	CoreNumber   int     `json:"core_number"`
+	Slack        float64 `json:"slack"`
+	Articulation bool    `json:"articulation"`
+	Community    int     `json:"community"`
+}
+
+// MarshalReport builds the AnalysisReport for stats, waiting for Phase 2 to
+// finish, and encodes it as indented JSON. plan may be nil to leave the
+// execution plan out.
+func MarshalReport(stats *GraphStats, plan *ExecutionPlan) ([]byte, error) {
+	return json.MarshalIndent(NewAnalysisReport(stats, plan), "", "  ")
+}
+
+// NewAnalysisReport is MarshalReport without the encoding, for callers that
+// embed the report in a larger document
+func NewAnalysisReport(stats *GraphStats, plan *ExecutionPlan) AnalysisReport {
+	stats.WaitForPhase2()
+	status := stats.Status()
+
+	r := AnalysisReport{
+		Schema:  "beads_viewer.analysis",
+		Version: ReportSchemaVersion,
+		Graph: ReportGraph{
+			Nodes:      stats.NodeCount,
+			Edges:      stats.EdgeCount,
+			Density:    stats.Density,
+			Modularity: stats.Modularity(),
+			Acyclic:    len(stats.TopologicalOrder) == len(stats.OutDegree),
+		},
+		Metrics: map[string]statusEntry{
+			"pagerank":      status.PageRank,
+			"betweenness":   status.Betweenness,
+			"eigenvector":   status.Eigenvector,
+			"hits":          status.HITS,
+			"critical_path": status.Critical,
+			"cycles":        status.Cycles,
+			"kcore":         status.KCore,
+			"articulation":  status.Articulation,
+			"slack":         status.Slack,
+			"communities":   status.Communities,
+		},
+		Issues:       make([]ReportIssueMetrics, 0, len(stats.OutDegree)),
+		Cycles:       stats.Cycles(),
+		CriticalPath: reportCriticalPath(stats),
+		Plan:         plan,
+	}
+	for name, entry := range r.Metrics {
+		entry.Elapsed = 0
+		r.Metrics[name] = entry
+	}
+	if r.Cycles == nil {
+		r.Cycles = [][]string{}
+	}
+	if r.Graph.Nodes == 0 {
+		r.Graph.Nodes = len(stats.OutDegree)
+	}
+
+	ids := make([]string, 0, len(stats.OutDegree))
+	for id := range stats.OutDegree {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		row := ReportIssueMetrics{
+			ID:           id,
+			InDegree:     stats.InDegree[id],
+			OutDegree:    stats.OutDegree[id],
+			PageRank:     stats.GetPageRankScore(id),
+			Betweenness:  stats.GetBetweennessScore(id),
+			Eigenvector:  stats.GetEigenvectorScore(id),
+			Hub:          stats.GetHubScore(id),
+			Authority:    stats.GetAuthorityScore(id),
+			CriticalPath: stats.GetCriticalPathScore(id),
+		}
+		row.CoreNumber, _ = stats.CoreNumberValue(id)
+		row.Slack, _ = stats.SlackValue(id)
+		row.Articulation, _ = stats.IsArticulationPoint(id)
+		row.Community, _ = stats.CommunityOf(id)
+		r.Issues = append(r.Issues, row)
+	}
+	return r
+}
+
+// reportCriticalPath starts at the highest critical-path score and steps to
+// the dependent one score lower until the chain ends, ties going to the
+// smallest ID
+func reportCriticalPath(stats *GraphStats) []string {
+	scores := stats.CriticalPathScore()
+	path := []string{}
+	cur, best := "", 0.0
+	for id, s := range scores {
+		if s > best || (s == best && id < cur) {
+			cur, best = id, s
+		}
+	}
+	for cur != "" {
+		path = append(path, cur)
+		next := ""
+		for _, dep := range stats.dependents[cur] {
+			if scores[dep] == scores[cur]-1 {
+				next = dep
+				break
+			}
+		}
+		cur = next
+	}
+	return path
+}
@@ -0,0 +1,77 @@
+package analysis
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestMarshalReport(t *testing.T) {
+	blocks := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	// C waits on B, which waits on A; D stands alone.
+	issues := []model.Issue{
+		{ID: "D", Status: model.StatusOpen},
+		{ID: "C", Status: model.StatusOpen, Dependencies: blocks("B")},
+		{ID: "B", Status: model.StatusOpen, Dependencies: blocks("A")},
+		{ID: "A", Status: model.StatusOpen},
+	}
+	a := NewAnalyzer(issues)
+	stats := a.Analyze()
+	plan := a.GetExecutionPlan()
+
+	data, err := MarshalReport(&stats, &plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, _ := MarshalReport(&stats, &plan)
+	if !bytes.Equal(data, again) {
+		t.Error("report is not byte-stable across calls")
+	}
+
+	var r AnalysisReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Schema != "beads_viewer.analysis" || r.Version != ReportSchemaVersion {
+		t.Errorf("schema = %q v%d", r.Schema, r.Version)
+	}
+	if r.Graph.Nodes != 4 || r.Graph.Edges != 2 || !r.Graph.Acyclic {
+		t.Errorf("graph = %+v", r.Graph)
+	}
+	var ids []string
+	for _, row := range r.Issues {
+		ids = append(ids, row.ID)
+	}
+	if want := []string{"A", "B", "C", "D"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("issues = %v, want sorted %v", ids, want)
+	}
+	if r.Issues[0].InDegree != 1 || r.Issues[0].PageRank <= r.Issues[3].PageRank {
+		t.Errorf("A should be depended on and outrank D: %+v", r.Issues[0])
+	}
+	if want := []string{"A", "B", "C"}; !reflect.DeepEqual(r.CriticalPath, want) {
+		t.Errorf("critical path = %v, want %v", r.CriticalPath, want)
+	}
+	if r.Cycles == nil || len(r.Cycles) != 0 {
+		t.Errorf("cycles = %v, want empty list", r.Cycles)
+	}
+	if r.Plan == nil || r.Plan.TotalActionable != 2 {
+		t.Errorf("plan = %+v, want A and D actionable", r.Plan)
+	}
+	if !bytes.Contains(data, []byte(`"pagerank": {`)) {
+		t.Error("metrics should report the pagerank status")
+	}
+
+	noPlan, _ := MarshalReport(&stats, nil)
+	if bytes.Contains(noPlan, []byte(`"plan"`)) {
+		t.Error("nil plan should be omitted")
+	}
+}
@@ -0,0 +1,225 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+)
+
+// riskDepthSaturation is the blocker chain length that counts as fully risky
+const riskDepthSaturation = 5
+
+// RiskScoreWeights weights the factors of RiskScores. They are relative: the
+// score divides by their sum, so {2, 1, 1, 0} and {0.5, 0.25, 0.25, 0} rank
+// the same. Negative weights count as zero.
+type RiskScoreWeights struct {
+	BlockerDepth float64 `json:"blocker_depth"`
+	Staleness    float64 `json:"staleness"`
+	Priority     float64 `json:"priority"`
+	BusFactor    float64 `json:"bus_factor"`
+}
+
+// DefaultRiskScoreWeights leans on what stands between an issue and done
+func DefaultRiskScoreWeights() RiskScoreWeights {
+	return RiskScoreWeights{BlockerDepth: 0.3, Staleness: 0.25, Priority: 0.25, BusFactor: 0.2}
+}
+
+// RecipeRiskScoreWeights returns the recipe's risk weights, with the defaults
+// for any it leaves out
+func RecipeRiskScoreWeights(r *recipe.Recipe) RiskScoreWeights {
+	w := DefaultRiskScoreWeights()
+	if r == nil || r.Risk == nil {
+		return w
+	}
+	for _, o := range []struct {
+		set *float64
+		dst *float64
+	}{
+		{r.Risk.BlockerDepth, &w.BlockerDepth},
+		{r.Risk.Staleness, &w.Staleness},
+		{r.Risk.Priority, &w.Priority},
+		{r.Risk.BusFactor, &w.BusFactor},
+	} {
+		if o.set != nil {
+			*o.dst = *o.set
+		}
+	}
+	return w
+}
+
+// RiskScoreFactors are the 0-1 inputs to an issue's risk score
+type RiskScoreFactors struct {
+	// BlockerDepth grows with the longest chain of open blockers beneath the
+	// issue, saturating at five
+	BlockerDepth float64 `json:"blocker_depth"`
+	// Staleness is time since the last update, saturating at 30 days
+	Staleness float64 `json:"staleness"`
+	// Priority is 1 for P0 down to 0 for P4
+	Priority float64 `json:"priority"`
+	// BusFactor is 1 on a single-owner blocking chain, or the owner's share
+	// of an epic that one person carries; 0 otherwise
+	BusFactor float64 `json:"bus_factor"`
+}
+
+// IssueRisk is one entry of the RiskScores ranking
+type IssueRisk struct {
+	ID       string           `json:"id"`
+	Title    string           `json:"title"`
+	Assignee string           `json:"assignee,omitempty"`
+	Score    float64          `json:"score"` // 0-1
+	Factors  RiskScoreFactors `json:"factors"`
+	Depth    int              `json:"blocker_depth"`    // open issues on the longest blocker chain
+	Driver   string           `json:"driver,omitempty"` // factor contributing most to Score
+}
+
+// RiskScores ranks open issues by how likely they are to slip, blending how
+// deep they sit behind open blockers, how stale they are, how urgent they are
+// and whether they hang on one person. Highest risk comes first; ties go to
+// the more urgent issue, then by ID. All-zero weights fall back to the
+// defaults.
+func RiskScores(issues []model.Issue, now time.Time, w RiskScoreWeights) []IssueRisk {
+	w.BlockerDepth, w.Staleness = max(0, w.BlockerDepth), max(0, w.Staleness)
+	w.Priority, w.BusFactor = max(0, w.Priority), max(0, w.BusFactor)
+	total := w.BlockerDepth + w.Staleness + w.Priority + w.BusFactor
+	if total == 0 {
+		w = DefaultRiskScoreWeights()
+		total = 1
+	}
+
+	a := NewAnalyzer(issues)
+	depth := a.openBlockerDepths()
+	bus := a.busFactorExposure()
+
+	risks := []IssueRisk{}
+	for _, iss := range issues {
+		if isClosedLikeStatus(iss.Status) {
+			continue
+		}
+		r := IssueRisk{
+			ID:       iss.ID,
+			Title:    iss.Title,
+			Assignee: iss.Assignee,
+			Depth:    depth[iss.ID],
+			Factors: RiskScoreFactors{
+				BlockerDepth: float64(min(depth[iss.ID], riskDepthSaturation)) / riskDepthSaturation,
+				Staleness:    computeStaleness(iss.UpdatedAt, now),
+				Priority:     computePriorityBoost(iss.Priority),
+				BusFactor:    bus[iss.ID],
+			},
+		}
+
+		contributions := []struct {
+			name  string
+			value float64
+		}{
+			{"blocker_depth", w.BlockerDepth * r.Factors.BlockerDepth},
+			{"staleness", w.Staleness * r.Factors.Staleness},
+			{"priority", w.Priority * r.Factors.Priority},
+			{"bus_factor", w.BusFactor * r.Factors.BusFactor},
+		}
+		best := 0.0
+		for _, c := range contributions {
+			r.Score += c.value
+			if c.value > best {
+				best, r.Driver = c.value, c.name
+			}
+		}
+		r.Score /= total
+		risks = append(risks, r)
+	}
+
+	priority := make(map[string]int, len(issues))
+	for _, iss := range issues {
+		priority[iss.ID] = iss.Priority
+	}
+	sort.Slice(risks, func(i, j int) bool {
+		if risks[i].Score != risks[j].Score {
+			return risks[i].Score > risks[j].Score
+		}
+		if pi, pj := priority[risks[i].ID], priority[risks[j].ID]; pi != pj {
+			return pi < pj
+		}
+		return risks[i].ID < risks[j].ID
+	})
+	return risks
+}
+
+// RiskScoreMap indexes a RiskScores ranking by issue ID
+func RiskScoreMap(risks []IssueRisk) map[string]float64 {
+	scores := make(map[string]float64, len(risks))
+	for _, r := range risks {
+		scores[r.ID] = r.Score
+	}
+	return scores
+}
+
+// openBlockerDepths returns, per open issue, the number of open issues on the
+// longest chain of blocking dependencies beneath it. Edges closing a cycle
+// are ignored.
+func (a *Analyzer) openBlockerDepths() map[string]int {
+	open := func(n int64) bool {
+		return !isClosedLikeStatus(a.issueMap[a.nodeToID[n]].Status)
+	}
+	depth := make(map[int64]int)
+	onStack := make(map[int64]bool)
+	var walk func(int64) int
+	walk = func(n int64) int {
+		if d, ok := depth[n]; ok {
+			return d
+		}
+		onStack[n] = true
+		best := 0
+		to := a.g.From(n)
+		for to.Next() {
+			v := to.Node().ID()
+			if open(v) && !onStack[v] {
+				best = max(best, walk(v)+1)
+			}
+		}
+		onStack[n] = false
+		depth[n] = best
+		return best
+	}
+
+	out := make(map[string]int)
+	ids := make([]string, 0, len(a.idToNode))
+	for id := range a.idToNode {
+		ids = append(ids, id)
+	}
+	// Visit in ID order so cycles are broken the same way every run
+	sort.Strings(ids)
+	for _, id := range ids {
+		if n := a.idToNode[id]; open(n) {
+			out[id] = walk(n)
+		}
+	}
+	return out
+}
+
+// busFactorExposure returns, per open issue, how much it depends on a single
+// person: 1 on a single-owner chain, otherwise its owner's share of any epic
+// containing it that has a bus factor of 1
+func (a *Analyzer) busFactorExposure() map[string]float64 {
+	exposure := make(map[string]float64)
+	for _, chain := range a.singleOwnerChains() {
+		for _, id := range chain.IssueIDs {
+			exposure[id] = 1
+		}
+	}
+	for id, members := range a.epicMembers() {
+		c, ok := a.ownershipConcentration(id, "", members)
+		if !ok || c.BusFactor != 1 {
+			continue
+		}
+		owner := c.Assignees[0].Assignee
+		for _, m := range members {
+			iss := a.issueMap[m]
+			if iss.Assignee == owner && !isClosedLikeStatus(iss.Status) {
+				exposure[m] = max(exposure[m], c.TopShare)
+			}
+		}
+	}
+	return exposure
+}
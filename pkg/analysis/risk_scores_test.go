@@ -0,0 +1,69 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+)
+
+func TestRiskScores(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	blockedBy := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		// A chain C -> B -> A all held by ann
+		{ID: "A", Status: model.StatusOpen, Priority: 2, Assignee: "ann", UpdatedAt: now},
+		{ID: "B", Status: model.StatusOpen, Priority: 2, Assignee: "ann", UpdatedAt: now, Dependencies: blockedBy("A")},
+		{ID: "C", Status: model.StatusOpen, Priority: 0, Assignee: "ann", UpdatedAt: now.AddDate(0, 0, -30), Dependencies: blockedBy("B")},
+		{ID: "D", Status: model.StatusOpen, Priority: 4, Assignee: "bob", UpdatedAt: now},
+		{ID: "done", Status: model.StatusClosed, Priority: 0},
+	}
+
+	risks := RiskScores(issues, now, DefaultRiskScoreWeights())
+	if len(risks) != 4 {
+		t.Fatalf("expected 4 open issues ranked, got %d", len(risks))
+	}
+	byID := make(map[string]IssueRisk)
+	for _, r := range risks {
+		byID[r.ID] = r
+	}
+
+	c := byID["C"]
+	if c.Depth != 2 || c.Factors.BlockerDepth != 0.4 || c.Factors.Staleness != 1 || c.Factors.Priority != 1 || c.Factors.BusFactor != 1 {
+		t.Errorf("unexpected factors for C: depth %d, %+v", c.Depth, c.Factors)
+	}
+	if want := 0.3*0.4 + 0.25 + 0.25 + 0.2; math.Abs(c.Score-want) > 1e-9 {
+		t.Errorf("C: expected score %.3f, got %.3f", want, c.Score)
+	}
+	if risks[0].ID != "C" || risks[len(risks)-1].ID != "D" {
+		t.Errorf("expected C first and D last, got %s ... %s", risks[0].ID, risks[len(risks)-1].ID)
+	}
+	if d := byID["D"]; d.Score != 0 || d.Driver != "" {
+		t.Errorf("D: expected zero risk and no driver, got %.3f (%q)", d.Score, d.Driver)
+	}
+
+	// Weighting only priority reorders by urgency and drives every score
+	only := 1.0
+	zero := 0.0
+	r := &recipe.Recipe{Risk: &recipe.RiskConfig{Priority: &only, BlockerDepth: &zero, Staleness: &zero, BusFactor: &zero}}
+	w := RecipeRiskScoreWeights(r)
+	if w != (RiskScoreWeights{Priority: 1}) {
+		t.Fatalf("unexpected recipe weights %+v", w)
+	}
+	risks = RiskScores(issues, now, w)
+	if risks[0].ID != "C" || risks[0].Score != 1 || risks[0].Driver != "priority" {
+		t.Errorf("expected C at 1.0 driven by priority, got %+v", risks[0])
+	}
+	if risks[1].ID != "A" || risks[1].Score != 0.5 {
+		t.Errorf("expected A second at 0.5 (tie with B broken by ID), got %+v", risks[1])
+	}
+
+	// Omitted recipe weights keep their defaults
+	if got := RecipeRiskScoreWeights(&recipe.Recipe{Risk: &recipe.RiskConfig{Priority: &zero}}); got.Staleness != 0.25 || got.Priority != 0 {
+		t.Errorf("expected defaults for omitted weights, got %+v", got)
+	}
+}
@@ -0,0 +1,120 @@
+package analysis
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// StronglyConnectedComponent is one super-node of the condensed dependency
+// graph: a set of issues that all (transitively) depend on each other, or a
+// single issue outside any cycle.
+type StronglyConnectedComponent struct {
+	ID        string   `json:"id"`         // smallest member ID, stable across runs
+	Members   []string `json:"members"`    // sorted
+	DependsOn []string `json:"depends_on"` // IDs of the components this one depends on, sorted
+	Cyclic    bool     `json:"cyclic"`     // more than one member, or a self-dependency
+}
+
+// Condensation is the dependency graph with each cycle collapsed into one
+// node. It is always acyclic, so it can be laid out or scheduled where the
+// raw graph cannot.
+type Condensation struct {
+	// Components lists every component, dependencies before their
+	// dependents, ties broken by ID
+	Components []StronglyConnectedComponent `json:"components"`
+	// ComponentOf maps each issue ID to the ID of its component
+	ComponentOf map[string]string `json:"component_of"`
+}
+
+// computeCondensation turns Tarjan's components into the condensed graph
+func (a *Analyzer) computeCondensation(sccs [][]graph.Node) *Condensation {
+	out := &Condensation{
+		Components:  make([]StronglyConnectedComponent, 0, len(sccs)),
+		ComponentOf: make(map[string]string, len(a.nodeToID)),
+	}
+
+	byID := make(map[string]*StronglyConnectedComponent, len(sccs))
+	comps := make([]StronglyConnectedComponent, len(sccs))
+	for i, scc := range sccs {
+		c := &comps[i]
+		for _, n := range scc {
+			c.Members = append(c.Members, a.nodeToID[n.ID()])
+		}
+		sort.Strings(c.Members)
+		c.ID = c.Members[0]
+		c.DependsOn = []string{}
+		c.Cyclic = len(scc) > 1 || a.g.HasEdgeFromTo(scc[0].ID(), scc[0].ID())
+		for _, id := range c.Members {
+			out.ComponentOf[id] = c.ID
+		}
+		byID[c.ID] = c
+	}
+
+	// Edges between components; pending counts unresolved dependencies
+	dependents := make(map[string][]string)
+	pending := make(map[string]int, len(comps))
+	for i := range comps {
+		c := &comps[i]
+		seen := make(map[string]bool)
+		for _, member := range c.Members {
+			to := a.g.From(a.idToNode[member])
+			for to.Next() {
+				dep := out.ComponentOf[a.nodeToID[to.Node().ID()]]
+				if dep == c.ID || seen[dep] {
+					continue
+				}
+				seen[dep] = true
+				c.DependsOn = append(c.DependsOn, dep)
+				dependents[dep] = append(dependents[dep], c.ID)
+			}
+		}
+		sort.Strings(c.DependsOn)
+		pending[c.ID] = len(c.DependsOn)
+	}
+
+	var ready []string
+	for i := range comps {
+		if pending[comps[i].ID] == 0 {
+			ready = append(ready, comps[i].ID)
+		}
+	}
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		id := ready[0]
+		ready = ready[1:]
+		out.Components = append(out.Components, *byID[id])
+		for _, d := range dependents[id] {
+			pending[d]--
+			if pending[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+	return out
+}
+
+// SCCs returns the strongly connected components of the dependency graph as a
+// condensation: each cycle collapsed into one super-node, with the mapping
+// back to member issue IDs. It is computed alongside Cycles; the zero value
+// is returned until Phase 2 completes or when cycle detection is skipped.
+func (s *GraphStats) SCCs() Condensation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.sccs == nil {
+		return Condensation{}
+	}
+	cp := Condensation{
+		Components:  make([]StronglyConnectedComponent, len(s.sccs.Components)),
+		ComponentOf: make(map[string]string, len(s.sccs.ComponentOf)),
+	}
+	for i, c := range s.sccs.Components {
+		c.Members = append([]string(nil), c.Members...)
+		c.DependsOn = append([]string{}, c.DependsOn...)
+		cp.Components[i] = c
+	}
+	for k, v := range s.sccs.ComponentOf {
+		cp.ComponentOf[k] = v
+	}
+	return cp
+}
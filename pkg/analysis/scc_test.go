@@ -0,0 +1,50 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestGraphStatsSCCs(t *testing.T) {
+	blocks := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	// B <-> C form a cycle; A waits on it, and it waits on D. E depends on
+	// itself.
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen, Dependencies: blocks("B")},
+		{ID: "B", Status: model.StatusOpen, Dependencies: blocks("C")},
+		{ID: "C", Status: model.StatusOpen, Dependencies: blocks("B", "D")},
+		{ID: "D", Status: model.StatusOpen},
+		{ID: "E", Status: model.StatusOpen, Dependencies: blocks("E")},
+	}
+	stats := NewAnalyzer(issues).Analyze()
+	got := stats.SCCs()
+
+	want := []StronglyConnectedComponent{
+		{ID: "D", Members: []string{"D"}, DependsOn: []string{}},
+		{ID: "B", Members: []string{"B", "C"}, DependsOn: []string{"D"}, Cyclic: true},
+		{ID: "A", Members: []string{"A"}, DependsOn: []string{"B"}},
+		{ID: "E", Members: []string{"E"}, DependsOn: []string{}, Cyclic: true},
+	}
+	if !reflect.DeepEqual(got.Components, want) {
+		t.Errorf("expected components %+v, got %+v", want, got.Components)
+	}
+	wantOf := map[string]string{"A": "A", "B": "B", "C": "B", "D": "D", "E": "E"}
+	if !reflect.DeepEqual(got.ComponentOf, wantOf) {
+		t.Errorf("expected mapping %v, got %v", wantOf, got.ComponentOf)
+	}
+
+	got.Components[1].Members[0] = "mutated"
+	got.ComponentOf["C"] = "mutated"
+	again := stats.SCCs()
+	if again.Components[1].Members[0] != "B" || again.ComponentOf["C"] != "B" {
+		t.Error("SCCs should return a copy")
+	}
+}
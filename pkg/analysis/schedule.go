@@ -0,0 +1,208 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ScheduleOptions configures ComputeSchedule
+type ScheduleOptions struct {
+	// Agents is the number of parallel workers (default 1)
+	Agents int
+
+	// Now anchors the schedule (default time.Now())
+	Now time.Time
+}
+
+// ScheduledIssue is one open issue placed on the projected timeline
+type ScheduledIssue struct {
+	ID               string     `json:"id"`
+	Title            string     `json:"title"`
+	Status           string     `json:"status"`
+	Priority         int        `json:"priority"`
+	EstimatedMinutes int        `json:"estimated_minutes"`
+	Start            time.Time  `json:"start"`
+	Finish           time.Time  `json:"finish"`
+	Agent            int        `json:"agent"`              // 1-based worker lane
+	Layer            int        `json:"layer"`              // 0-based dependency depth (see TopoLayers)
+	Blockers         []string   `json:"blockers,omitempty"` // open blockers, all scheduled earlier
+	DueDate          *time.Time `json:"due_date,omitempty"`
+}
+
+// Schedule is a projected timeline of open work
+type Schedule struct {
+	Now                   time.Time        `json:"now"`
+	Agents                int              `json:"agents"`
+	VelocityMinutesPerDay float64          `json:"velocity_minutes_per_day"`
+	Items                 []ScheduledIssue `json:"items"`       // in start order
+	Unscheduled           []string         `json:"unscheduled"` // open issues TopoLayers cannot order (blocking cycles)
+}
+
+// ComputeSchedule lays all open issues out in dependency order across the
+// given number of agents, using the same durations and list scheduling as
+// ComputeCutLine.
+func ComputeSchedule(issues []model.Issue, opts ScheduleOptions) Schedule {
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	agents := opts.Agents
+	if agents <= 0 {
+		agents = 1
+	}
+
+	open := make(map[string]*model.Issue, len(issues))
+	scope := make(map[string]bool, len(issues))
+	openIssues := make([]model.Issue, 0, len(issues))
+	for i := range issues {
+		if !isClosedLikeStatus(issues[i].Status) {
+			open[issues[i].ID] = &issues[i]
+			scope[issues[i].ID] = true
+			openIssues = append(openIssues, issues[i])
+		}
+	}
+	layers := TopoLayers(openIssues)
+
+	median := computeMedianEstimatedMinutes(issues)
+	velocity := scheduleVelocity(issues, now, median)
+	sched := listSchedule(open, scope, agents, velocity, median)
+
+	out := Schedule{Now: now, Agents: agents, VelocityMinutesPerDay: velocity, Items: []ScheduledIssue{}, Unscheduled: []string{}}
+	for _, id := range sched.order {
+		iss := open[id]
+		out.Items = append(out.Items, ScheduledIssue{
+			ID:               id,
+			Title:            iss.Title,
+			Status:           string(iss.Status),
+			Priority:         iss.Priority,
+			EstimatedMinutes: sched.minutes[id],
+			Start:            now.Add(durationDays(sched.start[id])),
+			Finish:           now.Add(durationDays(sched.finish[id])),
+			Agent:            sched.agent[id] + 1,
+			Layer:            layers.Layer[id],
+			Blockers:         sched.blockers[id],
+			DueDate:          iss.DueDate,
+		})
+	}
+	out.Unscheduled = append(out.Unscheduled, layers.Unorderable...)
+	return out
+}
+
+// scheduleVelocity is the recent global velocity (minutes/day per agent),
+// falling back to the same default as EstimateETAForIssue
+func scheduleVelocity(issues []model.Issue, now time.Time, median int) float64 {
+	velocity, _ := velocityMinutesPerDayForLabel(issues, "", now.Add(-30*24*time.Hour), median)
+	if velocity <= 0 {
+		velocity = float64(median) / 5.0
+		if velocity <= 0 {
+			velocity = 60
+		}
+	}
+	return velocity
+}
+
+// listScheduleResult holds day offsets from the schedule anchor
+type listScheduleResult struct {
+	order    []string // scheduled IDs in start order
+	start    map[string]float64
+	finish   map[string]float64
+	minutes  map[string]int
+	agent    map[string]int      // 0-based worker lane
+	blockers map[string][]string // in-scope blockers, sorted
+	binding  map[string]string   // constraint that set the start (blocker or previous task on the lane)
+}
+
+// listSchedule runs Kahn's algorithm over in-scope blocking edges, highest
+// priority first, assigning each ready issue to the earliest-free agent.
+// Issues in a blocking cycle are never scheduled.
+func listSchedule(open map[string]*model.Issue, scope map[string]bool, agents int, velocity float64, median int) listScheduleResult {
+	return listScheduleDays(open, scope, agents, median, func(_ string, minutes int) float64 {
+		return float64(minutes) / velocity
+	})
+}
+
+// listScheduleDays is listSchedule with each issue's duration in days given
+// by days, which receives the issue's estimate (median fallback) in minutes
+func listScheduleDays(open map[string]*model.Issue, scope map[string]bool, agents int, median int, days func(id string, minutes int) float64) listScheduleResult {
+	res := listScheduleResult{
+		start:    make(map[string]float64, len(scope)),
+		finish:   make(map[string]float64, len(scope)),
+		minutes:  make(map[string]int, len(scope)),
+		agent:    make(map[string]int, len(scope)),
+		blockers: make(map[string][]string, len(scope)),
+		binding:  make(map[string]string, len(scope)),
+	}
+
+	dependents := make(map[string][]string, len(scope))
+	indegree := make(map[string]int, len(scope))
+	for id := range scope {
+		iss := open[id]
+		if iss.EstimatedMinutes != nil && *iss.EstimatedMinutes > 0 {
+			res.minutes[id] = *iss.EstimatedMinutes
+		} else {
+			res.minutes[id] = median
+		}
+		seen := make(map[string]bool)
+		for _, dep := range iss.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || !scope[dep.DependsOnID] || dep.DependsOnID == id || seen[dep.DependsOnID] {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			res.blockers[id] = append(res.blockers[id], dep.DependsOnID)
+			dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], id)
+			indegree[id]++
+		}
+		sort.Strings(res.blockers[id])
+	}
+	var ready []string
+	for id := range scope {
+		if indegree[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	workerFree := make([]float64, agents)
+	workerLast := make([]string, agents)
+
+	for len(ready) > 0 {
+		sort.Slice(ready, func(a, b int) bool {
+			pa, pb := open[ready[a]].Priority, open[ready[b]].Priority
+			if pa != pb {
+				return pa < pb
+			}
+			return ready[a] < ready[b]
+		})
+		id := ready[0]
+		ready = ready[1:]
+
+		w := 0
+		for i := 1; i < agents; i++ {
+			if workerFree[i] < workerFree[w] {
+				w = i
+			}
+		}
+		s, bind := workerFree[w], workerLast[w]
+		for _, b := range res.blockers[id] {
+			if res.finish[b] >= s { // prefer the dependency when it ties the agent
+				s, bind = res.finish[b], b
+			}
+		}
+		res.start[id] = s
+		res.finish[id] = s + days(id, res.minutes[id])
+		res.binding[id] = bind
+		res.agent[id] = w
+		res.order = append(res.order, id)
+		workerFree[w] = res.finish[id]
+		workerLast[w] = id
+
+		for _, d := range dependents[id] {
+			indegree[d]--
+			if indegree[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+	return res
+}
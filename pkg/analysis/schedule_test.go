@@ -0,0 +1,81 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeSchedule(t *testing.T) {
+	now := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	est60, est120, est240, est480 := 60, 120, 240, 480
+	// release <- api (2d) <- schema (1d)
+	// release <- docs (0.5d)
+	// unrelated polish (1d), closed "old" is ignored
+	issues := []model.Issue{
+		{ID: "release", Status: model.StatusOpen, Priority: 0, EstimatedMinutes: &est60, Dependencies: []*model.Dependency{
+			{IssueID: "release", DependsOnID: "api", Type: model.DepBlocks},
+			{IssueID: "release", DependsOnID: "docs", Type: model.DepBlocks},
+		}},
+		{ID: "api", Status: model.StatusOpen, Priority: 1, EstimatedMinutes: &est480, Dependencies: []*model.Dependency{
+			{IssueID: "api", DependsOnID: "schema", Type: model.DepBlocks},
+		}},
+		{ID: "schema", Status: model.StatusOpen, Priority: 1, EstimatedMinutes: &est240},
+		{ID: "docs", Status: model.StatusOpen, Priority: 2, EstimatedMinutes: &est120},
+		{ID: "polish", Status: model.StatusOpen, Priority: 3, EstimatedMinutes: &est240},
+		{ID: "old", Status: model.StatusClosed, Priority: 1, Dependencies: []*model.Dependency{
+			{IssueID: "old", DependsOnID: "api", Type: model.DepBlocks},
+		}},
+	}
+	sched := ComputeSchedule(issues, ScheduleOptions{Agents: 2, Now: now})
+
+	if len(sched.Items) != 5 {
+		t.Fatalf("scheduled %d items, want 5 open issues", len(sched.Items))
+	}
+	byID := make(map[string]ScheduledIssue, len(sched.Items))
+	for _, it := range sched.Items {
+		byID[it.ID] = it
+		if it.Agent < 1 || it.Agent > 2 {
+			t.Errorf("%s on agent %d, want 1..2", it.ID, it.Agent)
+		}
+		if it.Finish.Before(it.Start) {
+			t.Errorf("%s finishes before it starts", it.ID)
+		}
+	}
+	if !byID["api"].Start.Equal(byID["schema"].Finish) {
+		t.Errorf("api should start when schema finishes: %v vs %v", byID["api"].Start, byID["schema"].Finish)
+	}
+	if got := byID["release"].Blockers; len(got) != 2 || got[0] != "api" || got[1] != "docs" {
+		t.Errorf("release blockers = %v, want [api docs]", got)
+	}
+	for i := 1; i < len(sched.Items); i++ {
+		if sched.Items[i].Start.Before(sched.Items[i-1].Start) {
+			t.Errorf("items not in start order at %d", i)
+		}
+	}
+
+	// The cut line uses the same scheduler
+	plan, err := ComputeCutLine(issues, CutLineOptions{TargetID: "release", Agents: 2, Now: now})
+	if err != nil {
+		t.Fatalf("ComputeCutLine: %v", err)
+	}
+	if plan.LineDate == nil || !plan.LineDate.Equal(byID["release"].Finish) {
+		t.Errorf("cut line date %v, schedule finish %v", plan.LineDate, byID["release"].Finish)
+	}
+}
+
+func TestComputeSchedule_Cycle(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "a", DependsOnID: "b", Type: model.DepBlocks}}},
+		{ID: "b", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "b", DependsOnID: "a", Type: model.DepBlocks}}},
+		{ID: "c", Status: model.StatusOpen},
+	}
+	sched := ComputeSchedule(issues, ScheduleOptions{})
+	if len(sched.Items) != 1 || sched.Items[0].ID != "c" {
+		t.Errorf("scheduled %v, want only c", sched.Items)
+	}
+	if len(sched.Unscheduled) != 2 || sched.Unscheduled[0] != "a" || sched.Unscheduled[1] != "b" {
+		t.Errorf("unscheduled = %v, want [a b]", sched.Unscheduled)
+	}
+}
@@ -0,0 +1,157 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ChangeKind is the kind of hypothetical edit Simulate applies
+type ChangeKind string
+
+const (
+	// ChangeCloseIssue marks IssueID closed
+	ChangeCloseIssue ChangeKind = "close"
+	// ChangeRemoveDependency drops IssueID's dependency on DependsOnID
+	ChangeRemoveDependency ChangeKind = "remove_dependency"
+)
+
+// Change is one hypothetical edit to the issue set
+type Change struct {
+	Kind        ChangeKind `json:"kind"`
+	IssueID     string     `json:"issue_id"`
+	DependsOnID string     `json:"depends_on_id,omitempty"` // remove_dependency only
+}
+
+// String renders the change for display, e.g. "close A" or "cut A→B"
+func (c Change) String() string {
+	if c.Kind == ChangeRemoveDependency {
+		return fmt.Sprintf("cut %s→%s", c.IssueID, c.DependsOnID)
+	}
+	return fmt.Sprintf("%s %s", c.Kind, c.IssueID)
+}
+
+// SimulationMetrics are the headline numbers compared before and after a
+// simulation. In a delta every field is the change (after minus before).
+type SimulationMetrics struct {
+	Open               int `json:"open"`
+	Actionable         int `json:"actionable"`
+	Blocked            int `json:"blocked"`
+	Tracks             int `json:"tracks"`
+	CriticalPathLength int `json:"critical_path_length"` // issues on the longest open blocking chain
+	Layers             int `json:"layers"`               // dependency depth of the open work
+	Unorderable        int `json:"unorderable"`          // open issues caught in or behind a cycle
+}
+
+func (m SimulationMetrics) sub(o SimulationMetrics) SimulationMetrics {
+	return SimulationMetrics{
+		Open:               m.Open - o.Open,
+		Actionable:         m.Actionable - o.Actionable,
+		Blocked:            m.Blocked - o.Blocked,
+		Tracks:             m.Tracks - o.Tracks,
+		CriticalPathLength: m.CriticalPathLength - o.CriticalPathLength,
+		Layers:             m.Layers - o.Layers,
+		Unorderable:        m.Unorderable - o.Unorderable,
+	}
+}
+
+// SimulationResult is the outcome of Simulate
+type SimulationResult struct {
+	Changes         []Change          `json:"changes"`
+	Plan            ExecutionPlan     `json:"plan"` // execution plan after the changes
+	Before          SimulationMetrics `json:"before"`
+	After           SimulationMetrics `json:"after"`
+	Delta           SimulationMetrics `json:"delta"`
+	NewlyActionable []string          `json:"newly_actionable,omitempty"` // open issues freed by the changes, sorted
+}
+
+// Simulate applies hypothetical changes to a copy of issues and returns the
+// recomputed execution plan together with how the headline metrics move.
+// issues is not modified. Changes are applied in order; one naming an unknown
+// issue or a dependency that doesn't exist is an error, so typos don't pass
+// for "no effect".
+func Simulate(issues []model.Issue, changes []Change) (SimulationResult, error) {
+	modified := make([]model.Issue, len(issues))
+	index := make(map[string]int, len(issues))
+	for i := range issues {
+		modified[i] = issues[i].Clone()
+		index[issues[i].ID] = i
+	}
+
+	for _, c := range changes {
+		i, ok := index[c.IssueID]
+		if !ok {
+			return SimulationResult{}, fmt.Errorf("%s: unknown issue %q", c, c.IssueID)
+		}
+		switch c.Kind {
+		case ChangeCloseIssue:
+			modified[i].Status = model.StatusClosed
+		case ChangeRemoveDependency:
+			deps := modified[i].Dependencies[:0]
+			removed := false
+			for _, dep := range modified[i].Dependencies {
+				if dep != nil && dep.DependsOnID == c.DependsOnID {
+					removed = true
+					continue
+				}
+				deps = append(deps, dep)
+			}
+			if !removed {
+				return SimulationResult{}, fmt.Errorf("%s: %s does not depend on %q", c, c.IssueID, c.DependsOnID)
+			}
+			modified[i].Dependencies = deps
+		default:
+			return SimulationResult{}, fmt.Errorf("unknown change kind %q", c.Kind)
+		}
+	}
+
+	before, beforeActionable, _ := simulationMetrics(issues)
+	after, afterActionable, plan := simulationMetrics(modified)
+
+	result := SimulationResult{
+		Changes: changes,
+		Plan:    plan,
+		Before:  before,
+		After:   after,
+		Delta:   after.sub(before),
+	}
+	for id := range afterActionable {
+		if !beforeActionable[id] {
+			result.NewlyActionable = append(result.NewlyActionable, id)
+		}
+	}
+	sort.Strings(result.NewlyActionable)
+	return result, nil
+}
+
+// simulationMetrics computes the metrics, actionable set and plan for issues
+func simulationMetrics(issues []model.Issue) (SimulationMetrics, map[string]bool, ExecutionPlan) {
+	a := NewAnalyzer(issues)
+	plan := a.GetExecutionPlan()
+
+	actionable := make(map[string]bool, plan.TotalActionable)
+	for _, track := range plan.Tracks {
+		for _, item := range track.Items {
+			actionable[item.ID] = true
+		}
+	}
+
+	open := make([]model.Issue, 0, len(issues))
+	for _, iss := range issues {
+		if !isClosedLikeStatus(iss.Status) {
+			open = append(open, iss)
+		}
+	}
+	layers := TopoLayers(open)
+
+	return SimulationMetrics{
+		Open:               len(open),
+		Actionable:         plan.TotalActionable,
+		Blocked:            plan.TotalBlocked,
+		Tracks:             len(plan.Tracks),
+		CriticalPathLength: ComputeCriticalPath(issues).Length,
+		Layers:             layers.Depth(),
+		Unorderable:        len(layers.Unorderable),
+	}, actionable, plan
+}
@@ -0,0 +1,55 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestSimulate(t *testing.T) {
+	issue := func(id string, blockers ...string) model.Issue {
+		iss := model.Issue{ID: id, Status: model.StatusOpen}
+		for _, b := range blockers {
+			iss.Dependencies = append(iss.Dependencies, &model.Dependency{IssueID: id, DependsOnID: b, Type: model.DepBlocks})
+		}
+		return iss
+	}
+	issues := []model.Issue{
+		issue("A"),
+		issue("B", "A"),
+		issue("C", "B"),
+		issue("D", "A", "C"),
+	}
+
+	res, err := Simulate(issues, []Change{
+		{Kind: ChangeCloseIssue, IssueID: "A"},
+		{Kind: ChangeRemoveDependency, IssueID: "D", DependsOnID: "C"},
+	})
+	if err != nil {
+		t.Fatalf("Simulate: %v", err)
+	}
+	if !reflect.DeepEqual(res.NewlyActionable, []string{"B", "D"}) {
+		t.Errorf("NewlyActionable = %v, want [B D]", res.NewlyActionable)
+	}
+	want := SimulationMetrics{Open: -1, Actionable: 1, Blocked: -2, CriticalPathLength: -2, Layers: -2}
+	want.Tracks = res.After.Tracks - res.Before.Tracks
+	if res.Delta != want {
+		t.Errorf("Delta = %+v, want %+v (before %+v, after %+v)", res.Delta, want, res.Before, res.After)
+	}
+	if res.Plan.TotalActionable != 2 {
+		t.Errorf("plan actionable = %d, want 2", res.Plan.TotalActionable)
+	}
+
+	// The input is left untouched.
+	if issues[0].Status != model.StatusOpen || len(issues[3].Dependencies) != 2 {
+		t.Error("Simulate modified its input")
+	}
+
+	if _, err := Simulate(issues, []Change{{Kind: ChangeRemoveDependency, IssueID: "A", DependsOnID: "B"}}); err == nil {
+		t.Error("expected an error removing a dependency that doesn't exist")
+	}
+	if _, err := Simulate(issues, []Change{{Kind: ChangeCloseIssue, IssueID: "nope"}}); err == nil {
+		t.Error("expected an error for an unknown issue")
+	}
+}
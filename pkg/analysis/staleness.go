@@ -0,0 +1,56 @@
+package analysis
+
+import (
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Weights of the two age signals in StalenessScores; they sum to 1.
+const (
+	stalenessUpdateWeight  = 0.6
+	stalenessBlockerWeight = 0.4
+)
+
+// StalenessScores rates each open issue from 0 (fresh) to 1 (most at risk of
+// being forgotten). Two age signals saturate at 30 days: how long since the
+// issue was updated, and how long its oldest open blocker has been around.
+// Priority then scales the result from half (P4) to full (P0) weight, so an
+// idle P0 stuck behind old work outranks an equally idle backlog item, while
+// a freshly touched issue scores low whatever its priority. Closed issues are
+// omitted.
+func StalenessScores(issues []model.Issue, now time.Time) map[string]float64 {
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+
+	scores := make(map[string]float64, len(issues))
+	for _, iss := range issues {
+		if isClosedLikeStatus(iss.Status) {
+			continue
+		}
+
+		var oldestBlocker time.Time
+		for _, dep := range iss.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			blocker, ok := byID[dep.DependsOnID]
+			if !ok || isClosedLikeStatus(blocker.Status) || blocker.CreatedAt.IsZero() {
+				continue
+			}
+			if oldestBlocker.IsZero() || blocker.CreatedAt.Before(oldestBlocker) {
+				oldestBlocker = blocker.CreatedAt
+			}
+		}
+		blocked := 0.0
+		if !oldestBlocker.IsZero() {
+			blocked = computeStaleness(oldestBlocker, now)
+		}
+
+		age := stalenessUpdateWeight*computeStaleness(iss.UpdatedAt, now) + stalenessBlockerWeight*blocked
+		scores[iss.ID] = age * (0.5 + 0.5*computePriorityBoost(iss.Priority))
+	}
+	return scores
+}
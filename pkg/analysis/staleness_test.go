@@ -0,0 +1,50 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestStalenessScores(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.AddDate(0, 0, -d) }
+	blockedBy := func(id string) []*model.Dependency {
+		return []*model.Dependency{{DependsOnID: id, Type: model.DepBlocks}}
+	}
+
+	issues := []model.Issue{
+		{ID: "fresh", Status: model.StatusOpen, Priority: 0, UpdatedAt: now},
+		{ID: "idle-p0", Status: model.StatusOpen, Priority: 0, UpdatedAt: daysAgo(60)},
+		{ID: "idle-p4", Status: model.StatusOpen, Priority: 4, UpdatedAt: daysAgo(60)},
+		{ID: "stuck", Status: model.StatusBlocked, Priority: 0, UpdatedAt: daysAgo(60), Dependencies: blockedBy("old")},
+		{ID: "old", Status: model.StatusOpen, Priority: 2, CreatedAt: daysAgo(45), UpdatedAt: daysAgo(15)},
+		{ID: "done", Status: model.StatusClosed, Priority: 0, UpdatedAt: daysAgo(90)},
+	}
+
+	scores := StalenessScores(issues, now)
+
+	if _, ok := scores["done"]; ok {
+		t.Error("closed issues should not be scored")
+	}
+	want := map[string]float64{
+		"fresh":   0,
+		"idle-p0": 0.6,
+		"idle-p4": 0.3,
+		"stuck":   1,
+		"old":     0.3 * 0.75,
+	}
+	for id, w := range want {
+		if got := scores[id]; math.Abs(got-w) > 1e-9 {
+			t.Errorf("%s: expected %.3f, got %.3f", id, w, got)
+		}
+	}
+
+	// A blocker that has since closed no longer counts
+	issues[4].Status = model.StatusClosed
+	if got := StalenessScores(issues, now)["stuck"]; math.Abs(got-0.6) > 1e-9 {
+		t.Errorf("stuck with closed blocker: expected 0.6, got %.3f", got)
+	}
+}
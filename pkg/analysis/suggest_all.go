@@ -21,6 +21,9 @@ type SuggestAllConfig struct {
 	// Cycles warning config
 	Cycles CycleWarningConfig
 
+	// Related link suggestion config
+	Related RelatedSuggestionConfig
+
 	// EnableDuplicates enables duplicate detection
 	EnableDuplicates bool
 
@@ -33,6 +36,9 @@ type SuggestAllConfig struct {
 	// EnableCycles enables cycle warnings
 	EnableCycles bool
 
+	// EnableRelated enables "related" link suggestions
+	EnableRelated bool
+
 	// MinConfidence filters suggestions below this threshold
 	MinConfidence float64
 
@@ -53,10 +59,12 @@ func DefaultSuggestAllConfig() SuggestAllConfig {
 		Dependencies:       DefaultDependencySuggestionConfig(),
 		Labels:             DefaultLabelSuggestionConfig(),
 		Cycles:             DefaultCycleWarningConfig(),
+		Related:            DefaultRelatedSuggestionConfig(),
 		EnableDuplicates:   true,
 		EnableDependencies: true,
 		EnableLabels:       true,
 		EnableCycles:       true,
+		EnableRelated:      true,
 		MinConfidence:      0.0,
 		MaxSuggestions:     50,
 	}
@@ -87,6 +95,11 @@ func GenerateAllSuggestions(issues []model.Issue, config SuggestAllConfig, dataH
 		allSuggestions = append(allSuggestions, cycles...)
 	}
 
+	if config.EnableRelated && (config.FilterType == "" || config.FilterType == SuggestionRelatedLink) {
+		related := DetectRelatedLinks(issues, config.Related)
+		allSuggestions = append(allSuggestions, related...)
+	}
+
 	// Apply filters
 	filtered := make([]Suggestion, 0, len(allSuggestions))
 	for _, sug := range allSuggestions {
@@ -154,6 +167,7 @@ func GenerateRobotSuggestOutput(issues []model.Issue, config SuggestAllConfig, d
 			"jq '.suggestions.suggestions[:5]' - Top 5 suggestions by confidence",
 			"jq '.suggestions.suggestions[] | select(.type==\"potential_duplicate\")' - Filter duplicates",
 			"jq '.suggestions.suggestions[] | select(.confidence >= 0.8)' - High-confidence only",
+			"jq '.suggestions.suggestions[] | select(.type==\"related_link\")' - Likely missing related links",
 			"jq '.suggestions.stats.by_type' - Count by suggestion type",
 			"jq '.suggestions.suggestions[].action_command' - All action commands",
 			"--suggest-type=dependency - Filter to dependency suggestions",
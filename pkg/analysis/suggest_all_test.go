@@ -29,6 +29,9 @@ func TestDefaultSuggestAllConfig(t *testing.T) {
 	if !config.EnableCycles {
 		t.Error("EnableCycles should be true by default")
 	}
+	if !config.EnableRelated {
+		t.Error("EnableRelated should be true by default")
+	}
 
 	// Default limits
 	if config.MinConfidence != 0.0 {
@@ -105,6 +108,7 @@ func TestGenerateAllSuggestions_OnlyDuplicates(t *testing.T) {
 	config.EnableDependencies = false
 	config.EnableLabels = false
 	config.EnableCycles = false
+	config.EnableRelated = false
 	config.Duplicates.JaccardThreshold = 0.3
 
 	set := GenerateAllSuggestions(issues, config, "dup-hash")
@@ -128,6 +132,7 @@ func TestGenerateAllSuggestions_OnlyLabels(t *testing.T) {
 	config.EnableDependencies = false
 	config.EnableLabels = true
 	config.EnableCycles = false
+	config.EnableRelated = false
 	config.Labels.MinConfidence = 0.1
 
 	set := GenerateAllSuggestions(issues, config, "label-hash")
@@ -158,6 +163,7 @@ func TestGenerateAllSuggestions_OnlyCycles(t *testing.T) {
 	config.EnableDependencies = false
 	config.EnableLabels = false
 	config.EnableCycles = true
+	config.EnableRelated = false
 
 	set := GenerateAllSuggestions(issues, config, "cycle-hash")
 
@@ -186,6 +192,7 @@ func TestGenerateAllSuggestions_AllDisabled(t *testing.T) {
 	config.EnableDependencies = false
 	config.EnableLabels = false
 	config.EnableCycles = false
+	config.EnableRelated = false
 
 	set := GenerateAllSuggestions(issues, config, "disabled-hash")
 
@@ -210,6 +217,7 @@ func TestGenerateAllSuggestions_MinConfidenceFilter(t *testing.T) {
 	configLow.EnableDuplicates = false
 	configLow.EnableDependencies = false
 	configLow.EnableCycles = false
+	configLow.EnableRelated = false
 	configLow.Labels.MinConfidence = 0.1
 	configLow.MinConfidence = 0.1
 
@@ -221,6 +229,7 @@ func TestGenerateAllSuggestions_MinConfidenceFilter(t *testing.T) {
 	configHigh.EnableDuplicates = false
 	configHigh.EnableDependencies = false
 	configHigh.EnableCycles = false
+	configHigh.EnableRelated = false
 	configHigh.Labels.MinConfidence = 0.1
 	configHigh.MinConfidence = 0.9
 
@@ -412,6 +421,7 @@ func TestGenerateAllSuggestions_MixedSuggestionTypes(t *testing.T) {
 	config.EnableDependencies = true
 	config.EnableLabels = true
 	config.EnableCycles = true
+	config.EnableRelated = false
 	config.Duplicates.JaccardThreshold = 0.3
 	config.Labels.MinConfidence = 0.1
 
@@ -741,6 +751,7 @@ func BenchmarkGenerateAllSuggestions_OnlyLabels(b *testing.B) {
 	config.EnableDuplicates = false
 	config.EnableDependencies = false
 	config.EnableCycles = false
+	config.EnableRelated = false
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -22,6 +22,9 @@ const (
 
 	// SuggestionCycleWarning warns about potential dependency cycles
 	SuggestionCycleWarning SuggestionType = "cycle_warning"
+
+	// SuggestionRelatedLink suggests a missing "related" link between issues
+	SuggestionRelatedLink SuggestionType = "related_link"
 )
 
 // Suggestion represents a smart recommendation for project hygiene
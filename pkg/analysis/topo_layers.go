@@ -0,0 +1,99 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// TopoLayering assigns issues to dependency depth layers. Layer 0 holds the
+// issues blocked by nothing in the set; an issue in layer N has at least one
+// blocker in layer N-1 and none deeper, so everything within a layer can be
+// worked in parallel once the layers before it are done.
+type TopoLayering struct {
+	Layer       map[string]int `json:"layer"`                 // issue ID -> 0-based layer; absent when unorderable
+	Layers      [][]string     `json:"layers"`                // IDs per layer, sorted
+	Unorderable []string       `json:"unorderable,omitempty"` // caught in or behind a blocking cycle, sorted
+}
+
+// LayerOf returns id's layer, or -1 when it is unorderable or unknown
+func (l TopoLayering) LayerOf(id string) int {
+	if n, ok := l.Layer[id]; ok {
+		return n
+	}
+	return -1
+}
+
+// Depth is the number of layers
+func (l TopoLayering) Depth() int {
+	return len(l.Layers)
+}
+
+// TopoLayers layers issues by their blocking dependencies using Kahn's
+// algorithm with longest-path depths. Only dependencies between issues in the
+// slice count; pass only open issues to layer the remaining work. Issues in a
+// cycle, and everything that depends on one, never become ready and are
+// reported as Unorderable instead of being given a layer.
+func TopoLayers(issues []model.Issue) TopoLayering {
+	inSet := make(map[string]bool, len(issues))
+	for i := range issues {
+		inSet[issues[i].ID] = true
+	}
+
+	// Edges run blocker -> dependent.
+	dependents := make(map[string][]string, len(issues))
+	indegree := make(map[string]int, len(issues))
+	for i := range issues {
+		id := issues[i].ID
+		seen := make(map[string]bool)
+		for _, dep := range issues[i].Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || dep.DependsOnID == id || !inSet[dep.DependsOnID] || seen[dep.DependsOnID] {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], id)
+			indegree[id]++
+		}
+	}
+
+	res := TopoLayering{Layer: make(map[string]int, len(issues))}
+	var queue []string
+	for i := range issues {
+		id := issues[i].ID
+		if _, dup := res.Layer[id]; !dup && indegree[id] == 0 {
+			res.Layer[id] = 0
+			queue = append(queue, id)
+		}
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, d := range dependents[id] {
+			if res.Layer[id]+1 > res.Layer[d] {
+				res.Layer[d] = res.Layer[id] + 1
+			}
+			indegree[d]--
+			if indegree[d] == 0 {
+				queue = append(queue, d)
+			}
+		}
+	}
+
+	for id := range inSet {
+		if indegree[id] > 0 {
+			delete(res.Layer, id)
+			res.Unorderable = append(res.Unorderable, id)
+			continue
+		}
+		n := res.Layer[id]
+		for len(res.Layers) <= n {
+			res.Layers = append(res.Layers, nil)
+		}
+		res.Layers[n] = append(res.Layers[n], id)
+	}
+	for _, layer := range res.Layers {
+		sort.Strings(layer)
+	}
+	sort.Strings(res.Unorderable)
+	return res
+}
@@ -0,0 +1,46 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestTopoLayers(t *testing.T) {
+	issue := func(id string, blockers ...string) model.Issue {
+		iss := model.Issue{ID: id, Status: model.StatusOpen}
+		for _, b := range blockers {
+			iss.Dependencies = append(iss.Dependencies, &model.Dependency{IssueID: id, DependsOnID: b, Type: model.DepBlocks})
+		}
+		return iss
+	}
+	// D waits on both B (layer 1) and A (layer 0), so it lands in layer 2.
+	// P and Q block each other and R waits on Q: none of them can be ordered.
+	// The dependency on "missing" is outside the set and ignored.
+	issues := []model.Issue{
+		issue("A"),
+		issue("B", "A"),
+		issue("C", "missing"),
+		issue("D", "B", "A"),
+		issue("P", "Q"),
+		issue("Q", "P"),
+		issue("R", "Q"),
+	}
+
+	got := TopoLayers(issues)
+	want := [][]string{{"A", "C"}, {"B"}, {"D"}}
+	if !reflect.DeepEqual(got.Layers, want) {
+		t.Errorf("Layers = %v, want %v", got.Layers, want)
+	}
+	if !reflect.DeepEqual(got.Unorderable, []string{"P", "Q", "R"}) {
+		t.Errorf("Unorderable = %v, want [P Q R]", got.Unorderable)
+	}
+	if got.LayerOf("D") != 2 || got.LayerOf("P") != -1 || got.Depth() != 3 {
+		t.Errorf("LayerOf(D)=%d LayerOf(P)=%d Depth=%d", got.LayerOf("D"), got.LayerOf("P"), got.Depth())
+	}
+
+	if empty := TopoLayers(nil); empty.Depth() != 0 || len(empty.Unorderable) != 0 {
+		t.Errorf("TopoLayers(nil) = %+v, want empty", empty)
+	}
+}
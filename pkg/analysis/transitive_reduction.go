@@ -0,0 +1,56 @@
+package analysis
+
+import (
+	"gonum.org/v1/gonum/graph/topo"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// TransitiveReduction returns the blocking dependency edges of issues with
+// redundant transitive edges removed: A→C is dropped when A already reaches C
+// through another dependency (A→B→C). Reachability is unchanged, so the
+// reduced edge set draws the same ordering with less clutter.
+//
+// Cycles have no unique reduction, so it is taken on the condensation: edges
+// inside a cycle are always kept, and an edge between two components is kept
+// unless another path connects them. Edges are sorted by From, then To.
+func TransitiveReduction(issues []model.Issue) []GraphEdge {
+	a := NewAnalyzer(issues)
+	cond := a.computeCondensation(topo.TarjanSCC(a.g))
+
+	// reach[c] holds every component c transitively depends on. Components
+	// arrive dependencies first, so each DependsOn entry is already complete.
+	reach := make(map[string]map[string]bool, len(cond.Components))
+	redundant := make(map[GraphEdge]bool)
+	for _, c := range cond.Components {
+		r := make(map[string]bool)
+		for _, dep := range c.DependsOn {
+			r[dep] = true
+			for id := range reach[dep] {
+				r[id] = true
+			}
+		}
+		reach[c.ID] = r
+		for _, dep := range c.DependsOn {
+			for _, via := range c.DependsOn {
+				if via != dep && reach[via][dep] {
+					redundant[GraphEdge{From: c.ID, To: dep}] = true
+					break
+				}
+			}
+		}
+	}
+
+	out := []GraphEdge{}
+	edges := a.g.Edges()
+	for edges.Next() {
+		e := edges.Edge()
+		from, to := a.nodeToID[e.From().ID()], a.nodeToID[e.To().ID()]
+		if redundant[GraphEdge{From: cond.ComponentOf[from], To: cond.ComponentOf[to]}] {
+			continue
+		}
+		out = append(out, GraphEdge{From: from, To: to})
+	}
+	sortGraphEdges(out)
+	return out
+}
@@ -0,0 +1,50 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestTransitiveReduction(t *testing.T) {
+	blocks := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	// A→C is implied by A→B→C. X and Y form a cycle that both wait on Z, so
+	// neither of those edges may go, but W→Z is implied by W→X→Z.
+	issues := []model.Issue{
+		{ID: "A", Dependencies: append(blocks("B", "C", "D"),
+			&model.Dependency{DependsOnID: "C", Type: model.DepRelated})},
+		{ID: "B", Dependencies: blocks("C")},
+		{ID: "C"},
+		{ID: "D"},
+		{ID: "W", Dependencies: blocks("X", "Z")},
+		{ID: "X", Dependencies: blocks("Y", "Z")},
+		{ID: "Y", Dependencies: blocks("X", "Z")},
+		{ID: "Z"},
+	}
+
+	got := TransitiveReduction(issues)
+	want := []GraphEdge{
+		{From: "A", To: "B"},
+		{From: "A", To: "D"},
+		{From: "B", To: "C"},
+		{From: "W", To: "X"},
+		{From: "X", To: "Y"},
+		{From: "X", To: "Z"},
+		{From: "Y", To: "X"},
+		{From: "Y", To: "Z"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if got := TransitiveReduction(nil); len(got) != 0 {
+		t.Errorf("expected no edges for empty input, got %v", got)
+	}
+}
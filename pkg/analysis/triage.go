@@ -1477,72 +1477,28 @@ func EnhanceRecommendationWithTriageReasons(rec *Recommendation, triageReasons T
 // buildRecommendationsByTrack groups recommendations by execution layer (topological depth).
 // This enables multi-agent parallelization: all items in the same layer can be worked concurrently.
 //
-// Layering algorithm (BFS-based topological sort):
+// Layers come from TopoLayers over the analyzer's open issues:
 //   - Layer 0 ("track-A"): All currently actionable items (no open blockers)
 //   - Layer 1 ("track-B"): Items blocked only by layer-0 items
-//   - Layer N: Items blocked only by layer-(N-1) items
+//   - Layer N: Items whose deepest open blocker is in layer N-1
 //
 // This differs from the previous connected-components approach which created
 // one track per disconnected work stream (issue #68).
 func buildRecommendationsByTrack(recs []Recommendation, analyzer *Analyzer, unblocksMap map[string][]string) []TrackRecommendationGroup {
-	// Compute blocker depth for all recommendations.
-	// Depth 0 = actionable now (no blockers), Depth N = blocked by depth-(N-1) items.
-	blockerDepths := make(map[string]int, len(recs))
-	recByID := make(map[string]*Recommendation, len(recs))
-
-	for i := range recs {
-		recByID[recs[i].ID] = &recs[i]
-	}
-
-	// BFS-based depth computation.
-	// Items with no blockers are depth 0. Items blocked by depth-0 items are depth 1, etc.
-	var dfs func(id string, visited map[string]bool) int
-	dfs = func(id string, visited map[string]bool) int {
-		if depth, ok := blockerDepths[id]; ok {
-			return depth
-		}
-		if visited[id] {
-			return -1 // Cycle detected
-		}
-		visited[id] = true
-
-		rec := recByID[id]
-		if rec == nil || len(rec.BlockedBy) == 0 {
-			visited[id] = false
-			blockerDepths[id] = 0
-			return 0
-		}
-
-		maxBlockerDepth := 0
-		for _, blockerID := range rec.BlockedBy {
-			blockerDepth := dfs(blockerID, visited)
-			if blockerDepth == -1 {
-				visited[id] = false
-				blockerDepths[id] = -1
-				return -1
-			}
-			if blockerDepth+1 > maxBlockerDepth {
-				maxBlockerDepth = blockerDepth + 1
-			}
+	open := make([]model.Issue, 0, len(analyzer.issueMap))
+	for _, issue := range analyzer.issueMap {
+		if !isClosedLikeStatus(issue.Status) {
+			open = append(open, issue)
 		}
-
-		visited[id] = false
-		blockerDepths[id] = maxBlockerDepth
-		return maxBlockerDepth
-	}
-
-	// Compute depths for all recommendations
-	for _, rec := range recs {
-		visited := make(map[string]bool)
-		dfs(rec.ID, visited)
 	}
+	layers := TopoLayers(open)
 
 	// Group recommendations by depth into tracks
 	groups := make(map[int]*TrackRecommendationGroup)
 
 	for _, rec := range recs {
-		depth := blockerDepths[rec.ID]
-		if depth < 0 {
+		depth, ok := layers.Layer[rec.ID]
+		if !ok && len(rec.BlockedBy) > 0 {
 			depth = 999 // Put cyclic items in a special track
 		}
 
@@ -0,0 +1,23 @@
+package analysis
+
+import (
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ImpactScores returns, for each open issue, how many other issues would
+// become unblocked if it were closed, following the cascade: an issue whose
+// last open blocker is freed counts, and so does everything it frees in turn.
+// Direct unblocks (PlanItem.UnblocksIDs) undercount work that sits at the
+// head of a long chain; this is the number prioritization should look at.
+// Closed issues are omitted.
+func ImpactScores(issues []model.Issue) map[string]int {
+	a := NewAnalyzer(issues)
+	scores := make(map[string]int, len(a.issueMap))
+	for id, issue := range a.issueMap {
+		if isClosedLikeStatus(issue.Status) {
+			continue
+		}
+		scores[id] = a.countTransitiveUnblocks(id)
+	}
+	return scores
+}
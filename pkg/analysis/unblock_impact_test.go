@@ -0,0 +1,43 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestImpactScores(t *testing.T) {
+	issue := func(id string, status model.Status, blockers ...string) model.Issue {
+		iss := model.Issue{ID: id, Status: status}
+		for _, b := range blockers {
+			iss.Dependencies = append(iss.Dependencies, &model.Dependency{IssueID: id, DependsOnID: b, Type: model.DepBlocks})
+		}
+		return iss
+	}
+	// A frees B, which frees C. D also waits on E, so closing A alone never
+	// frees it; closing E alone doesn't either.
+	issues := []model.Issue{
+		issue("A", model.StatusOpen, "done"),
+		issue("B", model.StatusOpen, "A"),
+		issue("C", model.StatusBlocked, "B"),
+		issue("D", model.StatusOpen, "A", "E"),
+		issue("E", model.StatusOpen),
+		issue("done", model.StatusClosed),
+	}
+
+	got := ImpactScores(issues)
+	want := map[string]int{"A": 2, "B": 1, "C": 0, "D": 0, "E": 0}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ImpactScores = %v, want %v", got, want)
+	}
+
+	plan := NewAnalyzer(issues).GetExecutionPlan()
+	for _, track := range plan.Tracks {
+		for _, item := range track.Items {
+			if item.TransitiveUnblocks != want[item.ID] {
+				t.Errorf("plan item %s TransitiveUnblocks = %d, want %d", item.ID, item.TransitiveUnblocks, want[item.ID])
+			}
+		}
+	}
+}
@@ -0,0 +1,410 @@
+package export
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+
+	"git.sr.ht/~sbinet/gg"
+	"github.com/ajstarks/svgo"
+	"golang.org/x/image/font/basicfont"
+)
+
+// BurnUpChartOptions controls burn-up chart export.
+type BurnUpChartOptions struct {
+	Path   string // Output path; format inferred from extension when Format empty
+	Format string // "svg" or "png" (case-insensitive). If empty, inferred from Path.
+	Title  string // Optional chart title
+	BurnUp analysis.BurnUp
+}
+
+var (
+	colorBurnScope     = color.RGBA{0x6b, 0x80, 0xbf, 0xff}
+	colorBurnDone      = color.RGBA{0x2e, 0x7d, 0x32, 0xff}
+	colorBurnCone      = color.NRGBA{0x2e, 0x7d, 0x32, 0x40}
+	colorBurnMilestone = color.RGBA{0xc6, 0x28, 0x28, 0xff}
+	colorBurnGrid      = color.RGBA{0xe0, 0xe0, 0xe0, 0xff}
+)
+
+// SaveBurnUpChart renders the burn-up chart (scope line, completed line and
+// forecast cone up to the milestone) as SVG or PNG.
+func SaveBurnUpChart(opts BurnUpChartOptions) error {
+	if len(opts.BurnUp.History) == 0 {
+		return fmt.Errorf("no burn-up history to chart")
+	}
+	format := strings.ToLower(strings.TrimPrefix(opts.Format, "."))
+	if format == "" {
+		format = "svg"
+		if strings.EqualFold(filepath.Ext(opts.Path), ".png") {
+			format = "png"
+		}
+	}
+	if format != "svg" && format != "png" {
+		return fmt.Errorf("unsupported format %q (want svg or png)", format)
+	}
+	if opts.Path == "" {
+		return fmt.Errorf("output path is required")
+	}
+	if err := os.MkdirAll(filepath.Dir(opts.Path), 0o755); err != nil {
+		return fmt.Errorf("create parent dir: %w", err)
+	}
+
+	layout := buildBurnUpLayout(opts)
+	if format == "png" {
+		return renderBurnUpPNG(opts.Path, layout)
+	}
+	file, err := os.Create(opts.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return renderBurnUpSVG(file, layout)
+}
+
+type burnUpPoint struct{ X, Y float64 }
+
+type burnUpLayout struct {
+	Width, Height  int
+	Left, Right    float64 // plot area in pixels
+	Top, Bottom    float64
+	Title, Summary string
+	Scope, Done    []burnUpPoint
+	Median         []burnUpPoint
+	Cone           []burnUpPoint // P90 forward, then P10 backward
+	MilestoneX     float64
+	MilestoneLabel string
+	YTicks         []burnUpTick
+	XTicks         []burnUpTick
+}
+
+type burnUpTick struct {
+	Pos   float64
+	Label string
+}
+
+func buildBurnUpLayout(opts BurnUpChartOptions) burnUpLayout {
+	b := opts.BurnUp
+	l := burnUpLayout{Width: 900, Height: 460, Left: 60, Right: 870, Top: 80, Bottom: 410}
+	l.Title = opts.Title
+	if strings.TrimSpace(l.Title) == "" {
+		l.Title = "Deadline Risk Burn-up"
+	}
+	l.Summary = burnUpSummary(b)
+
+	start := b.History[0].Date
+	end := b.Milestone
+	last := b.History[len(b.History)-1].Date
+	if last.After(end) {
+		end = last
+	}
+	if !end.After(start) {
+		end = start.AddDate(0, 0, 1)
+	}
+	maxY := float64(b.Scope)
+	for _, p := range b.History {
+		if float64(p.Scope) > maxY {
+			maxY = float64(p.Scope)
+		}
+	}
+	if maxY < 1 {
+		maxY = 1
+	}
+	maxY *= 1.1
+
+	span := end.Sub(start).Hours()
+	x := func(t time.Time) float64 {
+		return l.Left + (l.Right-l.Left)*t.Sub(start).Hours()/span
+	}
+	y := func(v float64) float64 {
+		return l.Bottom - (l.Bottom-l.Top)*v/maxY
+	}
+
+	for _, p := range b.History {
+		l.Scope = append(l.Scope, burnUpPoint{x(p.Date), y(float64(p.Scope))})
+		l.Done = append(l.Done, burnUpPoint{x(p.Date), y(float64(p.Completed))})
+	}
+	if n := len(l.Scope); n > 0 {
+		// Scope is flat going forward; extend it to the right edge.
+		l.Scope = append(l.Scope, burnUpPoint{l.Right, l.Scope[n-1].Y})
+	}
+
+	var low []burnUpPoint
+	if len(b.Forecast) > 0 {
+		origin := l.Done[len(l.Done)-1]
+		l.Median = append(l.Median, origin)
+		l.Cone = append(l.Cone, origin)
+		low = append(low, origin)
+		for _, f := range b.Forecast {
+			if f.Date.After(end) {
+				break
+			}
+			l.Median = append(l.Median, burnUpPoint{x(f.Date), y(f.Median)})
+			l.Cone = append(l.Cone, burnUpPoint{x(f.Date), y(f.High)})
+			low = append(low, burnUpPoint{x(f.Date), y(f.Low)})
+		}
+		for i := len(low) - 1; i >= 0; i-- {
+			l.Cone = append(l.Cone, low[i])
+		}
+	}
+
+	l.MilestoneX = x(b.Milestone)
+	l.MilestoneLabel = "milestone " + b.Milestone.Format("2006-01-02")
+
+	step := niceStep(maxY / 5)
+	for v := 0.0; v <= maxY; v += step {
+		l.YTicks = append(l.YTicks, burnUpTick{y(v), fmt.Sprintf("%.0f", v)})
+	}
+	days := end.Sub(start).Hours() / 24
+	every := int(days/6) + 1
+	for d := 0; float64(d) <= days; d += every {
+		t := start.AddDate(0, 0, d)
+		l.XTicks = append(l.XTicks, burnUpTick{x(t), t.Format("Jan 02")})
+	}
+	return l
+}
+
+// niceStep rounds a raw tick step up to 1, 2 or 5 times a power of ten.
+func niceStep(raw float64) float64 {
+	if raw <= 1 {
+		return 1
+	}
+	pow := 1.0
+	for pow*10 <= raw {
+		pow *= 10
+	}
+	for _, m := range []float64{1, 2, 5, 10} {
+		if m*pow >= raw {
+			return m * pow
+		}
+	}
+	return 10 * pow
+}
+
+// burnUpSummary is the one-line risk headline shown under the title.
+func burnUpSummary(b analysis.BurnUp) string {
+	line := fmt.Sprintf("%d/%d done, %.0f%% chance by %s, risk %s",
+		b.Completed, b.Scope, b.OnTimeProbability*100, b.Milestone.Format("2006-01-02"), b.Risk)
+	if b.FinishP85 != nil {
+		line += ", P85 finish " + b.FinishP85.Format("2006-01-02")
+	}
+	return line
+}
+
+func renderBurnUpSVG(w io.Writer, l burnUpLayout) error {
+	canvas := svg.New(w)
+	canvas.Start(l.Width, l.Height)
+	canvas.Rect(0, 0, l.Width, l.Height, "fill:"+css(colorBackdrop))
+	canvas.Text(int(l.Left), 34, l.Title, "fill:"+css(colorText)+";font-size:18px;font-family:monospace;font-weight:bold")
+	canvas.Text(int(l.Left), 56, l.Summary, "fill:"+css(colorSubtle)+";font-size:12px;font-family:monospace")
+
+	for _, t := range l.YTicks {
+		canvas.Line(int(l.Left), int(t.Pos), int(l.Right), int(t.Pos), "stroke:"+css(colorBurnGrid))
+		canvas.Text(int(l.Left)-8, int(t.Pos)+4, t.Label, "fill:"+css(colorSubtle)+";font-size:11px;font-family:monospace;text-anchor:end")
+	}
+	for _, t := range l.XTicks {
+		canvas.Text(int(t.Pos), int(l.Bottom)+18, t.Label, "fill:"+css(colorSubtle)+";font-size:11px;font-family:monospace;text-anchor:middle")
+	}
+
+	if len(l.Cone) > 0 {
+		xs, ys := svgCoords(l.Cone)
+		canvas.Polygon(xs, ys, "fill:"+css(colorBurnDone)+";fill-opacity:0.2;stroke:none")
+		xs, ys = svgCoords(l.Median)
+		canvas.Polyline(xs, ys, "fill:none;stroke:"+css(colorBurnDone)+";stroke-width:1.5;stroke-dasharray:6,4")
+	}
+	xs, ys := svgCoords(l.Scope)
+	canvas.Polyline(xs, ys, "fill:none;stroke:"+css(colorBurnScope)+";stroke-width:2")
+	xs, ys = svgCoords(l.Done)
+	canvas.Polyline(xs, ys, "fill:none;stroke:"+css(colorBurnDone)+";stroke-width:2.5")
+
+	mx := int(l.MilestoneX)
+	canvas.Line(mx, int(l.Top), mx, int(l.Bottom), "stroke:"+css(colorBurnMilestone)+";stroke-width:1.5;stroke-dasharray:4,3")
+	canvas.Text(mx-4, int(l.Top)-6, l.MilestoneLabel, "fill:"+css(colorBurnMilestone)+";font-size:11px;font-family:monospace;text-anchor:end")
+
+	canvas.Line(int(l.Left), int(l.Bottom), int(l.Right), int(l.Bottom), "stroke:"+css(colorStroke))
+	drawBurnUpLegendSVG(canvas, l)
+	canvas.End()
+	return nil
+}
+
+func drawBurnUpLegendSVG(canvas *svg.SVG, l burnUpLayout) {
+	x, y := int(l.Left), int(l.Bottom)+38
+	items := []struct {
+		c     color.RGBA
+		label string
+	}{{colorBurnScope, "scope"}, {colorBurnDone, "completed"}, {colorBurnDone, "forecast p10-p90"}}
+	for i, it := range items {
+		if i == 2 {
+			canvas.Rect(x, y-8, 18, 10, "fill:"+css(it.c)+";fill-opacity:0.2")
+		} else {
+			canvas.Line(x, y-3, x+18, y-3, "stroke:"+css(it.c)+";stroke-width:2.5")
+		}
+		canvas.Text(x+24, y, it.label, "fill:"+css(colorSubtle)+";font-size:11px;font-family:monospace")
+		x += 30 + 8*len(it.label)
+	}
+}
+
+func svgCoords(pts []burnUpPoint) ([]int, []int) {
+	xs := make([]int, len(pts))
+	ys := make([]int, len(pts))
+	for i, p := range pts {
+		xs[i], ys[i] = int(p.X), int(p.Y)
+	}
+	return xs, ys
+}
+
+func renderBurnUpPNG(path string, l burnUpLayout) error {
+	dc := gg.NewContext(l.Width, l.Height)
+	dc.SetColor(colorBackdrop)
+	dc.Clear()
+	dc.SetFontFace(basicfont.Face7x13)
+
+	dc.SetColor(colorText)
+	dc.DrawString(l.Title, l.Left, 34)
+	dc.SetColor(colorSubtle)
+	dc.DrawString(l.Summary, l.Left, 56)
+
+	dc.SetLineWidth(1)
+	for _, t := range l.YTicks {
+		dc.SetColor(colorBurnGrid)
+		dc.DrawLine(l.Left, t.Pos, l.Right, t.Pos)
+		dc.Stroke()
+		dc.SetColor(colorSubtle)
+		dc.DrawStringAnchored(t.Label, l.Left-8, t.Pos, 1, 0.35)
+	}
+	for _, t := range l.XTicks {
+		dc.DrawStringAnchored(t.Label, t.Pos, l.Bottom+16, 0.5, 0.5)
+	}
+
+	if len(l.Cone) > 0 {
+		pngPath(dc, l.Cone)
+		dc.ClosePath()
+		dc.SetColor(colorBurnCone)
+		dc.Fill()
+		dc.SetColor(colorBurnDone)
+		dc.SetLineWidth(1.5)
+		dc.SetDash(6, 4)
+		pngPath(dc, l.Median)
+		dc.Stroke()
+		dc.SetDash()
+	}
+	dc.SetColor(colorBurnScope)
+	dc.SetLineWidth(2)
+	pngPath(dc, l.Scope)
+	dc.Stroke()
+	dc.SetColor(colorBurnDone)
+	dc.SetLineWidth(2.5)
+	pngPath(dc, l.Done)
+	dc.Stroke()
+
+	dc.SetColor(colorBurnMilestone)
+	dc.SetLineWidth(1.5)
+	dc.SetDash(4, 3)
+	dc.DrawLine(l.MilestoneX, l.Top, l.MilestoneX, l.Bottom)
+	dc.Stroke()
+	dc.SetDash()
+	dc.DrawStringAnchored(l.MilestoneLabel, l.MilestoneX-4, l.Top-8, 1, 0)
+
+	dc.SetColor(colorStroke)
+	dc.SetLineWidth(1)
+	dc.DrawLine(l.Left, l.Bottom, l.Right, l.Bottom)
+	dc.Stroke()
+
+	x, y := l.Left, l.Bottom+38
+	for i, label := range []string{"scope", "completed", "forecast p10-p90"} {
+		switch i {
+		case 0:
+			dc.SetColor(colorBurnScope)
+		case 1:
+			dc.SetColor(colorBurnDone)
+		default:
+			dc.SetColor(colorBurnCone)
+		}
+		dc.DrawRectangle(x, y-8, 18, 8)
+		dc.Fill()
+		dc.SetColor(colorSubtle)
+		dc.DrawString(label, x+24, y)
+		x += 30 + 8*float64(len(label))
+	}
+
+	return dc.SavePNG(path)
+}
+
+func pngPath(dc *gg.Context, pts []burnUpPoint) {
+	for i, p := range pts {
+		if i == 0 {
+			dc.MoveTo(p.X, p.Y)
+		} else {
+			dc.LineTo(p.X, p.Y)
+		}
+	}
+}
+
+// GenerateBurnUpMarkdown renders the deadline-risk section for reports: the
+// headline odds, forecast dates and a weekly table of the forecast cone.
+// chartPath, when set, is linked as an image above the table.
+func GenerateBurnUpMarkdown(b analysis.BurnUp, chartPath string) string {
+	return generateBurnUpMarkdown(b, chartPath, false, nil)
+}
+
+// generateBurnUpMarkdown is GenerateBurnUpMarkdown with a bracketed risk tag
+// in place of the traffic light when ascii is set
+func generateBurnUpMarkdown(b analysis.BurnUp, chartPath string, ascii bool, locale *reportLocale) string {
+	var sb strings.Builder
+	sb.WriteString("## 📈 " + locale.T("burnup.heading") + "\n\n")
+	if chartPath != "" {
+		sb.WriteString(fmt.Sprintf("![%s](%s)\n\n", locale.T("burnup.chart"), chartPath))
+	}
+
+	riskEmoji := map[string]string{analysis.BurnUpRiskLow: "🟢", analysis.BurnUpRiskMedium: "🟡", analysis.BurnUpRiskHigh: "🔴"}[b.Risk]
+	if ascii {
+		riskEmoji = burnUpRiskTag(b.Risk)
+	}
+	sb.WriteString(fmt.Sprintf("| %s | %s |\n|--------|-------|\n", locale.T("col.metric"), locale.T("col.value")))
+	sb.WriteString(fmt.Sprintf("| **%s** | %s |\n", locale.T("burnup.milestone"), b.Milestone.Format("2006-01-02")))
+	if b.TargetID != "" {
+		sb.WriteString(fmt.Sprintf("| **%s** | %s |\n", locale.T("burnup.scope"), locale.Tf("burnup.scope_value", b.TargetID)))
+	}
+	sb.WriteString(fmt.Sprintf("| **%s** | %s |\n", locale.T("burnup.progress"), locale.Tf("burnup.done", b.Completed, b.Scope)))
+	sb.WriteString(fmt.Sprintf("| **%s** | %s |\n", locale.T("burnup.throughput"), locale.Tf("burnup.per_day", b.DailyThroughput)))
+	sb.WriteString(fmt.Sprintf("| **%s** | %s %.0f%% (%s) |\n", locale.T("burnup.on_time"), riskEmoji, b.OnTimeProbability*100,
+		locale.Tf("burnup.risk", locale.value("risk", b.Risk))))
+	sb.WriteString(fmt.Sprintf("| **%s** | %s |\n", locale.T("burnup.p50"), formatCutDate(b.FinishP50)))
+	sb.WriteString(fmt.Sprintf("| **%s** | %s |\n\n", locale.T("burnup.p85"), formatCutDate(b.FinishP85)))
+
+	if len(b.Forecast) == 0 {
+		if b.Completed < b.Scope {
+			sb.WriteString("*" + locale.T("burnup.no_forecast") + "*\n\n")
+		}
+		return sb.String()
+	}
+
+	sb.WriteString(fmt.Sprintf("| %s | %s | P10 | P50 | P90 |\n|------|-------|-----|-----|-----|\n", locale.T("col.date"), locale.T("col.scope")))
+	past := 0 // rows after the milestone; a few show how far the cone slips
+	for i, f := range b.Forecast {
+		weekly := (i+1)%7 == 0
+		atMilestone := !f.Date.Before(b.Milestone) && (i == 0 || b.Forecast[i-1].Date.Before(b.Milestone))
+		if !weekly && !atMilestone {
+			continue
+		}
+		marker := ""
+		if atMilestone {
+			marker = " 🏁"
+		}
+		sb.WriteString(fmt.Sprintf("| %s%s | %d | %.0f | %.0f | %.0f |\n",
+			f.Date.Format("2006-01-02"), marker, b.Scope, f.Low, f.Median, f.High))
+		if f.Date.After(b.Milestone) {
+			past++
+		}
+		if past >= 4 || f.Low >= float64(b.Scope) {
+			break
+		}
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
@@ -0,0 +1,109 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func burnUpForTest(t *testing.T) analysis.BurnUp {
+	t.Helper()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	var issues []model.Issue
+	for i := 0; i < 30; i++ {
+		iss := model.Issue{ID: fmt.Sprintf("b-%d", i), Title: "t", Status: model.StatusOpen, IssueType: model.TypeTask, CreatedAt: now.AddDate(0, 0, -40+i)}
+		if i%2 == 0 {
+			closed := now.AddDate(0, 0, -i/2)
+			iss.Status = model.StatusClosed
+			iss.ClosedAt = &closed
+		}
+		issues = append(issues, iss)
+	}
+	b, err := analysis.ComputeBurnUp(issues, analysis.BurnUpOptions{Milestone: now.AddDate(0, 0, 21), Now: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestSaveBurnUpChart(t *testing.T) {
+	b := burnUpForTest(t)
+	dir := t.TempDir()
+
+	svgPath := filepath.Join(dir, "burnup.svg")
+	if err := SaveBurnUpChart(BurnUpChartOptions{Path: svgPath, BurnUp: b}); err != nil {
+		t.Fatalf("svg: %v", err)
+	}
+	data, err := os.ReadFile(svgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<svg", "Deadline Risk Burn-up", "milestone 2026-03-22", "<polygon", "forecast p10-p90"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("svg missing %q", want)
+		}
+	}
+
+	pngPath := filepath.Join(dir, "burnup.png")
+	if err := SaveBurnUpChart(BurnUpChartOptions{Path: pngPath, BurnUp: b}); err != nil {
+		t.Fatalf("png: %v", err)
+	}
+	if info, err := os.Stat(pngPath); err != nil || info.Size() == 0 {
+		t.Fatalf("png not written: %v", err)
+	}
+
+	if err := SaveBurnUpChart(BurnUpChartOptions{Path: filepath.Join(dir, "x.gif"), Format: "gif", BurnUp: b}); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+	if err := SaveBurnUpChart(BurnUpChartOptions{Path: svgPath}); err == nil {
+		t.Error("expected error without history")
+	}
+}
+
+func TestGenerateBurnUpMarkdown(t *testing.T) {
+	b := burnUpForTest(t)
+	md := GenerateBurnUpMarkdown(b, "risk.svg")
+	for _, want := range []string{
+		"## 📈 Deadline Risk",
+		"![Burn-up chart](risk.svg)",
+		"| **Milestone** | 2026-03-22 |",
+		"| **Progress** | 15 / 30 done |",
+		"| Date | Scope | P10 | P50 | P90 |",
+		"🏁",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown missing %q:\n%s", want, md)
+		}
+	}
+
+	b.Forecast = nil
+	if md := GenerateBurnUpMarkdown(b, ""); !strings.Contains(md, "no forecast") || strings.Contains(md, "![") {
+		t.Errorf("no-forecast section wrong:\n%s", md)
+	}
+}
+
+func TestGenerateMarkdownWithOptions_Milestone(t *testing.T) {
+	issues := []model.Issue{{ID: "a", Title: "A", Status: model.StatusOpen, IssueType: model.TypeTask, CreatedAt: time.Now()}}
+	opts := DefaultMarkdownOptions()
+	md, err := GenerateMarkdownWithOptions(issues, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(md, "Deadline Risk") {
+		t.Error("deadline section should be off without a milestone")
+	}
+	opts.Milestone = time.Now().AddDate(0, 0, 14)
+	md, err = GenerateMarkdownWithOptions(issues, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(md, "## 📈 Deadline Risk") {
+		t.Error("deadline section missing with a milestone")
+	}
+}
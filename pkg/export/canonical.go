@@ -0,0 +1,418 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// CanonicalFormatName identifies bv's interchange documents. Readers match on
+// it before trusting the rest of the envelope.
+const CanonicalFormatName = "beads_viewer.issues"
+
+// CanonicalVersion is the envelope version written by this build. Readers
+// accept any version up to and including it.
+const CanonicalVersion = 1
+
+// InterchangeFormat names a serialization `bv convert` can read or write
+type InterchangeFormat string
+
+const (
+	// FormatAuto sniffs the input (reading only)
+	FormatAuto InterchangeFormat = "auto"
+	// FormatBeads is the native .beads/*.jsonl layout: one bare issue per line
+	FormatBeads InterchangeFormat = "beads"
+	// FormatCanonicalJSON is a single indented envelope holding every issue
+	FormatCanonicalJSON InterchangeFormat = "json"
+	// FormatCanonicalJSONL is a header line followed by one issue per line
+	FormatCanonicalJSONL InterchangeFormat = "jsonl"
+)
+
+// ParseInterchangeFormat validates a format name ("" means auto)
+func ParseInterchangeFormat(s string) (InterchangeFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "auto":
+		return FormatAuto, nil
+	case "beads", "bd":
+		return FormatBeads, nil
+	case "json", "canonical":
+		return FormatCanonicalJSON, nil
+	case "jsonl", "ndjson":
+		return FormatCanonicalJSONL, nil
+	default:
+		return "", fmt.Errorf("unknown format %q (want auto, beads, json or jsonl)", s)
+	}
+}
+
+// CanonicalIssue is a model.Issue plus whatever fields the source carried that
+// bv does not model, so tools that extend the beads schema survive a round trip.
+type CanonicalIssue struct {
+	model.Issue
+	CustomFields map[string]json.RawMessage `json:"custom_fields,omitempty"`
+}
+
+// CanonicalHeader describes a canonical document. In the JSONL layout it is
+// the first line; in the JSON layout it is embedded in the envelope.
+type CanonicalHeader struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	DataHash   string    `json:"data_hash"`
+	IssueCount int       `json:"issue_count"`
+}
+
+// CanonicalEnvelope is the JSON layout: header fields plus the issues.
+type CanonicalEnvelope struct {
+	CanonicalHeader
+	Issues []CanonicalIssue `json:"issues"`
+}
+
+// knownIssueKeys are the JSON keys model.Issue decodes itself
+var knownIssueKeys = func() map[string]bool {
+	keys := make(map[string]bool)
+	t := reflect.TypeOf(model.Issue{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			keys[name] = true
+		}
+	}
+	return keys
+}()
+
+// CanonicalizeIssues puts issues into canonical order in place: issues by ID,
+// labels alphabetically, dependencies by target then type, comments by time
+// then ID, and every timestamp in UTC. Two exports of the same data are then
+// byte-identical apart from exported_at.
+func CanonicalizeIssues(issues []CanonicalIssue) {
+	for i := range issues {
+		iss := &issues[i].Issue
+		if len(iss.Labels) > 0 {
+			iss.Labels = append([]string(nil), iss.Labels...)
+			sort.Strings(iss.Labels)
+		}
+		if len(iss.Dependencies) > 0 {
+			deps := make([]*model.Dependency, 0, len(iss.Dependencies))
+			for _, d := range iss.Dependencies {
+				if d == nil {
+					continue
+				}
+				dep := *d
+				dep.CreatedAt = dep.CreatedAt.UTC()
+				deps = append(deps, &dep)
+			}
+			sort.SliceStable(deps, func(a, b int) bool {
+				if deps[a].DependsOnID != deps[b].DependsOnID {
+					return deps[a].DependsOnID < deps[b].DependsOnID
+				}
+				return deps[a].Type < deps[b].Type
+			})
+			iss.Dependencies = deps
+		}
+		if len(iss.Comments) > 0 {
+			comments := make([]*model.Comment, 0, len(iss.Comments))
+			for _, c := range iss.Comments {
+				if c == nil {
+					continue
+				}
+				comment := *c
+				comment.CreatedAt = comment.CreatedAt.UTC()
+				comments = append(comments, &comment)
+			}
+			sort.SliceStable(comments, func(a, b int) bool {
+				if !comments[a].CreatedAt.Equal(comments[b].CreatedAt) {
+					return comments[a].CreatedAt.Before(comments[b].CreatedAt)
+				}
+				return comments[a].ID < comments[b].ID
+			})
+			iss.Comments = comments
+		}
+		iss.CreatedAt = iss.CreatedAt.UTC()
+		iss.UpdatedAt = iss.UpdatedAt.UTC()
+		iss.DueDate = utcPtr(iss.DueDate)
+		iss.ClosedAt = utcPtr(iss.ClosedAt)
+		iss.CompactedAt = utcPtr(iss.CompactedAt)
+	}
+	sort.SliceStable(issues, func(a, b int) bool { return issues[a].ID < issues[b].ID })
+}
+
+func utcPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.UTC()
+	return &v
+}
+
+// ToCanonicalIssues wraps plain issues with no custom fields
+func ToCanonicalIssues(issues []model.Issue) []CanonicalIssue {
+	out := make([]CanonicalIssue, len(issues))
+	for i := range issues {
+		out[i] = CanonicalIssue{Issue: issues[i].Clone()}
+	}
+	return out
+}
+
+// FromCanonicalIssues drops custom fields, returning the model issues
+func FromCanonicalIssues(issues []CanonicalIssue) []model.Issue {
+	out := make([]model.Issue, len(issues))
+	for i := range issues {
+		out[i] = issues[i].Issue
+	}
+	return out
+}
+
+// WriteInterchange canonicalizes issues and writes them in format.
+// exportedAt stamps the canonical header; it is ignored for FormatBeads.
+func WriteInterchange(w io.Writer, issues []CanonicalIssue, format InterchangeFormat, exportedAt time.Time) error {
+	CanonicalizeIssues(issues)
+	header := CanonicalHeader{
+		Format:     CanonicalFormatName,
+		Version:    CanonicalVersion,
+		ExportedAt: exportedAt.UTC(),
+		DataHash:   analysis.ComputeDataHash(FromCanonicalIssues(issues)),
+		IssueCount: len(issues),
+	}
+
+	switch format {
+	case FormatCanonicalJSON:
+		env := CanonicalEnvelope{CanonicalHeader: header, Issues: issues}
+		if env.Issues == nil {
+			env.Issues = []CanonicalIssue{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(env)
+	case FormatCanonicalJSONL:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(header); err != nil {
+			return err
+		}
+		for _, iss := range issues {
+			if err := enc.Encode(iss); err != nil {
+				return fmt.Errorf("encoding %s: %w", iss.ID, err)
+			}
+		}
+		return nil
+	case FormatBeads:
+		for _, iss := range issues {
+			line, err := beadsLine(iss)
+			if err != nil {
+				return fmt.Errorf("encoding %s: %w", iss.ID, err)
+			}
+			if _, err := w.Write(append(line, '\n')); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("cannot write format %q", format)
+	}
+}
+
+// beadsLine encodes an issue the way bd stores it: custom fields flattened
+// back to the top level, never shadowing a modelled field.
+func beadsLine(iss CanonicalIssue) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(iss.Issue); err != nil {
+		return nil, err
+	}
+	line := bytes.TrimRight(buf.Bytes(), "\n")
+	if len(iss.CustomFields) == 0 {
+		return line, nil
+	}
+
+	keys := make([]string, 0, len(iss.CustomFields))
+	for k := range iss.CustomFields {
+		if !knownIssueKeys[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	out := append([]byte(nil), line[:len(line)-1]...) // drop the closing brace
+	for _, k := range keys {
+		name, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, ',')
+		out = append(out, name...)
+		out = append(out, ':')
+		out = append(out, iss.CustomFields[k]...)
+	}
+	return append(out, '}'), nil
+}
+
+// InterchangeResult is what ReadInterchange decoded
+type InterchangeResult struct {
+	Format   InterchangeFormat // the detected format when FormatAuto was requested
+	Header   *CanonicalHeader  // nil for FormatBeads
+	Issues   []CanonicalIssue
+	Warnings []string // skipped lines and issues, as the loader reports them
+}
+
+// ReadInterchange decodes issues in format, sniffing it when format is
+// FormatAuto. Malformed or invalid issues are skipped with a warning, like
+// the loader does; a bad or too-new canonical header is an error.
+func ReadInterchange(r io.Reader, format InterchangeFormat) (*InterchangeResult, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})
+	if format == FormatAuto {
+		format = DetectInterchangeFormat(data)
+	}
+
+	res := &InterchangeResult{Format: format}
+	switch format {
+	case FormatCanonicalJSON:
+		var raw struct {
+			CanonicalHeader
+			Issues []json.RawMessage `json:"issues"`
+		}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("decoding canonical JSON: %w", err)
+		}
+		if err := checkCanonicalHeader(raw.CanonicalHeader); err != nil {
+			return nil, err
+		}
+		res.Header = &raw.CanonicalHeader
+		for i, msg := range raw.Issues {
+			res.addIssue(msg, fmt.Sprintf("issue %d", i+1))
+		}
+	case FormatCanonicalJSONL, FormatBeads:
+		sc := bufio.NewScanner(bytes.NewReader(data))
+		sc.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+		lineNum := 0
+		for sc.Scan() {
+			lineNum++
+			line := bytes.TrimSpace(sc.Bytes())
+			if len(line) == 0 {
+				continue
+			}
+			if format == FormatCanonicalJSONL && res.Header == nil {
+				var header CanonicalHeader
+				if err := json.Unmarshal(line, &header); err != nil {
+					return nil, fmt.Errorf("decoding canonical JSONL header: %w", err)
+				}
+				if err := checkCanonicalHeader(header); err != nil {
+					return nil, err
+				}
+				res.Header = &header
+				continue
+			}
+			res.addIssue(line, fmt.Sprintf("line %d", lineNum))
+		}
+		if err := sc.Err(); err != nil {
+			return nil, fmt.Errorf("reading line %d: %w", lineNum+1, err)
+		}
+		if format == FormatCanonicalJSONL && res.Header == nil {
+			return nil, fmt.Errorf("canonical JSONL is missing its header line")
+		}
+	default:
+		return nil, fmt.Errorf("cannot read format %q", format)
+	}
+
+	if res.Header != nil && res.Header.IssueCount != len(res.Issues) && len(res.Warnings) == 0 {
+		res.Warnings = append(res.Warnings, fmt.Sprintf("header declares %d issues but %d were read", res.Header.IssueCount, len(res.Issues)))
+	}
+	return res, nil
+}
+
+func checkCanonicalHeader(h CanonicalHeader) error {
+	if h.Format != CanonicalFormatName {
+		return fmt.Errorf("not a %s document (format %q)", CanonicalFormatName, h.Format)
+	}
+	if h.Version < 1 || h.Version > CanonicalVersion {
+		return fmt.Errorf("unsupported %s version %d (this bv reads up to %d)", CanonicalFormatName, h.Version, CanonicalVersion)
+	}
+	return nil
+}
+
+// addIssue decodes one issue object, keeping unknown keys as custom fields
+func (res *InterchangeResult) addIssue(data []byte, where string) {
+	var iss CanonicalIssue
+	if err := json.Unmarshal(data, &iss.Issue); err != nil {
+		res.Warnings = append(res.Warnings, fmt.Sprintf("skipping malformed JSON on %s: %v", where, err))
+		return
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		res.Warnings = append(res.Warnings, fmt.Sprintf("skipping malformed JSON on %s: %v", where, err))
+		return
+	}
+	for k, v := range fields {
+		if knownIssueKeys[k] {
+			continue
+		}
+		if k == "custom_fields" {
+			var nested map[string]json.RawMessage
+			if json.Unmarshal(v, &nested) == nil {
+				for nk, nv := range nested {
+					iss.setCustomField(nk, nv)
+				}
+				continue
+			}
+		}
+		iss.setCustomField(k, v)
+	}
+
+	iss.Status = model.Status(strings.ToLower(strings.TrimSpace(string(iss.Status))))
+	if err := iss.Validate(); err != nil {
+		res.Warnings = append(res.Warnings, fmt.Sprintf("skipping invalid issue on %s: %v", where, err))
+		return
+	}
+	res.Issues = append(res.Issues, iss)
+}
+
+func (iss *CanonicalIssue) setCustomField(key string, value json.RawMessage) {
+	if iss.CustomFields == nil {
+		iss.CustomFields = make(map[string]json.RawMessage)
+	}
+	var compact bytes.Buffer
+	if json.Compact(&compact, value) == nil {
+		value = compact.Bytes()
+	}
+	iss.CustomFields[key] = value
+}
+
+// DetectInterchangeFormat sniffs data: a canonical envelope or JSONL header
+// carries CanonicalFormatName; anything else is taken to be beads JSONL.
+func DetectInterchangeFormat(data []byte) InterchangeFormat {
+	first, _, _ := bytes.Cut(bytes.TrimLeft(data, " \t\r\n"), []byte("\n"))
+	var probe map[string]json.RawMessage
+	if json.Unmarshal(first, &probe) == nil {
+		if isCanonicalFormat(probe["format"]) {
+			if _, ok := probe["issues"]; ok {
+				return FormatCanonicalJSON
+			}
+			return FormatCanonicalJSONL
+		}
+		return FormatBeads
+	}
+	// Not a one-line object: an indented envelope spans many lines.
+	probe = nil
+	if json.Unmarshal(data, &probe) == nil && isCanonicalFormat(probe["format"]) {
+		return FormatCanonicalJSON
+	}
+	return FormatBeads
+}
+
+func isCanonicalFormat(raw json.RawMessage) bool {
+	var name string
+	return raw != nil && json.Unmarshal(raw, &name) == nil && name == CanonicalFormatName
+}
@@ -0,0 +1,111 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const beadsFixture = `{"id":"bv-2","title":"Second","description":"","status":"Open","priority":2,"issue_type":"task","created_at":"2025-01-02T10:00:00+02:00","updated_at":"2025-01-02T10:00:00+02:00","labels":["ui","api"],"dependencies":[{"issue_id":"bv-2","depends_on_id":"bv-1","type":"blocks","created_at":"2025-01-02T10:00:00Z","created_by":"amy"}],"comments":[{"id":2,"issue_id":"bv-2","author":"bo","text":"later","created_at":"2025-01-03T00:00:00Z"},{"id":1,"issue_id":"bv-2","author":"amy","text":"first","created_at":"2025-01-02T00:00:00Z"}],"sprint":"s1","estimate":{"points":3}}
+not json
+{"id":"","title":"missing id","status":"open","issue_type":"task"}
+{"id":"bv-1","title":"First","description":"root","status":"closed","priority":0,"issue_type":"bug","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z","closed_at":"2025-01-04T00:00:00Z"}
+`
+
+func TestInterchangeRoundTrip(t *testing.T) {
+	src, err := ReadInterchange(strings.NewReader(beadsFixture), FormatAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if src.Format != FormatBeads || len(src.Issues) != 2 || len(src.Warnings) != 2 {
+		t.Fatalf("format=%s issues=%d warnings=%v", src.Format, len(src.Issues), src.Warnings)
+	}
+
+	exportedAt := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	var canonical bytes.Buffer
+	if err := WriteInterchange(&canonical, src.Issues, FormatCanonicalJSON, exportedAt); err != nil {
+		t.Fatal(err)
+	}
+
+	var env CanonicalEnvelope
+	if err := json.Unmarshal(canonical.Bytes(), &env); err != nil {
+		t.Fatal(err)
+	}
+	if env.Format != CanonicalFormatName || env.Version != CanonicalVersion || env.IssueCount != 2 || env.DataHash == "" {
+		t.Fatalf("bad header: %+v", env.CanonicalHeader)
+	}
+	second := env.Issues[1]
+	if env.Issues[0].ID != "bv-1" || second.ID != "bv-2" {
+		t.Fatalf("issues not sorted by ID: %s, %s", env.Issues[0].ID, second.ID)
+	}
+	if !reflect.DeepEqual(second.Labels, []string{"api", "ui"}) || second.Comments[0].Text != "first" {
+		t.Errorf("labels/comments not canonical: %v %+v", second.Labels, second.Comments[0])
+	}
+	if second.Status != "open" || second.CreatedAt.Location() != time.UTC {
+		t.Errorf("status %q / time %v not normalized", second.Status, second.CreatedAt)
+	}
+	var estimate bytes.Buffer
+	if err := json.Compact(&estimate, second.CustomFields["estimate"]); err != nil || estimate.String() != `{"points":3}` {
+		t.Errorf("custom field estimate = %s", second.CustomFields["estimate"])
+	}
+
+	// canonical JSON -> canonical JSONL -> beads must preserve everything.
+	fromJSON, err := ReadInterchange(bytes.NewReader(canonical.Bytes()), FormatAuto)
+	if err != nil || fromJSON.Format != FormatCanonicalJSON {
+		t.Fatalf("reading canonical JSON: format=%v err=%v", fromJSON, err)
+	}
+	var jsonl bytes.Buffer
+	if err := WriteInterchange(&jsonl, fromJSON.Issues, FormatCanonicalJSONL, exportedAt); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(jsonl.String(), "\n"); lines != 3 {
+		t.Fatalf("JSONL should be a header plus 2 issues, got %d lines", lines)
+	}
+	fromJSONL, err := ReadInterchange(bytes.NewReader(jsonl.Bytes()), FormatAuto)
+	if err != nil || fromJSONL.Format != FormatCanonicalJSONL {
+		t.Fatalf("reading canonical JSONL: %v %v", fromJSONL, err)
+	}
+	var beads bytes.Buffer
+	if err := WriteInterchange(&beads, fromJSONL.Issues, FormatBeads, exportedAt); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(beads.String(), `"sprint":"s1"`) || strings.Contains(beads.String(), "custom_fields") {
+		t.Errorf("beads output should flatten custom fields: %s", beads.String())
+	}
+
+	back, err := ReadInterchange(bytes.NewReader(beads.Bytes()), FormatAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again bytes.Buffer
+	if err := WriteInterchange(&again, back.Issues, FormatCanonicalJSON, exportedAt); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != canonical.String() {
+		t.Errorf("round trip changed the canonical form:\n%s\nvs\n%s", again.String(), canonical.String())
+	}
+}
+
+func TestReadInterchangeRejectsNewerVersion(t *testing.T) {
+	doc := `{"format":"beads_viewer.issues","version":99,"issue_count":0,"issues":[]}`
+	if _, err := ReadInterchange(strings.NewReader(doc), FormatAuto); err == nil || !strings.Contains(err.Error(), "version 99") {
+		t.Fatalf("expected version error, got %v", err)
+	}
+	if _, err := ReadInterchange(strings.NewReader(`{"id":"x"}`), FormatCanonicalJSONL); err == nil {
+		t.Fatal("JSONL without a canonical header should be rejected")
+	}
+}
+
+func TestParseInterchangeFormat(t *testing.T) {
+	for in, want := range map[string]InterchangeFormat{"": FormatAuto, "BEADS": FormatBeads, "json": FormatCanonicalJSON, "ndjson": FormatCanonicalJSONL} {
+		if got, err := ParseInterchangeFormat(in); err != nil || got != want {
+			t.Errorf("ParseInterchangeFormat(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseInterchangeFormat("yaml"); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
@@ -0,0 +1,111 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+// GenerateCutLineMarkdown renders a release cut-line plan: what ships, what
+// slips past the line, and which items move the line if they slip.
+func GenerateCutLineMarkdown(plan analysis.CutLinePlan, title string) string {
+	var sb strings.Builder
+	if strings.TrimSpace(title) == "" {
+		title = "Release Cut Line"
+	}
+
+	sb.WriteString(fmt.Sprintf("# ✂️ %s\n\n", title))
+	sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", time.Now().Format("2006-01-02 15:04")))
+
+	sb.WriteString("| Setting | Value |\n|---------|-------|\n")
+	if plan.TargetID != "" {
+		sb.WriteString(fmt.Sprintf("| **Target Issue** | `%s` |\n", plan.TargetID))
+	}
+	if plan.TargetDate != nil {
+		sb.WriteString(fmt.Sprintf("| **Target Date** | %s |\n", plan.TargetDate.Format("2006-01-02")))
+	}
+	if plan.LineDate != nil {
+		sb.WriteString(fmt.Sprintf("| **Projected Line** | %s |\n", plan.LineDate.Format("2006-01-02")))
+	}
+	if plan.SlackDays != nil {
+		sb.WriteString(fmt.Sprintf("| **Slack** | %s |\n", formatSlackDays(*plan.SlackDays)))
+	}
+	sb.WriteString(fmt.Sprintf("| **Agents** | %d |\n", plan.Agents))
+	sb.WriteString(fmt.Sprintf("| **Velocity** | %.0f min/day per agent |\n\n", plan.VelocityMinutesPerDay))
+
+	if len(plan.LineIDs) > 0 {
+		sb.WriteString("## ⚠️ Moves the Line\n\n")
+		sb.WriteString("A slip in any of these pushes the projected line out:\n\n")
+		chain := make([]string, len(plan.LineIDs))
+		for i, id := range plan.LineIDs {
+			chain[i] = fmt.Sprintf("`%s`", id)
+		}
+		sb.WriteString(strings.Join(chain, " → "))
+		sb.WriteString("\n\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("## ✅ In the Cut (%d)\n\n", len(plan.InCut)))
+	if len(plan.InCut) == 0 {
+		sb.WriteString("*Nothing is projected to make the cut.*\n\n")
+	} else {
+		sb.WriteString("| | ID | Title | Priority | Estimate | Finish |\n")
+		sb.WriteString("|---|----|-------|----------|----------|--------|\n")
+		for _, it := range plan.InCut {
+			marker := ""
+			if it.OnLine {
+				marker = "⚠️"
+			}
+			sb.WriteString(fmt.Sprintf("| %s | `%s` | %s | P%d | %s | %s |\n",
+				marker, it.ID, escapeTableCell(it.Title), it.Priority, formatEstimate(it.EstimatedMinutes), formatCutDate(it.Finish)))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("## ⏭️ After the Cut (%d)\n\n", len(plan.AfterCut)))
+	if len(plan.AfterCut) == 0 {
+		sb.WriteString("*All open work makes the cut.*\n\n")
+	} else {
+		sb.WriteString("| ID | Title | Priority | Finish | Reason |\n")
+		sb.WriteString("|----|-------|----------|--------|--------|\n")
+		for _, it := range plan.AfterCut {
+			sb.WriteString(fmt.Sprintf("| `%s` | %s | P%d | %s | %s |\n",
+				it.ID, escapeTableCell(it.Title), it.Priority, formatCutDate(it.Finish), it.Reason))
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// SaveCutLineMarkdown writes the cut-line report to a file
+func SaveCutLineMarkdown(plan analysis.CutLinePlan, title, filename string) error {
+	return os.WriteFile(filename, []byte(GenerateCutLineMarkdown(plan, title)), 0644)
+}
+
+func formatSlackDays(days float64) string {
+	if days < 0 {
+		return fmt.Sprintf("%.1f days late", -days)
+	}
+	return fmt.Sprintf("%.1f days", days)
+}
+
+func formatEstimate(minutes int) string {
+	if minutes >= 60 {
+		return fmt.Sprintf("%.1fh", float64(minutes)/60)
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
+
+func formatCutDate(t *time.Time) string {
+	if t == nil {
+		return "—"
+	}
+	return t.Format("2006-01-02")
+}
+
+func escapeTableCell(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "|", "\\|"), "\n", " ")
+}
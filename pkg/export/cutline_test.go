@@ -0,0 +1,44 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+func TestGenerateCutLineMarkdown(t *testing.T) {
+	finish := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	target := time.Date(2025, 3, 10, 23, 59, 0, 0, time.UTC)
+	slack := target.Sub(finish).Hours() / 24
+	plan := analysis.CutLinePlan{
+		TargetID:   "rel",
+		TargetDate: &target,
+		LineDate:   &finish,
+		SlackDays:  &slack,
+		Agents:     2,
+		InCut: []analysis.CutLineItem{
+			{ID: "api", Title: "API | v2", Priority: 1, EstimatedMinutes: 480, Finish: &finish, OnLine: true},
+		},
+		AfterCut: []analysis.CutLineItem{
+			{ID: "polish", Title: "Polish", Priority: 3, Reason: analysis.CutReasonNotRequired},
+		},
+		LineIDs: []string{"api", "rel"},
+	}
+
+	md := GenerateCutLineMarkdown(plan, "")
+	for _, want := range []string{
+		"# ✂️ Release Cut Line",
+		"| **Target Issue** | `rel` |",
+		"| **Projected Line** | 2025-03-14 |",
+		"days late",
+		"`api` → `rel`",
+		"| ⚠️ | `api` | API \\| v2 | P1 | 8.0h | 2025-03-14 |",
+		"| `polish` | Polish | P3 | — | not required by target |",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown missing %q:\n%s", want, md)
+		}
+	}
+}
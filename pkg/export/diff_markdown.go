@@ -0,0 +1,281 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DiffMarkdownOptions controls the changelog report between two snapshots
+type DiffMarkdownOptions struct {
+	Title string // defaults to "Changes"
+	Since string // label for the old snapshot, e.g. a git ref or date
+}
+
+// diffPageRankMovers caps the PageRank table of the graph section
+const diffPageRankMovers = 10
+
+// issueTransition is one field moving from one value to another
+type issueTransition struct {
+	Issue    model.Issue
+	From, To string
+	Raised   bool // priority moved toward P0
+}
+
+// depChange is a dependency added to or removed from an issue
+type depChange struct {
+	Issue model.Issue
+	Dep   model.Dependency
+}
+
+// snapshotChanges is everything GenerateDiffMarkdown reports, sorted by ID
+type snapshotChanges struct {
+	New, Closed, Reopened, Removed []model.Issue
+	Status, Priority               []issueTransition
+	DepsAdded, DepsRemoved         []depChange
+}
+
+// GenerateDiffMarkdown renders a changelog-style report of what changed
+// between two snapshots: new, closed, reopened and removed issues, status and
+// priority transitions, added or removed dependencies, and the graph changes
+// they caused (issues that became blocked or unblocked, PageRank movers).
+func GenerateDiffMarkdown(oldIssues, newIssues []model.Issue) string {
+	return GenerateDiffMarkdownWithOptions(oldIssues, newIssues, DiffMarkdownOptions{})
+}
+
+// GenerateDiffMarkdownWithOptions is GenerateDiffMarkdown with a custom title
+// and "since" label.
+func GenerateDiffMarkdownWithOptions(oldIssues, newIssues []model.Issue, opts DiffMarkdownOptions) string {
+	c := compareForChangelog(oldIssues, newIssues)
+	g := analysis.DiffGraphs(analysis.NewSnapshot(oldIssues), analysis.NewSnapshot(newIssues))
+	blocked, unblocked := g.NewlyBlocked(), g.Unblocked()
+	title := strings.TrimSpace(opts.Title)
+	if title == "" {
+		title = "Changes"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# 📰 %s\n\n", title))
+	if opts.Since != "" {
+		sb.WriteString(fmt.Sprintf("*Since %s · Generated: %s*\n\n", opts.Since, time.Now().Format("2006-01-02 15:04")))
+	} else {
+		sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", time.Now().Format("2006-01-02 15:04")))
+	}
+
+	sb.WriteString("| Change | Count |\n|--------|-------|\n")
+	sb.WriteString(fmt.Sprintf("| New | %d |\n", len(c.New)))
+	sb.WriteString(fmt.Sprintf("| Closed | %d |\n", len(c.Closed)))
+	sb.WriteString(fmt.Sprintf("| Reopened | %d |\n", len(c.Reopened)))
+	sb.WriteString(fmt.Sprintf("| Removed | %d |\n", len(c.Removed)))
+	sb.WriteString(fmt.Sprintf("| Status changes | %d |\n", len(c.Status)))
+	sb.WriteString(fmt.Sprintf("| Priority changes | %d |\n", len(c.Priority)))
+	sb.WriteString(fmt.Sprintf("| Dependencies added | %d |\n", len(c.DepsAdded)))
+	sb.WriteString(fmt.Sprintf("| Dependencies removed | %d |\n", len(c.DepsRemoved)))
+	sb.WriteString(fmt.Sprintf("| Newly blocked | %d |\n", len(blocked)))
+	sb.WriteString(fmt.Sprintf("| Unblocked | %d |\n\n", len(unblocked)))
+
+	if len(c.New)+len(c.Closed)+len(c.Reopened)+len(c.Removed)+len(c.Status)+
+		len(c.Priority)+len(c.DepsAdded)+len(c.DepsRemoved)+len(g.BlockedFlips) == 0 {
+		sb.WriteString("*No changes.*\n")
+		return sb.String()
+	}
+
+	writeIssueList(&sb, "🆕 New Issues", c.New)
+	writeIssueList(&sb, "✅ Closed", c.Closed)
+	writeIssueList(&sb, "🔁 Reopened", c.Reopened)
+	writeIssueList(&sb, "🗑️ Removed", c.Removed)
+
+	if len(c.Status) > 0 {
+		sb.WriteString(fmt.Sprintf("## 🔀 Status Changes (%d)\n\n", len(c.Status)))
+		sb.WriteString("| ID | Title | From | To |\n|----|-------|------|----|\n")
+		for _, t := range c.Status {
+			sb.WriteString(fmt.Sprintf("| `%s` | %s | %s %s | %s %s |\n",
+				t.Issue.ID, escapeTableCell(t.Issue.Title),
+				getStatusEmoji(t.From), t.From, getStatusEmoji(t.To), t.To))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(c.Priority) > 0 {
+		sb.WriteString(fmt.Sprintf("## ⚡ Priority Changes (%d)\n\n", len(c.Priority)))
+		sb.WriteString("| | ID | Title | From | To |\n|---|----|-------|------|----|\n")
+		for _, t := range c.Priority {
+			arrow := "⬇️"
+			if t.Raised {
+				arrow = "⬆️"
+			}
+			sb.WriteString(fmt.Sprintf("| %s | `%s` | %s | %s | %s |\n",
+				arrow, t.Issue.ID, escapeTableCell(t.Issue.Title), t.From, t.To))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(c.DepsAdded)+len(c.DepsRemoved) > 0 {
+		sb.WriteString(fmt.Sprintf("## 🔗 Dependency Changes (%d)\n\n", len(c.DepsAdded)+len(c.DepsRemoved)))
+		for _, d := range c.DepsAdded {
+			sb.WriteString(fmt.Sprintf("- ➕ `%s` now depends on `%s` (%s)\n", d.Issue.ID, d.Dep.DependsOnID, depTypeLabel(d.Dep.Type)))
+		}
+		for _, d := range c.DepsRemoved {
+			sb.WriteString(fmt.Sprintf("- ➖ `%s` no longer depends on `%s` (%s)\n", d.Issue.ID, d.Dep.DependsOnID, depTypeLabel(d.Dep.Type)))
+		}
+		sb.WriteString("\n")
+	}
+
+	writeGraphChanges(&sb, g, blocked, unblocked)
+
+	return sb.String()
+}
+
+// writeGraphChanges renders blocked flips and the largest PageRank moves
+func writeGraphChanges(sb *strings.Builder, g *analysis.GraphDiff, blocked, unblocked []analysis.BlockedFlip) {
+	if len(g.BlockedFlips) == 0 && len(g.PageRankMoves) == 0 {
+		return
+	}
+	sb.WriteString("## 🕸️ Graph Changes\n\n")
+
+	if len(blocked) > 0 {
+		sb.WriteString(fmt.Sprintf("### ⛔ Newly Blocked (%d)\n\n", len(blocked)))
+		for _, f := range blocked {
+			sb.WriteString(fmt.Sprintf("- `%s` %s · blocked by `%s`\n", f.ID, f.Title, strings.Join(f.Blockers, "`, `")))
+		}
+		sb.WriteString("\n")
+	}
+	if len(unblocked) > 0 {
+		sb.WriteString(fmt.Sprintf("### 🔓 Unblocked (%d)\n\n", len(unblocked)))
+		for _, f := range unblocked {
+			sb.WriteString(fmt.Sprintf("- `%s` %s\n", f.ID, f.Title))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(g.PageRankMoves) > 0 {
+		moves := g.PageRankMoves
+		if len(moves) > diffPageRankMovers {
+			moves = moves[:diffPageRankMovers]
+		}
+		sb.WriteString("### 📈 PageRank Movers\n\n")
+		sb.WriteString("| ID | Title | Rank | PageRank |\n|----|-------|------|----------|\n")
+		for _, m := range moves {
+			sb.WriteString(fmt.Sprintf("| `%s` | %s | #%d → #%d | %.4f → %.4f (%+.4f) |\n",
+				m.ID, escapeTableCell(m.Title), m.OldRank, m.NewRank, m.Old, m.New, m.Delta))
+		}
+		sb.WriteString("\n")
+	}
+}
+
+// SaveDiffMarkdown writes the changelog report to a file
+func SaveDiffMarkdown(oldIssues, newIssues []model.Issue, opts DiffMarkdownOptions, filename string) error {
+	return os.WriteFile(filename, []byte(GenerateDiffMarkdownWithOptions(oldIssues, newIssues, opts)), 0644)
+}
+
+func writeIssueList(sb *strings.Builder, heading string, issues []model.Issue) {
+	if len(issues) == 0 {
+		return
+	}
+	sb.WriteString(fmt.Sprintf("## %s (%d)\n\n", heading, len(issues)))
+	for _, i := range issues {
+		sb.WriteString(fmt.Sprintf("- %s `%s` %s · P%d", getTypeEmoji(string(i.IssueType)), i.ID, i.Title, i.Priority))
+		if i.Assignee != "" {
+			sb.WriteString(" · @" + i.Assignee)
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+}
+
+// depTypeLabel names a dependency type, reading legacy empty types as blocks
+func depTypeLabel(t model.DependencyType) string {
+	if t == "" {
+		return string(model.DepBlocks)
+	}
+	return string(t)
+}
+
+// compareForChangelog diffs two snapshots by issue ID. Closing and reopening
+// are reported in their own lists rather than as status changes.
+func compareForChangelog(oldIssues, newIssues []model.Issue) snapshotChanges {
+	var c snapshotChanges
+	before := make(map[string]model.Issue, len(oldIssues))
+	for _, i := range oldIssues {
+		before[i.ID] = i
+	}
+	after := make(map[string]bool, len(newIssues))
+
+	for _, cur := range newIssues {
+		after[cur.ID] = true
+		prev, existed := before[cur.ID]
+		if !existed {
+			c.New = append(c.New, cur)
+			continue
+		}
+
+		wasClosed, isClosed := isClosedLikeStatus(prev.Status), isClosedLikeStatus(cur.Status)
+		switch {
+		case !wasClosed && isClosed:
+			c.Closed = append(c.Closed, cur)
+		case wasClosed && !isClosed:
+			c.Reopened = append(c.Reopened, cur)
+		case prev.Status != cur.Status:
+			c.Status = append(c.Status, issueTransition{Issue: cur, From: string(prev.Status), To: string(cur.Status)})
+		}
+		if prev.Priority != cur.Priority {
+			c.Priority = append(c.Priority, issueTransition{
+				Issue:  cur,
+				From:   fmt.Sprintf("P%d", prev.Priority),
+				To:     fmt.Sprintf("P%d", cur.Priority),
+				Raised: cur.Priority < prev.Priority,
+			})
+		}
+
+		oldDeps, newDeps := changelogDeps(prev), changelogDeps(cur)
+		for key, dep := range newDeps {
+			if _, ok := oldDeps[key]; !ok {
+				c.DepsAdded = append(c.DepsAdded, depChange{Issue: cur, Dep: dep})
+			}
+		}
+		for key, dep := range oldDeps {
+			if _, ok := newDeps[key]; !ok {
+				c.DepsRemoved = append(c.DepsRemoved, depChange{Issue: cur, Dep: dep})
+			}
+		}
+	}
+	for _, prev := range oldIssues {
+		if !after[prev.ID] {
+			c.Removed = append(c.Removed, prev)
+		}
+	}
+
+	for _, list := range [][]model.Issue{c.New, c.Closed, c.Reopened, c.Removed} {
+		sort.SliceStable(list, func(a, b int) bool { return list[a].ID < list[b].ID })
+	}
+	for _, list := range [][]issueTransition{c.Status, c.Priority} {
+		sort.SliceStable(list, func(a, b int) bool { return list[a].Issue.ID < list[b].Issue.ID })
+	}
+	for _, list := range [][]depChange{c.DepsAdded, c.DepsRemoved} {
+		sort.SliceStable(list, func(a, b int) bool {
+			if list[a].Issue.ID != list[b].Issue.ID {
+				return list[a].Issue.ID < list[b].Issue.ID
+			}
+			return list[a].Dep.DependsOnID < list[b].Dep.DependsOnID
+		})
+	}
+	return c
+}
+
+// changelogDeps keys an issue's dependencies by target and type, so a type
+// change shows as one removal plus one addition
+func changelogDeps(i model.Issue) map[string]model.Dependency {
+	deps := make(map[string]model.Dependency, len(i.Dependencies))
+	for _, d := range i.Dependencies {
+		if d == nil || d.DependsOnID == "" {
+			continue
+		}
+		deps[d.DependsOnID+"\x00"+depTypeLabel(d.Type)] = *d
+	}
+	return deps
+}
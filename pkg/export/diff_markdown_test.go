@@ -0,0 +1,65 @@
+package export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestGenerateDiffMarkdown(t *testing.T) {
+	old := []model.Issue{
+		{ID: "a", Title: "Alpha", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{DependsOnID: "b", Type: model.DepBlocks}}},
+		{ID: "b", Title: "Beta", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeBug},
+		{ID: "c", Title: "Gamma", Status: model.StatusClosed, Priority: 3, IssueType: model.TypeTask},
+		{ID: "d", Title: "Delta", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask},
+		{ID: "gone", Title: "Gone", Status: model.StatusOpen, IssueType: model.TypeChore},
+	}
+	cur := []model.Issue{
+		{ID: "a", Title: "Alpha", Status: model.StatusInProgress, Priority: 0, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{DependsOnID: "d", Type: model.DepRelated}}},
+		{ID: "b", Title: "Beta | fix", Status: model.StatusClosed, Priority: 3, IssueType: model.TypeBug},
+		{ID: "c", Title: "Gamma", Status: model.StatusOpen, Priority: 3, IssueType: model.TypeTask},
+		{ID: "d", Title: "Delta", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask},
+		{ID: "e", Title: "Epsilon", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeFeature, Assignee: "kim"},
+	}
+
+	md := GenerateDiffMarkdownWithOptions(old, cur, DiffMarkdownOptions{Title: "Weekly", Since: "v1.0"})
+	for _, want := range []string{
+		"# 📰 Weekly",
+		"*Since v1.0 · Generated:",
+		"| New | 1 |",
+		"| Dependencies added | 1 |",
+		"## 🆕 New Issues (1)\n\n- ✨ `e` Epsilon · P1 · @kim",
+		"## ✅ Closed (1)\n\n- 🐛 `b` Beta | fix · P3",
+		"## 🔁 Reopened (1)\n\n- 📋 `c` Gamma · P3",
+		"## 🗑️ Removed (1)\n\n- 🧹 `gone` Gone · P0",
+		"| `a` | Alpha | 🟢 open | 🔵 in_progress |",
+		"| ⬆️ | `a` | Alpha | P2 | P0 |",
+		"| ⬇️ | `b` | Beta \\| fix | P1 | P3 |",
+		"- ➕ `a` now depends on `d` (related)",
+		"- ➖ `a` no longer depends on `b` (blocks)",
+		"| Newly blocked | 0 |",
+		"| Unblocked | 1 |",
+		"## 🕸️ Graph Changes",
+		"### 🔓 Unblocked (1)\n\n- `a` Alpha\n",
+		"### 📈 PageRank Movers",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("missing %q in:\n%s", want, md)
+		}
+	}
+	// Closing is reported once, not again as a status change.
+	if strings.Contains(md, "| `b` | Beta \\| fix | 🟢 open") {
+		t.Error("closed issue also listed under status changes")
+	}
+}
+
+func TestGenerateDiffMarkdown_NoChanges(t *testing.T) {
+	issues := []model.Issue{{ID: "a", Title: "A", Status: model.StatusOpen, IssueType: model.TypeTask}}
+	md := GenerateDiffMarkdown(issues, issues)
+	if !strings.Contains(md, "# 📰 Changes") || !strings.Contains(md, "*No changes.*") {
+		t.Errorf("unexpected report:\n%s", md)
+	}
+}
@@ -0,0 +1,304 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Gantt grouping modes for TerminalGanttOptions.GroupBy
+const (
+	GanttGroupTrack     = "track"     // one section per agent lane
+	GanttGroupMilestone = "milestone" // one section per parent epic
+	GanttGroupLayer     = "layer"     // one section per dependency depth layer
+)
+
+// TerminalGanttOptions configures RenderTerminalGantt.
+type TerminalGanttOptions struct {
+	Width   int    // Total line width in columns (default 100, minimum 60)
+	GroupBy string // GanttGroupTrack (default), GanttGroupMilestone or GanttGroupLayer
+	ASCII   bool   // Use plain ASCII instead of block and box-drawing characters
+}
+
+type ganttGlyphs struct {
+	active, planned, due, arrow, today, rule, tick, marker, from string
+}
+
+var (
+	unicodeGanttGlyphs = ganttGlyphs{active: "█", planned: "▒", due: "◆", arrow: "▶", today: "┊", rule: "─", tick: "┬", marker: "▼", from: "←"}
+	plainGanttGlyphs   = ganttGlyphs{active: "#", planned: "=", due: "!", arrow: ">", today: "|", rule: "-", tick: "+", marker: "v", from: "<-"}
+)
+
+// ganttGroup is one titled section of rows
+type ganttGroup struct {
+	key   string
+	title string
+	items []analysis.ScheduledIssue
+}
+
+// RenderTerminalGantt writes a Gantt chart of the projected schedule that fits
+// in a terminal: one bar per issue, a today marker, due-date diamonds, and a
+// ▶ in front of bars that wait on a dependency. At widths of 100 columns or
+// more each row also names its blockers.
+func RenderTerminalGantt(w io.Writer, sched analysis.Schedule, issues []model.Issue, opts TerminalGanttOptions) error {
+	g := unicodeGanttGlyphs
+	if opts.ASCII {
+		g = plainGanttGlyphs
+	}
+	width := opts.Width
+	if width <= 0 {
+		width = 100
+	}
+	if width < 60 {
+		width = 60
+	}
+	labelW := width / 3
+	if labelW < 20 {
+		labelW = 20
+	}
+	if labelW > 36 {
+		labelW = 36
+	}
+	suffixW := 0
+	if width >= 100 {
+		suffixW = 18
+	}
+	chartW := width - labelW - 2 - suffixW
+
+	bw := bufio.NewWriter(w)
+	if len(sched.Items) == 0 {
+		fmt.Fprintln(bw, "Gantt: no open issues to schedule")
+		writeGanttUnscheduled(bw, sched)
+		return bw.Flush()
+	}
+
+	// Time axis: start of today through the last finish or due date.
+	y, m, d := sched.Now.Date()
+	t0 := time.Date(y, m, d, 0, 0, 0, 0, sched.Now.Location())
+	t1 := t0.Add(24 * time.Hour)
+	for _, it := range sched.Items {
+		if it.Finish.After(t1) {
+			t1 = it.Finish
+		}
+		if it.DueDate != nil && it.DueDate.After(t1) {
+			t1 = *it.DueDate
+		}
+	}
+	span := t1.Sub(t0)
+	col := func(t time.Time) int {
+		c := int(float64(t.Sub(t0)) / float64(span) * float64(chartW-1))
+		if c < 0 {
+			return 0
+		}
+		if c > chartW-1 {
+			return chartW - 1
+		}
+		return c
+	}
+	todayCol := col(sched.Now)
+
+	end := sched.Items[0].Finish
+	for _, it := range sched.Items {
+		if it.Finish.After(end) {
+			end = it.Finish
+		}
+	}
+	agentWord := "agents"
+	if sched.Agents == 1 {
+		agentWord = "agent"
+	}
+	fmt.Fprintf(bw, "Gantt: %d open issues on %d %s, done ~%s (%.1f days at %.0f min/day per agent)\n\n",
+		len(sched.Items), sched.Agents, agentWord, end.Format("Mon Jan 2"),
+		end.Sub(sched.Now).Hours()/24, sched.VelocityMinutesPerDay)
+
+	// Axis: date labels over tick marks, with the today marker.
+	labels := []rune(strings.Repeat(" ", chartW))
+	ticks := make([]string, chartW)
+	for i := range ticks {
+		ticks[i] = g.rule
+	}
+	next := 0
+	for day := t0; !day.After(t1); day = day.AddDate(0, 0, 1) {
+		c := col(day)
+		text := []rune(day.Format("Jan 2"))
+		if c < next {
+			continue
+		}
+		if c+len(text) > chartW {
+			break
+		}
+		copy(labels[c:], text)
+		ticks[c] = g.tick
+		next = c + len(text) + 3
+	}
+	ticks[todayCol] = g.marker
+	pad := strings.Repeat(" ", labelW+2)
+	fmt.Fprintf(bw, "%s%s\n", pad, strings.TrimRight(string(labels), " "))
+	fmt.Fprintf(bw, "%s%s\n", pad, strings.Join(ticks, ""))
+
+	for _, grp := range ganttGroups(sched, issues, opts.GroupBy) {
+		fmt.Fprintf(bw, "%s\n", grp.title)
+		for _, it := range grp.items {
+			cells := make([]string, chartW)
+			for i := range cells {
+				cells[i] = " "
+			}
+			cells[todayCol] = g.today
+
+			bar := g.planned
+			if it.Status == string(model.StatusInProgress) {
+				bar = g.active
+			}
+			s, f := col(it.Start), col(it.Finish)
+			if f <= s {
+				f = s + 1
+			}
+			for c := s; c < f && c < chartW; c++ {
+				cells[c] = bar
+			}
+			if len(it.Blockers) > 0 && s > 0 {
+				cells[s-1] = g.arrow
+			}
+			late := false
+			if it.DueDate != nil {
+				cells[col(*it.DueDate)] = g.due
+				late = it.Finish.After(*it.DueDate)
+			}
+
+			label := it.ID
+			if title := strings.Join(strings.Fields(it.Title), " "); title != "" {
+				label += " " + title
+			}
+			line := "  " + padRunes(truncateRunes(label, labelW-1), labelW) + strings.Join(cells, "")
+
+			if suffixW > 0 {
+				var notes []string
+				if late {
+					notes = append(notes, "late")
+				}
+				if len(it.Blockers) > 0 {
+					notes = append(notes, g.from+" "+strings.Join(it.Blockers, ","))
+				}
+				if len(notes) > 0 {
+					line += " " + truncateRunes(strings.Join(notes, " "), suffixW-1)
+				}
+			} else if late {
+				line += " late"
+			}
+			fmt.Fprintln(bw, strings.TrimRight(line, " "))
+		}
+		fmt.Fprintln(bw)
+	}
+
+	fmt.Fprintf(bw, "%s in progress  %s planned  %s due  %s waits on dependency  %s today\n",
+		g.active, g.planned, g.due, g.arrow, g.today)
+	writeGanttUnscheduled(bw, sched)
+	return bw.Flush()
+}
+
+func writeGanttUnscheduled(w io.Writer, sched analysis.Schedule) {
+	if len(sched.Unscheduled) > 0 {
+		fmt.Fprintf(w, "Not scheduled (dependency cycle): %s\n", strings.Join(sched.Unscheduled, ", "))
+	}
+}
+
+// padRunes right-pads s with spaces to n runes
+func padRunes(s string, n int) string {
+	if pad := n - len([]rune(s)); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+	return s
+}
+
+// ganttGroups splits scheduled items into sections. Track mode groups by agent
+// lane; layer mode by the issue's topological layer; milestone mode groups by
+// the nearest epic ancestor (parent-child links), with an epic itself listed
+// in its own section.
+func ganttGroups(sched analysis.Schedule, issues []model.Issue, groupBy string) []ganttGroup {
+	if groupBy == GanttGroupLayer {
+		var layers [][]analysis.ScheduledIssue
+		for _, it := range sched.Items {
+			for len(layers) <= it.Layer {
+				layers = append(layers, nil)
+			}
+			layers[it.Layer] = append(layers[it.Layer], it)
+		}
+		var groups []ganttGroup
+		for i, layer := range layers {
+			if len(layer) > 0 {
+				groups = append(groups, ganttGroup{title: fmt.Sprintf("Layer %d", i+1), items: layer})
+			}
+		}
+		return groups
+	}
+	if groupBy != GanttGroupMilestone {
+		lanes := make([][]analysis.ScheduledIssue, sched.Agents)
+		for _, it := range sched.Items {
+			if it.Agent >= 1 && it.Agent <= sched.Agents {
+				lanes[it.Agent-1] = append(lanes[it.Agent-1], it)
+			}
+		}
+		var groups []ganttGroup
+		for i, lane := range lanes {
+			if len(lane) > 0 {
+				groups = append(groups, ganttGroup{title: fmt.Sprintf("Agent %d", i+1), items: lane})
+			}
+		}
+		return groups
+	}
+
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	milestoneOf := func(id string) string {
+		seen := make(map[string]bool)
+		for cur := id; cur != "" && !seen[cur]; {
+			seen[cur] = true
+			iss, ok := byID[cur]
+			if !ok {
+				return ""
+			}
+			if iss.IssueType == model.TypeEpic {
+				return cur
+			}
+			parent := ""
+			for _, dep := range iss.Dependencies {
+				if dep != nil && dep.Type == model.DepParentChild {
+					parent = dep.DependsOnID
+					break
+				}
+			}
+			cur = parent
+		}
+		return ""
+	}
+
+	index := make(map[string]int)
+	var groups []ganttGroup
+	for _, it := range sched.Items {
+		key := milestoneOf(it.ID)
+		idx, ok := index[key]
+		if !ok {
+			title := "No milestone"
+			if epic, found := byID[key]; found {
+				title = fmt.Sprintf("%s %s", epic.ID, strings.Join(strings.Fields(epic.Title), " "))
+			}
+			idx = len(groups)
+			index[key] = idx
+			groups = append(groups, ganttGroup{key: key, title: title})
+		}
+		groups[idx].items = append(groups[idx].items, it)
+	}
+	// Keep "No milestone" last; others stay in order of first start.
+	sort.SliceStable(groups, func(a, b int) bool {
+		return groups[a].key != "" && groups[b].key == ""
+	})
+	return groups
+}
@@ -0,0 +1,107 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestRenderTerminalGantt(t *testing.T) {
+	now := time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)
+	est60, est120, est240, est480 := 60, 120, 240, 480
+	due := now.Add(36 * time.Hour)
+	issues := []model.Issue{
+		{ID: "epic", Title: "Launch", IssueType: model.TypeEpic, Status: model.StatusOpen, Priority: 0, EstimatedMinutes: &est60},
+		{ID: "api", Title: "Build API", Status: model.StatusInProgress, Priority: 1, EstimatedMinutes: &est480, DueDate: &due,
+			Dependencies: []*model.Dependency{{IssueID: "api", DependsOnID: "epic", Type: model.DepParentChild}}},
+		{ID: "ui", Title: "Build UI", Status: model.StatusOpen, Priority: 2, EstimatedMinutes: &est240,
+			Dependencies: []*model.Dependency{
+				{IssueID: "ui", DependsOnID: "api", Type: model.DepBlocks},
+				{IssueID: "ui", DependsOnID: "epic", Type: model.DepParentChild},
+			}},
+		{ID: "misc", Title: "Tidy", Status: model.StatusOpen, Priority: 3, EstimatedMinutes: &est120},
+	}
+	sched := analysis.ComputeSchedule(issues, analysis.ScheduleOptions{Agents: 2, Now: now})
+
+	t.Run("tracks", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := RenderTerminalGantt(&buf, sched, issues, TerminalGanttOptions{Width: 120}); err != nil {
+			t.Fatalf("RenderTerminalGantt: %v", err)
+		}
+		out := buf.String()
+
+		for _, want := range []string{"Gantt: 4 open issues on 2 agents", "Agent 1\n", "Agent 2\n", "▼", "█", "◆", "▶", "← api", "┊ today"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("missing %q in:\n%s", want, out)
+			}
+		}
+		for _, line := range strings.Split(out, "\n") {
+			if n := len([]rune(line)); n > 120 {
+				t.Errorf("line is %d columns, want <= 120: %q", n, line)
+			}
+		}
+	})
+
+	t.Run("milestones ascii", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := RenderTerminalGantt(&buf, sched, issues, TerminalGanttOptions{Width: 80, GroupBy: GanttGroupMilestone, ASCII: true}); err != nil {
+			t.Fatalf("RenderTerminalGantt: %v", err)
+		}
+		out := buf.String()
+
+		for _, r := range out {
+			if r > 127 {
+				t.Fatalf("non-ASCII rune %q in:\n%s", r, out)
+			}
+		}
+		epic := strings.Index(out, "epic Launch\n")
+		none := strings.Index(out, "No milestone\n")
+		if epic < 0 || none < 0 || epic > none {
+			t.Fatalf("expected epic section before No milestone:\n%s", out)
+		}
+		section := out[epic:none]
+		for _, id := range []string{"  epic ", "  api ", "  ui "} {
+			if !strings.Contains(section, id) {
+				t.Errorf("%q missing from epic section:\n%s", id, section)
+			}
+		}
+		if !strings.Contains(out[none:], "  misc ") {
+			t.Errorf("misc should be under No milestone:\n%s", out)
+		}
+		if strings.Contains(out, "<- api") {
+			t.Errorf("blocker notes should be omitted below 100 columns:\n%s", out)
+		}
+	})
+
+	t.Run("layers", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := RenderTerminalGantt(&buf, sched, issues, TerminalGanttOptions{Width: 100, GroupBy: GanttGroupLayer}); err != nil {
+			t.Fatalf("RenderTerminalGantt: %v", err)
+		}
+		out := buf.String()
+
+		first := strings.Index(out, "Layer 1\n")
+		second := strings.Index(out, "Layer 2\n")
+		if first < 0 || second < first {
+			t.Fatalf("expected Layer 1 then Layer 2:\n%s", out)
+		}
+		if !strings.Contains(out[first:second], "  api ") || !strings.Contains(out[second:], "  ui ") {
+			t.Errorf("ui should sit one layer below its blocker api:\n%s", out)
+		}
+	})
+}
+
+func TestRenderTerminalGantt_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	sched := analysis.Schedule{Unscheduled: []string{"a", "b"}}
+	if err := RenderTerminalGantt(&buf, sched, nil, TerminalGanttOptions{}); err != nil {
+		t.Fatalf("RenderTerminalGantt: %v", err)
+	}
+	if want := "Gantt: no open issues to schedule\nNot scheduled (dependency cycle): a, b\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
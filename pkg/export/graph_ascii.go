@@ -0,0 +1,156 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ASCIIGraphOptions configures ExportASCIIGraph.
+type ASCIIGraphOptions struct {
+	ASCII    bool // Use plain ASCII connectors instead of box-drawing characters
+	MaxTitle int  // Title width in runes (default 48, negative hides titles)
+	Reduce   bool // Hide redundant transitive edges (analysis.TransitiveReduction)
+}
+
+// asciiGlyphs holds the connector and status symbols for one character set.
+type asciiGlyphs struct {
+	branch, last, pipe, space, edge, lastEdge string
+	open, inProgress, blocked, closed         string
+}
+
+var (
+	unicodeGlyphs = asciiGlyphs{
+		branch: "├── ", last: "└── ", pipe: "│   ", space: "    ",
+		edge: "├─▶ ", lastEdge: "└─▶ ",
+		open: "○", inProgress: "◐", blocked: "⊘", closed: "●",
+	}
+	plainGlyphs = asciiGlyphs{
+		branch: "+-- ", last: "`-- ", pipe: "|   ", space: "    ",
+		edge: "+-> ", lastEdge: "`-> ",
+		open: "o", inProgress: "~", blocked: "x", closed: "*",
+	}
+)
+
+func (g asciiGlyphs) status(s model.Status) string {
+	switch {
+	case isClosedLikeStatus(s):
+		return g.closed
+	case s == model.StatusInProgress:
+		return g.inProgress
+	case s == model.StatusBlocked:
+		return g.blocked
+	default:
+		return g.open
+	}
+}
+
+// ExportASCIIGraph writes a compact text rendering of the blocking-dependency
+// DAG. Issues are grouped into topological layers (layer 1 has no blockers in
+// the set); under each issue, ─▶ lines list the issues it unblocks. Issues
+// caught in a dependency cycle cannot be layered and are listed last.
+func ExportASCIIGraph(w io.Writer, issues []model.Issue, opts ASCIIGraphOptions) error {
+	g := unicodeGlyphs
+	if opts.ASCII {
+		g = plainGlyphs
+	}
+	maxTitle := opts.MaxTitle
+	if maxTitle == 0 {
+		maxTitle = 48
+	}
+	if opts.Reduce {
+		issues, _ = reduceDependencies(issues)
+	}
+
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+
+	// Edges run blocker -> dependent.
+	dependents := make(map[string][]string, len(issues))
+	edgeCount := 0
+	for _, iss := range issues {
+		seen := make(map[string]bool)
+		for _, dep := range iss.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || dep.DependsOnID == iss.ID || seen[dep.DependsOnID] {
+				continue
+			}
+			if _, ok := byID[dep.DependsOnID]; !ok {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], iss.ID)
+			edgeCount++
+		}
+	}
+
+	layers := analysis.TopoLayers(issues)
+	maxLayer := layers.Depth()
+	groups := make(map[int][]*model.Issue, maxLayer+1)
+	for i := range issues {
+		iss := &issues[i]
+		// Layer 0 collects the cyclic issues, listed last.
+		l := layers.LayerOf(iss.ID) + 1
+		groups[l] = append(groups[l], iss)
+	}
+
+	order := func(ids []*model.Issue) {
+		sort.Slice(ids, func(a, b int) bool {
+			if ids[a].Priority != ids[b].Priority {
+				return ids[a].Priority < ids[b].Priority
+			}
+			return ids[a].ID < ids[b].ID
+		})
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "Dependency graph: %d issues, %d edges, %d layers\n", len(issues), edgeCount, maxLayer)
+
+	writeGroup := func(heading string, group []*model.Issue) {
+		order(group)
+		fmt.Fprintf(bw, "\n%s\n", heading)
+		for idx, iss := range group {
+			lastNode := idx == len(group)-1
+			prefix, child := g.branch, g.pipe
+			if lastNode {
+				prefix, child = g.last, g.space
+			}
+			line := fmt.Sprintf("%s%s %s  P%d", prefix, g.status(iss.Status), iss.ID, iss.Priority)
+			if maxTitle > 0 && iss.Title != "" {
+				line += "  " + truncateRunes(strings.Join(strings.Fields(iss.Title), " "), maxTitle)
+			}
+			fmt.Fprintln(bw, line)
+
+			deps := append([]string(nil), dependents[iss.ID]...)
+			sort.Strings(deps)
+			for j, d := range deps {
+				connector := g.edge
+				if j == len(deps)-1 {
+					connector = g.lastEdge
+				}
+				target := d
+				if l := layers.LayerOf(d); l >= 0 {
+					target = fmt.Sprintf("%s (L%d)", d, l+1)
+				}
+				fmt.Fprintf(bw, "%s%s%s\n", child, connector, target)
+			}
+		}
+	}
+
+	for l := 1; l <= maxLayer; l++ {
+		if len(groups[l]) > 0 {
+			writeGroup(fmt.Sprintf("Layer %d", l), groups[l])
+		}
+	}
+	if len(groups[0]) > 0 {
+		writeGroup("Cycle (cannot be layered)", groups[0])
+	}
+
+	return bw.Flush()
+}
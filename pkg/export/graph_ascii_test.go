@@ -0,0 +1,99 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestExportASCIIGraph(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Schema", Status: model.StatusClosed, Priority: 1},
+		{ID: "B", Title: "API", Status: model.StatusInProgress, Priority: 1, Dependencies: []*model.Dependency{
+			{IssueID: "B", DependsOnID: "A", Type: model.DepBlocks},
+		}},
+		{ID: "C", Title: "UI", Status: model.StatusOpen, Priority: 2, Dependencies: []*model.Dependency{
+			{IssueID: "C", DependsOnID: "B", Type: model.DepBlocks},
+			{IssueID: "C", DependsOnID: "A", Type: model.DepBlocks},
+		}},
+		{ID: "D", Title: "Docs", Status: model.StatusOpen, Priority: 3, Dependencies: []*model.Dependency{
+			{IssueID: "D", DependsOnID: "A", Type: model.DepRelated},
+		}},
+	}
+
+	t.Run("layers", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := ExportASCIIGraph(&buf, issues, ASCIIGraphOptions{}); err != nil {
+			t.Fatalf("ExportASCIIGraph: %v", err)
+		}
+		out := buf.String()
+
+		if !strings.HasPrefix(out, "Dependency graph: 4 issues, 3 edges, 3 layers\n") {
+			t.Fatalf("unexpected header:\n%s", out)
+		}
+		for _, want := range []string{
+			"Layer 1\n├── ● A  P1  Schema\n│   ├─▶ B (L2)\n│   └─▶ C (L3)\n└── ○ D  P3  Docs\n",
+			"Layer 2\n└── ◐ B  P1  API\n    └─▶ C (L3)\n",
+			"Layer 3\n└── ○ C  P2  UI\n",
+		} {
+			if !strings.Contains(out, want) {
+				t.Errorf("missing %q in:\n%s", want, out)
+			}
+		}
+	})
+
+	t.Run("plain ascii", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := ExportASCIIGraph(&buf, issues, ASCIIGraphOptions{ASCII: true, MaxTitle: -1}); err != nil {
+			t.Fatalf("ExportASCIIGraph: %v", err)
+		}
+		out := buf.String()
+		for _, r := range out {
+			if r > 127 {
+				t.Fatalf("non-ASCII rune %q in:\n%s", r, out)
+			}
+		}
+		if !strings.Contains(out, "+-- * A  P1\n|   +-> B (L2)\n|   `-> C (L3)\n") {
+			t.Errorf("unexpected ASCII rendering:\n%s", out)
+		}
+		if strings.Contains(out, "Schema") {
+			t.Errorf("titles should be hidden with negative MaxTitle:\n%s", out)
+		}
+	})
+}
+
+func TestExportASCIIGraph_Cycle(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "X", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "X", DependsOnID: "Y", Type: model.DepBlocks}}},
+		{ID: "Y", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "Y", DependsOnID: "X", Type: model.DepBlocks}}},
+		{ID: "Z", Status: model.StatusOpen},
+	}
+	var buf bytes.Buffer
+	if err := ExportASCIIGraph(&buf, issues, ASCIIGraphOptions{}); err != nil {
+		t.Fatalf("ExportASCIIGraph: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "Cycle (cannot be layered)\n├── ○ X  P0\n│   └─▶ Y\n└── ○ Y  P0\n    └─▶ X\n") {
+		t.Errorf("cycle members not grouped:\n%s", out)
+	}
+	if !strings.Contains(out, "Layer 1\n└── ○ Z  P0\n") {
+		t.Errorf("acyclic node missing from layer 1:\n%s", out)
+	}
+}
+
+func TestExportGraph_ASCIIFormat(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen},
+		{ID: "B", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "B", DependsOnID: "A", Type: model.DepBlocks}}},
+		{ID: "C", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "C", DependsOnID: "B", Type: model.DepBlocks}}},
+	}
+	result, err := ExportGraph(issues, nil, GraphExportConfig{Format: GraphFormatASCII})
+	if err != nil {
+		t.Fatalf("ExportGraph: %v", err)
+	}
+	if result.Format != "ascii" || !strings.Contains(result.Graph, "Layer 3") {
+		t.Errorf("unexpected ascii result: format=%q graph=\n%s", result.Format, result.Graph)
+	}
+}
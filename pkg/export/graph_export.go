@@ -18,15 +18,17 @@ const (
 	GraphFormatJSON    GraphExportFormat = "json"
 	GraphFormatDOT     GraphExportFormat = "dot"
 	GraphFormatMermaid GraphExportFormat = "mermaid"
+	GraphFormatASCII   GraphExportFormat = "ascii"
 )
 
 // GraphExportConfig configures graph export behavior.
 type GraphExportConfig struct {
-	Format   GraphExportFormat // Output format (json, dot, mermaid)
+	Format   GraphExportFormat // Output format (json, dot, mermaid, ascii)
 	Label    string            // Filter to specific label
 	Root     string            // Subgraph from specific root
 	Depth    int               // Max depth for subgraph (0 = unlimited)
 	DataHash string            // Hash of input data for provenance
+	Reduce   bool              // Hide redundant transitive edges (analysis.TransitiveReduction)
 }
 
 // GraphExportResult contains the exported graph and metadata.
@@ -38,6 +40,7 @@ type GraphExportResult struct {
 	FiltersApplied map[string]string `json:"filters_applied,omitempty"`
 	Explanation    GraphExplanation  `json:"explanation"`
 	DataHash       string            `json:"data_hash,omitempty"`
+	RedundantEdges int               `json:"redundant_edges,omitempty"` // hidden by Reduce
 	Adjacency      *AdjacencyGraph   `json:"adjacency,omitempty"`
 }
 
@@ -88,6 +91,11 @@ func ExportGraph(issues []model.Issue, stats *analysis.GraphStats, config GraphE
 		}, nil
 	}
 
+	redundant := 0
+	if config.Reduce {
+		filteredIssues, redundant = reduceDependencies(filteredIssues)
+	}
+
 	// Build issue ID set for edge filtering
 	issueIDs := make(map[string]bool, len(filteredIssues))
 	for _, i := range filteredIssues {
@@ -115,6 +123,9 @@ func ExportGraph(issues []model.Issue, stats *analysis.GraphStats, config GraphE
 	if config.Depth > 0 {
 		filtersApplied["depth"] = fmt.Sprintf("%d", config.Depth)
 	}
+	if config.Reduce {
+		filtersApplied["reduce"] = "transitive"
+	}
 
 	result := &GraphExportResult{
 		Format:         string(config.Format),
@@ -122,6 +133,7 @@ func ExportGraph(issues []model.Issue, stats *analysis.GraphStats, config GraphE
 		Edges:          edgeCount,
 		FiltersApplied: filtersApplied,
 		DataHash:       config.DataHash,
+		RedundantEdges: redundant,
 	}
 
 	switch config.Format {
@@ -143,6 +155,18 @@ func ExportGraph(issues []model.Issue, stats *analysis.GraphStats, config GraphE
 			WhenToUse:   "When you need an embeddable diagram for documentation or GitHub issues",
 		}
 
+	case GraphFormatASCII:
+		var sb strings.Builder
+		if err := ExportASCIIGraph(&sb, filteredIssues, ASCIIGraphOptions{}); err != nil {
+			return nil, err
+		}
+		result.Graph = sb.String()
+		result.Explanation = GraphExplanation{
+			What:        "Dependency graph as layered box-drawing text",
+			HowToRender: "Print as-is in a monospace font, or wrap in a ``` code block",
+			WhenToUse:   "When you need a graph that pastes into PRs, chat, or terminals without images",
+		}
+
 	case GraphFormatJSON:
 		fallthrough
 	default:
@@ -158,6 +182,54 @@ func ExportGraph(issues []model.Issue, stats *analysis.GraphStats, config GraphE
 	return result, nil
 }
 
+// redundantDependencies returns the blocking edges between issues that
+// analysis.TransitiveReduction drops, i.e. those implied by a longer path.
+func redundantDependencies(issues []model.Issue) map[analysis.GraphEdge]bool {
+	kept := make(map[analysis.GraphEdge]bool)
+	for _, e := range analysis.TransitiveReduction(issues) {
+		kept[e] = true
+	}
+	present := make(map[string]bool, len(issues))
+	for _, iss := range issues {
+		present[iss.ID] = true
+	}
+	redundant := make(map[analysis.GraphEdge]bool)
+	for _, iss := range issues {
+		for _, dep := range iss.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || dep.DependsOnID == iss.ID || !present[dep.DependsOnID] {
+				continue
+			}
+			if e := (analysis.GraphEdge{From: iss.ID, To: dep.DependsOnID}); !kept[e] {
+				redundant[e] = true
+			}
+		}
+	}
+	return redundant
+}
+
+// reduceDependencies returns copies of issues without their redundant blocking
+// dependencies, plus how many edges were hidden. Rendering the copies keeps
+// the drawn graph readable; the caller's issues are left untouched.
+func reduceDependencies(issues []model.Issue) ([]model.Issue, int) {
+	redundant := redundantDependencies(issues)
+	if len(redundant) == 0 {
+		return issues, 0
+	}
+	out := make([]model.Issue, len(issues))
+	for i, iss := range issues {
+		deps := make([]*model.Dependency, 0, len(iss.Dependencies))
+		for _, dep := range iss.Dependencies {
+			if dep != nil && dep.Type.IsBlocking() && redundant[analysis.GraphEdge{From: iss.ID, To: dep.DependsOnID}] {
+				continue
+			}
+			deps = append(deps, dep)
+		}
+		iss.Dependencies = deps
+		out[i] = iss
+	}
+	return out, len(redundant)
+}
+
 // filterIssues applies label and root filters to the issue list.
 func filterIssues(issues []model.Issue, config GraphExportConfig) []model.Issue {
 	// Filter by label first
@@ -183,6 +255,12 @@ func filterIssues(issues []model.Issue, config GraphExportConfig) []model.Issue
 	return filtered
 }
 
+// Subgraph returns the issues reachable from rootID along dependencies, at
+// most maxDepth hops away (0 = unlimited).
+func Subgraph(issues []model.Issue, rootID string, maxDepth int) []model.Issue {
+	return extractSubgraph(issues, rootID, maxDepth)
+}
+
 // extractSubgraph extracts a subgraph starting from a root node.
 func extractSubgraph(issues []model.Issue, rootID string, maxDepth int) []model.Issue {
 	// Build issue map
@@ -413,3 +413,44 @@ func TestExportGraph_DeterministicOutput(t *testing.T) {
 		t.Error("DOT output should be deterministic across calls")
 	}
 }
+
+func TestExportGraph_Reduce(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Base", Status: model.StatusOpen},
+		{ID: "bv-2", Title: "Middle", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+		{ID: "bv-3", Title: "Top", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{
+				{IssueID: "bv-3", DependsOnID: "bv-2", Type: model.DepBlocks},
+				{IssueID: "bv-3", DependsOnID: "bv-1", Type: model.DepBlocks},
+			}},
+	}
+	stats := analysis.NewAnalyzer(issues).Analyze()
+
+	result, err := ExportGraph(issues, &stats, GraphExportConfig{Format: GraphFormatJSON, Reduce: true})
+	if err != nil {
+		t.Fatalf("ExportGraph failed: %v", err)
+	}
+	if result.Edges != 2 || result.RedundantEdges != 1 {
+		t.Errorf("expected 2 edges with 1 redundant, got %d and %d", result.Edges, result.RedundantEdges)
+	}
+	for _, e := range result.Adjacency.Edges {
+		if e.From == "bv-3" && e.To == "bv-1" {
+			t.Errorf("redundant edge bv-3 -> bv-1 should be hidden")
+		}
+	}
+	if result.FiltersApplied["reduce"] != "transitive" {
+		t.Errorf("expected reduce filter to be reported, got %v", result.FiltersApplied)
+	}
+	if len(issues[2].Dependencies) != 2 {
+		t.Errorf("input issues must keep every dependency, got %d", len(issues[2].Dependencies))
+	}
+
+	full, err := ExportGraph(issues, &stats, GraphExportConfig{Format: GraphFormatJSON})
+	if err != nil {
+		t.Fatalf("ExportGraph failed: %v", err)
+	}
+	if full.Edges != 3 || full.RedundantEdges != 0 {
+		t.Errorf("expected full graph with 3 edges, got %d (redundant %d)", full.Edges, full.RedundantEdges)
+	}
+}
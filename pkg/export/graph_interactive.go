@@ -32,6 +32,7 @@ type InteractiveGraphOptions struct {
 	DataHash    string
 	Path        string // Output path - if empty, auto-generates based on project
 	ProjectName string // Project name for auto-naming
+	Reduce      bool   // Omit links implied by a longer path; node data keeps every dependency
 }
 
 // graphNode represents a node in the interactive graph with full bead data
@@ -78,10 +79,12 @@ type graphNode struct {
 	InDegree        int     `json:"in_degree"`
 	OutDegree       int     `json:"out_degree"`
 	CoreNumber      int     `json:"core_number"`
+	Community       int     `json:"community"`
 	Slack           float64 `json:"slack"`
 	IsArticulation  bool    `json:"is_articulation"`
 	PageRankRank    int     `json:"pagerank_rank"`
 	BetweennessRank int     `json:"betweenness_rank"`
+	Staleness       float64 `json:"staleness"` // analysis.StalenessScores; 0 for closed issues
 }
 
 // graphLink represents an edge in the interactive graph
@@ -128,10 +131,14 @@ func GenerateInteractiveGraphHTML(opts InteractiveGraphOptions) (string, error)
 	for _, iss := range opts.Issues {
 		issueMap[iss.ID] = true
 	}
+	var redundant map[analysis.GraphEdge]bool
+	if opts.Reduce {
+		redundant = redundantDependencies(opts.Issues)
+	}
 
 	// Get all metrics if available
 	var pageRank, betweenness, eigenvector, hubs, authorities, criticalPath, slack map[string]float64
-	var coreNumber map[string]int
+	var coreNumber, community map[string]int
 	var articulation []string
 	var pageRankRank, betweennessRank map[string]int
 	var inDegree, outDegree map[string]int
@@ -145,6 +152,7 @@ func GenerateInteractiveGraphHTML(opts InteractiveGraphOptions) (string, error)
 		criticalPath = opts.Stats.CriticalPathScore()
 		slack = opts.Stats.Slack()
 		coreNumber = opts.Stats.CoreNumber()
+		community = opts.Stats.Communities()
 		articulation = opts.Stats.ArticulationPoints()
 		pageRankRank = opts.Stats.PageRankRank()
 		betweennessRank = opts.Stats.BetweennessRank()
@@ -152,6 +160,8 @@ func GenerateInteractiveGraphHTML(opts InteractiveGraphOptions) (string, error)
 		outDegree = opts.Stats.OutDegree
 	}
 
+	staleness := analysis.StalenessScores(opts.Issues, time.Now())
+
 	// Create articulation set for O(1) lookup
 	articulationSet := make(map[string]bool)
 	for _, id := range articulation {
@@ -251,10 +261,12 @@ func GenerateInteractiveGraphHTML(opts InteractiveGraphOptions) (string, error)
 			InDegree:        inDegree[iss.ID],
 			OutDegree:       outDegree[iss.ID],
 			CoreNumber:      coreNumber[iss.ID],
+			Community:       community[iss.ID],
 			Slack:           slack[iss.ID],
 			IsArticulation:  articulationSet[iss.ID],
 			PageRankRank:    pageRankRank[iss.ID],
 			BetweennessRank: betweennessRank[iss.ID],
+			Staleness:       staleness[iss.ID],
 		}
 		nodes = append(nodes, node)
 
@@ -263,6 +275,9 @@ func GenerateInteractiveGraphHTML(opts InteractiveGraphOptions) (string, error)
 			if dep == nil || !issueMap[dep.DependsOnID] {
 				continue
 			}
+			if dep.Type.IsBlocking() && redundant[analysis.GraphEdge{From: iss.ID, To: dep.DependsOnID}] {
+				continue
+			}
 			// Only mark as critical if we have stats AND both ends have zero slack
 			isCritical := opts.Stats != nil && slack[iss.ID] == 0 && slack[dep.DependsOnID] == 0
 			link := graphLink{
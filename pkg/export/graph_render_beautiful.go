@@ -59,6 +59,23 @@ func generateUltimateHTML(title, dataHash, graphDataJSON string, nodeCount, edge
         body.light-mode #graph-container {
             background: radial-gradient(ellipse at center, #ffffff 0%%, #f0f2f5 100%%);
         }
+        /* Follow the OS scheme until the user picks one explicitly (dark-mode class) */
+        @media (prefers-color-scheme: light) {
+            body:not(.dark-mode) {
+                --bg: #f8f9fc;
+                --bg-secondary: #ffffff;
+                --bg-tertiary: #f0f2f5;
+                --bg-elevated: #e8eaf0;
+                --bg-glass: rgba(255, 255, 255, 0.9);
+                --fg: #1a1a2e;
+                --fg-muted: #555577;
+                --fg-dim: #8888aa;
+                --shadow: 0 8px 32px rgba(0,0,0,0.1);
+            }
+            body:not(.dark-mode) #graph-container {
+                background: radial-gradient(ellipse at center, #ffffff 0%%, #f0f2f5 100%%);
+            }
+        }
         * { box-sizing: border-box; margin: 0; padding: 0; }
         body {
             font-family: 'Inter', -apple-system, BlinkMacSystemFont, sans-serif;
@@ -371,6 +388,16 @@ func generateUltimateHTML(title, dataHash, graphDataJSON string, nodeCount, edge
             width: 12px; height: 12px; border-radius: 50%%;
             box-shadow: 0 0 8px currentColor;
         }
+        .legend-toggle {
+            border: 1px solid transparent; cursor: pointer; font: inherit;
+            transition: opacity 0.15s ease, border-color 0.15s ease;
+        }
+        .legend-toggle:hover { border-color: var(--purple); }
+        .legend-toggle:focus-visible { outline: 2px solid var(--gold); outline-offset: 2px; }
+        .legend-toggle[aria-pressed="false"] { opacity: 0.4; text-decoration: line-through; }
+        .legend-line { width: 18px; height: 0; border-top: 2px solid currentColor; }
+        .legend-line.dashed { border-top-style: dashed; }
+        .legend-line.dotted { border-top-style: dotted; }
 
         /* Triage Panel */
         .triage-item {
@@ -608,11 +635,12 @@ func generateUltimateHTML(title, dataHash, graphDataJSON string, nodeCount, edge
                 <div class="search-results" id="search-results"></div>
             </div>
             <div class="toolbar-group">
-                <select id="view-mode" title="Graph layout mode: Force (physics simulation), DAG (directed acyclic graph), or Radial. Press 1-4 for shortcuts.">
+                <select id="view-mode" title="Graph layout mode: Force (physics simulation), DAG (directed acyclic graph), Radial, or Clusters (force layout pulled together by community). Press 1-5 for shortcuts.">
                     <option value="force">Force</option>
                     <option value="td">DAG ↓</option>
                     <option value="lr">DAG →</option>
                     <option value="radialout">Radial</option>
+                    <option value="cluster">Clusters</option>
                 </select>
             </div>
             <div class="toolbar-group">
@@ -637,6 +665,9 @@ func generateUltimateHTML(title, dataHash, graphDataJSON string, nodeCount, edge
                     <option value="betweenness">Size: Betweenness</option>
                     <option value="critical">Size: Critical Path</option>
                     <option value="indegree">Size: In-Degree</option>
+                    <option value="hub">Size: Hub (HITS)</option>
+                    <option value="authority">Size: Authority (HITS)</option>
+                    <option value="staleness">Size: Staleness</option>
                 </select>
             </div>
             <div class="toolbar-group">
@@ -805,12 +836,31 @@ func generateUltimateHTML(title, dataHash, graphDataJSON string, nodeCount, edge
                 <div id="triage-list"></div>
             </div>
             <div class="panel">
-                <div class="panel-title">Status Legend</div>
-                <div class="legend">
-                    <div class="legend-item"><div class="legend-dot" style="background:#22c55e;color:#22c55e"></div>Open</div>
-                    <div class="legend-item"><div class="legend-dot" style="background:#f97316;color:#f97316"></div>In Progress</div>
-                    <div class="legend-item"><div class="legend-dot" style="background:#ef4444;color:#ef4444"></div>Blocked</div>
-                    <div class="legend-item"><div class="legend-dot" style="background:#555577;color:#555577"></div>Closed</div>
+                <div class="panel-title" id="legend-status-title">Status Legend</div>
+                <div class="legend" role="group" aria-labelledby="legend-status-title">
+                    <button type="button" class="legend-item legend-toggle" data-filter="status" data-value="open" aria-pressed="true" title="Toggle open beads"><div class="legend-dot" style="background:#22c55e;color:#22c55e"></div>Open</button>
+                    <button type="button" class="legend-item legend-toggle" data-filter="status" data-value="in_progress" aria-pressed="true" title="Toggle in-progress beads"><div class="legend-dot" style="background:#f97316;color:#f97316"></div>In Progress</button>
+                    <button type="button" class="legend-item legend-toggle" data-filter="status" data-value="blocked" aria-pressed="true" title="Toggle blocked beads"><div class="legend-dot" style="background:#ef4444;color:#ef4444"></div>Blocked</button>
+                    <button type="button" class="legend-item legend-toggle" data-filter="status" data-value="closed" aria-pressed="true" title="Toggle closed beads"><div class="legend-dot" style="background:#555577;color:#555577"></div>Closed</button>
+                </div>
+            </div>
+            <div class="panel">
+                <div class="panel-title" id="legend-priority-title">Priorities</div>
+                <div class="legend" role="group" aria-labelledby="legend-priority-title">
+                    <button type="button" class="legend-item legend-toggle" data-filter="priority" data-value="0" aria-pressed="true" title="Toggle P0 beads">P0</button>
+                    <button type="button" class="legend-item legend-toggle" data-filter="priority" data-value="1" aria-pressed="true" title="Toggle P1 beads">P1</button>
+                    <button type="button" class="legend-item legend-toggle" data-filter="priority" data-value="2" aria-pressed="true" title="Toggle P2 beads">P2</button>
+                    <button type="button" class="legend-item legend-toggle" data-filter="priority" data-value="3" aria-pressed="true" title="Toggle P3 beads">P3</button>
+                    <button type="button" class="legend-item legend-toggle" data-filter="priority" data-value="4" aria-pressed="true" title="Toggle P4 beads">P4</button>
+                </div>
+            </div>
+            <div class="panel">
+                <div class="panel-title" id="legend-edge-title">Edge Types</div>
+                <div class="legend" role="group" aria-labelledby="legend-edge-title">
+                    <button type="button" class="legend-item legend-toggle" data-filter="edge" data-value="blocks" aria-pressed="true" title="Toggle blocking edges"><div class="legend-line"></div>Blocks</button>
+                    <button type="button" class="legend-item legend-toggle" data-filter="edge" data-value="related" aria-pressed="true" title="Toggle related edges"><div class="legend-line dashed"></div>Related</button>
+                    <button type="button" class="legend-item legend-toggle" data-filter="edge" data-value="parent-child" aria-pressed="true" title="Toggle parent-child edges"><div class="legend-line dotted"></div>Parent</button>
+                    <button type="button" class="legend-item legend-toggle" data-filter="edge" data-value="discovered-from" aria-pressed="true" title="Toggle discovered-from edges"><div class="legend-line dotted"></div>Discovered</button>
                 </div>
             </div>
             <div class="panel">
@@ -838,6 +888,8 @@ func generateUltimateHTML(title, dataHash, graphDataJSON string, nodeCount, edge
                         <div class="metric-item"><span class="metric-label">BW Rank</span><span class="metric-value" id="m-bwrank">-</span></div>
                         <div class="metric-item"><span class="metric-label">Critical</span><span class="metric-value" id="m-critical">-</span></div>
                         <div class="metric-item"><span class="metric-label">Slack</span><span class="metric-value" id="m-slack">-</span></div>
+                        <div class="metric-item" title="HITS hub: depends on many important issues"><span class="metric-label">Hub</span><span class="metric-value" id="m-hub">-</span></div>
+                        <div class="metric-item" title="HITS authority: many important issues depend on it"><span class="metric-label">Authority</span><span class="metric-value" id="m-auth">-</span></div>
                         <div class="metric-item"><span class="metric-label">In-Deg</span><span class="metric-value" id="m-indeg">-</span></div>
                         <div class="metric-item"><span class="metric-label">Out-Deg</span><span class="metric-value" id="m-outdeg">-</span></div>
                     </div>
@@ -852,7 +904,7 @@ func generateUltimateHTML(title, dataHash, graphDataJSON string, nodeCount, edge
                 <div class="panel-title">Shortcuts</div>
                 <div class="keyboard-hints">
                     <kbd>F</kbd> Fit · <kbd>R</kbd> Reset · <kbd>Space</kbd> Fullscreen<br>
-                    <kbd>Esc</kbd> Clear · <kbd>1-4</kbd> View modes<br>
+                    <kbd>Esc</kbd> Clear · <kbd>1-5</kbd> View modes<br>
                     <kbd>H</kbd> Heatmap · <kbd>T</kbd> Top · <kbd>G</kbd> Triage
                 </div>
             </div>
@@ -906,6 +958,7 @@ func generateUltimateHTML(title, dataHash, graphDataJSON string, nodeCount, edge
                     <div class="help-item"><span class="help-key">2</span> DAG top-down</div>
                     <div class="help-item"><span class="help-key">3</span> DAG left-right</div>
                     <div class="help-item"><span class="help-key">4</span> Radial layout</div>
+                    <div class="help-item"><span class="help-key">5</span> Community clusters</div>
                 </div>
             </div>
             <div class="help-section">
@@ -916,6 +969,9 @@ func generateUltimateHTML(title, dataHash, graphDataJSON string, nodeCount, edge
                     <div class="help-item"><span class="help-key">Right-click</span> Context menu</div>
                     <div class="help-item"><span class="help-key">Scroll</span> Zoom in/out</div>
                     <div class="help-item"><span class="help-key">Drag</span> Pan the view</div>
+                    <div class="help-item"><span class="help-key">Tab</span> Focus legend filters</div>
+                    <div class="help-item"><span class="help-key">Enter</span> Toggle focused legend entry</div>
+                    <div class="help-item"><span class="help-key">Arrows</span> Move within a legend</div>
                 </div>
             </div>
         </div>
@@ -951,6 +1007,9 @@ document.getElementById('stat-articulation').textContent = articulationCount;
 const maxPR = Math.max(...DATA.nodes.map(n => n.pagerank || 0), 0.001);
 const maxBW = Math.max(...DATA.nodes.map(n => n.betweenness || 0), 0.001);
 const maxCP = Math.max(...DATA.nodes.map(n => n.critical_path || 0), 1);
+const maxHub = Math.max(...DATA.nodes.map(n => n.hub || 0), 0.001);
+const maxAuth = Math.max(...DATA.nodes.map(n => n.authority || 0), 0.001);
+const maxStale = Math.max(...DATA.nodes.map(n => n.staleness || 0), 0.001);
 const maxInDeg = Math.max(...DATA.nodes.map(n => n.in_degree || 0), 1);
 
 let sizeMetric = 'pagerank', heatmapMode = false, hoveredNode = null, highlightedNodes = new Set();
@@ -962,6 +1021,9 @@ function getNodeSize(n) {
         case 'betweenness': return base + ((n.betweenness || 0) / maxBW) * scale;
         case 'critical': return base + ((n.critical_path || 0) / maxCP) * scale;
         case 'indegree': return base + ((n.in_degree || 0) / maxInDeg) * scale;
+        case 'hub': return base + ((n.hub || 0) / maxHub) * scale;
+        case 'authority': return base + ((n.authority || 0) / maxAuth) * scale;
+        case 'staleness': return base + ((n.staleness || 0) / maxStale) * scale;
         default: return base + ((n.pagerank || 0) / maxPR) * scale;
     }
 }
@@ -973,6 +1035,9 @@ function getHeatmapColor(n) {
         case 'betweenness': val = n.betweenness || 0; max = maxBW; break;
         case 'critical': val = n.critical_path || 0; max = maxCP; break;
         case 'indegree': val = n.in_degree || 0; max = maxInDeg; break;
+        case 'hub': val = n.hub || 0; max = maxHub; break;
+        case 'authority': val = n.authority || 0; max = maxAuth; break;
+        case 'staleness': val = n.staleness || 0; max = maxStale; break;
     }
     const ratio = val / max;
     const hue = 120 - ratio * 120; // Green to red
@@ -1248,6 +1313,9 @@ function populatePanelContent(prefix, node) {
     addMetric('BW Rank', '#' + (node.betweenness_rank || '-'));
     addMetric('Critical Path', fmt(node.critical_path, 1));
     addMetric('Slack', fmt(node.slack, 1));
+    addMetric('Hub', fmt(node.hub, 4));
+    addMetric('Authority', fmt(node.authority, 4));
+    addMetric('Staleness', fmt(node.staleness, 2));
     addMetric('In-Degree', node.in_degree ?? '-');
     addMetric('Out-Degree', node.out_degree ?? '-');
 }
@@ -1351,6 +1419,8 @@ function selectNode(node) {
     const slackEl = document.getElementById('m-slack');
     slackEl.textContent = fmtSide(node.slack, 1);
     slackEl.className = 'metric-value' + (node.slack === 0 ? ' highlight' : '');
+    document.getElementById('m-hub').textContent = fmtSide(node.hub, 4);
+    document.getElementById('m-auth').textContent = fmtSide(node.authority, 4);
     document.getElementById('m-indeg').textContent = node.in_degree ?? '-';
     document.getElementById('m-outdeg').textContent = node.out_degree ?? '-';
     document.getElementById('node-detail').classList.add('visible');
@@ -1540,17 +1610,43 @@ function updateVisibleCount() {
     document.getElementById('stat-visible').innerHTML = '<span class="stat-value">' + count + '</span> visible';
 }
 
+// Cluster layout: a force pulling each node toward its community's centroid
+function clusterForce() {
+    let nodes = [];
+    const force = alpha => {
+        const centers = new Map();
+        nodes.forEach(n => {
+            const c = centers.get(n.community) || {x: 0, y: 0, k: 0};
+            c.x += n.x || 0; c.y += n.y || 0; c.k++;
+            centers.set(n.community, c);
+        });
+        nodes.forEach(n => {
+            const c = centers.get(n.community);
+            if (c.k < 2) return;
+            n.vx += (c.x / c.k - n.x) * alpha * 0.3;
+            n.vy += (c.y / c.k - n.y) * alpha * 0.3;
+        });
+    };
+    force.initialize = ns => { nodes = ns; };
+    return force;
+}
+
+function setLayout(mode) {
+    Graph.dagMode(mode === 'force' || mode === 'cluster' ? null : mode);
+    Graph.d3Force('cluster', mode === 'cluster' ? clusterForce() : null);
+    Graph.d3ReheatSimulation();
+}
+
 // View mode
 document.getElementById('view-mode').onchange = e => {
-    const mode = e.target.value;
-    Graph.dagMode(mode === 'force' ? null : mode);
+    setLayout(e.target.value);
     setTimeout(() => Graph.zoomToFit(400), 100);
 };
 
 // Size metric
 document.getElementById('size-by').onchange = e => {
     sizeMetric = e.target.value;
-    document.getElementById('heatmap-metric').textContent = { pagerank: 'PageRank', betweenness: 'Betweenness', critical: 'Critical Path', indegree: 'In-Degree' }[sizeMetric];
+    document.getElementById('heatmap-metric').textContent = { pagerank: 'PageRank', betweenness: 'Betweenness', critical: 'Critical Path', indegree: 'In-Degree', hub: 'Hub', authority: 'Authority', staleness: 'Staleness' }[sizeMetric];
     Graph.nodeVal(n => getNodeSize(n));
     if (heatmapMode) Graph.nodeColor(n => getHeatmapColor(n));
 };
@@ -1564,8 +1660,9 @@ document.getElementById('btn-reset').onclick = () => {
     document.getElementById('view-mode').value = 'force';
     document.getElementById('size-by').value = 'pagerank';
     statusFilter = ''; typeFilter = ''; sizeMetric = 'pagerank'; heatmapMode = false;
+    resetLegend(); currentVisibilityFilter = () => true;
     highlightedNodes = new Set();
-    Graph.dagMode(null); Graph.nodeVisibility(() => true); Graph.nodeVal(n => getNodeSize(n));
+    setLayout('force'); Graph.nodeVisibility(() => true); Graph.nodeVal(n => getNodeSize(n));
     Graph.nodeColor(n => STATUS_COLORS[n.status] || '#555577');
     Graph.linkColor(l => l.critical ? '#ec489980' : '#44475a40');
     clearSelection(); hideHoverPanel(); Graph.zoomToFit(400, 50); updateVisibleCount();
@@ -1643,16 +1740,26 @@ function showToast(msg) {
     setTimeout(() => toast.classList.remove('visible'), 2500);
 }
 
-// Light/Dark mode toggle
-let isDarkMode = true;
-function toggleLightMode() {
-    isDarkMode = !isDarkMode;
-    document.body.classList.toggle('light-mode', !isDarkMode);
+// Light/Dark mode - defaults to the OS color scheme, explicit choice is persisted
+const colorSchemeQuery = window.matchMedia ? window.matchMedia('(prefers-color-scheme: light)') : null;
+let isDarkMode = !(colorSchemeQuery && colorSchemeQuery.matches);
+function applyTheme(dark) {
+    isDarkMode = dark;
+    document.body.classList.toggle('light-mode', !dark);
+    document.body.classList.toggle('dark-mode', dark);
     const btn = document.getElementById('btn-theme');
     btn.textContent = isDarkMode ? '☀️' : '🌙';
     btn.title = isDarkMode ? 'Switch to light mode (L)' : 'Switch to dark mode (L)';
+}
+function toggleLightMode() {
+    applyTheme(!isDarkMode);
     localStorage.setItem('bv-graph-theme', isDarkMode ? 'dark' : 'light');
 }
+if (colorSchemeQuery && colorSchemeQuery.addEventListener) {
+    colorSchemeQuery.addEventListener('change', e => {
+        if (!localStorage.getItem('bv-graph-theme')) applyTheme(!e.matches);
+    });
+}
 
 // Recently viewed nodes
 const recentlyViewed = [];
@@ -1753,15 +1860,54 @@ labelSelect.onchange = e => {
     applyFilters();
 };
 
+// Legend toggles - each legend entry is a button that hides/shows its slice
+const hiddenLegend = { status: new Set(), priority: new Set(), edge: new Set() };
+const legendToggles = [...document.querySelectorAll('.legend-toggle')];
+function edgeTypeKey(t) { return t || 'blocks'; }
+function toggleLegend(btn) {
+    const set = hiddenLegend[btn.dataset.filter];
+    const value = btn.dataset.value;
+    const hidden = !set.has(value);
+    if (hidden) set.add(value); else set.delete(value);
+    btn.setAttribute('aria-pressed', hidden ? 'false' : 'true');
+    applyFilters();
+    showToast((hidden ? 'Hidden: ' : 'Shown: ') + btn.textContent.trim());
+}
+function resetLegend() {
+    Object.values(hiddenLegend).forEach(s => s.clear());
+    legendToggles.forEach(b => b.setAttribute('aria-pressed', 'true'));
+    Graph.linkVisibility(() => true);
+}
+legendToggles.forEach(btn => {
+    btn.addEventListener('click', () => toggleLegend(btn));
+    // Arrow keys move focus within a legend group; Enter/Space activate natively
+    btn.addEventListener('keydown', e => {
+        if (!['ArrowLeft', 'ArrowRight', 'ArrowUp', 'ArrowDown', 'Home', 'End'].includes(e.key)) return;
+        e.preventDefault();
+        const group = [...btn.parentElement.querySelectorAll('.legend-toggle')];
+        const idx = group.indexOf(btn);
+        let next = idx;
+        if (e.key === 'ArrowLeft' || e.key === 'ArrowUp') next = (idx - 1 + group.length) %% group.length;
+        if (e.key === 'ArrowRight' || e.key === 'ArrowDown') next = (idx + 1) %% group.length;
+        if (e.key === 'Home') next = 0;
+        if (e.key === 'End') next = group.length - 1;
+        group[next].focus();
+    });
+});
+
 // Combined filter function
 function applyFilters() {
-    Graph.nodeVisibility(n => {
+    currentVisibilityFilter = n => {
         if (statusFilter && n.status !== statusFilter) return false;
         if (typeFilter && n.type !== typeFilter) return false;
         if (priorityFilter && n.priority !== parseInt(priorityFilter)) return false;
         if (labelFilter && !(n.labels || []).includes(labelFilter)) return false;
+        if (hiddenLegend.status.has(n.status)) return false;
+        if (hiddenLegend.priority.has(String(n.priority))) return false;
         return true;
-    });
+    };
+    Graph.nodeVisibility(currentVisibilityFilter);
+    Graph.linkVisibility(l => !hiddenLegend.edge.has(edgeTypeKey(l.type)));
     updateVisibleCount();
 }
 
@@ -1807,11 +1953,12 @@ setInterval(updateMinimap, 2000);
 // LocalStorage preferences
 function loadPreferences() {
     const theme = localStorage.getItem('bv-graph-theme');
-    if (theme === 'light') { isDarkMode = false; toggleLightMode(); toggleLightMode(); }
+    if (theme === 'light' || theme === 'dark') applyTheme(theme === 'dark');
+    else applyTheme(isDarkMode);
     const layout = localStorage.getItem('bv-graph-layout');
     if (layout) {
         document.getElementById('view-mode').value = layout;
-        Graph.dagMode(layout === 'force' ? null : layout);
+        setLayout(layout);
     }
 }
 document.getElementById('view-mode').addEventListener('change', e => {
@@ -1821,6 +1968,7 @@ document.getElementById('view-mode').addEventListener('change', e => {
 // Keyboard shortcuts
 document.onkeydown = e => {
     if (e.target.tagName === 'INPUT') return;
+    if (e.target.classList && e.target.classList.contains('legend-toggle') && (e.key === ' ' || e.key === 'Enter')) return;
     if (e.key === '?') { toggleHelp(); return; }
     switch(e.key.toLowerCase()) {
         case 'f': Graph.zoomToFit(400, 50); break;
@@ -1837,10 +1985,11 @@ document.onkeydown = e => {
         case 'l': toggleLightMode(); break;
         case 'y': document.getElementById('btn-recent').click(); break;
         case 'p': togglePathFinder(); break;
-        case '1': document.getElementById('view-mode').value = 'force'; Graph.dagMode(null); localStorage.setItem('bv-graph-layout', 'force'); break;
-        case '2': document.getElementById('view-mode').value = 'td'; Graph.dagMode('td'); localStorage.setItem('bv-graph-layout', 'td'); break;
-        case '3': document.getElementById('view-mode').value = 'lr'; Graph.dagMode('lr'); localStorage.setItem('bv-graph-layout', 'lr'); break;
-        case '4': document.getElementById('view-mode').value = 'radialout'; Graph.dagMode('radialout'); localStorage.setItem('bv-graph-layout', 'radialout'); break;
+        case '1': document.getElementById('view-mode').value = 'force'; setLayout('force'); localStorage.setItem('bv-graph-layout', 'force'); break;
+        case '2': document.getElementById('view-mode').value = 'td'; setLayout('td'); localStorage.setItem('bv-graph-layout', 'td'); break;
+        case '3': document.getElementById('view-mode').value = 'lr'; setLayout('lr'); localStorage.setItem('bv-graph-layout', 'lr'); break;
+        case '4': document.getElementById('view-mode').value = 'radialout'; setLayout('radialout'); localStorage.setItem('bv-graph-layout', 'radialout'); break;
+        case '5': document.getElementById('view-mode').value = 'cluster'; setLayout('cluster'); localStorage.setItem('bv-graph-layout', 'cluster'); break;
     }
 };
 
@@ -1,10 +1,16 @@
 package export
 
 import (
+	"encoding/json"
 	"fmt"
 	"html"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
 func TestGenerateUltimateHTML_EscapesTitleAndProject(t *testing.T) {
@@ -31,3 +37,128 @@ func TestGenerateUltimateHTML_EscapesTitleAndProject(t *testing.T) {
 		t.Fatalf("expected escaped project name in footer")
 	}
 }
+
+func TestGenerateUltimateHTML_LegendTogglesAreAccessible(t *testing.T) {
+	out := generateUltimateHTML("t", "h", `{}`, 1, 1, "p", "", "")
+
+	if strings.Contains(out, "%!") {
+		t.Fatalf("template contains a formatting error")
+	}
+	for _, want := range []string{
+		`data-filter="status" data-value="blocked" aria-pressed="true"`,
+		`data-filter="priority" data-value="0" aria-pressed="true"`,
+		`data-filter="edge" data-value="related" aria-pressed="true"`,
+		`role="group" aria-labelledby="legend-edge-title"`,
+		`Graph.linkVisibility(`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+	if n := strings.Count(out, `<button type="button" class="legend-item legend-toggle"`); n != 13 {
+		t.Errorf("expected 13 legend toggle buttons, got %d", n)
+	}
+}
+
+func TestGenerateUltimateHTML_FollowsColorScheme(t *testing.T) {
+	out := generateUltimateHTML("t", "h", `{}`, 1, 1, "p", "", "")
+
+	for _, want := range []string{
+		"@media (prefers-color-scheme: light)",
+		"body:not(.dark-mode)",
+		"matchMedia('(prefers-color-scheme: light)')",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+}
+
+func TestGenerateInteractiveGraphHTML_SizesByHITS(t *testing.T) {
+	// bv-2 and bv-3 both depend on bv-1: they are hubs, bv-1 the authority
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Schema", Status: model.StatusOpen},
+		{ID: "bv-2", Title: "API", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+		{ID: "bv-3", Title: "UI", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "bv-3", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+	}
+	stats := analysis.NewAnalyzer(issues).Analyze()
+	path, err := GenerateInteractiveGraphHTML(InteractiveGraphOptions{
+		Issues: issues,
+		Stats:  &stats,
+		Path:   filepath.Join(t.TempDir(), "graph.html"),
+	})
+	if err != nil {
+		t.Fatalf("GenerateInteractiveGraphHTML: %v", err)
+	}
+	page, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(page)
+
+	for _, want := range []string{
+		`<option value="hub">Size: Hub (HITS)</option>`,
+		`<option value="authority">Size: Authority (HITS)</option>`,
+		`case 'hub': return base + ((n.hub || 0) / maxHub) * scale;`,
+		`case 'authority': return base + ((n.authority || 0) / maxAuth) * scale;`,
+		`id="m-hub"`,
+		`id="m-auth"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q", want)
+		}
+	}
+
+	_, data, ok := strings.Cut(out, "const DATA = ")
+	if !ok {
+		t.Fatal("graph data not found")
+	}
+	data, _, _ = strings.Cut(data, ";\n")
+	var graph struct {
+		Nodes []graphNode `json:"nodes"`
+	}
+	if err := json.Unmarshal([]byte(data), &graph); err != nil {
+		t.Fatalf("graph data: %v", err)
+	}
+	hubs, authorities := stats.Hubs(), stats.Authorities()
+	nodes := make(map[string]graphNode)
+	for _, n := range graph.Nodes {
+		nodes[n.ID] = n
+		if n.Hub != hubs[n.ID] || n.Authority != authorities[n.ID] {
+			t.Errorf("%s: hub %v authority %v, want %v %v", n.ID, n.Hub, n.Authority, hubs[n.ID], authorities[n.ID])
+		}
+	}
+	if !(nodes["bv-2"].Hub > nodes["bv-1"].Hub && nodes["bv-1"].Authority > nodes["bv-2"].Authority) {
+		t.Errorf("bv-2 should be the hub and bv-1 the authority: hubs %v, authorities %v", hubs, authorities)
+	}
+}
+
+func TestViewerAssets_SizeByHITS(t *testing.T) {
+	// The static viewer computes HITS in the browser, so check the page
+	// offers the sizes and graph.js gives every node both scores
+	for file, wants := range map[string][]string{
+		"viewer_assets/index.html": {
+			`<option value="hub">Hub (HITS)</option>`,
+			`<option value="authority">Authority (HITS)</option>`,
+		},
+		"viewer_assets/graph.js": {
+			"hub: idx !== undefined && metrics.hits ? metrics.hits.hub[idx] : 0,",
+			"authority: idx !== undefined && metrics.hits ? metrics.hits.authority[idx] : 0,",
+			"store.maxMetrics.hub = ",
+			"store.maxMetrics.authority = ",
+			"if (store.sizeMetric === 'hub' || store.sizeMetric === 'authority') {",
+		},
+	} {
+		data, err := ViewerAssetsFS.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range wants {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s should contain %q", file, want)
+			}
+		}
+	}
+}
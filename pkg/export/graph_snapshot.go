@@ -2,6 +2,7 @@ package export
 
 import (
 	"fmt"
+	"image"
 	"image/color"
 	"io"
 	"math"
@@ -9,8 +10,10 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/limits"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"git.sr.ht/~sbinet/gg"
@@ -27,6 +30,26 @@ type GraphSnapshotOptions struct {
 	Issues   []model.Issue        // Issues to render (already filtered by recipe/workspace)
 	Stats    *analysis.GraphStats // Graph analysis used for layout/summary
 	DataHash string               // Hash of input issues for provenance
+
+	// GeneratedAt is encoded with DataHash in the header QR stamp. Zero omits
+	// the timestamp so the stamp (and golden output) stays deterministic.
+	GeneratedAt time.Time
+
+	// ShowSuggestions overlays likely missing "related" links as dashed edges.
+	// Off by default so the standard snapshot only shows recorded dependencies.
+	ShowSuggestions bool
+
+	// CriticalPath outlines the longest chain of open blocking dependencies
+	// (analysis.ComputeCriticalPath) so what gates delivery stands out.
+	CriticalPath bool
+
+	// Reduce hides redundant transitive edges (analysis.TransitiveReduction)
+	// so the drawing shows only the direct ordering; Stats stay full-graph.
+	Reduce bool
+
+	// Diff, when set, annotates nodes with trend markers (priority raised,
+	// recently unblocked, recently commented) relative to the older snapshot.
+	Diff *analysis.SnapshotDiff
 }
 
 // SaveGraphSnapshot renders a static graph snapshot (SVG or PNG) with a minimal
@@ -60,12 +83,26 @@ func SaveGraphSnapshot(opts GraphSnapshotOptions) error {
 	if opts.Path == "" {
 		return fmt.Errorf("output path is required")
 	}
+	guard := limits.Current()
+	if err := guard.CheckNodes("graph snapshot", len(opts.Issues), graphFocusHint); err != nil {
+		return err
+	}
 
-	if err := os.MkdirAll(filepath.Dir(opts.Path), 0o755); err != nil {
-		return fmt.Errorf("create parent dir: %w", err)
+	if opts.Reduce {
+		opts.Issues, _ = reduceDependencies(opts.Issues)
 	}
 
 	layout := buildLayout(opts)
+	if format == "png" {
+		if err := guard.CheckMemory("PNG graph snapshot", limits.RasterBytes(layout.Width, layout.Height),
+			"export .svg instead, or "+graphFocusHint); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(opts.Path), 0o755); err != nil {
+		return fmt.Errorf("create parent dir: %w", err)
+	}
 
 	switch format {
 	case "svg":
@@ -77,6 +114,35 @@ func SaveGraphSnapshot(opts GraphSnapshotOptions) error {
 	}
 }
 
+// RenderGraphSnapshotImage rasterizes the snapshot in memory instead of
+// writing a file, for callers that display the image themselves (the TUI's
+// inline graph preview). Path and Format are ignored; the same node and
+// memory limits as a PNG export apply.
+func RenderGraphSnapshotImage(opts GraphSnapshotOptions) (image.Image, error) {
+	if len(opts.Issues) == 0 {
+		return nil, fmt.Errorf("no issues to render")
+	}
+	if opts.Stats == nil {
+		return nil, fmt.Errorf("graph stats are required for snapshot rendering")
+	}
+	guard := limits.Current()
+	if err := guard.CheckNodes("graph snapshot", len(opts.Issues), graphFocusHint); err != nil {
+		return nil, err
+	}
+	if opts.Reduce {
+		opts.Issues, _ = reduceDependencies(opts.Issues)
+	}
+
+	layout := buildLayout(opts)
+	if err := guard.CheckMemory("PNG graph snapshot", limits.RasterBytes(layout.Width, layout.Height), graphFocusHint); err != nil {
+		return nil, err
+	}
+	return drawPNG(layout).Image(), nil
+}
+
+// graphFocusHint suggests ways to shrink a graph that trips a render limit
+const graphFocusHint = "focus the graph on a label (--label) or a subtree (--graph-root/--graph-depth)"
+
 // --- layout computation ----------------------------------------------------
 
 type layoutNode struct {
@@ -89,11 +155,15 @@ type layoutNode struct {
 	NodeW    float64
 	NodeH    float64
 	PageRank float64
+	Trends   []analysis.IssueTrend
+	Critical bool // on the critical path
 }
 
 type layoutEdge struct {
-	From string
-	To   string
+	From      string
+	To        string
+	Suggested bool // suggested "related" link, rendered dashed without arrow
+	Critical  bool // links two consecutive issues of the critical path
 }
 
 type layoutResult struct {
@@ -108,8 +178,12 @@ type layoutResult struct {
 type summaryInfo struct {
 	Title         string
 	DataHash      string
+	Stamp         string // QR payload tying the image to its dataset snapshot
 	NodeCount     int
 	EdgeCount     int
+	Suggested     int
+	Trended       int // nodes carrying at least one trend marker
+	Critical      int // issues on the highlighted critical path
 	TopBottleneck string
 }
 
@@ -141,15 +215,17 @@ func buildLayout(opts GraphSnapshotOptions) layoutResult {
 
 	// Pre-compute helper maps
 	pageRank := opts.Stats.PageRank()
-	critical := opts.Stats.CriticalPathScore()
 
-	// determine levels using critical path score (fallback 1)
+	// one column per topological layer, mirrored so edges run left to right
+	// from dependent to blocker; issues caught in a cycle get a column of
+	// their own at the end
+	layers := analysis.TopoLayers(opts.Issues)
 	levelByID := make(map[string]int, len(opts.Issues))
-	maxLevel := 1
+	maxLevel := max(layers.Depth(), 1)
 	for _, iss := range opts.Issues {
-		lvl := int(math.Round(critical[iss.ID]))
-		if lvl < 1 {
-			lvl = 1
+		lvl := layers.Depth() - layers.LayerOf(iss.ID)
+		if layers.LayerOf(iss.ID) < 0 {
+			lvl = layers.Depth() + 1
 		}
 		levelByID[iss.ID] = lvl
 		if lvl > maxLevel {
@@ -157,6 +233,15 @@ func buildLayout(opts GraphSnapshotOptions) layoutResult {
 		}
 	}
 
+	var trends map[string][]analysis.IssueTrend
+	if opts.Diff != nil {
+		trends = opts.Diff.IssueTrends(opts.Issues)
+	}
+	var criticalPath analysis.CriticalPathResult
+	if opts.CriticalPath {
+		criticalPath = analysis.ComputeCriticalPath(opts.Issues)
+	}
+
 	// group nodes by level for row placement
 	levelBuckets := make(map[int][]layoutNode, maxLevel)
 	for _, iss := range opts.Issues {
@@ -170,6 +255,8 @@ func buildLayout(opts GraphSnapshotOptions) layoutResult {
 			NodeW:    nodeW,
 			NodeH:    nodeH,
 			PageRank: pageRank[iss.ID],
+			Trends:   trends[iss.ID],
+			Critical: criticalPath.Length > 1 && criticalPath.Contains(iss.ID),
 		}
 		levelBuckets[level] = append(levelBuckets[level], n)
 	}
@@ -227,7 +314,23 @@ func buildLayout(opts GraphSnapshotOptions) layoutResult {
 			if !nodeIDs[dep.DependsOnID] {
 				continue // filtered out by recipe/workspace
 			}
-			edges = append(edges, layoutEdge{From: iss.ID, To: dep.DependsOnID})
+			// The path runs blocker first, so the edge from a dependent
+			// points at the step just before it.
+			k := criticalPath.Index(iss.ID)
+			onPath := k > 0 && criticalPath.Path[k-1] == dep.DependsOnID
+			edges = append(edges, layoutEdge{From: iss.ID, To: dep.DependsOnID, Critical: onPath})
+		}
+	}
+
+	// suggested related links (opt-in overlay)
+	suggested := 0
+	if opts.ShowSuggestions {
+		for _, m := range analysis.FindRelatedLinks(opts.Issues, analysis.DefaultRelatedSuggestionConfig()) {
+			if !nodeIDs[m.From] || !nodeIDs[m.To] {
+				continue
+			}
+			edges = append(edges, layoutEdge{From: m.From, To: m.To, Suggested: true})
+			suggested++
 		}
 	}
 
@@ -252,13 +355,25 @@ func buildLayout(opts GraphSnapshotOptions) layoutResult {
 		Summary: summaryInfo{
 			Title:         title,
 			DataHash:      opts.DataHash,
+			Stamp:         provenanceStamp(opts.DataHash, opts.GeneratedAt),
 			NodeCount:     len(nodes),
-			EdgeCount:     len(edges),
+			EdgeCount:     len(edges) - suggested,
+			Suggested:     suggested,
+			Trended:       len(trends),
+			Critical:      criticalCount(criticalPath),
 			TopBottleneck: topBottleneck,
 		},
 	}
 }
 
+// criticalCount is the highlighted path length; a single issue is no chain.
+func criticalCount(cp analysis.CriticalPathResult) int {
+	if cp.Length > 1 {
+		return cp.Length
+	}
+	return 0
+}
+
 func topByMetric(m map[string]float64) string {
 	var bestID string
 	var bestVal float64
@@ -306,6 +421,11 @@ var (
 	colorStroke    = color.RGBA{0x22, 0x22, 0x22, 0xff}
 	colorEdge      = color.RGBA{0x6b, 0x80, 0xbf, 0xff}
 	colorEdgeArrow = color.RGBA{0x6b, 0x80, 0xbf, 0xff}
+	colorSuggested = color.RGBA{0xb0, 0x7c, 0xc6, 0xff}
+	colorTrendPrio = color.RGBA{0xe6, 0x51, 0x00, 0xff}
+	colorTrendUnbl = color.RGBA{0x2e, 0x7d, 0x32, 0xff}
+	colorTrendCmt  = color.RGBA{0x15, 0x65, 0xc0, 0xff}
+	colorCritical  = color.RGBA{0xd8, 0x43, 0x15, 0xff}
 	colorText      = color.RGBA{0x11, 0x11, 0x11, 0xff}
 	colorSubtle    = color.RGBA{0x66, 0x66, 0x66, 0xff}
 	colorBackdrop  = color.RGBA{0xf9, 0xfa, 0xfb, 0xff}
@@ -313,6 +433,134 @@ var (
 	colorLegendBG  = color.RGBA{0xee, 0xee, 0xee, 0xff}
 )
 
+// svgPalette is one color scheme for SVG snapshots. SVG output embeds both
+// palettes and switches with prefers-color-scheme; PNG always uses light.
+type svgPalette struct {
+	Backdrop, Header, Legend, Stroke  color.RGBA
+	Edge, Arrow, Suggested, Text, Dim color.RGBA
+	Open, InProg, Blocked, Closed     color.RGBA
+	TrendPrio, TrendUnbl, TrendCmt    color.RGBA
+	Critical                          color.RGBA
+}
+
+var (
+	lightSVGPalette = svgPalette{
+		Backdrop: colorBackdrop, Header: colorHeaderBG, Legend: colorLegendBG, Stroke: colorStroke,
+		Edge: colorEdge, Arrow: colorEdgeArrow, Suggested: colorSuggested, Text: colorText, Dim: colorSubtle,
+		Open: colorOpen, InProg: colorInProg, Blocked: colorBlocked, Closed: colorClosed,
+		TrendPrio: colorTrendPrio, TrendUnbl: colorTrendUnbl, TrendCmt: colorTrendCmt,
+		Critical: colorCritical,
+	}
+	darkSVGPalette = svgPalette{
+		Backdrop:  color.RGBA{0x11, 0x18, 0x27, 0xff},
+		Header:    color.RGBA{0x1f, 0x29, 0x37, 0xff},
+		Legend:    color.RGBA{0x1f, 0x29, 0x37, 0xff},
+		Stroke:    color.RGBA{0x9c, 0xa3, 0xaf, 0xff},
+		Edge:      color.RGBA{0x8e, 0xa2, 0xe0, 0xff},
+		Arrow:     color.RGBA{0x8e, 0xa2, 0xe0, 0xff},
+		Suggested: color.RGBA{0xc8, 0x9a, 0xd8, 0xff},
+		Text:      color.RGBA{0xf3, 0xf4, 0xf6, 0xff},
+		Dim:       color.RGBA{0x9c, 0xa3, 0xaf, 0xff},
+		Open:      color.RGBA{0x1f, 0x4d, 0x2b, 0xff},
+		InProg:    color.RGBA{0x5c, 0x45, 0x16, 0xff},
+		Blocked:   color.RGBA{0x5c, 0x1f, 0x24, 0xff},
+		Closed:    color.RGBA{0x37, 0x41, 0x51, 0xff},
+		TrendPrio: color.RGBA{0xff, 0xb7, 0x4d, 0xff},
+		TrendUnbl: color.RGBA{0x81, 0xc7, 0x84, 0xff},
+		TrendCmt:  color.RGBA{0x64, 0xb5, 0xf6, 0xff},
+		Critical:  color.RGBA{0xff, 0x8a, 0x65, 0xff},
+	}
+)
+
+// rules renders the palette as CSS class rules used by the SVG renderer.
+func (p svgPalette) rules() string {
+	return fmt.Sprintf(`.bg{fill:%s} .hdr{fill:%s} .lgd{fill:%s;stroke:%s}
+.edge{stroke:%s} .arrow{fill:%s} .sugg{stroke:%s} .txt{fill:%s} .dim{fill:%s}
+.st-open{fill:%s;stroke:%s} .st-inprog{fill:%s;stroke:%s} .st-blocked{fill:%s;stroke:%s} .st-closed{fill:%s;stroke:%s}
+.tr-prio{fill:%s} .tr-unbl{fill:%s} .tr-cmt{fill:%s}`,
+		css(p.Backdrop), css(p.Header), css(p.Legend), css(p.Stroke),
+		css(p.Edge), css(p.Arrow), css(p.Suggested), css(p.Text), css(p.Dim),
+		css(p.Open), css(p.Stroke), css(p.InProg), css(p.Stroke), css(p.Blocked), css(p.Stroke), css(p.Closed), css(p.Stroke),
+		css(p.TrendPrio), css(p.TrendUnbl), css(p.TrendCmt))
+}
+
+// criticalRules styles the critical-path overlay. It is only emitted when a
+// path is highlighted, leaving ordinary snapshots byte-for-byte unchanged.
+func (p svgPalette) criticalRules() string {
+	return fmt.Sprintf("\n.crit{stroke:%s} .crit-arrow{fill:%s}", css(p.Critical), css(p.Critical))
+}
+
+// svgSchemeCSS returns the embedded stylesheet: light palette by default,
+// dark palette when the viewer prefers a dark color scheme.
+func svgSchemeCSS(critical bool) string {
+	light, dark := lightSVGPalette.rules(), darkSVGPalette.rules()
+	if critical {
+		light += lightSVGPalette.criticalRules()
+		dark += darkSVGPalette.criticalRules()
+	}
+	return light + "\n@media (prefers-color-scheme: dark) {\n" + dark + "\n}"
+}
+
+// statusClass maps a status to its SVG class (mirrors statusColor).
+func statusClass(s model.Status) string {
+	switch {
+	case isClosedLikeStatus(s):
+		return "st-closed"
+	case s == model.StatusBlocked:
+		return "st-blocked"
+	case s == model.StatusInProgress:
+		return "st-inprog"
+	default:
+		return "st-open"
+	}
+}
+
+func class(name string) string {
+	return fmt.Sprintf(`class="%s"`, name)
+}
+
+// trendMarker describes how one trend kind is drawn on a node corner.
+type trendMarker struct {
+	Trend analysis.IssueTrend
+	Class string
+	Color color.RGBA
+	Label string
+}
+
+// trendMarkers lists trend kinds in drawing order (right to left on a node).
+var trendMarkers = []trendMarker{
+	{analysis.TrendPriorityRaised, "tr-prio", colorTrendPrio, "prio"},
+	{analysis.TrendUnblocked, "tr-unbl", colorTrendUnbl, "unblocked"},
+	{analysis.TrendCommented, "tr-cmt", colorTrendCmt, "comment"},
+}
+
+// nodeTrendMarkers returns the markers for a node in drawing order.
+func nodeTrendMarkers(n layoutNode) []trendMarker {
+	var out []trendMarker
+	for _, m := range trendMarkers {
+		for _, t := range n.Trends {
+			if t == m.Trend {
+				out = append(out, m)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// trendShape returns the polygon for a marker centred at (cx, cy): an up
+// arrow for priority, a right arrow for unblocked. Comments are drawn as a
+// dot and return nil.
+func trendShape(t analysis.IssueTrend, cx, cy float64) (xs, ys []float64) {
+	switch t {
+	case analysis.TrendPriorityRaised:
+		return []float64{cx, cx + 5, cx - 5}, []float64{cy - 5, cy + 4, cy + 4}
+	case analysis.TrendUnblocked:
+		return []float64{cx - 4, cx + 5, cx - 4}, []float64{cy - 5, cy, cy + 5}
+	}
+	return nil, nil
+}
+
 func statusColor(s model.Status) color.RGBA {
 	switch {
 	case isClosedLikeStatus(s):
@@ -329,6 +577,11 @@ func statusColor(s model.Status) color.RGBA {
 }
 
 func renderPNG(opts GraphSnapshotOptions, layout layoutResult) error {
+	return drawPNG(layout).SavePNG(opts.Path)
+}
+
+// drawPNG rasterizes the layout onto a fresh canvas
+func drawPNG(layout layoutResult) *gg.Context {
 	dc := gg.NewContext(layout.Width, layout.Height)
 	dc.SetColor(colorBackdrop)
 	dc.Clear()
@@ -342,6 +595,7 @@ func renderPNG(opts GraphSnapshotOptions, layout layoutResult) error {
 
 	drawSummaryBlock(dc, layout)
 	drawLegend(dc, layout)
+	drawStamp(dc, layout)
 
 	// edges
 	nodePos := make(map[string]layoutNode, len(layout.Nodes))
@@ -357,9 +611,28 @@ func renderPNG(opts GraphSnapshotOptions, layout layoutResult) error {
 		y1 := from.Y + from.NodeH/2
 		x2 := to.X
 		y2 := to.Y + to.NodeH/2
+		if e.Suggested {
+			dc.SetColor(colorSuggested)
+			dc.SetDash(6, 4)
+			dc.DrawLine(x1, y1, x2, y2)
+			dc.Stroke()
+			dc.SetDash()
+			dc.SetColor(colorEdge)
+			continue
+		}
+		if e.Critical {
+			dc.SetColor(colorCritical)
+			dc.SetLineWidth(4)
+		}
 		dc.DrawLine(x1, y1, x2, y2)
 		dc.Stroke()
-		drawArrow(dc, x2, y2, -8, 0)
+		if e.Critical {
+			drawArrow(dc, x2, y2, -8, 0, colorCritical)
+			dc.SetColor(colorEdge)
+			dc.SetLineWidth(2)
+			continue
+		}
+		drawArrow(dc, x2, y2, -8, 0, colorEdgeArrow)
 	}
 
 	// nodes
@@ -367,7 +640,7 @@ func renderPNG(opts GraphSnapshotOptions, layout layoutResult) error {
 		drawNode(dc, n)
 	}
 
-	return dc.SavePNG(opts.Path)
+	return dc
 }
 
 func renderSVG(opts GraphSnapshotOptions, layout layoutResult) error {
@@ -383,11 +656,13 @@ func renderSVG(opts GraphSnapshotOptions, layout layoutResult) error {
 func renderSVGToWriter(w io.Writer, layout layoutResult) error {
 	canvas := svg.New(w)
 	canvas.Start(layout.Width, layout.Height)
-	canvas.Rect(0, 0, layout.Width, layout.Height, fmt.Sprintf("fill:%s", css(colorBackdrop)))
-	canvas.Roundrect(16, 16, layout.Width-32, int(layout.Header-24), 10, 10, fmt.Sprintf("fill:%s", css(colorHeaderBG)))
+	canvas.Style("text/css", svgSchemeCSS(layout.Summary.Critical > 0))
+	canvas.Rect(0, 0, layout.Width, layout.Height, class("bg"))
+	canvas.Roundrect(16, 16, layout.Width-32, int(layout.Header-24), 10, 10, class("hdr"))
 
 	drawSummaryBlockSVG(canvas, layout)
 	drawLegendSVG(canvas, layout)
+	drawStampSVG(canvas, layout)
 
 	nodePos := make(map[string]layoutNode, len(layout.Nodes))
 	for _, n := range layout.Nodes {
@@ -401,24 +676,37 @@ func renderSVGToWriter(w io.Writer, layout layoutResult) error {
 		y1 := int(from.Y + from.NodeH/2)
 		x2 := int(to.X)
 		y2 := int(to.Y + to.NodeH/2)
-		canvas.Line(x1, y1, x2, y2, fmt.Sprintf("stroke:%s;stroke-width:2", css(colorEdge)))
+		if e.Suggested {
+			canvas.Line(x1, y1, x2, y2, class("sugg"), "stroke-width:1.5;stroke-dasharray:6,4")
+			continue
+		}
+		lineClass, arrowClass, width := "edge", "arrow", "stroke-width:2"
+		if e.Critical {
+			lineClass, arrowClass, width = "crit", "crit-arrow", "stroke-width:4"
+		}
+		canvas.Line(x1, y1, x2, y2, class(lineClass), width)
 		// simple arrow head
 		canvas.Polygon(
 			[]int{x2, x2 + 8, x2 + 8},
 			[]int{y2, y2 + 4, y2 - 4},
-			fmt.Sprintf("fill:%s", css(colorEdgeArrow)),
+			class(arrowClass),
 		)
 	}
 
 	for _, n := range layout.Nodes {
 		x := int(n.X)
 		y := int(n.Y)
-		canvas.Roundrect(x, y, int(n.NodeW), int(n.NodeH), 8, 8,
-			fmt.Sprintf("fill:%s;stroke:%s;stroke-width:1.2", css(statusColor(n.Status)), css(colorStroke)))
-		canvas.Text(x+10, y+22, n.ID, fmt.Sprintf("fill:%s;font-size:13px;font-family:monospace;font-weight:bold", css(colorText)))
-		canvas.Text(x+10, y+42, truncate(n.Title, 40), fmt.Sprintf("fill:%s;font-size:12px;font-family:monospace", css(colorSubtle)))
-		canvas.Text(x+10, y+60, fmt.Sprintf("PR %.3f", n.PageRank),
-			fmt.Sprintf("fill:%s;font-size:11px;font-family:monospace", css(colorSubtle)))
+		if n.Critical {
+			canvas.Roundrect(x, y, int(n.NodeW), int(n.NodeH), 8, 8, class(statusClass(n.Status)+" crit"), "stroke-width:3")
+		} else {
+			canvas.Roundrect(x, y, int(n.NodeW), int(n.NodeH), 8, 8, class(statusClass(n.Status)), "stroke-width:1.2")
+		}
+		canvas.Text(x+10, y+22, n.ID, class("txt"), "font-size:13px;font-family:monospace;font-weight:bold")
+		canvas.Text(x+10, y+42, truncate(n.Title, 40), class("dim"), "font-size:12px;font-family:monospace")
+		canvas.Text(x+10, y+60, fmt.Sprintf("PR %.3f", n.PageRank), class("dim"), "font-size:11px;font-family:monospace")
+		for idx, m := range nodeTrendMarkers(n) {
+			drawTrendMarkerSVG(canvas, m, x+int(n.NodeW)-12-idx*14, y+12)
+		}
 	}
 
 	canvas.End()
@@ -431,6 +719,10 @@ func drawNode(dc *gg.Context, n layoutNode) {
 	dc.Fill()
 	dc.SetColor(colorStroke)
 	dc.SetLineWidth(1.2)
+	if n.Critical {
+		dc.SetColor(colorCritical)
+		dc.SetLineWidth(3)
+	}
 	dc.DrawRoundedRectangle(n.X, n.Y, n.NodeW, n.NodeH, 8)
 	dc.Stroke()
 
@@ -439,10 +731,46 @@ func drawNode(dc *gg.Context, n layoutNode) {
 	dc.SetColor(colorSubtle)
 	dc.DrawStringAnchored(truncate(n.Title, 40), n.X+10, n.Y+36, 0, 0.5)
 	dc.DrawStringAnchored(fmt.Sprintf("PR %.3f", n.PageRank), n.X+10, n.Y+54, 0, 0.5)
+
+	for idx, m := range nodeTrendMarkers(n) {
+		drawTrendMarker(dc, m, n.X+n.NodeW-12-float64(idx)*14, n.Y+12)
+	}
+}
+
+func drawTrendMarker(dc *gg.Context, m trendMarker, cx, cy float64) {
+	dc.SetColor(m.Color)
+	xs, ys := trendShape(m.Trend, cx, cy)
+	if xs == nil {
+		dc.DrawCircle(cx, cy, 4)
+		dc.Fill()
+		return
+	}
+	dc.NewSubPath()
+	dc.MoveTo(xs[0], ys[0])
+	for i := 1; i < len(xs); i++ {
+		dc.LineTo(xs[i], ys[i])
+	}
+	dc.ClosePath()
+	dc.Fill()
+}
+
+func drawTrendMarkerSVG(canvas *svg.SVG, m trendMarker, cx, cy int) {
+	xs, ys := trendShape(m.Trend, float64(cx), float64(cy))
+	if xs == nil {
+		canvas.Circle(cx, cy, 4, class(m.Class))
+		return
+	}
+	ix := make([]int, len(xs))
+	iy := make([]int, len(ys))
+	for i := range xs {
+		ix[i] = int(xs[i])
+		iy[i] = int(ys[i])
+	}
+	canvas.Polygon(ix, iy, class(m.Class))
 }
 
-func drawArrow(dc *gg.Context, x, y, dx, dy float64) {
-	dc.SetColor(colorEdgeArrow)
+func drawArrow(dc *gg.Context, x, y, dx, dy float64, c color.RGBA) {
+	dc.SetColor(c)
 	dc.NewSubPath()
 	dc.MoveTo(x, y)
 	dc.LineTo(x+dx, y+dy+4)
@@ -456,13 +784,29 @@ func drawSummaryBlock(dc *gg.Context, layout layoutResult) {
 	dc.DrawStringAnchored(layout.Summary.Title, 32, 44, 0, 0.5)
 	dc.SetColor(colorSubtle)
 	dc.DrawStringAnchored(fmt.Sprintf("data_hash: %s", layout.Summary.DataHash), 32, 64, 0, 0.5)
-	dc.DrawStringAnchored(fmt.Sprintf("nodes: %d  edges: %d", layout.Summary.NodeCount, layout.Summary.EdgeCount), 32, 84, 0, 0.5)
+	dc.DrawStringAnchored(edgeSummary(layout.Summary), 32, 84, 0, 0.5)
 	dc.DrawStringAnchored(fmt.Sprintf("top bottleneck: %s", layout.Summary.TopBottleneck), 32, 104, 0, 0.5)
 }
 
+// edgeSummary formats the node/edge count line, mentioning suggested links
+// only when the overlay produced any.
+func edgeSummary(info summaryInfo) string {
+	line := fmt.Sprintf("nodes: %d  edges: %d", info.NodeCount, info.EdgeCount)
+	if info.Suggested > 0 {
+		line += fmt.Sprintf("  suggested: %d", info.Suggested)
+	}
+	if info.Critical > 0 {
+		line += fmt.Sprintf("  critical path: %d", info.Critical)
+	}
+	return line
+}
+
 func drawLegend(dc *gg.Context, layout layoutResult) {
 	boxW := 180.0
 	boxH := 96.0
+	if layout.Summary.Trended > 0 {
+		boxW, boxH = 232, 114
+	}
 	x := float64(layout.Width) - boxW - 20
 	y := 24.0
 	dc.SetColor(colorLegendBG)
@@ -478,6 +822,16 @@ func drawLegend(dc *gg.Context, layout layoutResult) {
 	drawLegendRow(dc, x+12, y+52, colorInProg, "In Progress")
 	drawLegendRow(dc, x+12, y+68, colorBlocked, "Blocked (has blockers)")
 	drawLegendRow(dc, x+12, y+84, colorClosed, "Closed")
+
+	if layout.Summary.Trended > 0 {
+		mx := x + 16
+		for _, m := range trendMarkers {
+			drawTrendMarker(dc, m, mx, y+102)
+			dc.SetColor(colorSubtle)
+			dc.DrawStringAnchored(m.Label, mx+8, y+102, 0, 0.5)
+			mx += 22 + float64(len(m.Label))*7
+		}
+	}
 }
 
 func drawLegendRow(dc *gg.Context, x, y float64, c color.RGBA, label string) {
@@ -492,28 +846,40 @@ func drawLegendRow(dc *gg.Context, x, y float64, c color.RGBA, label string) {
 }
 
 func drawSummaryBlockSVG(canvas *svg.SVG, layout layoutResult) {
-	canvas.Text(32, 44, layout.Summary.Title, fmt.Sprintf("fill:%s;font-size:16px;font-family:monospace;font-weight:bold", css(colorText)))
-	canvas.Text(32, 64, fmt.Sprintf("data_hash: %s", layout.Summary.DataHash), fmt.Sprintf("fill:%s;font-size:13px;font-family:monospace", css(colorSubtle)))
-	canvas.Text(32, 84, fmt.Sprintf("nodes: %d  edges: %d", layout.Summary.NodeCount, layout.Summary.EdgeCount), fmt.Sprintf("fill:%s;font-size:13px;font-family:monospace", css(colorSubtle)))
-	canvas.Text(32, 104, fmt.Sprintf("top bottleneck: %s", layout.Summary.TopBottleneck), fmt.Sprintf("fill:%s;font-size:13px;font-family:monospace", css(colorSubtle)))
+	canvas.Text(32, 44, layout.Summary.Title, class("txt"), "font-size:16px;font-family:monospace;font-weight:bold")
+	canvas.Text(32, 64, fmt.Sprintf("data_hash: %s", layout.Summary.DataHash), class("dim"), "font-size:13px;font-family:monospace")
+	canvas.Text(32, 84, edgeSummary(layout.Summary), class("dim"), "font-size:13px;font-family:monospace")
+	canvas.Text(32, 104, fmt.Sprintf("top bottleneck: %s", layout.Summary.TopBottleneck), class("dim"), "font-size:13px;font-family:monospace")
 }
 
 func drawLegendSVG(canvas *svg.SVG, layout layoutResult) {
 	boxW := 180
 	boxH := 96
+	if layout.Summary.Trended > 0 {
+		boxW, boxH = 232, 114
+	}
 	x := layout.Width - boxW - 20
 	y := 24
-	canvas.Roundrect(x, y, boxW, boxH, 10, 10, fmt.Sprintf("fill:%s;stroke:%s;stroke-width:1", css(colorLegendBG), css(colorStroke)))
-	canvas.Text(x+12, y+18, "Legend", fmt.Sprintf("fill:%s;font-size:13px;font-family:monospace;font-weight:bold", css(colorText)))
-	drawLegendRowSVG(canvas, x+12, y+36, colorOpen, "Open / Ready")
-	drawLegendRowSVG(canvas, x+12, y+52, colorInProg, "In Progress")
-	drawLegendRowSVG(canvas, x+12, y+68, colorBlocked, "Blocked")
-	drawLegendRowSVG(canvas, x+12, y+84, colorClosed, "Closed")
+	canvas.Roundrect(x, y, boxW, boxH, 10, 10, class("lgd"), "stroke-width:1")
+	canvas.Text(x+12, y+18, "Legend", class("txt"), "font-size:13px;font-family:monospace;font-weight:bold")
+	drawLegendRowSVG(canvas, x+12, y+36, "st-open", "Open / Ready")
+	drawLegendRowSVG(canvas, x+12, y+52, "st-inprog", "In Progress")
+	drawLegendRowSVG(canvas, x+12, y+68, "st-blocked", "Blocked")
+	drawLegendRowSVG(canvas, x+12, y+84, "st-closed", "Closed")
+
+	if layout.Summary.Trended > 0 {
+		mx := x + 16
+		for _, m := range trendMarkers {
+			drawTrendMarkerSVG(canvas, m, mx, y+102)
+			canvas.Text(mx+8, y+106, m.Label, class("dim"), "font-size:11px;font-family:monospace")
+			mx += 22 + len(m.Label)*7
+		}
+	}
 }
 
-func drawLegendRowSVG(canvas *svg.SVG, x, y int, c color.RGBA, label string) {
-	canvas.Roundrect(x, y-8, 14, 14, 3, 3, fmt.Sprintf("fill:%s;stroke:%s;stroke-width:1", css(c), css(colorStroke)))
-	canvas.Text(x+20, y, label, fmt.Sprintf("fill:%s;font-size:12px;font-family:monospace", css(colorSubtle)))
+func drawLegendRowSVG(canvas *svg.SVG, x, y int, swatch, label string) {
+	canvas.Roundrect(x, y-8, 14, 14, 3, 3, class(swatch), "stroke-width:1")
+	canvas.Text(x+20, y, label, class("dim"), "font-size:12px;font-family:monospace")
 }
 
 // --- helpers ---------------------------------------------------------------
@@ -1,6 +1,7 @@
 package export
 
 import (
+	"bytes"
 	"encoding/xml"
 	"os"
 	"path/filepath"
@@ -8,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
@@ -496,6 +498,44 @@ func TestSVG_NonBlockingDepsIgnored(t *testing.T) {
 	}
 }
 
+// TestSVG_CriticalPathHighlighted verifies the opt-in critical path overlay
+func TestSVG_CriticalPathHighlighted(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "A", Status: model.StatusOpen},
+		{ID: "B", Title: "B", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepBlocks}}},
+		{ID: "C", Title: "C", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "B", Type: model.DepBlocks}}},
+		{ID: "D", Title: "D", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepBlocks}}},
+	}
+	analyzer := analysis.NewAnalyzer(issues)
+	stats := analyzer.Analyze()
+
+	render := func(critical bool) string {
+		var buf bytes.Buffer
+		layout := buildLayout(GraphSnapshotOptions{Issues: issues, Stats: &stats, DataHash: "hash", CriticalPath: critical})
+		if err := renderSVGToWriter(&buf, layout); err != nil {
+			t.Fatalf("render: %v", err)
+		}
+		return buf.String()
+	}
+
+	plain := render(false)
+	if strings.Contains(plain, "crit") {
+		t.Error("critical path styling should be opt-in")
+	}
+
+	svgStr := render(true)
+	// A -> B -> C is the path; D hangs off A and stays plain.
+	if got := strings.Count(svgStr, `class="crit"`); got != 2 {
+		t.Errorf("expected 2 critical edges, found %d", got)
+	}
+	if got := strings.Count(svgStr, ` crit"`); got != 3 {
+		t.Errorf("expected 3 critical nodes, found %d", got)
+	}
+	if !strings.Contains(svgStr, "critical path: 3") {
+		t.Error("summary should report the critical path length")
+	}
+}
+
 // ============================================================================
 // Legend and Summary Tests
 // ============================================================================
@@ -1002,3 +1042,144 @@ func TestSVG_LongTitleTruncation(t *testing.T) {
 		t.Error("Truncation ellipsis not found for long title")
 	}
 }
+
+// TestSVG_SuggestedEdgesDashed verifies the opt-in related-link overlay
+func TestSVG_SuggestedEdgesDashed(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Login session timeout", Status: model.StatusOpen, Labels: []string{"auth"}},
+		{ID: "B", Title: "Login session refresh", Status: model.StatusOpen, Labels: []string{"auth"}},
+	}
+	analyzer := analysis.NewAnalyzer(issues)
+	stats := analyzer.Analyze()
+
+	tmp := t.TempDir()
+	plain := filepath.Join(tmp, "plain.svg")
+	overlay := filepath.Join(tmp, "overlay.svg")
+
+	for path, show := range map[string]bool{plain: false, overlay: true} {
+		err := SaveGraphSnapshot(GraphSnapshotOptions{
+			Path:            path,
+			Issues:          issues,
+			Stats:           &stats,
+			DataHash:        "hash",
+			ShowSuggestions: show,
+		})
+		if err != nil {
+			t.Fatalf("SaveGraphSnapshot error: %v", err)
+		}
+	}
+
+	plainContent, _ := os.ReadFile(plain)
+	if strings.Contains(string(plainContent), "stroke-dasharray") {
+		t.Error("suggested edges should not render unless ShowSuggestions is set")
+	}
+	overlayContent, _ := os.ReadFile(overlay)
+	if !strings.Contains(string(overlayContent), "stroke-dasharray") {
+		t.Error("expected dashed suggested edge in overlay SVG")
+	}
+	if !strings.Contains(string(overlayContent), "suggested: 1") {
+		t.Error("expected suggested count in summary block")
+	}
+}
+
+// TestSVG_EmbedsBothColorSchemes verifies the prefers-color-scheme stylesheet
+func TestSVG_EmbedsBothColorSchemes(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Task A", Status: model.StatusOpen},
+		{ID: "B", Title: "Task B", Status: model.StatusBlocked, Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepBlocks}}},
+	}
+	analyzer := analysis.NewAnalyzer(issues)
+	stats := analyzer.Analyze()
+
+	out := filepath.Join(t.TempDir(), "scheme.svg")
+	if err := SaveGraphSnapshot(GraphSnapshotOptions{Path: out, Issues: issues, Stats: &stats}); err != nil {
+		t.Fatalf("SaveGraphSnapshot error: %v", err)
+	}
+	content, _ := os.ReadFile(out)
+	svgStr := string(content)
+
+	if !strings.Contains(svgStr, "@media (prefers-color-scheme: dark)") {
+		t.Fatal("expected dark color scheme media query")
+	}
+	if !strings.Contains(svgStr, css(darkSVGPalette.Backdrop)) {
+		t.Error("expected dark palette colors to be embedded")
+	}
+	if strings.Contains(svgStr, "style=\"fill:") {
+		t.Error("inline fills would override the color scheme stylesheet")
+	}
+	if !strings.Contains(svgStr, `class="st-blocked"`) {
+		t.Error("expected status class on blocked node")
+	}
+}
+
+// TestSVG_TrendMarkers verifies diff-driven markers are drawn on moved nodes
+func TestSVG_TrendMarkers(t *testing.T) {
+	before := []model.Issue{
+		{ID: "A", Title: "Root", Status: model.StatusOpen, Priority: 2},
+		{ID: "B", Title: "Child", Status: model.StatusOpen, Priority: 2},
+	}
+	after := []model.Issue{
+		{ID: "A", Title: "Root", Status: model.StatusOpen, Priority: 0,
+			Comments: []*model.Comment{{ID: 1, Text: "bump"}}},
+		{ID: "B", Title: "Child", Status: model.StatusOpen, Priority: 2},
+	}
+	stats := analysis.NewAnalyzer(after).Analyze()
+	diff := analysis.CompareSnapshots(analysis.NewSnapshot(before), analysis.NewSnapshot(after))
+
+	var buf bytes.Buffer
+	layout := buildLayout(GraphSnapshotOptions{Issues: after, Stats: &stats, Diff: diff})
+	if err := renderSVGToWriter(&buf, layout); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	content := buf.String()
+
+	// One marker each on node A plus one each in the legend
+	if got := strings.Count(content, `class="tr-prio"`); got != 2 {
+		t.Errorf("expected 2 priority markers (node + legend), got %d", got)
+	}
+	if got := strings.Count(content, `class="tr-cmt"`); got != 2 {
+		t.Errorf("expected 2 comment markers (node + legend), got %d", got)
+	}
+	if got := strings.Count(content, `class="tr-unbl"`); got != 1 {
+		t.Errorf("expected legend-only unblocked marker, got %d", got)
+	}
+
+	// No diff, no markers or trend legend
+	buf.Reset()
+	if err := renderSVGToWriter(&buf, buildLayout(GraphSnapshotOptions{Issues: after, Stats: &stats})); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(buf.String(), `class="tr-`) {
+		t.Error("trend markers should only render when a diff is supplied")
+	}
+}
+
+func TestSVG_ProvenanceStamp(t *testing.T) {
+	issues := []model.Issue{{ID: "A", Title: "Root", Status: model.StatusOpen}}
+	stats := analysis.NewAnalyzer(issues).Analyze()
+	generated := time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	layout := buildLayout(GraphSnapshotOptions{Issues: issues, Stats: &stats, DataHash: "0123456789abcdef", GeneratedAt: generated})
+	if err := renderSVGToWriter(&buf, layout); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	content := buf.String()
+	if !strings.Contains(content, `<g class="stamp"`) {
+		t.Fatal("expected QR stamp group in header")
+	}
+	if !strings.Contains(content, "<title>bv data_hash=0123456789abcdef generated=2025-01-06T12:00:00Z</title>") {
+		t.Errorf("stamp title should carry hash and timestamp:\n%s", content)
+	}
+	if !strings.Contains(content, "data_hash: 0123456789abcdef") {
+		t.Error("textual data_hash should remain alongside the QR stamp")
+	}
+
+	buf.Reset()
+	if err := renderSVGToWriter(&buf, buildLayout(GraphSnapshotOptions{Issues: issues, Stats: &stats})); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if strings.Contains(buf.String(), `class="stamp"`) {
+		t.Error("no stamp expected without a data hash")
+	}
+}
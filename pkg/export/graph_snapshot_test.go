@@ -1,12 +1,15 @@
 package export
 
 import (
+	"errors"
 	"image/color"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/limits"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
@@ -51,6 +54,63 @@ func TestSaveGraphSnapshot_SVGAndPNG(t *testing.T) {
 	}
 }
 
+func TestSaveGraphSnapshot_ResourceLimits(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Root", Status: model.StatusOpen},
+		{ID: "B", Title: "Leaf", Status: model.StatusOpen},
+		{ID: "C", Title: "Other", Status: model.StatusOpen},
+	}
+	stats := analysis.NewAnalyzer(issues).Analyze()
+	tmp := t.TempDir()
+
+	restore := limits.Set(limits.Limits{MaxRenderNodes: 2})
+	err := SaveGraphSnapshot(GraphSnapshotOptions{Path: filepath.Join(tmp, "nodes.svg"), Issues: issues, Stats: &stats})
+	restore()
+	var exceeded *limits.ExceededError
+	if !errors.As(err, &exceeded) || !strings.Contains(err.Error(), "--graph-root") {
+		t.Fatalf("expected a node-limit error suggesting focus options, got %v", err)
+	}
+
+	restore = limits.Set(limits.Limits{MaxMemoryMB: 1})
+	defer restore()
+	err = SaveGraphSnapshot(GraphSnapshotOptions{Path: filepath.Join(tmp, "big.png"), Issues: issues, Stats: &stats})
+	if !errors.As(err, &exceeded) || exceeded.Unit != "MB" {
+		t.Fatalf("expected a memory-limit error for the PNG canvas, got %v", err)
+	}
+	if _, statErr := os.Stat(filepath.Join(tmp, "big.png")); !os.IsNotExist(statErr) {
+		t.Error("nothing should be written when a limit is exceeded")
+	}
+	// SVG has no raster canvas, so the memory limit does not apply.
+	if err := SaveGraphSnapshot(GraphSnapshotOptions{Path: filepath.Join(tmp, "ok.svg"), Issues: issues, Stats: &stats}); err != nil {
+		t.Fatalf("SVG export should be unaffected: %v", err)
+	}
+}
+
+func TestRenderGraphSnapshotImage(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Root", Status: model.StatusOpen},
+		{ID: "B", Title: "Leaf", Status: model.StatusBlocked, Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepBlocks}}},
+	}
+	stats := analysis.NewAnalyzer(issues).Analyze()
+	opts := GraphSnapshotOptions{Issues: issues, Stats: &stats}
+
+	img, err := RenderGraphSnapshotImage(opts)
+	if err != nil {
+		t.Fatalf("RenderGraphSnapshotImage error: %v", err)
+	}
+	layout := buildLayout(opts)
+	if b := img.Bounds(); b.Dx() != layout.Width || b.Dy() != layout.Height {
+		t.Errorf("image is %dx%d, layout %dx%d", b.Dx(), b.Dy(), layout.Width, layout.Height)
+	}
+
+	restore := limits.Set(limits.Limits{MaxMemoryMB: 1})
+	defer restore()
+	var exceeded *limits.ExceededError
+	if _, err := RenderGraphSnapshotImage(opts); !errors.As(err, &exceeded) {
+		t.Errorf("expected the PNG memory limit to apply, got %v", err)
+	}
+}
+
 func TestSaveGraphSnapshot_InvalidFormat(t *testing.T) {
 	issues := []model.Issue{{ID: "A", Title: "Root", Status: model.StatusOpen}}
 	analyzer := analysis.NewAnalyzer(issues)
@@ -0,0 +1,104 @@
+package export
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+	"time"
+
+	"git.sr.ht/~sbinet/gg"
+	"github.com/ajstarks/svgo"
+)
+
+const (
+	stampModulePx = 2 // pixels per QR module
+	stampQuiet    = 4 // quiet-zone modules around the symbol
+)
+
+// provenanceStamp is the text encoded in the header QR code. It is empty when
+// there is no data hash to trace.
+func provenanceStamp(dataHash string, generatedAt time.Time) string {
+	if dataHash == "" {
+		return ""
+	}
+	stamp := "bv data_hash=" + dataHash
+	if !generatedAt.IsZero() {
+		stamp += " generated=" + generatedAt.UTC().Format(time.RFC3339)
+	}
+	return stamp
+}
+
+// stampPlacement encodes the stamp and positions it in the header card, just
+// left of the legend. ok is false when there is nothing to draw.
+func stampPlacement(layout layoutResult) (q *qrCode, x, y, side int, ok bool) {
+	if layout.Summary.Stamp == "" {
+		return nil, 0, 0, 0, false
+	}
+	q, err := encodeQR([]byte(layout.Summary.Stamp))
+	if err != nil {
+		return nil, 0, 0, 0, false
+	}
+	side = (q.size + 2*stampQuiet) * stampModulePx
+	legendW := 180
+	if layout.Summary.Trended > 0 {
+		legendW = 232
+	}
+	x = layout.Width - legendW - 20 - 12 - side
+	y = 16 + (int(layout.Header)-24-side)/2
+	if y < 16 {
+		y = 16
+	}
+	return q, x, y, side, true
+}
+
+// drawStamp renders the provenance QR code. It is always dark-on-white so
+// scanners can read it regardless of the surrounding theme.
+func drawStamp(dc *gg.Context, layout layoutResult) {
+	q, x, y, side, ok := stampPlacement(layout)
+	if !ok {
+		return
+	}
+	dc.SetColor(color.White)
+	dc.DrawRectangle(float64(x), float64(y), float64(side), float64(side))
+	dc.Fill()
+	dc.SetColor(color.Black)
+	for row := 0; row < q.size; row++ {
+		for col := 0; col < q.size; col++ {
+			if q.modules[row][col] {
+				dc.DrawRectangle(float64(x+(stampQuiet+col)*stampModulePx), float64(y+(stampQuiet+row)*stampModulePx),
+					stampModulePx, stampModulePx)
+			}
+		}
+	}
+	dc.Fill()
+}
+
+func drawStampSVG(canvas *svg.SVG, layout layoutResult) {
+	q, x, y, side, ok := stampPlacement(layout)
+	if !ok {
+		return
+	}
+	// One path with a subpath per horizontal run of dark modules keeps the
+	// SVG small.
+	var d strings.Builder
+	for row := 0; row < q.size; row++ {
+		for col := 0; col < q.size; {
+			if !q.modules[row][col] {
+				col++
+				continue
+			}
+			run := 0
+			for col+run < q.size && q.modules[row][col+run] {
+				run++
+			}
+			fmt.Fprintf(&d, "M%d %dh%dv%dh-%dz", x+(stampQuiet+col)*stampModulePx, y+(stampQuiet+row)*stampModulePx,
+				run*stampModulePx, stampModulePx, run*stampModulePx)
+			col += run
+		}
+	}
+	canvas.Group(`class="stamp"`)
+	canvas.Title(layout.Summary.Stamp)
+	canvas.Rect(x, y, side, side, "fill:#ffffff")
+	canvas.Path(d.String(), "fill:#000000")
+	canvas.Gend()
+}
@@ -0,0 +1,234 @@
+package export
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+
+	"git.sr.ht/~sbinet/gg"
+	"github.com/ajstarks/svgo"
+	"golang.org/x/image/font/basicfont"
+)
+
+// LabelHeatmapOptions controls label co-occurrence heatmap export.
+type LabelHeatmapOptions struct {
+	Path     string // Output path; format inferred from extension when Format empty
+	Format   string // "svg" or "png" (case-insensitive). If empty, inferred from Path.
+	Title    string // Optional chart title
+	Taxonomy analysis.LabelTaxonomy
+
+	// MaxLabels keeps the most used labels so the grid stays readable
+	// (default 30)
+	MaxLabels int
+}
+
+var (
+	colorHeatLow  = color.RGBA{0xe3, 0xf2, 0xfd, 0xff}
+	colorHeatHigh = color.RGBA{0x0d, 0x47, 0xa1, 0xff}
+	colorHeatSelf = color.RGBA{0xe0, 0xe0, 0xe0, 0xff}
+)
+
+// SaveLabelHeatmap renders the label co-occurrence matrix as a heatmap (SVG or
+// PNG). Cell shade is the pair's Jaccard overlap, so a dark cell means the two
+// labels nearly always travel together; the diagonal shows each label's count.
+func SaveLabelHeatmap(opts LabelHeatmapOptions) error {
+	if len(opts.Taxonomy.Labels) == 0 {
+		return fmt.Errorf("no labels to chart")
+	}
+	format := strings.ToLower(strings.TrimPrefix(opts.Format, "."))
+	if format == "" {
+		format = "svg"
+		if strings.EqualFold(filepath.Ext(opts.Path), ".png") {
+			format = "png"
+		}
+	}
+	if format != "svg" && format != "png" {
+		return fmt.Errorf("unsupported format %q (want svg or png)", format)
+	}
+	if opts.Path == "" {
+		return fmt.Errorf("output path is required")
+	}
+	if err := os.MkdirAll(filepath.Dir(opts.Path), 0o755); err != nil {
+		return fmt.Errorf("create parent dir: %w", err)
+	}
+
+	layout := buildHeatmapLayout(opts)
+	if format == "png" {
+		return renderHeatmapPNG(opts.Path, layout)
+	}
+	file, err := os.Create(opts.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return renderHeatmapSVG(file, layout)
+}
+
+const (
+	heatmapCellSize  = 24
+	heatmapLabelRune = 18 // label characters shown before truncation
+)
+
+type heatmapCell struct {
+	Row, Col int
+	Count    int
+	Fill     color.RGBA
+	Dark     bool // fill is dark enough to need light text
+}
+
+type heatmapLayout struct {
+	Width, Height  int
+	Left, Top      float64 // grid origin in pixels
+	Title, Summary string
+	Labels         []string
+	Cells          []heatmapCell
+}
+
+func buildHeatmapLayout(opts LabelHeatmapOptions) heatmapLayout {
+	tax := opts.Taxonomy
+	limit := opts.MaxLabels
+	if limit <= 0 {
+		limit = 30
+	}
+
+	// Keep the most used labels, then show them alphabetically.
+	idx := make([]int, len(tax.Labels))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return tax.Counts[idx[a]] > tax.Counts[idx[b]] })
+	if len(idx) > limit {
+		idx = idx[:limit]
+	}
+	sort.Ints(idx)
+
+	l := heatmapLayout{Title: opts.Title}
+	if strings.TrimSpace(l.Title) == "" {
+		l.Title = "Label Co-occurrence"
+	}
+	l.Summary = fmt.Sprintf("%d labels, %d pairs seen together, %d merge suggestion(s)",
+		len(tax.Labels), len(tax.Pairs), len(tax.Merges))
+	if len(idx) < len(tax.Labels) {
+		l.Summary += fmt.Sprintf(" (showing top %d)", len(idx))
+	}
+
+	longest := 0
+	for _, i := range idx {
+		label := truncate(tax.Labels[i], heatmapLabelRune)
+		l.Labels = append(l.Labels, label)
+		longest = max(longest, len([]rune(label)))
+	}
+	gutter := float64(8*longest + 16)
+	l.Left = math.Max(60, gutter)
+	l.Top = 80 + gutter*math.Sqrt2/2
+	n := float64(len(idx))
+	l.Width = int(math.Max(l.Left+n*heatmapCellSize+gutter*math.Sqrt2/2+20, 480))
+	l.Height = int(l.Top + n*heatmapCellSize + 30)
+
+	for r, i := range idx {
+		for c, j := range idx {
+			count := tax.Matrix[i][j]
+			cell := heatmapCell{Row: r, Col: c, Count: count, Fill: colorHeatSelf}
+			if i != j {
+				share := 0.0
+				if union := tax.Counts[i] + tax.Counts[j] - count; union > 0 {
+					share = float64(count) / float64(union)
+				}
+				cell.Fill = colorBackdrop
+				if count > 0 {
+					cell.Fill = blendRGBA(colorHeatLow, colorHeatHigh, share)
+				}
+				cell.Dark = share > 0.5
+			}
+			l.Cells = append(l.Cells, cell)
+		}
+	}
+	return l
+}
+
+func blendRGBA(a, b color.RGBA, t float64) color.RGBA {
+	mix := func(x, y uint8) uint8 { return uint8(float64(x) + (float64(y)-float64(x))*t + 0.5) }
+	return color.RGBA{mix(a.R, b.R), mix(a.G, b.G), mix(a.B, b.B), 0xff}
+}
+
+func (l heatmapLayout) cellOrigin(c heatmapCell) (float64, float64) {
+	return l.Left + float64(c.Col*heatmapCellSize), l.Top + float64(c.Row*heatmapCellSize)
+}
+
+func renderHeatmapSVG(w io.Writer, l heatmapLayout) error {
+	canvas := svg.New(w)
+	canvas.Start(l.Width, l.Height)
+	canvas.Rect(0, 0, l.Width, l.Height, "fill:"+css(colorBackdrop))
+	canvas.Text(20, 34, l.Title, "fill:"+css(colorText)+";font-size:18px;font-family:monospace;font-weight:bold")
+	canvas.Text(20, 56, l.Summary, "fill:"+css(colorSubtle)+";font-size:12px;font-family:monospace")
+
+	labelStyle := "fill:" + css(colorText) + ";font-size:11px;font-family:monospace"
+	for i, label := range l.Labels {
+		y := int(l.Top) + i*heatmapCellSize + heatmapCellSize/2 + 4
+		canvas.Text(int(l.Left)-6, y, label, labelStyle+";text-anchor:end")
+		x := int(l.Left) + i*heatmapCellSize + heatmapCellSize/2
+		canvas.TranslateRotate(x, int(l.Top)-6, -45)
+		canvas.Text(0, 0, label, labelStyle)
+		canvas.Gend()
+	}
+	for _, c := range l.Cells {
+		x, y := l.cellOrigin(c)
+		canvas.Rect(int(x), int(y), heatmapCellSize, heatmapCellSize, "fill:"+css(c.Fill)+";stroke:"+css(colorBackdrop))
+		if c.Count == 0 {
+			continue
+		}
+		text := colorText
+		if c.Dark {
+			text = colorBackdrop
+		}
+		canvas.Text(int(x)+heatmapCellSize/2, int(y)+heatmapCellSize/2+4, fmt.Sprint(c.Count),
+			"fill:"+css(text)+";font-size:10px;font-family:monospace;text-anchor:middle")
+	}
+	canvas.End()
+	return nil
+}
+
+func renderHeatmapPNG(path string, l heatmapLayout) error {
+	dc := gg.NewContext(l.Width, l.Height)
+	dc.SetColor(colorBackdrop)
+	dc.Clear()
+	dc.SetFontFace(basicfont.Face7x13)
+
+	dc.SetColor(colorText)
+	dc.DrawString(l.Title, 20, 34)
+	dc.SetColor(colorSubtle)
+	dc.DrawString(l.Summary, 20, 56)
+
+	dc.SetColor(colorText)
+	for i, label := range l.Labels {
+		y := l.Top + float64(i*heatmapCellSize) + heatmapCellSize/2
+		dc.DrawStringAnchored(label, l.Left-6, y, 1, 0.35)
+		x := l.Left + float64(i*heatmapCellSize) + heatmapCellSize/2
+		dc.Push()
+		dc.RotateAbout(gg.Radians(-45), x, l.Top-6)
+		dc.DrawString(label, x, l.Top-6)
+		dc.Pop()
+	}
+	for _, c := range l.Cells {
+		x, y := l.cellOrigin(c)
+		dc.SetColor(c.Fill)
+		dc.DrawRectangle(x+0.5, y+0.5, heatmapCellSize-1, heatmapCellSize-1)
+		dc.Fill()
+		if c.Count == 0 {
+			continue
+		}
+		dc.SetColor(colorText)
+		if c.Dark {
+			dc.SetColor(colorBackdrop)
+		}
+		dc.DrawStringAnchored(fmt.Sprint(c.Count), x+heatmapCellSize/2, y+heatmapCellSize/2, 0.5, 0.35)
+	}
+	return dc.SavePNG(path)
+}
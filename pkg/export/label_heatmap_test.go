@@ -0,0 +1,58 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestSaveLabelHeatmap(t *testing.T) {
+	tax := analysis.ComputeLabelTaxonomy([]model.Issue{
+		{ID: "a", Labels: []string{"api", "backend"}},
+		{ID: "b", Labels: []string{"api", "backend"}},
+		{ID: "c", Labels: []string{"api", "ui"}},
+		{ID: "d", Labels: []string{"docs"}},
+	})
+	dir := t.TempDir()
+
+	svgPath := filepath.Join(dir, "labels.svg")
+	if err := SaveLabelHeatmap(LabelHeatmapOptions{Path: svgPath, Taxonomy: tax}); err != nil {
+		t.Fatalf("svg: %v", err)
+	}
+	data, err := os.ReadFile(svgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<svg", "Label Co-occurrence", "4 labels, 2 pairs seen together", ">backend<", "rotate(-45"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("svg missing %q", want)
+		}
+	}
+
+	if err := SaveLabelHeatmap(LabelHeatmapOptions{Path: svgPath, Taxonomy: tax, MaxLabels: 2}); err != nil {
+		t.Fatalf("svg limited: %v", err)
+	}
+	data, _ = os.ReadFile(svgPath)
+	if !strings.Contains(string(data), "(showing top 2)") || strings.Contains(string(data), ">docs<") {
+		t.Error("MaxLabels should keep only the two most used labels")
+	}
+
+	pngPath := filepath.Join(dir, "labels.png")
+	if err := SaveLabelHeatmap(LabelHeatmapOptions{Path: pngPath, Taxonomy: tax}); err != nil {
+		t.Fatalf("png: %v", err)
+	}
+	if info, err := os.Stat(pngPath); err != nil || info.Size() == 0 {
+		t.Fatalf("png not written: %v", err)
+	}
+
+	if err := SaveLabelHeatmap(LabelHeatmapOptions{Path: filepath.Join(dir, "x.gif"), Format: "gif", Taxonomy: tax}); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+	if err := SaveLabelHeatmap(LabelHeatmapOptions{Path: svgPath}); err == nil {
+		t.Error("expected error without labels")
+	}
+}
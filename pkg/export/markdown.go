@@ -7,9 +7,11 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
 	"unicode"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
@@ -70,18 +72,121 @@ func sanitizeMermaidText(text string) string {
 	return result
 }
 
-// GenerateMarkdown creates a comprehensive markdown report of all issues
+// MarkdownSortOrder selects the issue order in a markdown report
+type MarkdownSortOrder string
+
+const (
+	MarkdownSortStatus   MarkdownSortOrder = "status"   // open before closed, then priority, then newest
+	MarkdownSortPriority MarkdownSortOrder = "priority" // priority, then ID
+	MarkdownSortID       MarkdownSortOrder = "id"       // ID ascending
+	MarkdownSortUpdated  MarkdownSortOrder = "updated"  // most recently updated first
+	MarkdownSortNone     MarkdownSortOrder = "none"     // order given by the caller
+)
+
+// MarkdownGrouping selects how a markdown report nests issues
+type MarkdownGrouping string
+
+const (
+	MarkdownGroupNone MarkdownGrouping = "none" // one flat list
+	MarkdownGroupEpic MarkdownGrouping = "epic" // an H2 section per epic, with progress
+)
+
+// ParseMarkdownGrouping validates a grouping name ("" means none)
+func ParseMarkdownGrouping(s string) (MarkdownGrouping, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "none", "flat":
+		return MarkdownGroupNone, nil
+	case "epic", "epics":
+		return MarkdownGroupEpic, nil
+	default:
+		return "", fmt.Errorf("unknown grouping %q (want none or epic)", s)
+	}
+}
+
+// MarkdownOptions selects which sections and issues a markdown report
+// contains, from a slim executive summary to a full dump.
+type MarkdownOptions struct {
+	Title                string
+	IncludeTOC           bool // table of contents with anchor links
+	IncludeMermaid       bool // Mermaid dependency graph
+	IncludeComments      bool // per-issue comment threads
+	IncludeClosed        bool // closed and tombstoned issues
+	IncludeAnalysis      bool // Analysis appendix: PageRank, top blockers, at-risk, cycles, execution plan
+	MaxDescriptionLength int  // truncate descriptions to this many runes (0 = no limit)
+	SortOrder            MarkdownSortOrder
+	GroupBy              MarkdownGrouping  // nest issues under their nearest epic ancestor
+	TemplatePath         string            // text/template file; empty uses DefaultMarkdownTemplate
+	Milestone            time.Time         // adds a Deadline Risk burn-up section when set
+	BurnUpChartPath      string            // chart image linked from the Deadline Risk section
+	ASCII                bool              // bracketed tags ([OPEN], [BUG], [P0]) instead of emoji, for plain-text renderers
+	SlugStyle            MarkdownSlugStyle // anchor format of the target platform: github (default), gitlab or azure
+	SplitEvery           int               // SaveMarkdownToFileWithOptions: at most this many issues per part file (0 = one file)
+	Locale               string            // language of headings, labels and status names: en (default), de or ja
+
+	// RiskWeights weights the "Most Likely to Slip" analysis table; the zero
+	// value uses analysis.DefaultRiskScoreWeights
+	RiskWeights analysis.RiskScoreWeights
+}
+
+// DefaultMarkdownOptions returns the full report used by SaveMarkdownToFile
+func DefaultMarkdownOptions() MarkdownOptions {
+	return MarkdownOptions{
+		Title:           "Beads Export",
+		IncludeTOC:      true,
+		IncludeMermaid:  true,
+		IncludeComments: true,
+		IncludeClosed:   true,
+		SortOrder:       MarkdownSortStatus,
+		GroupBy:         MarkdownGroupNone,
+	}
+}
+
+// ParseMarkdownSortOrder validates a sort order name ("" means status)
+func ParseMarkdownSortOrder(s string) (MarkdownSortOrder, error) {
+	switch order := MarkdownSortOrder(strings.ToLower(strings.TrimSpace(s))); order {
+	case "":
+		return MarkdownSortStatus, nil
+	case MarkdownSortStatus, MarkdownSortPriority, MarkdownSortID, MarkdownSortUpdated, MarkdownSortNone:
+		return order, nil
+	default:
+		return "", fmt.Errorf("unknown sort order %q (want status, priority, id, updated or none)", s)
+	}
+}
+
+// GenerateMarkdown creates a comprehensive markdown report of all issues in
+// the order given, rendered from DefaultMarkdownTemplate
 func GenerateMarkdown(issues []model.Issue, title string) (string, error) {
-	var sb strings.Builder
+	opts := DefaultMarkdownOptions()
+	opts.Title = title
+	opts.SortOrder = MarkdownSortNone
+	return GenerateMarkdownWithOptions(issues, opts)
+}
 
-	// Header
-	sb.WriteString(fmt.Sprintf("# %s\n\n", title))
-	sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", time.Now().Format(time.RFC1123)))
+// GenerateMarkdownWithOptions creates a markdown report with the sections,
+// issues and layout selected by opts
+func GenerateMarkdownWithOptions(issues []model.Issue, opts MarkdownOptions) (string, error) {
+	tmpl, err := markdownTemplateFor(opts)
+	if err != nil {
+		return "", err
+	}
+	return RenderMarkdownTemplate(tmpl, NewReportData(issues, opts))
+}
 
-	// Summary Statistics
-	sb.WriteString("## Summary\n\n")
+// markdownTemplateFor returns opts.TemplatePath parsed, or the default template
+func markdownTemplateFor(opts MarkdownOptions) (*template.Template, error) {
+	if opts.TemplatePath == "" {
+		return parsedDefaultMarkdownTemplate()
+	}
+	text, err := os.ReadFile(opts.TemplatePath)
+	if err != nil {
+		return nil, fmt.Errorf("read markdown template: %w", err)
+	}
+	return ParseMarkdownTemplate(opts.TemplatePath, string(text))
+}
 
-	open, inProgress, blocked, closed := 0, 0, 0, 0
+// countByStatus buckets issues into the four report categories. Closed-like
+// statuses count as closed; unrecognized statuses count as open.
+func countByStatus(issues []model.Issue) (open, inProgress, blocked, closed int) {
 	for _, i := range issues {
 		if isClosedLikeStatus(i.Status) {
 			closed++
@@ -96,6 +201,12 @@ func GenerateMarkdown(issues []model.Issue, title string) (string, error) {
 			open++
 		}
 	}
+	return open, inProgress, blocked, closed
+}
+
+// writeStatusSummaryTable writes the status count table used in report headers.
+func writeStatusSummaryTable(sb *strings.Builder, issues []model.Issue) {
+	open, inProgress, blocked, closed := countByStatus(issues)
 
 	sb.WriteString("| Metric | Count |\n|--------|-------|\n")
 	sb.WriteString(fmt.Sprintf("| **Total** | %d |\n", len(issues)))
@@ -103,132 +214,65 @@ func GenerateMarkdown(issues []model.Issue, title string) (string, error) {
 	sb.WriteString(fmt.Sprintf("| In Progress | %d |\n", inProgress))
 	sb.WriteString(fmt.Sprintf("| Blocked | %d |\n", blocked))
 	sb.WriteString(fmt.Sprintf("| Closed | %d |\n\n", closed))
+}
 
-	// Quick Actions Section
-	sb.WriteString(generateQuickActions(issues))
-
-	// Precompute stable, unique slugs for TOC anchors and headings.
-	slugCounts := make(map[string]int, len(issues))
-	issueSlugs := make([]string, len(issues))
-	for idx, i := range issues {
-		base := createSlug(issueHeadingText(i))
-		issueSlugs[idx] = uniqueSlug(base, slugCounts)
+// writeIssueMetadataTable writes the property/value table for an issue.
+func writeIssueMetadataTable(sb *strings.Builder, i model.Issue) {
+	typeIcon := getTypeEmoji(string(i.IssueType))
+	sb.WriteString("| Property | Value |\n|----------|-------|\n")
+	sb.WriteString(fmt.Sprintf("| **Type** | %s %s |\n", typeIcon, i.IssueType))
+	sb.WriteString(fmt.Sprintf("| **Priority** | %s |\n", getPriorityLabel(i.Priority)))
+	sb.WriteString(fmt.Sprintf("| **Status** | %s %s |\n", getStatusEmoji(string(i.Status)), i.Status))
+	if i.Assignee != "" {
+		// Sanitize assignee: replace newlines with spaces, escape pipes
+		cleanAssignee := strings.ReplaceAll(i.Assignee, "\n", " ")
+		cleanAssignee = strings.ReplaceAll(cleanAssignee, "\r", "")
+		escapedAssignee := strings.ReplaceAll(cleanAssignee, "|", "\\|")
+		sb.WriteString(fmt.Sprintf("| **Assignee** | @%s |\n", escapedAssignee))
+	}
+	if logged := formatLogged(i.LoggedTime()); logged != "" {
+		sb.WriteString(fmt.Sprintf("| **Logged** | %s |\n", logged))
+	}
+	sb.WriteString(fmt.Sprintf("| **Created** | %s |\n", i.CreatedAt.Format("2006-01-02 15:04")))
+	sb.WriteString(fmt.Sprintf("| **Updated** | %s |\n", i.UpdatedAt.Format("2006-01-02 15:04")))
+	if i.ClosedAt != nil {
+		sb.WriteString(fmt.Sprintf("| **Closed** | %s |\n", i.ClosedAt.Format("2006-01-02 15:04")))
+	}
+	if len(i.Labels) > 0 {
+		// Escape pipe characters and sanitize newlines in labels
+		escapedLabels := make([]string, len(i.Labels))
+		for idx, label := range i.Labels {
+			cleanLabel := strings.ReplaceAll(label, "\n", " ")
+			cleanLabel = strings.ReplaceAll(cleanLabel, "\r", "")
+			escapedLabels[idx] = strings.ReplaceAll(cleanLabel, "|", "\\|")
+		}
+		sb.WriteString(fmt.Sprintf("| **Labels** | %s |\n", strings.Join(escapedLabels, ", ")))
 	}
+	sb.WriteString("\n")
+}
 
-	// Table of Contents
-	sb.WriteString("## Table of Contents\n\n")
-	for idx, i := range issues {
-		slug := issueSlugs[idx]
-		statusIcon := getStatusEmoji(string(i.Status))
-		sb.WriteString(fmt.Sprintf("- [%s %s %s](#%s)\n", statusIcon, i.ID, i.Title, slug))
+// writeIssueTextSections writes the free-text sections (description,
+// acceptance criteria, design, notes) that are present on an issue.
+func writeIssueTextSections(sb *strings.Builder, i model.Issue) {
+	if i.Description != "" {
+		sb.WriteString("### Description\n\n")
+		sb.WriteString(i.Description + "\n\n")
 	}
-	sb.WriteString("\n---\n\n")
 
-	// Dependency Graph (Mermaid)
-	sb.WriteString("## Dependency Graph\n\n")
-	sb.WriteString("```mermaid\n")
-
-	issueIDs := make(map[string]bool)
-	for _, i := range issues {
-		issueIDs[i.ID] = true
+	if i.AcceptanceCriteria != "" {
+		sb.WriteString("### Acceptance Criteria\n\n")
+		sb.WriteString(i.AcceptanceCriteria + "\n\n")
 	}
 
-	graph := GenerateMermaidGraph(issues, issueIDs, MermaidConfig{ShowNoDependenciesNode: true})
-	sb.WriteString(graph)
-
-	sb.WriteString("```\n\n")
-	sb.WriteString("---\n\n")
-
-	// Individual Issues
-	for idx, i := range issues {
-		typeIcon := getTypeEmoji(string(i.IssueType))
-		slug := issueSlugs[idx]
-		sb.WriteString(fmt.Sprintf("<a id=\"%s\"></a>\n\n", slug))
-		sb.WriteString(fmt.Sprintf("## %s\n\n", issueHeadingText(i)))
-
-		// Metadata Table
-		sb.WriteString("| Property | Value |\n|----------|-------|\n")
-		sb.WriteString(fmt.Sprintf("| **Type** | %s %s |\n", typeIcon, i.IssueType))
-		sb.WriteString(fmt.Sprintf("| **Priority** | %s |\n", getPriorityLabel(i.Priority)))
-		sb.WriteString(fmt.Sprintf("| **Status** | %s %s |\n", getStatusEmoji(string(i.Status)), i.Status))
-		if i.Assignee != "" {
-			// Sanitize assignee: replace newlines with spaces, escape pipes
-			cleanAssignee := strings.ReplaceAll(i.Assignee, "\n", " ")
-			cleanAssignee = strings.ReplaceAll(cleanAssignee, "\r", "")
-			escapedAssignee := strings.ReplaceAll(cleanAssignee, "|", "\\|")
-			sb.WriteString(fmt.Sprintf("| **Assignee** | @%s |\n", escapedAssignee))
-		}
-		sb.WriteString(fmt.Sprintf("| **Created** | %s |\n", i.CreatedAt.Format("2006-01-02 15:04")))
-		sb.WriteString(fmt.Sprintf("| **Updated** | %s |\n", i.UpdatedAt.Format("2006-01-02 15:04")))
-		if i.ClosedAt != nil {
-			sb.WriteString(fmt.Sprintf("| **Closed** | %s |\n", i.ClosedAt.Format("2006-01-02 15:04")))
-		}
-		if len(i.Labels) > 0 {
-			// Escape pipe characters and sanitize newlines in labels
-			escapedLabels := make([]string, len(i.Labels))
-			for idx, label := range i.Labels {
-				cleanLabel := strings.ReplaceAll(label, "\n", " ")
-				cleanLabel = strings.ReplaceAll(cleanLabel, "\r", "")
-				escapedLabels[idx] = strings.ReplaceAll(cleanLabel, "|", "\\|")
-			}
-			sb.WriteString(fmt.Sprintf("| **Labels** | %s |\n", strings.Join(escapedLabels, ", ")))
-		}
-		sb.WriteString("\n")
-
-		if i.Description != "" {
-			sb.WriteString("### Description\n\n")
-			sb.WriteString(i.Description + "\n\n")
-		}
-
-		if i.AcceptanceCriteria != "" {
-			sb.WriteString("### Acceptance Criteria\n\n")
-			sb.WriteString(i.AcceptanceCriteria + "\n\n")
-		}
-
-		if i.Design != "" {
-			sb.WriteString("### Design\n\n")
-			sb.WriteString(i.Design + "\n\n")
-		}
-
-		if i.Notes != "" {
-			sb.WriteString("### Notes\n\n")
-			sb.WriteString(i.Notes + "\n\n")
-		}
-
-		if len(i.Dependencies) > 0 {
-			sb.WriteString("### Dependencies\n\n")
-			for _, dep := range i.Dependencies {
-				if dep == nil {
-					continue
-				}
-				icon := "🔗"
-				if dep.Type == model.DepBlocks {
-					icon = "⛔"
-				}
-				sb.WriteString(fmt.Sprintf("- %s **%s**: `%s`\n", icon, dep.Type, dep.DependsOnID))
-			}
-			sb.WriteString("\n")
-		}
-
-		if len(i.Comments) > 0 {
-			sb.WriteString("### Comments\n\n")
-			for _, c := range i.Comments {
-				if c == nil {
-					continue
-				}
-				escapedText := strings.ReplaceAll(c.Text, "\n", "\n> ")
-				sb.WriteString(fmt.Sprintf("> **%s** (%s)\n>\n> %s\n\n",
-					c.Author, c.CreatedAt.Format("2006-01-02"), escapedText))
-			}
-		}
-
-		// Per-issue command snippets
-		sb.WriteString(generateIssueCommands(i))
-
-		sb.WriteString("---\n\n")
+	if i.Design != "" {
+		sb.WriteString("### Design\n\n")
+		sb.WriteString(i.Design + "\n\n")
 	}
 
-	return sb.String(), nil
+	if i.Notes != "" {
+		sb.WriteString("### Notes\n\n")
+		sb.WriteString(i.Notes + "\n\n")
+	}
 }
 
 func issueHeadingText(i model.Issue) string {
@@ -311,34 +355,77 @@ func getPriorityLabel(priority int) string {
 	}
 }
 
-// SaveMarkdownToFile writes the generated markdown to a file
+// SaveMarkdownToFile writes the full markdown report to a file
 func SaveMarkdownToFile(issues []model.Issue, filename string) error {
-	// Make a copy to avoid mutating the caller's slice
-	issuesCopy := make([]model.Issue, len(issues))
-	copy(issuesCopy, issues)
-
-	// Sort issues for the report: Open first, then priority, then date
-	sort.Slice(issuesCopy, func(i, j int) bool {
-		iClosed := isClosedLikeStatus(issuesCopy[i].Status)
-		jClosed := isClosedLikeStatus(issuesCopy[j].Status)
-		if iClosed != jClosed {
-			return !iClosed
-		}
-		if issuesCopy[i].Priority != issuesCopy[j].Priority {
-			return issuesCopy[i].Priority < issuesCopy[j].Priority
-		}
-		return issuesCopy[i].CreatedAt.After(issuesCopy[j].CreatedAt)
-	})
+	return SaveMarkdownToFileWithOptions(issues, filename, DefaultMarkdownOptions())
+}
 
-	content, err := GenerateMarkdown(issuesCopy, "Beads Export")
+// SaveMarkdownToFileWithOptions writes a markdown report shaped by opts to a
+// file. With opts.SplitEvery set and more issues than that, filename becomes
+// an index and the issues go to numbered part files beside it.
+func SaveMarkdownToFileWithOptions(issues []model.Issue, filename string, opts MarkdownOptions) error {
+	if opts.SplitEvery > 0 {
+		return saveSplitMarkdown(issues, filename, opts)
+	}
+	content, err := GenerateMarkdownWithOptions(issues, opts)
 	if err != nil {
 		return err
 	}
 	return os.WriteFile(filename, []byte(content), 0644)
 }
 
+// selectIssuesForReport returns a filtered, sorted copy of issues with
+// descriptions truncated per opts. The caller's slice is not mutated.
+func selectIssuesForReport(issues []model.Issue, opts MarkdownOptions) []model.Issue {
+	selected := make([]model.Issue, 0, len(issues))
+	for _, i := range issues {
+		if !opts.IncludeClosed && isClosedLikeStatus(i.Status) {
+			continue
+		}
+		if opts.MaxDescriptionLength > 0 {
+			i.Description = truncateRunes(i.Description, opts.MaxDescriptionLength)
+		}
+		selected = append(selected, i)
+	}
+
+	var less func(a, b model.Issue) bool
+	switch opts.SortOrder {
+	case MarkdownSortNone:
+		return selected
+	case MarkdownSortPriority:
+		less = func(a, b model.Issue) bool {
+			if a.Priority != b.Priority {
+				return a.Priority < b.Priority
+			}
+			return a.ID < b.ID
+		}
+	case MarkdownSortID:
+		less = func(a, b model.Issue) bool { return a.ID < b.ID }
+	case MarkdownSortUpdated:
+		less = func(a, b model.Issue) bool {
+			if !a.UpdatedAt.Equal(b.UpdatedAt) {
+				return a.UpdatedAt.After(b.UpdatedAt)
+			}
+			return a.ID < b.ID
+		}
+	default: // MarkdownSortStatus
+		less = func(a, b model.Issue) bool {
+			aClosed, bClosed := isClosedLikeStatus(a.Status), isClosedLikeStatus(b.Status)
+			if aClosed != bClosed {
+				return !aClosed
+			}
+			if a.Priority != b.Priority {
+				return a.Priority < b.Priority
+			}
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool { return less(selected[i], selected[j]) })
+	return selected
+}
+
 // generateQuickActions creates a Quick Actions section with bulk commands
-func generateQuickActions(issues []model.Issue) string {
+func generateQuickActions(issues []model.Issue, locale *reportLocale) string {
 	var sb strings.Builder
 
 	// Collect non-closed issues for bulk operations
@@ -365,34 +452,34 @@ func generateQuickActions(issues []model.Issue) string {
 		return ""
 	}
 
-	sb.WriteString("## Quick Actions\n\n")
-	sb.WriteString("Ready-to-run commands for bulk operations:\n\n")
+	sb.WriteString("## " + locale.T("quick.heading") + "\n\n")
+	sb.WriteString(locale.T("quick.intro") + "\n\n")
 	sb.WriteString("```bash\n")
 
 	// Close in-progress items (most common action)
 	if len(inProgressIDs) > 0 {
-		sb.WriteString("# Close all in-progress items\n")
+		sb.WriteString("# " + locale.T("quick.close_in_progress") + "\n")
 		sb.WriteString(fmt.Sprintf("bd close %s\n\n", strings.Join(inProgressIDs, " ")))
 	}
 
 	// Close open items
 	if len(openIDs) > 0 && len(openIDs) <= 10 {
-		sb.WriteString("# Close all open items\n")
+		sb.WriteString("# " + locale.T("quick.close_open") + "\n")
 		sb.WriteString(fmt.Sprintf("bd close %s\n\n", strings.Join(openIDs, " ")))
 	} else if len(openIDs) > 10 {
-		sb.WriteString(fmt.Sprintf("# Close open items (%d total, showing first 10)\n", len(openIDs)))
+		sb.WriteString("# " + locale.Tf("quick.close_open_first", len(openIDs)) + "\n")
 		sb.WriteString(fmt.Sprintf("bd close %s\n\n", strings.Join(openIDs[:10], " ")))
 	}
 
 	// Bulk priority update for high-priority items
 	if len(highPriorityIDs) > 0 {
-		sb.WriteString("# View high-priority items (P0/P1)\n")
+		sb.WriteString("# " + locale.T("quick.view_high") + "\n")
 		sb.WriteString(fmt.Sprintf("bd show %s\n\n", strings.Join(highPriorityIDs, " ")))
 	}
 
 	// Unblock blocked items
 	if len(blockedIDs) > 0 {
-		sb.WriteString("# Update blocked items to in_progress when unblocked\n")
+		sb.WriteString("# " + locale.T("quick.unblock") + "\n")
 		sb.WriteString(fmt.Sprintf("bd update %s -s in_progress\n", strings.Join(blockedIDs, " ")))
 	}
 
@@ -402,7 +489,7 @@ func generateQuickActions(issues []model.Issue) string {
 }
 
 // generateIssueCommands creates command snippets for a single issue
-func generateIssueCommands(issue model.Issue) string {
+func generateIssueCommands(issue model.Issue, locale *reportLocale) string {
 	var sb strings.Builder
 
 	// Skip command snippets for closed issues
@@ -412,30 +499,30 @@ func generateIssueCommands(issue model.Issue) string {
 
 	escapedID := shellEscape(issue.ID)
 
-	sb.WriteString("<details>\n<summary>📋 Commands</summary>\n\n")
+	sb.WriteString("<details>\n<summary>📋 " + locale.T("cmd.summary") + "</summary>\n\n")
 	sb.WriteString("```bash\n")
 
 	// Status transitions based on current state
 	switch issue.Status {
 	case model.StatusOpen:
-		sb.WriteString("# Start working on this issue\n")
+		sb.WriteString("# " + locale.T("cmd.start") + "\n")
 		sb.WriteString(fmt.Sprintf("bd update %s -s in_progress\n\n", escapedID))
 	case model.StatusInProgress:
-		sb.WriteString("# Mark as complete\n")
+		sb.WriteString("# " + locale.T("cmd.complete") + "\n")
 		sb.WriteString(fmt.Sprintf("bd close %s\n\n", escapedID))
 	case model.StatusBlocked:
-		sb.WriteString("# Unblock and start working\n")
+		sb.WriteString("# " + locale.T("cmd.unblock") + "\n")
 		sb.WriteString(fmt.Sprintf("bd update %s -s in_progress\n\n", escapedID))
 	}
 
 	// Common actions
-	sb.WriteString("# Add a comment\n")
-	sb.WriteString(fmt.Sprintf("bd comment %s 'Your comment here'\n\n", escapedID))
+	sb.WriteString("# " + locale.T("cmd.comment") + "\n")
+	sb.WriteString(fmt.Sprintf("bd comment %s %s\n\n", escapedID, shellEscape(locale.T("cmd.comment_arg"))))
 
-	sb.WriteString("# Change priority (0=Critical, 1=High, 2=Medium, 3=Low)\n")
+	sb.WriteString("# " + locale.T("cmd.priority") + "\n")
 	sb.WriteString(fmt.Sprintf("bd update %s -p 1\n\n", escapedID))
 
-	sb.WriteString("# View full details\n")
+	sb.WriteString("# " + locale.T("cmd.show") + "\n")
 	sb.WriteString(fmt.Sprintf("bd show %s\n", escapedID))
 
 	sb.WriteString("```\n\n")
@@ -0,0 +1,113 @@
+package export
+
+import (
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// getStatusTag is the MarkdownOptions.ASCII counterpart of getStatusEmoji
+func getStatusTag(status string) string {
+	if status == "" {
+		return "[UNKNOWN]"
+	}
+	return "[" + strings.ToUpper(status) + "]"
+}
+
+// getTypeTag is the MarkdownOptions.ASCII counterpart of getTypeEmoji
+func getTypeTag(issueType string) string {
+	if issueType == "" {
+		return "[ISSUE]"
+	}
+	return "[" + strings.ToUpper(issueType) + "]"
+}
+
+// getPriorityTag is the MarkdownOptions.ASCII counterpart of getPriorityLabel
+func getPriorityTag(priority int) string {
+	return priorityTagFromLabel(getPriorityLabel(priority))
+}
+
+// priorityTagFromLabel turns "🔥 Critical (P0)" into "[P0] Critical"
+func priorityTagFromLabel(label string) string {
+	i := strings.Index(label, " (")
+	if i < 0 {
+		return label
+	}
+	name := label[:i]
+	if icon, rest, ok := strings.Cut(name, " "); ok && strings.IndexFunc(icon, func(r rune) bool { return r <= unicode.MaxASCII }) < 0 {
+		name = rest
+	}
+	return "[" + strings.Trim(label[i+2:], ")") + "] " + strings.TrimSpace(name)
+}
+
+// burnUpRiskTag is the ASCII form of the Deadline Risk traffic light
+func burnUpRiskTag(risk string) string {
+	return "[" + strings.ToUpper(risk) + "]"
+}
+
+// asciiTemplateFuncs replace the emoji helpers when MarkdownOptions.ASCII is set
+var asciiTemplateFuncs = template.FuncMap{
+	"statusEmoji":   func(s model.Status) string { return getStatusTag(string(s)) },
+	"typeEmoji":     func(t model.IssueType) string { return getTypeTag(string(t)) },
+	"priorityLabel": getPriorityTag,
+	"depEmoji": func(t model.DependencyType) string {
+		if t == model.DepBlocks {
+			return "[BLOCKER]"
+		}
+		return "[LINK]"
+	},
+}
+
+// markdownASCIIGlyphs maps the report's decorative symbols to plain text. Status,
+// type and priority emoji never reach it: the tag helpers handle those.
+var markdownASCIIGlyphs = strings.NewReplacer(
+	"⚠️", "[!]",
+	"⚠", "[!]",
+	"🏁", "[MILESTONE]",
+	"→", "->",
+	"←", "<-",
+	"—", "--",
+	"…", "...",
+	"█", "#",
+	"░", "-",
+)
+
+// toASCIIMarkdown finishes an ASCII report: known glyphs become plain text,
+// and any emoji left over (from a custom template or issue text) is dropped
+// along with the space after it, so "## 📊 Analysis" reads "## Analysis".
+func toASCIIMarkdown(s string) string {
+	s = markdownASCIIGlyphs.Replace(s)
+	var sb strings.Builder
+	sb.Grow(len(s))
+	dropSpace := false
+	for _, r := range s {
+		if isEmojiRune(r) {
+			dropSpace = true
+			continue
+		}
+		if dropSpace && r == ' ' {
+			dropSpace = false
+			continue
+		}
+		dropSpace = false
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// isEmojiRune reports pictographs, dingbats and the joiners that combine them
+func isEmojiRune(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // pictographs, emoticons, transport, symbols
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // misc symbols and dingbats (⚡ ☕ ✨ ⛔ ✅)
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // arrows and shapes (⬆ ⬇ ⭐)
+		return true
+	case r == 0xFE0F || r == 0x200D || r == 0x20E3: // variation selector, ZWJ, keycap
+		return true
+	}
+	return false
+}
@@ -0,0 +1,534 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// reportMessages holds the English strings of the markdown report; every
+// other bundle translates these keys and falls back to them when a key is
+// missing. status.* and type.* keys are deliberately absent from English:
+// there the raw field value ("in_progress", "bug") is shown, as it always was.
+var reportMessages = map[string]map[string]string{
+	"en": {
+		"report.generated": "Generated",
+		"format.timestamp": "Mon, 02 Jan 2006 15:04:05 MST",
+
+		"section.summary":  "Summary",
+		"section.parts":    "Parts",
+		"section.toc":      "Table of Contents",
+		"section.graph":    "Dependency Graph",
+		"section.analysis": "Analysis",
+
+		"col.metric":   "Metric",
+		"col.count":    "Count",
+		"col.property": "Property",
+		"col.value":    "Value",
+		"col.id":       "ID",
+		"col.title":    "Title",
+		"col.date":     "Date",
+		"col.scope":    "Scope",
+
+		"stat.total":       "Total",
+		"stat.open":        "Open",
+		"stat.in_progress": "In Progress",
+		"stat.blocked":     "Blocked",
+		"stat.closed":      "Closed",
+
+		"field.type":                "Type",
+		"field.priority":            "Priority",
+		"field.status":              "Status",
+		"field.assignee":            "Assignee",
+		"field.logged":              "Logged",
+		"field.created":             "Created",
+		"field.updated":             "Updated",
+		"field.closed":              "Closed",
+		"field.labels":              "Labels",
+		"field.description":         "Description",
+		"field.acceptance_criteria": "Acceptance Criteria",
+		"field.design":              "Design",
+		"field.notes":               "Notes",
+		"field.dependencies":        "Dependencies",
+		"field.comments":            "Comments",
+
+		"priority.0": "Critical",
+		"priority.1": "High",
+		"priority.2": "Medium",
+		"priority.3": "Low",
+		"priority.4": "Backlog",
+
+		"part":           "Part",
+		"part.title":     "%s (Part %d of %d)",
+		"part.range":     "`%s` to `%s`",
+		"nav.index":      "Index",
+		"issues.one":     "%d issue",
+		"issues.other":   "%d issues",
+		"toc.closed":     "%d/%d closed",
+		"group.no_epic":  "No Epic",
+		"group.progress": "%d/%d closed (%d%%)",
+		"group.empty":    "no child issues",
+		"group.orphans":  "%d issues without an epic",
+
+		"notice.graph_omitted": "Dependency graph omitted: %s.",
+
+		"analysis.pagerank":            "Top PageRank",
+		"analysis.pagerank_col":        "PageRank",
+		"analysis.no_rank":             "No dependency graph to rank.",
+		"analysis.blockers":            "Top Blockers",
+		"analysis.direct_unblocks":     "Direct Unblocks",
+		"analysis.transitive_unblocks": "Transitive Unblocks",
+		"analysis.no_blockers":         "No open issue blocks other work.",
+		"analysis.at_risk":             "Most At-Risk",
+		"analysis.staleness_col":       "Staleness",
+		"analysis.no_at_risk":          "No open issue is going stale.",
+		"analysis.slip":                "Most Likely to Slip",
+		"analysis.risk_col":            "Risk",
+		"analysis.driver_col":          "Main Factor",
+		"analysis.no_slip":             "No open issues.",
+		"analysis.forecast":            "Milestone Forecast",
+		"analysis.remaining_col":       "Remaining",
+		"analysis.expected_col":        "Expected",
+		"analysis.range_col":           "%d%% Range",
+		"analysis.forecast_unknown":    "not enough recent throughput",
+		"analysis.no_forecast":         "No open epics.",
+		"risk.blocker_depth":           "blocker depth",
+		"risk.staleness":               "staleness",
+		"risk.priority":                "priority",
+		"risk.bus_factor":              "bus factor",
+		"analysis.cycles":              "Dependency Cycles",
+		"analysis.no_cycles":           "No dependency cycles detected.",
+		"analysis.critical_path":       "Critical Path",
+		"analysis.no_critical_path":    "No open issue is blocked by another open issue.",
+		"analysis.plan":                "Execution Plan",
+		"plan.counts":                  "%d actionable, %d blocked.",
+		"plan.start":                   "Start with",
+		"plan.unblocks":                "unblocks %d",
+
+		"quick.heading":           "Quick Actions",
+		"quick.intro":             "Ready-to-run commands for bulk operations:",
+		"quick.close_in_progress": "Close all in-progress items",
+		"quick.close_open":        "Close all open items",
+		"quick.close_open_first":  "Close open items (%d total, showing first 10)",
+		"quick.view_high":         "View high-priority items (P0/P1)",
+		"quick.unblock":           "Update blocked items to in_progress when unblocked",
+
+		"cmd.summary":     "Commands",
+		"cmd.start":       "Start working on this issue",
+		"cmd.complete":    "Mark as complete",
+		"cmd.unblock":     "Unblock and start working",
+		"cmd.comment":     "Add a comment",
+		"cmd.comment_arg": "Your comment here",
+		"cmd.priority":    "Change priority (0=Critical, 1=High, 2=Medium, 3=Low)",
+		"cmd.show":        "View full details",
+
+		"burnup.heading":     "Deadline Risk",
+		"burnup.chart":       "Burn-up chart",
+		"burnup.milestone":   "Milestone",
+		"burnup.scope":       "Scope",
+		"burnup.scope_value": "`%s` and its blockers",
+		"burnup.progress":    "Progress",
+		"burnup.done":        "%d / %d done",
+		"burnup.throughput":  "Throughput",
+		"burnup.per_day":     "%.1f issues/day",
+		"burnup.on_time":     "On-time chance",
+		"burnup.risk":        "%s risk",
+		"burnup.p50":         "P50 finish",
+		"burnup.p85":         "P85 finish",
+		"burnup.no_forecast": "No issues closed in the sampling window, so there is no forecast.",
+	},
+	"de": {
+		"report.generated": "Erstellt",
+		"format.timestamp": "02.01.2006 15:04:05 MST",
+
+		"section.summary":  "Zusammenfassung",
+		"section.parts":    "Teile",
+		"section.toc":      "Inhaltsverzeichnis",
+		"section.graph":    "Abhängigkeitsgraph",
+		"section.analysis": "Analyse",
+
+		"col.metric":   "Kennzahl",
+		"col.count":    "Anzahl",
+		"col.property": "Eigenschaft",
+		"col.value":    "Wert",
+		"col.id":       "ID",
+		"col.title":    "Titel",
+		"col.date":     "Datum",
+		"col.scope":    "Umfang",
+
+		"stat.total":       "Gesamt",
+		"stat.open":        "Offen",
+		"stat.in_progress": "In Arbeit",
+		"stat.blocked":     "Blockiert",
+		"stat.closed":      "Geschlossen",
+
+		"status.open":        "offen",
+		"status.in_progress": "in Arbeit",
+		"status.blocked":     "blockiert",
+		"status.deferred":    "zurückgestellt",
+		"status.pinned":      "angeheftet",
+		"status.hooked":      "zugewiesen (Hook)",
+		"status.review":      "im Review",
+		"status.closed":      "geschlossen",
+		"status.tombstone":   "gelöscht",
+
+		"type.bug":     "Fehler",
+		"type.feature": "Funktion",
+		"type.task":    "Aufgabe",
+		"type.epic":    "Epic",
+		"type.chore":   "Wartung",
+
+		"field.type":                "Typ",
+		"field.priority":            "Priorität",
+		"field.status":              "Status",
+		"field.assignee":            "Zuständig",
+		"field.logged":              "Erfasst",
+		"field.created":             "Erstellt",
+		"field.updated":             "Aktualisiert",
+		"field.closed":              "Geschlossen",
+		"field.labels":              "Labels",
+		"field.description":         "Beschreibung",
+		"field.acceptance_criteria": "Akzeptanzkriterien",
+		"field.design":              "Entwurf",
+		"field.notes":               "Notizen",
+		"field.dependencies":        "Abhängigkeiten",
+		"field.comments":            "Kommentare",
+
+		"priority.0": "Kritisch",
+		"priority.1": "Hoch",
+		"priority.2": "Mittel",
+		"priority.3": "Niedrig",
+		"priority.4": "Backlog",
+
+		"part":           "Teil",
+		"part.title":     "%s (Teil %d von %d)",
+		"part.range":     "`%s` bis `%s`",
+		"nav.index":      "Übersicht",
+		"issues.one":     "%d Issue",
+		"issues.other":   "%d Issues",
+		"toc.closed":     "%d/%d geschlossen",
+		"group.no_epic":  "Ohne Epic",
+		"group.progress": "%d/%d geschlossen (%d %%)",
+		"group.empty":    "keine untergeordneten Issues",
+		"group.orphans":  "%d Issues ohne Epic",
+
+		"notice.graph_omitted": "Abhängigkeitsgraph ausgelassen: %s.",
+
+		"analysis.pagerank":            "Top-PageRank",
+		"analysis.pagerank_col":        "PageRank",
+		"analysis.no_rank":             "Kein Abhängigkeitsgraph zum Bewerten.",
+		"analysis.blockers":            "Größte Blocker",
+		"analysis.direct_unblocks":     "Direkt freigegeben",
+		"analysis.transitive_unblocks": "Transitiv freigegeben",
+		"analysis.no_blockers":         "Kein offenes Issue blockiert andere Arbeit.",
+		"analysis.at_risk":             "Am stärksten gefährdet",
+		"analysis.staleness_col":       "Veraltung",
+		"analysis.no_at_risk":          "Kein offenes Issue veraltet.",
+		"analysis.slip":                "Am ehesten verzögert",
+		"analysis.risk_col":            "Risiko",
+		"analysis.driver_col":          "Hauptfaktor",
+		"analysis.no_slip":             "Keine offenen Issues.",
+		"analysis.forecast":            "Meilenstein-Prognose",
+		"analysis.remaining_col":       "Offen",
+		"analysis.expected_col":        "Erwartet",
+		"analysis.range_col":           "%d%%-Bereich",
+		"analysis.forecast_unknown":    "zu wenig aktueller Durchsatz",
+		"analysis.no_forecast":         "Keine offenen Epics.",
+		"risk.blocker_depth":           "Blockiertiefe",
+		"risk.staleness":               "Veraltung",
+		"risk.priority":                "Priorität",
+		"risk.bus_factor":              "Busfaktor",
+		"analysis.cycles":              "Abhängigkeitszyklen",
+		"analysis.no_cycles":           "Keine Abhängigkeitszyklen gefunden.",
+		"analysis.critical_path":       "Kritischer Pfad",
+		"analysis.no_critical_path":    "Kein offenes Issue wird von einem anderen offenen Issue blockiert.",
+		"analysis.plan":                "Ausführungsplan",
+		"plan.counts":                  "%d bearbeitbar, %d blockiert.",
+		"plan.start":                   "Beginne mit",
+		"plan.unblocks":                "gibt %d frei",
+
+		"quick.heading":           "Schnellaktionen",
+		"quick.intro":             "Sofort ausführbare Befehle für Massenänderungen:",
+		"quick.close_in_progress": "Alle Issues in Arbeit schließen",
+		"quick.close_open":        "Alle offenen Issues schließen",
+		"quick.close_open_first":  "Offene Issues schließen (%d insgesamt, die ersten 10)",
+		"quick.view_high":         "Issues mit hoher Priorität anzeigen (P0/P1)",
+		"quick.unblock":           "Blockierte Issues nach der Freigabe auf in_progress setzen",
+
+		"cmd.summary":     "Befehle",
+		"cmd.start":       "Mit der Arbeit an diesem Issue beginnen",
+		"cmd.complete":    "Als erledigt markieren",
+		"cmd.unblock":     "Freigeben und mit der Arbeit beginnen",
+		"cmd.comment":     "Kommentar hinzufügen",
+		"cmd.comment_arg": "Ihr Kommentar",
+		"cmd.priority":    "Priorität ändern (0=Kritisch, 1=Hoch, 2=Mittel, 3=Niedrig)",
+		"cmd.show":        "Alle Details anzeigen",
+
+		"burnup.heading":     "Terminrisiko",
+		"burnup.chart":       "Burn-up-Diagramm",
+		"burnup.milestone":   "Meilenstein",
+		"burnup.scope":       "Umfang",
+		"burnup.scope_value": "`%s` und seine Blocker",
+		"burnup.progress":    "Fortschritt",
+		"burnup.done":        "%d / %d erledigt",
+		"burnup.throughput":  "Durchsatz",
+		"burnup.per_day":     "%.1f Issues/Tag",
+		"burnup.on_time":     "Chance auf Termintreue",
+		"burnup.risk":        "Risiko %s",
+		"burnup.p50":         "P50-Fertigstellung",
+		"burnup.p85":         "P85-Fertigstellung",
+		"burnup.no_forecast": "Im Stichprobenzeitraum wurden keine Issues geschlossen, daher gibt es keine Prognose.",
+
+		"risk.low":    "niedrig",
+		"risk.medium": "mittel",
+		"risk.high":   "hoch",
+	},
+	"ja": {
+		"report.generated": "生成日時",
+		"format.timestamp": "2006年01月02日 15:04:05 MST",
+
+		"section.summary":  "概要",
+		"section.parts":    "パート",
+		"section.toc":      "目次",
+		"section.graph":    "依存関係グラフ",
+		"section.analysis": "分析",
+
+		"col.metric":   "指標",
+		"col.count":    "件数",
+		"col.property": "項目",
+		"col.value":    "値",
+		"col.id":       "ID",
+		"col.title":    "タイトル",
+		"col.date":     "日付",
+		"col.scope":    "スコープ",
+
+		"stat.total":       "合計",
+		"stat.open":        "未着手",
+		"stat.in_progress": "進行中",
+		"stat.blocked":     "ブロック中",
+		"stat.closed":      "完了",
+
+		"status.open":        "未着手",
+		"status.in_progress": "進行中",
+		"status.blocked":     "ブロック中",
+		"status.deferred":    "保留",
+		"status.pinned":      "固定",
+		"status.hooked":      "フック済み",
+		"status.review":      "レビュー中",
+		"status.closed":      "完了",
+		"status.tombstone":   "削除済み",
+
+		"type.bug":     "バグ",
+		"type.feature": "機能",
+		"type.task":    "タスク",
+		"type.epic":    "エピック",
+		"type.chore":   "雑務",
+
+		"field.type":                "種別",
+		"field.priority":            "優先度",
+		"field.status":              "ステータス",
+		"field.assignee":            "担当者",
+		"field.logged":              "作業記録",
+		"field.created":             "作成日時",
+		"field.updated":             "更新日時",
+		"field.closed":              "完了日時",
+		"field.labels":              "ラベル",
+		"field.description":         "説明",
+		"field.acceptance_criteria": "受け入れ基準",
+		"field.design":              "設計",
+		"field.notes":               "メモ",
+		"field.dependencies":        "依存関係",
+		"field.comments":            "コメント",
+
+		"priority.0": "緊急",
+		"priority.1": "高",
+		"priority.2": "中",
+		"priority.3": "低",
+		"priority.4": "バックログ",
+
+		"part":           "パート",
+		"part.title":     "%s（パート %d / %d）",
+		"part.range":     "`%s` 〜 `%s`",
+		"nav.index":      "目次へ",
+		"issues.one":     "%d 件",
+		"issues.other":   "%d 件",
+		"toc.closed":     "%d/%d 完了",
+		"group.no_epic":  "エピックなし",
+		"group.progress": "%d/%d 完了 (%d%%)",
+		"group.empty":    "子課題なし",
+		"group.orphans":  "エピックに属さない課題 %d 件",
+
+		"notice.graph_omitted": "依存関係グラフを省略しました: %s。",
+
+		"analysis.pagerank":            "PageRank 上位",
+		"analysis.pagerank_col":        "PageRank",
+		"analysis.no_rank":             "評価対象の依存関係グラフがありません。",
+		"analysis.blockers":            "主要なブロッカー",
+		"analysis.direct_unblocks":     "直接解除数",
+		"analysis.transitive_unblocks": "連鎖解除数",
+		"analysis.no_blockers":         "他の作業をブロックしている未完了の課題はありません。",
+		"analysis.at_risk":             "放置リスクの高い課題",
+		"analysis.staleness_col":       "放置度",
+		"analysis.no_at_risk":          "放置されている未完了の課題はありません。",
+		"analysis.slip":                "遅延リスクの高い課題",
+		"analysis.risk_col":            "リスク",
+		"analysis.driver_col":          "主な要因",
+		"analysis.no_slip":             "未完了の課題はありません。",
+		"analysis.forecast":            "マイルストーン予測",
+		"analysis.remaining_col":       "残り",
+		"analysis.expected_col":        "予測日",
+		"analysis.range_col":           "%d%% 区間",
+		"analysis.forecast_unknown":    "直近のスループットが不足",
+		"analysis.no_forecast":         "未完了のエピックはありません。",
+		"risk.blocker_depth":           "ブロッカーの深さ",
+		"risk.staleness":               "放置度",
+		"risk.priority":                "優先度",
+		"risk.bus_factor":              "バス係数",
+		"analysis.cycles":              "依存関係の循環",
+		"analysis.no_cycles":           "依存関係の循環は検出されませんでした。",
+		"analysis.critical_path":       "クリティカルパス",
+		"analysis.no_critical_path":    "他の未完了の課題にブロックされている未完了の課題はありません。",
+		"analysis.plan":                "実行計画",
+		"plan.counts":                  "着手可能 %d 件、ブロック中 %d 件。",
+		"plan.start":                   "最初に着手:",
+		"plan.unblocks":                "%d 件を解除",
+
+		"quick.heading":           "クイックアクション",
+		"quick.intro":             "一括操作用のコマンド:",
+		"quick.close_in_progress": "進行中の課題をすべて完了にする",
+		"quick.close_open":        "未着手の課題をすべて完了にする",
+		"quick.close_open_first":  "未着手の課題を完了にする（全 %d 件中、先頭 10 件）",
+		"quick.view_high":         "優先度の高い課題を表示 (P0/P1)",
+		"quick.unblock":           "ブロック解除後に in_progress へ更新",
+
+		"cmd.summary":     "コマンド",
+		"cmd.start":       "この課題の作業を開始",
+		"cmd.complete":    "完了にする",
+		"cmd.unblock":     "ブロックを解除して作業を開始",
+		"cmd.comment":     "コメントを追加",
+		"cmd.comment_arg": "コメントを入力",
+		"cmd.priority":    "優先度を変更 (0=緊急, 1=高, 2=中, 3=低)",
+		"cmd.show":        "詳細を表示",
+
+		"burnup.heading":     "納期リスク",
+		"burnup.chart":       "バーンアップチャート",
+		"burnup.milestone":   "マイルストーン",
+		"burnup.scope":       "スコープ",
+		"burnup.scope_value": "`%s` とそのブロッカー",
+		"burnup.progress":    "進捗",
+		"burnup.done":        "%d / %d 完了",
+		"burnup.throughput":  "スループット",
+		"burnup.per_day":     "1 日あたり %.1f 件",
+		"burnup.on_time":     "期限内の達成確率",
+		"burnup.risk":        "リスク: %s",
+		"burnup.p50":         "P50 完了予測",
+		"burnup.p85":         "P85 完了予測",
+		"burnup.no_forecast": "サンプリング期間中に完了した課題がないため、予測はありません。",
+
+		"risk.low":    "低",
+		"risk.medium": "中",
+		"risk.high":   "高",
+	},
+}
+
+// ParseMarkdownLocale validates a report locale ("" means en). Region and
+// encoding suffixes are dropped, so "de_DE.UTF-8" and "ja-JP" are accepted.
+func ParseMarkdownLocale(s string) (string, error) {
+	code := strings.ToLower(strings.TrimSpace(s))
+	if i := strings.IndexAny(code, "-_."); i >= 0 {
+		code = code[:i]
+	}
+	switch code {
+	case "", "c", "posix":
+		return "en", nil
+	}
+	if _, ok := reportMessages[code]; !ok {
+		return "", fmt.Errorf("unsupported locale %q (want %s)", s, strings.Join(MarkdownLocales(), ", "))
+	}
+	return code, nil
+}
+
+// MarkdownLocales lists the report locales with a message bundle
+func MarkdownLocales() []string {
+	codes := make([]string, 0, len(reportMessages))
+	for code := range reportMessages {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// reportLocale translates report strings. A nil *reportLocale is English.
+type reportLocale struct {
+	msgs map[string]string
+}
+
+// newReportLocale returns the translator for a MarkdownOptions.Locale value;
+// nil (English) for "", "en" and anything without a bundle.
+func newReportLocale(locale string) *reportLocale {
+	code, err := ParseMarkdownLocale(locale)
+	if err != nil || code == "en" {
+		return nil
+	}
+	return &reportLocale{msgs: reportMessages[code]}
+}
+
+// T returns the message for key, falling back to English and then the key
+func (l *reportLocale) T(key string) string {
+	if l != nil {
+		if msg, ok := l.msgs[key]; ok {
+			return msg
+		}
+	}
+	if msg, ok := reportMessages["en"][key]; ok {
+		return msg
+	}
+	return key
+}
+
+// Tf formats the message for key with args
+func (l *reportLocale) Tf(key string, args ...any) string {
+	return fmt.Sprintf(l.T(key), args...)
+}
+
+// Tn formats key.one or key.other with n
+func (l *reportLocale) Tn(key string, n int) string {
+	if n == 1 {
+		return l.Tf(key+".one", n)
+	}
+	return l.Tf(key+".other", n)
+}
+
+// value translates an enum-like field value (status, type, risk level),
+// showing the raw value when the locale has no word for it.
+func (l *reportLocale) value(prefix, v string) string {
+	if l != nil {
+		if msg, ok := l.msgs[prefix+"."+v]; ok {
+			return msg
+		}
+	}
+	return v
+}
+
+// priorityLabel is getPriorityLabel in the report's language
+func (l *reportLocale) priorityLabel(priority int) string {
+	label := getPriorityLabel(priority)
+	if l == nil || priority < 0 || priority > 4 {
+		return label
+	}
+	// "🔥 Critical (P0)": keep the icon and the code, translate the name.
+	icon, _, _ := strings.Cut(label, " ")
+	return fmt.Sprintf("%s %s (P%d)", icon, l.T(fmt.Sprintf("priority.%d", priority)), priority)
+}
+
+// templateFuncs are the locale-dependent template helpers
+func (l *reportLocale) templateFuncs() map[string]any {
+	return map[string]any{
+		"t":             l.T,
+		"tf":            l.Tf,
+		"tn":            l.Tn,
+		"statusName":    func(s any) string { return l.value("status", fmt.Sprint(s)) },
+		"typeName":      func(t any) string { return l.value("type", fmt.Sprint(t)) },
+		"priorityLabel": l.priorityLabel,
+	}
+}
@@ -0,0 +1,86 @@
+package export
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// MarkdownSlugStyle selects how report anchors are derived from headings, so
+// TOC links land on the anchors the target platform generates.
+type MarkdownSlugStyle string
+
+const (
+	// MarkdownSlugGitHub is the compact slug the report has always used
+	// ("bv-1-fix-parser"). GitHub resolves it through the explicit <a id>
+	// anchor emitted above each heading.
+	MarkdownSlugGitHub MarkdownSlugStyle = "github"
+	// MarkdownSlugGitLab matches GitLab's heading IDs, for renderers that
+	// strip inline HTML anchors: non-word characters removed, spaces to
+	// hyphens, hyphen runs squeezed.
+	MarkdownSlugGitLab MarkdownSlugStyle = "gitlab"
+	// MarkdownSlugAzure matches Azure DevOps wiki heading links: spaces to
+	// hyphens, everything else kept and percent-encoded.
+	MarkdownSlugAzure MarkdownSlugStyle = "azure"
+)
+
+// ParseMarkdownSlugStyle validates a slug style name ("" means github)
+func ParseMarkdownSlugStyle(s string) (MarkdownSlugStyle, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "github", "gh":
+		return MarkdownSlugGitHub, nil
+	case "gitlab", "gl":
+		return MarkdownSlugGitLab, nil
+	case "azure", "azure-devops", "ado":
+		return MarkdownSlugAzure, nil
+	default:
+		return "", fmt.Errorf("unknown slug style %q (want github, gitlab or azure)", s)
+	}
+}
+
+// gitlabNonWordRegex matches what GitLab drops from heading IDs: anything
+// but letters, marks, decimal digits, connector punctuation, hyphens and spaces
+var gitlabNonWordRegex = regexp.MustCompile(`[^\p{L}\p{M}\p{Nd}\p{Pc}\- ]`)
+
+var gitlabHyphenRunRegex = regexp.MustCompile(`-{2,}`)
+
+// headingSlug derives the anchor for heading text in the given style.
+// Duplicates are numbered separately by uniqueSlug, which follows the
+// "-1", "-2" suffix convention all three platforms share.
+func headingSlug(text string, style MarkdownSlugStyle) string {
+	switch style {
+	case MarkdownSlugGitLab:
+		slug := strings.ToLower(strings.TrimSpace(text))
+		slug = gitlabNonWordRegex.ReplaceAllString(slug, "")
+		slug = strings.ReplaceAll(slug, " ", "-")
+		slug = gitlabHyphenRunRegex.ReplaceAllString(slug, "-")
+		if slug != "" && strings.IndexFunc(slug, func(r rune) bool { return !unicode.IsDigit(r) }) < 0 {
+			slug = "anchor-" + slug // GitLab never emits all-digit IDs
+		}
+		return slug
+	case MarkdownSlugAzure:
+		slug := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(text)), " ", "-")
+		var sb strings.Builder
+		for _, b := range []byte(slug) {
+			if b < 0x80 && (b >= 'a' && b <= 'z' || b >= '0' && b <= '9' || strings.IndexByte("-_.~", b) >= 0) {
+				sb.WriteByte(b)
+			} else {
+				fmt.Fprintf(&sb, "%%%02X", b)
+			}
+		}
+		return sb.String()
+	default:
+		return createSlug(text)
+	}
+}
+
+// groupSlug is the anchor for an epic section heading. The github style
+// keeps its "group-" prefix so sections never collide with issue anchors;
+// the others must match the heading as the platform sees it.
+func groupSlug(title string, style MarkdownSlugStyle) string {
+	if style == MarkdownSlugGitHub || style == "" {
+		return "group-" + createSlug(title)
+	}
+	return headingSlug(title, style)
+}
@@ -0,0 +1,160 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ReportPart is one file of a report split by MarkdownOptions.SplitEvery.
+type ReportPart struct {
+	Number  int    // 1-based
+	Of      int    // total number of parts
+	File    string // file name, relative to the index
+	Count   int    // issues in this part
+	FirstID string // first and last issue listed in the part
+	LastID  string
+
+	index, prev, next string
+	locale            *reportLocale
+}
+
+// Nav links a part back to the index and to its neighbours.
+func (p ReportPart) Nav() string {
+	part := p.locale.T("part")
+	links := []string{fmt.Sprintf("[%s](%s)", p.locale.T("nav.index"), p.index)}
+	if p.prev != "" {
+		links = append(links, fmt.Sprintf("[← %s %d](%s)", part, p.Number-1, p.prev))
+	}
+	if p.next != "" {
+		links = append(links, fmt.Sprintf("[%s %d →](%s)", part, p.Number+1, p.next))
+	}
+	return strings.Join(links, " · ")
+}
+
+// splitPartFile names part n of a split report: "report.md" -> "report-part-01.md"
+func splitPartFile(filename string, n, width int) string {
+	ext := filepath.Ext(filename)
+	base := strings.TrimSuffix(filepath.Base(filename), ext)
+	if ext == "" {
+		ext = ".md"
+	}
+	return fmt.Sprintf("%s-part-%0*d%s", base, width, n, ext)
+}
+
+// saveSplitMarkdown writes a report too large for one renderer as an index
+// plus part files of at most opts.SplitEvery issues each. Parts are cut in
+// document order, so epic sections stay together where they fit. The index
+// keeps the summary, the Mermaid graph and the analysis appendix, and its
+// table of contents links into the parts; parts carry only issue sections.
+func saveSplitMarkdown(issues []model.Issue, filename string, opts MarkdownOptions) error {
+	tmpl, err := markdownTemplateFor(opts)
+	if err != nil {
+		return err
+	}
+	index := NewReportData(issues, opts)
+
+	var ordered []ReportIssue
+	for _, g := range index.Groups {
+		ordered = append(ordered, g.Issues...)
+	}
+	if len(ordered) <= opts.SplitEvery {
+		content, err := RenderMarkdownTemplate(tmpl, index)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filename, []byte(content), 0644)
+	}
+
+	count := (len(ordered) + opts.SplitEvery - 1) / opts.SplitEvery
+	width := len(strconv.Itoa(count))
+	if width < 2 {
+		width = 2
+	}
+	files := make([]string, count)
+	for k := range files {
+		files[k] = splitPartFile(filename, k+1, width)
+	}
+
+	partOpts := opts
+	partOpts.IncludeMermaid = false
+	partOpts.IncludeAnalysis = false
+	partOpts.Milestone = time.Time{}
+
+	// Anchors are assigned per part, as each file's renderer sees them; the
+	// index links to wherever a heading ended up.
+	type anchor struct{ file, slug string }
+	issueAnchors := make(map[string]anchor, len(ordered))
+	groupAnchors := make(map[string]anchor)
+	dir := filepath.Dir(filename)
+	for k := 0; k < count; k++ {
+		chunk := ordered[k*opts.SplitEvery : min((k+1)*opts.SplitEvery, len(ordered))]
+		chunkIssues := make([]model.Issue, len(chunk))
+		for n, ri := range chunk {
+			chunkIssues[n] = ri.Issue
+		}
+
+		part := ReportPart{
+			Number:  k + 1,
+			Of:      count,
+			File:    files[k],
+			Count:   len(chunk),
+			FirstID: chunk[0].ID,
+			LastID:  chunk[len(chunk)-1].ID,
+			index:   filepath.Base(filename),
+			locale:  index.locale,
+		}
+		if k > 0 {
+			part.prev = files[k-1]
+		}
+		if k < count-1 {
+			part.next = files[k+1]
+		}
+
+		partOpts.Title = index.locale.Tf("part.title", opts.Title, k+1, count)
+		data := newReportData(chunkIssues, issues, partOpts)
+		data.GeneratedAt = index.GeneratedAt
+		data.Part = &part
+		content, err := RenderMarkdownTemplate(tmpl, data)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, files[k]), []byte(content), 0644); err != nil {
+			return err
+		}
+
+		for _, g := range data.Groups {
+			if _, seen := groupAnchors[g.EpicID]; g.Title != "" && !seen {
+				groupAnchors[g.EpicID] = anchor{files[k], g.Slug}
+			}
+			for _, ri := range g.Issues {
+				issueAnchors[ri.ID] = anchor{files[k], ri.Slug}
+			}
+		}
+		index.Parts = append(index.Parts, part)
+	}
+
+	for g := range index.Groups {
+		group := &index.Groups[g]
+		if a, ok := groupAnchors[group.EpicID]; ok {
+			group.File, group.Slug = a.file, a.slug
+		}
+		for n := range group.Issues {
+			ri := &group.Issues[n]
+			if a, ok := issueAnchors[ri.ID]; ok {
+				ri.File, ri.Slug = a.file, a.slug
+				index.Issues[ri.index].File, index.Issues[ri.index].Slug = a.file, a.slug
+			}
+		}
+	}
+	content, err := RenderMarkdownTemplate(tmpl, index)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, []byte(content), 0644)
+}
@@ -0,0 +1,506 @@
+package export
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/limits"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DefaultMarkdownTemplate is the text/template source behind GenerateMarkdown.
+// Copy it as a starting point for a custom report layout.
+//
+//go:embed templates/report.md.tmpl
+var DefaultMarkdownTemplate string
+
+// ReportData is the data passed to markdown report templates.
+type ReportData struct {
+	Title       string
+	GeneratedAt time.Time
+	Issues      []ReportIssue
+	Groups      []ReportGroup // one untitled group unless Options.GroupBy is set
+	Stats       ReportStats
+	Options     MarkdownOptions // sections to include; templates may honor or ignore them
+	Parts       []ReportPart    // set on the index of a split report; issue bodies live in the parts
+	Part        *ReportPart     // set on each part file of a split report
+	Notices     []string        // sections dropped by resource limits, and why
+
+	issues    []model.Issue
+	all       []model.Issue // input before filtering, for whole-project views
+	locale    *reportLocale // Options.Locale; nil is English
+	graphOnce sync.Once
+	analyzer  *analysis.Analyzer
+	graph     *analysis.GraphStats
+}
+
+// ReportIssue is an issue plus its unique anchor slug. Issue fields are
+// promoted, so templates can use {{.ID}}, {{.Title}}, {{.Status}}, ...
+type ReportIssue struct {
+	model.Issue
+	Slug  string
+	File  string // part file holding the issue in a split report's index; "" when in this document
+	Level int    // heading level: 2 in a flat report, 3 inside a group
+
+	ascii  bool          // Options.ASCII: tags instead of emoji in Heading
+	index  int           // position in ReportData.Issues, to share slugs with group copies
+	locale *reportLocale // language of Commands
+}
+
+// ReportGroup is a titled section of issues, e.g. one epic and its
+// descendants. Closed/Total count the epic's descendants in the full input,
+// so progress stays accurate when closed issues are left out of the listing.
+type ReportGroup struct {
+	Title  string // "" for the single group of a flat report
+	Slug   string
+	File   string // like ReportIssue.File
+	EpicID string // "" for the catch-all group
+	Closed int
+	Total  int
+	Issues []ReportIssue
+
+	locale *reportLocale // language of ProgressBar
+}
+
+// ReportStats holds the status counts shown in the report summary.
+type ReportStats struct {
+	Total, Open, InProgress, Blocked, Closed int
+}
+
+// NewReportData prepares template data, selecting and ordering issues per opts.
+func NewReportData(issues []model.Issue, opts MarkdownOptions) *ReportData {
+	return newReportData(selectIssuesForReport(issues, opts), issues, opts)
+}
+
+// newReportData builds template data for already selected and ordered
+// issues; all is the unfiltered input behind progress and burn-up counts.
+func newReportData(issues, all []model.Issue, opts MarkdownOptions) *ReportData {
+	d := &ReportData{
+		Title:       opts.Title,
+		GeneratedAt: time.Now(),
+		Options:     opts,
+		Issues:      make([]ReportIssue, len(issues)),
+		issues:      issues,
+		all:         all,
+		locale:      newReportLocale(opts.Locale),
+	}
+	for idx, i := range issues {
+		d.Issues[idx] = ReportIssue{Issue: i, Level: 2, ascii: opts.ASCII, index: idx, locale: d.locale}
+	}
+	if opts.GroupBy == MarkdownGroupEpic {
+		d.Groups = groupReportByEpic(d.Issues, all, opts.ASCII, d.locale)
+	} else {
+		d.Groups = []ReportGroup{{Issues: d.Issues, locale: d.locale}}
+	}
+
+	// Slugs are handed out in document order, so duplicate headings get the
+	// same -1, -2 suffixes the rendering platform gives them.
+	slugCounts := make(map[string]int, len(issues))
+	for g := range d.Groups {
+		group := &d.Groups[g]
+		if group.Title != "" {
+			group.Slug = uniqueSlug(groupSlug(group.Title, opts.SlugStyle), slugCounts)
+		}
+		for n := range group.Issues {
+			ri := &group.Issues[n]
+			ri.Slug = uniqueSlug(headingSlug(ri.Heading(), opts.SlugStyle), slugCounts)
+			d.Issues[ri.index].Slug = ri.Slug
+		}
+	}
+	d.Stats.Total = len(issues)
+	d.Stats.Open, d.Stats.InProgress, d.Stats.Blocked, d.Stats.Closed = countByStatus(issues)
+
+	// Renderers give up on huge Mermaid diagrams, so past the node limit the
+	// graph is left out with a notice rather than breaking the whole report.
+	if opts.IncludeMermaid {
+		if err := limits.Current().CheckNodes("it", len(issues),
+			"split the report (--md-split) or leave the graph out (--md-omit=mermaid)"); err != nil {
+			d.Options.IncludeMermaid = false
+			d.Notices = append(d.Notices, d.locale.Tf("notice.graph_omitted", err.Error()))
+		}
+	}
+	return d
+}
+
+// groupReportByEpic nests issues under their nearest epic ancestor. Epics
+// appear in the order of their first listed issue, each epic leading its own
+// section; issues without an epic go last under "No Epic".
+func groupReportByEpic(issues []ReportIssue, all []model.Issue, ascii bool, locale *reportLocale) []ReportGroup {
+	byID := make(map[string]*model.Issue, len(all))
+	for i := range all {
+		byID[all[i].ID] = &all[i]
+	}
+
+	// Progress counts every descendant in the input, listed or not.
+	closed := make(map[string]int)
+	total := make(map[string]int)
+	for _, iss := range all {
+		if epic := nearestEpic(iss, byID); epic != "" && epic != iss.ID {
+			total[epic]++
+			if isClosedLikeStatus(iss.Status) {
+				closed[epic]++
+			}
+		}
+	}
+
+	index := make(map[string]int)
+	var groups []ReportGroup
+	for _, ri := range issues {
+		epic := nearestEpic(ri.Issue, byID)
+		idx, ok := index[epic]
+		if !ok {
+			g := ReportGroup{Title: locale.T("group.no_epic"), EpicID: epic, Closed: closed[epic], Total: total[epic], locale: locale}
+			if e, found := byID[epic]; found {
+				g.Title = reportHeadingText(*e, ascii)
+			}
+			idx = len(groups)
+			index[epic] = idx
+			groups = append(groups, g)
+		}
+		ri.Level = 3
+		if ri.ID == epic {
+			groups[idx].Issues = append([]ReportIssue{ri}, groups[idx].Issues...)
+		} else {
+			groups[idx].Issues = append(groups[idx].Issues, ri)
+		}
+	}
+	sort.SliceStable(groups, func(a, b int) bool {
+		return groups[a].EpicID != "" && groups[b].EpicID == ""
+	})
+	return groups
+}
+
+// ProgressBar renders "████░░░░░░ 4/10 closed (40%)" for the group's epic.
+func (g ReportGroup) ProgressBar() string {
+	if g.EpicID == "" {
+		return g.locale.Tf("group.orphans", len(g.Issues))
+	}
+	const width = 10
+	if g.Total == 0 {
+		return strings.Repeat("░", width) + " " + g.locale.T("group.empty")
+	}
+	filled := g.Closed * width / g.Total
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled) + " " +
+		g.locale.Tf("group.progress", g.Closed, g.Total, g.Closed*100/g.Total)
+}
+
+// Link is the href of the group's heading, in this document or a part file.
+func (g ReportGroup) Link() string {
+	return g.File + "#" + g.Slug
+}
+
+// Link is the href of the issue's heading, in this document or a part file.
+func (i ReportIssue) Link() string {
+	return i.File + "#" + i.Slug
+}
+
+// H is the Markdown heading marker for the issue ("##" or "###").
+func (i ReportIssue) H() string {
+	return strings.Repeat("#", i.Level)
+}
+
+// SubH is the heading marker for sections within the issue.
+func (i ReportIssue) SubH() string {
+	return strings.Repeat("#", i.Level+1)
+}
+
+// Logged is the work logged on the issue with the timer, e.g. "1h 05m",
+// or "" when there is none.
+func (i ReportIssue) Logged() string {
+	return formatLogged(i.LoggedTime())
+}
+
+// formatLogged renders logged work as hours and minutes, "" for none.
+func formatLogged(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	minutes := int(d.Minutes())
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
+
+// Graph returns the dependency graph analysis (PageRank, betweenness,
+// critical path, cycles, ...). It is computed on first use, so templates
+// that never reference it pay nothing.
+func (d *ReportData) Graph() *analysis.GraphStats {
+	d.graphOnce.Do(func() {
+		d.analyzer = analysis.NewAnalyzer(d.issues)
+		stats := d.analyzer.Analyze()
+		d.graph = &stats
+	})
+	return d.graph
+}
+
+// ReportAnalysis is the data behind the optional Analysis appendix.
+type ReportAnalysis struct {
+	PageRank []ReportRankedIssue // top 10 by PageRank
+	Blockers []ReportBlocker     // top 10 open issues by transitive unblock count
+	AtRisk   []ReportRankedIssue // top 10 open issues by staleness score, above zero
+	Slipping []ReportRisk        // top 10 open issues by risk score, above zero
+	Forecast ReportForecast      // completion dates of open epics
+	Cycles   []string            // each cycle as "a → b → a"
+	Plan     analysis.ExecutionPlan
+
+	// CriticalPath is the longest chain of open blocking dependencies,
+	// first blocker first; empty when no open issue blocks another.
+	CriticalPath []ReportPathStep
+}
+
+// ReportPathStep is one issue on the critical path.
+type ReportPathStep struct {
+	Step  int
+	ID    string
+	Title string
+}
+
+// ReportRankedIssue is one row of a ranked metric table.
+type ReportRankedIssue struct {
+	Rank  int
+	ID    string
+	Title string
+	Score float64
+}
+
+// ReportRisk is one row of the most-likely-to-slip table.
+type ReportRisk struct {
+	Rank int
+	analysis.IssueRisk
+}
+
+// ReportForecast is the milestone forecast table: analysis.Forecast over
+// every open epic.
+type ReportForecast struct {
+	Percent    int // confidence interval width, e.g. 80
+	Milestones []analysis.MilestoneCompletion
+}
+
+// ReportBlocker is one row of the top-blockers table.
+type ReportBlocker struct {
+	Rank int
+	analysis.WhatIfEntry
+}
+
+// Analysis returns the appendix data: PageRank leaders, the blockers whose
+// completion cascades furthest, the issues most at risk of going stale, those
+// most likely to slip, forecast epic completion, dependency cycles, the
+// critical path and the execution plan.
+// Like Graph, it is computed only when a template asks for it.
+func (d *ReportData) Analysis() ReportAnalysis {
+	stats := d.Graph()
+	titles := make(map[string]string, len(d.issues))
+	for _, i := range d.issues {
+		titles[i.ID] = i.Title
+	}
+
+	var a ReportAnalysis
+	pr := stats.PageRank()
+	ids := make([]string, 0, len(pr))
+	for id := range pr {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(x, y int) bool {
+		if pr[ids[x]] != pr[ids[y]] {
+			return pr[ids[x]] > pr[ids[y]]
+		}
+		return ids[x] < ids[y]
+	})
+	for n, id := range ids {
+		if n == 10 {
+			break
+		}
+		a.PageRank = append(a.PageRank, ReportRankedIssue{Rank: n + 1, ID: id, Title: titles[id], Score: pr[id]})
+	}
+
+	for n, entry := range d.analyzer.TopWhatIfDeltas(10) {
+		a.Blockers = append(a.Blockers, ReportBlocker{Rank: n + 1, WhatIfEntry: entry})
+	}
+
+	stale := analysis.StalenessScores(d.issues, d.GeneratedAt)
+	ids = ids[:0]
+	for id, score := range stale {
+		if score > 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(x, y int) bool {
+		if stale[ids[x]] != stale[ids[y]] {
+			return stale[ids[x]] > stale[ids[y]]
+		}
+		return ids[x] < ids[y]
+	})
+	for n, id := range ids {
+		if n == 10 {
+			break
+		}
+		a.AtRisk = append(a.AtRisk, ReportRankedIssue{Rank: n + 1, ID: id, Title: titles[id], Score: stale[id]})
+	}
+	for _, r := range analysis.RiskScores(d.issues, d.GeneratedAt, d.Options.RiskWeights) {
+		if len(a.Slipping) == 10 || r.Score <= 0 {
+			break
+		}
+		a.Slipping = append(a.Slipping, ReportRisk{Rank: len(a.Slipping) + 1, IssueRisk: r})
+	}
+	// Forecasts need closed history, so they run over all input issues
+	if f, err := analysis.Forecast(d.all, analysis.ForecastOptions{Now: d.GeneratedAt}); err == nil {
+		a.Forecast = ReportForecast{Percent: int(f.Confidence*100 + 0.5), Milestones: f.Milestones}
+	}
+	for _, cycle := range stats.Cycles() {
+		if len(cycle) > 0 {
+			// Cycles come closed (first == last)
+			a.Cycles = append(a.Cycles, strings.Join(cycle, " → "))
+		}
+	}
+	a.Plan = d.analyzer.GetExecutionPlan()
+	if cp := analysis.ComputeCriticalPath(d.issues); cp.Length > 1 {
+		for n, id := range cp.Path {
+			a.CriticalPath = append(a.CriticalPath, ReportPathStep{Step: n + 1, ID: id, Title: titles[id]})
+		}
+	}
+	return a
+}
+
+// Insights returns the top-10 graph insights (bottlenecks, keystones, cycles...).
+func (d *ReportData) Insights() analysis.Insights {
+	return d.Graph().GenerateInsights(10)
+}
+
+// Mermaid returns the dependency graph as a Mermaid diagram body.
+func (d *ReportData) Mermaid() string {
+	issueIDs := make(map[string]bool, len(d.issues))
+	for _, i := range d.issues {
+		issueIDs[i.ID] = true
+	}
+	return GenerateMermaidGraph(d.issues, issueIDs, MermaidConfig{ShowNoDependenciesNode: true})
+}
+
+// DeadlineRisk returns the burn-up forecast section for Options.Milestone,
+// or "" when no milestone is set. It covers all input issues, closed ones
+// included, since completed work is half of a burn-up.
+func (d *ReportData) DeadlineRisk() (string, error) {
+	if d.Options.Milestone.IsZero() {
+		return "", nil
+	}
+	b, err := analysis.ComputeBurnUp(d.all, analysis.BurnUpOptions{Milestone: d.Options.Milestone, Now: d.GeneratedAt})
+	if err != nil {
+		return "", err
+	}
+	return generateBurnUpMarkdown(b, d.Options.BurnUpChartPath, d.Options.ASCII, d.locale) + "---\n\n", nil
+}
+
+// QuickActions returns the bulk-command section, or "" when nothing is open.
+func (d *ReportData) QuickActions() string {
+	return generateQuickActions(d.issues, d.locale)
+}
+
+// Heading returns the issue's section heading text (type icon, ID, title).
+func (i ReportIssue) Heading() string {
+	return reportHeadingText(i.Issue, i.ascii)
+}
+
+// reportHeadingText is issueHeadingText with a type tag in ASCII reports
+func reportHeadingText(i model.Issue, ascii bool) string {
+	if ascii {
+		return fmt.Sprintf("%s %s %s", getTypeTag(string(i.IssueType)), i.ID, i.Title)
+	}
+	return issueHeadingText(i)
+}
+
+// Commands returns the collapsible per-issue command snippets.
+func (i ReportIssue) Commands() string {
+	return generateIssueCommands(i.Issue, i.locale)
+}
+
+// markdownTemplateFuncs are the helpers available to report templates.
+var markdownTemplateFuncs = template.FuncMap{
+	"statusEmoji":   func(s model.Status) string { return getStatusEmoji(string(s)) },
+	"typeEmoji":     func(t model.IssueType) string { return getTypeEmoji(string(t)) },
+	"priorityLabel": getPriorityLabel,
+	"depEmoji": func(t model.DependencyType) string {
+		if t == model.DepBlocks {
+			return "⛔"
+		}
+		return "🔗"
+	},
+	// cell makes a value safe inside a table cell
+	"cell": func(s string) string {
+		s = strings.ReplaceAll(s, "\n", " ")
+		s = strings.ReplaceAll(s, "\r", "")
+		return strings.ReplaceAll(s, "|", "\\|")
+	},
+	// quote continues a multi-line value inside a > blockquote
+	"quote":    func(s string) string { return strings.ReplaceAll(s, "\n", "\n> ") },
+	"join":     strings.Join,
+	"truncate": truncateRunes,
+	"lower":    strings.ToLower,
+	"upper":    strings.ToUpper,
+	// t, tf and tn look up report strings in Options.Locale
+	"t":  (*reportLocale)(nil).T,
+	"tf": (*reportLocale)(nil).Tf,
+	"tn": (*reportLocale)(nil).Tn,
+	// statusName and typeName translate field values (raw in English)
+	"statusName": func(s model.Status) string { return string(s) },
+	"typeName":   func(t model.IssueType) string { return string(t) },
+}
+
+// ParseMarkdownTemplate parses a report template with the report helper
+// functions available.
+func ParseMarkdownTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(markdownTemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse markdown template %s: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// RenderMarkdownTemplate executes tmpl against data. Options.Locale selects
+// the language of the report strings; with Options.ASCII set, the emoji
+// helpers return bracketed tags and leftover emoji are removed.
+func RenderMarkdownTemplate(tmpl *template.Template, data *ReportData) (string, error) {
+	if data.locale != nil || data.Options.ASCII {
+		funcs := template.FuncMap{}
+		if data.locale != nil {
+			for name, fn := range data.locale.templateFuncs() {
+				funcs[name] = fn
+			}
+		}
+		if data.Options.ASCII {
+			for name, fn := range asciiTemplateFuncs {
+				funcs[name] = fn
+			}
+			locale := data.locale
+			funcs["priorityLabel"] = func(p int) string { return priorityTagFromLabel(locale.priorityLabel(p)) }
+		}
+		clone, err := tmpl.Clone()
+		if err != nil {
+			return "", fmt.Errorf("render markdown template %s: %w", tmpl.Name(), err)
+		}
+		tmpl = clone.Funcs(funcs)
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("render markdown template %s: %w", tmpl.Name(), err)
+	}
+	if data.Options.ASCII {
+		return toASCIIMarkdown(sb.String()), nil
+	}
+	return sb.String(), nil
+}
+
+var (
+	defaultMarkdownTemplateOnce sync.Once
+	defaultMarkdownTemplate     *template.Template
+	defaultMarkdownTemplateErr  error
+)
+
+func parsedDefaultMarkdownTemplate() (*template.Template, error) {
+	defaultMarkdownTemplateOnce.Do(func() {
+		defaultMarkdownTemplate, defaultMarkdownTemplateErr = ParseMarkdownTemplate("default", DefaultMarkdownTemplate)
+	})
+	return defaultMarkdownTemplate, defaultMarkdownTemplateErr
+}
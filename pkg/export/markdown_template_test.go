@@ -0,0 +1,312 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func templateTestIssues() []model.Issue {
+	return []model.Issue{
+		{ID: "A", Title: "Root", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask},
+		{ID: "B", Title: "Leaf", Status: model.StatusBlocked, Priority: 2, IssueType: model.TypeBug,
+			Dependencies: []*model.Dependency{{IssueID: "B", DependsOnID: "A", Type: model.DepBlocks}}},
+		{ID: "C", Title: "Done", Status: model.StatusClosed, Priority: 3, IssueType: model.TypeTask},
+	}
+}
+
+func TestRenderMarkdownTemplate_CustomLayout(t *testing.T) {
+	tmpl, err := ParseMarkdownTemplate("custom", `# {{.Title}} ({{.Stats.Total}} issues, {{.Stats.Blocked}} blocked)
+{{range .Issues}}- {{statusEmoji .Status}} [{{.ID}}](#{{.Slug}}) {{upper .Title}} {{priorityLabel .Priority}}
+{{end}}`)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	opts := DefaultMarkdownOptions()
+	opts.Title = "Sprint"
+	got, err := RenderMarkdownTemplate(tmpl, NewReportData(templateTestIssues(), opts))
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	want := "# Sprint (3 issues, 1 blocked)\n" +
+		"- 🟢 [A](#a-root) ROOT ⚡ High (P1)\n" +
+		"- 🔴 [B](#b-leaf) LEAF 🔹 Medium (P2)\n" +
+		"- ⚫ [C](#c-done) DONE ☕ Low (P3)\n"
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderMarkdownTemplate_AnalysisData(t *testing.T) {
+	tmpl, err := ParseMarkdownTemplate("analysis",
+		`{{range .Insights.Keystones}}{{.ID}} {{end}}| pr(A)>0: {{gt (.Graph.GetPageRankScore "A") 0.0}}`)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	got, err := RenderMarkdownTemplate(tmpl, NewReportData(templateTestIssues(), DefaultMarkdownOptions()))
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if !strings.HasPrefix(got, "A ") || !strings.HasSuffix(got, "pr(A)>0: true") {
+		t.Errorf("unexpected analysis output: %q", got)
+	}
+}
+
+func TestParseMarkdownTemplate_Errors(t *testing.T) {
+	if _, err := ParseMarkdownTemplate("bad", "{{range .Issues}}"); err == nil {
+		t.Error("expected parse error for unterminated range")
+	}
+	tmpl, err := ParseMarkdownTemplate("missing", "{{.NoSuchField}}")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if _, err := RenderMarkdownTemplate(tmpl, NewReportData(nil, DefaultMarkdownOptions())); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("expected render error naming the template, got %v", err)
+	}
+}
+
+func TestSaveMarkdownToFileWithOptions_Template(t *testing.T) {
+	dir := t.TempDir()
+	tmplPath := filepath.Join(dir, "report.tmpl")
+	if err := os.WriteFile(tmplPath, []byte("{{range .Issues}}{{.ID}}{{end}}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	opts := DefaultMarkdownOptions()
+	opts.TemplatePath = tmplPath
+	out := filepath.Join(dir, "report.md")
+	if err := SaveMarkdownToFileWithOptions(templateTestIssues(), out, opts); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	data, _ := os.ReadFile(out)
+	// Open issues first, then by priority
+	if string(data) != "ABC" {
+		t.Errorf("got %q, want %q", data, "ABC")
+	}
+	opts.TemplatePath = filepath.Join(dir, "missing.tmpl")
+	if err := SaveMarkdownToFileWithOptions(nil, out, opts); err == nil {
+		t.Error("expected error for missing template file")
+	}
+}
+
+func TestGenerateMarkdownWithOptions_SlimSummary(t *testing.T) {
+	issues := templateTestIssues()
+	issues[0].Description = strings.Repeat("long text ", 20)
+	issues[0].Comments = []*model.Comment{{Author: "ann", Text: "secret chatter"}}
+
+	full, err := GenerateMarkdownWithOptions(issues, DefaultMarkdownOptions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"## Table of Contents", "```mermaid", "### Comments", "C Done"} {
+		if !strings.Contains(full, want) {
+			t.Errorf("full report missing %q", want)
+		}
+	}
+
+	opts := DefaultMarkdownOptions()
+	opts.IncludeTOC = false
+	opts.IncludeMermaid = false
+	opts.IncludeComments = false
+	opts.IncludeClosed = false
+	opts.MaxDescriptionLength = 20
+	slim, err := GenerateMarkdownWithOptions(issues, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, unwanted := range []string{"## Table of Contents", "```mermaid", "### Comments", "secret chatter", "C Done"} {
+		if strings.Contains(slim, unwanted) {
+			t.Errorf("slim report should not contain %q", unwanted)
+		}
+	}
+	if !strings.Contains(slim, "### Description\n\nlong text long te...\n") {
+		t.Errorf("expected truncated description in slim report:\n%s", slim)
+	}
+	if !strings.Contains(slim, "| **Total** | 2 |") {
+		t.Error("summary counts should cover only the included issues")
+	}
+	if issues[0].Description == "long text long te..." {
+		t.Error("caller's issues must not be mutated")
+	}
+}
+
+func TestSelectIssuesForReport_SortOrders(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "b", Priority: 2, Status: model.StatusOpen, UpdatedAt: base.Add(3 * time.Hour), CreatedAt: base},
+		{ID: "c", Priority: 0, Status: model.StatusClosed, UpdatedAt: base.Add(1 * time.Hour), CreatedAt: base},
+		{ID: "a", Priority: 2, Status: model.StatusOpen, UpdatedAt: base.Add(2 * time.Hour), CreatedAt: base.Add(time.Hour)},
+	}
+	tests := map[MarkdownSortOrder]string{
+		MarkdownSortStatus:   "abc",
+		MarkdownSortPriority: "cab",
+		MarkdownSortID:       "abc",
+		MarkdownSortUpdated:  "bac",
+		MarkdownSortNone:     "bca",
+	}
+	for order, want := range tests {
+		opts := DefaultMarkdownOptions()
+		opts.SortOrder = order
+		var got string
+		for _, i := range selectIssuesForReport(issues, opts) {
+			got += i.ID
+		}
+		if got != want {
+			t.Errorf("%s: got %s, want %s", order, got, want)
+		}
+	}
+	if _, err := ParseMarkdownSortOrder("sideways"); err == nil {
+		t.Error("expected error for unknown sort order")
+	}
+	if order, err := ParseMarkdownSortOrder(""); err != nil || order != MarkdownSortStatus {
+		t.Errorf("empty sort order = %q, %v; want status", order, err)
+	}
+}
+
+func TestGenerateMarkdownWithOptions_GroupByEpic(t *testing.T) {
+	child := func(id, parent string, status model.Status) model.Issue {
+		return model.Issue{ID: id, Title: "Child " + id, Status: status, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: id, DependsOnID: parent, Type: model.DepParentChild}}}
+	}
+	issues := []model.Issue{
+		{ID: "E1", Title: "Launch", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		child("T1", "E1", model.StatusClosed),
+		child("T2", "E1", model.StatusOpen),
+		child("T3", "T2", model.StatusClosed), // grandchild counts toward E1
+		child("T4", "E1", model.StatusClosed),
+		{ID: "L1", Title: "Loose", Status: model.StatusOpen, IssueType: model.TypeBug},
+	}
+	opts := DefaultMarkdownOptions()
+	opts.GroupBy = MarkdownGroupEpic
+	opts.IncludeClosed = false
+	opts.SortOrder = MarkdownSortNone
+
+	data := NewReportData(issues, opts)
+	if len(data.Groups) != 2 {
+		t.Fatalf("got %d groups, want 2", len(data.Groups))
+	}
+	epic := data.Groups[0]
+	if epic.EpicID != "E1" || epic.Closed != 3 || epic.Total != 4 {
+		t.Errorf("epic group = %+v, want E1 with 3/4 closed", epic)
+	}
+	if len(epic.Issues) != 2 || epic.Issues[0].ID != "E1" || epic.Issues[1].ID != "T2" {
+		t.Errorf("epic group should list the epic then its open child, got %v", epic.Issues)
+	}
+	if got := epic.ProgressBar(); got != "███████░░░ 3/4 closed (75%)" {
+		t.Errorf("ProgressBar = %q", got)
+	}
+	if data.Groups[1].Title != "No Epic" || data.Groups[1].Issues[0].ID != "L1" {
+		t.Errorf("ungrouped issues should come last, got %+v", data.Groups[1])
+	}
+
+	md, err := GenerateMarkdownWithOptions(issues, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"## 🚀 E1 Launch\n\n███████░░░ 3/4 closed (75%)\n\n",
+		"### 📋 T2 Child T2\n",
+		"- [🚀 E1 Launch](#group-e1-launch) (3/4 closed)\n  - [🟢 E1 Launch](#e1-launch)\n",
+		"## No Epic\n",
+		"### 🐛 L1 Loose\n",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("grouped report missing %q", want)
+		}
+	}
+	if strings.Contains(md, "\n## 📋 T2") {
+		t.Error("grouped issues should use H3 headings")
+	}
+}
+
+func TestGenerateMarkdownWithOptions_AnalysisAppendix(t *testing.T) {
+	issues := append(templateTestIssues(),
+		model.Issue{ID: "X", Title: "Cycle X", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "X", DependsOnID: "Y", Type: model.DepBlocks}}},
+		model.Issue{ID: "Y", Title: "Cycle Y", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "Y", DependsOnID: "X", Type: model.DepBlocks}}},
+	)
+	opts := DefaultMarkdownOptions()
+	md, err := GenerateMarkdownWithOptions(issues, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(md, "## 📊 Analysis") {
+		t.Error("analysis appendix should be opt-in")
+	}
+
+	opts.IncludeAnalysis = true
+	md, err = GenerateMarkdownWithOptions(issues, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"## 📊 Analysis",
+		"### Top PageRank\n\n| # | ID | Title | PageRank |",
+		"| 1 | `A` | Root | 1 | 1 |",
+		"### Most At-Risk\n\n| # | ID | Title | Staleness |",
+		"| 1 | `X` | Cycle X | 0.30 |",
+		"### Most Likely to Slip\n\n| # | ID | Title | Risk | Main Factor |",
+		"### Milestone Forecast\n\n",
+		"- ⚠️ X → Y → X\n",
+		"### Critical Path\n\n1. `A` Root\n2. `B` Leaf\n",
+		"### Execution Plan\n\n1 actionable, 3 blocked. Start with `A`",
+		"- `A` Root (⚡ High (P1)) → unblocks 1",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("missing %q in appendix:\n%s", want, md[strings.Index(md, "## 📊"):])
+		}
+	}
+}
+
+func TestGenerateMarkdownWithOptions_ASCII(t *testing.T) {
+	opts := DefaultMarkdownOptions()
+	opts.IncludeAnalysis = true
+	opts.Milestone = time.Now().AddDate(0, 0, 30)
+	opts.ASCII = true
+	md, err := GenerateMarkdownWithOptions(templateTestIssues(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range md {
+		if isEmojiRune(r) {
+			t.Fatalf("emoji %q left in ASCII report:\n%s", r, md)
+		}
+	}
+	for _, want := range []string{
+		"## [BUG] B Leaf",
+		"| **Type** | [TASK] task |",
+		"| **Priority** | [P1] High |",
+		"| **Status** | [BLOCKED] blocked |",
+		"- [BLOCKER] **blocks**: `A`",
+		"- [[OPEN] A Root](#task-a-root)",
+		"## Deadline Risk",
+		"## Analysis",
+		"- `A` Root ([P1] High) -> unblocks 1",
+		"<summary>Commands</summary>",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("ASCII report missing %q:\n%s", want, md)
+		}
+	}
+
+	// Custom templates get the tag helpers too.
+	tmpl, err := ParseMarkdownTemplate("custom", "{{range .Issues}}{{statusEmoji .Status}} 🎉 {{.ID}}\n{{end}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := RenderMarkdownTemplate(tmpl, NewReportData(templateTestIssues()[:1], opts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "[OPEN] A\n" {
+		t.Errorf("custom ASCII render = %q", got)
+	}
+	// The shared template keeps its emoji helpers.
+	if got, _ := RenderMarkdownTemplate(tmpl, NewReportData(templateTestIssues()[:1], DefaultMarkdownOptions())); got != "🟢 🎉 A\n" {
+		t.Errorf("non-ASCII render after ASCII render = %q", got)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/limits"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
@@ -177,6 +178,111 @@ func TestCreateSlug(t *testing.T) {
 	}
 }
 
+func TestHeadingSlugStyles(t *testing.T) {
+	tests := []struct {
+		style MarkdownSlugStyle
+		input string
+		want  string
+	}{
+		{MarkdownSlugGitHub, "🐛 BV-1 Fix the Parser!", "bv-1-fix-the-parser"},
+		{MarkdownSlugGitLab, "🐛 BV-1 Fix the Parser!", "-bv-1-fix-the-parser"},
+		{MarkdownSlugGitLab, "bd_101.task -- Déjà vu", "bd_101task-déjà-vu"},
+		{MarkdownSlugGitLab, "2024", "anchor-2024"},
+		{MarkdownSlugAzure, "BV-1 Fix (parser)?", "bv-1-fix-%28parser%29%3F"},
+		{MarkdownSlugAzure, "🐛 bv-1", "%F0%9F%90%9B-bv-1"},
+	}
+	for _, tt := range tests {
+		if got := headingSlug(tt.input, tt.style); got != tt.want {
+			t.Errorf("headingSlug(%q, %s) = %q; want %q", tt.input, tt.style, got, tt.want)
+		}
+	}
+
+	if _, err := ParseMarkdownSlugStyle("bitbucket"); err == nil {
+		t.Error("expected error for unknown slug style")
+	}
+	if style, err := ParseMarkdownSlugStyle(""); err != nil || style != MarkdownSlugGitHub {
+		t.Errorf("empty style = %q, %v; want github", style, err)
+	}
+}
+
+func TestGenerateMarkdown_GitLabSlugsFollowDocumentOrder(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "E1", Title: "Epic", Status: model.StatusOpen, IssueType: model.TypeEpic},
+		{ID: "T1", Title: "Child", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "T1", DependsOnID: "E1", Type: model.DepParentChild}}},
+	}
+	opts := DefaultMarkdownOptions()
+	opts.SortOrder = MarkdownSortNone
+	opts.GroupBy = MarkdownGroupEpic
+	opts.SlugStyle = MarkdownSlugGitLab
+	md, err := GenerateMarkdownWithOptions(issues, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The epic's section heading and its own issue heading share text; the
+	// section comes first in the document, so it keeps the bare anchor.
+	for _, want := range []string{
+		"- [🚀 E1 Epic](#-e1-epic) (0/1 closed)",
+		"  - [🟢 E1 Epic](#-e1-epic-1)",
+		"  - [🟢 T1 Child](#-t1-child)",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("missing TOC line %q:\n%s", want, md)
+		}
+	}
+}
+
+func TestGenerateMarkdownWithOptions_Locale(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "BV-1", Title: "Fix Parser", Status: model.StatusInProgress, IssueType: model.TypeBug, Priority: 0,
+			Description: "Parser crashes"},
+	}
+	render := func(locale string, ascii bool) string {
+		t.Helper()
+		opts := DefaultMarkdownOptions()
+		opts.Locale = locale
+		opts.ASCII = ascii
+		md, err := GenerateMarkdownWithOptions(issues, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return md
+	}
+
+	de := render("de", false)
+	for _, want := range []string{"## Zusammenfassung", "## Inhaltsverzeichnis", "| **Typ** | 🐛 Fehler |",
+		"| **Priorität** | 🔥 Kritisch (P0) |", "| **Status** | 🔵 in Arbeit |", "### Beschreibung", "# Als erledigt markieren"} {
+		if !strings.Contains(de, want) {
+			t.Errorf("German report missing %q", want)
+		}
+	}
+	// Anchors come from the issue heading, which is not translated.
+	if !strings.Contains(de, "](#bv-1-fix-parser)") {
+		t.Error("German report should keep the English-independent anchor")
+	}
+
+	ja := render("ja", true)
+	for _, want := range []string{"## 概要", "| **優先度** | [P0] 緊急 |", "| **ステータス** | [IN_PROGRESS] 進行中 |"} {
+		if !strings.Contains(ja, want) {
+			t.Errorf("Japanese ASCII report missing %q", want)
+		}
+	}
+
+	en := render("", false)
+	for _, want := range []string{"## Summary", "| **Status** | 🔵 in_progress |", "| **Priority** | 🔥 Critical (P0) |"} {
+		if !strings.Contains(en, want) {
+			t.Errorf("English report missing %q", want)
+		}
+	}
+
+	if code, err := ParseMarkdownLocale("de_DE.UTF-8"); err != nil || code != "de" {
+		t.Errorf("ParseMarkdownLocale(de_DE.UTF-8) = %q, %v; want de", code, err)
+	}
+	if _, err := ParseMarkdownLocale("fr"); err == nil {
+		t.Error("expected error for a locale without a bundle")
+	}
+}
+
 func TestGenerateMarkdown_TOCAnchorsMatchHeadings(t *testing.T) {
 	issues := []model.Issue{
 		{ID: "BV-1", Title: "Fix Parser", Status: model.StatusOpen, IssueType: model.TypeBug},
@@ -649,6 +755,10 @@ func TestGenerateMarkdown_WithAllFields(t *testing.T) {
 			CreatedAt:          now,
 			UpdatedAt:          now,
 			ClosedAt:           &closedAt,
+			TimeEntries: []model.TimeEntry{
+				{Start: now, End: &closedAt},
+				{Start: closedAt}, // running: not logged yet
+			},
 		},
 	}
 
@@ -668,6 +778,7 @@ func TestGenerateMarkdown_WithAllFields(t *testing.T) {
 		"### Notes",
 		"Additional notes",
 		"**Assignee** | @developer",
+		"**Logged** | 24h 00m",
 		"**Labels** | urgent, backend",
 		"**Closed**",
 	}
@@ -844,6 +955,86 @@ func TestSaveMarkdownToFile_DoesNotMutateInput(t *testing.T) {
 	}
 }
 
+func TestGenerateMarkdown_MermaidOverNodeLimit(t *testing.T) {
+	defer limits.Set(limits.Limits{MaxRenderNodes: 1})()
+	issues := []model.Issue{
+		{ID: "M-1", Title: "One", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "M-2", Title: "Two", Status: model.StatusOpen, IssueType: model.TypeTask},
+	}
+	md, err := GenerateMarkdown(issues, "Limits")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(md, "```mermaid") {
+		t.Error("Mermaid graph should be omitted past the node limit")
+	}
+	if !strings.Contains(md, "Dependency graph omitted: it needs 2 nodes") || !strings.Contains(md, "--md-split") {
+		t.Errorf("report should explain the omission:\n%s", md)
+	}
+}
+
+func TestSaveMarkdownToFile_SplitEvery(t *testing.T) {
+	tmpDir := t.TempDir()
+	now := time.Now()
+	var issues []model.Issue
+	for n := 1; n <= 5; n++ {
+		issues = append(issues, model.Issue{
+			ID: fmt.Sprintf("S-%d", n), Title: fmt.Sprintf("Issue %d", n), Status: model.StatusOpen,
+			IssueType: model.TypeTask, Priority: n, CreatedAt: now, UpdatedAt: now,
+		})
+	}
+	issues[4].Dependencies = []*model.Dependency{{IssueID: "S-5", DependsOnID: "S-1", Type: model.DepBlocks}}
+
+	opts := DefaultMarkdownOptions()
+	opts.SortOrder = MarkdownSortID
+	opts.SplitEvery = 2
+	filePath := filepath.Join(tmpDir, "report.md")
+	if err := SaveMarkdownToFileWithOptions(issues, filePath, opts); err != nil {
+		t.Fatalf("SaveMarkdownToFileWithOptions returned error: %v", err)
+	}
+
+	read := func(name string) string {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join(tmpDir, name))
+		if err != nil {
+			t.Fatalf("reading %s: %v", name, err)
+		}
+		return string(data)
+	}
+	index := read("report.md")
+	for _, want := range []string{"```mermaid", "## Parts", "[Part 3](report-part-03.md): 1 issue, `S-5` to `S-5`", "](report-part-02.md#s-3-issue-3)"} {
+		if !strings.Contains(index, want) {
+			t.Errorf("index missing %q", want)
+		}
+	}
+	if strings.Contains(index, "| **Priority** |") {
+		t.Error("index should not contain issue sections")
+	}
+
+	part2 := read("report-part-02.md")
+	for _, want := range []string{"(Part 2 of 3)", "[Index](report.md)", "[← Part 1](report-part-01.md)", "[Part 3 →](report-part-03.md)", `<a id="s-3-issue-3"></a>`, "S-4"} {
+		if !strings.Contains(part2, want) {
+			t.Errorf("part 2 missing %q", want)
+		}
+	}
+	if strings.Contains(part2, "mermaid") || strings.Contains(part2, "S-5 Issue 5") {
+		t.Error("part 2 should hold only its own issues and no graph")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "report-part-04.md")); !os.IsNotExist(err) {
+		t.Error("unexpected fourth part")
+	}
+
+	// Small reports stay in one file.
+	opts.SplitEvery = 10
+	single := filepath.Join(tmpDir, "single.md")
+	if err := SaveMarkdownToFileWithOptions(issues, single, opts); err != nil {
+		t.Fatal(err)
+	}
+	if md := read("single.md"); strings.Contains(md, "## Parts") || !strings.Contains(md, "S-5 Issue 5") {
+		t.Error("a report under SplitEvery should be written whole")
+	}
+}
+
 // ============================================================================
 // Integration tests with realistic data
 // ============================================================================
@@ -1281,7 +1472,7 @@ func TestGenerateQuickActions_WithOpenIssues(t *testing.T) {
 		{ID: "CLOSED-1", Status: model.StatusClosed, Priority: 2, CreatedAt: now, UpdatedAt: now},
 	}
 
-	result := generateQuickActions(issues)
+	result := generateQuickActions(issues, nil)
 
 	if !strings.Contains(result, "## Quick Actions") {
 		t.Error("Missing Quick Actions header")
@@ -1301,7 +1492,7 @@ func TestGenerateQuickActions_WithInProgressIssues(t *testing.T) {
 		{ID: "PROG-2", Status: model.StatusInProgress, Priority: 2, CreatedAt: now, UpdatedAt: now},
 	}
 
-	result := generateQuickActions(issues)
+	result := generateQuickActions(issues, nil)
 
 	if !strings.Contains(result, "# Close all in-progress items") {
 		t.Error("Missing in-progress close comment")
@@ -1317,7 +1508,7 @@ func TestGenerateQuickActions_WithBlockedIssues(t *testing.T) {
 		{ID: "BLOCKED-1", Status: model.StatusBlocked, Priority: 2, CreatedAt: now, UpdatedAt: now},
 	}
 
-	result := generateQuickActions(issues)
+	result := generateQuickActions(issues, nil)
 
 	if !strings.Contains(result, "# Update blocked items") {
 		t.Error("Missing blocked items comment")
@@ -1335,7 +1526,7 @@ func TestGenerateQuickActions_AllClosed(t *testing.T) {
 		{ID: "TOMB-1", Status: model.StatusTombstone, Priority: 1, CreatedAt: now, UpdatedAt: now},
 	}
 
-	result := generateQuickActions(issues)
+	result := generateQuickActions(issues, nil)
 
 	// Should return empty string when all issues are closed
 	if result != "" {
@@ -1356,7 +1547,7 @@ func TestGenerateQuickActions_ManyOpenIssues(t *testing.T) {
 		}
 	}
 
-	result := generateQuickActions(issues)
+	result := generateQuickActions(issues, nil)
 
 	// Should truncate to first 10 for large lists
 	if !strings.Contains(result, "15 total, showing first 10") {
@@ -1401,7 +1592,7 @@ func TestGenerateIssueCommands_OpenIssue(t *testing.T) {
 		UpdatedAt: now,
 	}
 
-	result := generateIssueCommands(issue)
+	result := generateIssueCommands(issue, nil)
 
 	if !strings.Contains(result, "<details>") {
 		t.Error("Missing details tag")
@@ -1430,7 +1621,7 @@ func TestGenerateIssueCommands_InProgressIssue(t *testing.T) {
 		UpdatedAt: now,
 	}
 
-	result := generateIssueCommands(issue)
+	result := generateIssueCommands(issue, nil)
 
 	if !strings.Contains(result, "# Mark as complete") {
 		t.Error("Missing mark complete comment")
@@ -1450,7 +1641,7 @@ func TestGenerateIssueCommands_BlockedIssue(t *testing.T) {
 		UpdatedAt: now,
 	}
 
-	result := generateIssueCommands(issue)
+	result := generateIssueCommands(issue, nil)
 
 	if !strings.Contains(result, "# Unblock and start working") {
 		t.Error("Missing unblock comment")
@@ -1470,7 +1661,7 @@ func TestGenerateIssueCommands_ClosedIssue(t *testing.T) {
 		UpdatedAt: now,
 	}
 
-	result := generateIssueCommands(issue)
+	result := generateIssueCommands(issue, nil)
 
 	// Should return empty string for closed issues
 	if result != "" {
@@ -1488,7 +1679,7 @@ func TestGenerateIssueCommands_SpecialCharID(t *testing.T) {
 		UpdatedAt: now,
 	}
 
-	result := generateIssueCommands(issue)
+	result := generateIssueCommands(issue, nil)
 
 	// ID should be shell-escaped
 	if !strings.Contains(result, "'issue with spaces'") {
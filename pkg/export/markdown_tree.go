@@ -0,0 +1,283 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// MarkdownTreeGrouping selects how SaveMarkdownTree lays out directories.
+type MarkdownTreeGrouping string
+
+const (
+	// MarkdownTreeByEpic places each issue under the directory of its nearest
+	// epic ancestor (via parent-child dependencies).
+	MarkdownTreeByEpic MarkdownTreeGrouping = "epic"
+	// MarkdownTreeByLabel places each issue under the directory of its first
+	// label (alphabetically).
+	MarkdownTreeByLabel MarkdownTreeGrouping = "label"
+	// MarkdownTreeFlat writes every issue file next to index.md.
+	MarkdownTreeFlat MarkdownTreeGrouping = "flat"
+)
+
+// ungroupedDir holds issues that have no epic/label in grouped layouts.
+const ungroupedDir = "ungrouped"
+
+// MarkdownTreeOptions configures SaveMarkdownTree.
+type MarkdownTreeOptions struct {
+	Dir     string               // Output directory (created if missing)
+	Title   string               // Title for index.md (default "Beads Export")
+	GroupBy MarkdownTreeGrouping // Directory layout (default: by epic)
+}
+
+// ParseMarkdownTreeGrouping converts a CLI value into a grouping mode.
+func ParseMarkdownTreeGrouping(s string) (MarkdownTreeGrouping, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "epic", "epics":
+		return MarkdownTreeByEpic, nil
+	case "label", "labels":
+		return MarkdownTreeByLabel, nil
+	case "flat", "none":
+		return MarkdownTreeFlat, nil
+	default:
+		return "", fmt.Errorf("unknown grouping %q (want epic, label, or flat)", s)
+	}
+}
+
+// SaveMarkdownTree writes one markdown file per issue into a directory
+// hierarchy plus an index.md with the summary and Mermaid graph. Issues are
+// cross-linked with relative links so the tree can be committed into a docs/
+// folder and browsed on any forge.
+func SaveMarkdownTree(issues []model.Issue, opts MarkdownTreeOptions) error {
+	if opts.Dir == "" {
+		return fmt.Errorf("output directory is required")
+	}
+	if opts.GroupBy == "" {
+		opts.GroupBy = MarkdownTreeByEpic
+	}
+	title := opts.Title
+	if strings.TrimSpace(title) == "" {
+		title = "Beads Export"
+	}
+
+	sorted := make([]model.Issue, len(issues))
+	copy(sorted, issues)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	paths := markdownTreePaths(sorted, opts.GroupBy)
+
+	// Reverse edges so each page can list what points at it.
+	referencedBy := make(map[string][]*model.Dependency)
+	for _, iss := range sorted {
+		for _, dep := range iss.Dependencies {
+			if dep == nil {
+				continue
+			}
+			if _, ok := paths[dep.DependsOnID]; ok {
+				referencedBy[dep.DependsOnID] = append(referencedBy[dep.DependsOnID], dep)
+			}
+		}
+	}
+
+	for _, iss := range sorted {
+		rel := paths[iss.ID]
+		content := generateTreeIssuePage(iss, rel, paths, referencedBy[iss.ID])
+		if err := writeTreeFile(opts.Dir, rel, content); err != nil {
+			return err
+		}
+	}
+
+	return writeTreeFile(opts.Dir, "index.md", generateTreeIndex(sorted, title, paths))
+}
+
+// markdownTreePaths assigns each issue a slash-separated path relative to the
+// output root.
+func markdownTreePaths(issues []model.Issue, groupBy MarkdownTreeGrouping) map[string]string {
+	byID := make(map[string]*model.Issue, len(issues))
+	for idx := range issues {
+		byID[issues[idx].ID] = &issues[idx]
+	}
+
+	paths := make(map[string]string, len(issues))
+	used := make(map[string]int, len(issues))
+	used["index"] = 0 // index.md is the generated index page; an issue slugged "index" gets index-1
+	for _, iss := range issues {
+		dir := ""
+		switch groupBy {
+		case MarkdownTreeByEpic:
+			dir = ungroupedDir
+			if epic := nearestEpic(iss, byID); epic != "" {
+				dir = treeSlug(epic)
+			}
+		case MarkdownTreeByLabel:
+			dir = ungroupedDir
+			if len(iss.Labels) > 0 {
+				labels := append([]string(nil), iss.Labels...)
+				sort.Strings(labels)
+				dir = treeSlug(labels[0])
+			}
+		}
+		name := uniqueSlug(treeSlug(iss.ID), used) + ".md"
+		paths[iss.ID] = path.Join(dir, name)
+	}
+	return paths
+}
+
+// nearestEpic walks parent-child links upward and returns the first epic ID
+// found, including the issue itself when it is an epic.
+func nearestEpic(iss model.Issue, byID map[string]*model.Issue) string {
+	seen := make(map[string]bool)
+	cur := &iss
+	for cur != nil && !seen[cur.ID] {
+		seen[cur.ID] = true
+		if cur.IssueType == model.TypeEpic {
+			return cur.ID
+		}
+		var parent *model.Issue
+		for _, dep := range cur.Dependencies {
+			if dep != nil && dep.Type == model.DepParentChild {
+				parent = byID[dep.DependsOnID]
+				break
+			}
+		}
+		cur = parent
+	}
+	return ""
+}
+
+func treeSlug(s string) string {
+	slug := createSlug(s)
+	if slug == "" {
+		return "item"
+	}
+	return slug
+}
+
+// relativeTreeLink returns the link from the page at fromPath to toPath.
+func relativeTreeLink(fromPath, toPath string) string {
+	rel, err := filepath.Rel(filepath.Dir(filepath.FromSlash(fromPath)), filepath.FromSlash(toPath))
+	if err != nil {
+		return toPath
+	}
+	return filepath.ToSlash(rel)
+}
+
+func generateTreeIssuePage(i model.Issue, self string, paths map[string]string, referencedBy []*model.Dependency) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# %s\n\n", issueHeadingText(i)))
+	sb.WriteString(fmt.Sprintf("[← Index](%s)\n\n", relativeTreeLink(self, "index.md")))
+
+	writeIssueMetadataTable(&sb, i)
+	writeIssueTextSections(&sb, i)
+
+	link := func(id string) string {
+		if target, ok := paths[id]; ok {
+			return fmt.Sprintf("[%s](%s)", id, relativeTreeLink(self, target))
+		}
+		return fmt.Sprintf("`%s`", id)
+	}
+
+	if len(i.Dependencies) > 0 {
+		sb.WriteString("## Dependencies\n\n")
+		for _, dep := range i.Dependencies {
+			if dep == nil {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("- **%s**: %s\n", dep.Type, link(dep.DependsOnID)))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(referencedBy) > 0 {
+		sb.WriteString("## Referenced By\n\n")
+		for _, dep := range referencedBy {
+			sb.WriteString(fmt.Sprintf("- **%s**: %s\n", dep.Type, link(dep.IssueID)))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(i.Comments) > 0 {
+		sb.WriteString("## Comments\n\n")
+		for _, c := range i.Comments {
+			if c == nil {
+				continue
+			}
+			escapedText := strings.ReplaceAll(c.Text, "\n", "\n> ")
+			sb.WriteString(fmt.Sprintf("> **%s** (%s)\n>\n> %s\n\n",
+				c.Author, c.CreatedAt.Format("2006-01-02"), escapedText))
+		}
+	}
+
+	return sb.String()
+}
+
+func generateTreeIndex(issues []model.Issue, title string, paths map[string]string) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# %s\n\n", title))
+	sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", time.Now().Format(time.RFC1123)))
+
+	sb.WriteString("## Summary\n\n")
+	writeStatusSummaryTable(&sb, issues)
+
+	sb.WriteString("## Dependency Graph\n\n")
+	sb.WriteString("```mermaid\n")
+	issueIDs := make(map[string]bool, len(issues))
+	for _, i := range issues {
+		issueIDs[i.ID] = true
+	}
+	sb.WriteString(GenerateMermaidGraph(issues, issueIDs, MermaidConfig{ShowNoDependenciesNode: true}))
+	sb.WriteString("```\n\n")
+
+	// Group the listing by directory so the index mirrors the tree.
+	groups := make(map[string][]model.Issue)
+	for _, i := range issues {
+		dir := path.Dir(paths[i.ID])
+		groups[dir] = append(groups[dir], i)
+	}
+	dirs := make([]string, 0, len(groups))
+	for dir := range groups {
+		dirs = append(dirs, dir)
+	}
+	sort.Slice(dirs, func(a, b int) bool {
+		// Ungrouped issues go last; the flat root goes first.
+		if (dirs[a] == ungroupedDir) != (dirs[b] == ungroupedDir) {
+			return dirs[b] == ungroupedDir
+		}
+		return dirs[a] < dirs[b]
+	})
+
+	sb.WriteString("## Issues\n\n")
+	for _, dir := range dirs {
+		if dir != "." {
+			sb.WriteString(fmt.Sprintf("### %s\n\n", dir))
+		}
+		for _, i := range groups[dir] {
+			sb.WriteString(fmt.Sprintf("- %s [%s %s](%s)\n",
+				getStatusEmoji(string(i.Status)), i.ID, i.Title, paths[i.ID]))
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+func writeTreeFile(root, rel, content string) error {
+	full := filepath.Join(root, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+		return fmt.Errorf("create dir: %w", err)
+	}
+	if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+		return fmt.Errorf("write %s: %w", rel, err)
+	}
+	return nil
+}
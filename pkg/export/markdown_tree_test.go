@@ -0,0 +1,121 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestSaveMarkdownTree(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "EPIC-1", Title: "Auth overhaul", Status: model.StatusOpen, IssueType: model.TypeEpic, Labels: []string{"auth"}},
+		{ID: "T-1", Title: "Login form", Status: model.StatusOpen, IssueType: model.TypeTask, Labels: []string{"ui"},
+			Dependencies: []*model.Dependency{{IssueID: "T-1", DependsOnID: "EPIC-1", Type: model.DepParentChild}}},
+		{ID: "T-2", Title: "Session store", Status: model.StatusBlocked, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{
+				{IssueID: "T-2", DependsOnID: "T-1", Type: model.DepParentChild},
+				{IssueID: "T-2", DependsOnID: "X-1", Type: model.DepBlocks},
+			}},
+		{ID: "X-1", Title: "Infra", Status: model.StatusClosed, IssueType: model.TypeChore},
+	}
+
+	t.Run("by epic", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := SaveMarkdownTree(issues, MarkdownTreeOptions{Dir: dir}); err != nil {
+			t.Fatalf("SaveMarkdownTree: %v", err)
+		}
+
+		for _, rel := range []string{"index.md", "epic-1/epic-1.md", "epic-1/t-1.md", "epic-1/t-2.md", "ungrouped/x-1.md"} {
+			if _, err := os.Stat(filepath.Join(dir, rel)); err != nil {
+				t.Errorf("expected %s: %v", rel, err)
+			}
+		}
+
+		page, err := os.ReadFile(filepath.Join(dir, "epic-1", "t-2.md"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		content := string(page)
+		if !strings.Contains(content, "[X-1](../ungrouped/x-1.md)") {
+			t.Errorf("expected relative link to blocker, got:\n%s", content)
+		}
+		if !strings.Contains(content, "[T-1](t-1.md)") {
+			t.Errorf("expected sibling link to parent, got:\n%s", content)
+		}
+		if !strings.Contains(content, "[← Index](../index.md)") {
+			t.Errorf("expected back link to index")
+		}
+
+		blocker, _ := os.ReadFile(filepath.Join(dir, "ungrouped", "x-1.md"))
+		if !strings.Contains(string(blocker), "## Referenced By") || !strings.Contains(string(blocker), "[T-2](../epic-1/t-2.md)") {
+			t.Errorf("expected back-reference to T-2, got:\n%s", blocker)
+		}
+
+		index, _ := os.ReadFile(filepath.Join(dir, "index.md"))
+		idx := string(index)
+		if !strings.Contains(idx, "```mermaid") || !strings.Contains(idx, "| **Total** | 4 |") {
+			t.Errorf("index missing summary or graph:\n%s", idx)
+		}
+		if strings.Index(idx, "### epic-1") > strings.Index(idx, "### ungrouped") {
+			t.Errorf("expected ungrouped section last")
+		}
+	})
+
+	t.Run("by label", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := SaveMarkdownTree(issues, MarkdownTreeOptions{Dir: dir, GroupBy: MarkdownTreeByLabel}); err != nil {
+			t.Fatal(err)
+		}
+		for _, rel := range []string{"auth/epic-1.md", "ui/t-1.md", "ungrouped/t-2.md"} {
+			if _, err := os.Stat(filepath.Join(dir, rel)); err != nil {
+				t.Errorf("expected %s: %v", rel, err)
+			}
+		}
+	})
+
+	t.Run("flat", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := SaveMarkdownTree(issues, MarkdownTreeOptions{Dir: dir, GroupBy: MarkdownTreeFlat}); err != nil {
+			t.Fatal(err)
+		}
+		page, _ := os.ReadFile(filepath.Join(dir, "t-2.md"))
+		if !strings.Contains(string(page), "[X-1](x-1.md)") {
+			t.Errorf("expected flat relative link, got:\n%s", page)
+		}
+	})
+}
+
+func TestSaveMarkdownTree_IssueNamedIndex(t *testing.T) {
+	dir := t.TempDir()
+	issues := []model.Issue{
+		{ID: "index", Title: "Search index rebuild", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "T-1", Title: "Login form", Status: model.StatusOpen, IssueType: model.TypeTask},
+	}
+	if err := SaveMarkdownTree(issues, MarkdownTreeOptions{Dir: dir, GroupBy: MarkdownTreeFlat}); err != nil {
+		t.Fatal(err)
+	}
+	page, err := os.ReadFile(filepath.Join(dir, "index-1.md"))
+	if err != nil || !strings.Contains(string(page), "Search index rebuild") {
+		t.Fatalf("issue \"index\" should be written to index-1.md, got %v:\n%s", err, page)
+	}
+	index, _ := os.ReadFile(filepath.Join(dir, "index.md"))
+	if !strings.Contains(string(index), "(index-1.md)") {
+		t.Errorf("generated index should link the issue page, got:\n%s", index)
+	}
+}
+
+func TestParseMarkdownTreeGrouping(t *testing.T) {
+	cases := map[string]MarkdownTreeGrouping{"": MarkdownTreeByEpic, "Label": MarkdownTreeByLabel, "flat": MarkdownTreeFlat}
+	for in, want := range cases {
+		got, err := ParseMarkdownTreeGrouping(in)
+		if err != nil || got != want {
+			t.Errorf("ParseMarkdownTreeGrouping(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseMarkdownTreeGrouping("bogus"); err == nil {
+		t.Error("expected error for unknown grouping")
+	}
+}
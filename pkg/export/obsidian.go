@@ -0,0 +1,178 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// obsidianIndexNote is the map-of-content note written at the vault root.
+const obsidianIndexNote = "Beads Index"
+
+// ObsidianVaultOptions configures SaveObsidianVault.
+type ObsidianVaultOptions struct {
+	Dir   string // Vault directory (created if missing)
+	Title string // Heading for the index note (default "Beads Index")
+}
+
+// SaveObsidianVault writes an Obsidian-compatible vault: one note per issue
+// with YAML frontmatter (status, priority, labels) and [[wikilinks]] for
+// dependencies, so Obsidian's graph view mirrors the dependency graph.
+func SaveObsidianVault(issues []model.Issue, opts ObsidianVaultOptions) error {
+	if opts.Dir == "" {
+		return fmt.Errorf("output directory is required")
+	}
+	title := opts.Title
+	if strings.TrimSpace(title) == "" {
+		title = obsidianIndexNote
+	}
+
+	sorted := make([]model.Issue, len(issues))
+	copy(sorted, issues)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	names := make(map[string]string, len(sorted))
+	used := make(map[string]int, len(sorted))
+	for _, iss := range sorted {
+		names[iss.ID] = uniqueSlug(obsidianNoteName(iss.ID), used)
+	}
+
+	for _, iss := range sorted {
+		if err := writeTreeFile(opts.Dir, names[iss.ID]+".md", generateObsidianNote(iss, names)); err != nil {
+			return err
+		}
+	}
+
+	return writeTreeFile(opts.Dir, obsidianIndexNote+".md", generateObsidianIndex(sorted, title, names))
+}
+
+// obsidianNoteName strips characters Obsidian does not allow in note names.
+func obsidianNoteName(id string) string {
+	name := strings.Map(func(r rune) rune {
+		switch r {
+		case '*', '"', '\\', '/', '<', '>', ':', '|', '?', '#', '^', '[', ']':
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(id))
+	if name == "" || name == obsidianIndexNote {
+		return "issue-" + name
+	}
+	return name
+}
+
+// obsidianTag converts a label into a valid Obsidian tag (no spaces).
+func obsidianTag(label string) string {
+	tag := strings.Join(strings.Fields(label), "-")
+	return strings.Trim(tag, "#")
+}
+
+// yamlString quotes s as a YAML scalar. JSON strings are valid YAML.
+func yamlString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+func generateObsidianNote(i model.Issue, names map[string]string) string {
+	var sb strings.Builder
+
+	sb.WriteString("---\n")
+	sb.WriteString(fmt.Sprintf("id: %s\n", yamlString(i.ID)))
+	sb.WriteString(fmt.Sprintf("title: %s\n", yamlString(i.Title)))
+	sb.WriteString(fmt.Sprintf("status: %s\n", yamlString(string(i.Status))))
+	sb.WriteString(fmt.Sprintf("priority: %d\n", i.Priority))
+	sb.WriteString(fmt.Sprintf("type: %s\n", yamlString(string(i.IssueType))))
+	if i.Assignee != "" {
+		sb.WriteString(fmt.Sprintf("assignee: %s\n", yamlString(i.Assignee)))
+	}
+	if len(i.Labels) > 0 {
+		sb.WriteString("labels:\n")
+		for _, l := range i.Labels {
+			sb.WriteString(fmt.Sprintf("  - %s\n", yamlString(l)))
+		}
+	}
+	sb.WriteString("tags:\n")
+	sb.WriteString(fmt.Sprintf("  - %s\n", yamlString("bead/"+string(i.Status))))
+	for _, l := range i.Labels {
+		if tag := obsidianTag(l); tag != "" {
+			sb.WriteString(fmt.Sprintf("  - %s\n", yamlString(tag)))
+		}
+	}
+	if !i.CreatedAt.IsZero() {
+		sb.WriteString(fmt.Sprintf("created: %s\n", i.CreatedAt.Format(time.RFC3339)))
+	}
+	if !i.UpdatedAt.IsZero() {
+		sb.WriteString(fmt.Sprintf("updated: %s\n", i.UpdatedAt.Format(time.RFC3339)))
+	}
+	if i.DueDate != nil {
+		sb.WriteString(fmt.Sprintf("due: %s\n", i.DueDate.Format("2006-01-02")))
+	}
+	if i.ClosedAt != nil {
+		sb.WriteString(fmt.Sprintf("closed: %s\n", i.ClosedAt.Format(time.RFC3339)))
+	}
+	sb.WriteString("---\n\n")
+
+	sb.WriteString(fmt.Sprintf("# %s\n\n", i.Title))
+	writeIssueTextSections(&sb, i)
+
+	if len(i.Dependencies) > 0 {
+		sb.WriteString("## Dependencies\n\n")
+		for _, dep := range i.Dependencies {
+			if dep == nil {
+				continue
+			}
+			depType := dep.Type
+			if depType == "" {
+				depType = model.DepBlocks
+			}
+			target := fmt.Sprintf("`%s`", dep.DependsOnID)
+			if name, ok := names[dep.DependsOnID]; ok {
+				target = fmt.Sprintf("[[%s]]", name)
+			}
+			sb.WriteString(fmt.Sprintf("- %s:: %s\n", depType, target))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(i.Comments) > 0 {
+		sb.WriteString("## Comments\n\n")
+		for _, c := range i.Comments {
+			if c == nil {
+				continue
+			}
+			escapedText := strings.ReplaceAll(c.Text, "\n", "\n> ")
+			sb.WriteString(fmt.Sprintf("> **%s** (%s)\n>\n> %s\n\n",
+				c.Author, c.CreatedAt.Format("2006-01-02"), escapedText))
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("\n[[%s]]\n", obsidianIndexNote))
+	return sb.String()
+}
+
+func generateObsidianIndex(issues []model.Issue, title string, names map[string]string) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# %s\n\n", title))
+	sb.WriteString(fmt.Sprintf("*Generated: %s*\n\n", time.Now().Format(time.RFC1123)))
+	sb.WriteString("## Summary\n\n")
+	writeStatusSummaryTable(&sb, issues)
+
+	// Aliases cannot contain the wikilink delimiters.
+	alias := strings.NewReplacer("|", "/", "[", "(", "]", ")")
+
+	sb.WriteString("## Issues\n\n")
+	for _, i := range issues {
+		sb.WriteString(fmt.Sprintf("- [[%s|%s]] (%s, P%d)\n",
+			names[i.ID], alias.Replace(i.ID+" "+i.Title), i.Status, i.Priority))
+	}
+	sb.WriteString("\n")
+
+	return sb.String()
+}
@@ -0,0 +1,60 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestSaveObsidianVault(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Parser: handle BOM", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeBug,
+			Labels: []string{"parser", "needs review"},
+			Dependencies: []*model.Dependency{
+				{IssueID: "bv-1", DependsOnID: "bv/2", Type: model.DepBlocks},
+				{IssueID: "bv-1", DependsOnID: "ext-9", Type: model.DepRelated},
+			}},
+		{ID: "bv/2", Title: "Loader [core]", Status: model.StatusClosed, Priority: 2, IssueType: model.TypeTask},
+	}
+
+	dir := t.TempDir()
+	if err := SaveObsidianVault(issues, ObsidianVaultOptions{Dir: dir}); err != nil {
+		t.Fatalf("SaveObsidianVault: %v", err)
+	}
+
+	note, err := os.ReadFile(filepath.Join(dir, "bv-1.md"))
+	if err != nil {
+		t.Fatalf("read note: %v", err)
+	}
+	content := string(note)
+	for _, want := range []string{
+		"---\nid: \"bv-1\"\n",
+		"title: \"Parser: handle BOM\"\n",
+		"status: \"open\"\n",
+		"priority: 1\n",
+		"labels:\n  - \"parser\"\n  - \"needs review\"\n",
+		"  - \"needs-review\"\n",
+		"- blocks:: [[bv-2]]\n",
+		"- related:: `ext-9`\n",
+		"[[Beads Index]]",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("note missing %q:\n%s", want, content)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "bv-2.md")); err != nil {
+		t.Errorf("expected sanitized note name bv-2.md: %v", err)
+	}
+
+	index, err := os.ReadFile(filepath.Join(dir, "Beads Index.md"))
+	if err != nil {
+		t.Fatalf("read index: %v", err)
+	}
+	if !strings.Contains(string(index), "[[bv-2|bv/2 Loader (core)]]") {
+		t.Errorf("index missing aliased wikilink:\n%s", index)
+	}
+}
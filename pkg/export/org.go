@@ -0,0 +1,267 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// orgTodoLine declares the TODO keywords used by GenerateOrg; keywords after
+// the bar count as done in org-mode's agenda.
+const orgTodoLine = "#+TODO: TODO STARTED WAITING HOLD REVIEW | DONE CANCELLED"
+
+// orgTodoKeyword maps a bead status onto the keywords declared in orgTodoLine.
+func orgTodoKeyword(status model.Status) string {
+	switch status {
+	case model.StatusInProgress, model.StatusHooked:
+		return "STARTED"
+	case model.StatusBlocked:
+		return "WAITING"
+	case model.StatusDeferred:
+		return "HOLD"
+	case model.StatusReview:
+		return "REVIEW"
+	case model.StatusClosed:
+		return "DONE"
+	case model.StatusTombstone:
+		return "CANCELLED"
+	default:
+		return "TODO"
+	}
+}
+
+// orgPriorityCookie maps P0..P4 onto [#A]..[#E] (see #+PRIORITIES in the header).
+func orgPriorityCookie(priority int) string {
+	if priority < 0 {
+		priority = 0
+	}
+	if priority > 4 {
+		priority = 4
+	}
+	return fmt.Sprintf("[#%c]", 'A'+priority)
+}
+
+// orgTag converts a label into a valid org tag (letters, digits, _ @ # %).
+func orgTag(label string) string {
+	tag := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '_', r == '@', r == '#', r == '%':
+			return r
+		case r > 127 && !strings.ContainsRune(" \t:", r):
+			return r
+		}
+		return '_'
+	}, strings.TrimSpace(label))
+	return strings.Trim(tag, "_")
+}
+
+// orgDate formats an active date stamp, e.g. <2025-01-31 Fri>.
+func orgDate(t time.Time) string {
+	return "<" + t.Format("2006-01-02 Mon") + ">"
+}
+
+// orgTimestamp formats an inactive timestamp, e.g. [2025-01-31 Fri 14:05].
+func orgTimestamp(t time.Time) string {
+	return "[" + t.Format("2006-01-02 Mon 15:04") + "]"
+}
+
+// orgDependencyProperty names the property drawer key for a dependency type.
+func orgDependencyProperty(t model.DependencyType) string {
+	switch t {
+	case "", model.DepBlocks:
+		return "BLOCKED_BY"
+	case model.DepParentChild:
+		return "PARENT"
+	case model.DepRelated:
+		return "RELATED"
+	case model.DepDiscoveredFrom:
+		return "DISCOVERED_FROM"
+	default:
+		return strings.ToUpper(strings.NewReplacer("-", "_", " ", "_").Replace(string(t)))
+	}
+}
+
+// writeOrgText writes free text indented under a heading so that lines
+// beginning with '*' or '#+' are not parsed as headings or keywords.
+func writeOrgText(sb *strings.Builder, text string) {
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			sb.WriteString("\n")
+			continue
+		}
+		sb.WriteString("  " + line + "\n")
+	}
+}
+
+// writeOrgLogbook writes time entries as CLOCK lines in a :LOGBOOK:
+// drawer, newest first as org-mode keeps them. A running entry has no end.
+func writeOrgLogbook(sb *strings.Builder, entries []model.TimeEntry) {
+	if len(entries) == 0 {
+		return
+	}
+	sorted := make([]model.TimeEntry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(a, b int) bool { return sorted[a].Start.After(sorted[b].Start) })
+
+	sb.WriteString("  :LOGBOOK:\n")
+	for _, e := range sorted {
+		if e.End == nil {
+			sb.WriteString(fmt.Sprintf("  CLOCK: %s\n", orgTimestamp(e.Start)))
+			continue
+		}
+		minutes := int(e.Duration().Minutes())
+		sb.WriteString(fmt.Sprintf("  CLOCK: %s--%s => %2d:%02d\n", orgTimestamp(e.Start), orgTimestamp(*e.End), minutes/60, minutes%60))
+	}
+	sb.WriteString("  :END:\n")
+}
+
+// GenerateOrg creates an Emacs org-mode document with one heading per issue.
+// Status becomes a TODO keyword, priority a [#A]..[#E] cookie, labels become
+// tags, due dates become DEADLINE planning lines, and each property drawer
+// holds the issue ID (as CUSTOM_ID) plus [[#id]] links to its dependencies.
+// The issue model has no start or defer date, so no SCHEDULED lines are
+// emitted.
+func GenerateOrg(issues []model.Issue, title string) (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("#+TITLE: %s\n", title))
+	sb.WriteString(fmt.Sprintf("#+DATE: %s\n", orgTimestamp(time.Now())))
+	sb.WriteString(orgTodoLine + "\n")
+	sb.WriteString("#+PRIORITIES: A E C\n")
+	sb.WriteString("#+STARTUP: overview\n\n")
+
+	open, inProgress, blocked, closed := countByStatus(issues)
+	sb.WriteString(fmt.Sprintf("Total %d | Open %d | In Progress %d | Blocked %d | Closed %d\n\n",
+		len(issues), open, inProgress, blocked, closed))
+
+	known := make(map[string]bool, len(issues))
+	for _, i := range issues {
+		known[i.ID] = true
+	}
+
+	for _, i := range issues {
+		heading := fmt.Sprintf("* %s %s %s", orgTodoKeyword(i.Status), orgPriorityCookie(i.Priority), strings.Join(strings.Fields(i.Title), " "))
+		var tags []string
+		for _, l := range i.Labels {
+			if tag := orgTag(l); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		if len(tags) > 0 {
+			heading += " :" + strings.Join(tags, ":") + ":"
+		}
+		sb.WriteString(heading + "\n")
+
+		var planning []string
+		if i.ClosedAt != nil && isClosedLikeStatus(i.Status) {
+			planning = append(planning, "CLOSED: "+orgTimestamp(*i.ClosedAt))
+		}
+		if i.DueDate != nil {
+			planning = append(planning, "DEADLINE: "+orgDate(*i.DueDate))
+		}
+		if len(planning) > 0 {
+			sb.WriteString("  " + strings.Join(planning, " ") + "\n")
+		}
+
+		sb.WriteString("  :PROPERTIES:\n")
+		sb.WriteString(fmt.Sprintf("  :CUSTOM_ID: %s\n", i.ID))
+		sb.WriteString(fmt.Sprintf("  :STATUS: %s\n", i.Status))
+		if i.IssueType != "" {
+			sb.WriteString(fmt.Sprintf("  :TYPE: %s\n", i.IssueType))
+		}
+		if i.Assignee != "" {
+			sb.WriteString(fmt.Sprintf("  :ASSIGNEE: %s\n", i.Assignee))
+		}
+		if i.EstimatedMinutes != nil && *i.EstimatedMinutes > 0 {
+			sb.WriteString(fmt.Sprintf("  :EFFORT: %d:%02d\n", *i.EstimatedMinutes/60, *i.EstimatedMinutes%60))
+		}
+		if !i.CreatedAt.IsZero() {
+			sb.WriteString(fmt.Sprintf("  :CREATED: %s\n", orgTimestamp(i.CreatedAt)))
+		}
+
+		deps := make(map[string][]string)
+		var depKeys []string
+		for _, dep := range i.Dependencies {
+			if dep == nil || dep.DependsOnID == "" {
+				continue
+			}
+			key := orgDependencyProperty(dep.Type)
+			if _, ok := deps[key]; !ok {
+				depKeys = append(depKeys, key)
+			}
+			link := dep.DependsOnID
+			if known[dep.DependsOnID] {
+				link = fmt.Sprintf("[[#%s][%s]]", dep.DependsOnID, dep.DependsOnID)
+			}
+			deps[key] = append(deps[key], link)
+		}
+		sort.Strings(depKeys)
+		for _, key := range depKeys {
+			sb.WriteString(fmt.Sprintf("  :%s: %s\n", key, strings.Join(deps[key], " ")))
+		}
+		sb.WriteString("  :END:\n")
+		writeOrgLogbook(&sb, i.TimeEntries)
+
+		for _, section := range []struct{ name, text string }{
+			{"", i.Description},
+			{"Acceptance Criteria", i.AcceptanceCriteria},
+			{"Design", i.Design},
+			{"Notes", i.Notes},
+		} {
+			if strings.TrimSpace(section.text) == "" {
+				continue
+			}
+			sb.WriteString("\n")
+			if section.name != "" {
+				sb.WriteString(fmt.Sprintf("  /%s/\n\n", section.name))
+			}
+			writeOrgText(&sb, section.text)
+		}
+
+		if len(i.Comments) > 0 {
+			sb.WriteString("\n** Comments\n")
+			for _, c := range i.Comments {
+				if c == nil {
+					continue
+				}
+				sb.WriteString(fmt.Sprintf("   - %s %s ::\n", c.Author, orgTimestamp(c.CreatedAt)))
+				for _, line := range strings.Split(strings.TrimRight(c.Text, "\n"), "\n") {
+					sb.WriteString("     " + line + "\n")
+				}
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String(), nil
+}
+
+// SaveOrgToFile writes the issues as an org-mode document, open work first
+// and ordered by priority within each group.
+func SaveOrgToFile(issues []model.Issue, filename string) error {
+	issuesCopy := make([]model.Issue, len(issues))
+	copy(issuesCopy, issues)
+
+	sort.SliceStable(issuesCopy, func(i, j int) bool {
+		iClosed := isClosedLikeStatus(issuesCopy[i].Status)
+		jClosed := isClosedLikeStatus(issuesCopy[j].Status)
+		if iClosed != jClosed {
+			return !iClosed
+		}
+		if issuesCopy[i].Priority != issuesCopy[j].Priority {
+			return issuesCopy[i].Priority < issuesCopy[j].Priority
+		}
+		return issuesCopy[i].ID < issuesCopy[j].ID
+	})
+
+	content, err := GenerateOrg(issuesCopy, "Beads Export")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, []byte(content), 0644)
+}
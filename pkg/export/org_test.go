@@ -0,0 +1,94 @@
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestGenerateOrg(t *testing.T) {
+	due := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	closedAt := time.Date(2025, 2, 1, 9, 30, 0, 0, time.UTC)
+	est := 90
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Parser: handle BOM", Status: model.StatusBlocked, Priority: 0, IssueType: model.TypeBug,
+			Labels: []string{"parser", "needs review"}, DueDate: &due, EstimatedMinutes: &est,
+			Description: "First line\n* not a heading",
+			Dependencies: []*model.Dependency{
+				{IssueID: "bv-1", DependsOnID: "bv-2", Type: model.DepBlocks},
+				{IssueID: "bv-1", DependsOnID: "ext-9", Type: model.DepRelated},
+			}},
+		{ID: "bv-2", Title: "Loader", Status: model.StatusClosed, Priority: 2, IssueType: model.TypeTask, ClosedAt: &closedAt,
+			TimeEntries: []model.TimeEntry{
+				{Start: closedAt.Add(-26 * time.Hour), End: &closedAt},
+				{Start: closedAt},
+			}},
+	}
+
+	content, err := GenerateOrg(issues, "Beads")
+	if err != nil {
+		t.Fatalf("GenerateOrg: %v", err)
+	}
+	for _, want := range []string{
+		"#+TITLE: Beads\n",
+		orgTodoLine + "\n",
+		"#+PRIORITIES: A E C\n",
+		"* WAITING [#A] Parser: handle BOM :parser:needs_review:\n  DEADLINE: <2025-03-14 Fri>\n  :PROPERTIES:\n  :CUSTOM_ID: bv-1\n",
+		"  :EFFORT: 1:30\n",
+		"  :BLOCKED_BY: [[#bv-2][bv-2]]\n",
+		"  :RELATED: ext-9\n",
+		"  :END:\n\n  First line\n  * not a heading\n",
+		"* DONE [#C] Loader\n  CLOSED: [2025-02-01 Sat 09:30]\n",
+		"  :END:\n  :LOGBOOK:\n  CLOCK: [2025-02-01 Sat 09:30]\n  CLOCK: [2025-01-31 Fri 07:30]--[2025-02-01 Sat 09:30] => 26:00\n  :END:\n",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("org output missing %q:\n%s", want, content)
+		}
+	}
+	if strings.Contains(content, "SCHEDULED") {
+		t.Errorf("unexpected SCHEDULED line:\n%s", content)
+	}
+}
+
+func TestOrgTodoKeyword(t *testing.T) {
+	cases := map[model.Status]string{
+		model.StatusOpen:       "TODO",
+		model.StatusInProgress: "STARTED",
+		model.StatusBlocked:    "WAITING",
+		model.StatusDeferred:   "HOLD",
+		model.StatusReview:     "REVIEW",
+		model.StatusClosed:     "DONE",
+		model.StatusTombstone:  "CANCELLED",
+		"custom":               "TODO",
+	}
+	for status, want := range cases {
+		if got := orgTodoKeyword(status); got != want {
+			t.Errorf("orgTodoKeyword(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestSaveOrgToFile(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Title: "Done", Status: model.StatusClosed, Priority: 0},
+		{ID: "b", Title: "Later", Status: model.StatusOpen, Priority: 3},
+		{ID: "c", Title: "Now", Status: model.StatusOpen, Priority: 1},
+	}
+	path := filepath.Join(t.TempDir(), "beads.org")
+	if err := SaveOrgToFile(issues, path); err != nil {
+		t.Fatalf("SaveOrgToFile: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read org file: %v", err)
+	}
+	content := string(data)
+	now, later, done := strings.Index(content, "] Now"), strings.Index(content, "] Later"), strings.Index(content, "] Done")
+	if now < 0 || later < 0 || done < 0 || !(now < later && later < done) {
+		t.Errorf("expected open issues by priority, then closed:\n%s", content)
+	}
+}
@@ -0,0 +1,365 @@
+package export
+
+import "fmt"
+
+// Minimal QR Code encoder (ISO/IEC 18004) for provenance stamps on exports.
+// It supports byte mode at error-correction level M for versions 1-6, which
+// holds up to 106 bytes: enough for a data hash and a timestamp. Versions 7+
+// would also need version-information blocks and more alignment patterns.
+
+// qrVersionM describes one version at level M: total codewords, EC codewords
+// per block, and the number of (equal-size) blocks.
+type qrVersionM struct {
+	total, ecPerBlock, blocks int
+}
+
+var qrVersionsM = [...]qrVersionM{
+	1: {26, 10, 1},
+	2: {44, 16, 1},
+	3: {70, 26, 1},
+	4: {100, 18, 2},
+	5: {134, 24, 2},
+	6: {172, 16, 4},
+}
+
+// qrCode is a square matrix of modules; true is dark.
+type qrCode struct {
+	size     int
+	modules  [][]bool
+	function [][]bool // finder, timing, alignment and format modules
+}
+
+// dataCapacity is the number of data codewords for version v at level M.
+func (v qrVersionM) dataCapacity() int {
+	return v.total - v.ecPerBlock*v.blocks
+}
+
+// encodeQR encodes payload in byte mode using the smallest version that fits.
+func encodeQR(payload []byte) (*qrCode, error) {
+	version := 0
+	for v := 1; v < len(qrVersionsM); v++ {
+		// 4-bit mode indicator + 8-bit length fit in 2 codewords (rounded up)
+		if len(payload)+2 <= qrVersionsM[v].dataCapacity() {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("qr payload too long: %d bytes", len(payload))
+	}
+	info := qrVersionsM[version]
+
+	// Data bit stream: mode 0100, 8-bit count, bytes, terminator, padding.
+	capacity := info.dataCapacity()
+	var bits []bool
+	appendBits := func(val, n int) {
+		for i := n - 1; i >= 0; i-- {
+			bits = append(bits, (val>>uint(i))&1 == 1)
+		}
+	}
+	appendBits(0x4, 4)
+	appendBits(len(payload), 8)
+	for _, b := range payload {
+		appendBits(int(b), 8)
+	}
+	for i := 0; i < 4 && len(bits) < capacity*8; i++ {
+		bits = append(bits, false)
+	}
+	for len(bits)%8 != 0 {
+		bits = append(bits, false)
+	}
+	for pad := 0xEC; len(bits) < capacity*8; pad ^= 0xEC ^ 0x11 {
+		appendBits(pad, 8)
+	}
+	data := make([]byte, capacity)
+	for i, bit := range bits {
+		if bit {
+			data[i/8] |= 1 << uint(7-i%8)
+		}
+	}
+
+	codewords := qrInterleave(data, info)
+
+	size := 17 + 4*version
+	q := &qrCode{size: size, modules: make([][]bool, size), function: make([][]bool, size)}
+	for i := range q.modules {
+		q.modules[i] = make([]bool, size)
+		q.function[i] = make([]bool, size)
+	}
+	set := func(x, y int, dark bool) {
+		q.modules[y][x] = dark
+		q.function[y][x] = true
+	}
+
+	// Timing patterns, then finders and alignment (which overwrite them).
+	for i := 0; i < size; i++ {
+		set(6, i, i%2 == 0)
+		set(i, 6, i%2 == 0)
+	}
+	for _, c := range [][2]int{{3, 3}, {size - 4, 3}, {3, size - 4}} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := c[0]+dx, c[1]+dy
+				if x < 0 || x >= size || y < 0 || y >= size {
+					continue
+				}
+				d := qrMaxAbs(dx, dy)
+				set(x, y, d != 2 && d != 4)
+			}
+		}
+	}
+	if version >= 2 {
+		p := size - 7
+		for dy := -2; dy <= 2; dy++ {
+			for dx := -2; dx <= 2; dx++ {
+				set(p+dx, p+dy, qrMaxAbs(dx, dy) != 1)
+			}
+		}
+	}
+	q.drawFormat(set, 0) // reserve format areas; redrawn once the mask is chosen
+
+	// Codewords zigzag up and down two-column strips from the bottom right,
+	// skipping the vertical timing column.
+	i := 0
+	for right := size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < size; vert++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vert
+				if (right+1)&2 == 0 {
+					y = size - 1 - vert
+				}
+				if !q.function[y][x] && i < len(codewords)*8 {
+					q.modules[y][x] = codewords[i>>3]>>uint(7-i&7)&1 == 1
+					i++
+				}
+			}
+		}
+	}
+
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		q.applyMask(mask)
+		q.drawFormat(set, mask)
+		if p := q.penalty(); bestPenalty < 0 || p < bestPenalty {
+			best, bestPenalty = mask, p
+		}
+		q.applyMask(mask) // XOR again to undo
+	}
+	q.applyMask(best)
+	q.drawFormat(set, best)
+	return q, nil
+}
+
+// qrInterleave splits data into blocks, appends Reed-Solomon EC codewords and
+// interleaves the result column-wise as the standard requires.
+func qrInterleave(data []byte, info qrVersionM) []byte {
+	per := len(data) / info.blocks
+	divisor := qrRSDivisor(info.ecPerBlock)
+	var dataBlocks, ecBlocks [][]byte
+	for b := 0; b < info.blocks; b++ {
+		block := data[b*per : (b+1)*per]
+		dataBlocks = append(dataBlocks, block)
+		ecBlocks = append(ecBlocks, qrRSRemainder(block, divisor))
+	}
+	var out []byte
+	for i := 0; i < per; i++ {
+		for _, block := range dataBlocks {
+			out = append(out, block[i])
+		}
+	}
+	for i := 0; i < info.ecPerBlock; i++ {
+		for _, block := range ecBlocks {
+			out = append(out, block[i])
+		}
+	}
+	return out
+}
+
+// drawFormat writes both copies of the 15-bit format information (level M)
+// and the dark module.
+func (q *qrCode) drawFormat(set func(x, y int, dark bool), mask int) {
+	bits := qrFormatBits(mask)
+	bit := func(i int) bool { return (bits>>uint(i))&1 == 1 }
+	for i := 0; i <= 5; i++ {
+		set(8, i, bit(i))
+	}
+	set(8, 7, bit(6))
+	set(8, 8, bit(7))
+	set(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		set(14-i, 8, bit(i))
+	}
+	for i := 0; i < 8; i++ {
+		set(q.size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		set(8, q.size-15+i, bit(i))
+	}
+	set(8, q.size-8, true)
+}
+
+// qrFormatBits is the BCH(15,5)-protected, masked format word for level M.
+func qrFormatBits(mask int) int {
+	data := 0<<3 | mask // level M is 00
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+func (q *qrCode) applyMask(mask int) {
+	for y := 0; y < q.size; y++ {
+		for x := 0; x < q.size; x++ {
+			if q.function[y][x] {
+				continue
+			}
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert {
+				q.modules[y][x] = !q.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores a masked symbol with the four standard rules; lower is
+// easier for scanners.
+func (q *qrCode) penalty() int {
+	n := q.size
+	at := func(x, y int, vertical bool) bool {
+		if vertical {
+			return q.modules[x][y]
+		}
+		return q.modules[y][x]
+	}
+	score := 0
+	finderA := []bool{true, false, true, true, true, false, true, false, false, false, false}
+	finderB := []bool{false, false, false, false, true, false, true, true, true, false, true}
+	for _, vertical := range []bool{false, true} {
+		for y := 0; y < n; y++ {
+			run := 1
+			for x := 1; x <= n; x++ {
+				if x < n && at(x, y, vertical) == at(x-1, y, vertical) {
+					run++
+					continue
+				}
+				if run >= 5 {
+					score += 3 + run - 5
+				}
+				run = 1
+			}
+			for x := 0; x+len(finderA) <= n; x++ {
+				matchA, matchB := true, true
+				for k := range finderA {
+					m := at(x+k, y, vertical)
+					matchA = matchA && m == finderA[k]
+					matchB = matchB && m == finderB[k]
+				}
+				if matchA {
+					score += 40
+				}
+				if matchB {
+					score += 40
+				}
+			}
+		}
+	}
+	dark := 0
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			if q.modules[y][x] {
+				dark++
+			}
+			if x+1 < n && y+1 < n {
+				c := q.modules[y][x]
+				if q.modules[y][x+1] == c && q.modules[y+1][x] == c && q.modules[y+1][x+1] == c {
+					score += 3
+				}
+			}
+		}
+	}
+	percent := dark * 100 / (n * n)
+	dev := percent - 50
+	if dev < 0 {
+		dev = -dev
+	}
+	score += dev / 5 * 10
+	return score
+}
+
+// qrRSDivisor returns the Reed-Solomon generator polynomial of the given
+// degree (coefficients highest first, leading 1 omitted).
+func qrRSDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = qrGFMul(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = qrGFMul(root, 0x02)
+	}
+	return result
+}
+
+// qrRSRemainder computes the EC codewords for data.
+func qrRSRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coef := range divisor {
+			result[i] ^= qrGFMul(coef, factor)
+		}
+	}
+	return result
+}
+
+// qrGFMul multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1.
+func qrGFMul(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>uint(i))&1) * int(x)
+	}
+	return byte(z)
+}
+
+func qrMaxAbs(a, b int) int {
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	if a > b {
+		return a
+	}
+	return b
+}
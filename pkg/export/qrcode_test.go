@@ -0,0 +1,183 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestQRRSRemainder_KnownVector(t *testing.T) {
+	// "HELLO WORLD" at 1-M, the common worked example for QR encoders.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if got := qrRSRemainder(data, qrRSDivisor(10)); !bytes.Equal(got, want) {
+		t.Errorf("EC codewords = %v, want %v", got, want)
+	}
+}
+
+func TestQRFormatBits_KnownValues(t *testing.T) {
+	// Level M rows of the standard format information table.
+	want := map[int]int{
+		0: 0b101010000010010,
+		1: 0b101000100100101,
+		4: 0b100010111111001,
+		7: 0b100101010100000,
+	}
+	for mask, bits := range want {
+		if got := qrFormatBits(mask); got != bits {
+			t.Errorf("mask %d: format bits = %015b, want %015b", mask, got, bits)
+		}
+	}
+}
+
+// decodeQRForTest reads a symbol produced by encodeQR back into its payload:
+// format info, unmasking, codeword extraction, de-interleaving, EC check.
+func decodeQRForTest(t *testing.T, q *qrCode) []byte {
+	t.Helper()
+	version := (q.size - 17) / 4
+	info := qrVersionsM[version]
+
+	// First copy of the format information, matching drawFormat.
+	var coords [][2]int
+	for i := 0; i <= 5; i++ {
+		coords = append(coords, [2]int{8, i})
+	}
+	coords = append(coords, [2]int{8, 7}, [2]int{8, 8}, [2]int{7, 8})
+	for i := 9; i < 15; i++ {
+		coords = append(coords, [2]int{14 - i, 8})
+	}
+	format := 0
+	for i, c := range coords {
+		if q.modules[c[1]][c[0]] {
+			format |= 1 << uint(i)
+		}
+	}
+	second := 0
+	for i := 0; i < 8; i++ {
+		if q.modules[8][q.size-1-i] {
+			second |= 1 << uint(i)
+		}
+	}
+	for i := 8; i < 15; i++ {
+		if q.modules[q.size-15+i][8] {
+			second |= 1 << uint(i)
+		}
+	}
+	if format != second {
+		t.Fatalf("format copies differ: %015b vs %015b", format, second)
+	}
+	mask := -1
+	for m := 0; m < 8; m++ {
+		if qrFormatBits(m) == format {
+			mask = m
+		}
+	}
+	if mask < 0 {
+		t.Fatalf("format bits %015b are not a valid level-M word", format)
+	}
+
+	clone := &qrCode{size: q.size, modules: make([][]bool, q.size), function: q.function}
+	for y := range q.modules {
+		clone.modules[y] = append([]bool(nil), q.modules[y]...)
+	}
+	clone.applyMask(mask)
+
+	var raw []byte
+	var cur byte
+	n := 0
+	for right := q.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := 0; vert < q.size; vert++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vert
+				if (right+1)&2 == 0 {
+					y = q.size - 1 - vert
+				}
+				if q.function[y][x] || len(raw) == info.total {
+					continue
+				}
+				cur <<= 1
+				if clone.modules[y][x] {
+					cur |= 1
+				}
+				if n++; n == 8 {
+					raw = append(raw, cur)
+					cur, n = 0, 0
+				}
+			}
+		}
+	}
+	if len(raw) != info.total {
+		t.Fatalf("read %d codewords, want %d", len(raw), info.total)
+	}
+
+	per := info.dataCapacity() / info.blocks
+	var data []byte
+	for b := 0; b < info.blocks; b++ {
+		var block, ec []byte
+		for i := 0; i < per; i++ {
+			block = append(block, raw[i*info.blocks+b])
+		}
+		for i := 0; i < info.ecPerBlock; i++ {
+			ec = append(ec, raw[info.dataCapacity()+i*info.blocks+b])
+		}
+		if want := qrRSRemainder(block, qrRSDivisor(info.ecPerBlock)); !bytes.Equal(ec, want) {
+			t.Fatalf("block %d EC mismatch", b)
+		}
+		data = append(data, block...)
+	}
+
+	if data[0]>>4 != 0x4 {
+		t.Fatalf("mode = %x, want byte mode", data[0]>>4)
+	}
+	length := int(data[0]&0x0f)<<4 | int(data[1]>>4)
+	out := make([]byte, length)
+	for i := range out {
+		out[i] = data[1+i]<<4 | data[2+i]>>4
+	}
+	return out
+}
+
+func TestEncodeQR_RoundTrip(t *testing.T) {
+	for _, payload := range []string{
+		"a",
+		"bv data_hash=0123456789abcdef",
+		"bv data_hash=0123456789abcdef generated=2025-01-06T12:00:00Z",
+		strings.Repeat("x", 104),
+	} {
+		q, err := encodeQR([]byte(payload))
+		if err != nil {
+			t.Fatalf("encodeQR(%d bytes): %v", len(payload), err)
+		}
+		if got := string(decodeQRForTest(t, q)); got != payload {
+			t.Errorf("round trip = %q, want %q", got, payload)
+		}
+		// Finder pattern corners are dark, separators light.
+		for _, c := range [][2]int{{0, 0}, {q.size - 1, 0}, {0, q.size - 1}} {
+			if !q.modules[c[1]][c[0]] {
+				t.Errorf("finder corner %v should be dark", c)
+			}
+		}
+		if q.modules[7][7] {
+			t.Error("separator module (7,7) should be light")
+		}
+	}
+}
+
+func TestEncodeQR_VersionSelection(t *testing.T) {
+	cases := map[int]int{1: 21, 14: 21, 15: 25, 60: 33, 106: 41}
+	for n, size := range cases {
+		q, err := encodeQR(bytes.Repeat([]byte("z"), n))
+		if err != nil {
+			t.Fatalf("encodeQR(%d): %v", n, err)
+		}
+		if q.size != size {
+			t.Errorf("%d bytes -> size %d, want %d", n, q.size, size)
+		}
+	}
+	if _, err := encodeQR(bytes.Repeat([]byte("z"), 107)); err == nil {
+		t.Error("expected error for payload beyond version 6")
+	}
+}
@@ -0,0 +1,358 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// SiteOptions configures BuildSite.
+type SiteOptions struct {
+	Dir      string               // Output directory (created if missing)
+	Title    string               // Site title (default "Beads")
+	Issues   []model.Issue        // Issues to publish
+	Stats    *analysis.GraphStats // Optional; computed when nil
+	DataHash string               // Hash of input issues for provenance
+}
+
+// SiteSearchIndex is the prebuilt client-side search index (search-index.json).
+// Terms map to postings of [docIndex, weight] where weight is a tf-idf score
+// with a title boost, so the browser only has to sum postings per query term.
+type SiteSearchIndex struct {
+	Version int                     `json:"version"`
+	Docs    []SiteSearchDoc         `json:"docs"`
+	Terms   map[string][][2]float64 `json:"terms"`
+}
+
+// SiteSearchDoc is a search result entry.
+type SiteSearchDoc struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	URL    string `json:"url"`
+	Status string `json:"status"`
+}
+
+// siteTitleBoost weights title terms above body terms.
+const siteTitleBoost = 3.0
+
+// BuildSite generates a multi-page static website (index, one page per issue,
+// embedded SVG graph) with a prebuilt search index, suitable for GitHub Pages.
+func BuildSite(opts SiteOptions) error {
+	if opts.Dir == "" {
+		return fmt.Errorf("output directory is required")
+	}
+	if len(opts.Issues) == 0 {
+		return fmt.Errorf("no issues to export")
+	}
+	title := opts.Title
+	if strings.TrimSpace(title) == "" {
+		title = "Beads"
+	}
+	stats := opts.Stats
+	if stats == nil {
+		computed := analysis.NewAnalyzer(opts.Issues).Analyze()
+		stats = &computed
+	}
+
+	issues := make([]model.Issue, len(opts.Issues))
+	copy(issues, opts.Issues)
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].ID < issues[j].ID
+	})
+
+	pages := make(map[string]string, len(issues))
+	used := make(map[string]int, len(issues))
+	for _, iss := range issues {
+		pages[iss.ID] = "issues/" + uniqueSlug(treeSlug(iss.ID), used) + ".html"
+	}
+
+	var graph bytes.Buffer
+	layout := buildLayout(GraphSnapshotOptions{Issues: issues, Stats: stats, Title: title, DataHash: opts.DataHash})
+	if err := renderSVGToWriter(&graph, layout); err != nil {
+		return fmt.Errorf("render graph: %w", err)
+	}
+	svgMarkup := graph.String()
+	if idx := strings.Index(svgMarkup, "<svg"); idx > 0 {
+		svgMarkup = svgMarkup[idx:] // drop XML prolog for inline embedding
+	}
+
+	index, err := json.Marshal(BuildSiteSearchIndex(issues, pages))
+	if err != nil {
+		return fmt.Errorf("marshal search index: %w", err)
+	}
+
+	files := map[string]string{
+		"index.html":        generateSiteIndex(issues, title, opts.DataHash, pages, svgMarkup),
+		"search-index.json": string(index),
+		"search.js":         siteSearchJS,
+		"style.css":         siteCSS,
+		".nojekyll":         "",
+	}
+	for _, iss := range issues {
+		files[pages[iss.ID]] = generateSiteIssuePage(iss, title, pages)
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := writeTreeFile(opts.Dir, name, files[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BuildSiteSearchIndex builds the tf-idf search index for the given pages.
+func BuildSiteSearchIndex(issues []model.Issue, pages map[string]string) SiteSearchIndex {
+	idx := SiteSearchIndex{
+		Version: 1,
+		Docs:    make([]SiteSearchDoc, 0, len(issues)),
+		Terms:   make(map[string][][2]float64),
+	}
+
+	termFreqs := make([]map[string]float64, len(issues))
+	docFreq := make(map[string]int)
+	for d, iss := range issues {
+		idx.Docs = append(idx.Docs, SiteSearchDoc{
+			ID:     iss.ID,
+			Title:  iss.Title,
+			URL:    pages[iss.ID],
+			Status: string(iss.Status),
+		})
+
+		tf := make(map[string]float64)
+		for _, t := range siteTokenize(iss.ID + " " + iss.Title) {
+			tf[t] += siteTitleBoost
+		}
+		body := strings.Join([]string{iss.Description, iss.AcceptanceCriteria, iss.Design, iss.Notes, strings.Join(iss.Labels, " "), iss.Assignee}, " ")
+		for _, t := range siteTokenize(body) {
+			tf[t]++
+		}
+		termFreqs[d] = tf
+		for t := range tf {
+			docFreq[t]++
+		}
+	}
+
+	n := float64(len(issues))
+	for d, tf := range termFreqs {
+		for t, f := range tf {
+			idf := math.Log(1 + n/float64(docFreq[t]))
+			weight := math.Round((1+math.Log(f))*idf*1000) / 1000
+			idx.Terms[t] = append(idx.Terms[t], [2]float64{float64(d), weight})
+		}
+	}
+	return idx
+}
+
+// siteTokenize lowercases text and splits it into alphanumeric tokens. The
+// same rules are implemented in search.js so queries match indexed terms.
+func siteTokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	tokens := fields[:0]
+	for _, f := range fields {
+		if len([]rune(f)) >= 2 {
+			tokens = append(tokens, f)
+		}
+	}
+	return tokens
+}
+
+func generateSiteIndex(issues []model.Issue, title, dataHash string, pages map[string]string, svgMarkup string) string {
+	var sb strings.Builder
+	safeTitle := html.EscapeString(title)
+
+	sb.WriteString(siteHead(safeTitle, ""))
+	sb.WriteString(fmt.Sprintf("<header><h1>%s</h1>\n", safeTitle))
+	sb.WriteString(`<input id="search" type="search" placeholder="Search issues…" aria-label="Search issues" autocomplete="off">` + "\n")
+	sb.WriteString(`<ol id="results" aria-live="polite"></ol></header>` + "\n<main>\n")
+
+	open, inProgress, blocked, closed := countByStatus(issues)
+	sb.WriteString("<section class=\"summary\">\n")
+	for _, kv := range []struct {
+		label string
+		n     int
+	}{{"Total", len(issues)}, {"Open", open}, {"In Progress", inProgress}, {"Blocked", blocked}, {"Closed", closed}} {
+		sb.WriteString(fmt.Sprintf("<div><strong>%d</strong><span>%s</span></div>\n", kv.n, kv.label))
+	}
+	sb.WriteString("</section>\n")
+
+	sb.WriteString("<section class=\"graph\"><h2>Dependency Graph</h2>\n")
+	sb.WriteString(svgMarkup)
+	sb.WriteString("</section>\n")
+
+	sb.WriteString("<section><h2>Issues</h2>\n<table>\n<thead><tr><th>ID</th><th>Title</th><th>Status</th><th>Priority</th></tr></thead>\n<tbody>\n")
+	for _, i := range issues {
+		sb.WriteString(fmt.Sprintf("<tr class=\"status-%s\"><td><a href=\"%s\">%s</a></td><td>%s</td><td>%s</td><td>P%d</td></tr>\n",
+			html.EscapeString(string(i.Status)), html.EscapeString(pages[i.ID]), html.EscapeString(i.ID),
+			html.EscapeString(i.Title), html.EscapeString(string(i.Status)), i.Priority))
+	}
+	sb.WriteString("</tbody>\n</table>\n</section>\n</main>\n")
+
+	sb.WriteString(fmt.Sprintf("<footer>Generated %s", time.Now().Format("2006-01-02 15:04")))
+	if dataHash != "" {
+		sb.WriteString(fmt.Sprintf(" · Hash: %s", html.EscapeString(dataHash)))
+	}
+	sb.WriteString("</footer>\n<script src=\"search.js\"></script>\n</body>\n</html>\n")
+	return sb.String()
+}
+
+func generateSiteIssuePage(i model.Issue, siteTitle string, pages map[string]string) string {
+	var sb strings.Builder
+	self := pages[i.ID]
+
+	sb.WriteString(siteHead(html.EscapeString(i.ID+" "+i.Title+" | "+siteTitle), "../"))
+	sb.WriteString(fmt.Sprintf("<header><a href=\"%s\">← %s</a></header>\n<main>\n",
+		relativeTreeLink(self, "index.html"), html.EscapeString(siteTitle)))
+	sb.WriteString(fmt.Sprintf("<h1><code>%s</code> %s</h1>\n", html.EscapeString(i.ID), html.EscapeString(i.Title)))
+
+	sb.WriteString("<dl class=\"meta\">\n")
+	meta := [][2]string{
+		{"Status", string(i.Status)},
+		{"Priority", fmt.Sprintf("P%d", i.Priority)},
+		{"Type", string(i.IssueType)},
+	}
+	if i.Assignee != "" {
+		meta = append(meta, [2]string{"Assignee", i.Assignee})
+	}
+	if len(i.Labels) > 0 {
+		meta = append(meta, [2]string{"Labels", strings.Join(i.Labels, ", ")})
+	}
+	if !i.UpdatedAt.IsZero() {
+		meta = append(meta, [2]string{"Updated", i.UpdatedAt.Format("2006-01-02 15:04")})
+	}
+	for _, kv := range meta {
+		sb.WriteString(fmt.Sprintf("<dt>%s</dt><dd>%s</dd>\n", kv[0], html.EscapeString(kv[1])))
+	}
+	sb.WriteString("</dl>\n")
+
+	for _, sec := range []struct{ name, text string }{
+		{"Description", i.Description},
+		{"Acceptance Criteria", i.AcceptanceCriteria},
+		{"Design", i.Design},
+		{"Notes", i.Notes},
+	} {
+		if sec.text == "" {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("<h2>%s</h2>\n<pre class=\"text\">%s</pre>\n", sec.name, html.EscapeString(sec.text)))
+	}
+
+	if len(i.Dependencies) > 0 {
+		sb.WriteString("<h2>Dependencies</h2>\n<ul>\n")
+		for _, dep := range i.Dependencies {
+			if dep == nil {
+				continue
+			}
+			target := fmt.Sprintf("<code>%s</code>", html.EscapeString(dep.DependsOnID))
+			if page, ok := pages[dep.DependsOnID]; ok {
+				target = fmt.Sprintf("<a href=\"%s\">%s</a>", html.EscapeString(relativeTreeLink(self, page)), html.EscapeString(dep.DependsOnID))
+			}
+			sb.WriteString(fmt.Sprintf("<li><strong>%s</strong>: %s</li>\n", html.EscapeString(string(dep.Type)), target))
+		}
+		sb.WriteString("</ul>\n")
+	}
+
+	sb.WriteString("</main>\n</body>\n</html>\n")
+	return sb.String()
+}
+
+func siteHead(title, root string) string {
+	return fmt.Sprintf(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>%s</title>
+<link rel="stylesheet" href="%sstyle.css">
+</head>
+<body>
+`, title, root)
+}
+
+const siteCSS = `:root { --bg: #ffffff; --fg: #1f2937; --muted: #6b7280; --line: #e5e7eb; --accent: #6b80bf; }
+@media (prefers-color-scheme: dark) { :root { --bg: #111827; --fg: #f3f4f6; --muted: #9ca3af; --line: #374151; --accent: #8ea2e0; } }
+body { margin: 0 auto; max-width: 1100px; padding: 1rem 1.5rem; background: var(--bg); color: var(--fg); font: 15px/1.5 -apple-system, BlinkMacSystemFont, "Segoe UI", sans-serif; }
+a { color: var(--accent); }
+header { border-bottom: 1px solid var(--line); padding-bottom: 0.75rem; margin-bottom: 1rem; position: relative; }
+#search { width: 100%; padding: 0.5rem 0.75rem; font-size: 1rem; border: 1px solid var(--line); border-radius: 6px; background: var(--bg); color: var(--fg); }
+#results { list-style: none; padding: 0; margin: 0.25rem 0 0; }
+#results li { padding: 0.25rem 0; }
+.summary { display: flex; gap: 1rem; flex-wrap: wrap; }
+.summary div { border: 1px solid var(--line); border-radius: 8px; padding: 0.5rem 1rem; min-width: 6rem; }
+.summary strong { display: block; font-size: 1.5rem; }
+.summary span, footer, dt { color: var(--muted); }
+.graph { overflow-x: auto; }
+.graph svg { max-width: none; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; padding: 0.35rem 0.5rem; border-bottom: 1px solid var(--line); }
+tr.status-closed, tr.status-tombstone { color: var(--muted); }
+dl.meta { display: grid; grid-template-columns: max-content 1fr; gap: 0.25rem 1rem; }
+dd { margin: 0; }
+pre.text { white-space: pre-wrap; font-family: inherit; }
+footer { border-top: 1px solid var(--line); margin-top: 2rem; padding-top: 0.75rem; font-size: 0.85rem; }
+`
+
+// siteSearchJS queries search-index.json. Tokenization mirrors siteTokenize.
+const siteSearchJS = `(function () {
+  var input = document.getElementById('search');
+  var results = document.getElementById('results');
+  if (!input || !results) return;
+  var index = null;
+  fetch('search-index.json').then(function (r) { return r.json(); }).then(function (data) { index = data; run(); });
+
+  function tokenize(text) {
+    return text.toLowerCase().split(/[^\p{L}\p{N}]+/u).filter(function (t) { return t.length >= 2; });
+  }
+
+  function run() {
+    results.innerHTML = '';
+    if (!index) return;
+    var terms = tokenize(input.value);
+    if (terms.length === 0) return;
+    var scores = {};
+    var hits = {};
+    terms.forEach(function (term) {
+      Object.keys(index.terms).forEach(function (key) {
+        if (key.indexOf(term) !== 0) return; // prefix match
+        var exact = key === term ? 1 : 0.5;
+        index.terms[key].forEach(function (p) {
+          scores[p[0]] = (scores[p[0]] || 0) + p[1] * exact;
+          hits[p[0]] = hits[p[0]] || {};
+          hits[p[0]][term] = true;
+        });
+      });
+    });
+    Object.keys(scores)
+      .filter(function (d) { return Object.keys(hits[d]).length === terms.length; })
+      .sort(function (a, b) { return scores[b] - scores[a]; })
+      .slice(0, 20)
+      .forEach(function (d) {
+        var doc = index.docs[d];
+        var li = document.createElement('li');
+        var a = document.createElement('a');
+        a.href = doc.url;
+        a.textContent = doc.id + ' ' + doc.title;
+        li.appendChild(a);
+        li.appendChild(document.createTextNode(' (' + doc.status + ')'));
+        results.appendChild(li);
+      });
+  }
+
+  input.addEventListener('input', run);
+})();
+`
@@ -0,0 +1,98 @@
+package export
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestBuildSite(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Parser handles BOM", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeBug,
+			Description: "Strip the byte order mark before decoding.",
+			Dependencies: []*model.Dependency{
+				{IssueID: "bv-1", DependsOnID: "bv-2", Type: model.DepBlocks},
+			}},
+		{ID: "bv-2", Title: "Loader refactor", Status: model.StatusClosed, Priority: 2, IssueType: model.TypeTask},
+	}
+
+	dir := t.TempDir()
+	if err := BuildSite(SiteOptions{Dir: dir, Title: "Demo <Site>", Issues: issues, DataHash: "abc123"}); err != nil {
+		t.Fatalf("BuildSite: %v", err)
+	}
+
+	for _, name := range []string{"index.html", "search-index.json", "search.js", "style.css", ".nojekyll", "issues/bv-1.html", "issues/bv-2.html"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("missing %s: %v", name, err)
+		}
+	}
+
+	index, err := os.ReadFile(filepath.Join(dir, "index.html"))
+	if err != nil {
+		t.Fatalf("read index: %v", err)
+	}
+	content := string(index)
+	if !strings.Contains(content, "<svg") || strings.Contains(content, "<?xml") {
+		t.Error("expected inline SVG without XML prolog")
+	}
+	for _, want := range []string{"<h1>Demo &lt;Site&gt;</h1>", `href="issues/bv-1.html"`, `id="search"`, "abc123"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("index.html missing %q", want)
+		}
+	}
+
+	page, err := os.ReadFile(filepath.Join(dir, "issues", "bv-1.html"))
+	if err != nil {
+		t.Fatalf("read issue page: %v", err)
+	}
+	for _, want := range []string{`href="../index.html"`, `href="../style.css"`, `<a href="bv-2.html">bv-2</a>`, "byte order mark"} {
+		if !strings.Contains(string(page), want) {
+			t.Errorf("issue page missing %q", want)
+		}
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dir, "search-index.json"))
+	if err != nil {
+		t.Fatalf("read search index: %v", err)
+	}
+	var idx SiteSearchIndex
+	if err := json.Unmarshal(raw, &idx); err != nil {
+		t.Fatalf("unmarshal search index: %v", err)
+	}
+	if len(idx.Docs) != 2 || idx.Docs[0].URL != "issues/bv-1.html" {
+		t.Fatalf("unexpected docs: %+v", idx.Docs)
+	}
+	if postings := idx.Terms["parser"]; len(postings) != 1 || postings[0][0] != 0 {
+		t.Errorf("expected 'parser' to index doc 0, got %v", postings)
+	}
+	if len(idx.Terms["byte"]) != 1 {
+		t.Error("expected description terms to be indexed")
+	}
+}
+
+func TestBuildSiteSearchIndex_TitleBoost(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Title: "cache eviction"},
+		{ID: "b", Title: "metrics", Description: "cache hit rate"},
+	}
+	idx := BuildSiteSearchIndex(issues, map[string]string{"a": "issues/a.html", "b": "issues/b.html"})
+
+	weights := map[float64]float64{}
+	for _, p := range idx.Terms["cache"] {
+		weights[p[0]] = p[1]
+	}
+	if weights[0] <= weights[1] {
+		t.Errorf("title match should outrank body match: %v", weights)
+	}
+}
+
+func TestSiteTokenize(t *testing.T) {
+	got := strings.Join(siteTokenize("Fix: UTF-8 BOM in a_b, x"), ",")
+	if got != "fix,utf,bom,in" {
+		t.Errorf("siteTokenize = %q", got)
+	}
+}
@@ -246,8 +246,8 @@ class GraphStore {
 
         // Heatmap & metrics mode
         this.heatmapMode = false;
-        this.sizeMetric = 'pagerank'; // pagerank | betweenness | critical | indegree
-        this.maxMetrics = { pagerank: 1, betweenness: 1, critical: 1, indegree: 1 };
+        this.sizeMetric = 'pagerank'; // pagerank | betweenness | critical | indegree | hub | authority
+        this.maxMetrics = { pagerank: 1, betweenness: 1, critical: 1, indegree: 1, hub: 1, authority: 1 };
 
         // Filters
         this.filters = {
@@ -332,6 +332,8 @@ function computeMaxMetrics() {
     store.maxMetrics.betweenness = Math.max(...nodes.map(n => n.betweenness || 0), 0.001);
     store.maxMetrics.critical = Math.max(...nodes.map(n => n.criticalDepth || 0), 1);
     store.maxMetrics.indegree = Math.max(...nodes.map(n => n.blockerCount || 0), 1);
+    store.maxMetrics.hub = Math.max(...nodes.map(n => n.hub || 0), 0.001);
+    store.maxMetrics.authority = Math.max(...nodes.map(n => n.authority || 0), 0.001);
 }
 
 /**
@@ -357,6 +359,11 @@ function getHeatmapColor(node) {
             val = node.blockerCount || 0;
             max = store.maxMetrics.indegree;
             break;
+        case 'hub':
+        case 'authority':
+            val = node[store.sizeMetric] || 0;
+            max = store.maxMetrics[store.sizeMetric];
+            break;
     }
     const ratio = Math.min(val / max, 1);
     const hue = 120 - ratio * 120; // Green (120) to Red (0)
@@ -420,7 +427,7 @@ export function toggleHeatmap() {
  * Set the size/heatmap metric
  */
 export function setSizeMetric(metric) {
-    if (['pagerank', 'betweenness', 'critical', 'indegree'].includes(metric)) {
+    if (['pagerank', 'betweenness', 'critical', 'indegree', 'hub', 'authority'].includes(metric)) {
         store.sizeMetric = metric;
         refreshGraph();
         dispatchEvent('metricChange', { metric: store.sizeMetric });
@@ -1026,6 +1033,8 @@ function prepareGraphData(layout = null) {
             criticalDepth: idx !== undefined && metrics.criticalPath ? metrics.criticalPath[idx] : 0,
             eigenvector: idx !== undefined && metrics.eigenvector ? metrics.eigenvector[idx] : 0,
             kcore: idx !== undefined && metrics.kcore ? metrics.kcore[idx] : 0,
+            hub: idx !== undefined && metrics.hits ? metrics.hits.hub[idx] : 0,
+            authority: idx !== undefined && metrics.hits ? metrics.hits.authority[idx] : 0,
             inCycle: pre?.inCycle ?? false,
 
             // Dependency counts (prefer pre-computed)
@@ -1059,6 +1068,12 @@ function prepareGraphData(layout = null) {
 function getNodeSize(node) {
     const { nodeMinSize, nodeMaxSize } = store.config;
 
+    // HITS sizing: hubs depend on many things, authorities are depended on
+    if (store.sizeMetric === 'hub' || store.sizeMetric === 'authority') {
+        const score = Math.min((node[store.sizeMetric] || 0) / store.maxMetrics[store.sizeMetric], 1);
+        return nodeMinSize + score * (nodeMaxSize - nodeMinSize);
+    }
+
     // Use PageRank for sizing (normalized 0-1)
     let score = node.pagerank || 0;
 
@@ -2893,10 +2908,10 @@ function showTooltip(node) {
     if (node.kcore !== undefined && isFinite(node.kcore)) {
         extendedMetrics.push(`<span title="Cluster cohesion level">K-core: ${node.kcore}</span>`);
     }
-    if (node.hitsHub !== undefined || node.hitsAuth !== undefined) {
-        const hub = safeMetric(node.hitsHub !== undefined ? node.hitsHub * 100 : undefined, 0);
-        const auth = safeMetric(node.hitsAuth !== undefined ? node.hitsAuth * 100 : undefined, 0);
-        extendedMetrics.push(`<span title="Hub/Authority scores">HITS: ${hub}/${auth}</span>`);
+    if (node.hub || node.authority) {
+        const hub = safeMetric(node.hub * 100, 1);
+        const auth = safeMetric(node.authority * 100, 1);
+        extendedMetrics.push(`<span title="HITS hub (depends on key issues) / authority (key issues depend on it)">Hub/Auth: ${hub}/${auth}</span>`);
     }
     if (node.eigenvector !== undefined && isFinite(node.eigenvector)) {
         extendedMetrics.push(`<span title="Influence centrality">Eigen: ${safeMetric(node.eigenvector * 100, 1, '%')}</span>`);
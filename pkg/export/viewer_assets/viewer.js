@@ -3321,7 +3321,9 @@ function beadsApp() {
         pagerank: 'PageRank',
         betweenness: 'Betweenness Centrality',
         critical: 'Critical Path Depth',
-        indegree: 'In-Degree (Blockers)'
+        indegree: 'In-Degree (Blockers)',
+        hub: 'HITS Hub (depends on many)',
+        authority: 'HITS Authority (depended on)'
       };
       showToast(`Metric: ${metricLabels[metric] || metric}`, 'info');
     },
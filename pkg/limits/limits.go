@@ -0,0 +1,144 @@
+// Package limits holds the resource guardrails that keep bv predictable on
+// very large projects: how many goroutines fan-out work may use, how much
+// memory a single artifact is estimated to need, and how many nodes a graph
+// may have before bv stops drawing it automatically.
+//
+// Limits come from the environment (BV_MAX_WORKERS, BV_MAX_MEMORY_MB,
+// BV_MAX_RENDER_NODES) and can be overridden by command-line flags. Work that
+// would exceed them fails fast with an *ExceededError naming the limit and
+// how to narrow the input, instead of exhausting the machine.
+package limits
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Environment variables read by LoadEnv
+const (
+	EnvMaxWorkers     = "BV_MAX_WORKERS"
+	EnvMaxMemoryMB    = "BV_MAX_MEMORY_MB"
+	EnvMaxRenderNodes = "BV_MAX_RENDER_NODES"
+)
+
+// Limits are the guardrails. A zero field means no limit.
+type Limits struct {
+	MaxWorkers     int // goroutines any one fan-out (layout, export, analysis) may use
+	MaxMemoryMB    int // largest estimated allocation for a single artifact, e.g. a PNG canvas
+	MaxRenderNodes int // largest graph drawn without being asked to focus (snapshots, Mermaid)
+}
+
+// Default returns the built-in limits. Workers are left to each caller's own
+// cap; memory and node limits sit well above what renders usefully anyway.
+func Default() Limits {
+	return Limits{
+		MaxMemoryMB:    2048,
+		MaxRenderNodes: 2000,
+	}
+}
+
+var (
+	mu      sync.RWMutex
+	current = Default()
+)
+
+// Current returns the limits in effect
+func Current() Limits {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// Set replaces the limits in effect and returns a func restoring the old ones.
+func Set(l Limits) (restore func()) {
+	mu.Lock()
+	prev := current
+	current = l
+	mu.Unlock()
+	return func() { Set(prev) }
+}
+
+// LoadEnv applies the BV_MAX_* environment variables on top of the current
+// limits. Invalid values are skipped and reported together in the error.
+func LoadEnv() error {
+	l := Current()
+	var bad []string
+	for _, v := range []struct {
+		name string
+		dst  *int
+	}{
+		{EnvMaxWorkers, &l.MaxWorkers},
+		{EnvMaxMemoryMB, &l.MaxMemoryMB},
+		{EnvMaxRenderNodes, &l.MaxRenderNodes},
+	} {
+		raw := strings.TrimSpace(os.Getenv(v.name))
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			bad = append(bad, fmt.Sprintf("%s=%q", v.name, raw))
+			continue
+		}
+		*v.dst = n
+	}
+	Set(l)
+	if len(bad) > 0 {
+		return fmt.Errorf("ignoring invalid limits (want a non-negative integer): %s", strings.Join(bad, ", "))
+	}
+	return nil
+}
+
+// Workers caps a caller's preferred goroutine count at MaxWorkers (at least 1)
+func (l Limits) Workers(want int) int {
+	if l.MaxWorkers > 0 && want > l.MaxWorkers {
+		want = l.MaxWorkers
+	}
+	if want < 1 {
+		want = 1
+	}
+	return want
+}
+
+// CheckNodes fails when drawing n nodes would exceed MaxRenderNodes.
+// what names the artifact; hint says how to focus the input.
+func (l Limits) CheckNodes(what string, n int, hint string) error {
+	if l.MaxRenderNodes > 0 && n > l.MaxRenderNodes {
+		return &ExceededError{What: what, Need: int64(n), Max: int64(l.MaxRenderNodes), Unit: "nodes", Setting: EnvMaxRenderNodes, Hint: hint}
+	}
+	return nil
+}
+
+// CheckMemory fails when an allocation estimated at bytes would exceed MaxMemoryMB.
+func (l Limits) CheckMemory(what string, bytes int64, hint string) error {
+	if l.MaxMemoryMB > 0 && bytes > int64(l.MaxMemoryMB)<<20 {
+		return &ExceededError{What: what, Need: (bytes + 1<<20 - 1) >> 20, Max: int64(l.MaxMemoryMB), Unit: "MB", Setting: EnvMaxMemoryMB, Hint: hint}
+	}
+	return nil
+}
+
+// RasterBytes estimates the memory of a w×h RGBA canvas
+func RasterBytes(w, h int) int64 {
+	return int64(w) * int64(h) * 4
+}
+
+// ExceededError reports work refused by a guardrail
+type ExceededError struct {
+	What    string // the artifact, e.g. "graph snapshot"
+	Need    int64  // estimated requirement, in Unit
+	Max     int64  // the limit, in Unit
+	Unit    string // "nodes" or "MB"
+	Setting string // environment variable that raises the limit
+	Hint    string // how to shrink the input instead
+}
+
+func (e *ExceededError) Error() string {
+	msg := fmt.Sprintf("%s needs %d %s, over the limit of %d (raise with %s)", e.What, e.Need, e.Unit, e.Max, e.Setting)
+	if e.Hint != "" {
+		msg += "; " + e.Hint
+	}
+	return msg
+}
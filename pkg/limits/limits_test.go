@@ -0,0 +1,54 @@
+package limits
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLoadEnv(t *testing.T) {
+	defer Set(Default())
+	t.Setenv(EnvMaxWorkers, "3")
+	t.Setenv(EnvMaxMemoryMB, "lots")
+	t.Setenv(EnvMaxRenderNodes, "0")
+
+	err := LoadEnv()
+	if err == nil || !strings.Contains(err.Error(), EnvMaxMemoryMB) {
+		t.Fatalf("expected an error naming %s, got %v", EnvMaxMemoryMB, err)
+	}
+	got := Current()
+	if got.MaxWorkers != 3 || got.MaxRenderNodes != 0 || got.MaxMemoryMB != Default().MaxMemoryMB {
+		t.Fatalf("limits = %+v", got)
+	}
+}
+
+func TestChecks(t *testing.T) {
+	l := Limits{MaxWorkers: 4, MaxMemoryMB: 10, MaxRenderNodes: 100}
+	if l.Workers(16) != 4 || l.Workers(2) != 2 || l.Workers(0) != 1 || (Limits{}).Workers(16) != 16 {
+		t.Error("Workers should cap at MaxWorkers and never go below 1")
+	}
+
+	if err := l.CheckNodes("graph", 100, ""); err != nil {
+		t.Errorf("at the limit should pass: %v", err)
+	}
+	err := l.CheckNodes("graph snapshot", 101, "filter by label")
+	var exceeded *ExceededError
+	if !errors.As(err, &exceeded) || exceeded.Unit != "nodes" {
+		t.Fatalf("expected ExceededError, got %v", err)
+	}
+	for _, want := range []string{"graph snapshot needs 101 nodes", "limit of 100", EnvMaxRenderNodes, "filter by label"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q missing %q", err, want)
+		}
+	}
+
+	if err := l.CheckMemory("png", RasterBytes(1000, 1000), ""); err != nil {
+		t.Errorf("4 MB canvas should fit in 10 MB: %v", err)
+	}
+	if err := l.CheckMemory("png", RasterBytes(4000, 1000), ""); err == nil || !strings.Contains(err.Error(), "needs 16 MB") {
+		t.Errorf("16 MB canvas should be refused, got %v", err)
+	}
+	if err := (Limits{}).CheckNodes("graph", 1<<30, ""); err != nil {
+		t.Errorf("zero limits mean unlimited: %v", err)
+	}
+}
@@ -0,0 +1,294 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// IssueEdit is the set of fields the TUI edits on an issue. All of them are
+// written; an empty Assignee or Labels removes the field.
+type IssueEdit struct {
+	Status   model.Status
+	Priority int
+	Assignee string
+	Labels   []string
+}
+
+// editMu serializes read-modify-write cycles on beads files, so two edits
+// saved in quick succession don't overwrite each other.
+var editMu sync.Mutex
+
+// UpdateIssueInFile applies edit to the issue with the given ID in a beads
+// JSONL file and stamps updated_at with now. Closing an issue sets
+// closed_at if it is missing; reopening removes it.
+//
+// Only the issue's own line is rewritten, and fields bv does not model are
+// kept as they are, so the file stays valid for bd. The write is atomic
+// (temp file + rename).
+func UpdateIssueInFile(path, id string, edit IssueEdit, now time.Time) error {
+	return UpdateIssuesInFile(path, map[string]IssueEdit{id: edit}, now)
+}
+
+// UpdateIssuesInFile applies several edits, keyed by issue ID, in a single
+// write, the way UpdateIssueInFile applies one. Nothing is written unless
+// every issue is found.
+func UpdateIssuesInFile(path string, edits map[string]IssueEdit, now time.Time) error {
+	for id, edit := range edits {
+		if !edit.Status.IsValid() {
+			return fmt.Errorf("invalid status %q for issue %s", edit.Status, id)
+		}
+	}
+
+	return rewriteIssues(path, edits, func(id string, fields map[string]json.RawMessage) error {
+		return applyIssueEdit(fields, edits[id], now)
+	})
+}
+
+// UpdateTimeEntriesInFile replaces the time_entries of the issues keyed by
+// ID, removing the field when an issue's list is empty. updated_at is left
+// alone: logging work on an issue doesn't change it. Nothing is written
+// unless every issue is found.
+func UpdateTimeEntriesInFile(path string, entries map[string][]model.TimeEntry) error {
+	return rewriteIssues(path, entries, func(id string, fields map[string]json.RawMessage) error {
+		if len(entries[id]) == 0 {
+			delete(fields, "time_entries")
+			return nil
+		}
+		raw, err := json.Marshal(entries[id])
+		if err != nil {
+			return err
+		}
+		fields["time_entries"] = raw
+		return nil
+	})
+}
+
+// rewriteIssues runs update on the raw fields of each issue keyed in ids
+// and writes the file back atomically. Only those issues' lines change.
+func rewriteIssues[V any](path string, ids map[string]V, update func(id string, fields map[string]json.RawMessage) error) error {
+	editMu.Lock()
+	defer editMu.Unlock()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	lines := bytes.Split(data, []byte("\n"))
+	done := make(map[string]bool, len(ids))
+	for i, line := range lines {
+		if len(done) == len(ids) {
+			break
+		}
+		content := bytes.TrimRight(line, "\r")
+		suffix := line[len(content):] // Keep CRLF line endings
+		var prefix []byte
+		if i == 0 && bytes.HasPrefix(content, []byte{0xEF, 0xBB, 0xBF}) {
+			prefix, content = content[:3], content[3:]
+		}
+		if !mentionsAny(content, ids) {
+			continue
+		}
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(content, &fields); err != nil {
+			continue // Malformed lines are skipped on load too
+		}
+		var id string
+		if err := json.Unmarshal(fields["id"], &id); err != nil {
+			continue
+		}
+		if _, ok := ids[id]; !ok || done[id] {
+			continue
+		}
+
+		if err := update(id, fields); err != nil {
+			return fmt.Errorf("failed to update issue %s: %w", id, err)
+		}
+		updated, err := encodeIssueFields(fields)
+		if err != nil {
+			return fmt.Errorf("failed to encode issue %s: %w", id, err)
+		}
+		out := make([]byte, 0, len(prefix)+len(updated)+len(suffix))
+		out = append(out, prefix...)
+		out = append(out, updated...)
+		lines[i] = append(out, suffix...)
+		done[id] = true
+	}
+	if len(done) < len(ids) {
+		var missing []string
+		for id := range ids {
+			if !done[id] {
+				missing = append(missing, id)
+			}
+		}
+		sort.Strings(missing)
+		return fmt.Errorf("issue %s not found in %s", strings.Join(missing, ", "), path)
+	}
+
+	return writeFileAtomic(path, bytes.Join(lines, []byte("\n")))
+}
+
+// AppendIssueToFile adds a new issue as the last line of a beads JSONL
+// file. The file's line endings are kept, and nothing is written if the
+// issue is invalid or its ID is already taken. The write is atomic.
+func AppendIssueToFile(path string, issue model.Issue) error {
+	if err := issue.Validate(); err != nil {
+		return fmt.Errorf("invalid issue: %w", err)
+	}
+
+	editMu.Lock()
+	defer editMu.Unlock()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	ids := map[string]bool{issue.ID: true}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimPrefix(bytes.TrimRight(line, "\r"), []byte{0xEF, 0xBB, 0xBF})
+		if !mentionsAny(line, ids) {
+			continue
+		}
+		var existing struct {
+			ID string `json:"id"`
+		}
+		if json.Unmarshal(line, &existing) == nil && existing.ID == issue.ID {
+			return fmt.Errorf("issue %s already exists in %s", issue.ID, path)
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(issue); err != nil {
+		return fmt.Errorf("failed to encode issue %s: %w", issue.ID, err)
+	}
+	line := bytes.TrimRight(buf.Bytes(), "\n")
+
+	eol := []byte("\n")
+	if bytes.Contains(data, []byte("\r\n")) {
+		eol = []byte("\r\n")
+	}
+	out := make([]byte, 0, len(data)+len(line)+2*len(eol))
+	out = append(out, data...)
+	if len(out) > 0 && !bytes.HasSuffix(out, []byte("\n")) {
+		out = append(out, eol...)
+	}
+	out = append(out, line...)
+	out = append(out, eol...)
+	return writeFileAtomic(path, out)
+}
+
+// mentionsAny is a cheap check that a line may hold one of the edited
+// issues, so the rest of the file isn't parsed
+func mentionsAny[V any](content []byte, ids map[string]V) bool {
+	for id := range ids {
+		if bytes.Contains(content, []byte(id)) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyIssueEdit sets the edited fields on an issue's raw JSON fields
+func applyIssueEdit(fields map[string]json.RawMessage, edit IssueEdit, now time.Time) error {
+	set := func(key string, v any) error {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		fields[key] = raw
+		return nil
+	}
+
+	var prevStatus model.Status
+	_ = json.Unmarshal(fields["status"], &prevStatus)
+	if err := set("status", edit.Status); err != nil {
+		return err
+	}
+	if err := set("priority", edit.Priority); err != nil {
+		return err
+	}
+	if edit.Assignee != "" {
+		if err := set("assignee", edit.Assignee); err != nil {
+			return err
+		}
+	} else {
+		delete(fields, "assignee")
+	}
+	if len(edit.Labels) > 0 {
+		if err := set("labels", edit.Labels); err != nil {
+			return err
+		}
+	} else {
+		delete(fields, "labels")
+	}
+
+	switch {
+	case edit.Status.IsClosed() && !prevStatus.IsClosed():
+		if _, ok := fields["closed_at"]; !ok {
+			if err := set("closed_at", now); err != nil {
+				return err
+			}
+		}
+	case !edit.Status.IsClosed():
+		delete(fields, "closed_at")
+	}
+	return set("updated_at", now)
+}
+
+// encodeIssueFields marshals an issue line without escaping HTML characters,
+// so untouched text fields keep their bytes
+func encodeIssueFields(fields map[string]json.RawMessage) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(fields); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// writeFileAtomic replaces path with data through a temp file in the same
+// directory, keeping the file's permissions
+func writeFileAtomic(path string, data []byte) error {
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpName := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to close temp file: %w", err)
+	}
+	if err := os.Chmod(tmpName, mode); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to set permissions: %w", err)
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to rename temp file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestUpdateIssueInFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	original := `{"id":"bv-1","title":"Keep <this> & that","status":"open","priority":2,"issue_type":"task","assignee":"bob","design":"not modeled","created_at":"2024-01-01T00:00:00Z"}` + "\r\n" +
+		`{"id":"bv-10","title":"Other","status":"open","priority":1,"issue_type":"bug","labels":["ui"],"created_at":"2024-01-01T00:00:00Z"}` + "\r\n"
+	if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	edit := IssueEdit{Status: model.StatusClosed, Priority: 0, Labels: []string{"backend", "auth"}}
+	if err := UpdateIssueInFile(path, "bv-1", edit, now); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(data), "\r\n")
+	if len(lines) != 3 || lines[2] != "" {
+		t.Fatalf("line endings should be kept, got %q", data)
+	}
+	if !strings.HasPrefix(string(data[len(lines[0])+2:]), `{"id":"bv-10","title":"Other"`) {
+		t.Errorf("other issues should be untouched:\n%s", data)
+	}
+	for _, want := range []string{`"design":"not modeled"`, `"title":"Keep <this> & that"`, `"closed_at":"2024-06-01T12:00:00Z"`, `"updated_at":"2024-06-01T12:00:00Z"`} {
+		if !strings.Contains(lines[0], want) {
+			t.Errorf("edited line missing %s:\n%s", want, lines[0])
+		}
+	}
+	if strings.Contains(lines[0], "assignee") {
+		t.Errorf("empty assignee should remove the field:\n%s", lines[0])
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("permissions should be kept, got %v", info.Mode())
+	}
+
+	issues, err := LoadIssuesFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := issues[0]
+	if got.Status != model.StatusClosed || got.Priority != 0 || got.Assignee != "" ||
+		strings.Join(got.Labels, ",") != "backend,auth" || got.ClosedAt == nil {
+		t.Errorf("reloaded issue = %+v", got)
+	}
+
+	// Reopening clears closed_at
+	edit.Status = model.StatusInProgress
+	if err := UpdateIssueInFile(path, "bv-1", edit, now); err != nil {
+		t.Fatal(err)
+	}
+	if issues, _ := LoadIssuesFromFile(path); issues[0].ClosedAt != nil {
+		t.Error("reopening should clear closed_at")
+	}
+}
+
+func TestUpdateIssueInFileErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	if err := os.WriteFile(path, []byte(`{"id":"bv-1","title":"A","status":"open"}`+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := UpdateIssueInFile(path, "bv-2", IssueEdit{Status: model.StatusOpen}, time.Now()); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("missing issue should fail, got %v", err)
+	}
+	if err := UpdateIssueInFile(path, "bv-1", IssueEdit{Status: "done"}, time.Now()); err == nil {
+		t.Error("invalid status should fail")
+	}
+	if err := UpdateIssueInFile(filepath.Join(t.TempDir(), "missing.jsonl"), "bv-1", IssueEdit{Status: model.StatusOpen}, time.Now()); err == nil {
+		t.Error("missing file should fail")
+	}
+}
+
+func TestUpdateIssuesInFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	original := `{"id":"bv-1","title":"A","status":"open","priority":2,"issue_type":"task"}` + "\n" +
+		`{"id":"bv-2","title":"B","status":"open","priority":2,"issue_type":"task"}` + "\n" +
+		`{"id":"bv-3","title":"C","status":"open","priority":2,"issue_type":"task"}` + "\n"
+	if err := os.WriteFile(path, []byte(original), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	edits := map[string]IssueEdit{
+		"bv-1": {Status: model.StatusBlocked, Priority: 1, Labels: []string{"api"}},
+		"bv-3": {Status: model.StatusBlocked, Priority: 1},
+	}
+	if err := UpdateIssuesInFile(path, edits, now); err != nil {
+		t.Fatal(err)
+	}
+	issues, err := LoadIssuesFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 3 {
+		t.Fatalf("loaded %d issues, want 3", len(issues))
+	}
+	for _, issue := range issues {
+		edited := issue.ID != "bv-2"
+		if got := issue.Status == model.StatusBlocked && issue.Priority == 1; got != edited {
+			t.Errorf("%s: status %s, p%d", issue.ID, issue.Status, issue.Priority)
+		}
+	}
+
+	// A missing issue fails the whole batch
+	edits["bv-9"] = IssueEdit{Status: model.StatusOpen}
+	edits["bv-1"] = IssueEdit{Status: model.StatusClosed}
+	if err := UpdateIssuesInFile(path, edits, now); err == nil || !strings.Contains(err.Error(), "bv-9 not found") {
+		t.Errorf("missing issue should fail, got %v", err)
+	}
+	if issues, _ := LoadIssuesFromFile(path); issues[0].Status != model.StatusBlocked {
+		t.Errorf("failed batch should not write, bv-1 is %s", issues[0].Status)
+	}
+}
+
+func TestAppendIssueToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	original := `{"id":"bv-1","title":"A","status":"open","priority":2,"issue_type":"task","design":"not modeled"}` + "\r\n" +
+		`{"id":"bv-2","title":"B","status":"open","priority":2,"issue_type":"task"}`
+	if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	issue := model.Issue{
+		ID: "bv-3", Title: "Follow up <soon>", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeBug,
+		Labels:       []string{"ui"},
+		Dependencies: []*model.Dependency{{IssueID: "bv-3", DependsOnID: "bv-1", Type: model.DepBlocks, CreatedAt: now}},
+		CreatedAt:    now, UpdatedAt: now,
+	}
+	if err := AppendIssueToFile(path, issue); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), original+"\r\n") || !strings.HasSuffix(string(data), "}\r\n") {
+		t.Errorf("existing lines and line endings should be kept:\n%q", data)
+	}
+	if !strings.Contains(string(data), `"title":"Follow up <soon>"`) {
+		t.Errorf("title should not be HTML-escaped:\n%s", data)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("permissions should be kept, got %v", info.Mode())
+	}
+
+	issues, err := LoadIssuesFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 3 {
+		t.Fatalf("loaded %d issues, want 3", len(issues))
+	}
+	got := issues[2]
+	if got.ID != "bv-3" || got.Priority != 1 || got.IssueType != model.TypeBug ||
+		len(got.Dependencies) != 1 || got.Dependencies[0].DependsOnID != "bv-1" {
+		t.Errorf("reloaded issue = %+v", got)
+	}
+
+	// Taken IDs and invalid issues are refused without writing
+	if err := AppendIssueToFile(path, issue); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("duplicate ID should fail, got %v", err)
+	}
+	issue.ID, issue.Title = "bv-4", ""
+	if err := AppendIssueToFile(path, issue); err == nil {
+		t.Error("issue without a title should fail")
+	}
+	if after, _ := os.ReadFile(path); string(after) != string(data) {
+		t.Error("failed appends should not write")
+	}
+}
+
+func TestUpdateTimeEntriesInFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.jsonl")
+	original := `{"id":"bv-1","title":"A","status":"open","priority":2,"issue_type":"task","updated_at":"2024-01-01T00:00:00Z"}` + "\n" +
+		`{"id":"bv-2","title":"B","status":"open","priority":2,"issue_type":"task","time_entries":[{"start":"2024-05-01T09:00:00Z"}]}` + "\n"
+	if err := os.WriteFile(path, []byte(original), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(45 * time.Minute)
+	entries := map[string][]model.TimeEntry{
+		"bv-1": {{Start: start, End: &end, Author: "alice"}},
+		"bv-2": nil,
+	}
+	if err := UpdateTimeEntriesInFile(path, entries); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"updated_at":"2024-01-01T00:00:00Z"`) {
+		t.Errorf("logging time should not stamp updated_at:\n%s", data)
+	}
+	if strings.Count(string(data), "time_entries") != 1 {
+		t.Errorf("an empty list should remove the field:\n%s", data)
+	}
+	issues, err := LoadIssuesFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := issues[0].LoggedTime(); got != 45*time.Minute || issues[0].TimeEntries[0].Author != "alice" {
+		t.Errorf("reloaded entries = %+v", issues[0].TimeEntries)
+	}
+
+	if err := UpdateTimeEntriesInFile(path, map[string][]model.TimeEntry{"bv-9": nil}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("missing issue should fail, got %v", err)
+	}
+}
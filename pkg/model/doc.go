@@ -0,0 +1,22 @@
+// Package model defines the issue, dependency and planning types shared by
+// the loader, analysis, export and UI packages.
+//
+// Importers that build issues from other trackers should start from
+// NewIssue, call Dependency.Normalize on every edge, and run Lint over the
+// finished slice before handing it to the rest of bv. The invariants below
+// are what downstream code relies on; Lint reports every violation instead of
+// letting it surface later as a panic or a silently wrong graph.
+//
+//   - Issue.ID is non-empty and unique within a project.
+//   - Issue.Title is non-empty.
+//   - Issue.Status is one of the Status constants; IssueType is non-empty
+//     (unknown types are allowed and shown with a default icon).
+//   - Issue.Priority is between 0 (critical) and 4 (backlog).
+//   - UpdatedAt is not before CreatedAt when both are set.
+//   - Dependencies are non-nil, their IssueID is the owning issue's ID, and
+//     DependsOnID names a different issue. An empty Dependency.Type is read
+//     as DepBlocks for compatibility with older data.
+//   - Dependencies whose DependsOnID is not in the slice are tolerated (the
+//     graph ignores them) but reported, since they usually mean a partial
+//     import.
+package model
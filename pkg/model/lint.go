@@ -0,0 +1,151 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Priority bounds. Lower numbers are more urgent.
+const (
+	PriorityCritical = 0
+	PriorityBacklog  = 4
+)
+
+// NewIssue returns an open task with the given ID and title, default priority
+// and both timestamps set to now. Callers fill in the remaining fields.
+func NewIssue(id, title string) Issue {
+	now := time.Now()
+	return Issue{
+		ID:        strings.TrimSpace(id),
+		Title:     strings.TrimSpace(title),
+		Status:    StatusOpen,
+		Priority:  2,
+		IssueType: TypeTask,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Normalize puts the dependency into canonical form for its owning issue:
+// IDs are trimmed, IssueID defaults to owner, and the type is lowercased with
+// underscores spelled as hyphens ("parent_child" -> "parent-child"). An empty
+// type becomes DepBlocks, which is how it is already interpreted.
+func (d *Dependency) Normalize(owner string) {
+	d.IssueID = strings.TrimSpace(d.IssueID)
+	if d.IssueID == "" {
+		d.IssueID = strings.TrimSpace(owner)
+	}
+	d.DependsOnID = strings.TrimSpace(d.DependsOnID)
+	t := strings.ToLower(strings.TrimSpace(string(d.Type)))
+	t = strings.ReplaceAll(t, "_", "-")
+	if t == "" {
+		t = string(DepBlocks)
+	}
+	d.Type = DependencyType(t)
+}
+
+// Validate checks if the dependency is well-formed on its own. Whether the
+// target exists is checked by Lint, which sees the whole issue set.
+func (d *Dependency) Validate() error {
+	if d.DependsOnID == "" {
+		return fmt.Errorf("depends_on_id cannot be empty")
+	}
+	if d.IssueID != "" && d.IssueID == d.DependsOnID {
+		return fmt.Errorf("issue %s cannot depend on itself", d.IssueID)
+	}
+	if d.Type != "" && !d.Type.IsValid() {
+		return fmt.Errorf("invalid dependency type: %s", d.Type)
+	}
+	return nil
+}
+
+// LintSeverity ranks a LintProblem.
+type LintSeverity string
+
+const (
+	// LintError marks data that downstream code cannot use as-is.
+	LintError LintSeverity = "error"
+	// LintWarning marks data that loads but is probably not what was meant.
+	LintWarning LintSeverity = "warning"
+)
+
+// LintProblem is one invariant violation found by Lint.
+type LintProblem struct {
+	IssueID  string       `json:"issue_id,omitempty"`
+	Severity LintSeverity `json:"severity"`
+	Message  string       `json:"message"`
+}
+
+func (p LintProblem) String() string {
+	if p.IssueID == "" {
+		return fmt.Sprintf("%s: %s", p.Severity, p.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", p.Severity, p.IssueID, p.Message)
+}
+
+// Lint checks issues against the invariants documented in the package
+// comment and returns every violation, in input order. It never modifies
+// issues and never panics on malformed input.
+func Lint(issues []Issue) []LintProblem {
+	var problems []LintProblem
+	add := func(id string, sev LintSeverity, format string, args ...any) {
+		problems = append(problems, LintProblem{IssueID: id, Severity: sev, Message: fmt.Sprintf(format, args...)})
+	}
+
+	known := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		if issue.ID != "" {
+			known[issue.ID] = true
+		}
+	}
+
+	seen := make(map[string]bool, len(issues))
+	for idx := range issues {
+		issue := &issues[idx]
+		id := issue.ID
+		if err := issue.Validate(); err != nil {
+			add(id, LintError, "%v", err)
+		}
+		if id != "" {
+			if seen[id] {
+				add(id, LintError, "duplicate issue ID")
+			}
+			seen[id] = true
+		}
+		if issue.Priority < PriorityCritical || issue.Priority > PriorityBacklog {
+			add(id, LintWarning, "priority %d outside %d-%d", issue.Priority, PriorityCritical, PriorityBacklog)
+		}
+
+		for n, dep := range issue.Dependencies {
+			if dep == nil {
+				add(id, LintError, "dependency %d is nil", n)
+				continue
+			}
+			if err := dep.Validate(); err != nil {
+				add(id, LintError, "dependency %d: %v", n, err)
+				continue
+			}
+			if dep.IssueID != "" && dep.IssueID != id {
+				add(id, LintError, "dependency %d belongs to %s", n, dep.IssueID)
+			}
+			if dep.DependsOnID == id {
+				add(id, LintError, "dependency %d: issue cannot depend on itself", n)
+			}
+			if !known[dep.DependsOnID] {
+				add(id, LintWarning, "depends on unknown issue %s", dep.DependsOnID)
+			}
+		}
+	}
+	return problems
+}
+
+// HasLintErrors reports whether any problem is an error rather than a warning.
+func HasLintErrors(problems []LintProblem) bool {
+	for _, p := range problems {
+		if p.Severity == LintError {
+			return true
+		}
+	}
+	return false
+}
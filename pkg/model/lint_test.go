@@ -0,0 +1,79 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewIssue_IsValid(t *testing.T) {
+	issue := NewIssue("  bv-1 ", " Title ")
+	if issue.ID != "bv-1" || issue.Title != "Title" {
+		t.Fatalf("ID/Title not trimmed: %q %q", issue.ID, issue.Title)
+	}
+	if err := issue.Validate(); err != nil {
+		t.Fatalf("NewIssue().Validate() = %v", err)
+	}
+	if problems := Lint([]Issue{issue}); len(problems) != 0 {
+		t.Fatalf("Lint(NewIssue) = %v, want none", problems)
+	}
+}
+
+func TestDependency_Normalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Dependency
+		want Dependency
+	}{
+		{"fills owner and default type", Dependency{DependsOnID: " bv-2 "}, Dependency{IssueID: "bv-1", DependsOnID: "bv-2", Type: DepBlocks}},
+		{"keeps explicit issue", Dependency{IssueID: "bv-9", DependsOnID: "bv-2", Type: DepRelated}, Dependency{IssueID: "bv-9", DependsOnID: "bv-2", Type: DepRelated}},
+		{"canonical type spelling", Dependency{DependsOnID: "bv-2", Type: " Parent_Child "}, Dependency{IssueID: "bv-1", DependsOnID: "bv-2", Type: DepParentChild}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.in
+			got.Normalize("bv-1")
+			if got != tt.want {
+				t.Errorf("Normalize() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLint_ReportsViolations(t *testing.T) {
+	a := NewIssue("bv-1", "A")
+	a.Dependencies = []*Dependency{
+		nil,
+		{IssueID: "bv-1", DependsOnID: "bv-1"},
+		{DependsOnID: "bv-missing"},
+		{IssueID: "bv-1", DependsOnID: "bv-2", Type: "bogus"},
+		{IssueID: "bv-2", DependsOnID: "bv-2"},
+	}
+	b := NewIssue("bv-2", "B")
+	b.Priority = 7
+	dup := NewIssue("bv-2", "")
+
+	problems := Lint([]Issue{a, b, dup})
+	wants := []string{
+		"error: bv-1: dependency 0 is nil",
+		"error: bv-1: dependency 1: issue bv-1 cannot depend on itself",
+		"warning: bv-1: depends on unknown issue bv-missing",
+		"error: bv-1: dependency 3: invalid dependency type: bogus",
+		"error: bv-1: dependency 4: issue bv-2 cannot depend on itself",
+		"warning: bv-2: priority 7 outside 0-4",
+		"error: bv-2: issue title cannot be empty",
+		"error: bv-2: duplicate issue ID",
+	}
+	var got []string
+	for _, p := range problems {
+		got = append(got, p.String())
+	}
+	if strings.Join(got, "\n") != strings.Join(wants, "\n") {
+		t.Errorf("Lint() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(wants, "\n"))
+	}
+	if !HasLintErrors(problems) {
+		t.Error("HasLintErrors() = false, want true")
+	}
+	if HasLintErrors(Lint([]Issue{b})) {
+		t.Error("priority warning alone should not count as an error")
+	}
+}
@@ -31,6 +31,7 @@ type Issue struct {
 	Labels             []string      `json:"labels,omitempty"`
 	Dependencies       []*Dependency `json:"dependencies,omitempty"`
 	Comments           []*Comment    `json:"comments,omitempty"`
+	TimeEntries        []TimeEntry   `json:"time_entries,omitempty"`
 	SourceRepo         string        `json:"source_repo,omitempty"`
 }
 
@@ -88,9 +89,39 @@ func (i Issue) Clone() Issue {
 		}
 	}
 
+	if i.TimeEntries != nil {
+		clone.TimeEntries = make([]TimeEntry, len(i.TimeEntries))
+		for idx, entry := range i.TimeEntries {
+			clone.TimeEntries[idx] = entry
+			if entry.End != nil {
+				v := *entry.End
+				clone.TimeEntries[idx].End = &v
+			}
+		}
+	}
+
 	return clone
 }
 
+// LoggedTime is the work logged on the issue in finished time entries
+func (i Issue) LoggedTime() time.Duration {
+	var total time.Duration
+	for _, e := range i.TimeEntries {
+		total += e.Duration()
+	}
+	return total
+}
+
+// RunningTimer returns the time entry still being logged, or nil
+func (i Issue) RunningTimer() *TimeEntry {
+	for idx := range i.TimeEntries {
+		if i.TimeEntries[idx].End == nil {
+			return &i.TimeEntries[idx]
+		}
+	}
+	return nil
+}
+
 // Validate checks if the issue data is logically valid
 func (i *Issue) Validate() error {
 	if i.ID == "" {
@@ -189,6 +220,22 @@ type Dependency struct {
 	CreatedBy   string         `json:"created_by"`
 }
 
+// TimeEntry is a stretch of work logged on an issue. End is nil while the
+// timer is running.
+type TimeEntry struct {
+	Start  time.Time  `json:"start"`
+	End    *time.Time `json:"end,omitempty"`
+	Author string     `json:"author,omitempty"`
+}
+
+// Duration is the length of a finished entry; a running one counts as 0
+func (e TimeEntry) Duration() time.Duration {
+	if e.End == nil || e.End.Before(e.Start) {
+		return 0
+	}
+	return e.End.Sub(e.Start)
+}
+
 // IssueMetrics holds computed metrics for export/robot consumers.
 type IssueMetrics struct {
 	PageRank          float64 `json:"pagerank,omitempty"`
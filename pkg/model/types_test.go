@@ -714,3 +714,35 @@ func TestIssue_Clone_NilFields(t *testing.T) {
 		t.Errorf("Comments should be nil")
 	}
 }
+
+func TestIssue_TimeEntries(t *testing.T) {
+	start := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(90 * time.Minute)
+	later := start.Add(3 * time.Hour)
+	original := Issue{
+		ID: "TEST-1",
+		TimeEntries: []TimeEntry{
+			{Start: start, End: &end, Author: "alice"},
+			{Start: later},
+		},
+	}
+
+	if got := original.LoggedTime(); got != 90*time.Minute {
+		t.Errorf("LoggedTime = %v, want 1h30m (running entries don't count)", got)
+	}
+	if running := original.RunningTimer(); running == nil || !running.Start.Equal(later) {
+		t.Errorf("RunningTimer = %+v, want the entry started at %v", running, later)
+	}
+
+	clone := original.Clone()
+	*clone.TimeEntries[0].End = later
+	clone.TimeEntries[1].Start = end
+	if !original.TimeEntries[0].End.Equal(end) || !original.TimeEntries[1].Start.Equal(later) {
+		t.Error("modifying the clone's time entries affected the original")
+	}
+
+	backwards := TimeEntry{Start: later, End: &end}
+	if backwards.Duration() != 0 {
+		t.Errorf("an entry ending before it starts should count as 0, got %v", backwards.Duration())
+	}
+}
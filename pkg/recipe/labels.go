@@ -0,0 +1,233 @@
+package recipe
+
+import (
+	"strings"
+	"sync"
+)
+
+// LabelExpr is a parsed label expression: labels combined with AND, OR and
+// NOT and grouped with parentheses, such as
+//
+//	backend AND (urgent OR regression) AND NOT wontfix
+//
+// NOT binds tightest, then AND, then OR; labels written side by side are
+// ANDed. The keywords are case-insensitive. Double quotes keep a label with
+// spaces or parentheses, or one named like a keyword, in one piece
+// ("needs review", "or"). Labels are compared case-insensitively.
+type LabelExpr struct {
+	op    labelOp
+	label string       // For labelHas
+	args  []*LabelExpr // One for labelNot, two or more for labelAnd and labelOr
+}
+
+type labelOp int
+
+const (
+	labelHas labelOp = iota
+	labelNot
+	labelAnd
+	labelOr
+)
+
+// ParseLabelExpr parses a label expression. Errors are QueryErrors naming
+// the token the parse stopped at.
+func ParseLabelExpr(expr string) (*LabelExpr, error) {
+	tokens, err := lexLabelExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, &QueryError{Msg: "empty label expression"}
+	}
+	p := labelParser{tokens: tokens}
+	e, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, &QueryError{Term: p.tokens[p.pos].text, Msg: "unexpected here"}
+	}
+	return e, nil
+}
+
+// Match reports whether an issue with labels satisfies the expression
+func (e *LabelExpr) Match(labels []string) bool {
+	switch e.op {
+	case labelNot:
+		return !e.args[0].Match(labels)
+	case labelAnd:
+		for _, a := range e.args {
+			if !a.Match(labels) {
+				return false
+			}
+		}
+		return true
+	case labelOr:
+		for _, a := range e.args {
+			if a.Match(labels) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, l := range labels {
+		if strings.EqualFold(l, e.label) {
+			return true
+		}
+	}
+	return false
+}
+
+// labelExprs caches parsed expressions for MatchLabelExpr, by source
+var labelExprs sync.Map
+
+// MatchLabelExpr reports whether labels satisfy the expression expr. The
+// filters call it once per issue, so parses are cached. An expression that
+// doesn't parse matches nothing; the recipe loader warns about those.
+func MatchLabelExpr(expr string, labels []string) bool {
+	if cached, ok := labelExprs.Load(expr); ok {
+		e, _ := cached.(*LabelExpr)
+		return e != nil && e.Match(labels)
+	}
+	e, err := ParseLabelExpr(expr)
+	if err != nil {
+		e = nil
+	}
+	labelExprs.Store(expr, e)
+	return e != nil && e.Match(labels)
+}
+
+// labelToken is a word, a keyword or a parenthesis of a label expression
+type labelToken struct {
+	text   string
+	quoted bool
+}
+
+// keyword reports whether t is the unquoted keyword kw
+func (t labelToken) keyword(kw string) bool {
+	return !t.quoted && strings.EqualFold(t.text, kw)
+}
+
+// lexLabelExpr splits an expression into parentheses, quoted labels and
+// words
+func lexLabelExpr(expr string) ([]labelToken, error) {
+	var tokens []labelToken
+	for i := 0; i < len(expr); {
+		switch c := expr[i]; {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, labelToken{text: string(c)})
+			i++
+		case c == '"':
+			end := strings.IndexByte(expr[i+1:], '"')
+			if end < 0 {
+				return nil, &QueryError{Term: expr[i:], Msg: "unterminated quote"}
+			}
+			tokens = append(tokens, labelToken{text: expr[i+1 : i+1+end], quoted: true})
+			i += end + 2
+		default:
+			end := i
+			for end < len(expr) && !strings.ContainsRune(" \t\n()\"", rune(expr[end])) {
+				end++
+			}
+			tokens = append(tokens, labelToken{text: expr[i:end]})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+// labelParser is a recursive descent parser over the tokens:
+//
+//	or  = and { OR and }
+//	and = not { [AND] not }
+//	not = NOT not | "(" or ")" | label
+type labelParser struct {
+	tokens []labelToken
+	pos    int
+}
+
+func (p *labelParser) peek() (labelToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return labelToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *labelParser) or() (*LabelExpr, error) {
+	first, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	args := []*LabelExpr{first}
+	for t, ok := p.peek(); ok && t.keyword("OR"); t, ok = p.peek() {
+		p.pos++
+		next, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, next)
+	}
+	if len(args) == 1 {
+		return first, nil
+	}
+	return &LabelExpr{op: labelOr, args: args}, nil
+}
+
+func (p *labelParser) and() (*LabelExpr, error) {
+	first, err := p.not()
+	if err != nil {
+		return nil, err
+	}
+	args := []*LabelExpr{first}
+	for {
+		t, ok := p.peek()
+		if !ok || t.keyword("OR") || (!t.quoted && t.text == ")") {
+			break
+		}
+		if t.keyword("AND") {
+			p.pos++
+		}
+		next, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, next)
+	}
+	if len(args) == 1 {
+		return first, nil
+	}
+	return &LabelExpr{op: labelAnd, args: args}, nil
+}
+
+func (p *labelParser) not() (*LabelExpr, error) {
+	t, ok := p.peek()
+	if !ok {
+		return nil, &QueryError{Msg: "label expression ends early"}
+	}
+	switch {
+	case t.keyword("NOT"):
+		p.pos++
+		arg, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return &LabelExpr{op: labelNot, args: []*LabelExpr{arg}}, nil
+	case !t.quoted && t.text == "(":
+		p.pos++
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if c, ok := p.peek(); !ok || c.quoted || c.text != ")" {
+			return nil, &QueryError{Term: "(", Msg: "missing )"}
+		}
+		p.pos++
+		return e, nil
+	case t.keyword("AND") || t.keyword("OR") || (!t.quoted && t.text == ")"):
+		return nil, &QueryError{Term: t.text, Msg: "expected a label"}
+	}
+	p.pos++
+	return &LabelExpr{op: labelHas, label: t.text}, nil
+}
@@ -0,0 +1,101 @@
+package recipe_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+)
+
+func TestLabelExprMatch(t *testing.T) {
+	tests := []struct {
+		expr   string
+		labels []string
+		want   bool
+	}{
+		{"backend", []string{"backend"}, true},
+		{"backend", []string{"Backend", "ui"}, true},
+		{"backend", []string{"frontend"}, false},
+		{"backend AND (urgent OR regression) AND NOT wontfix", []string{"backend", "regression"}, true},
+		{"backend AND (urgent OR regression) AND NOT wontfix", []string{"backend", "urgent", "wontfix"}, false},
+		{"backend AND (urgent OR regression) AND NOT wontfix", []string{"backend"}, false},
+		// AND binds tighter than OR, NOT tighter than both
+		{"a OR b AND c", []string{"a"}, true},
+		{"a OR b AND c", []string{"b"}, false},
+		{"NOT a AND b", []string{"b"}, true},
+		{"NOT (a AND b)", []string{"a"}, true},
+		{"not not a", []string{"a"}, true},
+		// Side by side means AND; quotes keep spaces and keywords
+		{"backend urgent", []string{"backend"}, false},
+		{`"needs review" or "or"`, []string{"or"}, true},
+		{`"needs review"`, []string{"needs review"}, true},
+		{"NOT wontfix", nil, true},
+	}
+	for _, tt := range tests {
+		e, err := recipe.ParseLabelExpr(tt.expr)
+		if err != nil {
+			t.Errorf("ParseLabelExpr(%q): %v", tt.expr, err)
+			continue
+		}
+		if got := e.Match(tt.labels); got != tt.want {
+			t.Errorf("%q on %v = %v, want %v", tt.expr, tt.labels, got, tt.want)
+		}
+		if got := recipe.MatchLabelExpr(tt.expr, tt.labels); got != tt.want {
+			t.Errorf("MatchLabelExpr(%q) on %v = %v, want %v", tt.expr, tt.labels, got, tt.want)
+		}
+	}
+}
+
+func TestLabelExprErrors(t *testing.T) {
+	tests := map[string]string{
+		"":                   "empty",
+		"a AND":              "ends early",
+		"(a OR b":            "missing )",
+		"a) b":               ")",
+		"OR a":               "OR",
+		"a AND AND b":        "AND",
+		`a OR "needs review`: "unterminated",
+	}
+	for expr, want := range tests {
+		_, err := recipe.ParseLabelExpr(expr)
+		var qe *recipe.QueryError
+		if !errors.As(err, &qe) || !strings.Contains(err.Error(), want) {
+			t.Errorf("ParseLabelExpr(%q) error = %v, want one mentioning %q", expr, err, want)
+		}
+	}
+	if recipe.MatchLabelExpr("(a", []string{"a"}) {
+		t.Error("an expression that doesn't parse should match nothing")
+	}
+}
+
+func TestLoaderRejectsBadLabelExpr(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recipes.yaml")
+	config := `
+recipes:
+  hot-backend:
+    filters:
+      labels: "backend AND (urgent OR regression)"
+  broken:
+    filters:
+      labels: "backend AND (urgent"
+`
+	if err := os.WriteFile(path, []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loader := recipe.NewLoader(recipe.WithUserPath(path), recipe.WithProjectDir(""))
+	if err := loader.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if r := loader.Get("hot-backend"); r == nil || r.Filters.Labels != "backend AND (urgent OR regression)" {
+		t.Errorf("hot-backend = %+v", r)
+	}
+	if loader.Get("broken") != nil {
+		t.Error("a recipe whose labels don't parse should not load")
+	}
+	if w := loader.Warnings(); len(w) != 1 || !strings.Contains(w[0], "broken") {
+		t.Errorf("warnings = %v", w)
+	}
+}
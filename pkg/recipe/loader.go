@@ -1,6 +1,7 @@
 package recipe
 
 import (
+	"bytes"
 	"embed"
 	"fmt"
 	"os"
@@ -15,9 +16,13 @@ var defaultRecipesFS embed.FS
 
 // RecipeFile represents the structure of a recipes YAML file
 type RecipeFile struct {
-	Recipes map[string]*Recipe `yaml:"recipes"`
+	Recipes  map[string]*Recipe   `yaml:"recipes"`
+	Searches map[int]*SavedSearch `yaml:"searches,omitempty"` // Keyed by number key, 1-9
 }
 
+// MaxSearchSlot is the highest number key a saved search can be bound to
+const MaxSearchSlot = 9
+
 // RecipeSummary is a lightweight representation for discovery
 type RecipeSummary struct {
 	Name        string `json:"name"`
@@ -29,6 +34,7 @@ type RecipeSummary struct {
 type Loader struct {
 	recipes    map[string]Recipe
 	sources    map[string]string // recipe name -> source
+	searches   map[int]SavedSearch
 	userPath   string
 	projectDir string
 	warnings   []string
@@ -54,8 +60,9 @@ func WithProjectDir(dir string) LoaderOption {
 // NewLoader creates a new recipe loader with options
 func NewLoader(opts ...LoaderOption) *Loader {
 	l := &Loader{
-		recipes: make(map[string]Recipe),
-		sources: make(map[string]string),
+		recipes:  make(map[string]Recipe),
+		sources:  make(map[string]string),
+		searches: make(map[int]SavedSearch),
 	}
 
 	for _, opt := range opts {
@@ -77,6 +84,30 @@ func NewLoader(opts ...LoaderOption) *Loader {
 	return l
 }
 
+// UserPath returns the user recipes file
+func (l *Loader) UserPath() string {
+	return l.userPath
+}
+
+// ProjectPath returns the project recipes file, .bv/recipes.yaml in the
+// project directory
+func (l *Loader) ProjectPath() string {
+	if l.projectDir == "" {
+		return ""
+	}
+	return filepath.Join(l.projectDir, ".bv", "recipes.yaml")
+}
+
+// Reload drops the loaded recipes and loads them again, picking up edits
+// to the recipe files
+func (l *Loader) Reload() error {
+	l.recipes = make(map[string]Recipe)
+	l.sources = make(map[string]string)
+	l.searches = make(map[int]SavedSearch)
+	l.warnings = nil
+	return l.Load()
+}
+
 // Load loads recipes from all sources in order: builtin < user < project
 func (l *Loader) Load() error {
 	// 1. Load embedded defaults
@@ -95,8 +126,7 @@ func (l *Loader) Load() error {
 	}
 
 	// 3. Load project config (optional, no error if missing)
-	if l.projectDir != "" {
-		projectPath := filepath.Join(l.projectDir, ".bv", "recipes.yaml")
+	if projectPath := l.ProjectPath(); projectPath != "" {
 		if err := l.loadFromFile(projectPath, "project"); err != nil {
 			if !os.IsNotExist(err) {
 				l.warnings = append(l.warnings, fmt.Sprintf("project config: %v", err))
@@ -150,14 +180,60 @@ func (l *Loader) loadFromFile(path, source string) error {
 			delete(l.sources, name)
 			continue
 		}
+		if lo, hi := recipe.Filters.PriorityMin, recipe.Filters.PriorityMax; lo != nil && hi != nil && *lo > *hi {
+			l.warnings = append(l.warnings, fmt.Sprintf("%s: recipe %s: priority_min %d is above priority_max %d", path, name, *lo, *hi))
+			continue
+		}
+		if recipe.Filters.Labels != "" {
+			if _, err := ParseLabelExpr(recipe.Filters.Labels); err != nil {
+				l.warnings = append(l.warnings, fmt.Sprintf("%s: recipe %s: labels: %v", path, name, err))
+				continue
+			}
+		}
+		if _, err := ParseTextQuery(recipe.Filters.Query); err != nil {
+			l.warnings = append(l.warnings, fmt.Sprintf("%s: recipe %s: query: %v", path, name, err))
+			continue
+		}
 		recipe.Name = name
 		l.recipes[name] = *recipe
 		l.sources[name] = source
 	}
 
+	for slot, search := range file.Searches {
+		switch {
+		case slot < 1 || slot > MaxSearchSlot:
+			l.warnings = append(l.warnings, fmt.Sprintf("%s: search slot %d is not a number key (1-%d)", path, slot, MaxSearchSlot))
+		case search == nil:
+			// Explicit null frees the slot
+			delete(l.searches, slot)
+		default:
+			if _, err := ParseQuery(search.Query); err != nil {
+				l.warnings = append(l.warnings, fmt.Sprintf("%s: search %d: %v", path, slot, err))
+				continue
+			}
+			l.searches[slot] = *search
+		}
+	}
+
 	return nil
 }
 
+// Search returns the saved search bound to a number key
+func (l *Loader) Search(slot int) (SavedSearch, bool) {
+	s, ok := l.searches[slot]
+	return s, ok
+}
+
+// SearchSlots returns the number keys with a saved search, in order
+func (l *Loader) SearchSlots() []int {
+	slots := make([]int, 0, len(l.searches))
+	for slot := range l.searches {
+		slots = append(slots, slot)
+	}
+	sort.Ints(slots)
+	return slots
+}
+
 // Get returns a recipe by name, or nil if not found
 func (l *Loader) Get(name string) *Recipe {
 	if recipe, ok := l.recipes[name]; ok {
@@ -228,3 +304,85 @@ func LoadDefault() (*Loader, error) {
 	}
 	return loader, nil
 }
+
+// SaveRecipe writes r into the recipes file at path under its name,
+// replacing a recipe of the same name and leaving the rest of the file,
+// comments included, as it was. The file and its directory are created
+// when missing.
+func SaveRecipe(path string, r Recipe) error {
+	if r.Name == "" {
+		return fmt.Errorf("recipe has no name")
+	}
+
+	var doc yaml.Node
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(data) > 0 {
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("parsing %s: top level is not a mapping", path)
+	}
+
+	recipes := mappingValue(root, "recipes")
+	if recipes == nil || recipes.Kind != yaml.MappingNode {
+		if recipes == nil {
+			recipes = &yaml.Node{Kind: yaml.MappingNode}
+			root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "recipes"}, recipes)
+		} else {
+			// "recipes:" with nothing under it
+			*recipes = yaml.Node{Kind: yaml.MappingNode}
+		}
+	}
+
+	// The name is the key, so it isn't repeated in the body
+	var body yaml.Node
+	if err := body.Encode(r); err != nil {
+		return err
+	}
+	for i := 0; i+1 < len(body.Content); i += 2 {
+		if body.Content[i].Value == "name" {
+			body.Content = append(body.Content[:i], body.Content[i+2:]...)
+			break
+		}
+	}
+
+	if existing := mappingValue(recipes, r.Name); existing != nil {
+		*existing = body
+	} else {
+		recipes.Content = append(recipes.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: r.Name}, &body)
+	}
+
+	// Two-space indents, like the built-in recipes file
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out.Bytes(), 0o644)
+}
+
+// mappingValue returns the value under key in a YAML mapping, or nil
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
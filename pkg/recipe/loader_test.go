@@ -3,6 +3,8 @@ package recipe_test
 import (
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
@@ -25,7 +27,7 @@ func TestLoaderBuiltinRecipes(t *testing.T) {
 	}
 
 	// Check for expected builtins (core recipes)
-	expectedRecipes := []string{"default", "actionable", "recent", "blocked", "high-impact", "stale", "triage", "closed", "release-cut", "quick-wins", "bottlenecks"}
+	expectedRecipes := []string{"default", "actionable", "recent", "blocked", "high-impact", "stale", "slipping", "triage", "closed", "release-cut", "quick-wins", "bottlenecks"}
 	for _, name := range expectedRecipes {
 		r := loader.Get(name)
 		if r == nil {
@@ -205,6 +207,124 @@ recipes:
 	}
 }
 
+func TestLoaderSavedSearches(t *testing.T) {
+	tmpDir := t.TempDir()
+	userPath := filepath.Join(tmpDir, "recipes.yaml")
+	userConfig := `
+searches:
+  1: {name: My P0s, query: "assignee:alice priority:0"}
+  2: "blocked:true label:backend"
+  3: "status:later"
+  12: "label:ui"
+`
+	if err := os.WriteFile(userPath, []byte(userConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	projectConfig := `
+searches:
+  1: null
+  4: "label:sprint-42"
+`
+	if err := os.MkdirAll(filepath.Join(tmpDir, ".bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".bv", "recipes.yaml"), []byte(projectConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loader := recipe.NewLoader(
+		recipe.WithUserPath(userPath),
+		recipe.WithProjectDir(tmpDir),
+	)
+	if err := loader.Load(); err != nil {
+		t.Fatalf("Failed to load: %v", err)
+	}
+
+	// The project frees slot 1; slot 3's query and slot 12 are rejected
+	if got := loader.SearchSlots(); !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("slots = %v, want [2 4]", got)
+	}
+	if s, ok := loader.Search(2); !ok || s.Query != "blocked:true label:backend" || s.Label() != s.Query {
+		t.Errorf("search 2 = %+v", s)
+	}
+	if len(loader.Warnings()) != 2 {
+		t.Errorf("expected warnings for slots 3 and 12, got %v", loader.Warnings())
+	}
+
+	if err := os.WriteFile(userPath, []byte("searches:\n  1: {name: My P0s, query: \"p:0\"}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(tmpDir, ".bv", "recipes.yaml")); err != nil {
+		t.Fatal(err)
+	}
+	if err := loader.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := loader.Search(1); !ok || s.Label() != "My P0s" || len(loader.SearchSlots()) != 1 {
+		t.Errorf("reload should pick up the edited searches, slot 1 = %+v, slots %v", s, loader.SearchSlots())
+	}
+}
+
+func TestLoaderRejectsEmptyPriorityRange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recipes.yaml")
+	config := `
+recipes:
+  hot-bugs:
+    filters:
+      types: [bug]
+      priority_max: 1
+  upside-down:
+    filters:
+      priority_min: 2
+      priority_max: 1
+`
+	if err := os.WriteFile(path, []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loader := recipe.NewLoader(recipe.WithUserPath(path), recipe.WithProjectDir(""))
+	if err := loader.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if r := loader.Get("hot-bugs"); r == nil || r.Filters.PriorityMax == nil || *r.Filters.PriorityMax != 1 {
+		t.Errorf("hot-bugs = %+v", r)
+	}
+	if loader.Get("upside-down") != nil {
+		t.Error("a recipe with priority_min above priority_max should not load")
+	}
+	if w := loader.Warnings(); len(w) != 1 || !strings.Contains(w[0], "priority_min 2 is above priority_max 1") {
+		t.Errorf("warnings = %v", w)
+	}
+}
+
+func TestLoaderRejectsBadTextQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recipes.yaml")
+	config := `
+recipes:
+  migrations:
+    filters:
+      query: '"schema migration" rollback'
+  half-quoted:
+    filters:
+      query: '"schema migration'
+`
+	if err := os.WriteFile(path, []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loader := recipe.NewLoader(recipe.WithUserPath(path), recipe.WithProjectDir(""))
+	if err := loader.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if r := loader.Get("migrations"); r == nil || r.Filters.Query != `"schema migration" rollback` {
+		t.Errorf("migrations = %+v", r)
+	}
+	if loader.Get("half-quoted") != nil {
+		t.Error("a recipe whose query doesn't parse should not load")
+	}
+	if w := loader.Warnings(); len(w) != 1 || !strings.Contains(w[0], "half-quoted: query") {
+		t.Errorf("warnings = %v", w)
+	}
+}
+
 func TestLoaderListSummaries(t *testing.T) {
 	loader := recipe.NewLoader(
 		recipe.WithUserPath(""),
@@ -316,3 +436,62 @@ func TestLoaderList(t *testing.T) {
 		t.Error("Expected non-empty list")
 	}
 }
+
+func TestSaveRecipe(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, ".bv", "recipes.yaml")
+
+	actionable := true
+	mine := recipe.Recipe{
+		Name:        "mine",
+		Description: "My open work",
+		Filters:     recipe.FilterConfig{Status: []string{"open"}, Actionable: &actionable},
+		Sort:        recipe.SortConfig{Field: "updated", Direction: "desc"},
+	}
+	if err := recipe.SaveRecipe(path, mine); err != nil {
+		t.Fatalf("SaveRecipe: %v", err)
+	}
+
+	// Saving again keeps other recipes and comments, and replaces one of
+	// the same name
+	data, _ := os.ReadFile(path)
+	edited := "# team recipes\n" + string(data) + "  other:\n    description: kept\n"
+	if err := os.WriteFile(path, []byte(edited), 0644); err != nil {
+		t.Fatal(err)
+	}
+	mine.Description = "Renamed"
+	if err := recipe.SaveRecipe(path, mine); err != nil {
+		t.Fatalf("SaveRecipe again: %v", err)
+	}
+	data, _ = os.ReadFile(path)
+	if !strings.Contains(string(data), "# team recipes") || strings.Contains(string(data), "name:") {
+		t.Errorf("unexpected file:\n%s", data)
+	}
+
+	loader := recipe.NewLoader(recipe.WithUserPath(filepath.Join(tmpDir, "none.yaml")), recipe.WithProjectDir(tmpDir))
+	if err := loader.Load(); err != nil {
+		t.Fatal(err)
+	}
+	r := loader.Get("mine")
+	if r == nil || r.Description != "Renamed" || r.Sort.Field != "updated" || r.Filters.Actionable == nil || !*r.Filters.Actionable {
+		t.Fatalf("saved recipe = %+v", r)
+	}
+	if loader.Get("other") == nil || loader.Source("mine") != "project" || loader.ProjectPath() != path {
+		t.Error("other recipes should be kept")
+	}
+
+	// Reload picks up recipes saved after the first load
+	if err := recipe.SaveRecipe(path, recipe.Recipe{Name: "later"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := loader.Reload(); err != nil || loader.Get("later") == nil || loader.Get("default") == nil {
+		t.Errorf("reload should see the new recipe, err %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("- not a mapping\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := recipe.SaveRecipe(path, mine); err == nil {
+		t.Error("a file that isn't a mapping should be refused")
+	}
+}
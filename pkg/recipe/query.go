@@ -0,0 +1,342 @@
+package recipe
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// maxPriority is the lowest priority (highest number) an issue can carry.
+const maxPriority = 4
+
+// QueryError reports the term of a filter query that could not be parsed.
+type QueryError struct {
+	Term string // The offending term as typed
+	Msg  string
+}
+
+func (e *QueryError) Error() string {
+	if e.Term == "" {
+		return e.Msg
+	}
+	return e.Term + ": " + e.Msg
+}
+
+// ParseQuery parses a filter query such as
+//
+//	status:open priority<=1 label:backend -assignee:alice updated>7d
+//
+// into a FilterConfig. Terms are separated by spaces and all must match.
+// Each term is field:value, field=value, or a comparison (<, <=, >, >=) for
+// priority and dates; a leading "-" negates it, commas list alternatives
+// ("status:open,blocked") and double quotes keep spaces in a value. Dates
+// compare as points in time, so updated>7d means updated within the last
+// seven days. Words without a field match the title.
+func ParseQuery(query string) (FilterConfig, error) {
+	var f FilterConfig
+	terms, err := splitQuery(query)
+	if err != nil {
+		return f, err
+	}
+
+	priorities := allPriorities()
+	var titleWords []string
+	for _, term := range terms {
+		raw := term
+		negate := false
+		if strings.HasPrefix(term, "-") && len(term) > 1 {
+			negate = true
+			term = term[1:]
+		}
+
+		field, op, value := splitTerm(term)
+		if op == "" {
+			if negate {
+				return f, &QueryError{Term: raw, Msg: "only field terms can be negated"}
+			}
+			titleWords = append(titleWords, unquote(term))
+			continue
+		}
+		field = strings.ToLower(field)
+		value = unquote(value)
+		if field == "" {
+			return f, &QueryError{Term: raw, Msg: "missing field name"}
+		}
+		if value == "" {
+			return f, &QueryError{Term: raw, Msg: "missing value"}
+		}
+		isMatch := op == ":" || op == "="
+		if negate && !isMatch {
+			return f, &QueryError{Term: raw, Msg: "comparisons cannot be negated"}
+		}
+
+		switch field {
+		case "status", "is":
+			if !isMatch {
+				return f, &QueryError{Term: raw, Msg: "status only supports ':'"}
+			}
+			values := splitValues(value)
+			for i, s := range values {
+				s = strings.ToLower(s)
+				if !model.Status(s).IsValid() {
+					return f, &QueryError{Term: raw, Msg: "unknown status " + strconv.Quote(s)}
+				}
+				values[i] = s
+			}
+			if negate {
+				f.ExcludeStatus = append(f.ExcludeStatus, values...)
+			} else {
+				f.Status = append(f.Status, values...)
+			}
+
+		case "priority", "prio", "p":
+			allowed, err := parsePriorityTerm(op, value, negate)
+			if err != nil {
+				return f, &QueryError{Term: raw, Msg: err.Error()}
+			}
+			priorities = intersectPriorities(priorities, allowed)
+			if len(priorities) == 0 {
+				return f, &QueryError{Term: raw, Msg: "no priority matches every priority term"}
+			}
+
+		case "label", "labels", "tag":
+			if !isMatch {
+				return f, &QueryError{Term: raw, Msg: "label only supports ':'"}
+			}
+			if negate {
+				f.ExcludeTags = append(f.ExcludeTags, splitValues(value)...)
+			} else {
+				f.Tags = append(f.Tags, splitValues(value)...)
+			}
+
+		case "assignee", "owner":
+			if !isMatch {
+				return f, &QueryError{Term: raw, Msg: "assignee only supports ':'"}
+			}
+			if negate {
+				f.ExcludeAssignee = append(f.ExcludeAssignee, splitValues(value)...)
+			} else {
+				f.Assignee = append(f.Assignee, splitValues(value)...)
+			}
+
+		case "type", "kind":
+			if !isMatch {
+				return f, &QueryError{Term: raw, Msg: "type only supports ':'"}
+			}
+			values := splitValues(value)
+			for i, v := range values {
+				values[i] = strings.ToLower(v)
+			}
+			if negate {
+				f.ExcludeTypes = append(f.ExcludeTypes, values...)
+			} else {
+				f.Types = append(f.Types, values...)
+			}
+
+		case "created", "updated":
+			if _, err := ParseRelativeTime(value, time.Now()); err != nil {
+				return f, &QueryError{Term: raw, Msg: err.Error()}
+			}
+			var after, before *string
+			if field == "created" {
+				after, before = &f.CreatedAfter, &f.CreatedBefore
+			} else {
+				after, before = &f.UpdatedAfter, &f.UpdatedBefore
+			}
+			switch op {
+			case ">", ">=":
+				*after = value
+			case "<", "<=":
+				*before = value
+			default:
+				return f, &QueryError{Term: raw, Msg: "dates need < or >, e.g. " + field + ">7d"}
+			}
+
+		case "id":
+			if !isMatch || negate {
+				return f, &QueryError{Term: raw, Msg: "id only supports a ':' prefix"}
+			}
+			f.IDPrefix = value
+
+		case "title":
+			if !isMatch || negate {
+				return f, &QueryError{Term: raw, Msg: "title only supports ':'"}
+			}
+			titleWords = append(titleWords, value)
+
+		case "blocked", "actionable":
+			if !isMatch {
+				return f, &QueryError{Term: raw, Msg: field + " only supports ':'"}
+			}
+			var want bool
+			switch strings.ToLower(value) {
+			case "true", "yes", "1":
+				want = true
+			case "false", "no", "0":
+			default:
+				return f, &QueryError{Term: raw, Msg: "expected true or false"}
+			}
+			if negate {
+				want = !want
+			}
+			switch {
+			case field == "blocked":
+				f.HasBlockers = &want
+			case want:
+				f.Actionable = &want
+			default:
+				// actionable:false is the same as blocked:true
+				blocked := true
+				f.HasBlockers = &blocked
+			}
+
+		default:
+			return f, &QueryError{Term: raw, Msg: "unknown field " + strconv.Quote(field)}
+		}
+	}
+
+	if len(priorities) < maxPriority+1 {
+		f.Priority = priorities
+	}
+	f.TitleContains = strings.Join(titleWords, " ")
+	return f, nil
+}
+
+// splitQuery breaks a query into terms at spaces outside double quotes.
+func splitQuery(query string) ([]string, error) {
+	var terms []string
+	var cur strings.Builder
+	inQuote := false
+	for _, r := range query {
+		switch {
+		case r == '"':
+			inQuote = !inQuote
+			cur.WriteRune(r)
+		case unicode.IsSpace(r) && !inQuote:
+			if cur.Len() > 0 {
+				terms = append(terms, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if inQuote {
+		return nil, &QueryError{Term: cur.String(), Msg: "unterminated quote"}
+	}
+	if cur.Len() > 0 {
+		terms = append(terms, cur.String())
+	}
+	return terms, nil
+}
+
+// splitTerm splits "field<op>value" at the first operator outside quotes.
+// op is empty for a plain word.
+func splitTerm(term string) (field, op, value string) {
+	for i, r := range term {
+		switch r {
+		case '"':
+			return "", "", ""
+		case ':', '=':
+			return term[:i], string(r), term[i+1:]
+		case '<', '>':
+			if strings.HasPrefix(term[i+1:], "=") {
+				return term[:i], string(r) + "=", term[i+2:]
+			}
+			return term[:i], string(r), term[i+1:]
+		}
+	}
+	return "", "", ""
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// splitValues splits a comma-separated value list, dropping empty entries.
+func splitValues(value string) []string {
+	var out []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func allPriorities() []int {
+	out := make([]int, 0, maxPriority+1)
+	for p := 0; p <= maxPriority; p++ {
+		out = append(out, p)
+	}
+	return out
+}
+
+// parsePriorityTerm returns the priorities a single priority term allows.
+// Values may be written as 1 or P1.
+func parsePriorityTerm(op, value string, negate bool) ([]int, error) {
+	parse := func(v string) (int, error) {
+		v = strings.TrimPrefix(strings.ToLower(v), "p")
+		p, err := strconv.Atoi(v)
+		if err != nil || p < 0 || p > maxPriority {
+			return 0, &QueryError{Msg: "priority must be 0-" + strconv.Itoa(maxPriority)}
+		}
+		return p, nil
+	}
+
+	want := make(map[int]bool)
+	if op == ":" || op == "=" {
+		for _, v := range splitValues(value) {
+			p, err := parse(v)
+			if err != nil {
+				return nil, err
+			}
+			want[p] = true
+		}
+	} else {
+		bound, err := parse(value)
+		if err != nil {
+			return nil, err
+		}
+		for p := 0; p <= maxPriority; p++ {
+			switch op {
+			case "<":
+				want[p] = p < bound
+			case "<=":
+				want[p] = p <= bound
+			case ">":
+				want[p] = p > bound
+			case ">=":
+				want[p] = p >= bound
+			}
+		}
+	}
+
+	var out []int
+	for p := 0; p <= maxPriority; p++ {
+		if want[p] != negate {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func intersectPriorities(a, b []int) []int {
+	keep := make(map[int]bool, len(b))
+	for _, p := range b {
+		keep[p] = true
+	}
+	var out []int
+	for _, p := range a {
+		if keep[p] {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,93 @@
+package recipe_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+)
+
+func TestParseQueryExample(t *testing.T) {
+	f, err := recipe.ParseQuery(`status:open priority<=1 label:backend -assignee:alice updated>7d login "page flow"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := recipe.FilterConfig{
+		Status:          []string{"open"},
+		Priority:        []int{0, 1},
+		Tags:            []string{"backend"},
+		ExcludeAssignee: []string{"alice"},
+		UpdatedAfter:    "7d",
+		TitleContains:   "login page flow",
+	}
+	if !reflect.DeepEqual(f, want) {
+		t.Errorf("got %+v\nwant %+v", f, want)
+	}
+}
+
+func TestParseQueryTerms(t *testing.T) {
+	yes, no := true, false
+	tests := []struct {
+		query string
+		want  recipe.FilterConfig
+	}{
+		{"", recipe.FilterConfig{}},
+		{"status:open,IN_PROGRESS -status:blocked", recipe.FilterConfig{Status: []string{"open", "in_progress"}, ExcludeStatus: []string{"blocked"}}},
+		{"priority>=1 p<3", recipe.FilterConfig{Priority: []int{1, 2}}},
+		{"priority:P0,2", recipe.FilterConfig{Priority: []int{0, 2}}},
+		{"-priority:4", recipe.FilterConfig{Priority: []int{0, 1, 2, 3}}},
+		{"priority<=4", recipe.FilterConfig{}},
+		{"-label:ui,docs tag:api", recipe.FilterConfig{Tags: []string{"api"}, ExcludeTags: []string{"ui", "docs"}}},
+		{"assignee:bob,carol", recipe.FilterConfig{Assignee: []string{"bob", "carol"}}},
+		{"type:Bug,feature -kind:epic", recipe.FilterConfig{Types: []string{"bug", "feature"}, ExcludeTypes: []string{"epic"}}},
+		{"created>2024-01-01 created<2w updated<=30d", recipe.FilterConfig{CreatedAfter: "2024-01-01", CreatedBefore: "2w", UpdatedBefore: "30d"}},
+		{`id:bv- title:"dark mode"`, recipe.FilterConfig{IDPrefix: "bv-", TitleContains: "dark mode"}},
+		{"blocked:yes", recipe.FilterConfig{HasBlockers: &yes}},
+		{"-blocked:true", recipe.FilterConfig{HasBlockers: &no}},
+		{"actionable:true", recipe.FilterConfig{Actionable: &yes}},
+		{"actionable:false", recipe.FilterConfig{HasBlockers: &yes}},
+	}
+	for _, tt := range tests {
+		got, err := recipe.ParseQuery(tt.query)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.query, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: got %+v, want %+v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	tests := []struct {
+		query, term string
+	}{
+		{"status:opne", "status:opne"},
+		{"priority<=x", "priority<=x"},
+		{"priority:7", "priority:7"},
+		{"priority<1 priority>2", "priority>2"},
+		{"updated>soon", "updated>soon"},
+		{"updated:7d", "updated:7d"},
+		{"colour:red", "colour:red"},
+		{"-login", "-login"},
+		{"-priority<2", "-priority<2"},
+		{"label:", "label:"},
+		{"type>bug", "type>bug"},
+		{":open", ":open"},
+		{"blocked:maybe", "blocked:maybe"},
+		{`title:"open ended`, `title:"open ended`},
+	}
+	for _, tt := range tests {
+		_, err := recipe.ParseQuery(tt.query)
+		var qe *recipe.QueryError
+		if !errors.As(err, &qe) {
+			t.Errorf("%q: expected a QueryError, got %v", tt.query, err)
+			continue
+		}
+		if qe.Term != tt.term {
+			t.Errorf("%q: error names term %q, want %q (%v)", tt.query, qe.Term, tt.term, err)
+		}
+	}
+}
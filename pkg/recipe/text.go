@@ -0,0 +1,114 @@
+package recipe
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// fuzzyMinRunes is the shortest word MatchText lets a typo through for;
+// shorter ones are one edit away from too many others
+const fuzzyMinRunes = 5
+
+// ParseTextQuery splits a full-text query into its terms: words, and
+// phrases in double quotes, lowercased
+func ParseTextQuery(query string) ([]string, error) {
+	raw, err := splitQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	terms := make([]string, 0, len(raw))
+	for _, t := range raw {
+		if t = strings.ToLower(unquote(t)); t != "" {
+			terms = append(terms, t)
+		}
+	}
+	return terms, nil
+}
+
+// textQueries caches parsed queries for MatchText, by source; a query that
+// doesn't parse is stored as nil
+var textQueries sync.Map
+
+// MatchText reports whether the issue's title, description or notes
+// mention every term of query, compared case-insensitively. A word of five
+// letters or more also matches a word of the text one typo away
+// ("migraton" finds "migration"); phrases match as written. An empty query
+// matches everything and one that doesn't parse nothing. The filters call
+// it once per issue, so parses are cached.
+func MatchText(issue model.Issue, query string) bool {
+	if query == "" {
+		return true
+	}
+	var terms []string
+	if cached, ok := textQueries.Load(query); ok {
+		terms, _ = cached.([]string)
+	} else {
+		var err error
+		if terms, err = ParseTextQuery(query); err != nil {
+			terms = nil
+		}
+		textQueries.Store(query, terms)
+	}
+	if terms == nil {
+		return false
+	}
+	if len(terms) == 0 {
+		return true
+	}
+	text := strings.ToLower(issue.Title + "\n" + issue.Description + "\n" + issue.Notes)
+	var words []string
+	for _, term := range terms {
+		if strings.Contains(text, term) {
+			continue
+		}
+		if len([]rune(term)) < fuzzyMinRunes || strings.ContainsFunc(term, unicode.IsSpace) {
+			return false
+		}
+		if words == nil {
+			words = strings.FieldsFunc(text, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			})
+		}
+		found := false
+		for _, w := range words {
+			if oneEditApart(term, w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// oneEditApart reports whether a and b differ by at most one inserted,
+// deleted or changed rune
+func oneEditApart(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) > len(rb) {
+		ra, rb = rb, ra
+	}
+	if len(rb)-len(ra) > 1 {
+		return false
+	}
+	i, j, edits := 0, 0, 0
+	for i < len(ra) && j < len(rb) {
+		if ra[i] == rb[j] {
+			i, j = i+1, j+1
+			continue
+		}
+		if edits++; edits > 1 {
+			return false
+		}
+		if len(ra) == len(rb) {
+			i++
+		}
+		j++
+	}
+	return edits+(len(rb)-j)+(len(ra)-i) <= 1
+}
@@ -0,0 +1,37 @@
+package recipe_test
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+)
+
+func TestMatchText(t *testing.T) {
+	issue := model.Issue{
+		Title:       "Speed up sync",
+		Description: "The schema Migration runs on every start.",
+		Notes:       "Ask Bob about the rollback plan",
+	}
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{"migration", true},        // Description, any case
+		{"ROLLBACK", true},         // Notes
+		{"sync migration", true},   // Every term, in any field
+		{"sync deploy", false},     // Not every term
+		{"migraton", true},         // One typo in a long word
+		{"migrashun", false},       // Too many
+		{"synk", false},            // Short words must match as typed
+		{`"rollback plan"`, true},  // Phrases match as written
+		{`"plan rollback"`, false}, // In order
+		{`"rollback`, false},       // Unterminated quote
+	}
+	for _, tt := range tests {
+		if got := recipe.MatchText(issue, tt.query); got != tt.want {
+			t.Errorf("MatchText(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
@@ -1,10 +1,15 @@
 package recipe
 
 import (
+	"os"
+	"os/exec"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Recipe defines a reusable view configuration for beads
@@ -16,34 +21,143 @@ type Recipe struct {
 	View        ViewConfig   `yaml:"view,omitempty" json:"view,omitempty"`
 	Export      ExportConfig `yaml:"export,omitempty" json:"export,omitempty"`
 	Metrics     []string     `yaml:"metrics,omitempty" json:"metrics,omitempty"` // Which metrics to show
+	Risk        *RiskConfig  `yaml:"risk,omitempty" json:"risk,omitempty"`       // Weights for the risk sort and reports
+}
+
+// SavedSearch is a filter bar query bound to one of the number keys. In a
+// recipes file it is either a mapping with a name and a query or just the
+// query:
+//
+//	searches:
+//	  1: {name: My P0s, query: "assignee:alice priority:0"}
+//	  2: "blocked:true label:backend"
+type SavedSearch struct {
+	Name  string `yaml:"name,omitempty" json:"name,omitempty"`
+	Query string `yaml:"query" json:"query"`
+}
+
+// UnmarshalYAML accepts a bare query as well as the mapping form
+func (s *SavedSearch) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		s.Name = ""
+		return node.Decode(&s.Query)
+	}
+	type plain SavedSearch
+	return node.Decode((*plain)(s))
+}
+
+// Label names the search for display: its name, or its query when unnamed
+func (s SavedSearch) Label() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.Query
 }
 
 // FilterConfig defines which issues to include
 type FilterConfig struct {
-	Status        []string `yaml:"status,omitempty" json:"status,omitempty"`                 // open, closed, in_progress, blocked
-	Priority      []int    `yaml:"priority,omitempty" json:"priority,omitempty"`             // 0, 1, 2, 3
-	Tags          []string `yaml:"tags,omitempty" json:"tags,omitempty"`                     // Include issues with these tags
-	ExcludeTags   []string `yaml:"exclude_tags,omitempty" json:"exclude_tags,omitempty"`     // Exclude issues with these tags
-	CreatedAfter  string   `yaml:"created_after,omitempty" json:"created_after,omitempty"`   // Relative: "14d", "1w", "2m" or ISO date
-	CreatedBefore string   `yaml:"created_before,omitempty" json:"created_before,omitempty"` // Relative or ISO date
-	UpdatedAfter  string   `yaml:"updated_after,omitempty" json:"updated_after,omitempty"`   // Relative or ISO date
-	UpdatedBefore string   `yaml:"updated_before,omitempty" json:"updated_before,omitempty"` // Relative or ISO date
-	HasBlockers   *bool    `yaml:"has_blockers,omitempty" json:"has_blockers,omitempty"`     // true = blocked, false = actionable
-	Actionable    *bool    `yaml:"actionable,omitempty" json:"actionable,omitempty"`         // true = no open blockers
-	TitleContains string   `yaml:"title_contains,omitempty" json:"title_contains,omitempty"` // Substring match
-	IDPrefix      string   `yaml:"id_prefix,omitempty" json:"id_prefix,omitempty"`           // e.g., "bv-" for project filtering
+	Status          []string `yaml:"status,omitempty" json:"status,omitempty"`                     // open, closed, in_progress, blocked
+	ExcludeStatus   []string `yaml:"exclude_status,omitempty" json:"exclude_status,omitempty"`     // Exclude issues with these statuses
+	Priority        []int    `yaml:"priority,omitempty" json:"priority,omitempty"`                 // 0, 1, 2, 3
+	PriorityMin     *int     `yaml:"priority_min,omitempty" json:"priority_min,omitempty"`         // Most urgent priority included (0 = P0)
+	PriorityMax     *int     `yaml:"priority_max,omitempty" json:"priority_max,omitempty"`         // Least urgent priority included, e.g. 1 for P0/P1
+	Tags            []string `yaml:"tags,omitempty" json:"tags,omitempty"`                         // Include issues with these tags
+	ExcludeTags     []string `yaml:"exclude_tags,omitempty" json:"exclude_tags,omitempty"`         // Exclude issues with these tags
+	Labels          string   `yaml:"labels,omitempty" json:"labels,omitempty"`                     // Label expression, e.g. "backend AND (urgent OR regression) AND NOT wontfix"
+	Assignee        []string `yaml:"assignee,omitempty" json:"assignee,omitempty"`                 // Include issues assigned to any of these; see MatchAssignee for "me" and "unassigned"
+	ExcludeAssignee []string `yaml:"exclude_assignee,omitempty" json:"exclude_assignee,omitempty"` // Exclude issues assigned to these
+	Types           []string `yaml:"types,omitempty" json:"types,omitempty"`                       // Include issues of any of these types
+	ExcludeTypes    []string `yaml:"exclude_types,omitempty" json:"exclude_types,omitempty"`       // Exclude issues of these types
+	CreatedAfter    string   `yaml:"created_after,omitempty" json:"created_after,omitempty"`       // Relative: "14d", "1w", "2m" or ISO date
+	CreatedBefore   string   `yaml:"created_before,omitempty" json:"created_before,omitempty"`     // Relative or ISO date
+	UpdatedAfter    string   `yaml:"updated_after,omitempty" json:"updated_after,omitempty"`       // Relative or ISO date
+	UpdatedBefore   string   `yaml:"updated_before,omitempty" json:"updated_before,omitempty"`     // Relative or ISO date
+	HasBlockers     *bool    `yaml:"has_blockers,omitempty" json:"has_blockers,omitempty"`         // true = blocked, false = actionable
+	Actionable      *bool    `yaml:"actionable,omitempty" json:"actionable,omitempty"`             // true = no open blockers
+	TitleContains   string   `yaml:"title_contains,omitempty" json:"title_contains,omitempty"`     // Substring match
+	Query           string   `yaml:"query,omitempty" json:"query,omitempty"`                       // Full text over title, description and notes; see MatchText
+	IDPrefix        string   `yaml:"id_prefix,omitempty" json:"id_prefix,omitempty"`               // e.g., "bv-" for project filtering
+}
+
+// Assignee filter values with a meaning of their own: "me" stands for the
+// current user (see CurrentUser) and "unassigned" for issues nobody holds
+const (
+	AssigneeMe         = "me"
+	AssigneeUnassigned = "unassigned"
+)
+
+// CurrentUser returns the names "me" matches in assignee filters: $BV_USER
+// when set (the same setting the pick draw uses), otherwise git's
+// user.name and user.email and then $USER. It is resolved once per run.
+var CurrentUser = sync.OnceValue(func() []string {
+	if u := strings.TrimSpace(os.Getenv("BV_USER")); u != "" {
+		return []string{u}
+	}
+	var names []string
+	for _, key := range []string{"user.name", "user.email"} {
+		out, err := exec.Command("git", "config", key).Output()
+		if u := strings.TrimSpace(string(out)); err == nil && u != "" {
+			names = append(names, u)
+		}
+	}
+	if u := strings.TrimSpace(os.Getenv("USER")); u != "" {
+		names = append(names, u)
+	}
+	return names
+})
+
+// MatchAssignee reports whether an issue's assignee is one of the filter
+// values, compared case-insensitively. "me" matches the current user and
+// "unassigned" an issue with no assignee.
+func MatchAssignee(assignee string, values []string) bool {
+	assignee = strings.TrimSpace(assignee)
+	for _, v := range values {
+		switch {
+		case strings.EqualFold(v, AssigneeUnassigned):
+			if assignee == "" {
+				return true
+			}
+		case strings.EqualFold(v, AssigneeMe):
+			for _, u := range CurrentUser() {
+				if assignee != "" && strings.EqualFold(assignee, u) {
+					return true
+				}
+			}
+		case assignee != "" && strings.EqualFold(assignee, v):
+			return true
+		}
+	}
+	return false
+}
+
+// InPriorityRange reports whether priority lies within PriorityMin and
+// PriorityMax; an unset bound doesn't limit
+func (f FilterConfig) InPriorityRange(priority int) bool {
+	if f.PriorityMin != nil && priority < *f.PriorityMin {
+		return false
+	}
+	return f.PriorityMax == nil || priority <= *f.PriorityMax
 }
 
 // SortConfig defines how to order issues
 type SortConfig struct {
-	Field     string      `yaml:"field" json:"field"`                             // priority, created, updated, title, id, pagerank, betweenness
+	Field     string      `yaml:"field" json:"field"`                             // priority, created, updated, title, id, pagerank, betweenness, staleness, risk
 	Direction string      `yaml:"direction,omitempty" json:"direction,omitempty"` // asc, desc (default: asc for priority, desc for dates)
 	Secondary *SortConfig `yaml:"secondary,omitempty" json:"secondary,omitempty"` // Tie-breaker
 }
 
+// RiskConfig weights the factors of the risk score. Weights are relative;
+// omitted ones keep their defaults and 0 ignores a factor.
+type RiskConfig struct {
+	BlockerDepth *float64 `yaml:"blocker_depth,omitempty" json:"blocker_depth,omitempty"` // Longest chain of open blockers
+	Staleness    *float64 `yaml:"staleness,omitempty" json:"staleness,omitempty"`         // Days since last update
+	Priority     *float64 `yaml:"priority,omitempty" json:"priority,omitempty"`           // P0 highest
+	BusFactor    *float64 `yaml:"bus_factor,omitempty" json:"bus_factor,omitempty"`       // Work held by a single person
+}
+
 // ViewConfig controls display options
 type ViewConfig struct {
-	Columns       []string `yaml:"columns,omitempty" json:"columns,omitempty"`               // id, title, status, priority, created, updated, tags, blockers
+	Columns       []string `yaml:"columns,omitempty" json:"columns,omitempty"`               // List columns as name or name:width; see ui.ParseListColumns
 	ShowGraph     bool     `yaml:"show_graph,omitempty" json:"show_graph,omitempty"`         // Show dependency graph in TUI
 	ShowMetrics   bool     `yaml:"show_metrics,omitempty" json:"show_metrics,omitempty"`     // Show analysis metrics
 	GroupBy       string   `yaml:"group_by,omitempty" json:"group_by,omitempty"`             // status, priority, tag, none
@@ -117,4 +231,3 @@ type TimeParseError struct {
 func (e *TimeParseError) Error() string {
 	return "invalid time format: " + e.Input + " (expected relative like '14d', '2w', '1m' or ISO date)"
 }
-
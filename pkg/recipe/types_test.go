@@ -153,3 +153,58 @@ func TestRecipeStructTags(t *testing.T) {
 		t.Error("Filters.Status should not be nil")
 	}
 }
+
+func TestMatchAssigneeMeAndUnassigned(t *testing.T) {
+	saved := recipe.CurrentUser
+	t.Cleanup(func() { recipe.CurrentUser = saved })
+	recipe.CurrentUser = func() []string { return []string{"Ann Lee", "ann@example.com"} }
+
+	tests := []struct {
+		assignee string
+		values   []string
+		want     bool
+	}{
+		{"bob", []string{"Bob"}, true},
+		{"bob", []string{"ann"}, false},
+		{"ANN@example.com", []string{"me"}, true},
+		{"Ann Lee", []string{"ME"}, true},
+		{"bob", []string{"me"}, false},
+		{"", []string{"me"}, false},
+		{"  ", []string{"unassigned"}, true},
+		{"bob", []string{"unassigned"}, false},
+		{"", []string{"me", "unassigned"}, true},
+		{"", nil, false},
+	}
+	for _, tt := range tests {
+		if got := recipe.MatchAssignee(tt.assignee, tt.values); got != tt.want {
+			t.Errorf("MatchAssignee(%q, %v) = %v, want %v", tt.assignee, tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestInPriorityRange(t *testing.T) {
+	one, three := 1, 3
+	tests := []struct {
+		min, max *int
+		in       []int
+		out      []int
+	}{
+		{nil, nil, []int{0, 4}, nil},
+		{nil, &one, []int{0, 1}, []int{2}},
+		{&one, nil, []int{1, 4}, []int{0}},
+		{&one, &three, []int{1, 2, 3}, []int{0, 4}},
+	}
+	for _, tt := range tests {
+		f := recipe.FilterConfig{PriorityMin: tt.min, PriorityMax: tt.max}
+		for _, p := range tt.in {
+			if !f.InPriorityRange(p) {
+				t.Errorf("P%d should be in range %v-%v", p, tt.min, tt.max)
+			}
+		}
+		for _, p := range tt.out {
+			if f.InPriorityRange(p) {
+				t.Errorf("P%d should be out of range %v-%v", p, tt.min, tt.max)
+			}
+		}
+	}
+}
@@ -3,12 +3,39 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"github.com/charmbracelet/lipgloss"
 )
 
+// ActionableDensity is how much of each plan item the actionable view shows
+type ActionableDensity int
+
+const (
+	DensityCompact  ActionableDensity = iota // One line per item
+	DensityDetailed                          // Wrapped title, then status, labels, estimate and unblocks
+	DensityMinimal                           // IDs only, packed across the width
+)
+
+// String returns the density's name
+func (d ActionableDensity) String() string {
+	switch d {
+	case DensityDetailed:
+		return "detailed"
+	case DensityMinimal:
+		return "minimal"
+	}
+	return "compact"
+}
+
+// Next returns the density after d: compact, detailed, minimal, compact
+func (d ActionableDensity) Next() ActionableDensity {
+	return (d + 1) % 3
+}
+
 // ActionableModel represents the actionable items view grouped by tracks
 type ActionableModel struct {
 	plan          analysis.ExecutionPlan
@@ -18,16 +45,27 @@ type ActionableModel struct {
 	width         int
 	height        int
 	theme         Theme
+	marked        map[string]bool         // Issues marked for bulk actions, shared with the list
+	issueMap      map[string]*model.Issue // Labels for the detailed density
+	density       ActionableDensity
+	idWidth       int // Widest item ID, the cell size of the minimal density
 }
 
 // NewActionableModel creates a new actionable view from execution plan
 func NewActionableModel(plan analysis.ExecutionPlan, theme Theme) ActionableModel {
+	idWidth := 0
+	for _, track := range plan.Tracks {
+		for _, item := range track.Items {
+			idWidth = max(idWidth, lipgloss.Width(item.ID))
+		}
+	}
 	return ActionableModel{
 		plan:          plan,
 		selectedTrack: 0,
 		selectedItem:  0,
 		scrollOffset:  0,
 		theme:         theme,
+		idWidth:       idWidth,
 	}
 }
 
@@ -111,41 +149,233 @@ func (m *ActionableModel) SelectedIssueID() string {
 	return track.Items[m.selectedItem].ID
 }
 
-// ensureVisible adjusts scroll to keep selection visible
-func (m *ActionableModel) ensureVisible() {
-	// Calculate the line number of the current selection
-	lineNum := 0
-	for i := 0; i < m.selectedTrack; i++ {
-		lineNum += 1 + len(m.plan.Tracks[i].Items) + 1 // header + items + blank
+// ItemIDs returns the IDs of the plan's items in display order
+func (m *ActionableModel) ItemIDs() []string {
+	var ids []string
+	for _, track := range m.plan.Tracks {
+		for _, item := range track.Items {
+			ids = append(ids, item.ID)
+		}
 	}
-	lineNum += 1 + m.selectedItem // header + item position
+	return ids
+}
 
-	// Calculate item height (expanded if selected and has unblocks)
-	itemHeight := 1
-	track := m.plan.Tracks[m.selectedTrack]
-	if len(track.Items) > m.selectedItem {
-		if len(track.Items[m.selectedItem].UnblocksIDs) > 0 {
-			itemHeight = 2
+// Index returns the position of the selected item in ItemIDs
+func (m *ActionableModel) Index() int {
+	idx := 0
+	for t := 0; t < m.selectedTrack && t < len(m.plan.Tracks); t++ {
+		idx += len(m.plan.Tracks[t].Items)
+	}
+	return idx + m.selectedItem
+}
+
+// SelectIndex selects the item at a position in ItemIDs, clamped to the
+// plan
+func (m *ActionableModel) SelectIndex(idx int) {
+	if len(m.plan.Tracks) == 0 {
+		return
+	}
+	idx = max(idx, 0)
+	for t, track := range m.plan.Tracks {
+		if idx < len(track.Items) {
+			m.selectedTrack, m.selectedItem = t, idx
+			m.ensureVisible()
+			return
+		}
+		idx -= len(track.Items)
+	}
+	last := len(m.plan.Tracks) - 1
+	m.selectedTrack, m.selectedItem = last, max(len(m.plan.Tracks[last].Items)-1, 0)
+	m.ensureVisible()
+}
+
+// SetMarked sets the marks drawn next to items
+func (m *ActionableModel) SetMarked(marked map[string]bool) {
+	m.marked = marked
+}
+
+// SetIssueMap sets the issues the detailed density takes labels from
+func (m *ActionableModel) SetIssueMap(issueMap map[string]*model.Issue) {
+	m.issueMap = issueMap
+}
+
+// Density returns how much of each item is shown
+func (m *ActionableModel) Density() ActionableDensity {
+	return m.density
+}
+
+// SetDensity sets how much of each item is shown, keeping the selection
+// in view
+func (m *ActionableModel) SetDensity(d ActionableDensity) {
+	m.density = d
+	m.ensureVisible()
+}
+
+// SelectByID selects the plan item with the given issue ID, reporting
+// whether it is in the plan
+func (m *ActionableModel) SelectByID(id string) bool {
+	for t, track := range m.plan.Tracks {
+		for i, item := range track.Items {
+			if item.ID == id {
+				m.selectedTrack, m.selectedItem = t, i
+				m.ensureVisible()
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// wipSummary describes the plan against its team-wide WIP limit, naming
+// the items that don't fit
+func (m *ActionableModel) wipSummary() string {
+	if m.plan.OverWIP == 0 {
+		return fmt.Sprintf("WIP limit %d ✓", m.plan.GlobalWIPLimit)
+	}
+	var over []string
+	for _, track := range m.plan.Tracks {
+		for _, item := range track.Items {
+			if item.OverWIP {
+				over = append(over, item.ID)
+			}
+		}
+	}
+	return fmt.Sprintf("⚠ %d over WIP limit %d: %s", m.plan.OverWIP, m.plan.GlobalWIPLimit, strings.Join(over, ", "))
+}
+
+// Layout: a fixed preamble (the header, then the recommendation when there
+// is one) above a scrolling body. Each track takes its header, a divider,
+// its item rows, and a blank line. The rows depend on the density: compact
+// gives each item a line and adds the unblocks line under the selected one,
+// detailed gives each item its wrapped title and a line of details, and
+// minimal packs the IDs several to a row. Line positions are worked out from
+// the track sizes, so scrolling and rendering only touch the rows on
+// screen, however long the plan is.
+
+// showsSummary reports whether the recommendation sits under the header
+func (m *ActionableModel) showsSummary() bool {
+	return m.plan.Summary.HighestImpact != "" && m.plan.Summary.UnblocksCount > 0
+}
+
+// bodyLines is how many lines of tracks fit under the preamble
+func (m *ActionableModel) bodyLines() int {
+	preamble := 2
+	if m.showsSummary() {
+		preamble += 2
+	}
+	return max(m.height-2-preamble, 1)
+}
+
+// selectedShowsUnblocks reports whether the selected item gets the line
+// listing what it unblocks, which only the compact density adds
+func (m *ActionableModel) selectedShowsUnblocks() bool {
+	if m.density != DensityCompact || m.selectedTrack >= len(m.plan.Tracks) {
+		return false
+	}
+	items := m.plan.Tracks[m.selectedTrack].Items
+	return m.selectedItem < len(items) && len(items[m.selectedItem].UnblocksIDs) > 0
+}
+
+// idsPerRow is how many IDs a row of the minimal density holds
+func (m *ActionableModel) idsPerRow() int {
+	return max((m.width-4)/(m.idWidth+4), 1)
+}
+
+// itemPrefixWidth is the width of the selection mark, tree connector,
+// priority icon and ID ahead of an item's title
+func (m *ActionableModel) itemPrefixWidth(item analysis.PlanItem) int {
+	return 2 + 3 + lipgloss.Width(GetPriorityIcon(item.Priority)) + 1 + lipgloss.Width(item.ID) + 1
+}
+
+// detailedTitle wraps an item's title for the detailed density
+func (m *ActionableModel) detailedTitle(item analysis.PlanItem) []string {
+	width := max(m.width-2-m.itemPrefixWidth(item)-1, 10)
+	lines := strings.Split(wrapText(item.Title, width), "\n")
+	for i, line := range lines {
+		lines[i] = truncateRunesHelper(line, width, "…")
+	}
+	return lines
+}
+
+// itemHeight is how many rows item i of track t takes, leaving out the
+// compact density's unblocks line
+func (m *ActionableModel) itemHeight(t, i int) int {
+	if m.density == DensityDetailed {
+		return len(m.detailedTitle(m.plan.Tracks[t].Items[i])) + 1
+	}
+	return 1
+}
+
+// bodyRows is how many item rows the track at index t takes
+func (m *ActionableModel) bodyRows(t int) int {
+	items := m.plan.Tracks[t].Items
+	switch m.density {
+	case DensityMinimal:
+		per := m.idsPerRow()
+		return (len(items) + per - 1) / per
+	case DensityDetailed:
+		n := 0
+		for i := range items {
+			n += m.itemHeight(t, i)
 		}
+		return n
+	}
+	n := len(items)
+	if t == m.selectedTrack && m.selectedShowsUnblocks() {
+		n++
 	}
+	return n
+}
 
-	visibleLines := m.height - 2 // account for header (2 lines used in Render for header+blank)
-	if visibleLines < 5 {
-		visibleLines = 5
+// itemSpan returns the first row and the number of rows of item i of track
+// t among the track's item rows
+func (m *ActionableModel) itemSpan(t, i int) (int, int) {
+	switch m.density {
+	case DensityMinimal:
+		return i / m.idsPerRow(), 1
+	case DensityDetailed:
+		row := 0
+		for j := 0; j < i; j++ {
+			row += m.itemHeight(t, j)
+		}
+		return row, m.itemHeight(t, i)
 	}
+	if t == m.selectedTrack && m.selectedShowsUnblocks() {
+		switch {
+		case i == m.selectedItem:
+			return i, 2
+		case i > m.selectedItem:
+			return i + 1, 1
+		}
+	}
+	return i, 1
+}
 
-	// Ensure top is visible
+// trackLines is how many body lines the track at index t takes
+func (m *ActionableModel) trackLines(t int) int {
+	return 2 + m.bodyRows(t) + 1
+}
+
+// ensureVisible adjusts scroll to keep selection visible
+func (m *ActionableModel) ensureVisible() {
+	if m.selectedTrack >= len(m.plan.Tracks) {
+		return
+	}
+	// Body line of the selected item: the tracks above, then its own
+	// header and divider
+	lineNum := 0
+	for i := 0; i < m.selectedTrack; i++ {
+		lineNum += m.trackLines(i)
+	}
+	row, itemHeight := m.itemSpan(m.selectedTrack, m.selectedItem)
+	lineNum += 2 + row
+
+	visibleLines := m.bodyLines()
 	if lineNum < m.scrollOffset {
 		m.scrollOffset = lineNum
 	}
-
-	// Ensure bottom is visible
-	// We need the last line of the item (lineNum + itemHeight - 1) to be visible
-	// The last visible line index is m.scrollOffset + visibleLines - 1
-	// So: lineNum + itemHeight - 1 <= m.scrollOffset + visibleLines - 1
-	//     lineNum + itemHeight <= m.scrollOffset + visibleLines
-	bottomLine := lineNum + itemHeight
-	if bottomLine > m.scrollOffset+visibleLines {
+	// The item's last line must sit above the bottom of the window
+	if bottomLine := lineNum + itemHeight; bottomLine > m.scrollOffset+visibleLines {
 		m.scrollOffset = bottomLine - visibleLines
 	}
 }
@@ -162,9 +392,12 @@ func (m *ActionableModel) Render() string {
 	// ══════════════════════════════════════════════════════════════════════════
 	// HEADER - Polished title with summary stats
 	// ══════════════════════════════════════════════════════════════════════════
-	totalItems := 0
+	totalItems, trackCount := 0, 0
 	for _, track := range m.plan.Tracks {
 		totalItems += len(track.Items)
+		if track.TrackID != pinnedTrackID {
+			trackCount++
+		}
 	}
 
 	headerStyle := t.Renderer.NewStyle().
@@ -174,7 +407,14 @@ func (m *ActionableModel) Render() string {
 		Padding(0, 2).
 		Width(m.width - 4)
 
-	header := fmt.Sprintf("⚡ ACTIONABLE ITEMS  │  %d items in %d tracks", totalItems, len(m.plan.Tracks))
+	header := fmt.Sprintf("⚡ ACTIONABLE ITEMS  │  %d items in %d tracks", totalItems, trackCount)
+	if m.density != DensityCompact {
+		header += "  │  " + m.density.String()
+	}
+	if m.plan.GlobalWIPLimit > 0 {
+		header += "  │  " + m.wipSummary()
+	}
+	header = truncateRunesHelper(header, m.width-8, "…")
 	lines = append(lines, headerStyle.Render(header))
 	lines = append(lines, "")
 
@@ -192,7 +432,7 @@ func (m *ActionableModel) Render() string {
 	// ══════════════════════════════════════════════════════════════════════════
 	// IMPACT SUMMARY - Highlighted recommendation
 	// ══════════════════════════════════════════════════════════════════════════
-	if m.plan.Summary.HighestImpact != "" && m.plan.Summary.UnblocksCount > 0 {
+	if m.showsSummary() {
 		summaryStyle := t.Renderer.NewStyle().
 			Foreground(t.Open).
 			Background(t.Highlight).
@@ -208,137 +448,338 @@ func (m *ActionableModel) Render() string {
 	}
 
 	// ══════════════════════════════════════════════════════════════════════════
-	// RENDER TRACKS - Card-based items with visual hierarchy
+	// RENDER TRACKS - only the window the scroll offset shows
 	// ══════════════════════════════════════════════════════════════════════════
-	for trackIdx, track := range m.plan.Tracks {
-		// Track header with pill-style badge
-		trackBadgeStyle := t.Renderer.NewStyle().
-			Foreground(t.Base.GetForeground()).
-			Background(t.Secondary).
-			Bold(true).
-			Padding(0, 1)
+	visibleLines := m.bodyLines()
+	total := 0
+	for i := range m.plan.Tracks {
+		total += m.trackLines(i)
+	}
+	startLine := min(m.scrollOffset, total-visibleLines)
+	startLine = max(startLine, 0)
+	endLine := min(startLine+visibleLines, total)
+
+	line := 0
+	for trackIdx := range m.plan.Tracks {
+		height := m.trackLines(trackIdx)
+		if line+height <= startLine {
+			line += height
+			continue
+		}
+		if line >= endLine {
+			break
+		}
+		from, to := max(startLine-line, 0), min(endLine-line, height)
+		lines = append(lines, m.renderTrackLines(trackIdx, from, to, height)...)
+		line += height
+	}
 
-		trackReasonStyle := t.Renderer.NewStyle().
-			Foreground(t.Secondary).
-			Italic(true)
+	return strings.Join(lines, "\n")
+}
+
+// renderTrackLines renders lines [from, to) of a track of the given
+// height: header, divider, item rows, then the blank line closing the
+// track
+func (m *ActionableModel) renderTrackLines(trackIdx, from, to, height int) []string {
+	t := m.theme
+	var lines []string
+	if from == 0 && to > 0 {
+		lines = append(lines, m.renderTrackHeader(m.plan.Tracks[trackIdx]))
+	}
+	if from <= 1 && to > 1 {
+		divWidth := max(m.width-4, 0)
+		lines = append(lines, t.Renderer.NewStyle().Foreground(t.Highlight).Render(strings.Repeat("·", divWidth)))
+	}
+	if lo, hi := max(from-2, 0), min(to-2, height-3); lo < hi {
+		lines = append(lines, m.renderTrackRows(trackIdx, lo, hi)...)
+	}
+	if to == height {
+		lines = append(lines, "") // Blank line between tracks
+	}
+	return lines
+}
 
-		trackNum := track.TrackID
-		if len(trackNum) > 6 {
-			trackNum = trackNum[6:] // Strip "track-" prefix
+// renderTrackRows renders item rows [lo, hi) of a track in the current
+// density
+func (m *ActionableModel) renderTrackRows(trackIdx, lo, hi int) []string {
+	items := m.plan.Tracks[trackIdx].Items
+	var lines []string
+	switch m.density {
+	case DensityMinimal:
+		per := m.idsPerRow()
+		for row := lo; row < hi; row++ {
+			lines = append(lines, m.renderIDRow(trackIdx, row*per, min((row+1)*per, len(items))))
+		}
+	case DensityDetailed:
+		row := 0
+		for itemIdx := range items {
+			if row >= hi {
+				break
+			}
+			height := m.itemHeight(trackIdx, itemIdx)
+			if row+height > lo {
+				for k, l := range m.renderDetailedItem(trackIdx, itemIdx) {
+					if row+k >= lo && row+k < hi {
+						lines = append(lines, l)
+					}
+				}
+			}
+			row += height
+		}
+	default:
+		// One line per item, with the unblocks line after the selected one
+		for row := lo; row < hi; row++ {
+			itemIdx := row
+			if trackIdx == m.selectedTrack && m.selectedShowsUnblocks() && itemIdx > m.selectedItem {
+				if itemIdx == m.selectedItem+1 {
+					lines = append(lines, m.renderUnblocks(items[m.selectedItem]))
+					continue
+				}
+				itemIdx--
+			}
+			lines = append(lines, m.renderItem(trackIdx, itemIdx))
 		}
+	}
+	return lines
+}
 
-		trackLine := trackBadgeStyle.Render(fmt.Sprintf("TRACK %s", trackNum)) +
-			" " + trackReasonStyle.Render(track.Reason)
-		lines = append(lines, trackLine)
+// renderTrackHeader renders a track's pill-style badge with its reason and
+// effort
+func (m *ActionableModel) renderTrackHeader(track analysis.ExecutionTrack) string {
+	t := m.theme
+	trackBadgeStyle := t.Renderer.NewStyle().
+		Foreground(t.Base.GetForeground()).
+		Background(t.Secondary).
+		Bold(true).
+		Padding(0, 1)
 
-		// Subtle divider
-		divWidth := m.width - 4
-		if divWidth < 0 {
-			divWidth = 0
-		}
-		lines = append(lines, t.Renderer.NewStyle().Foreground(t.Highlight).Render(strings.Repeat("·", divWidth)))
+	trackReasonStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
 
-		// Track items as mini-cards
-		for itemIdx, item := range track.Items {
-			isSelected := trackIdx == m.selectedTrack && itemIdx == m.selectedItem
+	trackNum := track.TrackID
+	if len(trackNum) > 6 {
+		trackNum = trackNum[6:] // Strip "track-" prefix
+	}
 
-			// Build the item card
-			var itemLine strings.Builder
+	badge := fmt.Sprintf("TRACK %s", trackNum)
+	if track.TrackID == pinnedTrackID {
+		badge = pinGlyph() + " PINNED"
+	}
+	trackLine := trackBadgeStyle.Render(badge) +
+		" " + trackReasonStyle.Render(track.Reason)
+	if track.EstimatedMinutes > 0 {
+		effort := fmt.Sprintf(" ⏱ ~%s", formatDuration(time.Duration(track.EstimatedMinutes)*time.Minute))
+		if track.ChainMinutes > track.EstimatedMinutes {
+			effort += fmt.Sprintf(", chain ~%s", formatDuration(time.Duration(track.ChainMinutes)*time.Minute))
+		}
+		trackLine += t.Renderer.NewStyle().Foreground(t.Subtext).Render(effort)
+	}
+	return trackLine
+}
 
-			// Selection indicator
-			if isSelected {
-				itemLine.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ "))
-			} else {
-				itemLine.WriteString("  ")
-			}
+// renderItemPrefix renders the selection mark, tree connector, priority
+// icon and ID ahead of an item's title
+func (m *ActionableModel) renderItemPrefix(trackIdx, itemIdx int) string {
+	t := m.theme
+	track := m.plan.Tracks[trackIdx]
+	item := track.Items[itemIdx]
+	isSelected := trackIdx == m.selectedTrack && itemIdx == m.selectedItem
+
+	var itemLine strings.Builder
+
+	// Selection indicator, with the bulk-selection mark beside it
+	markStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	switch {
+	case m.marked[item.ID] && isSelected:
+		itemLine.WriteString(markStyle.Render("▸" + markGlyph()))
+	case m.marked[item.ID]:
+		itemLine.WriteString(" " + markStyle.Render(markGlyph()))
+	case isSelected:
+		itemLine.WriteString(markStyle.Render("▸ "))
+	default:
+		itemLine.WriteString("  ")
+	}
 
-			// Tree connector with better styling
-			connectorStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
-			if itemIdx < len(track.Items)-1 {
-				itemLine.WriteString(connectorStyle.Render("├─ "))
-			} else {
-				itemLine.WriteString(connectorStyle.Render("└─ "))
-			}
+	// Tree connector with better styling
+	connectorStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	if itemIdx < len(track.Items)-1 {
+		itemLine.WriteString(connectorStyle.Render("├─ "))
+	} else {
+		itemLine.WriteString(connectorStyle.Render("└─ "))
+	}
 
-			// Priority badge (polished)
-			itemLine.WriteString(GetPriorityIcon(item.Priority))
-			itemLine.WriteString(" ")
+	// Priority badge (polished)
+	itemLine.WriteString(GetPriorityIcon(item.Priority))
+	itemLine.WriteString(" ")
 
-			// ID with secondary styling
-			idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
-			if isSelected {
-				idStyle = idStyle.Bold(true)
-			}
-			itemLine.WriteString(idStyle.Render(item.ID))
-			itemLine.WriteString(" ")
+	// ID with secondary styling
+	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	if isSelected {
+		idStyle = idStyle.Bold(true)
+	}
+	itemLine.WriteString(idStyle.Render(item.ID))
+	itemLine.WriteString(" ")
+	return itemLine.String()
+}
 
-			// Title with selection highlighting
-			maxTitleLen := m.width - lipgloss.Width(itemLine.String()) - 20
-			if maxTitleLen < 10 {
-				maxTitleLen = 10
-			}
-			title := truncateRunesHelper(item.Title, maxTitleLen, "…")
+// titleStyle styles an item's title, highlighted when selected
+func (m *ActionableModel) titleStyle(isSelected bool) lipgloss.Style {
+	t := m.theme
+	if isSelected {
+		return t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	}
+	return t.Renderer.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#333333", Dark: "#E8E8E8"})
+}
 
-			titleStyle := t.Renderer.NewStyle()
-			if isSelected {
-				titleStyle = titleStyle.Foreground(t.Primary).Bold(true)
-			} else {
-				titleStyle = titleStyle.Foreground(lipgloss.AdaptiveColor{Light: "#333333", Dark: "#E8E8E8"})
-			}
-			itemLine.WriteString(titleStyle.Render(title))
-
-			// Unblocks count badge
-			if len(item.UnblocksIDs) > 0 {
-				unblockBadge := t.Renderer.NewStyle().
-					Foreground(t.Open).
-					Bold(true).
-					Render(fmt.Sprintf(" →%d", len(item.UnblocksIDs)))
-				itemLine.WriteString(unblockBadge)
-			}
+// itemLineStyle styles a whole line of an item, with the selection
+// background when selected
+func (m *ActionableModel) itemLineStyle(isSelected bool) lipgloss.Style {
+	lineStyle := m.theme.Renderer.NewStyle().Width(m.width - 2)
+	if isSelected {
+		lineStyle = lineStyle.Background(m.theme.Highlight)
+	}
+	return lineStyle
+}
 
-			// Style the line with background if selected
-			lineStyle := t.Renderer.NewStyle().Width(m.width - 2)
-			if isSelected {
-				lineStyle = lineStyle.Background(t.Highlight)
-			}
+// renderItem renders a plan item as a mini-card
+func (m *ActionableModel) renderItem(trackIdx, itemIdx int) string {
+	t := m.theme
+	item := m.plan.Tracks[trackIdx].Items[itemIdx]
+	isSelected := trackIdx == m.selectedTrack && itemIdx == m.selectedItem
 
-			lines = append(lines, lineStyle.Render(itemLine.String()))
-
-			// Show unblocks detail for selected item
-			if isSelected && len(item.UnblocksIDs) > 0 {
-				unblocksStyle := t.Renderer.NewStyle().
-					Foreground(t.Feature).
-					Italic(true).
-					PaddingLeft(8)
-				unblocksText := "↳ Unblocks: " + strings.Join(item.UnblocksIDs, ", ")
-				unblocksText = truncateRunesHelper(unblocksText, m.width-12, "...")
-				lines = append(lines, unblocksStyle.Render(unblocksText))
-			}
+	// Build the item card
+	var itemLine strings.Builder
+	itemLine.WriteString(m.renderItemPrefix(trackIdx, itemIdx))
+
+	// Title with selection highlighting
+	maxTitleLen := m.width - lipgloss.Width(itemLine.String()) - 20
+	if maxTitleLen < 10 {
+		maxTitleLen = 10
+	}
+	title := truncateRunesHelper(item.Title, maxTitleLen, "…")
+	itemLine.WriteString(m.titleStyle(isSelected).Render(title))
+
+	// Unblocks count badge, with the cascade total when it reaches further
+	if len(item.UnblocksIDs) > 0 {
+		badge := fmt.Sprintf(" →%d", len(item.UnblocksIDs))
+		if item.TransitiveUnblocks > len(item.UnblocksIDs) {
+			badge += fmt.Sprintf(" (%d)", item.TransitiveUnblocks)
 		}
+		unblockBadge := t.Renderer.NewStyle().
+			Foreground(t.Open).
+			Bold(true).
+			Render(badge)
+		itemLine.WriteString(unblockBadge)
+	}
 
-		lines = append(lines, "") // Blank line between tracks
+	if item.OverWIP {
+		itemLine.WriteString(t.Renderer.NewStyle().Foreground(t.Blocked).Render(" ⏸ over WIP"))
 	}
 
-	// ══════════════════════════════════════════════════════════════════════════
-	// APPLY SCROLL OFFSET
-	// ══════════════════════════════════════════════════════════════════════════
-	visibleLines := m.height - 2
-	if visibleLines < 1 {
-		visibleLines = 1
+	// Style the line with background if selected
+	return m.itemLineStyle(isSelected).Render(itemLine.String())
+}
+
+// renderDetailedItem renders a plan item for the detailed density: its
+// title wrapped under the ID, then a line with its status, assignee,
+// labels, estimate and what it unblocks
+func (m *ActionableModel) renderDetailedItem(trackIdx, itemIdx int) []string {
+	t := m.theme
+	track := m.plan.Tracks[trackIdx]
+	item := track.Items[itemIdx]
+	isSelected := trackIdx == m.selectedTrack && itemIdx == m.selectedItem
+	lineStyle := m.itemLineStyle(isSelected)
+	titleStyle := m.titleStyle(isSelected)
+
+	// Continuation lines keep the tree connector running and line up with
+	// the title
+	connector := "│  "
+	if itemIdx == len(track.Items)-1 {
+		connector = "   "
 	}
+	indent := "  " + t.Renderer.NewStyle().Foreground(t.Subtext).Render(connector) +
+		strings.Repeat(" ", m.itemPrefixWidth(item)-5)
 
-	startLine := m.scrollOffset
-	if startLine > len(lines)-visibleLines {
-		startLine = len(lines) - visibleLines
+	title := m.detailedTitle(item)
+	first := m.renderItemPrefix(trackIdx, itemIdx) + titleStyle.Render(title[0])
+	if item.OverWIP {
+		first += t.Renderer.NewStyle().Foreground(t.Blocked).Render(" ⏸ over WIP")
+	}
+	lines := []string{lineStyle.Render(first)}
+	for _, l := range title[1:] {
+		lines = append(lines, lineStyle.Render(indent+titleStyle.Render(l)))
+	}
+
+	details := []string{item.Status}
+	if item.Assignee != "" {
+		details = append(details, "@"+item.Assignee)
+	} else if item.SuggestedAssignee != "" {
+		details = append(details, "@"+item.SuggestedAssignee+"?")
+	}
+	if issue, ok := m.issueMap[item.ID]; ok && len(issue.Labels) > 0 {
+		details = append(details, strings.Join(issue.Labels, ", "))
 	}
-	if startLine < 0 {
-		startLine = 0
+	if item.EstimatedMinutes > 0 {
+		details = append(details, "⏱ ~"+formatDuration(time.Duration(item.EstimatedMinutes)*time.Minute))
 	}
+	if len(item.UnblocksIDs) > 0 {
+		unblocks := "→ " + strings.Join(item.UnblocksIDs, ", ")
+		if item.TransitiveUnblocks > len(item.UnblocksIDs) {
+			unblocks += fmt.Sprintf(" (%d)", item.TransitiveUnblocks)
+		}
+		details = append(details, unblocks)
+	}
+	detail := truncateRunesHelper(strings.Join(details, " · "), max(m.width-2-lipgloss.Width(indent)-1, 10), "…")
+	lines = append(lines, lineStyle.Render(indent+t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).Render(detail)))
+	return lines
+}
 
-	endLine := startLine + visibleLines
-	if endLine > len(lines) {
-		endLine = len(lines)
+// renderIDRow renders items [from, to) of a track as a row of IDs for the
+// minimal density
+func (m *ActionableModel) renderIDRow(trackIdx, from, to int) string {
+	t := m.theme
+	items := m.plan.Tracks[trackIdx].Items
+	markStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+
+	var row strings.Builder
+	row.WriteString("  ")
+	for itemIdx := from; itemIdx < to; itemIdx++ {
+		item := items[itemIdx]
+		isSelected := trackIdx == m.selectedTrack && itemIdx == m.selectedItem
+		switch {
+		case m.marked[item.ID]:
+			row.WriteString(markStyle.Render(markGlyph()))
+		case isSelected:
+			row.WriteString(markStyle.Render("▸"))
+		default:
+			row.WriteString(" ")
+		}
+		idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+		if item.OverWIP {
+			idStyle = idStyle.Foreground(t.Blocked)
+		}
+		if isSelected {
+			idStyle = idStyle.Foreground(t.Primary).Bold(true).Background(t.Highlight)
+		}
+		row.WriteString(idStyle.Render(item.ID))
+		row.WriteString(strings.Repeat(" ", m.idWidth-lipgloss.Width(item.ID)+3))
 	}
+	return row.String()
+}
 
-	return strings.Join(lines[startLine:endLine], "\n")
+// renderUnblocks renders the line under the selected item listing what it
+// unblocks
+func (m *ActionableModel) renderUnblocks(item analysis.PlanItem) string {
+	t := m.theme
+	unblocksStyle := t.Renderer.NewStyle().
+		Foreground(t.Feature).
+		Italic(true).
+		PaddingLeft(8)
+	unblocksText := "↳ Unblocks: " + strings.Join(item.UnblocksIDs, ", ")
+	if item.TransitiveUnblocks > len(item.UnblocksIDs) {
+		unblocksText += fmt.Sprintf(" (%d total including cascades)", item.TransitiveUnblocks)
+	}
+	unblocksText = truncateRunesHelper(unblocksText, m.width-12, "...")
+	return unblocksStyle.Render(unblocksText)
 }
@@ -1,10 +1,12 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -75,3 +77,145 @@ func TestActionableRenderShowsSummary(t *testing.T) {
 		t.Fatalf("expected unblocks count badge, got:\n%s", out)
 	}
 }
+
+func TestActionableRenderShowsOverWIP(t *testing.T) {
+	plan := analysis.ExecutionPlan{
+		Tracks: []analysis.ExecutionTrack{
+			{TrackID: "track-A", Reason: "Independent work stream (batch 1 of 2)", Batch: 1, Batches: 2,
+				Items: []analysis.PlanItem{{ID: "A1", Title: "First"}}},
+			{TrackID: "track-A/2", Reason: "Independent work stream (batch 2 of 2)", Batch: 2, Batches: 2,
+				Items: []analysis.PlanItem{{ID: "A2", Title: "Second", OverWIP: true}}},
+		},
+		GlobalWIPLimit: 1,
+		OverWIP:        1,
+	}
+	m := NewActionableModel(plan, newTestTheme())
+	m.SetSize(120, 30)
+
+	out := m.Render()
+	for _, want := range []string{"⚠ 1 over WIP limit 1: A2", "TRACK A/2", "⏸ over WIP"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in render, got:\n%s", want, out)
+		}
+	}
+
+	plan.OverWIP = 0
+	plan.Tracks[1].Items[0].OverWIP = false
+	m = NewActionableModel(plan, newTestTheme())
+	m.SetSize(120, 30)
+	if out := m.Render(); !strings.Contains(out, "WIP limit 1 ✓") || strings.Contains(out, "over WIP") {
+		t.Errorf("expected a clean WIP header, got:\n%s", out)
+	}
+}
+
+func TestActionableRenderShowsTrackEffort(t *testing.T) {
+	plan := analysis.ExecutionPlan{
+		Tracks: []analysis.ExecutionTrack{
+			{TrackID: "track-A", Reason: "Independent work stream", EstimatedMinutes: 90, ChainMinutes: 600,
+				Items: []analysis.PlanItem{{ID: "B1", Title: "Quick fix", EstimatedMinutes: 90, ChainMinutes: 600}}},
+			{TrackID: "track-B", Reason: "Single actionable item",
+				Items: []analysis.PlanItem{{ID: "C1", Title: "Unestimated"}}},
+		},
+	}
+	m := NewActionableModel(plan, newTestTheme())
+	m.SetSize(120, 30)
+
+	out := m.Render()
+	if !strings.Contains(out, "⏱ ~1h, chain ~10h") {
+		t.Errorf("expected track effort in header, got:\n%s", out)
+	}
+	if strings.Count(out, "⏱") != 1 {
+		t.Errorf("tracks without estimates should not show effort, got:\n%s", out)
+	}
+}
+
+func TestActionableRendersOnlyTheVisibleWindow(t *testing.T) {
+	var tracks []analysis.ExecutionTrack
+	for tr := 0; tr < 50; tr++ {
+		track := analysis.ExecutionTrack{TrackID: fmt.Sprintf("track-%d", tr)}
+		for i := 0; i < 200; i++ {
+			item := analysis.PlanItem{ID: fmt.Sprintf("T%d-%d", tr, i), Title: "Item"}
+			if i%2 == 0 {
+				item.UnblocksIDs = []string{"X"}
+			}
+			track.Items = append(track.Items, item)
+		}
+		tracks = append(tracks, track)
+	}
+	m := NewActionableModel(analysis.ExecutionPlan{Tracks: tracks}, newTestTheme())
+	m.SetSize(100, 24)
+
+	for _, id := range []string{"T0-0", "T0-199", "T1-0", "T24-100", "T49-199"} {
+		if !m.SelectByID(id) {
+			t.Fatalf("SelectByID(%s) failed", id)
+		}
+		out := m.Render()
+		if n := strings.Count(out, "\n") + 1; n != 22 {
+			t.Errorf("%s: render is %d lines, want the 22 that fit", id, n)
+		}
+		if !strings.Contains(out, "ACTIONABLE ITEMS") {
+			t.Errorf("%s: header should stay put while scrolling", id)
+		}
+		if !strings.Contains(out, "▸ ") || !strings.Contains(out, id+" ") {
+			t.Errorf("%s: selected item not on screen:\n%s", id, out)
+		}
+	}
+
+	// Moving onto an item with unblocks shows its detail line too
+	m.SelectByID("T49-198")
+	if out := m.Render(); !strings.Contains(out, "Unblocks: X") {
+		t.Errorf("unblocks line under the selection should be on screen:\n%s", out)
+	}
+	m.MoveDown()
+	if out := m.Render(); !strings.Contains(out, "T49-199") {
+		t.Errorf("last item should be on screen:\n%s", out)
+	}
+}
+
+func TestActionableDensities(t *testing.T) {
+	var items []analysis.PlanItem
+	for i := 0; i < 40; i++ {
+		items = append(items, analysis.PlanItem{ID: fmt.Sprintf("D-%d", i), Title: "Item", Status: "open"})
+	}
+	items[0] = analysis.PlanItem{ID: "D-0", Status: "open", Assignee: "ann", EstimatedMinutes: 120,
+		Title:       "A long title that wraps onto a second line once the width of the terminal runs out",
+		UnblocksIDs: []string{"X", "Y"}, TransitiveUnblocks: 5}
+	plan := analysis.ExecutionPlan{Tracks: []analysis.ExecutionTrack{{TrackID: "track-A", Items: items}}}
+	m := NewActionableModel(plan, newTestTheme())
+	m.SetIssueMap(map[string]*model.Issue{"D-0": {ID: "D-0", Labels: []string{"api", "auth"}}})
+	m.SetSize(80, 24)
+
+	if m.Density() != DensityCompact || m.Density().Next() != DensityDetailed {
+		t.Fatalf("should start compact, then detailed")
+	}
+
+	m.SetDensity(DensityDetailed)
+	out := m.Render()
+	for _, want := range []string{"detailed", "second line", "@ann", "api, auth", "⏱ ~2h", "→ X, Y (5)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("detailed render missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Unblocks:") {
+		t.Error("detailed density lists unblocks inline, not on its own line")
+	}
+	// Two lines per short item plus three for the first: still scrolls
+	m.SelectByID("D-39")
+	if out := m.Render(); !strings.Contains(out, "D-39") || strings.Count(out, "\n")+1 != 22 {
+		t.Errorf("last item should be on screen in 22 lines:\n%s", out)
+	}
+
+	// Minimal packs the IDs several to a row
+	m.SetDensity(DensityMinimal)
+	out = m.Render()
+	if !strings.Contains(out, "minimal") || strings.Contains(out, "Item") {
+		t.Errorf("minimal render should show IDs only:\n%s", out)
+	}
+	if m.idsPerRow() < 4 || !strings.Contains(out, "D-0") || !strings.Contains(out, "▸D-39") {
+		t.Errorf("minimal render should fit every ID, %d per row:\n%s", m.idsPerRow(), out)
+	}
+	m.MoveUp()
+	if got := m.SelectedIssueID(); got != "D-38" {
+		t.Errorf("k should still move one item, at %s", got)
+	}
+}
@@ -85,7 +85,7 @@ func (m AgentPromptModal) View() string {
 
 	// Modal container style
 	modalStyle := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(m.theme.Primary).
 		Padding(1, 2).
 		Width(m.width)
@@ -102,7 +102,7 @@ func (m AgentPromptModal) View() string {
 
 	// Preview box
 	previewBoxStyle := r.NewStyle().
-		Border(lipgloss.NormalBorder()).
+		Border(termBorder(lipgloss.NormalBorder())).
 		BorderForeground(m.theme.Border).
 		Padding(0, 1).
 		Width(m.width - 8).
@@ -123,7 +123,7 @@ func (m AgentPromptModal) View() string {
 		Bold(true)
 
 	unselectedButton := buttonBase.
-		Border(lipgloss.NormalBorder()).
+		Border(termBorder(lipgloss.NormalBorder())).
 		BorderForeground(m.theme.Border)
 
 	muteButton := buttonBase.
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// analysisProgressInterval is how often the status bar spinner turns while
+// Phase 2 metrics compute, and how often finished metrics are picked up
+const analysisProgressInterval = 120 * time.Millisecond
+
+// analysisProgressMsg turns the metrics spinner and refines the list with
+// the metrics that finished since the last tick
+type analysisProgressMsg struct {
+	stats *analysis.GraphStats // The run being watched, to drop stale ticks
+}
+
+// analysisProgressCmd ticks while the given Phase 2 run is in progress
+func analysisProgressCmd(stats *analysis.GraphStats) tea.Cmd {
+	if stats == nil || stats.IsPhase2Ready() {
+		return nil
+	}
+	return tea.Tick(analysisProgressInterval, func(time.Time) tea.Msg {
+		return analysisProgressMsg{stats: stats}
+	})
+}
+
+// watchAnalysis starts following a new Phase 2 run: the progress ticks
+// alongside the wait for its completion
+func (m *Model) watchAnalysis(stats *analysis.GraphStats) tea.Cmd {
+	m.analysisSeen = 0
+	return tea.Batch(WaitForPhase2Cmd(stats), analysisProgressCmd(stats))
+}
+
+// handleAnalysisProgress refreshes list scores as metrics such as PageRank
+// and critical path land, so the list refines before Phase 2 is ready.
+// Phase2ReadyMsg does the full refresh once everything is in.
+func (m *Model) handleAnalysisProgress(msg analysisProgressMsg) tea.Cmd {
+	if msg.stats != m.analysis || msg.stats.IsPhase2Ready() {
+		return nil
+	}
+	m.analysisSpinnerIdx = (m.analysisSpinnerIdx + 1) % len(workerSpinnerFrames)
+	if done, _ := msg.stats.Progress(); done > m.analysisSeen {
+		m.analysisSeen = done
+		m.refreshListItemsPhase2()
+	}
+	return analysisProgressCmd(msg.stats)
+}
+
+// analysisProgressLabel is the status bar's note on Phase 2, e.g.
+// "⠹ metrics 3/7", or empty once the metrics are in
+func (m Model) analysisProgressLabel() string {
+	if m.analysis == nil || m.analysis.IsPhase2Ready() {
+		return ""
+	}
+	done, total := m.analysis.Progress()
+	return metricsProgressLabel(m.analysisSpinnerIdx, done, total)
+}
+
+// metricsProgressLabel renders a spinner frame with the metrics finished
+func metricsProgressLabel(frame, done, total int) string {
+	spinner := workerSpinnerFrames[frame%len(workerSpinnerFrames)]
+	if total == 0 {
+		return spinner + " metrics…"
+	}
+	return fmt.Sprintf("%s metrics %d/%d", spinner, done, total)
+}
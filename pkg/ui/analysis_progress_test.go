@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+func TestMetricsProgressLabel(t *testing.T) {
+	if got := metricsProgressLabel(0, 3, 7); got != "⠋ metrics 3/7" {
+		t.Errorf("label = %q", got)
+	}
+	if got := metricsProgressLabel(len(workerSpinnerFrames)+1, 0, 0); got != "⠙ metrics…" {
+		t.Errorf("label before the run starts = %q", got)
+	}
+}
+
+func TestAnalysisProgressStopsWhenReadyOrStale(t *testing.T) {
+	m := NewModel(generateFlatIssues(4), nil, "")
+	m.analysis.WaitForPhase2()
+	if got := m.analysisProgressLabel(); got != "" {
+		t.Errorf("no progress once Phase 2 is ready, got %q", got)
+	}
+	if cmd := m.handleAnalysisProgress(analysisProgressMsg{stats: m.analysis}); cmd != nil {
+		t.Error("ticks should stop once Phase 2 is ready")
+	}
+
+	stale := analysis.NewAnalyzer(generateFlatIssues(2)).AnalyzeAsync(context.Background())
+	if cmd := m.handleAnalysisProgress(analysisProgressMsg{stats: stale}); cmd != nil {
+		t.Error("ticks from a replaced run should stop")
+	}
+	if m.analysisSpinnerIdx != 0 {
+		t.Error("stale ticks shouldn't turn the spinner")
+	}
+}
@@ -0,0 +1,387 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// assigneeGroup is one person's open work, or the unassigned issues
+type assigneeGroup struct {
+	name    string        // "" for the unassigned section
+	issues  []model.Issue // in progress first, then blocked, then open
+	wip     int           // in_progress issues
+	blocked int           // blocked, by status or by an open blocker
+	limit   int           // WIP limit, 0 = none
+}
+
+// overLimit reports whether the person has more going than their limit
+func (g assigneeGroup) overLimit() bool {
+	return g.limit > 0 && g.wip > g.limit
+}
+
+// AssigneeModel groups open issues by assignee with each person's work in
+// progress and blocked counts, people over their WIP limit first and the
+// unassigned issues last.
+type AssigneeModel struct {
+	groups       []assigneeGroup
+	waitsOn      map[string][]string // open blockers of each issue shown
+	opts         analysis.PlanOptions
+	rows         []string // issue IDs in display order
+	selected     int
+	scrollOffset int
+	width        int
+	height       int
+	theme        Theme
+}
+
+// NewAssigneeModel groups the open issues of issues that shown keeps (all
+// when nil), taking WIP limits from opts. Blockers are looked up among all
+// of issues, so ones the filter hides still count.
+func NewAssigneeModel(issues []model.Issue, shown func(model.Issue) bool, opts analysis.PlanOptions, theme Theme) AssigneeModel {
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+
+	m := AssigneeModel{waitsOn: make(map[string][]string), opts: opts, theme: theme}
+	byName := make(map[string]*assigneeGroup)
+	unassigned := &assigneeGroup{}
+	for _, issue := range issues {
+		if isClosedLikeStatus(issue.Status) || (shown != nil && !shown(issue)) {
+			continue
+		}
+		g := unassigned
+		if issue.Assignee != "" {
+			if g = byName[issue.Assignee]; g == nil {
+				g = &assigneeGroup{name: issue.Assignee, limit: opts.LimitFor(issue.Assignee)}
+				byName[issue.Assignee] = g
+			}
+		}
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if blocker, ok := byID[dep.DependsOnID]; ok && !isClosedLikeStatus(blocker.Status) {
+				m.waitsOn[issue.ID] = append(m.waitsOn[issue.ID], dep.DependsOnID)
+			}
+		}
+		g.issues = append(g.issues, issue)
+		switch {
+		case issue.Status == model.StatusInProgress:
+			g.wip++
+		case issue.Status == model.StatusBlocked || len(m.waitsOn[issue.ID]) > 0:
+			g.blocked++
+		}
+	}
+
+	for _, g := range byName {
+		m.groups = append(m.groups, *g)
+	}
+	sort.Slice(m.groups, func(i, j int) bool {
+		a, b := m.groups[i], m.groups[j]
+		if a.overLimit() != b.overLimit() {
+			return a.overLimit()
+		}
+		if a.wip != b.wip {
+			return a.wip > b.wip
+		}
+		if len(a.issues) != len(b.issues) {
+			return len(a.issues) > len(b.issues)
+		}
+		return a.name < b.name
+	})
+	if len(unassigned.issues) > 0 {
+		m.groups = append(m.groups, *unassigned)
+	}
+
+	for gi := range m.groups {
+		items := m.groups[gi].issues
+		sort.SliceStable(items, func(i, j int) bool {
+			ri, rj := m.stateRank(items[i]), m.stateRank(items[j])
+			if ri != rj {
+				return ri < rj
+			}
+			if items[i].Priority != items[j].Priority {
+				return items[i].Priority < items[j].Priority
+			}
+			return items[i].ID < items[j].ID
+		})
+		for _, issue := range items {
+			m.rows = append(m.rows, issue.ID)
+		}
+	}
+	return m
+}
+
+// stateRank orders a person's issues: in progress, blocked, then open
+func (m *AssigneeModel) stateRank(issue model.Issue) int {
+	switch {
+	case issue.Status == model.StatusInProgress:
+		return 0
+	case issue.Status == model.StatusBlocked || len(m.waitsOn[issue.ID]) > 0:
+		return 1
+	}
+	return 2
+}
+
+// SetSize updates the view dimensions
+func (m *AssigneeModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// MoveDown moves selection down
+func (m *AssigneeModel) MoveDown() {
+	if m.selected < len(m.rows)-1 {
+		m.selected++
+	}
+	m.ensureVisible()
+}
+
+// MoveUp moves selection up
+func (m *AssigneeModel) MoveUp() {
+	if m.selected > 0 {
+		m.selected--
+	}
+	m.ensureVisible()
+}
+
+// SelectedIssueID returns the ID of the currently selected issue
+func (m *AssigneeModel) SelectedIssueID() string {
+	if m.selected >= 0 && m.selected < len(m.rows) {
+		return m.rows[m.selected]
+	}
+	return ""
+}
+
+// SelectByID selects the given issue, reporting whether it is shown
+func (m *AssigneeModel) SelectByID(id string) bool {
+	for i, rowID := range m.rows {
+		if rowID == id {
+			m.selected = i
+			m.ensureVisible()
+			return true
+		}
+	}
+	return false
+}
+
+// OverLimit returns the people over their WIP limit, in the order shown
+func (m *AssigneeModel) OverLimit() []string {
+	var names []string
+	for _, g := range m.groups {
+		if g.overLimit() {
+			names = append(names, g.name)
+		}
+	}
+	return names
+}
+
+// lineOf returns the body line index of row idx: each group has a header
+// line and is followed by a blank line
+func (m *AssigneeModel) lineOf(idx int) int {
+	line, row := 0, 0
+	for _, g := range m.groups {
+		if idx < row+len(g.issues) {
+			return line + 1 + idx - row
+		}
+		line += len(g.issues) + 2
+		row += len(g.issues)
+	}
+	return line
+}
+
+func (m *AssigneeModel) ensureVisible() {
+	visible := m.height - 3
+	if visible < 3 {
+		visible = 3
+	}
+	line := m.lineOf(m.selected)
+	// Keep the person's header in view above their first issue
+	if line-1 < m.scrollOffset {
+		m.scrollOffset = max(line-1, 0)
+	}
+	if line >= m.scrollOffset+visible {
+		m.scrollOffset = line - visible + 1
+	}
+}
+
+// limitLabel describes the configured WIP limits for the header
+func (m *AssigneeModel) limitLabel() string {
+	switch {
+	case m.opts.WIPLimit > 0 && len(m.opts.WIPLimits) > 0:
+		return fmt.Sprintf("WIP limit %d, %d per person", m.opts.WIPLimit, len(m.opts.WIPLimits))
+	case m.opts.WIPLimit > 0:
+		return fmt.Sprintf("WIP limit %d", m.opts.WIPLimit)
+	case len(m.opts.WIPLimits) > 0:
+		return fmt.Sprintf("WIP limits for %d people", len(m.opts.WIPLimits))
+	}
+	return "no WIP limit (--wip-limit)"
+}
+
+// Render renders the assignee view
+func (m *AssigneeModel) Render() string {
+	if m.width == 0 || m.height == 0 {
+		return ""
+	}
+	t := m.theme
+
+	headerStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Base.GetForeground()).
+		Background(t.Primary).
+		Padding(0, 2).
+		Width(m.width - 4)
+
+	people := len(m.groups)
+	if people > 0 && m.groups[people-1].name == "" {
+		people--
+	}
+	header := fmt.Sprintf("👥 BY ASSIGNEE  │  %d people · %d open  │  %s", people, len(m.rows), m.limitLabel())
+	if over := m.OverLimit(); len(over) > 0 {
+		header += fmt.Sprintf("  │  ⚠ %d over", len(over))
+	}
+
+	if len(m.rows) == 0 {
+		return headerStyle.Render(header) + "\n\n" +
+			t.Renderer.NewStyle().Foreground(t.Muted).Render("No open issues.")
+	}
+
+	var body []string
+	row := 0
+	for _, g := range m.groups {
+		body = append(body, m.renderGroupHeader(g))
+		for _, issue := range g.issues {
+			body = append(body, m.renderRow(issue, row == m.selected))
+			row++
+		}
+		body = append(body, "")
+	}
+
+	visible := m.height - 3
+	if visible < 1 {
+		visible = 1
+	}
+	start := min(m.scrollOffset, len(body))
+	end := min(start+visible, len(body))
+
+	legend := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).
+		Render("WIP = in progress  •  ⊘ blocked  •  j/k move  •  enter open  •  esc back")
+
+	return headerStyle.Render(header) + "\n" + strings.Join(body[start:end], "\n") + "\n" + legend
+}
+
+func (m *AssigneeModel) renderGroupHeader(g assigneeGroup) string {
+	t := m.theme
+	nameStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	countStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+
+	name := g.name
+	if name == "" {
+		name = "UNASSIGNED"
+		nameStyle = t.Renderer.NewStyle().Foreground(t.Muted).Bold(true)
+	}
+
+	wip := fmt.Sprintf("%d WIP", g.wip)
+	if g.limit > 0 {
+		wip = fmt.Sprintf("%d/%d WIP", g.wip, g.limit)
+	}
+	if g.overLimit() {
+		nameStyle = t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true)
+		wip = t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true).
+			Render(fmt.Sprintf("⚠ %s, over by %d", wip, g.wip-g.limit))
+	} else {
+		wip = countStyle.Render(wip)
+	}
+
+	counts := countStyle.Render(fmt.Sprintf(" · %d blocked · %d open", g.blocked, len(g.issues)))
+	return nameStyle.Render(name) + "  " + wip + counts
+}
+
+func (m *AssigneeModel) renderRow(issue model.Issue, selected bool) string {
+	t := m.theme
+
+	var sb strings.Builder
+	if selected {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ "))
+	} else {
+		sb.WriteString("  ")
+	}
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.GetStatusColor(string(issue.Status))).
+		Render(GetStatusIcon(string(issue.Status))))
+	sb.WriteString(" ")
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(issue.ID))
+	sb.WriteString(" ")
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Subtext).Render(GetPriorityLabel(issue.Priority)))
+	sb.WriteString(" ")
+
+	suffix := ""
+	if blockers := m.waitsOn[issue.ID]; len(blockers) > 0 {
+		suffix = "  " + glyph("⊘", "x") + " " + strings.Join(blockers, ",")
+	} else if issue.Status == model.StatusBlocked {
+		suffix = "  " + glyph("⊘", "x") + " blocked"
+	}
+	maxTitle := m.width - 16 - len([]rune(issue.ID)) - len([]rune(suffix))
+	if maxTitle < 10 {
+		maxTitle = 10
+	}
+	sb.WriteString(truncateRunesHelper(issue.Title, maxTitle, "…"))
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Blocked).Render(suffix))
+
+	lineStyle := t.Renderer.NewStyle().Width(m.width - 2)
+	if selected {
+		lineStyle = lineStyle.Background(t.Highlight)
+	}
+	return lineStyle.Render(sb.String())
+}
+
+// openAssignees groups the open issues the filter bar's query shows by
+// assignee and focuses the view
+func (m Model) openAssignees() Model {
+	m.clearAttentionOverlay()
+	m.isGraphView = false
+	m.isBoardView = false
+	m.isActionableView = false
+	m.isHistoryView = false
+	var shown func(model.Issue) bool
+	if m.queryRecipe() != nil {
+		shown = m.queryKeeps
+	}
+	m.assigneeView = NewAssigneeModel(m.issues, shown, m.planOptions, m.theme)
+	m.assigneeView.SetSize(m.width, m.height-1)
+	m.focused = focusAssignees
+	return m
+}
+
+// handleAssigneeKeys handles keyboard input when the assignee view is focused
+func (m Model) handleAssigneeKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.assigneeView.MoveDown()
+	case "k", "up":
+		m.assigneeView.MoveUp()
+	case "/":
+		m = m.openIssueSearch()
+	case "A", "esc":
+		m.focused = focusList
+	case "enter":
+		// Open the selected issue in the detail view
+		selectedID := m.assigneeView.SelectedIssueID()
+		if selectedID == "" || !m.selectInList(selectedID) {
+			return m
+		}
+		m.focused = focusDetail
+		if !m.isSplitView {
+			m.showDetails = true
+			m.viewport.GotoTop()
+		}
+		m.updateViewportContent()
+	}
+	return m
+}
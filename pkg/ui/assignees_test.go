@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestAssigneeView(t *testing.T) {
+	// ann has three in progress and one waiting on a blocker, bob one in
+	// progress and a blocked issue, two issues unassigned
+	issues := []model.Issue{
+		{ID: "a1", Title: "Ann one", Status: model.StatusInProgress, Assignee: "ann", Priority: 2},
+		{ID: "a2", Title: "Ann two", Status: model.StatusInProgress, Assignee: "ann", Priority: 1},
+		{ID: "a3", Title: "Ann three", Status: model.StatusInProgress, Assignee: "ann", Priority: 3},
+		{ID: "a4", Title: "Ann waits", Status: model.StatusOpen, Assignee: "ann",
+			Dependencies: []*model.Dependency{{IssueID: "a4", DependsOnID: "u1", Type: model.DepBlocks}}},
+		{ID: "a5", Title: "Ann done", Status: model.StatusClosed, Assignee: "ann"},
+		{ID: "b1", Title: "Bob one", Status: model.StatusInProgress, Assignee: "bob"},
+		{ID: "b2", Title: "Bob stuck", Status: model.StatusBlocked, Assignee: "bob"},
+		{ID: "u1", Title: "Nobody's", Status: model.StatusOpen},
+		{ID: "u2", Title: "Also nobody's", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "u2", DependsOnID: "a5", Type: model.DepBlocks}}},
+	}
+
+	t.Run("groups open work", func(t *testing.T) {
+		m := NewAssigneeModel(issues, nil, analysis.PlanOptions{WIPLimit: 2}, newTestTheme())
+		m.SetSize(120, 30)
+
+		// Over-limit first, in progress then blocked then open, unassigned last;
+		// closed issues and closed blockers don't count
+		if got := strings.Join(m.rows, " "); got != "a2 a1 a3 a4 b1 b2 u1 u2" {
+			t.Fatalf("rows = %q", got)
+		}
+		if got := m.OverLimit(); len(got) != 1 || got[0] != "ann" {
+			t.Errorf("over limit = %v", got)
+		}
+		out := m.Render()
+		for _, want := range []string{"BY ASSIGNEE", "2 people · 8 open", "WIP limit 2", "⚠ 1 over",
+			"⚠ 3/2 WIP, over by 1", "1/2 WIP", "UNASSIGNED", "⊘ u1", "⊘ blocked"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("render missing %q:\n%s", want, out)
+			}
+		}
+		if strings.Contains(out, "Ann done") || strings.Contains(out, "⊘ a5") {
+			t.Error("closed issues should be left out")
+		}
+		if !strings.Contains(out, "ann  ") || !strings.Contains(out, "1 blocked · 4 open") {
+			t.Errorf("ann's counts missing:\n%s", out)
+		}
+
+		// Per-person limits override the default; 0 lifts it
+		m = NewAssigneeModel(issues, nil, analysis.PlanOptions{WIPLimits: map[string]int{"ann": 0, "bob": 0}}, newTestTheme())
+		if len(m.OverLimit()) != 0 || m.rows[0] != "a2" {
+			t.Errorf("no one should be over, rows %v", m.rows)
+		}
+		m.SetSize(120, 30)
+		if !strings.Contains(m.Render(), "WIP limits for 2 people") {
+			t.Error("header should describe the per-person limits")
+		}
+	})
+
+	t.Run("opens from list and returns", func(t *testing.T) {
+		m := NewModel(issues, nil, "")
+		m.SetPlanOptions(analysis.PlanOptions{WIPLimit: 2})
+		m = pressKey(m, "A")
+		if m.CurrentContext() != ContextAssignees {
+			t.Fatalf("A should open the assignee view, context %s", m.CurrentContext())
+		}
+		if !strings.Contains(m.View(), "BY ASSIGNEE") {
+			t.Error("view should render the assignee view")
+		}
+		m = pressKey(m, "j")
+		if got := m.assigneeView.SelectedIssueID(); got != "a1" {
+			t.Errorf("j should move to the next issue, at %s", got)
+		}
+		if back := pressKey(m, "A"); back.focused != focusList {
+			t.Errorf("A should return to the list, focus %s", back.FocusState())
+		}
+
+		m = pressKey(m, "enter")
+		if m.focused != focusDetail || m.selectedIssueID() != "a1" {
+			t.Errorf("enter should open the issue, focus %s at %s", m.FocusState(), m.selectedIssueID())
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// Background picks which variant of the theme's adaptive colors to draw
+// with. Auto follows the terminal's background.
+type Background string
+
+const (
+	BackgroundAuto  Background = ""
+	BackgroundLight Background = "light"
+	BackgroundDark  Background = "dark"
+)
+
+// UnmarshalYAML accepts auto, light or dark
+func (b *Background) UnmarshalYAML(node *yaml.Node) error {
+	var s string
+	if err := node.Decode(&s); err != nil {
+		return err
+	}
+	switch Background(strings.ToLower(s)) {
+	case "auto", BackgroundAuto:
+		*b = BackgroundAuto
+	case BackgroundLight:
+		*b = BackgroundLight
+	case BackgroundDark:
+		*b = BackgroundDark
+	default:
+		return fmt.Errorf("line %d: invalid background %q (want auto, light or dark)", node.Line, s)
+	}
+	return nil
+}
+
+// isDark resolves the background, with detected standing in for auto
+func (b Background) isDark(detected bool) bool {
+	switch b {
+	case BackgroundLight:
+		return false
+	case BackgroundDark:
+		return true
+	}
+	return detected
+}
+
+// colorFGBGIsDark reads COLORFGBG, which rxvt, Konsole and others set to
+// the ANSI colors of their profile as "fg;bg" or "fg;default;bg". Like vim,
+// it counts background colors 0-6 and 8 as dark. ok is false when the
+// value doesn't name a background color.
+func colorFGBGIsDark(v string) (dark, ok bool) {
+	parts := strings.Split(v, ";")
+	if len(parts) < 2 {
+		return false, false
+	}
+	bg, err := strconv.Atoi(strings.TrimSpace(parts[len(parts)-1]))
+	if err != nil || bg < 0 || bg > 15 {
+		return false, false
+	}
+	return bg <= 6 || bg == 8, true
+}
+
+// DetectDarkBackground reports whether the terminal's background is dark.
+// COLORFGBG wins when it is set, since it needs no round trip to the
+// terminal and works inside tmux and screen, which can't answer the query
+// r otherwise sends (OSC 11). A terminal that can't be asked counts as dark.
+func DetectDarkBackground(getenv func(string) string, r *lipgloss.Renderer) bool {
+	if dark, ok := colorFGBGIsDark(getenv("COLORFGBG")); ok {
+		return dark
+	}
+	return r.HasDarkBackground()
+}
+
+// setDarkBackground switches every adaptive color, in the theme and in the
+// shared styles, to its dark or light variant
+func (m *Model) setDarkBackground(dark bool) {
+	m.theme.Renderer.SetHasDarkBackground(dark)
+	lipgloss.SetHasDarkBackground(dark)
+}
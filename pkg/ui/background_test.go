@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestColorFGBGIsDark(t *testing.T) {
+	tests := []struct {
+		value    string
+		dark, ok bool
+	}{
+		{"15;0", true, true},
+		{"0;15", false, true},
+		{"0;default;15", false, true},
+		{"7;8", true, true},
+		{"0;7", false, true},
+		{"12;default", false, false},
+		{"", false, false},
+		{"15", false, false},
+		{"0;231", false, false},
+	}
+	for _, tt := range tests {
+		dark, ok := colorFGBGIsDark(tt.value)
+		if dark != tt.dark || ok != tt.ok {
+			t.Errorf("colorFGBGIsDark(%q) = %v, %v; want %v, %v", tt.value, dark, ok, tt.dark, tt.ok)
+		}
+	}
+
+	env := func(v string) func(string) string {
+		return func(key string) string {
+			if key == "COLORFGBG" {
+				return v
+			}
+			return ""
+		}
+	}
+	r := lipgloss.NewRenderer(nil)
+	r.SetHasDarkBackground(true)
+	if DetectDarkBackground(env("0;15"), r) {
+		t.Error("COLORFGBG with a white background should win over the renderer")
+	}
+	if !DetectDarkBackground(env(""), r) {
+		t.Error("without COLORFGBG the renderer decides")
+	}
+}
+
+func TestBuiltinLightThemePinsBackground(t *testing.T) {
+	defer lipgloss.SetHasDarkBackground(lipgloss.HasDarkBackground())
+	t.Cleanup(func() { applyThemeGlobals(ThemeSpec{}) })
+
+	m := NewModel([]model.Issue{{ID: "1", Title: "x", Status: model.StatusOpen}}, nil, "")
+	m.detectedDark = true
+
+	m = runPalette(t, m, "theme light")
+	if m.themeName != "light" || m.theme.Renderer.HasDarkBackground() || lipgloss.HasDarkBackground() || m.renderer.IsDarkMode() {
+		t.Fatalf("light theme should draw the light colors, theme %q", m.themeName)
+	}
+	if m.board.theme.Renderer.HasDarkBackground() {
+		t.Error("views should follow the light background")
+	}
+
+	m = runPalette(t, m, "theme default")
+	if m.themeName != "" || !m.theme.Renderer.HasDarkBackground() {
+		t.Error("default theme should go back to the detected background")
+	}
+
+	// A user theme can pin its background too
+	m.themes = loadThemeFiles(writeThemeFile(t, t.TempDir(), "themes:\n  paper:\n    background: light\n    primary: {light: \"#5B2C83\", dark: \"#BD93F9\"}\n"), "")
+	m.switchTheme("paper")
+	if m.theme.Renderer.HasDarkBackground() || m.theme.Primary.Light != "#5B2C83" {
+		t.Error("paper should pin the light background")
+	}
+}
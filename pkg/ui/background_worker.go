@@ -186,8 +186,9 @@ type BackgroundWorker struct {
 	recoveryCount     int
 	recovering        bool
 	generation        uint64
-	lastHash          string // Content hash of last processed snapshot (for dedup)
-	forceNext         bool   // Force the next snapshot build even if content hash matches
+	lastHash          string    // Content hash of last processed snapshot (for dedup)
+	lastLoadAt        time.Time // When the last successful file read started, deduped or not
+	forceNext         bool      // Force the next snapshot build even if content hash matches
 	currentRecipe     *recipe.Recipe
 	currentRecipeID   string // Recipe identifier for snapshot rebuild keys
 	currentRecipeHash string // Recipe fingerprint for rebuild keys (bv-4ilb)
@@ -1318,6 +1319,7 @@ func (w *BackgroundWorker) buildSnapshot() *DataSnapshot {
 	loadOpenOnly := tier == datasetTierHuge && !recipeIncludesClosedStatuses(currentRecipe)
 
 	// Load issues from file with panic recovery
+	loadStarted := time.Now()
 	var issues []model.Issue
 	var pooledRefs []*model.Issue
 	var loadWarnings []string
@@ -1365,6 +1367,7 @@ func (w *BackgroundWorker) buildSnapshot() *DataSnapshot {
 
 	// Check if content is unchanged (dedup optimization)
 	w.mu.Lock()
+	w.lastLoadAt = loadStarted
 	forceNext := w.forceNext
 	if forceNext {
 		w.forceNext = false
@@ -1643,9 +1646,11 @@ func (w *BackgroundWorker) runPhase2Analysis(stats *analysis.GraphStats, dataHas
 		return
 	}
 
-	// Wait for Phase 2 to complete (blocking)
+	// Wait for Phase 2 to complete (blocking); give up if the worker stops
 	phase2Start := time.Now()
-	stats.WaitForPhase2()
+	if err := stats.ComputeAll(w.ctx); err != nil {
+		return
+	}
 	phase2Duration := time.Since(phase2Start)
 	if w.metricsEnabled {
 		w.metrics.lastPhase2Ns.Store(phase2Duration.Nanoseconds())
@@ -1733,6 +1738,15 @@ func (w *BackgroundWorker) LastHash() string {
 	return w.lastHash
 }
 
+// LastLoadTime returns when the last successful read of the beads file
+// started. Unlike the snapshot's CreatedAt it advances when a reload is
+// deduplicated, so a touched-but-unchanged file still counts as loaded.
+func (w *BackgroundWorker) LastLoadTime() time.Time {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.lastLoadAt
+}
+
 func estimateSnapshotBytes(issues []model.Issue) int64 {
 	const (
 		baseIssueBytes      = 256
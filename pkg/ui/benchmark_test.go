@@ -7,6 +7,8 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/testutil"
+
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 func copyIssues(in []model.Issue) []model.Issue {
@@ -68,3 +70,33 @@ func BenchmarkSnapshotBuilderBuild(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkKeystroke measures a cursor move plus the redraw it causes in
+// the list and the actionable view, which should stay within a frame
+// (16ms) on very large backlogs
+func BenchmarkKeystroke(b *testing.B) {
+	for _, size := range []int{1000, 50000} {
+		issues := testutil.QuickRandom(size, 0.0001)
+		for _, view := range []string{"list", "actionable"} {
+			b.Run(fmt.Sprintf("%s/issues=%d", view, size), func(b *testing.B) {
+				m := NewModel(copyIssues(issues), nil, "")
+				m.analysis.WaitForPhase2()
+				tm, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 50})
+				m = tm.(Model)
+				if view == "actionable" {
+					tm, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+					m = tm.(Model)
+				}
+				down := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")}
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					tm, _ := m.Update(down)
+					m = tm.(Model)
+					_ = m.View()
+				}
+			})
+		}
+	}
+}
@@ -1131,7 +1131,7 @@ func (b BoardModel) View(width, height int) string {
 			Width(baseWidth).
 			Height(colHeight).
 			Padding(0, 1).
-			Border(lipgloss.RoundedBorder())
+			Border(termBorder(lipgloss.RoundedBorder()))
 
 		if isFocused {
 			colStyle = colStyle.BorderForeground(columnColors[colIdx])
@@ -1268,17 +1268,17 @@ func (b BoardModel) renderCard(issue model.Issue, width int, selected bool, colI
 	if selected {
 		cardStyle = cardStyle.
 			Background(t.Highlight).
-			Border(lipgloss.RoundedBorder()).
+			Border(termBorder(lipgloss.RoundedBorder())).
 			BorderForeground(borderColor)
 	} else if isCurrentMatch {
 		// Highlight current match with subtle background (bv-yg39)
 		cardStyle = cardStyle.
 			Background(lipgloss.AdaptiveColor{Light: "#e1bee7", Dark: "#4a148c"}).
-			Border(lipgloss.RoundedBorder()).
+			Border(termBorder(lipgloss.RoundedBorder())).
 			BorderForeground(borderColor)
 	} else {
 		cardStyle = cardStyle.
-			Border(lipgloss.RoundedBorder()).
+			Border(termBorder(lipgloss.RoundedBorder())).
 			BorderForeground(borderColor)
 	}
 
@@ -1428,7 +1428,7 @@ func (b BoardModel) renderExpandedCard(issue model.Issue, width int, _, _ int) s
 
 	cardStyle = cardStyle.
 		Background(t.Highlight).
-		Border(lipgloss.DoubleBorder()). // Double border to distinguish expanded state
+		Border(termBorder(lipgloss.DoubleBorder())). // Double border to distinguish expanded state
 		BorderForeground(borderColor)
 
 	// ══════════════════════════════════════════════════════════════════════════
@@ -1691,7 +1691,7 @@ func (b *BoardModel) renderDetailPanel(width, height int) string {
 
 	// Panel border style
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Width(width).
 		Height(height).
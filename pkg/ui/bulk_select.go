@@ -0,0 +1,220 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// markGlyph is drawn next to issues marked for bulk actions
+func markGlyph() string {
+	return glyph("●", "*")
+}
+
+// inListScope reports whether the focused view is one of the issue lists
+// that take marks and vim motions: the list, or the actionable plan on its
+// own or as the split view's left pane
+func (m Model) inListScope() bool {
+	return m.focused == focusList || m.focused == focusActionable
+}
+
+// actionableFocused reports whether the focused list is the actionable plan
+func (m Model) actionableFocused() bool {
+	return m.focused == focusActionable || (m.focused == focusList && m.splitLeftIsActionable())
+}
+
+// markScope returns the issue IDs of the focused view in display order,
+// and the one under the cursor
+func (m Model) markScope() (ids []string, cursor string) {
+	if m.actionableFocused() {
+		return m.actionableView.ItemIDs(), m.actionableView.SelectedIssueID()
+	}
+	if m.focused != focusList {
+		return nil, ""
+	}
+	for _, item := range m.list.VisibleItems() {
+		if issueItem, ok := item.(IssueItem); ok {
+			ids = append(ids, issueItem.Issue.ID)
+		}
+	}
+	if item, ok := m.list.SelectedItem().(IssueItem); ok {
+		cursor = item.Issue.ID
+	}
+	return ids, cursor
+}
+
+// toggleMark marks or unmarks the issue under the cursor and moves on to
+// the next one, so space can be held down over a run of issues. It ends a
+// range in progress, keeping what the range marked.
+func (m Model) toggleMark() Model {
+	_, cursor := m.markScope()
+	if cursor == "" {
+		return m
+	}
+	m.markAnchor, m.markBase = "", nil
+	m.ensureMarks()
+	if m.marked[cursor] {
+		delete(m.marked, cursor)
+	} else {
+		m.marked[cursor] = true
+	}
+	if m.actionableFocused() {
+		m.actionableView.MoveDown()
+	} else {
+		m.list.CursorDown()
+	}
+	m.reportMarks()
+	return m
+}
+
+// toggleMarkRange starts a range at the cursor, or finishes the one in
+// progress. While a range is open every issue between its anchor and the
+// cursor is marked, on top of the marks made before it.
+func (m Model) toggleMarkRange() Model {
+	if m.markAnchor != "" {
+		m.markAnchor, m.markBase = "", nil
+		m.reportMarks()
+		return m
+	}
+	_, cursor := m.markScope()
+	if cursor == "" {
+		return m
+	}
+	m.ensureMarks()
+	m.markAnchor = cursor
+	m.markBase = make(map[string]bool, len(m.marked))
+	for id := range m.marked {
+		m.markBase[id] = true
+	}
+	m.extendMarkRange()
+	m.statusMsg = "Marking a range: move to extend, V to finish, esc to cancel"
+	m.statusIsError = false
+	return m
+}
+
+// extendMarkRange re-marks the open range after the cursor moved. The map
+// is refilled in place, since the list delegate and the actionable view
+// hold it too.
+func (m *Model) extendMarkRange() {
+	ids, cursor := m.markScope()
+	from, to := -1, -1
+	for i, id := range ids {
+		if id == m.markAnchor {
+			from = i
+		}
+		if id == cursor {
+			to = i
+		}
+	}
+	if from < 0 || to < 0 {
+		return // Anchor filtered out or the view changed; keep the marks
+	}
+	if from > to {
+		from, to = to, from
+	}
+	clear(m.marked)
+	for id := range m.markBase {
+		m.marked[id] = true
+	}
+	for _, id := range ids[from : to+1] {
+		m.marked[id] = true
+	}
+}
+
+// cancelMarks backs out of an open range, or clears the marks when there
+// is none. It reports whether there was anything to undo, so esc falls
+// through to its other uses otherwise.
+func (m *Model) cancelMarks() bool {
+	if m.markAnchor != "" {
+		clear(m.marked)
+		for id := range m.markBase {
+			m.marked[id] = true
+		}
+		m.markAnchor, m.markBase = "", nil
+		m.reportMarks()
+		return true
+	}
+	if len(m.marked) == 0 {
+		return false
+	}
+	m.clearMarks()
+	m.statusMsg = "Marks cleared"
+	m.statusIsError = false
+	return true
+}
+
+// clearMarks unmarks every issue
+func (m *Model) clearMarks() {
+	clear(m.marked)
+	m.markAnchor, m.markBase = "", nil
+}
+
+// ensureMarks creates the mark set on first use and hands it to the views
+// that draw it
+func (m *Model) ensureMarks() {
+	if m.marked != nil {
+		return
+	}
+	m.marked = make(map[string]bool)
+	m.updateListDelegate()
+	m.actionableView.SetMarked(m.marked)
+}
+
+// markedIDs returns the marked issues that are still loaded, in file order
+func (m Model) markedIDs() []string {
+	if len(m.marked) == 0 {
+		return nil
+	}
+	var ids []string
+	for _, issue := range m.issues {
+		if m.marked[issue.ID] {
+			ids = append(ids, issue.ID)
+		}
+	}
+	return ids
+}
+
+// markedIssues returns copies of the marked issues, in file order
+func (m Model) markedIssues() []model.Issue {
+	var issues []model.Issue
+	for _, id := range m.markedIDs() {
+		issues = append(issues, *m.issueMap[id])
+	}
+	return issues
+}
+
+func (m *Model) reportMarks() {
+	switch n := len(m.marked); n {
+	case 0:
+		m.statusMsg = "No issues marked"
+	case 1:
+		m.statusMsg = "1 issue marked: e edit, x export, esc clear"
+	default:
+		m.statusMsg = fmt.Sprintf("%d issues marked: e edit, x export, esc clear", n)
+	}
+	m.statusIsError = false
+}
+
+// exportMarked writes the marked issues to a Markdown report, returning
+// the toast that reports the outcome
+func (m *Model) exportMarked() tea.Cmd {
+	issues := m.markedIssues()
+	filename := m.generateExportFilename()
+	if err := export.SaveMarkdownToFile(issues, filename); err != nil {
+		return m.notify(toastError, fmt.Sprintf("Export failed: %v", err))
+	}
+	return m.notify(toastSuccess, fmt.Sprintf("Exported %d marked issues to %s", len(issues), filename))
+}
+
+// countBadgeText is the footer's issue count, with the marks when there
+// are any
+func (m Model) countBadgeText() string {
+	text := fmt.Sprintf("%d issues", len(m.list.Items()))
+	if n := len(m.markedIDs()); n > 0 {
+		text += fmt.Sprintf(" · %d marked", n)
+	}
+	return text
+}
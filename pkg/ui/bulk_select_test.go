@@ -0,0 +1,144 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMarkIssuesAndRanges(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, Labels: []string{"auth"}},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, Labels: []string{"ui", "theme"}},
+		{ID: "bv-3", Title: "Speed up sync", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask},
+		{ID: "login-4", Title: "Audit sessions", Status: model.StatusOpen, Priority: 3, IssueType: model.TypeTask},
+	}
+	m := NewModel(issues, nil, "")
+	m.beadsPath = writeTempBeadsFile(t, t.TempDir(), issues...)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	ids := strings.Split(listIDs(m), ",")
+
+	m, _ = pressEdit(m, runeKeys(" ")...)
+	if !m.marked[ids[0]] || m.list.Index() != 1 {
+		t.Fatalf("space should mark %s and move down, marks %v index %d", ids[0], m.marked, m.list.Index())
+	}
+	if view := m.View(); !strings.Contains(view, markGlyph()) {
+		t.Errorf("marks should show in the list:\n%s", view)
+	}
+	if got := m.countBadgeText(); got != "4 issues · 1 marked" {
+		t.Errorf("footer count = %q", got)
+	}
+
+	// V opens a range that follows the cursor, on top of the earlier mark
+	m, _ = pressEdit(m, runeKeys("Vjj")...)
+	if got := len(m.markedIDs()); got != 4 {
+		t.Fatalf("range should mark everything, got %v", m.marked)
+	}
+	m, _ = pressEdit(m, runeKeys("k")...)
+	if m.marked[ids[3]] || !m.marked[ids[2]] {
+		t.Errorf("range should shrink with the cursor, got %v", m.marked)
+	}
+
+	// esc backs out of the open range, then clears the marks
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.markAnchor != "" || len(m.markedIDs()) != 1 || !m.marked[ids[0]] {
+		t.Errorf("esc should drop the range only, got %v", m.marked)
+	}
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if len(m.marked) != 0 || m.showQuitConfirm {
+		t.Errorf("second esc should clear the marks, got %v quit=%v", m.marked, m.showQuitConfirm)
+	}
+
+	// The actionable plan takes marks too
+	m, _ = pressEdit(m, runeKeys("a")...)
+	planID := m.actionableView.SelectedIssueID()
+	m, _ = pressEdit(m, runeKeys(" ")...)
+	if planID == "" || !m.marked[planID] {
+		t.Fatalf("space should mark plan item %q, got %v", planID, m.marked)
+	}
+	if view := m.View(); !strings.Contains(view, markGlyph()) {
+		t.Errorf("plan should draw the mark:\n%s", view)
+	}
+}
+
+func TestBulkEditWritesMarkedIssues(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, Labels: []string{"auth"}},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, Labels: []string{"ui", "theme"}},
+	}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	m, _ = pressEdit(m, runeKeys("  ")...)
+	marked := m.markedIDs()
+	if len(marked) != 2 {
+		t.Fatalf("marked %v, want 2 issues", marked)
+	}
+	before := map[string]model.Issue{}
+	for _, id := range marked {
+		before[id] = m.issueMap[id].Clone()
+	}
+
+	m, _ = pressEdit(m, runeKeys("e")...)
+	if !m.issueEdit.IsBulk() || !strings.Contains(m.View(), "Edit 2 issues") {
+		t.Fatalf("e with marks should open the bulk form:\n%s", m.View())
+	}
+
+	// Status stays unchanged; priority 1; labels skip the assignee field
+	keys := []tea.KeyMsg{{Type: tea.KeyTab}}
+	keys = append(keys, runeKeys("1")...)
+	keys = append(keys, tea.KeyMsg{Type: tea.KeyTab})
+	keys = append(keys, runeKeys("api, ui")...)
+	m, _ = pressEdit(m, keys...)
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter should return the save command")
+	}
+	if len(m.marked) != 0 {
+		t.Errorf("marks should clear once the edit is applied, got %v", m.marked)
+	}
+
+	check := func(issues map[string]model.Issue) {
+		t.Helper()
+		for _, id := range marked {
+			got, prev := issues[id], before[id]
+			if got.Status != prev.Status || got.Priority != 1 || got.Assignee != prev.Assignee {
+				t.Errorf("%s: status %s, p%d, assignee %q", id, got.Status, got.Priority, got.Assignee)
+			}
+			labels := strings.Join(got.Labels, ",")
+			if !strings.Contains(labels, "api") || strings.Count(labels, "ui") != 1 {
+				t.Errorf("%s: labels %v should gain api and keep one ui", id, got.Labels)
+			}
+		}
+	}
+	local := map[string]model.Issue{}
+	for _, id := range marked {
+		local[id] = *m.issueMap[id]
+	}
+	check(local)
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if toast := lastToast(m); toast.level != toastSuccess || !strings.Contains(toast.text, "Saved 2 issues") || m.statusMsg != "" {
+		t.Errorf("toast = %+v, status = %q", toast, m.statusMsg)
+	}
+	reloaded, err := loader.LoadIssuesFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded) != len(m.issues) {
+		t.Fatalf("reloaded %d issues, want %d", len(reloaded), len(m.issues))
+	}
+	fromFile := map[string]model.Issue{}
+	for _, issue := range reloaded {
+		fromFile[issue.ID] = issue
+	}
+	check(fromFile)
+}
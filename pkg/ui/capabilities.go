@@ -0,0 +1,287 @@
+package ui
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// ColorLevel is how many colors the terminal can display
+type ColorLevel int
+
+const (
+	ColorNone      ColorLevel = iota // no color (dumb terminals, pipes)
+	ColorBasic                       // 16 ANSI colors
+	Color256                         // xterm 256-color palette
+	ColorTrueColor                   // 24-bit RGB
+)
+
+func (c ColorLevel) String() string {
+	switch c {
+	case ColorBasic:
+		return "16"
+	case Color256:
+		return "256"
+	case ColorTrueColor:
+		return "truecolor"
+	default:
+		return "none"
+	}
+}
+
+// ImageProtocol is the inline-image protocol a terminal understands
+type ImageProtocol string
+
+const (
+	ImageNone   ImageProtocol = "none"
+	ImageKitty  ImageProtocol = "kitty"
+	ImageITerm2 ImageProtocol = "iterm2"
+	ImageSixel  ImageProtocol = "sixel"
+)
+
+// Capabilities describes what the attached terminal can render. Views and
+// renderers consult it to fall back to plain ASCII, fewer colors, or plain
+// text instead of emitting sequences the terminal shows as garbage.
+type Capabilities struct {
+	Color      ColorLevel
+	ForceColor bool // use Color even where the output looks colorless (a pipe)
+	Unicode    bool // box drawing, block elements and emoji
+	Images     ImageProtocol
+	Hyperlinks bool // OSC 8 hyperlinks
+	Plain      bool // screen-reader mode: plain indented text with field labels
+}
+
+// WithPlain returns c in screen-reader mode: no color, box drawing, emoji,
+// images or hyperlinks, and views laid out as plain text
+func (c Capabilities) WithPlain() Capabilities {
+	c.Color, c.ForceColor, c.Unicode, c.Images, c.Hyperlinks = ColorNone, false, false, ImageNone, false
+	c.Plain = true
+	return c
+}
+
+var (
+	capsOnce sync.Once
+	capsMu   sync.RWMutex
+	caps     Capabilities
+)
+
+// TermCapabilities returns the terminal capabilities, probing the
+// environment on first use.
+func TermCapabilities() Capabilities {
+	capsOnce.Do(func() {
+		c := DetectCapabilities(os.Getenv)
+		capsMu.Lock()
+		caps = c
+		capsMu.Unlock()
+		applyCapabilities(c)
+	})
+	capsMu.RLock()
+	defer capsMu.RUnlock()
+	return caps
+}
+
+// SetCapabilities overrides the detected capabilities (tests, --ascii style
+// flags) and re-applies them to the shared lipgloss renderer and styles.
+func SetCapabilities(c Capabilities) {
+	capsOnce.Do(func() {})
+	capsMu.Lock()
+	caps = c
+	capsMu.Unlock()
+	applyCapabilities(c)
+}
+
+// applyCapabilities pushes capabilities into state computed before they were
+// known: the default renderer's color profile and package-level styles.
+func applyCapabilities(c Capabilities) {
+	c.LimitRenderer(lipgloss.DefaultRenderer())
+	PanelStyle = PanelStyle.BorderStyle(c.Border(lipgloss.RoundedBorder()))
+	FocusedPanelStyle = FocusedPanelStyle.BorderStyle(c.Border(lipgloss.RoundedBorder()))
+}
+
+// DetectCapabilities infers capabilities from environment variables. It never
+// queries the terminal, so it is safe before the TUI owns stdin.
+//
+// Color follows the NO_COLOR and CLICOLOR/CLICOLOR_FORCE conventions, with
+// NO_COLOR winning. Otherwise colors only ever degrade from what lipgloss
+// finds on the output, so pipes stay uncolored.
+//
+// Overrides: BV_COLOR (see ColorModes, also --color), BV_UNICODE=0|1,
+// BV_IMAGES=none|kitty|iterm2|sixel, BV_HYPERLINKS=0|1, and BV_PLAIN=1 for
+// the screen-reader mode, which beats the others.
+func DetectCapabilities(getenv func(string) string) Capabilities {
+	term := strings.ToLower(getenv("TERM"))
+	program := getenv("TERM_PROGRAM")
+	inTmux := getenv("TMUX") != "" || strings.HasPrefix(term, "screen") || strings.HasPrefix(term, "tmux")
+	dumb := term == "dumb"
+	console := term == "linux" || term == "cons25" || strings.HasPrefix(term, "vt") || term == "ansi"
+	kitty := term == "xterm-kitty" || getenv("KITTY_WINDOW_ID") != ""
+	ghostty := term == "xterm-ghostty" || program == "ghostty"
+	modern := kitty || ghostty || program == "iTerm.app" || program == "WezTerm" ||
+		program == "vscode" || getenv("WT_SESSION") != ""
+
+	c := Capabilities{Images: ImageNone}
+
+	// Color
+	colorterm := strings.ToLower(getenv("COLORTERM"))
+	switch {
+	case dumb:
+		c.Color = ColorNone
+	case colorterm == "truecolor" || colorterm == "24bit" || modern:
+		c.Color = ColorTrueColor
+	case strings.Contains(term, "256color"):
+		c.Color = Color256
+	case term == "" && colorterm == "":
+		c.Color = ColorNone
+	default:
+		c.Color = ColorBasic
+	}
+
+	// Unicode: trust the locale when it says something, otherwise assume
+	// UTF-8 except on consoles known to lack the glyphs.
+	c.Unicode = !dumb && !console
+	for _, key := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if v := strings.ToLower(getenv(key)); v != "" {
+			if !strings.Contains(v, "utf-8") && !strings.Contains(v, "utf8") {
+				c.Unicode = false
+			}
+			break
+		}
+	}
+	if getenv("WT_SESSION") != "" {
+		c.Unicode = true
+	}
+
+	// Inline images don't survive tmux/screen without passthrough setup.
+	if !inTmux {
+		switch {
+		case kitty || ghostty:
+			c.Images = ImageKitty
+		case program == "iTerm.app" || program == "WezTerm":
+			c.Images = ImageITerm2
+		case strings.HasPrefix(term, "foot") || strings.Contains(term, "mlterm"):
+			c.Images = ImageSixel
+		}
+	}
+
+	c.Hyperlinks = modern || strings.HasPrefix(term, "foot") || getenv("KONSOLE_VERSION") != ""
+	if v, err := strconv.Atoi(getenv("VTE_VERSION")); err == nil && v >= 5000 {
+		c.Hyperlinks = true
+	}
+	if dumb || console {
+		c.Hyperlinks = false
+	}
+
+	// Color conventions: CLICOLOR_FORCE colors pipes too, CLICOLOR=0 and
+	// NO_COLOR turn color off
+	detected := c.Color
+	if v := getenv("CLICOLOR_FORCE"); v != "" && v != "0" {
+		c.ForceColor = true
+		c.Color = max(c.Color, ColorBasic)
+	} else if getenv("CLICOLOR") == "0" {
+		c.Color = ColorNone
+	}
+	if getenv("NO_COLOR") != "" {
+		c.Color, c.ForceColor = ColorNone, false
+	}
+
+	// Explicit overrides win.
+	switch strings.ToLower(getenv("BV_COLOR")) {
+	case "never", "none", "0", "off":
+		c.Color, c.ForceColor = ColorNone, false
+	case "always":
+		c.Color, c.ForceColor = max(detected, ColorBasic), true
+	case "16", "ansi", "basic":
+		c.Color, c.ForceColor = ColorBasic, true
+	case "256":
+		c.Color, c.ForceColor = Color256, true
+	case "truecolor", "24bit":
+		c.Color, c.ForceColor = ColorTrueColor, true
+	}
+	if v, err := strconv.ParseBool(getenv("BV_UNICODE")); err == nil {
+		c.Unicode = v
+	}
+	switch p := ImageProtocol(strings.ToLower(getenv("BV_IMAGES"))); p {
+	case ImageNone, ImageKitty, ImageITerm2, ImageSixel:
+		c.Images = p
+	}
+	if v, err := strconv.ParseBool(getenv("BV_HYPERLINKS")); err == nil {
+		c.Hyperlinks = v
+	}
+	if v, err := strconv.ParseBool(getenv("BV_PLAIN")); err == nil && v {
+		c = c.WithPlain()
+	}
+	return c
+}
+
+// ColorModes are the values of --color and BV_COLOR: auto detects from
+// the terminal (and NO_COLOR), always colors even pipes, never turns color
+// off, and a depth forces that many colors
+var ColorModes = []string{"auto", "always", "never", "16", "256", "truecolor"}
+
+// ValidColorMode reports whether mode is one of ColorModes or an alias
+// BV_COLOR takes (none, off, 0, ansi, basic, 24bit)
+func ValidColorMode(mode string) bool {
+	switch strings.ToLower(mode) {
+	case "auto", "always", "never", "none", "0", "off", "16", "ansi", "basic", "256", "truecolor", "24bit":
+		return true
+	}
+	return false
+}
+
+// ColorProfile maps the color level onto a termenv profile for lipgloss,
+// which then downsamples hex colors automatically.
+func (c Capabilities) ColorProfile() termenv.Profile {
+	switch c.Color {
+	case ColorTrueColor:
+		return termenv.TrueColor
+	case Color256:
+		return termenv.ANSI256
+	case ColorBasic:
+		return termenv.ANSI
+	default:
+		return termenv.Ascii
+	}
+}
+
+// LimitRenderer lowers r's color profile to what the terminal supports. It
+// only raises it when colors are forced, so output to pipes otherwise stays
+// uncolored.
+func (c Capabilities) LimitRenderer(r *lipgloss.Renderer) {
+	if p := c.ColorProfile(); p > r.ColorProfile() || c.ForceColor {
+		r.SetColorProfile(p)
+	}
+}
+
+// Hyperlink wraps text in an OSC 8 hyperlink when supported, otherwise
+// returns text unchanged.
+func (c Capabilities) Hyperlink(url, text string) string {
+	if !c.Hyperlinks || url == "" {
+		return text
+	}
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
+// Border returns b, or a plain ASCII border without Unicode support
+func (c Capabilities) Border(b lipgloss.Border) lipgloss.Border {
+	if c.Unicode {
+		return b
+	}
+	return lipgloss.ASCIIBorder()
+}
+
+// glyph picks the Unicode form when the terminal can show it
+func glyph(unicode, ascii string) string {
+	if TermCapabilities().Unicode {
+		return unicode
+	}
+	return ascii
+}
+
+// termBorder is Border for the current terminal
+func termBorder(b lipgloss.Border) lipgloss.Border {
+	return TermCapabilities().Border(b)
+}
@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func envFrom(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+func TestDetectCapabilities(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want Capabilities
+	}{
+		{"dumb", map[string]string{"TERM": "dumb", "LANG": "en_US.UTF-8"},
+			Capabilities{Color: ColorNone, Unicode: false, Images: ImageNone}},
+		{"linux console", map[string]string{"TERM": "linux"},
+			Capabilities{Color: ColorBasic, Unicode: false, Images: ImageNone}},
+		{"xterm-256color", map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8"},
+			Capabilities{Color: Color256, Unicode: true, Images: ImageNone}},
+		{"C locale", map[string]string{"TERM": "xterm-256color", "LANG": "C"},
+			Capabilities{Color: Color256, Unicode: false, Images: ImageNone}},
+		{"LC_ALL wins over LANG", map[string]string{"TERM": "xterm", "LC_ALL": "C.UTF-8", "LANG": "C"},
+			Capabilities{Color: ColorBasic, Unicode: true, Images: ImageNone}},
+		{"kitty", map[string]string{"TERM": "xterm-kitty"},
+			Capabilities{Color: ColorTrueColor, Unicode: true, Images: ImageKitty, Hyperlinks: true}},
+		{"iterm2", map[string]string{"TERM": "xterm-256color", "TERM_PROGRAM": "iTerm.app"},
+			Capabilities{Color: ColorTrueColor, Unicode: true, Images: ImageITerm2, Hyperlinks: true}},
+		{"iterm2 inside tmux", map[string]string{"TERM": "screen-256color", "TERM_PROGRAM": "iTerm.app", "TMUX": "/tmp/tmux"},
+			Capabilities{Color: ColorTrueColor, Unicode: true, Images: ImageNone, Hyperlinks: true}},
+		{"vte", map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor", "VTE_VERSION": "6003"},
+			Capabilities{Color: ColorTrueColor, Unicode: true, Images: ImageNone, Hyperlinks: true}},
+		{"overrides", map[string]string{"TERM": "xterm-kitty", "BV_COLOR": "256", "BV_UNICODE": "0", "BV_IMAGES": "none", "BV_HYPERLINKS": "false"},
+			Capabilities{Color: Color256, ForceColor: true, Unicode: false, Images: ImageNone}},
+		{"NO_COLOR", map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8", "NO_COLOR": "1"},
+			Capabilities{Color: ColorNone, Unicode: true, Images: ImageNone}},
+		{"CLICOLOR=0", map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8", "CLICOLOR": "0"},
+			Capabilities{Color: ColorNone, Unicode: true, Images: ImageNone}},
+		{"CLICOLOR_FORCE without a TERM", map[string]string{"CLICOLOR_FORCE": "1", "LANG": "en_US.UTF-8"},
+			Capabilities{Color: ColorBasic, ForceColor: true, Unicode: true, Images: ImageNone}},
+		{"NO_COLOR beats CLICOLOR_FORCE", map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8", "CLICOLOR_FORCE": "1", "NO_COLOR": "1"},
+			Capabilities{Color: ColorNone, Unicode: true, Images: ImageNone}},
+		{"always beats NO_COLOR", map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8", "NO_COLOR": "1", "BV_COLOR": "always"},
+			Capabilities{Color: Color256, ForceColor: true, Unicode: true, Images: ImageNone}},
+		{"never", map[string]string{"TERM": "xterm-256color", "LANG": "en_US.UTF-8", "CLICOLOR_FORCE": "1", "BV_COLOR": "never"},
+			Capabilities{Color: ColorNone, Unicode: true, Images: ImageNone}},
+		{"plain beats overrides", map[string]string{"TERM": "xterm-kitty", "BV_COLOR": "truecolor", "BV_UNICODE": "1", "BV_PLAIN": "1"},
+			Capabilities{Color: ColorNone, Unicode: false, Images: ImageNone, Plain: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectCapabilities(envFrom(tt.env)); got != tt.want {
+				t.Errorf("DetectCapabilities() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCapabilities_Hyperlink(t *testing.T) {
+	on := Capabilities{Hyperlinks: true}
+	if got := on.Hyperlink("https://example.com", "docs"); got != "\x1b]8;;https://example.com\x1b\\docs\x1b]8;;\x1b\\" {
+		t.Errorf("Hyperlink = %q", got)
+	}
+	if got := on.Hyperlink("", "docs"); got != "docs" {
+		t.Errorf("empty URL should return text, got %q", got)
+	}
+	if got := (Capabilities{}).Hyperlink("https://example.com", "docs"); got != "docs" {
+		t.Errorf("unsupported terminal should return text, got %q", got)
+	}
+}
+
+func TestCapabilities_ASCIIFallbacks(t *testing.T) {
+	t.Cleanup(func() { SetCapabilities(Capabilities{Color: ColorTrueColor, Unicode: true, Images: ImageNone}) })
+	SetCapabilities(Capabilities{Color: ColorBasic, Unicode: false, Images: ImageNone})
+
+	out := GetStatusIcon("open") + GetPriorityIcon(0) + RenderSparkline(0.5, 6) + RenderDependencyTree(&DependencyNode{
+		ID: "A", Title: "Root", Status: "open", Type: "root",
+		Children: []*DependencyNode{{ID: "B", Title: "Child", Status: "blocked", Type: "blocks"}},
+	})
+	for _, r := range out {
+		if r > 127 {
+			t.Fatalf("non-ASCII rune %q in fallback output:\n%s", r, out)
+		}
+	}
+	if !strings.Contains(out, "`-- x  x  B") {
+		t.Errorf("expected ASCII tree connector, got:\n%s", out)
+	}
+	if termBorder(lipgloss.RoundedBorder()) != lipgloss.ASCIIBorder() {
+		t.Error("expected ASCII border without Unicode")
+	}
+}
+
+func TestCapabilities_ForcedColorRaisesRenderer(t *testing.T) {
+	r := lipgloss.NewRenderer(nil)
+	r.SetColorProfile(termenv.Ascii)
+	Capabilities{Color: Color256}.LimitRenderer(r)
+	if r.ColorProfile() != termenv.Ascii {
+		t.Error("detected colors should not raise a colorless output")
+	}
+	Capabilities{Color: Color256, ForceColor: true}.LimitRenderer(r)
+	if r.ColorProfile() != termenv.ANSI256 {
+		t.Errorf("forced colors should set the profile, got %v", r.ColorProfile())
+	}
+
+	for _, mode := range ColorModes {
+		if !ValidColorMode(mode) {
+			t.Errorf("%s should be a valid color mode", mode)
+		}
+	}
+	if ValidColorMode("sometimes") {
+		t.Error("unknown color modes should be rejected")
+	}
+}
+
+func TestBasicPaletteOnSixteenColors(t *testing.T) {
+	t.Cleanup(func() {
+		SetCapabilities(Capabilities{Color: ColorTrueColor, Unicode: true, Images: ImageNone})
+		applyThemeGlobals(ThemeSpec{})
+	})
+	SetCapabilities(Capabilities{Color: ColorBasic, Unicode: true, Images: ImageNone})
+	r := lipgloss.NewRenderer(nil)
+
+	th := baseTheme(r)
+	if th.Open.Dark != "10" || th.Blocked.Dark != "9" || th.Primary.Light != "5" {
+		t.Errorf("16 colors should use the ANSI palette, got open %v blocked %v", th.Open, th.Blocked)
+	}
+	// A theme's own colors still win over the palette
+	spec := ThemeSpec{Status: map[string]ThemeColor{"open": {Light: "#00FF00", Dark: "#00FF00"}}}
+	if got := spec.Apply(baseTheme(r)).Open.Dark; got != "#00FF00" {
+		t.Errorf("theme color should beat the palette, got %s", got)
+	}
+	applyThemeGlobals(spec)
+	if ColorStatusOpen.Dark != "#00FF00" || ColorStatusBlocked.Dark != "9" {
+		t.Errorf("package colors should take the palette under the theme, got %v %v", ColorStatusOpen, ColorStatusBlocked)
+	}
+
+	SetCapabilities(Capabilities{Color: Color256, Unicode: true, Images: ImageNone})
+	if th := baseTheme(r); th.Open != DefaultTheme(r).Open {
+		t.Error("256 colors should keep the default theme")
+	}
+}
@@ -90,7 +90,7 @@ func (m CassSessionModal) View() string {
 
 	// Modal container style
 	modalStyle := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(m.theme.Primary).
 		Padding(1, 2).
 		Width(m.width)
@@ -117,7 +117,7 @@ func (m CassSessionModal) View() string {
 		Italic(true)
 
 	snippetBoxStyle := r.NewStyle().
-		Border(lipgloss.NormalBorder()).
+		Border(termBorder(lipgloss.NormalBorder())).
 		BorderForeground(m.theme.Border).
 		Padding(0, 1).
 		Width(m.width - 10)
@@ -0,0 +1,453 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+// PaletteCommand is one action in the command palette
+type PaletteCommand struct {
+	Category string // View, Recipe, Filter, Issue, Export, Display, Data, App
+	Title    string
+	Key      string // Shortcut shown beside the title; empty when there is none
+	run      func(m Model) (Model, tea.Cmd)
+}
+
+// paletteMatch is a command matching the query, with the byte offsets of the
+// matched characters in its title
+type paletteMatch struct {
+	command int
+	matched []int
+}
+
+// CommandPaletteModel is the ctrl+p overlay listing every action with fuzzy
+// matching on its title and category, so features can be found without
+// knowing their keys.
+type CommandPaletteModel struct {
+	commands      []PaletteCommand
+	matches       []paletteMatch
+	input         textinput.Model
+	selectedIndex int
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewCommandPaletteModel creates an empty command palette
+func NewCommandPaletteModel(theme Theme) CommandPaletteModel {
+	ti := textinput.New()
+	ti.Placeholder = "type a command..."
+	ti.CharLimit = 100
+	ti.Width = 40
+	ti.Focus()
+	return CommandPaletteModel{input: ti, theme: theme}
+}
+
+// SetSize updates the overlay dimensions
+func (m *CommandPaletteModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// SetCommands replaces the commands and clears the query
+func (m *CommandPaletteModel) SetCommands(commands []PaletteCommand) {
+	m.commands = commands
+	m.SetQuery("")
+}
+
+// SetQuery replaces the query
+func (m *CommandPaletteModel) SetQuery(query string) {
+	m.input.SetValue(query)
+	m.match()
+}
+
+// UpdateInput processes a key message for the text input
+func (m *CommandPaletteModel) UpdateInput(msg tea.Msg) {
+	before := m.input.Value()
+	m.input, _ = m.input.Update(msg)
+	if m.input.Value() != before {
+		m.match()
+	}
+}
+
+// paletteSource adapts commands to fuzzy.Source. Each entry is the title
+// followed by the category, so offsets within the title can be highlighted
+// as is.
+type paletteSource []PaletteCommand
+
+func (s paletteSource) String(i int) string { return s[i].Title + " " + s[i].Category }
+func (s paletteSource) Len() int            { return len(s) }
+
+// match ranks the commands against the query; an empty query lists them all
+// in their usual order
+func (m *CommandPaletteModel) match() {
+	m.selectedIndex = 0
+	m.matches = nil
+	query := strings.TrimSpace(m.input.Value())
+	if query == "" {
+		for i := range m.commands {
+			m.matches = append(m.matches, paletteMatch{command: i})
+		}
+		return
+	}
+	for _, f := range fuzzy.FindFrom(query, paletteSource(m.commands)) {
+		var inTitle []int
+		for _, idx := range f.MatchedIndexes {
+			if idx < len(m.commands[f.Index].Title) {
+				inTitle = append(inTitle, idx)
+			}
+		}
+		m.matches = append(m.matches, paletteMatch{command: f.Index, matched: inTitle})
+	}
+}
+
+// Matches returns the matching commands, best first
+func (m *CommandPaletteModel) Matches() []PaletteCommand {
+	out := make([]PaletteCommand, len(m.matches))
+	for i, match := range m.matches {
+		out[i] = m.commands[match.command]
+	}
+	return out
+}
+
+// MoveUp moves selection up
+func (m *CommandPaletteModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves selection down
+func (m *CommandPaletteModel) MoveDown() {
+	if m.selectedIndex < len(m.matches)-1 {
+		m.selectedIndex++
+	}
+}
+
+// Selected returns the highlighted command
+func (m *CommandPaletteModel) Selected() (PaletteCommand, bool) {
+	if m.selectedIndex >= len(m.matches) {
+		return PaletteCommand{}, false
+	}
+	return m.commands[m.matches[m.selectedIndex].command], true
+}
+
+// View renders the palette overlay
+func (m *CommandPaletteModel) View() string {
+	if m.width == 0 {
+		m.width = 80
+	}
+	if m.height == 0 {
+		m.height = 24
+	}
+	t := m.theme
+
+	boxWidth := min(max(m.width-10, 30), 80)
+	inner := boxWidth - 6
+	maxVisible := max(m.height-14, 3)
+
+	var lines []string
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	lines = append(lines, titleStyle.Render("Command Palette"), "")
+
+	inputStyle := t.Renderer.NewStyle().
+		Border(termBorder(lipgloss.NormalBorder())).
+		BorderForeground(t.Secondary).
+		Padding(0, 1).
+		Width(inner)
+	lines = append(lines, inputStyle.Render(m.input.View()), "")
+
+	dimStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true)
+	if len(m.matches) == 0 {
+		lines = append(lines, dimStyle.Render("  No matching commands"))
+	} else {
+		start := 0
+		if m.selectedIndex >= maxVisible {
+			start = m.selectedIndex - maxVisible + 1
+		}
+		end := min(start+maxVisible, len(m.matches))
+		hlStyle := t.Renderer.NewStyle().Foreground(t.Feature).Bold(true).Underline(true)
+		keyStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+		const categoryW = 9
+		for i := start; i < end; i++ {
+			match := m.matches[i]
+			cmd := m.commands[match.command]
+			base := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+			catStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+			prefix := "  "
+			if i == m.selectedIndex {
+				base = base.Foreground(t.Primary).Bold(true)
+				catStyle = catStyle.Foreground(t.Primary)
+				prefix = "> "
+			}
+			keyW := lipgloss.Width(cmd.Key)
+			titleW := max(inner-categoryW-keyW-4, 10)
+			title := highlightMatches(cmd.Title, match.matched, titleW, base, hlStyle)
+			gap := max(inner-2-categoryW-lipgloss.Width(title)-keyW, 1)
+			lines = append(lines, base.Render(prefix)+
+				catStyle.Render(padRight(cmd.Category, categoryW))+
+				title+
+				strings.Repeat(" ", gap)+
+				keyStyle.Render(cmd.Key))
+		}
+		if len(m.matches) > maxVisible {
+			lines = append(lines, "", dimStyle.Render("  ("+itoa(m.selectedIndex+1)+"/"+itoa(len(m.matches))+")"))
+		}
+	}
+
+	lines = append(lines, "", dimStyle.Render("↑/↓: navigate | enter: run | esc: cancel"))
+
+	box := t.Renderer.NewStyle().
+		Border(termBorder(lipgloss.RoundedBorder())).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// openCommandPalette shows the palette over the current view
+func (m Model) openCommandPalette() Model {
+	if m.focused == focusCommandPalette {
+		return m
+	}
+	m.commandPalette.SetCommands(m.paletteCommands())
+	m.commandPalette.SetSize(m.width, m.height-1)
+	m.commandPaletteFrom = m.focused
+	m.showCommandPalette = true
+	m.focused = focusCommandPalette
+	return m
+}
+
+// handleCommandPaletteKeys handles keyboard input while the palette is open
+func (m Model) handleCommandPaletteKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.showCommandPalette = false
+		m.focused = m.commandPaletteFrom
+	case "down", "ctrl+n":
+		m.commandPalette.MoveDown()
+	case "up", "ctrl+p":
+		m.commandPalette.MoveUp()
+	case "enter":
+		cmd, ok := m.commandPalette.Selected()
+		m.showCommandPalette = false
+		m.focused = m.commandPaletteFrom
+		if ok {
+			return cmd.run(m)
+		}
+	default:
+		m.commandPalette.UpdateInput(msg)
+	}
+	return m, nil
+}
+
+// paletteCommands lists every action the palette offers. Most replay the
+// action's shortcut so they behave exactly like it; fromList ones first
+// return to the list, where their shortcut lives.
+func (m Model) paletteCommands() []PaletteCommand {
+	key := func(category, title, key string, fromList bool) PaletteCommand {
+		return PaletteCommand{Category: category, Title: title, Key: key, run: func(m Model) (Model, tea.Cmd) {
+			// The focused view may bind the key to something of its own
+			if fromList || m.viewOwnsKey(key) {
+				m.returnToList()
+			}
+			updated, cmd := m.Update(paletteKeyMsg(key))
+			return updated.(Model), cmd
+		}}
+	}
+
+	commands := []PaletteCommand{
+		{Category: "View", Title: "Issue list", Key: "esc", run: func(m Model) (Model, tea.Cmd) {
+			m.returnToList()
+			return m, nil
+		}},
+		key("View", "Toggle board", "b", false),
+		key("View", "Toggle graph", "g", false),
+		key("View", "Toggle insights", "i", false),
+		key("View", "Toggle actionable plan", "a", false),
+		key("View", "Toggle tree", "E", false),
+		key("View", "Toggle history", "h", false),
+		key("View", "Flow matrix", "f", false),
+		key("View", "Label dashboard", "[", false),
+		key("View", "Attention view", "]", false),
+		key("View", "Timeline", "L", true),
+		key("View", "Release cut line for selected issue", "R", true),
+		key("View", "Dependency tree for selected issue", "B", true),
+		key("View", "Issues by assignee", "A", true),
+		key("View", "Facet browser (labels, types, assignees)", "#", true),
+		key("Recipe", "Recipe picker", "'", false),
+	}
+	for _, r := range m.recipeLoader.List() {
+		commands = append(commands, PaletteCommand{Category: "Recipe", Title: "Apply recipe: " + r.Name, run: func(m Model) (Model, tea.Cmd) {
+			m.useRecipe(&r)
+			return m, nil
+		}})
+	}
+	commands = append(commands, PaletteCommand{Category: "Recipe", Title: "Save current filters as recipe", run: func(m Model) (Model, tea.Cmd) {
+		return m.openSaveRecipe(), nil
+	}})
+	commands = append(commands,
+		key("Filter", "Query filter bar", "Q", false),
+		key("Filter", "Open issues", "o", true),
+		key("Filter", "Closed issues", "c", true),
+		key("Filter", "Ready issues (no blockers)", "r", true),
+		PaletteCommand{Category: "Filter", Title: "Clear all filters", run: func(m Model) (Model, tea.Cmd) {
+			m.clearAllFilters()
+			return m, nil
+		}},
+		key("Filter", "Filter by label", "l", false),
+	)
+	for _, slot := range m.recipeLoader.SearchSlots() {
+		s, _ := m.recipeLoader.Search(slot)
+		commands = append(commands, PaletteCommand{Category: "Filter", Title: fmt.Sprintf("Saved search %d: %s", slot, s.Label()), Key: fmt.Sprintf("alt+%d", slot), run: func(m Model) (Model, tea.Cmd) {
+			return m.recallSearch(slot), nil
+		}})
+	}
+	commands = append(commands,
+		key("Filter", "Cycle sort", "s", true),
+		key("Filter", "Reverse sort direction", "I", true),
+		key("Filter", "Triage sort", "S", true),
+		key("Issue", "Jump to issue", "ctrl+f", false),
+		key("Issue", "Edit selected issue", "ctrl+e", false),
+		key("Issue", "New issue", "ctrl+n", false),
+		key("Issue", "Start/stop work timer", "ctrl+t", false),
+		key("Issue", "Copy selected issue", "C", true),
+		key("Issue", "Pin/unpin selected issue", "*", true),
+		key("Issue", "Open beads file in editor", "O", true),
+		key("Export", "Export to Markdown", "x", false),
+		PaletteCommand{Category: "Display", Title: "Toggle light/dark theme", run: func(m Model) (Model, tea.Cmd) {
+			m.toggleDarkBackground()
+			return m, nil
+		}},
+		key("Display", "Toggle priority hints", "p", false),
+		key("Display", "Toggle shortcuts sidebar", ";", false),
+		PaletteCommand{Category: "Display", Title: "Show/hide status bar", run: func(m Model) (Model, tea.Cmd) {
+			return m.toggleStatusBar()
+		}},
+		key("Display", "Help", "?", false),
+		key("Display", "Tutorial", "`", false),
+		key("Data", "Refresh", "ctrl+r", false),
+		key("Data", "Time-travel diff against HEAD~5", "T", true),
+		key("Data", "Time-travel diff against revision", "t", true),
+		key("Data", "Alerts", "!", false),
+	)
+	if m.isSplitView {
+		commands = append(commands,
+			key("Display", "Widen left pane", ">", true),
+			key("Display", "Narrow left pane", "<", true),
+			key("Display", "Swap left pane (list/actionable)", "\\", true),
+			key("Display", "Swap right pane (details/graph)", "|", true),
+		)
+	}
+	current, _ := m.themes.Get(m.themeName)
+	for _, spec := range m.themes.All() {
+		title := fmt.Sprintf("Theme: %s (%s)", spec.Name, spec.Source)
+		if spec.Name == current.Name {
+			title += " ✓"
+		}
+		commands = append(commands, PaletteCommand{Category: "Display", Title: title, run: func(m Model) (Model, tea.Cmd) {
+			m.switchTheme(spec.Name)
+			return m, nil
+		}})
+	}
+	commands = append(commands, PaletteCommand{Category: "Display", Title: "Reload themes from themes.yaml", run: func(m Model) (Model, tea.Cmd) {
+		m.reloadThemes()
+		return m, nil
+	}})
+	for _, def := range listColumnDefs {
+		if def.name == "title" {
+			continue
+		}
+		title := "Show column: " + def.name
+		if m.hasListColumn(def.name) {
+			title = "Hide column: " + def.name
+		}
+		commands = append(commands, PaletteCommand{Category: "Display", Title: title, run: func(m Model) (Model, tea.Cmd) {
+			m.toggleListColumn(def.name)
+			return m, nil
+		}})
+	}
+	commands = append(commands, PaletteCommand{Category: "Display", Title: "Reset list columns", run: func(m Model) (Model, tea.Cmd) {
+		m.resetListColumns()
+		return m, nil
+	}})
+	if m.editHistory.CanUndo() {
+		commands = append(commands, key("Issue", "Undo last edit", "u", false))
+	}
+	if m.editHistory.CanRedo() {
+		commands = append(commands, PaletteCommand{Category: "Issue", Title: "Redo undone edit", Key: "ctrl+r", run: func(m Model) (Model, tea.Cmd) {
+			return m.replayEdit(editOpRedo)
+		}})
+	}
+	if n := len(m.markedIDs()); n > 0 {
+		commands = append(commands,
+			PaletteCommand{Category: "Issue", Title: fmt.Sprintf("Clear marks (%d)", n), run: func(m Model) (Model, tea.Cmd) {
+				m.clearMarks()
+				m.statusMsg = "Marks cleared"
+				m.statusIsError = false
+				return m, nil
+			}},
+		)
+	}
+	if m.workspaceMode {
+		commands = append(commands,
+			key("Data", "Repo picker", "w", false),
+			key("Data", "Next workspace", "W", false),
+		)
+	}
+	commands = append(commands, PaletteCommand{Category: "App", Title: "Quit", Key: "q", run: func(m Model) (Model, tea.Cmd) {
+		return m, tea.Quit
+	}})
+	return commands
+}
+
+// paletteKeyMsg builds the key message for a shortcut as written in the help
+func paletteKeyMsg(key string) tea.KeyMsg {
+	switch key {
+	case "ctrl+f":
+		return tea.KeyMsg{Type: tea.KeyCtrlF}
+	case "ctrl+r":
+		return tea.KeyMsg{Type: tea.KeyCtrlR}
+	case "ctrl+e":
+		return tea.KeyMsg{Type: tea.KeyCtrlE}
+	case "ctrl+n":
+		return tea.KeyMsg{Type: tea.KeyCtrlN}
+	case "ctrl+t":
+		return tea.KeyMsg{Type: tea.KeyCtrlT}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+}
+
+// returnToList leaves whatever view is open for the issue list
+func (m *Model) returnToList() {
+	m.clearAttentionOverlay()
+	m.isGraphView = false
+	m.isBoardView = false
+	m.isActionableView = false
+	m.isHistoryView = false
+	if !m.isSplitView {
+		m.showDetails = false
+	}
+	m.focused = focusList
+}
+
+// toggleDarkBackground switches every adaptive color between its dark and
+// light variant, for terminals whose background was detected wrongly
+func (m *Model) toggleDarkBackground() {
+	dark := !m.theme.Renderer.HasDarkBackground()
+	m.setDarkBackground(dark)
+	m.renderer.SetDarkMode(dark, m.theme)
+	m.updateViewportContent()
+	if dark {
+		m.statusMsg = "Dark theme"
+	} else {
+		m.statusMsg = "Light theme"
+	}
+	m.statusIsError = false
+}
@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func openPalette(t *testing.T, m Model) Model {
+	t.Helper()
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = updated.(Model)
+	if m.FocusState() != "command_palette" {
+		t.Fatalf("ctrl+p should open the palette, focus = %s", m.FocusState())
+	}
+	return m
+}
+
+func runPalette(t *testing.T, m Model, query string) Model {
+	t.Helper()
+	m = openPalette(t, m)
+	for _, r := range query {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = updated.(Model)
+	}
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return updated.(Model)
+}
+
+func TestCommandPaletteMatchesFuzzily(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "1", Title: "x", Status: model.StatusOpen}}, nil, "")
+	p := NewCommandPaletteModel(newTestTheme())
+	p.SetCommands(m.paletteCommands())
+	if got, all := len(p.Matches()), len(m.paletteCommands()); got != all {
+		t.Fatalf("empty query should list all %d commands, got %d", all, got)
+	}
+
+	p.SetQuery("tgbrd")
+	if res := p.Matches(); len(res) == 0 || res[0].Title != "Toggle board" {
+		t.Errorf("fuzzy match = %+v", res)
+	}
+	// Categories match too
+	p.SetQuery("recipe triage")
+	if res := p.Matches(); len(res) == 0 || res[0].Title != "Apply recipe: triage" {
+		t.Errorf("recipe match = %+v", res)
+	}
+	p.SetQuery("zzzz")
+	if _, ok := p.Selected(); ok || len(p.Matches()) != 0 {
+		t.Error("expected no matches")
+	}
+
+	p.SetSize(100, 30)
+	p.SetQuery("export")
+	out := p.View()
+	for _, want := range []string{"Command Palette", "Export", "Export to Markdown", "x"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestCommandPaletteRunsCommands(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "1", Title: "x", Status: model.StatusOpen}}, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+
+	m = runPalette(t, m, "toggle graph")
+	if !m.IsGraphView() || m.FocusState() != "graph" {
+		t.Fatalf("expected graph view, focus = %s", m.FocusState())
+	}
+
+	// List-only commands return to the list first
+	m = runPalette(t, m, "closed issues")
+	if m.IsGraphView() || m.FocusState() != "list" || m.currentFilter != "closed" {
+		t.Errorf("expected closed filter on the list, focus=%s filter=%s", m.FocusState(), m.currentFilter)
+	}
+
+	m = runPalette(t, m, "apply recipe: actionable")
+	if m.activeRecipe == nil || m.activeRecipe.Name != "actionable" {
+		t.Errorf("expected actionable recipe, got %+v", m.activeRecipe)
+	}
+
+	m = openPalette(t, m)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	if m.FocusState() != "list" || m.showCommandPalette {
+		t.Errorf("esc should close the palette, focus = %s", m.FocusState())
+	}
+}
+
+func TestCommandPaletteTogglesTheme(t *testing.T) {
+	defer lipgloss.SetHasDarkBackground(lipgloss.HasDarkBackground())
+
+	m := NewModel([]model.Issue{{ID: "1", Title: "x", Status: model.StatusOpen}}, nil, "")
+	dark := m.theme.Renderer.HasDarkBackground()
+	m = runPalette(t, m, "light/dark")
+	if m.theme.Renderer.HasDarkBackground() == dark || m.renderer.IsDarkMode() == dark {
+		t.Error("theme command should flip the background")
+	}
+	if m.board.theme.Renderer.HasDarkBackground() == dark {
+		t.Error("views share the theme's renderer and should follow")
+	}
+}
@@ -32,6 +32,12 @@ const (
 	ContextSprint         Context = "sprint"
 	ContextLabelDashboard Context = "label-dashboard"
 	ContextAttention      Context = "attention"
+	ContextCutLine        Context = "cut-line"
+	ContextTimeline       Context = "timeline"
+	ContextTree           Context = "tree"
+	ContextDepTree        Context = "dep-tree"
+	ContextAssignees      Context = "assignees"
+	ContextFacets         Context = "facets"
 
 	// Detail states
 	ContextSplit      Context = "split"
@@ -127,11 +133,41 @@ func (m Model) CurrentContext() Context {
 		return ContextFlowMatrix
 	}
 
+	// Release cut-line view
+	if m.focused == focusCutLine {
+		return ContextCutLine
+	}
+
+	// Timeline view
+	if m.focused == focusTimeline {
+		return ContextTimeline
+	}
+
+	// Dependency tree of one issue
+	if m.focused == focusDepTree {
+		return ContextDepTree
+	}
+
+	// Open issues grouped by assignee
+	if m.focused == focusAssignees {
+		return ContextAssignees
+	}
+
+	// Facet browser
+	if m.focused == focusFacets {
+		return ContextFacets
+	}
+
 	// Label dashboard
 	if m.focused == focusLabelDashboard {
 		return ContextLabelDashboard
 	}
 
+	// Tree view
+	if m.focused == focusTree {
+		return ContextTree
+	}
+
 	// Graph view
 	if m.isGraphView {
 		return ContextGraph
@@ -210,6 +246,12 @@ func (c Context) Description() string {
 		ContextSprint:             "Sprint view",
 		ContextLabelDashboard:     "Label dashboard",
 		ContextAttention:          "Attention view",
+		ContextCutLine:            "Release cut line",
+		ContextTimeline:           "Timeline",
+		ContextTree:               "Tree view",
+		ContextDepTree:            "Dependency tree",
+		ContextAssignees:          "Assignees",
+		ContextFacets:             "Facet browser",
 		ContextSplit:              "Split view",
 		ContextDetail:             "Issue detail",
 		ContextTimeTravel:         "Time-travel mode",
@@ -239,7 +281,7 @@ func (c Context) IsView() bool {
 	switch c {
 	case ContextInsights, ContextFlowMatrix, ContextGraph, ContextBoard,
 		ContextActionable, ContextHistory, ContextSprint, ContextLabelDashboard,
-		ContextAttention, ContextSplit, ContextDetail, ContextTimeTravel:
+		ContextAttention, ContextCutLine, ContextTimeline, ContextTree, ContextDepTree, ContextAssignees, ContextFacets, ContextSplit, ContextDetail, ContextTimeTravel:
 		return true
 	}
 	return false
@@ -24,6 +24,11 @@ var ContextHelpContent = map[Context]string{
 	ContextTimeTravel:     contextHelpTimeTravel,
 	ContextLabelDashboard: contextHelpLabelDashboard,
 	ContextAttention:      contextHelpAttention,
+	ContextCutLine:        contextHelpCutLine,
+	ContextTimeline:       contextHelpTimeline,
+	ContextDepTree:        contextHelpDepTree,
+	ContextAssignees:      contextHelpAssignees,
+	ContextFacets:         contextHelpFacets,
 	ContextAgentPrompt:    contextHelpAgentPrompt,
 	ContextCassSession:    contextHelpCassSession,
 }
@@ -76,7 +81,7 @@ func RenderContextHelp(ctx Context, theme Theme, width, height int) string {
 
 	// Wrap in modal style
 	modalStyle := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(theme.Secondary).
 		Padding(1, 2).
 		Width(modalWidth)
@@ -91,29 +96,34 @@ func RenderContextHelp(ctx Context, theme Theme, width, height int) string {
 const contextHelpList = `## List View
 
 **Navigation**
-  j/k       Move up/down
+  j/k 5j    Move (by a count)
+  gg/G 5G   Top / bottom / 5th issue
+  Ctrl+D/U  Half page down/up
+  ma 'a     Set / jump to mark a
   Enter     View issue details
-  g/G       Jump to top/bottom
 
 **Filtering**
-  o         Open issues only
-  c         Closed issues only
-  r         Ready (no blockers)
-  /         Fuzzy search
+  o/c/r     Open / closed / ready only
+  / Q       Fuzzy search / query bar
   Ctrl+S    Semantic search (AI)
-  H         Hybrid ranking
-  Alt+H     Hybrid preset
+  H/Alt+H   Hybrid ranking / preset
+  s/I       Cycle sort / reverse it
 
 **Switch Views**
   a         Actionable view
   b         Board view
   g         Graph view
   i         Insights panel
-  h         History view
+  h/L       History / timeline (Gantt)
+  R         Cut line for selected issue
 
 **Actions**
+  Ctrl+P/T  Command palette / work timer
+  e/Ctrl+N  Edit (marked in bulk) / new issue
+  space/V   Mark issue / range · * pin
+  u/Ctrl+R  Undo / redo edit
   U         Self-update bv
-  V         Preview cass sessions`
+  v         Preview cass sessions`
 
 const contextHelpGraph = `## Graph View
 
@@ -124,6 +134,18 @@ const contextHelpGraph = `## Graph View
   f         Focus on subgraph
   Esc       Exit to list
 
+**Dependency Map (m)**
+Whole graph in layers, blockers left
+of what they block, edges in braille.
+  ←/→       Step between layers
+  H/J/K/L   Pan
+  +/-       Zoom: dots, IDs, titles
+  c         Center on selection
+  v         Image preview (kitty/iTerm2/
+            sixel), else the map
+
+**Search**
+  /         Find an issue and select it
 **Understanding the Graph**
 • Arrows point TO what's blocked
   (A → B means A blocks B)
@@ -158,7 +180,7 @@ const contextHelpBoard = `## Board View
 **Actions**
   Tab       Toggle detail panel
   Ctrl+j/k  Scroll detail panel
-  V         Preview cass sessions
+  v         Preview cass sessions
   y         Copy issue ID
   Enter     View issue details
   Esc       Return to List view`
@@ -216,6 +238,9 @@ const contextHelpDetail = `## Detail View
 
 **Navigation**
   j/k       Scroll content
+  1-9       Follow numbered link
+  n/N Enter Pick a link and follow it
+  ⌫         Back along the trail
   Esc       Return to list
   Tab       Switch to split view
 
@@ -225,7 +250,8 @@ const contextHelpDetail = `## Detail View
 
 **Info Shown**
 • Full description (markdown)
-• Dependencies
+• Dependencies and dependents, numbered
+• Trail of followed dependencies
 • Labels and metadata`
 
 const contextHelpSplit = `## Split View
@@ -233,11 +259,19 @@ const contextHelpSplit = `## Split View
 **Focus**
   Tab       Switch panes
 
+**Layout** (remembered across sessions)
+  < / >     Narrow/widen left pane
+  \         Left pane: list / actionable
+  |         Right pane: detail / graph
+
 **Left Pane (List)**
-  j/k       Navigate issues
+  j/k 5j    Navigate issues (by a count)
+  space/V   Mark issue / range · * pin
 
 **Right Pane (Detail)**
   j/k       Scroll content
+  1-9 n/N   Follow link (Enter)
+  ⌫         Back along the trail
 
 **Exit**
   Esc       Return to list view
@@ -262,7 +296,12 @@ const contextHelpFilter = `## Filter Mode
   Esc       Clear search
 
 **Label Filters**
-  l         Open label picker`
+  l         Open label picker
+
+**Query Filter (Q, any view)**
+  status:open priority<=1 label:api
+  -assignee:alice updated>7d
+  Esc       Restore previous filter`
 
 const contextHelpLabelPicker = `## Label Picker
 
@@ -285,14 +324,18 @@ const contextHelpRecipePicker = `## Recipe Picker
 **Navigation**
   j/k       Move selection
   Enter     Apply recipe
+  s         Save current filters as recipe
   Esc       Cancel
 
 **Recipes**
-Pre-configured filters and sorts:
-• Sprint Ready
-• Blocked Items
-• By Priority
-• Recently Updated`
+Built-in, user and project recipes,
+each marked with where it comes from;
+✓ marks the one in force. Applying one
+re-filters and re-sorts the issues.
+
+Saved recipes go to .bv/recipes.yaml
+with the filter, sort (s/I) and list
+columns in force.`
 
 const contextHelpHelp = `## Help Overlay
 
@@ -300,6 +343,7 @@ You're looking at the help overlay!
 
 **Navigation**
   j/k       Scroll help content
+  Tab       This view / all views
   Space     Open full tutorial
   Esc/?     Close this overlay
 
@@ -360,6 +404,94 @@ Sorted by attention score based on:
 
 Press 1 to return to List view`
 
+const contextHelpCutLine = `## Release Cut Line
+
+**What It Shows**
+Open work split at the release line:
+• In the cut: the target issue and
+  everything it transitively depends on
+• After the cut: everything else
+• ⚠ marks the chain whose slip would
+  move the projected release date
+
+**Navigation**
+  j/k       Move selection
+  Enter     View issue
+  R/Esc     Back to list`
+
+const contextHelpDepTree = `## Dependency Tree
+
+**What It Shows**
+The selected issue's blocking chains:
+• ▲ Blocked by: everything it waits on
+• ▼ Needed by: everything waiting on it
+• ↑2/↓2 steps away from the issue
+• (+n) issues hidden in a collapsed branch
+• (shown above) already expanded earlier
+
+**Navigation**
+  j/k       Move selection
+  h/l       Collapse / expand branch
+  Space     Toggle branch
+  o/O       Expand / collapse all
+  Enter     View issue
+  B/Esc     Back to list`
+
+const contextHelpAssignees = `## Assignees
+
+**What It Shows**
+Open issues grouped by who owns them:
+• WIP: in_progress issues, against the
+  --wip-limit / --wip-limits set
+• ⚠ people over their limit, listed first
+• Blocked: blocked status or waiting on
+  an open blocker (⊘ names it)
+• Unassigned issues in the last section
+
+**Navigation**
+  j/k       Move selection
+  /         Find issue
+  Enter     View issue
+  A/Esc     Back to list`
+
+const contextHelpFacets = `## Facets
+
+**What It Shows**
+Labels, types and assignees of the open
+issues, each with its issue count:
+• Picked labels must all be present
+• Picked types or assignees: any of them
+• Counts follow the picks, so values
+  that would leave nothing drop out
+
+**Navigation**
+  h/l       Switch column
+  j/k       Move selection
+  Space     Pick / unpick value
+  x         Clear picks
+  c         Include closed issues
+  Enter     Filter the list (Q edits it)
+  #/Esc     Back to list`
+
+const contextHelpTimeline = `## Timeline
+
+**What It Shows**
+Open work scheduled in dependency
+order, one bar per issue:
+• ━ in progress, ├─┤ planned
+• ┄▶ waits on a dependency
+• ◆ due date (red when late)
+• ┊ today
+Track lanes share the work among as
+many agents as there are assignees;
+s regroups lanes by assignee.
+
+**Navigation**
+  j/k       Move selection
+  s         Lanes by track/assignee
+  Enter     View issue
+  L/Esc     Back to list`
+
 const contextHelpAgentPrompt = `## AI Agent Prompt
 
 **Input**
@@ -659,8 +659,8 @@ func TestExportToMarkdownSmoke(t *testing.T) {
 	if len(files) == 0 {
 		t.Fatalf("expected export file to be written")
 	}
-	if m.statusIsError {
-		t.Fatalf("exportToMarkdown should succeed, got error status")
+	if len(m.toasts) != 1 || m.toasts[0].level != toastSuccess {
+		t.Fatalf("exportToMarkdown should toast its success, got %+v", m.toasts)
 	}
 }
 
@@ -949,8 +949,8 @@ func TestExportToMarkdownCreatesFile(t *testing.T) {
 	if _, err := os.Stat(filepath.Join(tmp, filename)); err != nil {
 		t.Fatalf("expected export file to exist: %v", err)
 	}
-	if m.statusIsError {
-		t.Fatalf("export should succeed, got error %q", m.statusMsg)
+	if len(m.toasts) != 1 || m.toasts[0].level != toastSuccess {
+		t.Fatalf("export should succeed, got %+v", m.toasts)
 	}
 }
 
@@ -1033,8 +1033,15 @@ func TestRenderFooterVariantsAndDiffStatus(t *testing.T) {
 	// Diff status mapping
 	m.newIssueIDs = map[string]bool{"n": true}
 	m.closedIssueIDs = map[string]bool{"c": true}
-	m.modifiedIssueIDs = map[string]bool{"m": true}
+	m.modifiedIssueIDs = map[string]bool{"m": true, "b": true}
+	m.blockedFlipIDs = map[string]bool{"b": true, "u": false}
 	m.timeTravelMode = true
+	if got := m.getDiffStatus("b"); got != DiffStatusBlocked {
+		t.Fatalf("blocked diff status should win over modified: %v", got)
+	}
+	if got := m.getDiffStatus("u"); got != DiffStatusUnblocked {
+		t.Fatalf("unblocked diff status mismatch: %v", got)
+	}
 	if got := m.getDiffStatus("n"); got != DiffStatusNew {
 		t.Fatalf("new diff status mismatch: %v", got)
 	}
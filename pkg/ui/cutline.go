@@ -0,0 +1,256 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CutLineModel renders a release cut-line plan: the target's required work
+// above the line, everything else below it, with slip-sensitive items marked.
+type CutLineModel struct {
+	plan         analysis.CutLinePlan
+	err          error
+	selected     int
+	scrollOffset int
+	width        int
+	height       int
+	theme        Theme
+}
+
+// NewCutLineModel creates a cut-line view for the given plan (or error)
+func NewCutLineModel(plan analysis.CutLinePlan, err error, theme Theme) CutLineModel {
+	return CutLineModel{plan: plan, err: err, theme: theme}
+}
+
+// SetSize updates the view dimensions
+func (m *CutLineModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+func (m *CutLineModel) itemCount() int {
+	return len(m.plan.InCut) + len(m.plan.AfterCut)
+}
+
+func (m *CutLineModel) itemAt(idx int) (analysis.CutLineItem, bool) {
+	if idx < 0 || idx >= m.itemCount() {
+		return analysis.CutLineItem{}, false
+	}
+	if idx < len(m.plan.InCut) {
+		return m.plan.InCut[idx], true
+	}
+	return m.plan.AfterCut[idx-len(m.plan.InCut)], true
+}
+
+// MoveDown moves selection down
+func (m *CutLineModel) MoveDown() {
+	if m.selected < m.itemCount()-1 {
+		m.selected++
+	}
+	m.ensureVisible()
+}
+
+// MoveUp moves selection up
+func (m *CutLineModel) MoveUp() {
+	if m.selected > 0 {
+		m.selected--
+	}
+	m.ensureVisible()
+}
+
+// SelectedIssueID returns the ID of the currently selected issue
+func (m *CutLineModel) SelectedIssueID() string {
+	it, ok := m.itemAt(m.selected)
+	if !ok {
+		return ""
+	}
+	return it.ID
+}
+
+// SelectByID selects the given issue, reporting whether it is in the plan
+func (m *CutLineModel) SelectByID(id string) bool {
+	for i := 0; i < m.itemCount(); i++ {
+		if it, _ := m.itemAt(i); it.ID == id {
+			m.selected = i
+			m.ensureVisible()
+			return true
+		}
+	}
+	return false
+}
+
+// lineOf returns the body line index of item idx (section headers and the
+// cut separator take one line each).
+func (m *CutLineModel) lineOf(idx int) int {
+	if idx < len(m.plan.InCut) {
+		return 1 + idx
+	}
+	return 1 + len(m.plan.InCut) + 2 + (idx - len(m.plan.InCut))
+}
+
+func (m *CutLineModel) ensureVisible() {
+	visible := m.height - 3
+	if visible < 3 {
+		visible = 3
+	}
+	line := m.lineOf(m.selected)
+	if line < m.scrollOffset {
+		m.scrollOffset = line
+	}
+	if line >= m.scrollOffset+visible {
+		m.scrollOffset = line - visible + 1
+	}
+}
+
+// Render renders the cut-line view
+func (m *CutLineModel) Render() string {
+	if m.width == 0 || m.height == 0 {
+		return ""
+	}
+	t := m.theme
+
+	headerStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Base.GetForeground()).
+		Background(t.Primary).
+		Padding(0, 2).
+		Width(m.width - 4)
+
+	if m.err != nil {
+		return headerStyle.Render("✂ RELEASE CUT LINE") + "\n\n" +
+			t.Renderer.NewStyle().Foreground(t.Blocked).Render(m.err.Error())
+	}
+
+	header := fmt.Sprintf("✂ RELEASE CUT LINE  │  target %s  │  %d in · %d after",
+		m.plan.TargetID, len(m.plan.InCut), len(m.plan.AfterCut))
+	if m.plan.LineDate != nil {
+		header += "  │  line " + m.plan.LineDate.Format("Jan 2")
+	}
+
+	sectionStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	cutStyle := t.Renderer.NewStyle().Foreground(t.Feature).Bold(true)
+
+	var body []string
+	body = append(body, sectionStyle.Render("IN THE CUT"))
+	for i, it := range m.plan.InCut {
+		body = append(body, m.renderItem(i, it))
+	}
+	sepWidth := m.width - 30
+	if sepWidth < 4 {
+		sepWidth = 4
+	}
+	body = append(body, cutStyle.Render("✂ "+strings.Repeat("─", sepWidth)+" cut line"))
+	body = append(body, sectionStyle.Render("AFTER THE CUT"))
+	for i, it := range m.plan.AfterCut {
+		body = append(body, m.renderItem(len(m.plan.InCut)+i, it))
+	}
+
+	visible := m.height - 3
+	if visible < 1 {
+		visible = 1
+	}
+	start := m.scrollOffset
+	if start > len(body) {
+		start = len(body)
+	}
+	end := start + visible
+	if end > len(body) {
+		end = len(body)
+	}
+
+	legend := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).
+		Render("⚠ slip moves the line  •  j/k move  •  enter open  •  esc back")
+
+	return headerStyle.Render(header) + "\n" + strings.Join(body[start:end], "\n") + "\n" + legend
+}
+
+func (m *CutLineModel) renderItem(idx int, it analysis.CutLineItem) string {
+	t := m.theme
+	var sb strings.Builder
+
+	if idx == m.selected {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ "))
+	} else {
+		sb.WriteString("  ")
+	}
+	if it.OnLine {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Blocked).Render("⚠ "))
+	} else {
+		sb.WriteString("  ")
+	}
+	sb.WriteString(GetPriorityIcon(it.Priority))
+	sb.WriteString(" ")
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(it.ID))
+	sb.WriteString(" ")
+
+	suffix := ""
+	switch {
+	case it.Reason != "":
+		suffix = "  " + it.Reason
+	case it.Finish != nil:
+		suffix = "  " + it.Finish.Format("Jan 2")
+	}
+	maxTitle := m.width - 30 - len([]rune(it.ID)) - len([]rune(suffix))
+	if maxTitle < 10 {
+		maxTitle = 10
+	}
+	sb.WriteString(truncateRunesHelper(it.Title, maxTitle, "…"))
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Subtext).Render(suffix))
+
+	lineStyle := t.Renderer.NewStyle().Width(m.width - 2)
+	if idx == m.selected {
+		lineStyle = lineStyle.Background(t.Highlight)
+	}
+	return lineStyle.Render(sb.String())
+}
+
+// openCutLine computes the cut-line plan for targetID and focuses the view
+func (m Model) openCutLine(targetID string) Model {
+	m.clearAttentionOverlay()
+	m.isGraphView = false
+	m.isBoardView = false
+	m.isActionableView = false
+	m.isHistoryView = false
+	plan, err := analysis.ComputeCutLine(m.issues, analysis.CutLineOptions{TargetID: targetID})
+	m.cutLineView = NewCutLineModel(plan, err, m.theme)
+	m.cutLineView.SetSize(m.width, m.height-1)
+	m.focused = focusCutLine
+	return m
+}
+
+// handleCutLineKeys handles keyboard input when the cut-line view is focused
+func (m Model) handleCutLineKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.cutLineView.MoveDown()
+	case "k", "up":
+		m.cutLineView.MoveUp()
+	case "/":
+		m = m.openIssueSearch()
+	case "R", "esc":
+		m.focused = focusList
+	case "enter":
+		// Jump to selected issue in list view
+		selectedID := m.cutLineView.SelectedIssueID()
+		if selectedID == "" {
+			return m
+		}
+		for i, item := range m.list.Items() {
+			if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
+				m.list.Select(i)
+				break
+			}
+		}
+		m.focused = focusDetail
+		if !m.isSplitView {
+			m.showDetails = true
+			m.viewport.GotoTop()
+		}
+		m.updateViewportContent()
+	}
+	return m
+}
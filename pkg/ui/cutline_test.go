@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+func TestCutLineRenderAndNavigation(t *testing.T) {
+	plan := analysis.CutLinePlan{
+		TargetID: "rel",
+		InCut: []analysis.CutLineItem{
+			{ID: "api", Title: "Ship API", OnLine: true},
+			{ID: "rel", Title: "Release", OnLine: true},
+		},
+		AfterCut: []analysis.CutLineItem{
+			{ID: "polish", Title: "Polish", Reason: analysis.CutReasonNotRequired},
+		},
+	}
+	m := NewCutLineModel(plan, nil, newTestTheme())
+	m.SetSize(100, 20)
+
+	out := m.Render()
+	for _, want := range []string{"RELEASE CUT LINE", "target rel", "IN THE CUT", "cut line", "AFTER THE CUT", "⚠", "not required by target"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("render missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "Ship API") > strings.Index(out, "AFTER THE CUT") {
+		t.Error("in-cut items should render above the cut line")
+	}
+
+	if got := m.SelectedIssueID(); got != "api" {
+		t.Fatalf("initial selection = %q, want api", got)
+	}
+	m.MoveDown()
+	m.MoveDown()
+	if got := m.SelectedIssueID(); got != "polish" {
+		t.Fatalf("selection should cross the cut line, got %q", got)
+	}
+	m.MoveDown()
+	if got := m.SelectedIssueID(); got != "polish" {
+		t.Fatalf("selection should stop at the last item, got %q", got)
+	}
+}
+
+func TestCutLineRenderError(t *testing.T) {
+	m := NewCutLineModel(analysis.CutLinePlan{}, errors.New("issue \"x\" not found"), newTestTheme())
+	m.SetSize(80, 10)
+	if out := m.Render(); !strings.Contains(out, "not found") {
+		t.Errorf("expected error message, got:\n%s", out)
+	}
+}
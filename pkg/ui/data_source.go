@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// dataSourceStatInterval is how often the loaded file is checked for newer
+// on-disk data. The watcher normally reloads first; this catches the cases it
+// misses (watcher failed to start, network filesystems, debounce in flight).
+const dataSourceStatInterval = 2 * time.Second
+
+// dataSourceStatMsg carries the beads file's modification time
+type dataSourceStatMsg struct {
+	ModTime time.Time
+}
+
+// dataSourceStatCmd stats path after dataSourceStatInterval, off the UI thread
+func dataSourceStatCmd(path string) tea.Cmd {
+	return tea.Tick(dataSourceStatInterval, func(time.Time) tea.Msg {
+		info, err := os.Stat(path)
+		if err != nil {
+			return dataSourceStatMsg{}
+		}
+		return dataSourceStatMsg{ModTime: info.ModTime()}
+	})
+}
+
+// notifyRefresh toasts a live reload, as a warning when lines were skipped
+func (m *Model) notifyRefresh(count, warnings int, cached bool) tea.Cmd {
+	text := fmt.Sprintf("Data refreshed · %d issues", count)
+	if cached {
+		text += " (cached)"
+	}
+	if warnings > 0 {
+		text += fmt.Sprintf(" · %d warnings", warnings)
+		return m.notify(toastWarning, text)
+	}
+	return m.notify(toastInfo, text)
+}
+
+// dataSourceLabel names where the loaded issues came from
+func (m Model) dataSourceLabel() string {
+	switch {
+	case m.workspaceMode && m.workspaceSummary != "":
+		return "workspace " + m.workspaceSummary
+	case m.beadsPath == "":
+		return "in-memory"
+	}
+	if cwd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(cwd, m.beadsPath); err == nil && len(rel) < len(m.beadsPath) {
+			return rel
+		}
+	}
+	return m.beadsPath
+}
+
+// loadedAt is when the data on screen was read from its source
+func (m Model) loadedAt() time.Time {
+	if m.snapshot != nil && !m.snapshot.CreatedAt.IsZero() {
+		loaded := m.snapshot.CreatedAt
+		if m.backgroundWorker != nil {
+			if last := m.backgroundWorker.LastLoadTime(); last.After(loaded) {
+				loaded = last
+			}
+		}
+		return loaded
+	}
+	return m.dataLoadedAt
+}
+
+// loadedDataHash is the content hash of the data on screen
+func (m Model) loadedDataHash() string {
+	if m.snapshot != nil && m.snapshot.DataHash != "" {
+		return m.snapshot.DataHash
+	}
+	return m.dataHash
+}
+
+// diskIsNewer reports whether the source file changed after the last load
+func (m Model) diskIsNewer() bool {
+	loaded := m.loadedAt()
+	return !m.diskModTime.IsZero() && !loaded.IsZero() && m.diskModTime.After(loaded)
+}
+
+// refreshModeLabel describes how the data is kept current: "live" with
+// fsnotify, "poll 2s" when polling, "manual" without a watcher
+func (m Model) refreshModeLabel() string {
+	var (
+		polling  bool
+		interval time.Duration
+	)
+	switch {
+	case m.backgroundWorker != nil:
+		polling, _, interval = m.backgroundWorker.WatcherInfo()
+	case m.watcher != nil:
+		polling, interval = m.watcher.IsPolling(), m.watcher.PollInterval()
+	default:
+		return "manual"
+	}
+	if polling {
+		return "poll " + interval.String()
+	}
+	return "live"
+}
+
+// renderDataSourceBadge renders the footer's data-source widget: a warning
+// when the file on disk is newer than what is loaded, plus source, age, hash
+// and refresh mode while the widget is toggled on (ctrl+g).
+func (m Model) renderDataSourceBadge() string {
+	newer := m.diskIsNewer()
+	if !m.showDataSource && !newer {
+		return ""
+	}
+
+	var out string
+	if newer {
+		out = lipgloss.NewStyle().
+			Background(ColorPrioHighBg).
+			Foreground(ColorWarning).
+			Bold(true).
+			Padding(0, 1).
+			Render("⟳ newer on disk (ctrl+r)")
+	}
+	if m.showDataSource {
+		// Keep the tail of long paths: the file name matters more than the root.
+		text := m.dataSourceLabel()
+		if runes := []rune(text); len(runes) > 40 {
+			text = "…" + string(runes[len(runes)-39:])
+		}
+		if loaded := m.loadedAt(); !loaded.IsZero() {
+			text += " · " + formatDataAge(time.Since(loaded)) + " ago"
+		}
+		if hash := m.loadedDataHash(); hash != "" {
+			text += " · #" + truncateRunesHelper(hash, 8, "")
+		}
+		text += " · " + m.refreshModeLabel()
+		out += lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorInfo).
+			Padding(0, 1).
+			Render("⛁ " + text)
+	}
+	return out
+}
+
+// formatDataAge renders a coarse age like "<1s", "42s", "5m", "3h", "2d"
+func formatDataAge(d time.Duration) string {
+	switch {
+	case d < time.Second:
+		return "<1s"
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestDataSourceBadge(t *testing.T) {
+	issues := []model.Issue{{ID: "a", Title: "A", Status: model.StatusOpen}}
+	m := NewModel(issues, nil, "")
+	m.width = 200
+	m.beadsPath = filepath.Join(t.TempDir(), "beads.jsonl")
+
+	if got := m.renderDataSourceBadge(); got != "" {
+		t.Fatalf("badge should be hidden by default, got %q", got)
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlG})
+	m = updated.(Model)
+	if !m.showDataSource {
+		t.Fatal("ctrl+g should toggle the data-source widget on")
+	}
+	hash := analysis.ComputeDataHash(issues)
+	footer := m.renderFooter()
+	for _, want := range []string{"beads.jsonl", "ago", "#" + hash[:8], "manual"} {
+		if !strings.Contains(footer, want) {
+			t.Errorf("footer missing %q: %s", want, footer)
+		}
+	}
+	if strings.Contains(footer, "newer on disk") {
+		t.Error("fresh data should not be flagged as stale")
+	}
+
+	// A newer on-disk file is flagged even with the widget off.
+	m.showDataSource = false
+	updated, _ = m.Update(dataSourceStatMsg{ModTime: m.dataLoadedAt.Add(time.Minute)})
+	m = updated.(Model)
+	if !strings.Contains(m.renderFooter(), "newer on disk") {
+		t.Error("footer should flag data newer on disk than what is loaded")
+	}
+
+	// Snapshots supply their own load time and hash.
+	m.snapshot = &DataSnapshot{CreatedAt: m.diskModTime.Add(time.Second), DataHash: "feedfacecafe"}
+	m.showDataSource = true
+	footer = m.renderFooter()
+	if strings.Contains(footer, "newer on disk") || !strings.Contains(footer, "#feedface") {
+		t.Errorf("footer should reflect the snapshot: %s", footer)
+	}
+}
+
+func TestDataSourceStatCmd(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.jsonl")
+	if err := os.WriteFile(path, []byte("{}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	info, _ := os.Stat(path)
+	msg, ok := dataSourceStatCmd(path)().(dataSourceStatMsg)
+	if !ok || !msg.ModTime.Equal(info.ModTime()) {
+		t.Fatalf("stat msg = %+v, want mod time %v", msg, info.ModTime())
+	}
+	if msg, _ := dataSourceStatCmd(path + ".missing")().(dataSourceStatMsg); !msg.ModTime.IsZero() {
+		t.Fatalf("missing file should report a zero mod time, got %v", msg.ModTime)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 
@@ -17,8 +18,11 @@ type IssueDelegate struct {
 	Theme             Theme
 	ShowPriorityHints bool
 	PriorityHints     map[string]*analysis.PriorityRecommendation
-	WorkspaceMode     bool // When true, shows repo prefix badges
-	ShowSearchScores  bool // Show semantic/hybrid score badge when search is active
+	WorkspaceMode     bool            // When true, shows repo prefix badges
+	ShowSearchScores  bool            // Show semantic/hybrid score badge when search is active
+	Marked            map[string]bool // Issues marked for bulk actions
+	Pinned            map[string]bool // Issues pinned to the top of the list
+	Columns           []ListColumn    // Columns to show; nil for DefaultListColumns
 }
 
 func (d IssueDelegate) Height() int {
@@ -48,74 +52,59 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	width = width - 1
 
 	isSelected := index == m.Index()
+	if TermCapabilities().Plain {
+		fmt.Fprint(w, d.plainRow(i, isSelected, width))
+		return
+	}
 
 	// ══════════════════════════════════════════════════════════════════════════
 	// POLISHED ROW LAYOUT - Stripe-level visual hierarchy
-	// Layout: [sel] [type] [prio-badge] [status-badge] [ID] [title...] [meta]
+	// Default layout: [sel] [type] [prio-badge] [status-badge] [ID] [title...] [meta]
 	// ══════════════════════════════════════════════════════════════════════════
 
-	// Get all the data
-	icon, iconColor := t.GetTypeIcon(string(i.Issue.IssueType))
-	idStr := i.Issue.ID
-	title := i.Issue.Title
-	ageStr := FormatTimeRel(i.Issue.CreatedAt)
-	commentCount := len(i.Issue.Comments)
-
-	// Measure actual icon display width (emojis vary: 1-2 cells)
-	iconDisplayWidth := lipgloss.Width(icon)
-
-	// Calculate widths for right-side columns (fixed)
-	rightWidth := 0
-	var rightParts []string
-
-	// Show Age and Comments only if we have reasonable width
-	if width > 60 {
-		// Age - with subtle styling (using pre-computed style)
-		rightParts = append(rightParts, t.MutedText.Render(fmt.Sprintf("%8s", ageStr)))
-		rightWidth += 9
-
-		// Comments with icon - use lipgloss.Width for accurate emoji measurement
-		if commentCount > 0 {
-			commentStr := fmt.Sprintf("💬%d", commentCount)
-			rightParts = append(rightParts, t.InfoText.Render(commentStr))
-			rightWidth += lipgloss.Width(commentStr) + 1 // +1 for spacing
-		} else {
-			rightParts = append(rightParts, "   ")
-			rightWidth += 3
-		}
+	columns := d.Columns
+	if len(columns) == 0 {
+		columns = DefaultListColumns()
 	}
-
-	// Sparkline (Graph Score) - visualization of importance
-	if width > 120 {
-		spark := RenderSparkline(i.GraphScore, 5)
-		sparkColor := GetHeatmapColor(i.GraphScore, t)
-		sparkStyle := t.Renderer.NewStyle().Foreground(sparkColor)
-		rightParts = append(rightParts, sparkStyle.Render(spark))
-		rightWidth += 6 // 5 + 1 spacing
+	titleAt := 0
+	for idx, c := range columns {
+		if c.Name == "title" {
+			titleAt = idx
+		}
 	}
 
-	// Assignee (if present and we have room)
-	if width > 100 && i.Issue.Assignee != "" {
-		assignee := truncateRunesHelper(i.Issue.Assignee, 12, "…")
-		rightParts = append(rightParts, t.SecondaryText.Render(fmt.Sprintf("@%-12s", assignee)))
-		rightWidth += 14
+	// Columns before the title are laid out left to right, columns after it
+	// are aligned on the right. Responsive columns drop out as the list
+	// narrows.
+	var leftCells, rightCells []listCell
+	for idx, c := range columns {
+		if c.Name == "title" {
+			continue
+		}
+		if def, _ := listColumnDefFor(c.Name); def.minWidth > 0 && width <= def.minWidth {
+			continue
+		}
+		cell, ok := d.cell(c, i, isSelected)
+		if !ok {
+			continue
+		}
+		if idx < titleAt {
+			leftCells = append(leftCells, cell)
+		} else {
+			rightCells = append(rightCells, cell)
+		}
 	}
 
-	// Labels (if present and we have room) - render as mini tags
-	if width > 140 && len(i.Issue.Labels) > 0 {
-		labelStr := truncateRunesHelper(strings.Join(i.Issue.Labels, ","), 20, "…")
-		labelStyle := t.Renderer.NewStyle().
-			Foreground(ColorPrimary).
-			Background(ColorBgSubtle).
-			Padding(0, 1)
-		rightParts = append(rightParts, labelStyle.Render(labelStr))
-		rightWidth += lipgloss.Width(labelStyle.Render(labelStr)) + 1
+	// Calculate widths for right-side columns (fixed)
+	rightWidth := 0
+	var rightParts []string
+	for _, cell := range rightCells {
+		rightParts = append(rightParts, cell.text)
+		rightWidth += cell.width + 1
 	}
 
-	// Left side fixed columns with polished badges
-	// [selector 2] [repo-badge 0-6] [icon 1-2] [prio-badge 3] [hint 1-2] [status-badge 6] [id dynamic] [space]
-	// Use measured iconDisplayWidth instead of hardcoded value for proper alignment
-	leftFixedWidth := 2 + iconDisplayWidth + 1 // selector(2) + icon(measured) + space(1)
+	// Left side: [selector 2] [repo-badge] [columns...] [space each]
+	leftFixedWidth := 2
 
 	// Repo badge width (workspace mode)
 	var repoBadge string
@@ -124,53 +113,30 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 		repoBadge = RenderRepoBadge(i.RepoPrefix)
 		leftFixedWidth += lipgloss.Width(repoBadge) + 1
 	}
-
-	// Priority badge (polished)
-	prioBadge := RenderPriorityBadge(i.Issue.Priority)
-	prioBadgeWidth := lipgloss.Width(prioBadge)
-	leftFixedWidth += prioBadgeWidth + 1
-
-	// Priority hint indicator
-	if d.ShowPriorityHints {
-		leftFixedWidth += 2
-	}
-
-	// Triage indicator width (bv-151) - use lipgloss.Width for accurate emoji measurement
-	if i.IsQuickWin {
-		leftFixedWidth += lipgloss.Width("⭐") + 1 // emoji + space
-	} else if i.IsBlocker && i.UnblocksCount > 0 {
-		leftFixedWidth += lipgloss.Width(fmt.Sprintf("🔓%d", i.UnblocksCount)) + 1 // emoji+count + space
-	} else if i.UnblocksCount > 0 {
-		leftFixedWidth += lipgloss.Width(fmt.Sprintf("↪%d", i.UnblocksCount)) + 1 // arrow+count + space
+	for _, cell := range leftCells {
+		leftFixedWidth += cell.width + 1
 	}
 
-	// Status badge (polished)
-	statusBadge := RenderStatusBadge(string(i.Issue.Status))
-	statusBadgeWidth := lipgloss.Width(statusBadge)
-	leftFixedWidth += statusBadgeWidth + 1
-
-	// Search score badge (semantic/hybrid)
-	var searchBadge string
-	if d.ShowSearchScores && i.SearchScoreSet {
-		scoreStr := fmt.Sprintf("%.2f", i.SearchScore)
-		searchBadge = t.InfoBold.Render(fmt.Sprintf("[%s]", scoreStr))
-		leftFixedWidth += lipgloss.Width(searchBadge) + 1
+	// The search score and diff badges sit beside the ID, or before the
+	// title when the ID is hidden
+	var titleBadges []string
+	if !d.showsID(columns) {
+		if badge := d.searchBadge(i); badge != "" {
+			titleBadges = append(titleBadges, badge)
+		}
+		if badge := i.DiffStatus.Badge(); badge != "" {
+			titleBadges = append(titleBadges, badge)
+		}
 	}
-
-	// ID width - use actual visual width, but cap reasonably
-	idWidth := lipgloss.Width(idStr)
-	if idWidth > 35 {
-		idWidth = 35
-		idStr = truncateRunesHelper(idStr, 35, "…")
+	if d.Pinned[i.Issue.ID] {
+		titleBadges = append(titleBadges, pinGlyph())
 	}
-	leftFixedWidth += idWidth + 1
-
-	// Diff badge width adjustment
-	if badge := i.DiffStatus.Badge(); badge != "" {
+	for _, badge := range titleBadges {
 		leftFixedWidth += lipgloss.Width(badge) + 1
 	}
 
 	// Title gets everything in between
+	title := i.Issue.Title
 	titleWidth := width - leftFixedWidth - rightWidth - 2
 	if titleWidth < 5 {
 		titleWidth = 5
@@ -190,10 +156,16 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	// ══════════════════════════════════════════════════════════════════════════
 	var leftSide strings.Builder
 
-	// Selection indicator with accent color (using pre-computed style)
-	if isSelected {
+	// Selection indicator with accent color (using pre-computed style),
+	// with the bulk-selection mark beside it
+	switch {
+	case d.Marked[i.Issue.ID] && isSelected:
+		leftSide.WriteString(t.PrimaryBold.Render("▸" + markGlyph()))
+	case d.Marked[i.Issue.ID]:
+		leftSide.WriteString(" " + t.PrimaryBold.Render(markGlyph()))
+	case isSelected:
 		leftSide.WriteString(t.PrimaryBold.Render("▸ "))
-	} else {
+	default:
 		leftSide.WriteString("  ")
 	}
 
@@ -203,62 +175,11 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 		leftSide.WriteString(" ")
 	}
 
-	// Type icon with color
-	leftSide.WriteString(t.Renderer.NewStyle().Foreground(iconColor).Render(icon))
-	leftSide.WriteString(" ")
-
-	// Priority badge (polished)
-	leftSide.WriteString(prioBadge)
-	leftSide.WriteString(" ")
-
-	// Priority hint indicator (↑/↓) - using pre-computed styles
-	if d.ShowPriorityHints && d.PriorityHints != nil {
-		if hint, ok := d.PriorityHints[i.Issue.ID]; ok {
-			if hint.Direction == "increase" {
-				leftSide.WriteString(t.PriorityUpArrow.Render("↑"))
-			} else if hint.Direction == "decrease" {
-				leftSide.WriteString(t.PriorityDownArrow.Render("↓"))
-			}
-		} else {
-			leftSide.WriteString(" ")
-		}
-		leftSide.WriteString(" ")
-	}
-
-	// Triage indicators (bv-151): Quick win ⭐ and Unblocks count 🔓 - using pre-computed styles
-	triageIndicator := ""
-	if i.IsQuickWin {
-		triageIndicator = t.TriageStar.Render("⭐")
-	} else if i.IsBlocker && i.UnblocksCount > 0 {
-		triageIndicator = t.TriageUnblocks.Render(fmt.Sprintf("🔓%d", i.UnblocksCount))
-	} else if i.UnblocksCount > 0 {
-		triageIndicator = t.TriageUnblocksAlt.Render(fmt.Sprintf("↪%d", i.UnblocksCount))
-	}
-	if triageIndicator != "" {
-		leftSide.WriteString(triageIndicator)
-		leftSide.WriteString(" ")
-	}
-
-	// Status badge (polished)
-	leftSide.WriteString(statusBadge)
-	leftSide.WriteString(" ")
-
-	// Search score badge (optional)
-	if searchBadge != "" {
-		leftSide.WriteString(searchBadge)
+	for _, cell := range leftCells {
+		leftSide.WriteString(cell.text)
 		leftSide.WriteString(" ")
 	}
-
-	// ID with secondary styling (using pre-computed style base)
-	idStyle := t.SecondaryText
-	if isSelected {
-		idStyle = idStyle.Bold(true)
-	}
-	leftSide.WriteString(idStyle.Render(idStr))
-	leftSide.WriteString(" ")
-
-	// Diff badge (time-travel mode)
-	if badge := i.DiffStatus.Badge(); badge != "" {
+	for _, badge := range titleBadges {
 		leftSide.WriteString(badge)
 		leftSide.WriteString(" ")
 	}
@@ -296,3 +217,188 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 
 	fmt.Fprint(w, row)
 }
+
+// listCell is one column of a row: its text and the width it takes
+type listCell struct {
+	text  string
+	width int
+}
+
+// cell renders column c of an issue's row. ok is false when the column
+// shows nothing for this issue.
+func (d IssueDelegate) cell(c ListColumn, i IssueItem, isSelected bool) (cell listCell, ok bool) {
+	t := d.Theme
+	switch c.Name {
+	case "type":
+		// Measure actual icon display width (emojis vary: 1-2 cells)
+		icon, iconColor := t.GetTypeIcon(string(i.Issue.IssueType))
+		return listCell{t.Renderer.NewStyle().Foreground(iconColor).Render(icon), lipgloss.Width(icon)}, true
+
+	case "priority":
+		// Priority badge, with the hint (↑/↓) and triage indicator after it
+		prioBadge := RenderPriorityBadge(i.Issue.Priority)
+		cell = listCell{prioBadge, lipgloss.Width(prioBadge)}
+		if d.ShowPriorityHints {
+			cell.width += 2
+			if d.PriorityHints != nil {
+				hintStr := " "
+				if hint, ok := d.PriorityHints[i.Issue.ID]; ok {
+					hintStr = ""
+					if hint.Direction == "increase" {
+						hintStr = t.PriorityUpArrow.Render(glyph("↑", "^"))
+					} else if hint.Direction == "decrease" {
+						hintStr = t.PriorityDownArrow.Render(glyph("↓", "v"))
+					}
+				}
+				cell.text += " " + hintStr
+			}
+		}
+		// Triage indicators (bv-151): Quick win ⭐ and Unblocks count 🔓
+		quickWinGlyph, unblocksGlyph, unblocksAltGlyph := glyph("⭐", "*"), glyph("🔓", "+"), glyph("↪", ">")
+		triage, triageWidth := "", 0
+		if i.IsQuickWin {
+			triage, triageWidth = t.TriageStar.Render(quickWinGlyph), lipgloss.Width(quickWinGlyph)
+		} else if i.IsBlocker && i.UnblocksCount > 0 {
+			s := fmt.Sprintf("%s%d", unblocksGlyph, i.UnblocksCount)
+			triage, triageWidth = t.TriageUnblocks.Render(s), lipgloss.Width(s)
+		} else if i.UnblocksCount > 0 {
+			s := fmt.Sprintf("%s%d", unblocksAltGlyph, i.UnblocksCount)
+			triage, triageWidth = t.TriageUnblocksAlt.Render(s), lipgloss.Width(s)
+		}
+		if triage != "" {
+			cell.text += " " + triage
+			cell.width += triageWidth + 1
+		}
+		return cell, true
+
+	case "status":
+		statusBadge := RenderStatusBadge(string(i.Issue.Status))
+		return listCell{statusBadge, lipgloss.Width(statusBadge)}, true
+
+	case "id":
+		// ID with secondary styling, capped at the column width, between
+		// the search score and diff badges
+		idStr := i.Issue.ID
+		if lipgloss.Width(idStr) > c.width() {
+			idStr = truncateRunesHelper(idStr, c.width(), "…")
+		}
+		idStyle := t.SecondaryText
+		if isSelected {
+			idStyle = idStyle.Bold(true)
+		}
+		cell = listCell{idStyle.Render(idStr), lipgloss.Width(idStr)}
+		if badge := d.searchBadge(i); badge != "" {
+			cell.text = badge + " " + cell.text
+			cell.width += lipgloss.Width(badge) + 1
+		}
+		if badge := i.DiffStatus.Badge(); badge != "" {
+			cell.text += " " + badge
+			cell.width += lipgloss.Width(badge) + 1
+		}
+		return cell, true
+
+	case "created", "updated":
+		at := i.Issue.CreatedAt
+		if c.Name == "updated" {
+			at = i.Issue.UpdatedAt
+		}
+		s := truncateRunesHelper(FormatTimeRel(at), c.width(), "…")
+		return listCell{t.MutedText.Render(fmt.Sprintf("%*s", c.width(), s)), c.width()}, true
+
+	case "due":
+		if i.Issue.DueDate == nil {
+			return listCell{strings.Repeat(" ", c.width()), c.width()}, true
+		}
+		due := *i.Issue.DueDate
+		style := t.MutedText
+		if !isClosedLikeStatus(i.Issue.Status) {
+			switch left := time.Until(due); {
+			case left < 0:
+				style = t.Renderer.NewStyle().Foreground(ColorDanger).Bold(true)
+			case left < 3*24*time.Hour:
+				style = t.Renderer.NewStyle().Foreground(ColorWarning)
+			}
+		}
+		s := truncateRunesHelper(due.Format("2006-01-02"), c.width(), "…")
+		return listCell{style.Render(fmt.Sprintf("%*s", c.width(), s)), c.width()}, true
+
+	case "estimate":
+		s := ""
+		if i.Issue.EstimatedMinutes != nil && *i.Issue.EstimatedMinutes > 0 {
+			s = truncateRunesHelper(formatEstimate(*i.Issue.EstimatedMinutes), c.width(), "…")
+		}
+		return listCell{t.InfoText.Render(fmt.Sprintf("%*s", c.width(), s)), c.width()}, true
+
+	case "comments":
+		// Comments with icon - use lipgloss.Width for accurate emoji measurement
+		if n := len(i.Issue.Comments); n > 0 {
+			commentStr := fmt.Sprintf("💬%d", n)
+			return listCell{t.InfoText.Render(commentStr), lipgloss.Width(commentStr)}, true
+		}
+		return listCell{"   ", 2}, true
+
+	case "score":
+		// Sparkline (Graph Score) - visualization of importance
+		spark := RenderSparkline(i.GraphScore, c.width())
+		sparkStyle := t.Renderer.NewStyle().Foreground(GetHeatmapColor(i.GraphScore, t))
+		return listCell{sparkStyle.Render(spark), c.width()}, true
+
+	case "triage":
+		s := ""
+		if i.TriageScore > 0 {
+			s = fmt.Sprintf("%.2f", i.TriageScore)
+		}
+		return listCell{t.InfoText.Render(fmt.Sprintf("%*s", c.width(), truncateRunesHelper(s, c.width(), ""))), c.width()}, true
+
+	case "assignee":
+		if i.Issue.Assignee == "" {
+			return listCell{}, false
+		}
+		assignee := truncateRunesHelper(i.Issue.Assignee, c.width(), "…")
+		return listCell{t.SecondaryText.Render(fmt.Sprintf("@%-*s", c.width(), assignee)), c.width() + 1}, true
+
+	case "labels":
+		// Labels rendered as a mini tag
+		if len(i.Issue.Labels) == 0 {
+			return listCell{}, false
+		}
+		labelStr := truncateRunesHelper(strings.Join(i.Issue.Labels, ","), c.width(), "…")
+		labelStyle := t.Renderer.NewStyle().
+			Foreground(ColorPrimary).
+			Background(ColorBgSubtle).
+			Padding(0, 1)
+		rendered := labelStyle.Render(labelStr)
+		return listCell{rendered, lipgloss.Width(rendered)}, true
+	}
+	return listCell{}, false
+}
+
+// searchBadge returns the semantic/hybrid search score badge, if shown
+func (d IssueDelegate) searchBadge(i IssueItem) string {
+	if !d.ShowSearchScores || !i.SearchScoreSet {
+		return ""
+	}
+	return d.Theme.InfoBold.Render(fmt.Sprintf("[%.2f]", i.SearchScore))
+}
+
+// showsID reports whether the ID column is among columns
+func (d IssueDelegate) showsID(columns []ListColumn) bool {
+	for _, c := range columns {
+		if c.Name == "id" {
+			return true
+		}
+	}
+	return false
+}
+
+// formatEstimate writes an estimate in minutes as 45m, 2h or 1h30m
+func formatEstimate(minutes int) string {
+	h, m := minutes/60, minutes%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	}
+	return fmt.Sprintf("%dh%dm", h, m)
+}
@@ -0,0 +1,471 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// depTreeOpenDepth is how deep the dependency tree starts out expanded;
+// deeper branches start collapsed with their size shown
+const depTreeOpenDepth = 2
+
+// depTreeNode is one issue in the dependency tree. Depth 1 is a direct
+// blocker or dependent of the root issue.
+type depTreeNode struct {
+	id       string
+	issue    *model.Issue // nil when the issue isn't loaded
+	depth    int
+	children []*depTreeNode
+	parent   *depTreeNode
+	expanded bool
+	repeat   bool // expanded elsewhere in the same direction; shown as a leaf
+	upstream bool
+}
+
+// size counts the node's descendants, the number shown on collapsed branches
+func (n *depTreeNode) size() int {
+	total := 0
+	for _, c := range n.children {
+		total += 1 + c.size()
+	}
+	return total
+}
+
+// DepTreeModel shows everything an issue waits on (upstream blockers) and
+// everything waiting on it (downstream dependents) as two collapsible trees.
+type DepTreeModel struct {
+	root         *model.Issue
+	upstream     []*depTreeNode
+	downstream   []*depTreeNode
+	upRows       []*depTreeNode // visible upstream nodes, in display order
+	downRows     []*depTreeNode
+	selected     int
+	scrollOffset int
+	width        int
+	height       int
+	theme        Theme
+}
+
+// NewDepTreeModel builds the blocker and dependent trees of rootID
+func NewDepTreeModel(rootID string, issues []model.Issue, theme Theme) DepTreeModel {
+	byID := make(map[string]*model.Issue, len(issues))
+	dependents := make(map[string][]string)
+	for i := range issues {
+		issue := &issues[i]
+		byID[issue.ID] = issue
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type.IsBlocking() {
+				dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], issue.ID)
+			}
+		}
+	}
+	blockers := func(id string) []string {
+		issue := byID[id]
+		if issue == nil {
+			return nil
+		}
+		var ids []string
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type.IsBlocking() && dep.DependsOnID != "" {
+				ids = append(ids, dep.DependsOnID)
+			}
+		}
+		return ids
+	}
+
+	m := DepTreeModel{root: byID[rootID], theme: theme}
+	if m.root == nil {
+		return m
+	}
+	m.upstream = buildDepTree(rootID, true, blockers, byID)
+	m.downstream = buildDepTree(rootID, false, func(id string) []string { return dependents[id] }, byID)
+	m.rebuildRows()
+	return m
+}
+
+// buildDepTree expands the links of rootID depth-first. Each issue is
+// expanded once per direction; later sightings are leaves marked as repeats,
+// which also stops cycles.
+func buildDepTree(rootID string, upstream bool, next func(string) []string, byID map[string]*model.Issue) []*depTreeNode {
+	seen := map[string]bool{rootID: true}
+	var build func(id string, depth int, parent *depTreeNode) *depTreeNode
+	build = func(id string, depth int, parent *depTreeNode) *depTreeNode {
+		node := &depTreeNode{
+			id:       id,
+			issue:    byID[id],
+			depth:    depth,
+			parent:   parent,
+			upstream: upstream,
+			expanded: depth < depTreeOpenDepth,
+		}
+		if seen[id] {
+			node.repeat = true
+			return node
+		}
+		seen[id] = true
+		for _, childID := range next(id) {
+			node.children = append(node.children, build(childID, depth+1, node))
+		}
+		return node
+	}
+	var nodes []*depTreeNode
+	for _, id := range next(rootID) {
+		nodes = append(nodes, build(id, 1, nil))
+	}
+	return nodes
+}
+
+// SetSize updates the view dimensions
+func (m *DepTreeModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+func (m *DepTreeModel) rebuildRows() {
+	var walk func(rows []*depTreeNode, nodes []*depTreeNode) []*depTreeNode
+	walk = func(rows []*depTreeNode, nodes []*depTreeNode) []*depTreeNode {
+		for _, n := range nodes {
+			rows = append(rows, n)
+			if n.expanded {
+				rows = walk(rows, n.children)
+			}
+		}
+		return rows
+	}
+	m.upRows = walk(nil, m.upstream)
+	m.downRows = walk(nil, m.downstream)
+	if m.selected >= m.rowCount() {
+		m.selected = m.rowCount() - 1
+	}
+	if m.selected < 0 {
+		m.selected = 0
+	}
+}
+
+func (m *DepTreeModel) rowCount() int {
+	return len(m.upRows) + len(m.downRows)
+}
+
+func (m *DepTreeModel) rowAt(idx int) *depTreeNode {
+	switch {
+	case idx < 0 || idx >= m.rowCount():
+		return nil
+	case idx < len(m.upRows):
+		return m.upRows[idx]
+	}
+	return m.downRows[idx-len(m.upRows)]
+}
+
+// MoveDown moves selection down
+func (m *DepTreeModel) MoveDown() {
+	if m.selected < m.rowCount()-1 {
+		m.selected++
+	}
+	m.ensureVisible()
+}
+
+// MoveUp moves selection up
+func (m *DepTreeModel) MoveUp() {
+	if m.selected > 0 {
+		m.selected--
+	}
+	m.ensureVisible()
+}
+
+// ToggleExpand expands or collapses the selected branch
+func (m *DepTreeModel) ToggleExpand() {
+	if n := m.rowAt(m.selected); n != nil && len(n.children) > 0 {
+		n.expanded = !n.expanded
+		m.rebuildRows()
+		m.ensureVisible()
+	}
+}
+
+// ExpandOrMoveToChild expands a collapsed branch, or steps into an open one
+func (m *DepTreeModel) ExpandOrMoveToChild() {
+	n := m.rowAt(m.selected)
+	if n == nil || len(n.children) == 0 {
+		return
+	}
+	if !n.expanded {
+		m.ToggleExpand()
+		return
+	}
+	m.MoveDown()
+}
+
+// CollapseOrJumpToParent collapses an open branch, or moves to its parent
+func (m *DepTreeModel) CollapseOrJumpToParent() {
+	n := m.rowAt(m.selected)
+	if n == nil {
+		return
+	}
+	if len(n.children) > 0 && n.expanded {
+		m.ToggleExpand()
+		return
+	}
+	for i := m.selected - 1; n.parent != nil && i >= 0; i-- {
+		if m.rowAt(i) == n.parent {
+			m.selected = i
+			m.ensureVisible()
+			return
+		}
+	}
+}
+
+// SetAllExpanded expands or collapses every branch
+func (m *DepTreeModel) SetAllExpanded(expanded bool) {
+	var set func(nodes []*depTreeNode)
+	set = func(nodes []*depTreeNode) {
+		for _, n := range nodes {
+			n.expanded = expanded
+			set(n.children)
+		}
+	}
+	set(m.upstream)
+	set(m.downstream)
+	m.rebuildRows()
+	m.ensureVisible()
+}
+
+// SelectedIssueID returns the ID of the currently selected issue
+func (m *DepTreeModel) SelectedIssueID() string {
+	if n := m.rowAt(m.selected); n != nil {
+		return n.id
+	}
+	return ""
+}
+
+// SelectByID selects the first visible row for the given issue, reporting
+// whether it is shown
+func (m *DepTreeModel) SelectByID(id string) bool {
+	for i := 0; i < m.rowCount(); i++ {
+		if m.rowAt(i).id == id {
+			m.selected = i
+			m.ensureVisible()
+			return true
+		}
+	}
+	return false
+}
+
+// sectionRows is how many body lines a section's rows take; an empty
+// section still shows a placeholder line
+func sectionRows(rows []*depTreeNode) int {
+	return max(len(rows), 1)
+}
+
+// lineOf returns the body line index of row idx (each section has a header
+// and the two are separated by a blank line)
+func (m *DepTreeModel) lineOf(idx int) int {
+	if idx < len(m.upRows) {
+		return 1 + idx
+	}
+	return 1 + sectionRows(m.upRows) + 2 + (idx - len(m.upRows))
+}
+
+func (m *DepTreeModel) ensureVisible() {
+	visible := m.height - 3
+	if visible < 3 {
+		visible = 3
+	}
+	line := m.lineOf(m.selected)
+	if line < m.scrollOffset {
+		m.scrollOffset = line
+	}
+	if line >= m.scrollOffset+visible {
+		m.scrollOffset = line - visible + 1
+	}
+}
+
+// countIssues counts the distinct issues in a direction's tree
+func countIssues(nodes []*depTreeNode) int {
+	count := 0
+	var walk func(nodes []*depTreeNode)
+	walk = func(nodes []*depTreeNode) {
+		for _, n := range nodes {
+			if !n.repeat {
+				count++
+			}
+			walk(n.children)
+		}
+	}
+	walk(nodes)
+	return count
+}
+
+// Render renders the dependency tree view
+func (m *DepTreeModel) Render() string {
+	if m.width == 0 || m.height == 0 {
+		return ""
+	}
+	t := m.theme
+
+	headerStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Base.GetForeground()).
+		Background(t.Primary).
+		Padding(0, 2).
+		Width(m.width - 4)
+
+	if m.root == nil {
+		return headerStyle.Render("🌲 DEPENDENCY TREE") + "\n\n" +
+			t.Renderer.NewStyle().Foreground(t.Muted).Render("No issue selected.")
+	}
+
+	header := fmt.Sprintf("🌲 DEPENDENCY TREE  │  %s %s  │  %d upstream · %d downstream",
+		m.root.ID, truncateRunesHelper(m.root.Title, 40, "…"), countIssues(m.upstream), countIssues(m.downstream))
+
+	sectionStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted)
+
+	var body []string
+	body = append(body, sectionStyle.Render("▲ BLOCKED BY (upstream)"))
+	for i, n := range m.upRows {
+		body = append(body, m.renderRow(i, n))
+	}
+	if len(m.upRows) == 0 {
+		body = append(body, mutedStyle.Render("  nothing blocks "+m.root.ID))
+	}
+	body = append(body, "")
+	body = append(body, sectionStyle.Render("▼ NEEDED BY (downstream)"))
+	for i, n := range m.downRows {
+		body = append(body, m.renderRow(len(m.upRows)+i, n))
+	}
+	if len(m.downRows) == 0 {
+		body = append(body, mutedStyle.Render("  nothing waits on "+m.root.ID))
+	}
+
+	visible := m.height - 3
+	if visible < 1 {
+		visible = 1
+	}
+	start := m.scrollOffset
+	if start > len(body) {
+		start = len(body)
+	}
+	end := start + visible
+	if end > len(body) {
+		end = len(body)
+	}
+
+	legend := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).
+		Render("↑n/↓n depth  •  (+n) hidden below  •  h/l collapse/expand  •  enter open  •  esc back")
+
+	return headerStyle.Render(header) + "\n" + strings.Join(body[start:end], "\n") + "\n" + legend
+}
+
+func (m *DepTreeModel) renderRow(idx int, n *depTreeNode) string {
+	t := m.theme
+	muted := t.Renderer.NewStyle().Foreground(t.Muted)
+	var sb strings.Builder
+
+	if idx == m.selected {
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ "))
+	} else {
+		sb.WriteString("  ")
+	}
+	sb.WriteString(muted.Render(strings.Repeat("│ ", n.depth-1)))
+
+	indicator := "•"
+	if len(n.children) > 0 {
+		indicator = "▸"
+		if n.expanded {
+			indicator = "▾"
+		}
+	}
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(indicator))
+
+	arrow := "↓"
+	if n.upstream {
+		arrow = "↑"
+	}
+	sb.WriteString(muted.Render(fmt.Sprintf(" %s%d ", arrow, n.depth)))
+
+	if n.issue == nil {
+		sb.WriteString("? ")
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(n.id))
+		sb.WriteString(muted.Render("  not loaded"))
+		return t.Renderer.NewStyle().Width(m.width - 2).Render(sb.String())
+	}
+
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.GetStatusColor(string(n.issue.Status))).
+		Render(GetStatusIcon(string(n.issue.Status))))
+	sb.WriteString(" ")
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(n.id))
+	sb.WriteString(" ")
+
+	suffix := ""
+	switch {
+	case n.repeat:
+		suffix = "  (shown above)"
+	case len(n.children) > 0 && !n.expanded:
+		suffix = fmt.Sprintf("  (+%d)", n.size())
+	}
+	maxTitle := m.width - 16 - 2*n.depth - len([]rune(n.id)) - len([]rune(suffix))
+	if maxTitle < 10 {
+		maxTitle = 10
+	}
+	sb.WriteString(truncateRunesHelper(n.issue.Title, maxTitle, "…"))
+	sb.WriteString(muted.Render(suffix))
+
+	lineStyle := t.Renderer.NewStyle().Width(m.width - 2)
+	if idx == m.selected {
+		lineStyle = lineStyle.Background(t.Highlight)
+	}
+	return lineStyle.Render(sb.String())
+}
+
+// openDepTree builds the dependency tree of targetID and focuses the view
+func (m Model) openDepTree(targetID string) Model {
+	m.clearAttentionOverlay()
+	m.isGraphView = false
+	m.isBoardView = false
+	m.isActionableView = false
+	m.isHistoryView = false
+	m.depTreeView = NewDepTreeModel(targetID, m.issues, m.theme)
+	m.depTreeView.SetSize(m.width, m.height-1)
+	m.focused = focusDepTree
+	return m
+}
+
+// handleDepTreeKeys handles keyboard input when the dependency tree is focused
+func (m Model) handleDepTreeKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.depTreeView.MoveDown()
+	case "k", "up":
+		m.depTreeView.MoveUp()
+	case "h", "left":
+		m.depTreeView.CollapseOrJumpToParent()
+	case "l", "right":
+		m.depTreeView.ExpandOrMoveToChild()
+	case " ":
+		m.depTreeView.ToggleExpand()
+	case "o":
+		m.depTreeView.SetAllExpanded(true)
+	case "O":
+		m.depTreeView.SetAllExpanded(false)
+	case "/":
+		m = m.openIssueSearch()
+	case "B", "esc":
+		m.focused = focusList
+	case "enter":
+		// Open the selected issue in the detail view
+		selectedID := m.depTreeView.SelectedIssueID()
+		if selectedID == "" || !m.selectInList(selectedID) {
+			return m
+		}
+		m.focused = focusDetail
+		if !m.isSplitView {
+			m.showDetails = true
+			m.viewport.GotoTop()
+		}
+		m.updateViewportContent()
+	}
+	return m
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func depTreeRows(m *DepTreeModel) string {
+	var ids []string
+	for i := 0; i < m.rowCount(); i++ {
+		ids = append(ids, m.rowAt(i).id)
+	}
+	return strings.Join(ids, " ")
+}
+
+func TestDepTreeShowsBlockersAndDependents(t *testing.T) {
+	// api waits on db and auth, auth waits on db, and db on schema; ui and
+	// docs wait on api
+	issues := []model.Issue{
+		{ID: "api", Title: "Ship API", Status: model.StatusInProgress, Dependencies: []*model.Dependency{
+			{IssueID: "api", DependsOnID: "db", Type: model.DepBlocks},
+			{IssueID: "api", DependsOnID: "auth", Type: model.DepBlocks},
+			{IssueID: "api", DependsOnID: "notes", Type: model.DepRelated},
+		}},
+		{ID: "db", Title: "Database", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "db", DependsOnID: "schema", Type: model.DepBlocks}}},
+		{ID: "auth", Title: "Auth", Status: model.StatusBlocked, Dependencies: []*model.Dependency{{IssueID: "auth", DependsOnID: "db", Type: model.DepBlocks}}},
+		{ID: "schema", Title: "Schema", Status: model.StatusClosed},
+		{ID: "notes", Title: "Notes", Status: model.StatusOpen},
+		{ID: "ui", Title: "Frontend", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "ui", DependsOnID: "api", Type: model.DepBlocks}}},
+		{ID: "docs", Title: "Docs", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "docs", DependsOnID: "ui", Type: model.DepBlocks}}},
+	}
+	m := NewDepTreeModel("api", issues, newTestTheme())
+	m.SetSize(120, 30)
+
+	// Related links aren't blockers; db is expanded once and repeats after
+	if got := depTreeRows(&m); got != "db schema auth db ui docs" {
+		t.Fatalf("rows = %q", got)
+	}
+	out := m.Render()
+	for _, want := range []string{"DEPENDENCY TREE", "api Ship API", "3 upstream · 2 downstream",
+		"BLOCKED BY", "NEEDED BY", "↑1", "↑2", "↓2", "(shown above)", GetStatusIcon("closed")} {
+		if !strings.Contains(out, want) {
+			t.Errorf("render missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Notes") {
+		t.Error("related issues are not blockers")
+	}
+
+	// Collapsing a branch hides its rows and shows how many it holds
+	m.CollapseOrJumpToParent()
+	if got := depTreeRows(&m); got != "db auth db ui docs" {
+		t.Fatalf("after collapse rows = %q", got)
+	}
+	if out := m.Render(); !strings.Contains(out, "Database  (+1)") {
+		t.Errorf("collapsed branch should show its size:\n%s", out)
+	}
+	m.ExpandOrMoveToChild()
+	m.ExpandOrMoveToChild()
+	if got := m.SelectedIssueID(); got != "schema" {
+		t.Errorf("l on an open branch should step into it, at %q", got)
+	}
+	m.CollapseOrJumpToParent()
+	if got := m.SelectedIssueID(); got != "db" {
+		t.Errorf("h on a leaf should go to its parent, at %q", got)
+	}
+
+	m.SetAllExpanded(false)
+	if got := depTreeRows(&m); got != "db auth ui" {
+		t.Errorf("collapse all rows = %q", got)
+	}
+	if !m.SelectByID("ui") || m.SelectedIssueID() != "ui" {
+		t.Error("SelectByID should reach the downstream section")
+	}
+}
+
+func TestDepTreeEmptySectionsAndCycles(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Title: "A", Dependencies: []*model.Dependency{{IssueID: "a", DependsOnID: "b"}}},
+		{ID: "b", Title: "B", Dependencies: []*model.Dependency{{IssueID: "b", DependsOnID: "a"}}},
+		{ID: "lonely", Title: "Lonely"},
+	}
+	m := NewDepTreeModel("a", issues, newTestTheme())
+	if got := depTreeRows(&m); got != "b a b a" {
+		t.Errorf("a cycle should stop at the first repeat, rows = %q", got)
+	}
+
+	m = NewDepTreeModel("lonely", issues, newTestTheme())
+	m.SetSize(100, 20)
+	out := m.Render()
+	for _, want := range []string{"nothing blocks lonely", "nothing waits on lonely"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("render missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestDepTreeOpensFromListAndReturns(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api", Title: "Ship API", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "api", DependsOnID: "db", Type: model.DepBlocks}}},
+		{ID: "db", Title: "Database", Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "")
+	m.selectInList("api")
+	m = pressKey(m, "B")
+	if m.CurrentContext() != ContextDepTree {
+		t.Fatalf("B should open the dependency tree, context %s", m.CurrentContext())
+	}
+	if !strings.Contains(m.View(), "DEPENDENCY TREE") {
+		t.Error("view should render the dependency tree")
+	}
+	// h belongs to the tree here, not the history view
+	m = pressKey(m, "h")
+	if m.CurrentContext() != ContextDepTree {
+		t.Errorf("h should stay in the tree, context %s", m.CurrentContext())
+	}
+	m = pressKey(m, "B")
+	if m.focused != focusList {
+		t.Errorf("B should return to the list, focus %s", m.FocusState())
+	}
+}
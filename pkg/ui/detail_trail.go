@@ -0,0 +1,252 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// maxDetailJumps is how many links the detail view numbers for the 1-9
+// jump keys; n/N reach the rest
+const maxDetailJumps = 9
+
+// detailTrail records the issues left behind while following dependencies
+// in the detail view, so backspace can walk back along the chain. It only
+// applies while the detail still shows the issue the last jump landed on;
+// picking another issue some other way starts a fresh trail.
+type detailTrail struct {
+	ids []string // issues jumped away from, oldest first
+	at  string   // issue the last jump landed on
+}
+
+// trail returns the breadcrumb leading to the issue in the detail view
+func (m Model) trail() []string {
+	if m.detailTrail.at == "" || m.detailTrail.at != m.selectedIssueID() {
+		return nil
+	}
+	return m.detailTrail.ids
+}
+
+// detailLink is an issue the detail view links to: one the shown issue
+// depends on, or a dependent that depends on it
+type detailLink struct {
+	id        string
+	kind      model.DependencyType
+	dependent bool
+}
+
+// detailLinks lists the issues the given issue depends on, in the order the
+// beads file records them, followed by the issues that depend on it
+func (m Model) detailLinks(issue model.Issue) []detailLink {
+	seen := map[string]bool{}
+	var links []detailLink
+	for _, dep := range issue.Dependencies {
+		if dep == nil || dep.DependsOnID == "" || seen[dep.DependsOnID] {
+			continue
+		}
+		seen[dep.DependsOnID] = true
+		links = append(links, detailLink{id: dep.DependsOnID, kind: dep.Type})
+	}
+	return append(links, m.dependents[issue.ID]...)
+}
+
+// indexDependents maps each issue ID to the issues depending on it, in the
+// order the beads file records them, so the detail view needn't scan every
+// issue to list them
+func indexDependents(issues []model.Issue) map[string][]detailLink {
+	index := make(map[string][]detailLink)
+	for i := range issues {
+		seen := map[string]bool{}
+		for _, dep := range issues[i].Dependencies {
+			if dep == nil || dep.DependsOnID == "" || seen[dep.DependsOnID] {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			index[dep.DependsOnID] = append(index[dep.DependsOnID], detailLink{id: issues[i].ID, kind: dep.Type, dependent: true})
+		}
+	}
+	return index
+}
+
+// selectedLink returns the index of the link picked with n/N, or -1 while
+// none is picked for the issue in the detail view
+func (m Model) selectedLink() int {
+	if m.detailLinkIssue == "" || m.detailLinkIssue != m.selectedIssueID() {
+		return -1
+	}
+	return m.detailLinkCursor
+}
+
+// handleDetailTrailKeys follows links on 1-9 or on enter after picking one
+// with n/N, steps back along the trail on backspace and closes the view on
+// esc. It reports whether it used the key.
+func (m Model) handleDetailTrailKeys(key string) (Model, bool) {
+	switch {
+	case len(key) == 1 && key[0] >= '1' && key[0] <= '9':
+		return m.followDetailLink(int(key[0] - '1')), true
+	case key == "n", key == "N":
+		item, ok := m.list.SelectedItem().(IssueItem)
+		if !ok {
+			return m, true
+		}
+		links := m.detailLinks(item.Issue)
+		if len(links) == 0 {
+			m.statusMsg = item.Issue.ID + " has no dependencies or dependents"
+			m.statusIsError = false
+			return m, true
+		}
+		cursor := m.selectedLink()
+		switch {
+		case cursor < 0 && key == "n":
+			cursor = 0
+		case cursor < 0:
+			cursor = len(links) - 1
+		case key == "n":
+			cursor = (cursor + 1) % len(links)
+		default:
+			cursor = (cursor - 1 + len(links)) % len(links)
+		}
+		m.detailLinkIssue, m.detailLinkCursor = item.Issue.ID, cursor
+		m.updateViewportContent()
+		return m, true
+	case key == "enter":
+		if cursor := m.selectedLink(); cursor >= 0 {
+			return m.followDetailLink(cursor), true
+		}
+	case key == "backspace":
+		return m.detailBack(), true
+	case key == "esc":
+		m.showDetails = false
+		m.focused = focusList
+		return m, true
+	}
+	return m, false
+}
+
+// followDetailLink shows the nth linked issue in the detail view, pushing
+// the issue onto the trail
+func (m Model) followDetailLink(n int) Model {
+	item, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		return m
+	}
+	links := m.detailLinks(item.Issue)
+	if n >= len(links) {
+		m.statusMsg = fmt.Sprintf("%s has no link %d", item.Issue.ID, n+1)
+		m.statusIsError = true
+		return m
+	}
+	target := links[n].id
+	if _, ok := m.issueMap[target]; !ok {
+		m.statusMsg = target + " is not loaded"
+		m.statusIsError = true
+		return m
+	}
+
+	prev := m.detailTrail
+	m.detailTrail = detailTrail{ids: append(append([]string(nil), m.trail()...), item.Issue.ID), at: target}
+	if !m.showDetailIssue(target) {
+		m.detailTrail = prev
+		return m
+	}
+	m.statusMsg = fmt.Sprintf("Jumped to %s (backspace to go back)", target)
+	m.statusIsError = false
+	return m
+}
+
+// detailBack returns the detail view to the issue the last jump left
+func (m Model) detailBack() Model {
+	ids := m.trail()
+	if len(ids) == 0 {
+		m.statusMsg = "No dependency trail to go back along"
+		m.statusIsError = false
+		return m
+	}
+	back := ids[len(ids)-1]
+	prev := m.detailTrail
+	m.detailTrail = detailTrail{ids: ids[:len(ids)-1], at: back}
+	if !m.showDetailIssue(back) {
+		m.detailTrail = prev
+		return m
+	}
+	m.statusMsg = "Back to " + back
+	m.statusIsError = false
+	return m
+}
+
+// showDetailIssue selects id in the list behind the detail view, clearing
+// filters that hide it the way jump-to-issue does
+func (m *Model) showDetailIssue(id string) bool {
+	found := m.selectInList(id)
+	if !found && m.hasActiveFilters() {
+		m.clearAllFilters()
+		found = m.selectInList(id)
+	}
+	if !found {
+		m.statusMsg = id + " is not shown in the list"
+		m.statusIsError = true
+		return false
+	}
+	if m.splitLeftIsActionable() {
+		m.actionableView.SelectByID(id)
+	}
+	m.viewport.GotoTop()
+	m.updateViewportContent()
+	return true
+}
+
+// detailTrailMD renders the breadcrumb above the issue detail, e.g.
+// "bv-1 › bv-4 › **bv-9**", or nothing before the first jump
+func (m Model) detailTrailMD(current string) string {
+	ids := m.trail()
+	if len(ids) == 0 {
+		return ""
+	}
+	crumbs := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		crumbs = append(crumbs, "`"+id+"`")
+	}
+	crumbs = append(crumbs, "**"+current+"**")
+	return "🧭 " + strings.Join(crumbs, " › ") + " · ⌫ back\n\n"
+}
+
+// detailLinksMD renders the numbered dependencies and dependents the 1-9
+// keys jump to, marking the one picked with n/N
+func (m Model) detailLinksMD(issue model.Issue) string {
+	links := m.detailLinks(issue)
+	if len(links) == 0 {
+		return ""
+	}
+	cursor := m.selectedLink()
+	var sb strings.Builder
+	for i, link := range links {
+		switch {
+		case i == 0 && !link.dependent:
+			sb.WriteString("### Depends On\n")
+		case link.dependent && (i == 0 || !links[i-1].dependent):
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			sb.WriteString("### Needed By\n")
+		}
+		num := "-"
+		if i < maxDetailJumps {
+			num = fmt.Sprintf("%d.", i+1)
+		}
+		if i == cursor {
+			num += " ▸"
+		}
+		kind := string(link.kind)
+		if kind == "" {
+			kind = string(model.DepBlocks)
+		}
+		if target, ok := m.issueMap[link.id]; ok {
+			sb.WriteString(fmt.Sprintf("%s **%s** %s — %s (%s)\n", num, link.id, target.Title, target.Status, kind))
+		} else {
+			sb.WriteString(fmt.Sprintf("%s **%s** — not loaded (%s)\n", num, link.id, kind))
+		}
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
@@ -0,0 +1,140 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func pressBackspace(m Model) Model {
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	return updated.(Model)
+}
+
+func TestDetailTrail(t *testing.T) {
+	// A blocking chain bv-1 → bv-2 → bv-3, with bv-3 closed
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Oldest", Status: model.StatusOpen, Priority: 2,
+			Dependencies: []*model.Dependency{{IssueID: "bv-1", DependsOnID: "bv-2", Type: model.DepBlocks}}},
+		{ID: "bv-2", Title: "Urgent", Status: model.StatusOpen, Priority: 0, Dependencies: []*model.Dependency{
+			{IssueID: "bv-2", DependsOnID: "bv-missing", Type: model.DepRelated},
+			{IssueID: "bv-2", DependsOnID: "bv-3", Type: model.DepBlocks},
+		}},
+		{ID: "bv-3", Title: "Newest", Status: model.StatusClosed, Priority: 3},
+		{ID: "bv-4", Title: "Tied", Status: model.StatusOpen, Priority: 2},
+	}
+
+	t.Run("follows dependencies and walks back", func(t *testing.T) {
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+		m = updated.(Model)
+		m.selectInList("bv-1")
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = updated.(Model)
+		if m.focused != focusDetail || !m.showDetails {
+			t.Fatal("enter should open the detail view")
+		}
+		md := m.detailLinksMD(*m.issueMap["bv-2"])
+		for _, want := range []string{
+			"### Depends On\n1. **bv-missing** — not loaded (related)",
+			"2. **bv-3** Newest — closed (blocks)",
+			"### Needed By\n3. **bv-1** Oldest — open (blocks)",
+		} {
+			if !strings.Contains(md, want) {
+				t.Errorf("link list missing %q:\n%s", want, md)
+			}
+		}
+
+		m = pressKey(m, "1")
+		if got := m.selectedIssueID(); got != "bv-2" {
+			t.Fatalf("1 should follow bv-1's dependency, detail shows %s", got)
+		}
+		if m = pressKey(m, "1"); !m.statusIsError || m.selectedIssueID() != "bv-2" {
+			t.Errorf("a dependency that isn't loaded should stay put: %q", m.statusMsg)
+		}
+		// bv-3 is closed; the jump clears the open filter hiding it
+		m.currentFilter = "open"
+		m.applyFilter()
+		m.selectInList("bv-2")
+		m = pressKey(m, "2")
+		if got := m.selectedIssueID(); got != "bv-3" {
+			t.Fatalf("2 should follow bv-2's second dependency, detail shows %s", got)
+		}
+		if got := strings.Join(m.trail(), " "); got != "bv-1 bv-2" {
+			t.Errorf("trail = %q", got)
+		}
+		if crumb := m.detailTrailMD("bv-3"); !strings.Contains(crumb, "`bv-1` › `bv-2` › **bv-3**") {
+			t.Errorf("breadcrumb = %q", crumb)
+		}
+
+		m = pressBackspace(m)
+		if got := m.selectedIssueID(); got != "bv-2" || strings.Join(m.trail(), " ") != "bv-1" {
+			t.Fatalf("backspace should return to bv-2, at %s with trail %v", got, m.trail())
+		}
+		m = pressBackspace(m)
+		if got := m.selectedIssueID(); got != "bv-1" || len(m.trail()) != 0 {
+			t.Fatalf("backspace should return to bv-1, at %s with trail %v", got, m.trail())
+		}
+		if m = pressBackspace(m); !m.showDetails || m.selectedIssueID() != "bv-1" {
+			t.Error("backspace with an empty trail should leave the detail alone")
+		}
+	})
+
+	t.Run("resets when selection moves elsewhere", func(t *testing.T) {
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+		m = updated.(Model)
+		m.selectInList("bv-1")
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = updated.(Model)
+		m = pressKey(m, "1")
+		if len(m.trail()) != 1 {
+			t.Fatalf("trail = %v", m.trail())
+		}
+		m.selectInList("bv-4")
+		if len(m.trail()) != 0 {
+			t.Errorf("choosing another issue should drop the trail, got %v", m.trail())
+		}
+	})
+
+	t.Run("links picked with n and followed with enter", func(t *testing.T) {
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+		m = updated.(Model)
+		m.selectInList("bv-1")
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = updated.(Model)
+		m = pressKey(m, "1")
+		if got := m.selectedIssueID(); got != "bv-2" {
+			t.Fatalf("detail shows %s", got)
+		}
+
+		// N from nothing picks the last link: bv-1, which needs bv-2
+		m = pressKey(m, "N")
+		if m.selectedLink() != 2 || !strings.Contains(m.detailLinksMD(*m.issueMap["bv-2"]), "3. ▸ **bv-1**") {
+			t.Fatalf("N should pick the dependent, cursor %d", m.selectedLink())
+		}
+		m = pressKey(m, "n")
+		if m.selectedLink() != 0 {
+			t.Errorf("n should wrap to the first link, cursor %d", m.selectedLink())
+		}
+		m = pressKey(m, "n")
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = updated.(Model)
+		if got := m.selectedIssueID(); got != "bv-3" || strings.Join(m.trail(), " ") != "bv-1 bv-2" {
+			t.Fatalf("enter should follow the picked link, at %s with trail %v", got, m.trail())
+		}
+		if m.selectedLink() != -1 {
+			t.Error("the pick belongs to the issue it was made on")
+		}
+
+		// Dependents are numbered after dependencies
+		m = pressKey(m, "1")
+		if got := m.selectedIssueID(); got != "bv-2" {
+			t.Errorf("1 on bv-3 should follow its dependent bv-2, at %s", got)
+		}
+	})
+}
@@ -0,0 +1,499 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// facetField is one column of the facet browser
+type facetField int
+
+const (
+	facetLabels facetField = iota
+	facetTypes
+	facetAssignees
+	facetFieldCount
+)
+
+// facetTitles are the column headers, in column order
+var facetTitles = [facetFieldCount]string{"LABELS", "TYPES", "ASSIGNEES"}
+
+// facetValue is one value of a field and how many issues carry it
+type facetValue struct {
+	name  string
+	count int
+}
+
+// FacetModel browses the labels, types and assignees of the issues with
+// their counts. Picking values narrows the issues: all picked labels must
+// be present, and any picked type or assignee matches. Each column counts
+// the issues the other columns' picks keep, so values that would leave
+// nothing drop out as the picks narrow. The picks become a filter bar
+// query when applied.
+type FacetModel struct {
+	issues      []model.Issue
+	picked      [facetFieldCount]map[string]bool
+	values      [facetFieldCount][]facetValue
+	matched     int  // Issues every pick keeps
+	includeDone bool // Count closed issues too
+	column      facetField
+	cursor      [facetFieldCount]int
+	scroll      [facetFieldCount]int
+	width       int
+	height      int
+	theme       Theme
+}
+
+// NewFacetModel counts the facets of issues, open ones only unless
+// includeDone is set
+func NewFacetModel(issues []model.Issue, includeDone bool, theme Theme) FacetModel {
+	m := FacetModel{issues: issues, includeDone: includeDone, theme: theme}
+	for f := range m.picked {
+		m.picked[f] = make(map[string]bool)
+	}
+	m.recount()
+	return m
+}
+
+// facetValues returns the values issue has for field; unassigned issues
+// and untyped ones have none
+func facetValues(issue model.Issue, field facetField) []string {
+	switch field {
+	case facetLabels:
+		return issue.Labels
+	case facetTypes:
+		if issue.IssueType != "" {
+			return []string{string(issue.IssueType)}
+		}
+	case facetAssignees:
+		if issue.Assignee != "" {
+			return []string{issue.Assignee}
+		}
+	}
+	return nil
+}
+
+// keeps reports whether issue passes the picks of every field but skip
+// (facetFieldCount to check them all)
+func (m *FacetModel) keeps(issue model.Issue, skip facetField) bool {
+	if !m.includeDone && isClosedLikeStatus(issue.Status) {
+		return false
+	}
+	for f := facetField(0); f < facetFieldCount; f++ {
+		if f == skip || len(m.picked[f]) == 0 {
+			continue
+		}
+		values := facetValues(issue, f)
+		if f == facetLabels {
+			has := make(map[string]bool, len(values))
+			for _, v := range values {
+				has[v] = true
+			}
+			for label := range m.picked[f] {
+				if !has[label] {
+					return false
+				}
+			}
+			continue
+		}
+		found := false
+		for _, v := range values {
+			found = found || m.picked[f][v]
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// recount rebuilds the value lists after the picks change. Labels narrow
+// within their own column, so they count against every pick; a type or
+// assignee is one of several alternatives, so its column ignores its own
+// picks. Picked values stay listed even when nothing carries them.
+func (m *FacetModel) recount() {
+	m.matched = 0
+	for _, issue := range m.issues {
+		if m.keeps(issue, facetFieldCount) {
+			m.matched++
+		}
+	}
+	for f := facetField(0); f < facetFieldCount; f++ {
+		skip := f
+		if f == facetLabels {
+			skip = facetFieldCount
+		}
+		counts := make(map[string]int)
+		for _, issue := range m.issues {
+			if m.keeps(issue, skip) {
+				for _, v := range facetValues(issue, f) {
+					counts[v]++
+				}
+			}
+		}
+		for v := range m.picked[f] {
+			if _, ok := counts[v]; !ok {
+				counts[v] = 0
+			}
+		}
+		values := make([]facetValue, 0, len(counts))
+		for name, count := range counts {
+			values = append(values, facetValue{name: name, count: count})
+		}
+		sort.Slice(values, func(i, j int) bool {
+			if values[i].count != values[j].count {
+				return values[i].count > values[j].count
+			}
+			return values[i].name < values[j].name
+		})
+		m.values[f] = values
+		m.cursor[f] = min(m.cursor[f], max(len(values)-1, 0))
+	}
+	m.ensureVisible()
+}
+
+// SetSize updates the view dimensions
+func (m *FacetModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.ensureVisible()
+}
+
+// MoveDown moves the cursor down the current column
+func (m *FacetModel) MoveDown() {
+	if m.cursor[m.column] < len(m.values[m.column])-1 {
+		m.cursor[m.column]++
+	}
+	m.ensureVisible()
+}
+
+// MoveUp moves the cursor up the current column
+func (m *FacetModel) MoveUp() {
+	if m.cursor[m.column] > 0 {
+		m.cursor[m.column]--
+	}
+	m.ensureVisible()
+}
+
+// NextColumn moves to the column on the right, wrapping around
+func (m *FacetModel) NextColumn() {
+	m.column = (m.column + 1) % facetFieldCount
+}
+
+// PrevColumn moves to the column on the left, wrapping around
+func (m *FacetModel) PrevColumn() {
+	m.column = (m.column + facetFieldCount - 1) % facetFieldCount
+}
+
+// current returns the value under the cursor, if the column has any
+func (m *FacetModel) current() (facetValue, bool) {
+	values := m.values[m.column]
+	if len(values) == 0 {
+		return facetValue{}, false
+	}
+	return values[m.cursor[m.column]], true
+}
+
+// Toggle picks the value under the cursor, or drops it if picked
+func (m *FacetModel) Toggle() {
+	v, ok := m.current()
+	if !ok {
+		return
+	}
+	if m.picked[m.column][v.name] {
+		delete(m.picked[m.column], v.name)
+	} else {
+		m.picked[m.column][v.name] = true
+	}
+	m.recount()
+}
+
+// Pick adds a value to the picks of field
+func (m *FacetModel) Pick(field facetField, value string) {
+	m.picked[field][value] = true
+	m.recount()
+}
+
+// Picked reports whether anything is picked
+func (m *FacetModel) Picked() bool {
+	for _, picked := range m.picked {
+		if len(picked) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear drops every pick
+func (m *FacetModel) Clear() {
+	for f := range m.picked {
+		m.picked[f] = make(map[string]bool)
+	}
+	m.recount()
+}
+
+// ToggleDone switches between counting open issues and all of them
+func (m *FacetModel) ToggleDone() {
+	m.includeDone = !m.includeDone
+	m.recount()
+}
+
+// Matched returns how many issues the picks keep
+func (m *FacetModel) Matched() int {
+	return m.matched
+}
+
+// facetTerm quotes a query value that holds a space, so the query keeps
+// it whole
+func facetTerm(value string) string {
+	if strings.ContainsAny(value, " \t") {
+		return `"` + value + `"`
+	}
+	return value
+}
+
+// Query returns the filter bar query for the picks, empty when nothing
+// narrows the issues
+func (m *FacetModel) Query() string {
+	var terms []string
+	if !m.includeDone && m.Picked() {
+		terms = append(terms, "-status:closed")
+	}
+	for _, f := range []facetField{facetTypes, facetAssignees} {
+		var values []string
+		for v := range m.picked[f] {
+			values = append(values, v)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		sort.Strings(values)
+		name := "type"
+		if f == facetAssignees {
+			name = "assignee"
+		}
+		terms = append(terms, name+":"+facetTerm(strings.Join(values, ",")))
+	}
+	labels := make([]string, 0, len(m.picked[facetLabels]))
+	for v := range m.picked[facetLabels] {
+		labels = append(labels, v)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		terms = append(terms, "label:"+facetTerm(label))
+	}
+	return strings.Join(terms, " ")
+}
+
+// rowsVisible is how many values fit in a column below its header
+func (m *FacetModel) rowsVisible() int {
+	return max(m.height-4, 1)
+}
+
+func (m *FacetModel) ensureVisible() {
+	visible := m.rowsVisible()
+	for f := range m.cursor {
+		if m.cursor[f] < m.scroll[f] {
+			m.scroll[f] = m.cursor[f]
+		}
+		if m.cursor[f] >= m.scroll[f]+visible {
+			m.scroll[f] = m.cursor[f] - visible + 1
+		}
+	}
+}
+
+// Render renders the facet browser
+func (m *FacetModel) Render() string {
+	if m.width == 0 || m.height == 0 {
+		return ""
+	}
+	t := m.theme
+
+	headerStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Base.GetForeground()).
+		Background(t.Primary).
+		Padding(0, 2).
+		Width(m.width - 4)
+	scope := "open issues"
+	if m.includeDone {
+		scope = "all issues"
+	}
+	header := fmt.Sprintf("🏷  FACETS  │  %d %s match", m.matched, scope)
+	if q := m.Query(); q != "" {
+		header += "  │  " + q
+	}
+	header = truncateRunesHelper(header, m.width-8, "…")
+
+	colWidth := max((m.width-2)/int(facetFieldCount), 12)
+	columns := make([][]string, facetFieldCount)
+	for f := facetField(0); f < facetFieldCount; f++ {
+		columns[f] = m.renderColumn(f, colWidth)
+	}
+	var body []string
+	for row := 0; row < m.rowsVisible()+1; row++ {
+		var line strings.Builder
+		for f := range columns {
+			cell := ""
+			if row < len(columns[f]) {
+				cell = columns[f][row]
+			}
+			line.WriteString(t.Renderer.NewStyle().Width(colWidth).MaxWidth(colWidth).Render(cell))
+		}
+		body = append(body, line.String())
+	}
+
+	legend := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).
+		Render("h/l column  •  j/k move  •  space pick  •  enter filter list  •  x clear  •  c closed  •  esc back")
+
+	return headerStyle.Render(header) + "\n" + strings.Join(body, "\n") + "\n" + legend
+}
+
+// renderColumn renders one field's header and the values in view
+func (m *FacetModel) renderColumn(f facetField, width int) []string {
+	t := m.theme
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	if f == m.column {
+		titleStyle = t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Underline(true)
+	}
+	values := m.values[f]
+	lines := []string{titleStyle.Render(fmt.Sprintf("%s (%d)", facetTitles[f], len(values)))}
+	if len(values) == 0 {
+		return append(lines, t.Renderer.NewStyle().Foreground(t.Muted).Render("  none"))
+	}
+
+	top := 1
+	for _, v := range values {
+		top = max(top, v.count)
+	}
+	const barWidth = 6
+	start := min(m.scroll[f], len(values))
+	end := min(start+m.rowsVisible(), len(values))
+	for i := start; i < end; i++ {
+		v := values[i]
+		cursor := f == m.column && i == m.cursor[f]
+
+		var sb strings.Builder
+		if cursor {
+			sb.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render(glyph("▸", ">") + " "))
+		} else {
+			sb.WriteString("  ")
+		}
+		box := glyph("☐", "[ ]")
+		if m.picked[f][v.name] {
+			box = t.Renderer.NewStyle().Foreground(t.Open).Bold(true).Render(glyph("☑", "[x]"))
+		}
+		sb.WriteString(box + " ")
+
+		count := fmt.Sprintf("%4d", v.count)
+		filled := (v.count*barWidth + top - 1) / top
+		bar := strings.Repeat(glyph("▪", "#"), filled) + strings.Repeat(" ", barWidth-filled)
+		nameWidth := max(width-lipgloss.Width(sb.String())-len(count)-barWidth-3, 4)
+		name := v.name
+		if f == facetTypes {
+			icon, _ := t.GetTypeIcon(v.name)
+			name = icon + " " + name
+		}
+		sb.WriteString(padRight(truncateRunesHelper(name, nameWidth, "…"), nameWidth))
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Subtext).Render(count))
+		sb.WriteString(" ")
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(bar))
+
+		style := t.Renderer.NewStyle()
+		if v.count == 0 {
+			style = style.Foreground(t.Muted)
+		}
+		if cursor {
+			style = style.Background(t.Highlight)
+		}
+		lines = append(lines, style.Render(sb.String()))
+	}
+	return lines
+}
+
+// openFacets counts the facets and focuses the browser. When the filter
+// bar's query is one the browser built, its picks come back.
+func (m Model) openFacets() Model {
+	m.clearAttentionOverlay()
+	m.isGraphView = false
+	m.isBoardView = false
+	m.isActionableView = false
+	m.isHistoryView = false
+
+	view := NewFacetModel(m.issues, false, m.theme)
+	if r := m.queryRecipe(); r != nil {
+		seeded := NewFacetModel(m.issues, true, m.theme)
+		for _, s := range r.Filters.ExcludeStatus {
+			seeded.includeDone = seeded.includeDone && s != string(model.StatusClosed)
+		}
+		for f, values := range [facetFieldCount][]string{r.Filters.Tags, r.Filters.Types, r.Filters.Assignee} {
+			for _, v := range values {
+				seeded.picked[f][v] = true
+			}
+		}
+		seeded.recount()
+		if seeded.Query() == r.Description {
+			view = seeded
+		}
+	}
+	m.facetView = view
+	m.facetView.SetSize(m.width, m.height-1)
+	m.focused = focusFacets
+	return m
+}
+
+// applyFacets filters every view by the picks, or by the value under the
+// cursor when nothing is picked, and returns to the list
+func (m Model) applyFacets() Model {
+	if !m.facetView.Picked() {
+		v, ok := m.facetView.current()
+		if !ok {
+			return m
+		}
+		m.facetView.Pick(m.facetView.column, v.name)
+	}
+	m.focused = focusList
+	query := m.facetView.Query()
+	f, err := recipe.ParseQuery(query)
+	if err != nil {
+		m.statusMsg = "❌ " + err.Error()
+		m.statusIsError = true
+		return m
+	}
+	m.applyQuery(query, f)
+	m.statusMsg = fmt.Sprintf("Filtered to %d issues: %s  (Q to edit)", len(m.list.Items()), query)
+	m.statusIsError = false
+	return m
+}
+
+// handleFacetKeys handles keyboard input when the facet browser is focused
+func (m Model) handleFacetKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.facetView.MoveDown()
+	case "k", "up":
+		m.facetView.MoveUp()
+	case "l", "right", "tab":
+		m.facetView.NextColumn()
+	case "h", "left", "shift+tab":
+		m.facetView.PrevColumn()
+	case " ":
+		m.facetView.Toggle()
+	case "x":
+		m.facetView.Clear()
+	case "c":
+		m.facetView.ToggleDone()
+	case "enter":
+		m = m.applyFacets()
+	case "#", "esc":
+		m.focused = focusList
+	}
+	return m
+}
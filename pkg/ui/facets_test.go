@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func facetCounts(m FacetModel, f facetField) string {
+	var parts []string
+	for _, v := range m.values[f] {
+		parts = append(parts, v.name+"="+itoa(v.count))
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestFacetCountsFollowPicks(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "f1", Title: "Login crash", Status: model.StatusOpen, IssueType: model.TypeBug, Assignee: "ann", Labels: []string{"api", "urgent"}},
+		{ID: "f2", Title: "Sync drops rows", Status: model.StatusInProgress, IssueType: model.TypeBug, Assignee: "bob", Labels: []string{"api"}},
+		{ID: "f3", Title: "Dark mode", Status: model.StatusOpen, IssueType: model.TypeFeature, Assignee: "ann", Labels: []string{"ui"}},
+		{ID: "f4", Title: "Old api bug", Status: model.StatusClosed, IssueType: model.TypeBug, Assignee: "ann", Labels: []string{"api"}},
+		{ID: "f5", Title: "Tidy up", Status: model.StatusOpen, IssueType: model.TypeChore, Labels: []string{"api", "needs review"}},
+	}
+	m := NewFacetModel(issues, false, newTestTheme())
+	if got := facetCounts(m, facetLabels); got != "api=3 needs review=1 ui=1 urgent=1" {
+		t.Errorf("labels = %q", got)
+	}
+	if got := facetCounts(m, facetAssignees); got != "ann=2 bob=1" {
+		t.Errorf("assignees = %q (closed and unassigned issues don't count)", got)
+	}
+
+	// A picked label narrows its own column; a picked type only the others
+	m.Pick(facetLabels, "api")
+	m.Pick(facetTypes, "bug")
+	if m.Matched() != 2 {
+		t.Errorf("api bugs = %d, want 2", m.Matched())
+	}
+	if got := facetCounts(m, facetLabels); got != "api=2 urgent=1" {
+		t.Errorf("labels after picks = %q", got)
+	}
+	if got := facetCounts(m, facetTypes); got != "bug=2 chore=1" {
+		t.Errorf("types should count alternatives to the picked one, got %q", got)
+	}
+	if got := m.Query(); got != "-status:closed type:bug label:api" {
+		t.Errorf("query = %q", got)
+	}
+
+	m.ToggleDone()
+	if m.Matched() != 3 || m.Query() != "type:bug label:api" {
+		t.Errorf("with closed issues: matched %d, query %q", m.Matched(), m.Query())
+	}
+	m.Clear()
+	if m.Picked() || m.Query() != "" {
+		t.Errorf("clear should drop every pick, query %q", m.Query())
+	}
+	m.Pick(facetLabels, "needs review")
+	if got := m.Query(); got != `label:"needs review"` {
+		t.Errorf("labels with spaces should be quoted, got %q", got)
+	}
+}
+
+func TestFacetBrowserFiltersListAndReopens(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "f1", Title: "Login crash", Status: model.StatusOpen, IssueType: model.TypeBug, Assignee: "ann", Labels: []string{"api", "urgent"}},
+		{ID: "f2", Title: "Sync drops rows", Status: model.StatusInProgress, IssueType: model.TypeBug, Assignee: "bob", Labels: []string{"api"}},
+		{ID: "f3", Title: "Dark mode", Status: model.StatusOpen, IssueType: model.TypeFeature, Assignee: "ann", Labels: []string{"ui"}},
+		{ID: "f4", Title: "Old api bug", Status: model.StatusClosed, IssueType: model.TypeBug, Assignee: "ann", Labels: []string{"api"}},
+		{ID: "f5", Title: "Tidy up", Status: model.StatusOpen, IssueType: model.TypeChore, Labels: []string{"api", "needs review"}},
+	}
+	m := NewModel(issues, nil, "")
+	m = pressKey(m, "#")
+	if m.CurrentContext() != ContextFacets || !strings.Contains(m.View(), "FACETS") {
+		t.Fatalf("# should open the facet browser, context %s", m.CurrentContext())
+	}
+
+	// Pick bug in the types column and ann in the assignees column
+	m = pressKey(m, "l")
+	m = pressKey(m, " ")
+	m = pressKey(m, "l")
+	m = pressKey(m, " ")
+	if got := m.facetView.Query(); got != "-status:closed type:bug assignee:ann" {
+		t.Fatalf("query = %q", got)
+	}
+	m = pressKey(m, "enter")
+	if m.focused != focusList {
+		t.Fatalf("enter should return to the list, focus %s", m.FocusState())
+	}
+	if items := m.list.Items(); len(items) != 1 || items[0].(IssueItem).Issue.ID != "f1" {
+		t.Errorf("list should show only ann's open bug, got %d items", len(items))
+	}
+
+	// Reopening restores the picks behind the query in force
+	m = pressKey(m, "#")
+	if !m.facetView.picked[facetTypes]["bug"] || !m.facetView.picked[facetAssignees]["ann"] {
+		t.Errorf("picks should come back from the query, got %v", m.facetView.picked)
+	}
+	if back := pressKey(m, "esc"); back.focused != focusList || len(back.list.Items()) != 1 {
+		t.Errorf("esc should go back keeping the filter, focus %s", back.FocusState())
+	}
+
+	// With nothing picked, enter filters by the value under the cursor
+	m = pressKey(m, "x")
+	m = pressKey(m, "enter")
+	if got := len(m.list.Items()); got != 3 {
+		t.Errorf("enter on api should show its 3 open issues, got %d", got)
+	}
+}
@@ -0,0 +1,283 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// queryRecipeName names the recipe built from the filter bar's query
+const queryRecipeName = "query"
+
+// FilterBarModel is the "Q" query bar shown in place of the footer. Each
+// edit is parsed with recipe.ParseQuery; err holds the last parse error,
+// while the last valid query stays applied.
+type FilterBarModel struct {
+	input textinput.Model
+	err   error
+	theme Theme
+}
+
+// NewFilterBarModel creates an empty filter bar
+func NewFilterBarModel(theme Theme) FilterBarModel {
+	ti := textinput.New()
+	ti.Placeholder = "status:open priority<=1 label:backend -assignee:alice updated>7d"
+	ti.CharLimit = 200
+	ti.Prompt = "Filter " + glyph("▸", ">") + " "
+	ti.PromptStyle = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	ti.TextStyle = lipgloss.NewStyle().Foreground(theme.Base.GetForeground())
+	return FilterBarModel{input: ti, theme: theme}
+}
+
+// Open focuses the bar with query as its text
+func (b *FilterBarModel) Open(query string) {
+	b.input.SetValue(query)
+	b.input.CursorEnd()
+	b.input.Focus()
+	b.err = nil
+}
+
+// Close blurs the bar
+func (b *FilterBarModel) Close() {
+	b.input.Blur()
+}
+
+// Value returns the query as typed
+func (b FilterBarModel) Value() string {
+	return b.input.Value()
+}
+
+// Err returns the parse error of the current text, if any
+func (b FilterBarModel) Err() error {
+	return b.err
+}
+
+// Update passes a key to the text input and re-parses the query. changed
+// reports whether the text changed.
+func (b *FilterBarModel) Update(msg tea.KeyMsg) (f recipe.FilterConfig, changed bool) {
+	before := b.input.Value()
+	b.input, _ = b.input.Update(msg)
+	f, b.err = recipe.ParseQuery(b.input.Value())
+	return f, b.input.Value() != before
+}
+
+// View renders the bar in one line of the given width: the input, then the
+// parse error or the number of issues shown
+func (b FilterBarModel) View(width, shown int) string {
+	t := b.theme
+	status := t.Renderer.NewStyle().Foreground(t.Secondary).Render(itoa(shown) + " issues")
+	if b.err != nil {
+		msg := truncateRunesHelper(glyph("✗", "x")+" "+b.err.Error(), max(width/2, 20), "…")
+		status = t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true).Render(msg)
+	}
+	// Padding, the gap before the status and the cursor cell
+	b.input.Width = max(width-lipgloss.Width(b.input.Prompt)-lipgloss.Width(status)-5, 10)
+	line := b.input.View() + "  " + status
+	return t.Renderer.NewStyle().Width(width).MaxWidth(width).Padding(0, 1).Render(line)
+}
+
+// queryRecipe returns the active recipe when it comes from the filter bar
+func (m Model) queryRecipe() *recipe.Recipe {
+	if m.activeRecipe != nil && m.activeRecipe.Name == queryRecipeName {
+		return m.activeRecipe
+	}
+	return nil
+}
+
+// openFilterBar shows the query bar, remembering the filter in force so
+// esc can restore it
+func (m Model) openFilterBar() Model {
+	if m.focused == focusFilterBar {
+		return m
+	}
+	query := ""
+	if r := m.queryRecipe(); r != nil {
+		query = r.Description
+	}
+	m.filterBar.Open(query)
+	m.filterBarFrom = m.focused
+	m.filterBarPrevRecipe = m.activeRecipe
+	m.filterBarPrevFilter = m.currentFilter
+	m.showFilterBar = true
+	m.focused = focusFilterBar
+	return m
+}
+
+// handleFilterBarKeys applies the query as it is typed. Enter keeps the last
+// valid query; esc restores the filter from before the bar opened.
+func (m Model) handleFilterBarKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "enter":
+		// A malformed query keeps the bar open with its error showing
+		if m.filterBar.Err() == nil {
+			m.closeFilterBar()
+		}
+	case "esc":
+		m.closeFilterBar()
+		m.setActiveRecipe(m.filterBarPrevRecipe)
+		if m.filterBarPrevRecipe != nil {
+			m.applyRecipe(m.filterBarPrevRecipe)
+		} else {
+			m.currentFilter = m.filterBarPrevFilter
+			m.applyFilter()
+		}
+		m.refreshQueryViews()
+	default:
+		f, changed := m.filterBar.Update(msg)
+		if changed && m.filterBar.Err() == nil {
+			m.applyQuery(m.filterBar.Value(), f)
+		}
+	}
+	return m
+}
+
+func (m *Model) closeFilterBar() {
+	m.filterBar.Close()
+	m.showFilterBar = false
+	m.focused = m.filterBarFrom
+}
+
+// applyQuery filters every view by the parsed query; an empty query shows
+// all issues again
+func (m *Model) applyQuery(query string, f recipe.FilterConfig) {
+	if strings.TrimSpace(query) == "" {
+		m.setActiveRecipe(nil)
+		m.currentFilter = "all"
+		m.applyFilter()
+	} else {
+		r := &recipe.Recipe{Name: queryRecipeName, Description: query, Filters: f}
+		m.setActiveRecipe(r)
+		m.applyRecipe(r)
+	}
+	m.refreshQueryViews()
+}
+
+// queryKeeps reports whether the filter bar's query, if any, shows issue
+func (m Model) queryKeeps(issue model.Issue) bool {
+	return issueMatchesRecipe(issue, m.issueMap, m.queryRecipe())
+}
+
+// queryIssues returns the issues the filter bar's query shows
+func (m Model) queryIssues() []model.Issue {
+	if m.queryRecipe() == nil {
+		return m.issues
+	}
+	var out []model.Issue
+	for _, issue := range m.issues {
+		if m.queryKeeps(issue) {
+			out = append(out, issue)
+		}
+	}
+	return out
+}
+
+// refreshQueryViews rebuilds the view under the filter bar when it is
+// computed from all issues rather than the filtered list (actionable plan,
+// also as the split view's left pane, tree, timeline, assignees), so the
+// query applies there too
+func (m *Model) refreshQueryViews() {
+	view := m.focused
+	if view == focusFilterBar {
+		view = m.filterBarFrom
+	}
+	switch view {
+	case focusActionable:
+		m.buildActionableView()
+	case focusList, focusDetail:
+		if m.splitLeftIsActionable() {
+			m.buildActionableView()
+		}
+	case focusTree:
+		m.buildTreeView()
+	case focusTimeline:
+		focused := m.focused
+		*m = m.openTimeline()
+		m.focused = focused
+	case focusAssignees:
+		focused := m.focused
+		*m = m.openAssignees()
+		m.focused = focused
+	}
+}
+
+// buildActionableView plans over all issues, so blockers hidden by the
+// query still hold their dependents back, then drops the plan items the
+// query hides. Outside the default sort, each track is ordered by the
+// sort mode.
+func (m *Model) buildActionableView() {
+	plan := analysis.NewAnalyzer(m.issues).GetExecutionPlanWithOptions(m.planOptions)
+	if m.queryRecipe() != nil {
+		keep := func(id string) bool {
+			issue, ok := m.issueMap[id]
+			return ok && m.queryKeeps(*issue)
+		}
+		var tracks []analysis.ExecutionTrack
+		plan.TotalActionable = 0
+		for _, track := range plan.Tracks {
+			var items []analysis.PlanItem
+			for _, item := range track.Items {
+				if keep(item.ID) {
+					items = append(items, item)
+				}
+			}
+			if len(items) > 0 {
+				track.Items = items
+				tracks = append(tracks, track)
+				plan.TotalActionable += len(items)
+			}
+		}
+		plan.Tracks = tracks
+		var deferred []analysis.PlanItem
+		for _, item := range plan.Deferred {
+			if keep(item.ID) {
+				deferred = append(deferred, item)
+			}
+		}
+		plan.Deferred = deferred
+	}
+	if m.sortMode.Field != SortDefault {
+		rank := func(id string) float64 {
+			if m.analysis == nil {
+				return 0
+			}
+			return m.analysis.GetPageRankScore(id)
+		}
+		for _, track := range plan.Tracks {
+			sort.SliceStable(track.Items, func(i, j int) bool {
+				a, b := track.Items[i], track.Items[j]
+				ai, aok := m.issueMap[a.ID]
+				bi, bok := m.issueMap[b.ID]
+				if !aok || !bok {
+					return a.ID < b.ID
+				}
+				return m.sortMode.less(*ai, *bi, rank(a.ID), rank(b.ID))
+			})
+		}
+	}
+	orderPlan(&plan, m.planOrder)
+	pinPlan(&plan, m.pinned)
+	density := m.actionableView.Density()
+	m.actionableView = NewActionableModel(plan, m.theme)
+	m.actionableView.SetSize(m.width, m.height-2)
+	m.actionableView.SetMarked(m.marked)
+	m.actionableView.SetIssueMap(m.issueMap)
+	m.actionableView.SetDensity(density)
+}
+
+// buildTreeView builds the tree from the snapshot, or from the issues the
+// query shows while one is active
+func (m *Model) buildTreeView() {
+	if m.snapshot != nil && m.queryRecipe() == nil {
+		m.tree.BuildFromSnapshot(m.snapshot)
+	} else {
+		m.tree.Build(m.queryIssues())
+	}
+	m.tree.SetSize(m.width, m.height-2)
+}
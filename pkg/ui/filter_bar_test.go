@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func typeFilterBar(m Model, text string) Model {
+	for _, r := range text {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = updated.(Model)
+	}
+	return m
+}
+
+func listIDs(m Model) string {
+	var ids []string
+	for _, item := range m.list.Items() {
+		ids = append(ids, item.(IssueItem).Issue.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestFilterBar(t *testing.T) {
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "API auth", Status: model.StatusOpen, Priority: 0, Labels: []string{"backend"}, Assignee: "bob", UpdatedAt: now},
+		{ID: "bv-2", Title: "API rate limit", Status: model.StatusOpen, Priority: 1, Labels: []string{"backend"}, Assignee: "alice", UpdatedAt: now},
+		{ID: "bv-3", Title: "Old API cleanup", Status: model.StatusOpen, Priority: 1, Labels: []string{"backend"}, UpdatedAt: now.AddDate(0, 0, -30)},
+		{ID: "bv-4", Title: "Theme picker", Status: model.StatusOpen, Priority: 1, Labels: []string{"ui"}, UpdatedAt: now},
+		{ID: "bv-5", Title: "API docs", Status: model.StatusClosed, Priority: 1, Labels: []string{"backend"}, UpdatedAt: now},
+		{ID: "bv-6", Title: "API paging", Status: model.StatusOpen, Priority: 3, Labels: []string{"backend"}, UpdatedAt: now},
+	}
+
+	t.Run("applies query live", func(t *testing.T) {
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+		m = updated.(Model)
+
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Q'}})
+		m = updated.(Model)
+		if m.FocusState() != "filter_bar" {
+			t.Fatalf("Q should open the filter bar, focus = %s", m.FocusState())
+		}
+
+		m = typeFilterBar(m, "status:open label:backend")
+		if got := listIDs(m); got != "bv-1,bv-2,bv-3,bv-6" {
+			t.Fatalf("list after status/label = %s", got)
+		}
+		m = typeFilterBar(m, " priority<=1 -assignee:alice updated>7d")
+		if got := listIDs(m); got != "bv-1" {
+			t.Fatalf("list after full query = %s", got)
+		}
+		if m.board.SelectIssueByID("bv-2") || !m.board.SelectIssueByID("bv-1") {
+			t.Error("board should show only the query's issues")
+		}
+
+		// A malformed term shows its error and leaves the last valid query applied
+		m = typeFilterBar(m, ` "`)
+		if m.filterBar.Err() == nil {
+			t.Fatal("expected a parse error")
+		}
+		if got := listIDs(m); got != "bv-1" {
+			t.Errorf("malformed query should keep the last filter, list = %s", got)
+		}
+		if view := m.View(); !strings.Contains(view, "unterminated quote") {
+			t.Errorf("bar should show the error:\n%s", view)
+		}
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = updated.(Model)
+		if m.FocusState() != "filter_bar" {
+			t.Errorf("enter on a malformed query should keep the bar open, focus = %s", m.FocusState())
+		}
+
+		// Esc restores the filter from before the bar opened
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		m = updated.(Model)
+		if m.FocusState() != "list" || m.activeRecipe != nil || len(m.list.Items()) != 6 {
+			t.Errorf("esc should restore all issues, focus=%s items=%d", m.FocusState(), len(m.list.Items()))
+		}
+	})
+
+	t.Run("applies to actionable view", func(t *testing.T) {
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+		m = updated.(Model)
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+		m = updated.(Model)
+
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'Q'}})
+		m = updated.(Model)
+		m = typeFilterBar(m, "label:ui")
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = updated.(Model)
+
+		if m.FocusState() != "actionable" {
+			t.Fatalf("enter should return to the actionable view, focus = %s", m.FocusState())
+		}
+		var ids []string
+		for _, track := range m.actionableView.plan.Tracks {
+			for _, item := range track.Items {
+				ids = append(ids, item.ID)
+			}
+		}
+		if strings.Join(ids, ",") != "bv-4" {
+			t.Errorf("actionable plan = %v, want only bv-4", ids)
+		}
+		if !strings.Contains(m.View(), "label:ui") {
+			t.Error("footer should show the active query")
+		}
+	})
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"image"
 	"sort"
 	"strings"
 
@@ -38,6 +39,25 @@ type GraphModel struct {
 	rankCriticalPath map[string]int
 	rankInDegree     map[string]int
 	rankOutDegree    map[string]int
+
+	// Longest chain of open blocking dependencies, marked with ◆
+	criticalPath analysis.CriticalPathResult
+
+	// Whole-graph map (m): layered layout with braille edges, zoomable and
+	// pannable around the selected issue
+	mapMode    bool
+	mapZoom    int
+	mapLayout  *graphMapLayout // built lazily, dropped when issues change
+	panX, panY int
+
+	// Inline preview (v): the snapshot image drawn with the terminal's
+	// graphics protocol, rendered off the UI thread
+	preview        bool
+	previewGen     int // bumped when issues change, dropping stale renders
+	previewPending bool
+	previewImage   image.Image
+	previewErr     error
+	previewCache   *graphPreviewCache
 }
 
 // NewGraphModel creates a new graph view from issues
@@ -46,6 +66,7 @@ func NewGraphModel(issues []model.Issue, insights *analysis.Insights, theme Them
 		issues:   issues,
 		insights: insights,
 		theme:    theme,
+		mapZoom:  graphMapZoomIDs,
 	}
 	g.rebuildGraph()
 	return g
@@ -67,6 +88,8 @@ func (g *GraphModel) SetSnapshot(snapshot *DataSnapshot) {
 	g.issues = snapshot.Issues
 	g.issueMap = snapshot.IssueMap
 	g.insights = &snapshot.Insights
+	g.mapLayout = nil
+	g.dropPreview()
 
 	if g.issueMap == nil {
 		g.issueMap = make(map[string]*model.Issue, len(g.issues))
@@ -88,6 +111,7 @@ func (g *GraphModel) SetSnapshot(snapshot *DataSnapshot) {
 		g.rankCriticalPath = snapshot.GraphLayout.RankCriticalPath
 		g.rankInDegree = snapshot.GraphLayout.RankInDegree
 		g.rankOutDegree = snapshot.GraphLayout.RankOutDegree
+		g.criticalPath = snapshot.GraphLayout.CriticalPath
 	} else {
 		g.rebuildGraph()
 	}
@@ -147,6 +171,8 @@ func (g *GraphModel) SetIssues(issues []model.Issue, insights *analysis.Insights
 
 func (g *GraphModel) rebuildGraph() {
 	size := len(g.issues)
+	g.mapLayout = nil
+	g.dropPreview()
 	g.issueMap = make(map[string]*model.Issue, size)
 	g.blockers = make(map[string][]string, size)
 	g.dependents = make(map[string][]string, size)
@@ -170,6 +196,7 @@ func (g *GraphModel) rebuildGraph() {
 
 	// Compute rankings for all metrics
 	g.computeRankings()
+	g.criticalPath = analysis.ComputeCriticalPath(g.issues)
 
 	// Sort by critical path score if available, else by ID
 	if g.insights != nil && g.insights.Stats != nil {
@@ -218,8 +245,18 @@ func (g *GraphModel) computeRankings() {
 	g.rankOutDegree = stats.OutDegreeRank()
 }
 
-// Navigation
+// onCriticalPath reports whether id is on a critical path of two or more issues
+func (g *GraphModel) onCriticalPath(id string) bool {
+	return g.criticalPath.Length > 1 && g.criticalPath.Contains(id)
+}
+
+// Navigation; on the map, up/down stay within a layer and left/right step
+// between layers
 func (g *GraphModel) MoveUp() {
+	if g.mapMode {
+		g.moveOnMap(0, -1)
+		return
+	}
 	if g.selectedIdx > 0 {
 		g.selectedIdx--
 		g.ensureVisible()
@@ -227,14 +264,31 @@ func (g *GraphModel) MoveUp() {
 }
 
 func (g *GraphModel) MoveDown() {
+	if g.mapMode {
+		g.moveOnMap(0, 1)
+		return
+	}
 	if g.selectedIdx < len(g.sortedIDs)-1 {
 		g.selectedIdx++
 		g.ensureVisible()
 	}
 }
 
-func (g *GraphModel) MoveLeft()  { g.MoveUp() }
-func (g *GraphModel) MoveRight() { g.MoveDown() }
+func (g *GraphModel) MoveLeft() {
+	if g.mapMode {
+		g.moveOnMap(-1, 0)
+		return
+	}
+	g.MoveUp()
+}
+
+func (g *GraphModel) MoveRight() {
+	if g.mapMode {
+		g.moveOnMap(1, 0)
+		return
+	}
+	g.MoveDown()
+}
 
 func (g *GraphModel) PageUp() {
 	g.selectedIdx -= 10
@@ -255,8 +309,18 @@ func (g *GraphModel) PageDown() {
 	g.ensureVisible()
 }
 
-func (g *GraphModel) ScrollLeft()  {}
-func (g *GraphModel) ScrollRight() {}
+// ScrollLeft and ScrollRight pan the map; the ego view has nothing to scroll
+func (g *GraphModel) ScrollLeft() {
+	if g.mapMode {
+		g.Pan(-1, 0)
+	}
+}
+
+func (g *GraphModel) ScrollRight() {
+	if g.mapMode {
+		g.Pan(1, 0)
+	}
+}
 
 func (g *GraphModel) ensureVisible() {}
 
@@ -304,6 +368,12 @@ func (g *GraphModel) View(width, height int) string {
 	if selectedIssue == nil {
 		return "Error: selected issue not found"
 	}
+	if g.preview {
+		return g.renderPreview(width, height, t)
+	}
+	if g.mapMode {
+		return g.renderMap(width, height, t)
+	}
 
 	// Layout: Left panel (node list) | Right panel (visual graph + metrics)
 	listWidth := 28
@@ -374,8 +444,15 @@ func (g *GraphModel) renderNodeList(width, height int, t Theme) string {
 		isSelected := i == g.selectedIdx
 		statusIcon := getStatusIcon(issue.Status)
 		maxIDLen := width - 4
+		onPath := g.onCriticalPath(id)
+		if onPath {
+			maxIDLen -= 2
+		}
 		displayID := smartTruncateID(id, maxIDLen)
 		line := fmt.Sprintf("%s %s", statusIcon, displayID)
+		if onPath {
+			line += " ◆"
+		}
 
 		var style lipgloss.Style
 		if isSelected {
@@ -583,7 +660,7 @@ func (g *GraphModel) renderNodeBox(id string, boxWidth int, t Theme, isEgo bool)
 	if isEgo {
 		// Ego node gets double-line border and highlight
 		boxStyle = t.Renderer.NewStyle().
-			Border(lipgloss.DoubleBorder()).
+			Border(termBorder(lipgloss.DoubleBorder())).
 			BorderForeground(t.Primary).
 			Foreground(t.Primary).
 			Bold(true).
@@ -592,7 +669,7 @@ func (g *GraphModel) renderNodeBox(id string, boxWidth int, t Theme, isEgo bool)
 			Padding(0, 1)
 	} else {
 		boxStyle = t.Renderer.NewStyle().
-			Border(lipgloss.RoundedBorder()).
+			Border(termBorder(lipgloss.RoundedBorder())).
 			BorderForeground(statusColor).
 			Foreground(statusColor).
 			Width(boxWidth).
@@ -645,9 +722,12 @@ func (g *GraphModel) renderEgoNode(id string, issue *model.Issue, width int, t T
 	blockerCount := len(g.blockers[id])
 	dependentCount := len(g.dependents[id])
 	content += fmt.Sprintf("\n⬆%d  ⬇%d", blockerCount, dependentCount)
+	if g.onCriticalPath(id) {
+		content += fmt.Sprintf("\n◆ critical path %d/%d", g.criticalPath.Index(id)+1, g.criticalPath.Length)
+	}
 
 	egoStyle := t.Renderer.NewStyle().
-		Border(lipgloss.DoubleBorder()).
+		Border(termBorder(lipgloss.DoubleBorder())).
 		BorderForeground(t.Primary).
 		Foreground(t.Primary).
 		Bold(true).
@@ -877,21 +957,21 @@ func (g *GraphModel) renderMetricsPanel(id string, width int, t Theme) string {
 func getStatusIcon(status model.Status) string {
 	switch {
 	case isClosedLikeStatus(status):
-		return "✅"
+		return glyph("✅", "* ")
 	case status == model.StatusOpen:
-		return "🔵"
+		return glyph("🔵", "o ")
 	case status == model.StatusInProgress:
-		return "🟡"
+		return glyph("🟡", "~ ")
 	case status == model.StatusBlocked:
-		return "🔴"
+		return glyph("🔴", "x ")
 	case status == model.StatusDeferred:
-		return "⏸️"
+		return glyph("⏸️", "z ")
 	case status == model.StatusPinned:
-		return "📌"
+		return glyph("📌", "p ")
 	case status == model.StatusHooked:
-		return "🪝"
+		return glyph("🪝", "h ")
 	default:
-		return "⚪"
+		return glyph("⚪", ". ")
 	}
 }
 
@@ -921,13 +1001,13 @@ func getStatusColor(status model.Status, t Theme) lipgloss.AdaptiveColor {
 func getPriorityIcon(priority int) string {
 	switch priority {
 	case 1:
-		return "🔥"
+		return glyph("🔥", "^^")
 	case 2:
-		return "⚡"
+		return glyph("⚡", "^ ")
 	case 3:
-		return "📌"
+		return glyph("📌", "- ")
 	case 4:
-		return "📋"
+		return glyph("📋", "v ")
 	default:
 		return "  "
 	}
@@ -936,17 +1016,17 @@ func getPriorityIcon(priority int) string {
 func getTypeIcon(itype model.IssueType) string {
 	switch itype {
 	case model.TypeBug:
-		return "🐛"
+		return glyph("🐛", "B ")
 	case model.TypeFeature:
-		return "✨"
+		return glyph("✨", "F ")
 	case model.TypeTask:
-		return "📝"
+		return glyph("📝", "T ")
 	case model.TypeEpic:
-		return "🎯"
+		return glyph("🎯", "E ")
 	case model.TypeChore:
-		return "🔧"
+		return glyph("🔧", "C ")
 	default:
-		return "📄"
+		return glyph("📄", ". ")
 	}
 }
 
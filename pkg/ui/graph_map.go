@@ -0,0 +1,405 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Zoom levels of the dependency map
+const (
+	graphMapZoomDots   = iota // status dot per issue
+	graphMapZoomIDs           // status and ID
+	graphMapZoomTitles        // status, ID and title
+)
+
+// graphMapPanStep is how far one pan key moves the map, in cells (x) and rows (y)
+const (
+	graphMapPanX = 16
+	graphMapPanY = 4
+)
+
+// graphMapPos is a node's label position in map cells
+type graphMapPos struct {
+	x, y   int
+	column int
+	row    int // index within its column
+}
+
+// graphMapLayout places every issue of the graph in a layered left-to-right
+// drawing: columns are dependency layers (blockers left of what they block)
+// and issues caught in a cycle get a last column of their own.
+type graphMapLayout struct {
+	zoom    int
+	pos     map[string]graphMapPos
+	labels  map[string]string
+	columns [][]string // IDs per column, top to bottom
+	edges   [][2]string
+	layers  int // dependency layers, not counting the cycle column
+	width   int
+	height  int
+}
+
+// ToggleMap switches between the focused ego view and the whole-graph map
+func (g *GraphModel) ToggleMap() {
+	g.mapMode = !g.mapMode
+	g.preview = false
+	g.panX, g.panY = 0, 0
+}
+
+// MapMode reports whether the whole-graph map is showing
+func (g *GraphModel) MapMode() bool {
+	return g.mapMode
+}
+
+// ZoomIn shows more of each issue on the map
+func (g *GraphModel) ZoomIn() {
+	if g.mapZoom < graphMapZoomTitles {
+		g.mapZoom++
+		g.mapLayout = nil
+		g.panX, g.panY = 0, 0
+	}
+}
+
+// ZoomOut fits more issues on the map
+func (g *GraphModel) ZoomOut() {
+	if g.mapZoom > graphMapZoomDots {
+		g.mapZoom--
+		g.mapLayout = nil
+		g.panX, g.panY = 0, 0
+	}
+}
+
+// Pan moves the map view by dx, dy steps; the view stays offset from the
+// selected issue until the selection moves or Center is called
+func (g *GraphModel) Pan(dx, dy int) {
+	g.panX += dx * graphMapPanX
+	g.panY += dy * graphMapPanY
+}
+
+// Center puts the selected issue back in the middle of the map
+func (g *GraphModel) Center() {
+	g.panX, g.panY = 0, 0
+}
+
+// layout returns the map layout for the current issues and zoom
+func (g *GraphModel) layout() *graphMapLayout {
+	if g.mapLayout == nil || g.mapLayout.zoom != g.mapZoom {
+		g.mapLayout = buildGraphMapLayout(g.issues, g.dependents, g.mapZoom)
+	}
+	return g.mapLayout
+}
+
+func buildGraphMapLayout(issues []model.Issue, dependents map[string][]string, zoom int) *graphMapLayout {
+	l := &graphMapLayout{
+		zoom:   zoom,
+		pos:    make(map[string]graphMapPos, len(issues)),
+		labels: make(map[string]string, len(issues)),
+	}
+	layering := analysis.TopoLayers(issues)
+	l.columns = append(l.columns, layering.Layers...)
+	l.layers = len(layering.Layers)
+	if len(layering.Unorderable) > 0 {
+		l.columns = append(l.columns, layering.Unorderable)
+	}
+
+	idW := 0
+	for _, col := range l.columns {
+		for _, id := range col {
+			idW = max(idW, len([]rune(id)))
+		}
+	}
+	idW = min(idW, 14)
+	titleW := 22
+	colGap, rowH := 6, 2
+	labelW := 1
+	switch zoom {
+	case graphMapZoomDots:
+		colGap, rowH = 5, 1
+	case graphMapZoomIDs:
+		labelW = 2 + idW
+	default:
+		labelW = 2 + idW + 1 + titleW
+	}
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	for id, issue := range byID {
+		switch zoom {
+		case graphMapZoomDots:
+			l.labels[id] = ""
+		case graphMapZoomIDs:
+			l.labels[id] = " " + truncateRunesHelper(id, idW, "…")
+		default:
+			title := strings.Join(strings.Fields(issue.Title), " ")
+			l.labels[id] = " " + padRight(truncateRunesHelper(id, idW, "…"), idW) + " " + truncateRunesHelper(title, titleW, "…")
+		}
+	}
+
+	// Order each column by the mean row of its blockers (barycenter), which
+	// keeps most edges short and uncrossed.
+	blockers := make(map[string][]string)
+	for from, tos := range dependents {
+		for _, to := range tos {
+			if byID[from] != nil && byID[to] != nil {
+				blockers[to] = append(blockers[to], from)
+				l.edges = append(l.edges, [2]string{from, to})
+			}
+		}
+	}
+	sort.Slice(l.edges, func(i, j int) bool {
+		if l.edges[i][0] != l.edges[j][0] {
+			return l.edges[i][0] < l.edges[j][0]
+		}
+		return l.edges[i][1] < l.edges[j][1]
+	})
+	tallest := 0
+	for _, col := range l.columns {
+		tallest = max(tallest, len(col))
+	}
+	for c, col := range l.columns {
+		col = append([]string(nil), col...)
+		if c > 0 {
+			center := make(map[string]float64, len(col))
+			for _, id := range col {
+				sum, n := 0.0, 0
+				for _, b := range blockers[id] {
+					if p, ok := l.pos[b]; ok {
+						sum += float64(p.y)
+						n++
+					}
+				}
+				center[id] = -1
+				if n > 0 {
+					center[id] = sum / float64(n)
+				}
+			}
+			sort.SliceStable(col, func(i, j int) bool {
+				return center[col[i]] < center[col[j]]
+			})
+		}
+		l.columns[c] = col
+		top := (tallest - len(col)) * rowH / 2
+		for r, id := range col {
+			l.pos[id] = graphMapPos{x: c * (labelW + colGap), y: top + r*rowH, column: c, row: r}
+		}
+	}
+	l.width = len(l.columns)*(labelW+colGap) - colGap
+	l.height = max(tallest*rowH-rowH+1, 1)
+	return l
+}
+
+// moveOnMap moves the selection within (dy) or across (dx) map columns,
+// picking the nearest row in the new column
+func (g *GraphModel) moveOnMap(dx, dy int) {
+	if len(g.sortedIDs) == 0 {
+		return
+	}
+	l := g.layout()
+	p, ok := l.pos[g.sortedIDs[g.selectedIdx]]
+	if !ok {
+		return
+	}
+	if dy != 0 {
+		col := l.columns[p.column]
+		if r := p.row + dy; r >= 0 && r < len(col) {
+			g.SelectByID(col[r])
+		}
+	} else if c := p.column + dx; c >= 0 && c < len(l.columns) {
+		best, bestDist := "", 0
+		for _, id := range l.columns[c] {
+			d := l.pos[id].y - p.y
+			if d < 0 {
+				d = -d
+			}
+			if best == "" || d < bestDist {
+				best, bestDist = id, d
+			}
+		}
+		g.SelectByID(best)
+	}
+	g.panX, g.panY = 0, 0
+}
+
+// graph map cell kinds, for styling runs of a row
+const (
+	mapCellEmpty = iota
+	mapCellEdge
+	mapCellEdgeHot
+	mapCellOpen
+	mapCellProgress
+	mapCellBlocked
+	mapCellClosed
+	mapCellSelected
+)
+
+// braille dot bits for (x%2, y%4) within a cell
+var brailleBits = [2][4]rune{{0x01, 0x02, 0x04, 0x40}, {0x08, 0x10, 0x20, 0x80}}
+
+// renderMap draws the whole dependency graph around the selected issue:
+// node labels in layer columns, edges as braille lines (plain dots without
+// Unicode), edges touching the selection highlighted
+func (g *GraphModel) renderMap(width, height int, t Theme) string {
+	l := g.layout()
+	selectedID := g.sortedIDs[g.selectedIdx]
+	sel := l.pos[selectedID]
+	unicode := TermCapabilities().Unicode
+
+	headerStyle := t.Renderer.NewStyle().Bold(true).
+		Foreground(t.Base.GetForeground()).Background(t.Primary).
+		Padding(0, 2).Width(width - 4)
+	zoomNames := []string{"dots", "ids", "titles"}
+	header := fmt.Sprintf("DEPENDENCY MAP  │  %d issues · %d edges · %d layers  │  zoom %s  │  %s",
+		len(l.pos), len(l.edges), l.layers, zoomNames[l.zoom], selectedID)
+	if len(l.columns) > l.layers {
+		header += "  │  last column: cycles"
+	}
+
+	viewW, viewH := max(width, 1), max(height-2, 1)
+	labelLen := len([]rune(l.labels[selectedID])) + 1
+	viewX := sel.x + labelLen/2 + g.panX - viewW/2
+	viewY := sel.y + g.panY - viewH/2
+
+	bits := make([][]rune, viewH)
+	hot := make([][]bool, viewH)
+	for y := range bits {
+		bits[y] = make([]rune, viewW)
+		hot[y] = make([]bool, viewW)
+	}
+	plot := func(dx, dy int, isHot bool) {
+		cx, cy := dx/2-viewX, dy/4-viewY
+		if dx < 0 || dy < 0 || cx < 0 || cy < 0 || cx >= viewW || cy >= viewH {
+			return
+		}
+		bits[cy][cx] |= brailleBits[dx%2][dy%4]
+		hot[cy][cx] = hot[cy][cx] || isHot
+	}
+	for _, e := range l.edges {
+		from, to := l.pos[e[0]], l.pos[e[1]]
+		isHot := e[0] == selectedID || e[1] == selectedID
+		x0 := (from.x+len([]rune(l.labels[e[0]]))+1)*2 + 1
+		y0 := from.y*4 + 2
+		x1 := (to.x-1)*2 + 1
+		y1 := to.y*4 + 2
+		// Skip lines wholly outside the view
+		minX, maxX := min(x0, x1)/2, max(x0, x1)/2
+		minY, maxY := min(y0, y1)/4, max(y0, y1)/4
+		if maxX < viewX || minX >= viewX+viewW || maxY < viewY || minY >= viewY+viewH {
+			continue
+		}
+		drawLine(x0, y0, x1, y1, func(x, y int) { plot(x, y, isHot) })
+	}
+
+	cells := make([][]string, viewH)
+	kinds := make([][]int, viewH)
+	for y := range cells {
+		cells[y] = make([]string, viewW)
+		kinds[y] = make([]int, viewW)
+		for x := range cells[y] {
+			switch {
+			case bits[y][x] == 0:
+				cells[y][x] = " "
+			case unicode:
+				cells[y][x] = string(0x2800 + bits[y][x])
+			default:
+				cells[y][x] = "."
+			}
+			if bits[y][x] != 0 {
+				kinds[y][x] = mapCellEdge
+				if hot[y][x] {
+					kinds[y][x] = mapCellEdgeHot
+				}
+			}
+		}
+	}
+	for id, p := range l.pos {
+		cy := p.y - viewY
+		if cy < 0 || cy >= viewH {
+			continue
+		}
+		issue := g.issueMap[id]
+		kind, dot := mapCellOpen, glyph("●", "o")
+		if issue != nil {
+			switch {
+			case isClosedLikeStatus(issue.Status):
+				kind, dot = mapCellClosed, glyph("✓", "*")
+			case issue.Status == model.StatusInProgress:
+				kind, dot = mapCellProgress, glyph("◐", "~")
+			case issue.Status == model.StatusBlocked:
+				kind, dot = mapCellBlocked, glyph("⊘", "x")
+			}
+		}
+		if id == selectedID {
+			kind = mapCellSelected
+		}
+		for i, r := range append([]string{dot}, strings.Split(l.labels[id], "")...) {
+			if cx := p.x + i - viewX; cx >= 0 && cx < viewW && r != "" {
+				cells[cy][cx], kinds[cy][cx] = r, kind
+			}
+		}
+	}
+
+	styles := map[int]lipgloss.Style{
+		mapCellEmpty:    t.Renderer.NewStyle(),
+		mapCellEdge:     t.Renderer.NewStyle().Foreground(t.Muted),
+		mapCellEdgeHot:  t.Renderer.NewStyle().Foreground(t.Primary),
+		mapCellOpen:     t.Renderer.NewStyle().Foreground(t.Open),
+		mapCellProgress: t.Renderer.NewStyle().Foreground(t.InProgress),
+		mapCellBlocked:  t.Renderer.NewStyle().Foreground(t.Blocked),
+		mapCellClosed:   t.Renderer.NewStyle().Foreground(t.Closed),
+		mapCellSelected: t.Renderer.NewStyle().Foreground(t.Primary).Background(t.Highlight).Bold(true),
+	}
+	rows := make([]string, viewH)
+	for y := range cells {
+		var sb strings.Builder
+		for x := 0; x < viewW; {
+			run := x
+			for run < viewW && kinds[y][run] == kinds[y][x] {
+				run++
+			}
+			sb.WriteString(styles[kinds[y][x]].Render(strings.Join(cells[y][x:run], "")))
+			x = run
+		}
+		rows[y] = sb.String()
+	}
+
+	legend := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).Render(truncateRunesHelper(
+		"blockers → dependents  •  arrows move  •  H/J/K/L pan  •  +/- zoom  •  c center  •  m ego view  •  enter open",
+		width-2, "…"))
+	return headerStyle.Render(header) + "\n" + strings.Join(rows, "\n") + "\n" + legend
+}
+
+// drawLine calls plot for each point of the line from (x0, y0) to (x1, y1)
+// (Bresenham)
+func drawLine(x0, y0, x1, y1 int, plot func(x, y int)) {
+	dx, dy := x1-x0, y1-y0
+	sx, sy := 1, 1
+	if dx < 0 {
+		dx, sx = -dx, -1
+	}
+	if dy < 0 {
+		dy, sy = -dy, -1
+	}
+	err := dx - dy
+	for {
+		plot(x0, y0)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 > -dy {
+			err -= dy
+			x0 += sx
+		}
+		if e2 < dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
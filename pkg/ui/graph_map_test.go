@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestGraphMap(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "core", Title: "Core lib", Status: model.StatusClosed},
+		{ID: "api", Title: "Build API", Status: model.StatusInProgress, Dependencies: []*model.Dependency{{DependsOnID: "core", Type: model.DepBlocks}}},
+		{ID: "db", Title: "Schema", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "core", Type: model.DepBlocks}}},
+		{ID: "ui", Title: "Build UI", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{DependsOnID: "api", Type: model.DepBlocks},
+			{DependsOnID: "db", Type: model.DepBlocks},
+		}},
+		{ID: "rel", Title: "Release", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "ui", Type: model.DepBlocks}}},
+	}
+
+	t.Run("render", func(t *testing.T) {
+		g := NewGraphModel(issues, nil, newTestTheme())
+		g.ToggleMap()
+		if !g.MapMode() {
+			t.Fatal("expected map mode")
+		}
+		g.SelectByID("api")
+
+		out := g.View(100, 16)
+		for _, want := range []string{"DEPENDENCY MAP", "5 issues · 5 edges · 4 layers", "zoom ids", "core", "api", "rel", "⠤"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("map missing %q:\n%s", want, out)
+			}
+		}
+		if strings.Contains(out, "Build API") {
+			t.Error("titles should only show at the highest zoom")
+		}
+
+		g.ZoomIn()
+		if out := g.View(120, 16); !strings.Contains(out, "Build API") || !strings.Contains(out, "zoom titles") {
+			t.Errorf("expected titles when zoomed in:\n%s", out)
+		}
+		g.ZoomOut()
+		g.ZoomOut()
+		if out := g.View(100, 16); strings.Contains(out, "core") || !strings.Contains(out, "◐") {
+			t.Errorf("dots zoom should draw status dots without labels:\n%s", out)
+		}
+	})
+
+	t.Run("navigation and pan", func(t *testing.T) {
+		g := NewGraphModel(issues, nil, newTestTheme())
+		g.ToggleMap()
+		g.SelectByID("core")
+
+		g.MoveRight()
+		if got := g.SelectedIssue().ID; got != "api" {
+			t.Fatalf("right from core = %q, want api (nearest in next layer)", got)
+		}
+		g.MoveDown()
+		if got := g.SelectedIssue().ID; got != "db" {
+			t.Fatalf("down from api = %q, want db", got)
+		}
+		g.MoveDown()
+		if got := g.SelectedIssue().ID; got != "db" {
+			t.Fatalf("down should stay within the layer, got %q", got)
+		}
+		g.MoveRight()
+		g.MoveRight()
+		if got := g.SelectedIssue().ID; got != "rel" {
+			t.Fatalf("expected to reach rel, got %q", got)
+		}
+
+		centered := g.View(60, 12)
+		g.ScrollRight()
+		g.ScrollRight()
+		if panned := g.View(60, 12); panned == centered {
+			t.Error("panning should move the view")
+		}
+		g.Center()
+		if again := g.View(60, 12); again != centered {
+			t.Error("Center should restore the centered view")
+		}
+	})
+}
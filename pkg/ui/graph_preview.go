@@ -0,0 +1,342 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
+
+	tea "github.com/charmbracelet/bubbletea"
+	xdraw "golang.org/x/image/draw"
+)
+
+// Cell size assumed when fitting the preview to the terminal; most fonts
+// are about twice as tall as wide
+const (
+	previewCellW = 8
+	previewCellH = 16
+)
+
+// kittyChunk is the largest base64 payload the kitty protocol takes in
+// one escape; bigger images go out in several
+const kittyChunk = 4096
+
+// kittyDeleteImages removes every kitty image placement on screen. Kitty
+// draws images on a layer above the text, so leaving the preview has to
+// take them down explicitly.
+const kittyDeleteImages = "\x1b_Ga=d,q=2\x1b\\"
+
+// graphPreviewMsg carries a rendered snapshot image back to the graph view
+type graphPreviewMsg struct {
+	gen int // graph generation it was rendered for, to drop stale renders
+	img image.Image
+	err error
+}
+
+// graphPreviewCache holds the escape sequence for the last preview size
+type graphPreviewCache struct {
+	key  string
+	seq  string
+	cols int
+	rows int
+}
+
+// TogglePreview switches the inline image preview of the whole graph on or
+// off
+func (g *GraphModel) TogglePreview() {
+	g.preview = !g.preview
+	g.mapMode = false
+}
+
+// PreviewMode reports whether the image preview is showing
+func (g *GraphModel) PreviewMode() bool {
+	return g.preview
+}
+
+// ShowingImage reports whether the preview is drawing an image right now,
+// rather than falling back to the map or waiting on the render
+func (g *GraphModel) ShowingImage() bool {
+	return g.preview && g.previewImage != nil && TermCapabilities().Images != ImageNone
+}
+
+// dropPreview forgets the rendered preview once the graph's issues change
+func (g *GraphModel) dropPreview() {
+	g.previewGen++
+	g.previewImage, g.previewErr = nil, nil
+	g.previewPending = false
+	g.previewCache = nil
+}
+
+// previewCmd renders the snapshot image off the UI thread when the preview
+// is showing and has nothing to draw yet. Terminals without an image
+// protocol get the map instead, so nothing is rendered for them.
+func (g *GraphModel) previewCmd() tea.Cmd {
+	if !g.preview || g.previewPending || g.previewImage != nil || g.previewErr != nil ||
+		len(g.issues) == 0 || TermCapabilities().Images == ImageNone {
+		return nil
+	}
+	g.previewPending = true
+	gen, issues := g.previewGen, g.issues
+	var stats *analysis.GraphStats
+	if g.insights != nil {
+		stats = g.insights.Stats
+	}
+	return func() tea.Msg {
+		if stats == nil {
+			full := analysis.NewAnalyzer(issues).Analyze()
+			stats = &full
+		}
+		img, err := export.RenderGraphSnapshotImage(export.GraphSnapshotOptions{
+			Issues:       issues,
+			Stats:        stats,
+			DataHash:     analysis.ComputeDataHash(issues),
+			CriticalPath: true,
+		})
+		return graphPreviewMsg{gen: gen, img: img, err: err}
+	}
+}
+
+// setPreview takes a finished render, unless the issues changed meanwhile
+func (g *GraphModel) setPreview(msg graphPreviewMsg) {
+	if msg.gen != g.previewGen {
+		return
+	}
+	g.previewPending = false
+	g.previewImage, g.previewErr = msg.img, msg.err
+	g.previewCache = &graphPreviewCache{}
+}
+
+// renderPreview draws the snapshot image with the terminal's graphics
+// protocol, or the Unicode map when the terminal has none or the render
+// failed
+func (g *GraphModel) renderPreview(width, height int, t Theme) string {
+	proto := TermCapabilities().Images
+	legend := func(s string) string {
+		return t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).Render(truncateRunesHelper(s, width-2, "…"))
+	}
+	switch {
+	case proto == ImageNone:
+		return g.renderMap(width, height-1, t) + "\n" +
+			legend("no inline image support (kitty, iTerm2 or sixel; see BV_IMAGES) - showing the map  •  v back")
+	case g.previewErr != nil:
+		return g.renderMap(width, height-1, t) + "\n" +
+			legend("preview unavailable: "+g.previewErr.Error()+"  •  v back")
+	}
+
+	headerStyle := t.Renderer.NewStyle().Bold(true).
+		Foreground(t.Base.GetForeground()).Background(t.Primary).
+		Padding(0, 2).Width(width - 4)
+	header := fmt.Sprintf("GRAPH PREVIEW  │  %d issues  │  %s", len(g.issues), proto)
+	rows := max(height-2, 1)
+	if g.previewImage == nil {
+		body := make([]string, rows)
+		body[0] = t.Renderer.NewStyle().Foreground(t.Secondary).Render("  Rendering graph…")
+		return headerStyle.Render(header) + "\n" + strings.Join(body, "\n") + "\n" + legend("v back  •  m map")
+	}
+
+	// Encoding is slow, so it is done once per size. The cache is shared by
+	// pointer: View renders from copies of the model.
+	cache := g.previewCache
+	if key := fmt.Sprintf("%s %dx%d", proto, width, rows); cache.key != key {
+		cache.cols, cache.rows, cache.seq = encodeInlineImage(proto, g.previewImage, width, rows)
+		cache.key = key
+	}
+	header += fmt.Sprintf("  │  %d×%d cells", cache.cols, cache.rows)
+	body := placeImage(cache.seq, width, cache.rows)
+	for len(body) < rows {
+		body = append(body, "")
+	}
+	return headerStyle.Render(header) + "\n" + strings.Join(body, "\n") + "\n" +
+		legend("v back  •  m map  •  blockers → dependents, critical path outlined")
+}
+
+// placeImage lays out the rows an image covers. The escape goes out on the
+// last of them and moves up to the first before drawing, so the blank rows
+// above, which the renderer clears to the end of the line, are written
+// first; rows that don't change are never rewritten and keep the image.
+func placeImage(seq string, width, rows int) []string {
+	if rows < 1 {
+		return nil
+	}
+	lines := make([]string, rows)
+	var sb strings.Builder
+	// A full row leaves no room for the renderer's erase-line after it
+	sb.WriteString(strings.Repeat(" ", width))
+	sb.WriteString("\x1b7")
+	if rows > 1 {
+		fmt.Fprintf(&sb, "\x1b[%dA", rows-1)
+	}
+	fmt.Fprintf(&sb, "\x1b[%dD", width)
+	sb.WriteString(seq)
+	sb.WriteString("\x1b8")
+	lines[rows-1] = sb.String()
+	return lines
+}
+
+// fitPreview scales an image of w×h pixels to fit maxCols×maxRows cells
+// without enlarging it, returning the scaled size in pixels and in cells
+func fitPreview(w, h, maxCols, maxRows int) (pw, ph, cols, rows int) {
+	if w <= 0 || h <= 0 {
+		return 0, 0, 0, 0
+	}
+	scale := min(1, float64(maxCols*previewCellW)/float64(w), float64(maxRows*previewCellH)/float64(h))
+	pw, ph = max(int(float64(w)*scale), 1), max(int(float64(h)*scale), 1)
+	cols = min((pw+previewCellW-1)/previewCellW, maxCols)
+	rows = min((ph+previewCellH-1)/previewCellH, maxRows)
+	return pw, ph, cols, rows
+}
+
+// encodeInlineImage scales img to fit maxCols×maxRows cells and encodes it
+// for the given protocol, returning the cells it covers
+func encodeInlineImage(proto ImageProtocol, img image.Image, maxCols, maxRows int) (cols, rows int, seq string) {
+	b := img.Bounds()
+	pw, ph, cols, rows := fitPreview(b.Dx(), b.Dy(), maxCols, maxRows)
+	if cols == 0 || rows == 0 {
+		return 0, 0, ""
+	}
+	scaled := image.NewRGBA(image.Rect(0, 0, pw, ph))
+	xdraw.ApproxBiLinear.Scale(scaled, scaled.Bounds(), img, b, xdraw.Src, nil)
+
+	switch proto {
+	case ImageKitty:
+		return cols, rows, kittyImage(encodePNG(scaled), cols)
+	case ImageITerm2:
+		return cols, rows, iterm2Image(encodePNG(scaled), cols, rows)
+	case ImageSixel:
+		return cols, rows, sixelImage(scaled)
+	}
+	return 0, 0, ""
+}
+
+// encodePNG encodes an image that is already in memory, which cannot fail
+func encodePNG(img image.Image) []byte {
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, img)
+	return buf.Bytes()
+}
+
+// kittyImage transmits and places a PNG with the kitty graphics protocol,
+// cols cells wide (kitty keeps the aspect ratio for the rows), replacing
+// any image placed before. Payloads are sent in chunks of kittyChunk.
+func kittyImage(data []byte, cols int) string {
+	payload := base64.StdEncoding.EncodeToString(data)
+	var sb strings.Builder
+	sb.WriteString(kittyDeleteImages)
+	for first := true; first || payload != ""; first = false {
+		chunk := payload
+		if len(chunk) > kittyChunk {
+			chunk = chunk[:kittyChunk]
+		}
+		payload = payload[len(chunk):]
+		more := 0
+		if payload != "" {
+			more = 1
+		}
+		if first {
+			fmt.Fprintf(&sb, "\x1b_Ga=T,f=100,q=2,c=%d,m=%d;%s\x1b\\", cols, more, chunk)
+		} else {
+			fmt.Fprintf(&sb, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+	return sb.String()
+}
+
+// iterm2Image shows a PNG inline with iTerm2's OSC 1337 file protocol,
+// also understood by WezTerm and others
+func iterm2Image(data []byte, cols, rows int) string {
+	return fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;width=%d;height=%d;preserveAspectRatio=1:%s\a",
+		len(data), cols, rows, base64.StdEncoding.EncodeToString(data))
+}
+
+// sixelImage encodes an image as sixels on the 6×6×6 color cube: each
+// band of six pixel rows is drawn once per color it uses, runs compressed
+func sixelImage(img *image.RGBA) string {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	level := func(v uint8) int { return (int(v)*5 + 127) / 255 }
+	index := make([]int, w*h)
+	used := make(map[int]bool)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := img.RGBAAt(b.Min.X+x, b.Min.Y+y)
+			i := level(c.R)*36 + level(c.G)*6 + level(c.B)
+			index[y*w+x] = i
+			used[i] = true
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "\x1bPq\"1;1;%d;%d", w, h)
+	colors := make([]int, 0, len(used))
+	for i := range used {
+		colors = append(colors, i)
+	}
+	sort.Ints(colors)
+	for _, i := range colors {
+		fmt.Fprintf(&sb, "#%d;2;%d;%d;%d", i, i/36*20, i/6%6*20, i%6*20)
+	}
+
+	for top := 0; top < h; top += 6 {
+		bits := make(map[int][]byte)
+		for y := top; y < min(top+6, h); y++ {
+			for x := 0; x < w; x++ {
+				i := index[y*w+x]
+				if bits[i] == nil {
+					bits[i] = make([]byte, w)
+				}
+				bits[i][x] |= 1 << (y - top)
+			}
+		}
+		band := make([]int, 0, len(bits))
+		for i := range bits {
+			band = append(band, i)
+		}
+		sort.Ints(band)
+		for n, i := range band {
+			if n > 0 {
+				sb.WriteByte('$')
+			}
+			fmt.Fprintf(&sb, "#%d", i)
+			row := bits[i]
+			for x := 0; x < w; {
+				run := x
+				for run < w && row[run] == row[x] {
+					run++
+				}
+				writeSixelRun(&sb, '?'+row[x], run-x)
+				x = run
+			}
+		}
+		sb.WriteByte('-')
+	}
+	sb.WriteString("\x1b\\")
+	return sb.String()
+}
+
+// writeSixelRun writes n repeats of a sixel, with the repeat introducer
+// once that is shorter
+func writeSixelRun(sb *strings.Builder, c byte, n int) {
+	if n > 3 {
+		fmt.Fprintf(sb, "!%d%c", n, c)
+		return
+	}
+	for ; n > 0; n-- {
+		sb.WriteByte(c)
+	}
+}
+
+// clearInlineImages is prefixed to frames that draw no preview, taking down
+// kitty images left from one; other protocols draw into the cells, which
+// the next frame overwrites
+func clearInlineImages() string {
+	if TermCapabilities().Images == ImageKitty {
+		return kittyDeleteImages
+	}
+	return ""
+}
@@ -0,0 +1,200 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// withImages switches the terminal's image protocol for one test
+func withImages(t *testing.T, proto ImageProtocol) {
+	prev := TermCapabilities()
+	c := prev
+	c.Images = proto
+	SetCapabilities(c)
+	t.Cleanup(func() { SetCapabilities(prev) })
+}
+
+// runPreviewCmd runs cmd, looking through batches, for the rendered preview
+func runPreviewCmd(t *testing.T, cmd tea.Cmd) graphPreviewMsg {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("expected a preview render")
+	}
+	switch msg := cmd().(type) {
+	case graphPreviewMsg:
+		return msg
+	case tea.BatchMsg:
+		for _, c := range msg {
+			if c == nil {
+				continue
+			}
+			if m, ok := c().(graphPreviewMsg); ok {
+				return m
+			}
+		}
+	}
+	t.Fatal("no preview render among the commands")
+	return graphPreviewMsg{}
+}
+
+func TestGraphPreviewFallsBackToMapWithoutImages(t *testing.T) {
+	withImages(t, ImageNone)
+	issues := []model.Issue{
+		{ID: "core", Title: "Core lib", Status: model.StatusClosed},
+		{ID: "api", Title: "Build API", Status: model.StatusInProgress, Dependencies: []*model.Dependency{{DependsOnID: "core", Type: model.DepBlocks}}},
+		{ID: "ui", Title: "Build UI", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "api", Type: model.DepBlocks}}},
+	}
+	g := NewGraphModel(issues, nil, newTestTheme())
+	g.TogglePreview()
+	if cmd := g.previewCmd(); cmd != nil {
+		t.Error("nothing should be rendered for a terminal without images")
+	}
+	out := g.View(100, 16)
+	for _, want := range []string{"DEPENDENCY MAP", "no inline image support", "v back"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("fallback missing %q:\n%s", want, out)
+		}
+	}
+	if g.ShowingImage() {
+		t.Error("no image is showing")
+	}
+}
+
+func TestGraphPreviewDrawsWithEachProtocol(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "core", Title: "Core lib", Status: model.StatusClosed},
+		{ID: "api", Title: "Build API", Status: model.StatusInProgress, Dependencies: []*model.Dependency{{DependsOnID: "core", Type: model.DepBlocks}}},
+		{ID: "ui", Title: "Build UI", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "api", Type: model.DepBlocks}}},
+	}
+	for proto, prefix := range map[ImageProtocol]string{
+		ImageKitty:  "\x1b_Ga=T,f=100,q=2,c=",
+		ImageITerm2: "\x1b]1337;File=inline=1;",
+		ImageSixel:  "\x1bPq\"1;1;",
+	} {
+		t.Run(string(proto), func(t *testing.T) {
+			withImages(t, proto)
+			g := NewGraphModel(issues, nil, newTestTheme())
+			g.TogglePreview()
+			if out := g.View(100, 30); !strings.Contains(out, "Rendering graph") {
+				t.Errorf("preview should say it is rendering:\n%s", out)
+			}
+			cmd := g.previewCmd()
+			if g.previewCmd() != nil {
+				t.Error("a pending render should not start another")
+			}
+			msg := runPreviewCmd(t, cmd)
+			if msg.err != nil {
+				t.Fatalf("render failed: %v", msg.err)
+			}
+			g.setPreview(msg)
+
+			out := g.View(100, 30)
+			if !strings.Contains(out, prefix) || !strings.Contains(out, "GRAPH PREVIEW") || !g.ShowingImage() {
+				t.Errorf("preview should draw with %s:\n%q", proto, out[:min(len(out), 400)])
+			}
+			if lines := strings.Split(out, "\n"); len(lines) != 30 {
+				t.Errorf("preview should fill the height, got %d lines", len(lines))
+			}
+
+			// New issues drop the image, and a render for the old ones is ignored
+			g.SetIssues(issues[:2], nil)
+			g.setPreview(msg)
+			if g.ShowingImage() {
+				t.Error("a stale render should not be shown")
+			}
+			if g.previewCmd() == nil {
+				t.Error("the preview should render again for the new issues")
+			}
+		})
+	}
+}
+
+func TestGraphPreviewTogglesFromGraphView(t *testing.T) {
+	withImages(t, ImageKitty)
+	issues := []model.Issue{
+		{ID: "core", Title: "Core lib", Status: model.StatusClosed},
+		{ID: "api", Title: "Build API", Status: model.StatusInProgress, Dependencies: []*model.Dependency{{DependsOnID: "core", Type: model.DepBlocks}}},
+		{ID: "ui", Title: "Build UI", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "api", Type: model.DepBlocks}}},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = pressKey(updated.(Model), "g")
+	if !m.isGraphView {
+		t.Fatal("g should open the graph view")
+	}
+	if !strings.HasPrefix(m.View(), kittyDeleteImages) {
+		t.Error("frames without the preview should take down kitty images")
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	m = updated.(Model)
+	if !m.graphView.PreviewMode() {
+		t.Fatal("v should open the preview")
+	}
+	updated, _ = m.Update(runPreviewCmd(t, cmd))
+	m = updated.(Model)
+	out := m.View()
+	if strings.HasPrefix(out, kittyDeleteImages) || !strings.Contains(out, "\x1b_Ga=T,f=100") || !strings.Contains(out, "m=0;") {
+		t.Errorf("view should carry the whole image:\n%q", out[:min(len(out), 400)])
+	}
+
+	m = pressKey(m, "v")
+	if m.graphView.PreviewMode() || !strings.HasPrefix(m.View(), kittyDeleteImages) {
+		t.Error("v again should close the preview and take the image down")
+	}
+}
+
+func TestKittyImageIsSentInChunks(t *testing.T) {
+	out := kittyImage(make([]byte, 5000), 40)
+	if !strings.HasPrefix(out, kittyDeleteImages+"\x1b_Ga=T,f=100,q=2,c=40,m=1;") {
+		t.Errorf("first chunk should place the image and announce more: %q", out[:80])
+	}
+	if n := strings.Count(out, "\x1b_G"); n != 3 {
+		t.Errorf("expected delete plus two chunks, got %d escapes", n)
+	}
+	if !strings.Contains(out, "\x1b_Gm=0;") || !strings.HasSuffix(out, "\x1b\\") {
+		t.Errorf("last chunk should close the transfer: %q", out[len(out)-80:])
+	}
+}
+
+func TestSixelImageEncodesRuns(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 7))
+	for y := 0; y < 7; y++ {
+		for x := 0; x < 8; x++ {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+	img.Set(0, 6, color.RGBA{B: 255, A: 255})
+
+	want := "\x1bPq\"1;1;8;7#5;2;0;0;100#180;2;100;0;0" +
+		"#180!8~-" + // first band: six red rows, one run
+		"#5@!7?$#180?!7@-" + // second band: a blue pixel, then red
+		"\x1b\\"
+	if got := sixelImage(img); got != want {
+		t.Errorf("sixel =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestFitPreviewKeepsAspectAndNeverEnlarges(t *testing.T) {
+	tests := []struct {
+		w, h, cols, rows           int
+		wantW, wantH, wantC, wantR int
+	}{
+		{800, 400, 200, 100, 800, 400, 100, 25},
+		{1600, 400, 100, 100, 800, 200, 100, 13},
+		{400, 1600, 100, 20, 80, 320, 10, 20},
+	}
+	for _, tt := range tests {
+		pw, ph, c, r := fitPreview(tt.w, tt.h, tt.cols, tt.rows)
+		if pw != tt.wantW || ph != tt.wantH || c != tt.wantC || r != tt.wantR {
+			t.Errorf("fitPreview(%d,%d,%d,%d) = %d,%d,%d,%d want %d,%d,%d,%d",
+				tt.w, tt.h, tt.cols, tt.rows, pw, ph, c, r, tt.wantW, tt.wantH, tt.wantC, tt.wantR)
+		}
+	}
+}
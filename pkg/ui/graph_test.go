@@ -2,6 +2,7 @@ package ui_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
@@ -308,6 +309,28 @@ func TestGraphModelViewRendering(t *testing.T) {
 	}
 }
 
+// TestGraphModelCriticalPath verifies nodes on the critical path are marked
+func TestGraphModelCriticalPath(t *testing.T) {
+	theme := createTheme()
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "B", Type: model.DepBlocks}}},
+		{ID: "B", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "C", Type: model.DepBlocks}}},
+		{ID: "C", Status: model.StatusOpen},
+		{ID: "D", Status: model.StatusOpen},
+	}
+	g := ui.NewGraphModel(issues, nil, theme)
+	if !g.SelectByID("B") {
+		t.Fatal("expected to select B")
+	}
+	if view := g.View(120, 40); !strings.Contains(view, "◆ critical path 2/3") {
+		t.Errorf("expected B to be marked as step 2 of 3:\n%s", view)
+	}
+	g.SelectByID("D")
+	if view := g.View(120, 40); strings.Contains(view, "critical path") {
+		t.Errorf("D is not on the critical path:\n%s", view)
+	}
+}
+
 // TestGraphModelIgnoresNonBlockingDeps verifies only blocking deps create edges
 func TestGraphModelIgnoresNonBlockingDeps(t *testing.T) {
 	theme := createTheme()
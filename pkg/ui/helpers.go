@@ -161,9 +161,9 @@ func renderTreeNode(sb *strings.Builder, node *DependencyNode, prefix string, is
 	if isRoot {
 		connector = "" // Root has no connector
 	} else if isLast {
-		connector = "└── "
+		connector = glyph("└── ", "`-- ")
 	} else {
-		connector = "├── "
+		connector = glyph("├── ", "+-- ")
 	}
 
 	// Get icons
@@ -192,7 +192,7 @@ func renderTreeNode(sb *strings.Builder, node *DependencyNode, prefix string, is
 	} else if isLast {
 		childPrefix = prefix + "    "
 	} else {
-		childPrefix = prefix + "│   "
+		childPrefix = prefix + glyph("│   ", "|   ")
 	}
 
 	// Render children
@@ -205,17 +205,17 @@ func renderTreeNode(sb *strings.Builder, node *DependencyNode, prefix string, is
 func getDepTypeIcon(depType string) string {
 	switch depType {
 	case "root":
-		return "📍"
+		return glyph("📍", "@ ")
 	case "blocks":
-		return "⛔"
+		return glyph("⛔", "x ")
 	case "related":
-		return "🔗"
+		return glyph("🔗", "~ ")
 	case "parent-child":
-		return "📦"
+		return glyph("📦", "^ ")
 	case "discovered-from":
-		return "🔍"
+		return glyph("🔍", "? ")
 	default:
-		return "•"
+		return glyph("•", "-")
 	}
 }
 
@@ -223,15 +223,15 @@ func getDepTypeIcon(depType string) string {
 func GetStatusIcon(s string) string {
 	switch s {
 	case "open":
-		return "🟢"
+		return glyph("🟢", "o ")
 	case "in_progress":
-		return "🔵"
+		return glyph("🔵", "~ ")
 	case "blocked":
-		return "🔴"
+		return glyph("🔴", "x ")
 	case "closed":
-		return "⚫"
+		return glyph("⚫", "* ")
 	default:
-		return "⚪"
+		return glyph("⚪", ". ")
 	}
 }
 
@@ -239,15 +239,15 @@ func GetStatusIcon(s string) string {
 func GetPriorityIcon(priority int) string {
 	switch priority {
 	case 0:
-		return "🔥" // Critical
+		return glyph("🔥", "!!") // Critical
 	case 1:
-		return "⚡" // High
+		return glyph("⚡", "! ") // High
 	case 2:
-		return "🔹" // Medium
+		return glyph("🔹", "- ") // Medium
 	case 3:
-		return "☕" // Low
+		return glyph("☕", "v ") // Low
 	case 4:
-		return "💤" // Backlog
+		return glyph("💤", "vv") // Backlog
 	default:
 		return "  "
 	}
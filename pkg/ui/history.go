@@ -1480,7 +1480,7 @@ func (h *HistoryModel) renderTimelinePanel(width, height int) string {
 	// Panel border style
 	borderColor := t.Border
 	panelStyle := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width - 2).
 		Height(height - 2)
@@ -1837,7 +1837,7 @@ func (h *HistoryModel) renderHeader() string {
 	if h.searchActive {
 		// Show search input
 		searchStyle := t.Renderer.NewStyle().
-			Border(lipgloss.RoundedBorder()).
+			Border(termBorder(lipgloss.RoundedBorder())).
 			BorderForeground(t.Primary).
 			Padding(0, 1)
 
@@ -2024,7 +2024,7 @@ func (h *HistoryModel) renderListPanel(width, height int) string {
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width - 2). // Account for border
 		Height(height - 2)
@@ -2149,7 +2149,7 @@ func (h *HistoryModel) renderFileTreePanel(width, height int) string {
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width - 2).
 		Height(height - 2)
@@ -2287,7 +2287,7 @@ func (h *HistoryModel) renderDetailPanel(width, height int) string {
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width - 2).
 		Height(height - 2)
@@ -3022,7 +3022,7 @@ func (h *HistoryModel) renderGitCommitListPanel(width, height int) string {
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width - 2).
 		Height(height - 2)
@@ -3121,7 +3121,7 @@ func (h *HistoryModel) renderGitDetailPanel(width, height int) string {
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width - 2).
 		Height(height - 2)
@@ -3260,7 +3260,7 @@ func (h *HistoryModel) renderCommitMiddlePanel(width, height int) string {
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width - 2).
 		Height(height - 2)
@@ -3352,7 +3352,7 @@ func (h *HistoryModel) renderGitBeadListPanel(width, height int) string {
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width - 2).
 		Height(height - 2)
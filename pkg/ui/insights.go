@@ -149,6 +149,9 @@ type InsightsModel struct {
 	recommendationMap  map[string]*analysis.Recommendation // ID -> Recommendation for quick lookup
 	triageDataHash     string                              // Hash of data used for triage
 
+	// Epic completion forecast shown under the velocity line
+	forecast *analysis.CompletionForecast
+
 	// Navigation state
 	focusedPanel  MetricPanel
 	selectedIndex [PanelCount]int // Selection per panel
@@ -188,7 +191,7 @@ func NewInsightsModel(ins analysis.Insights, issueMap map[string]*model.Issue, t
 	// Initialize viewport for detail panel scrolling
 	vp := viewport.New(50, 20)
 	vp.Style = theme.Renderer.NewStyle().
-		BorderStyle(lipgloss.RoundedBorder()).
+		BorderStyle(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(theme.Primary).
 		Padding(0, 1)
 
@@ -240,6 +243,11 @@ func (m *InsightsModel) SetRecommendations(recs []analysis.Recommendation, dataH
 	}
 }
 
+// SetForecast sets the milestone completion forecast for the header line
+func (m *InsightsModel) SetForecast(f analysis.CompletionForecast) {
+	m.forecast = &f
+}
+
 // isPanelSkipped returns true and a reason if the metric for this panel was skipped
 func (m *InsightsModel) isPanelSkipped(panel MetricPanel) (bool, string) {
 	if m.insights.Stats == nil {
@@ -664,6 +672,14 @@ func (m *InsightsModel) View() string {
 			v.Closed7, v.Closed30, v.AvgDays, weekly, estimate))
 	}
 
+	// Optional milestone forecast: median date and interval per epic
+	if line := m.forecastLine(); line != "" {
+		if velocityLine != "" {
+			velocityLine += "\n"
+		}
+		velocityLine += t.Base.Render(line)
+	}
+
 	// Calculate layout dimensions
 	mainWidth := m.width
 	detailWidth := 0
@@ -726,6 +742,28 @@ func (m *InsightsModel) View() string {
 	return mainContent
 }
 
+// forecastLine summarizes the first few epic forecasts, or "" without any
+func (m *InsightsModel) forecastLine() string {
+	if m.forecast == nil || len(m.forecast.Milestones) == 0 {
+		return ""
+	}
+	const shown = 3
+	parts := make([]string, 0, shown+1)
+	for i, f := range m.forecast.Milestones {
+		if i == shown {
+			parts = append(parts, fmt.Sprintf("+%d more", len(m.forecast.Milestones)-shown))
+			break
+		}
+		if f.Expected == nil || f.Latest == nil {
+			parts = append(parts, fmt.Sprintf("%s ?", f.ID))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s ~%s (%s–%s)", f.ID,
+			f.Expected.Format("Jan 2"), f.Earliest.Format("Jan 2"), f.Latest.Format("Jan 2")))
+	}
+	return fmt.Sprintf("Forecast (%.0f%%): %s", m.forecast.Confidence*100, strings.Join(parts, " • "))
+}
+
 func (m *InsightsModel) renderMetricPanel(panel MetricPanel, width, height int, t Theme) string {
 	info := metricDescriptions[panel]
 	items := m.getPanelItems(panel)
@@ -745,7 +783,7 @@ func (m *InsightsModel) renderMetricPanel(panel MetricPanel, width, height int,
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width).
 		Height(height).
@@ -960,7 +998,7 @@ func (m *InsightsModel) renderCyclesPanel(width, height int, t Theme) string {
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width).
 		Height(height).
@@ -1090,7 +1128,7 @@ func (m *InsightsModel) renderPriorityPanel(width, height int, t Theme) string {
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width).
 		Height(height).
@@ -1234,7 +1272,7 @@ func (m *InsightsModel) renderMiniBar(label string, value float64, width int, t
 // renderPriorityItem renders a single priority recommendation item
 func (m *InsightsModel) renderPriorityItem(pick analysis.TopPick, width, height int, isSelected bool, t Theme) string {
 	itemStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		Width(width - 2).
 		Height(height).
 		Padding(0, 1)
@@ -1349,7 +1387,7 @@ func (m *InsightsModel) renderHeatmapPanel(width, height int, t Theme) string {
 	}
 
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(borderColor).
 		Width(width).
 		Height(height).
@@ -1985,7 +2023,7 @@ Navigate to a metric panel and select an item to view its details here.
 	// width padding. The border + content naturally determines the panel width.
 	// Height is safe to set for vertical space utilization.
 	panelStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Height(height).
 		Padding(0, 1)
@@ -1,7 +1,9 @@
 package ui_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
@@ -368,6 +370,32 @@ func TestInsightsModelViewRendering(t *testing.T) {
 	}
 }
 
+func TestInsightsModelForecastLine(t *testing.T) {
+	m := ui.NewInsightsModel(createTestInsights(), createTestIssueMap(), createTheme())
+	m.SetSize(160, 40)
+	if strings.Contains(m.View(), "Forecast") {
+		t.Fatal("forecast line should be absent until a forecast is set")
+	}
+
+	day := func(d int) *time.Time {
+		v := time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+	m.SetForecast(analysis.CompletionForecast{
+		Confidence: 0.8,
+		Milestones: []analysis.MilestoneCompletion{
+			{ID: "epic-1", Remaining: 3, Expected: day(10), Earliest: day(8), Latest: day(14)},
+			{ID: "epic-2", Remaining: 5, Reason: "no issues closed in the last 30 days"},
+		},
+	})
+	view := m.View()
+	for _, want := range []string{"Forecast (80%)", "epic-1 ~Mar 10 (Mar 8–Mar 14)", "epic-2 ?"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view missing %q", want)
+		}
+	}
+}
+
 // TestInsightsModelAllPanelsRender verifies all panel types render without panic
 func TestInsightsModelAllPanelsRender(t *testing.T) {
 	theme := createTheme()
@@ -0,0 +1,581 @@
+package ui
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Fields of the quick-add form, in tab order
+const (
+	createFieldTitle = iota
+	createFieldType
+	createFieldPriority
+	createFieldLabels
+	createFieldDeps
+	createFieldCount
+)
+
+// createTypes are the issue types the quick-add form cycles through
+var createTypes = []model.IssueType{
+	model.TypeTask,
+	model.TypeBug,
+	model.TypeFeature,
+	model.TypeChore,
+	model.TypeEpic,
+}
+
+// maxDepSuggestions is how many issues the dependency field suggests
+const maxDepSuggestions = 5
+
+// IssueCreatedMsg reports the result of appending a new issue to the beads
+// file
+type IssueCreatedMsg struct {
+	Issue model.Issue
+	Err   error
+}
+
+// IssueCreateModel is the Ctrl+N form for capturing a new issue: title,
+// type, priority, labels and the issues it depends on, the last completed
+// against the loaded IDs. The draft is kept when the form is closed and
+// cleared once the issue is written.
+type IssueCreateModel struct {
+	title     textinput.Model
+	issueType model.IssueType
+	priority  int
+	labels    textinput.Model
+	deps      textinput.Model
+	field     int
+	err       string
+
+	issues     []model.Issue
+	suggestion int
+	width      int
+	height     int
+	theme      Theme
+}
+
+// NewIssueCreateModel creates an empty quick-add form
+func NewIssueCreateModel(theme Theme) IssueCreateModel {
+	title := textinput.New()
+	title.Placeholder = "what needs doing"
+	title.CharLimit = 200
+
+	labels := textinput.New()
+	labels.Placeholder = "comma-separated"
+	labels.CharLimit = 300
+
+	deps := textinput.New()
+	deps.Placeholder = "issue IDs, tab completes"
+	deps.CharLimit = 300
+
+	m := IssueCreateModel{title: title, labels: labels, deps: deps, theme: theme}
+	m.Reset()
+	return m
+}
+
+// Reset clears the draft
+func (m *IssueCreateModel) Reset() {
+	m.title.SetValue("")
+	m.labels.SetValue("")
+	m.deps.SetValue("")
+	m.issueType = model.TypeTask
+	m.priority = 2
+	m.err = ""
+	m.suggestion = 0
+	m.field = createFieldTitle
+	m.focusField()
+}
+
+// SetSize updates the overlay dimensions
+func (m *IssueCreateModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// SetIssues sets the issues dependencies are completed against
+func (m *IssueCreateModel) SetIssues(issues []model.Issue) {
+	m.issues = issues
+}
+
+// SetError shows a problem with the draft in the form
+func (m *IssueCreateModel) SetError(err string) {
+	m.err = err
+}
+
+// Field returns the field being filled in
+func (m IssueCreateModel) Field() int {
+	return m.field
+}
+
+// NextField moves to the next field, wrapping around
+func (m *IssueCreateModel) NextField() {
+	m.field = (m.field + 1) % createFieldCount
+	m.focusField()
+}
+
+// PrevField moves to the previous field, wrapping around
+func (m *IssueCreateModel) PrevField() {
+	m.field = (m.field + createFieldCount - 1) % createFieldCount
+	m.focusField()
+}
+
+func (m *IssueCreateModel) focusField() {
+	m.title.Blur()
+	m.labels.Blur()
+	m.deps.Blur()
+	switch m.field {
+	case createFieldTitle:
+		m.title.Focus()
+		m.title.CursorEnd()
+	case createFieldLabels:
+		m.labels.Focus()
+		m.labels.CursorEnd()
+	case createFieldDeps:
+		m.deps.Focus()
+		m.deps.CursorEnd()
+	}
+}
+
+// OnChoice reports whether the field is picked with ←/→ rather than typed
+func (m IssueCreateModel) OnChoice() bool {
+	return m.field == createFieldType || m.field == createFieldPriority
+}
+
+// Cycle steps the type or priority field by delta
+func (m *IssueCreateModel) Cycle(delta int) {
+	switch m.field {
+	case createFieldType:
+		idx := 0
+		for i, t := range createTypes {
+			if t == m.issueType {
+				idx = i
+			}
+		}
+		n := len(createTypes)
+		m.issueType = createTypes[((idx+delta)%n+n)%n]
+	case createFieldPriority:
+		m.priority = min(max(m.priority+delta, 0), 4)
+	}
+}
+
+// Update handles a key the form doesn't bind: digits set the priority, the
+// text fields take anything else
+func (m *IssueCreateModel) Update(msg tea.KeyMsg) {
+	m.err = ""
+	switch m.field {
+	case createFieldTitle:
+		m.title, _ = m.title.Update(msg)
+	case createFieldPriority:
+		if s := msg.String(); len(s) == 1 && s[0] >= '0' && s[0] <= '4' {
+			m.priority = int(s[0] - '0')
+		}
+	case createFieldLabels:
+		m.labels, _ = m.labels.Update(msg)
+	case createFieldDeps:
+		m.deps, _ = m.deps.Update(msg)
+		m.suggestion = 0
+	}
+}
+
+// splitList splits a comma-separated field, trimming and deduplicating the
+// entries in the order typed
+func splitList(s string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" && !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// depToken returns the dependency being typed: whatever follows the last
+// comma
+func (m IssueCreateModel) depToken() string {
+	value := m.deps.Value()
+	return strings.TrimSpace(value[strings.LastIndex(value, ",")+1:])
+}
+
+// Suggestions returns the issues matching the dependency being typed:
+// IDs starting with it, then IDs containing it, then titles containing
+// it. Issues already listed are left out, and an exact ID suggests nothing.
+func (m IssueCreateModel) Suggestions() []model.Issue {
+	token := strings.ToLower(m.depToken())
+	if token == "" {
+		return nil
+	}
+	listed := make(map[string]bool)
+	for _, id := range splitList(m.deps.Value()) {
+		listed[strings.ToLower(id)] = true
+	}
+
+	var byPrefix, byID, byTitle []model.Issue
+	for _, issue := range m.issues {
+		id := strings.ToLower(issue.ID)
+		switch {
+		case id == token:
+			return nil
+		case listed[id]:
+		case strings.HasPrefix(id, token):
+			byPrefix = append(byPrefix, issue)
+		case strings.Contains(id, token):
+			byID = append(byID, issue)
+		case strings.Contains(strings.ToLower(issue.Title), token):
+			byTitle = append(byTitle, issue)
+		}
+	}
+	out := append(append(byPrefix, byID...), byTitle...)
+	if len(out) > maxDepSuggestions {
+		out = out[:maxDepSuggestions]
+	}
+	return out
+}
+
+// MoveSuggestion moves the highlighted suggestion by delta, wrapping around
+func (m *IssueCreateModel) MoveSuggestion(delta int) {
+	if n := len(m.Suggestions()); n > 0 {
+		m.suggestion = ((m.suggestion+delta)%n + n) % n
+	}
+}
+
+// Complete replaces the dependency being typed with the highlighted
+// suggestion. Returns false when there is nothing to complete.
+func (m *IssueCreateModel) Complete() bool {
+	suggestions := m.Suggestions()
+	if m.field != createFieldDeps || len(suggestions) == 0 {
+		return false
+	}
+	pick := suggestions[min(m.suggestion, len(suggestions)-1)]
+	value := m.deps.Value()
+	head := value[:strings.LastIndex(value, ",")+1]
+	if head != "" {
+		head += " "
+	}
+	m.deps.SetValue(head + pick.ID + ", ")
+	m.deps.CursorEnd()
+	m.suggestion = 0
+	return true
+}
+
+// Title returns the trimmed title
+func (m IssueCreateModel) Title() string {
+	return strings.TrimSpace(m.title.Value())
+}
+
+// Deps returns the IDs the new issue depends on
+func (m IssueCreateModel) Deps() []string {
+	return splitList(m.deps.Value())
+}
+
+// Issue returns the draft as an open issue with the given ID
+func (m IssueCreateModel) Issue(id string, now time.Time) model.Issue {
+	issue := model.Issue{
+		ID:        id,
+		Title:     m.Title(),
+		Status:    model.StatusOpen,
+		Priority:  m.priority,
+		IssueType: m.issueType,
+		Labels:    splitList(m.labels.Value()),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, dep := range m.Deps() {
+		issue.Dependencies = append(issue.Dependencies, &model.Dependency{
+			IssueID:     id,
+			DependsOnID: dep,
+			Type:        model.DepBlocks,
+			CreatedAt:   now,
+		})
+	}
+	return issue
+}
+
+// View renders the quick-add form
+func (m *IssueCreateModel) View() string {
+	if m.width == 0 {
+		m.width = 80
+	}
+	if m.height == 0 {
+		m.height = 24
+	}
+	t := m.theme
+
+	boxWidth := min(max(m.width-10, 40), 70)
+	inner := boxWidth - 6
+	labelW := 11
+
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	lines := []string{titleStyle.Render("New issue"), ""}
+
+	for _, in := range []*textinput.Model{&m.title, &m.labels, &m.deps} {
+		in.Width = max(inner-labelW-4, 10)
+	}
+	arrows := func(s string) string {
+		return glyph("‹", "<") + " " + s + " " + glyph("›", ">")
+	}
+	typeIcon, _ := t.GetTypeIcon(string(m.issueType))
+	values := [createFieldCount]string{
+		createFieldTitle:    m.title.View(),
+		createFieldType:     arrows(typeIcon + " " + string(m.issueType)),
+		createFieldPriority: arrows(GetPriorityIcon(m.priority) + " P" + itoa(m.priority)),
+		createFieldLabels:   m.labels.View(),
+		createFieldDeps:     m.deps.View(),
+	}
+	names := [createFieldCount]string{"Title", "Type", "Priority", "Labels", "Depends on"}
+	for f := 0; f < createFieldCount; f++ {
+		nameStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+		prefix := "  "
+		if f == m.field {
+			nameStyle = nameStyle.Foreground(t.Primary).Bold(true)
+			prefix = "> "
+		}
+		lines = append(lines, nameStyle.Render(prefix+padRight(names[f], labelW))+values[f])
+	}
+
+	if m.field == createFieldDeps {
+		subStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+		pickStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+		indent := strings.Repeat(" ", labelW+2)
+		for i, issue := range m.Suggestions() {
+			line := truncateRunesHelper(issue.ID+"  "+issue.Title, inner-labelW-2, "…")
+			if i == m.suggestion {
+				lines = append(lines, indent+pickStyle.Render(line))
+			} else {
+				lines = append(lines, indent+subStyle.Render(line))
+			}
+		}
+	}
+
+	if m.err != "" {
+		errStyle := t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true)
+		lines = append(lines, "", errStyle.Render(truncateRunesHelper(m.err, inner, "…")))
+	}
+
+	dimStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true)
+	hint := "tab/↑↓: field | enter: create | esc: close (keeps draft)"
+	switch {
+	case m.OnChoice():
+		hint = "tab/↑↓: field | ←/→: change | enter: create | esc: close"
+	case m.field == createFieldDeps:
+		hint = "tab: complete | ctrl+n/p: pick | enter: create | esc: close"
+	}
+	lines = append(lines, "", dimStyle.Render(hint))
+
+	box := t.Renderer.NewStyle().
+		Border(termBorder(lipgloss.RoundedBorder())).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// nextIssueID picks an ID for a new issue in the style of the loaded ones:
+// the most common prefix, numbered after the highest number in use, or a
+// short hash when the IDs aren't numbered. fallback is the prefix when no
+// issues are loaded.
+func nextIssueID(issues []model.Issue, fallback, seed string) string {
+	counts := make(map[string]int)
+	taken := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		taken[issue.ID] = true
+		if i := strings.LastIndex(issue.ID, "-"); i > 0 {
+			counts[issue.ID[:i]]++
+		}
+	}
+	prefixes := make([]string, 0, len(counts))
+	for p := range counts {
+		prefixes = append(prefixes, p)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		if counts[prefixes[i]] != counts[prefixes[j]] {
+			return counts[prefixes[i]] > counts[prefixes[j]]
+		}
+		return prefixes[i] < prefixes[j]
+	})
+	prefix := fallback
+	if len(prefixes) > 0 {
+		prefix = prefixes[0]
+	}
+
+	highest, numbered := 0, false
+	for id := range taken {
+		if n, err := strconv.Atoi(strings.TrimPrefix(id, prefix+"-")); err == nil && strings.HasPrefix(id, prefix+"-") {
+			highest, numbered = max(highest, n), true
+		}
+	}
+	if numbered {
+		return fmt.Sprintf("%s-%d", prefix, highest+1)
+	}
+
+	sum := sha1.Sum([]byte(seed))
+	hash := hex.EncodeToString(sum[:])
+	for n := 4; n < len(hash); n++ {
+		if id := prefix + "-" + hash[:n]; !taken[id] {
+			return id
+		}
+	}
+	return prefix + "-" + hash
+}
+
+// newIssuePrefix names new issues after the project holding the beads
+// file, the way bd does for a fresh database
+func newIssuePrefix(beadsPath string) string {
+	project := filepath.Base(filepath.Dir(filepath.Dir(beadsPath)))
+	if project == "." || project == string(filepath.Separator) || project == "" {
+		return "bd"
+	}
+	return strings.ToLower(project)
+}
+
+// openIssueCreate shows the quick-add form. New issues are appended to
+// the beads file, so there has to be exactly one.
+func (m Model) openIssueCreate() Model {
+	if m.focused == focusIssueCreate {
+		return m
+	}
+	if m.beadsPath == "" || m.workspaceMode {
+		m.statusMsg = "❌ Adding issues needs a single beads file (not available in workspace mode)"
+		m.statusIsError = true
+		return m
+	}
+	m.issueCreate.SetIssues(m.issues)
+	m.issueCreate.SetSize(m.width, m.height-1)
+	m.issueCreateFrom = m.focused
+	m.showIssueCreate = true
+	m.focused = focusIssueCreate
+	return m
+}
+
+// handleIssueCreateKeys handles keyboard input while the quick-add form is
+// open. Tab completes a dependency before it moves on.
+func (m Model) handleIssueCreateKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	onChoice := m.issueCreate.OnChoice()
+	switch key := msg.String(); {
+	case key == "esc":
+		m.closeIssueCreate()
+	case key == "tab":
+		if !m.issueCreate.Complete() {
+			m.issueCreate.NextField()
+		}
+	case key == "down":
+		m.issueCreate.NextField()
+	case key == "shift+tab" || key == "up":
+		m.issueCreate.PrevField()
+	case key == "ctrl+n":
+		m.issueCreate.MoveSuggestion(1)
+	case key == "ctrl+p":
+		m.issueCreate.MoveSuggestion(-1)
+	case onChoice && (key == "left" || key == "h"):
+		m.issueCreate.Cycle(-1)
+	case onChoice && (key == "right" || key == "l" || key == " "):
+		m.issueCreate.Cycle(1)
+	case key == "enter":
+		return m.saveIssueCreate()
+	default:
+		m.issueCreate.Update(msg)
+	}
+	return m, nil
+}
+
+func (m *Model) closeIssueCreate() {
+	m.showIssueCreate = false
+	m.focused = m.issueCreateFrom
+}
+
+// saveIssueCreate checks the draft, shows the new issue at once and
+// returns the command appending it to the beads file. Problems with the
+// draft keep the form open.
+func (m Model) saveIssueCreate() (Model, tea.Cmd) {
+	if m.issueCreate.Title() == "" {
+		m.issueCreate.SetError("A title is required")
+		return m, nil
+	}
+	for _, dep := range m.issueCreate.Deps() {
+		if _, ok := m.issueMap[dep]; !ok {
+			m.issueCreate.SetError("Unknown issue " + dep)
+			return m, nil
+		}
+	}
+	m.closeIssueCreate()
+
+	now := time.Now()
+	id := nextIssueID(m.issues, newIssuePrefix(m.beadsPath), m.issueCreate.Title()+now.String())
+	return m.createIssue(m.issueCreate.Issue(id, now))
+}
+
+// createIssue shows issue at once and returns the command appending it to
+// the beads file
+func (m Model) createIssue(issue model.Issue) (Model, tea.Cmd) {
+	m.addIssueLocally(issue)
+	m.statusMsg = "Creating " + issue.ID + "..."
+	m.statusIsError = false
+
+	path := m.beadsPath
+	return m, func() tea.Msg {
+		return IssueCreatedMsg{Issue: issue, Err: loader.AppendIssueToFile(path, issue)}
+	}
+}
+
+// handleIssueCreated toasts a new issue once it is written, or removes it
+// again when the write failed and offers to retry. The draft is kept then,
+// so Ctrl+N brings it back.
+func (m *Model) handleIssueCreated(msg IssueCreatedMsg) tea.Cmd {
+	if msg.Err != nil {
+		m.removeIssueLocally(msg.Issue.ID)
+		m.statusMsg = fmt.Sprintf("❌ Could not create %s: %v", msg.Issue.ID, msg.Err)
+		m.statusIsError = true
+		issue := msg.Issue
+		m.openModal(errorModal("Could not create "+issue.ID,
+			"The issue was not added to the beads file. Its draft is kept for Ctrl+N.",
+			msg.Err, func(m Model) (Model, tea.Cmd) {
+				if _, ok := m.issueMap[issue.ID]; ok {
+					m.statusMsg = fmt.Sprintf("❌ %s is taken now; create it again with Ctrl+N", issue.ID)
+					m.statusIsError = true
+					return m, nil
+				}
+				return m.createIssue(issue)
+			}))
+		return nil
+	}
+	m.issueCreate.Reset()
+	m.clearStatus("Creating " + msg.Issue.ID + "...")
+	return m.notify(toastSuccess, "Created "+msg.Issue.ID)
+}
+
+// addIssueLocally shows a new issue before the reload that follows the
+// write, selecting it in the list if the filters let it through
+func (m *Model) addIssueLocally(issue model.Issue) {
+	issues := make([]model.Issue, len(m.issues), len(m.issues)+1)
+	copy(issues, m.issues)
+	m.setIssuesLocally(append(issues, issue))
+	m.selectInList(issue.ID)
+	m.updateViewportContent()
+}
+
+// removeIssueLocally drops an issue whose creation failed
+func (m *Model) removeIssueLocally(id string) {
+	issues := make([]model.Issue, 0, len(m.issues))
+	for _, issue := range m.issues {
+		if issue.ID != id {
+			issues = append(issues, issue)
+		}
+	}
+	m.setIssuesLocally(issues)
+}
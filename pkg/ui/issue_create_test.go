@@ -0,0 +1,196 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestIssueCreateAppendsToFile(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, Labels: []string{"auth"}},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, Labels: []string{"ui", "theme"}},
+		{ID: "bv-3", Title: "Speed up sync", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask},
+		{ID: "login-4", Title: "Audit sessions", Status: model.StatusOpen, Priority: 3, IssueType: model.TypeTask},
+	}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlN})
+	if m.FocusState() != "issue_create" {
+		t.Fatalf("ctrl+n should open the quick-add form, focus=%s", m.FocusState())
+	}
+
+	keys := runeKeys("Retry failed syncs")
+	keys = append(keys, tea.KeyMsg{Type: tea.KeyTab}, tea.KeyMsg{Type: tea.KeyRight}) // bug
+	keys = append(keys, tea.KeyMsg{Type: tea.KeyTab})
+	keys = append(keys, runeKeys("1")...)
+	keys = append(keys, tea.KeyMsg{Type: tea.KeyTab})
+	keys = append(keys, runeKeys("sync, api")...)
+	keys = append(keys, tea.KeyMsg{Type: tea.KeyTab})
+	keys = append(keys, runeKeys("speed")...) // title match for bv-3
+	m, _ = pressEdit(m, keys...)
+	if view := m.View(); !strings.Contains(view, "New issue") || !strings.Contains(view, "bv-3  Speed up sync") {
+		t.Errorf("form should suggest bv-3 for 'speed':\n%s", view)
+	}
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = pressEdit(m, runeKeys("bv-")...)
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlN}, tea.KeyMsg{Type: tea.KeyTab}) // bv-1, bv-2: pick bv-2
+	if got := strings.Join(m.issueCreate.Deps(), ","); got != "bv-3,bv-2" {
+		t.Fatalf("completed deps = %q, want bv-3,bv-2", got)
+	}
+
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter should return the write command")
+	}
+	if m.FocusState() != "list" {
+		t.Errorf("enter should close the form, focus = %s", m.FocusState())
+	}
+	created, ok := m.issueMap["bv-4"]
+	if !ok {
+		t.Fatalf("new issue should show before the write finishes, IDs: %v", m.issueMap)
+	}
+	if item, ok := m.list.SelectedItem().(IssueItem); !ok || item.Issue.ID != "bv-4" {
+		t.Errorf("the new issue should be selected")
+	}
+	if created.IssueType != model.TypeBug || created.Priority != 1 || strings.Join(created.Labels, ",") != "sync,api" ||
+		len(created.Dependencies) != 2 || created.Dependencies[0].DependsOnID != "bv-3" {
+		t.Errorf("created issue = %+v", created)
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if toast := lastToast(m); toast.level != toastSuccess || toast.text != "Created bv-4" || m.statusMsg != "" {
+		t.Errorf("toast = %+v, status = %q", toast, m.statusMsg)
+	}
+	if m.issueCreate.Title() != "" {
+		t.Error("the draft should be cleared once written")
+	}
+	reloaded, err := loader.LoadIssuesFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := reloaded[len(reloaded)-1]; last.ID != "bv-4" || last.Title != "Retry failed syncs" {
+		t.Errorf("last issue in file = %s %q", last.ID, last.Title)
+	}
+}
+
+func TestIssueCreateValidatesDraft(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	m := NewModel(issues, nil, "")
+	m.beadsPath = writeTempBeadsFile(t, t.TempDir(), issues...)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlN})
+
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || m.FocusState() != "issue_create" || !strings.Contains(m.View(), "A title is required") {
+		t.Errorf("a draft without a title should stay open with an error, focus=%s", m.FocusState())
+	}
+
+	keys := runeKeys("Follow up")
+	for i := 0; i < createFieldDeps; i++ {
+		keys = append(keys, tea.KeyMsg{Type: tea.KeyDown})
+	}
+	keys = append(keys, runeKeys("bv-99")...)
+	m, _ = pressEdit(m, keys...)
+	m, cmd = pressEdit(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || !strings.Contains(m.View(), "Unknown issue bv-99") {
+		t.Errorf("an unknown dependency should be refused")
+	}
+
+	// Esc keeps the draft for next time
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyEsc})
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlN})
+	if m.issueCreate.Title() != "Follow up" {
+		t.Errorf("draft should survive esc, title = %q", m.issueCreate.Title())
+	}
+}
+
+func TestIssueCreateRevertsOnWriteFailure(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, Labels: []string{"auth"}},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, Labels: []string{"ui", "theme"}},
+		{ID: "bv-3", Title: "Speed up sync", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask},
+		{ID: "login-4", Title: "Audit sessions", Status: model.StatusOpen, Priority: 3, IssueType: model.TypeTask},
+	}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlN})
+	m, _ = pressEdit(m, runeKeys("Lost")...)
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if _, ok := m.issueMap["bv-4"]; !ok {
+		t.Fatal("issue should show before the write")
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if _, ok := m.issueMap["bv-4"]; ok {
+		t.Error("failed write should remove the issue")
+	}
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "Could not create bv-4") || m.issueCreate.Title() != "Lost" {
+		t.Errorf("status = %q, draft = %q", m.statusMsg, m.issueCreate.Title())
+	}
+	if m.FocusState() != "modal" || !strings.Contains(m.View(), "Could not create bv-4") {
+		t.Fatalf("failed write should open an error modal, focus=%s", m.FocusState())
+	}
+
+	// r retries the same issue once the file is back
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m, cmd = pressEdit(m, runeKeys("r")...)
+	if cmd == nil || m.FocusState() != "list" {
+		t.Fatalf("r should close the modal and retry, focus=%s", m.FocusState())
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if _, ok := m.issueMap["bv-4"]; !ok || lastToast(m).text != "Created bv-4" {
+		t.Errorf("retry should create bv-4, toast %+v", lastToast(m))
+	}
+
+	// Without a beads file there is nothing to append to
+	m.beadsPath = ""
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlN})
+	if m.FocusState() == "issue_create" || !m.statusIsError {
+		t.Errorf("quick-add without a beads file should fail with a message")
+	}
+}
+
+func TestNextIssueID(t *testing.T) {
+	issues := func(ids ...string) []model.Issue {
+		var out []model.Issue
+		for _, id := range ids {
+			out = append(out, model.Issue{ID: id})
+		}
+		return out
+	}
+	if got := nextIssueID(issues("bv-1", "bv-12", "bv-3", "login-4"), "bd", "x"); got != "bv-13" {
+		t.Errorf("numbered IDs: got %s, want bv-13", got)
+	}
+	if got := nextIssueID(nil, "proj", "x"); got != "proj-"+got[len("proj-"):] || len(got) != len("proj-")+4 {
+		t.Errorf("no issues: got %s, want proj- and a 4-character hash", got)
+	}
+	got := nextIssueID(issues("bd-a1f3", "bd-9c2e"), "x", "seed")
+	if !strings.HasPrefix(got, "bd-") || got == "bd-a1f3" || got == "bd-9c2e" {
+		t.Errorf("hashed IDs: got %s", got)
+	}
+	if got := newIssuePrefix("/work/Beads_Viewer/.beads/issues.jsonl"); got != "beads_viewer" {
+		t.Errorf("prefix from path = %s", got)
+	}
+}
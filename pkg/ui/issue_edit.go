@@ -0,0 +1,637 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Fields of the edit form, in tab order
+const (
+	editFieldStatus = iota
+	editFieldPriority
+	editFieldAssignee
+	editFieldLabels
+	editFieldCount
+)
+
+// editStatuses are the statuses the edit form cycles through. Tombstones
+// are left to bd, since deleting is not an edit.
+var editStatuses = []model.Status{
+	model.StatusOpen,
+	model.StatusInProgress,
+	model.StatusBlocked,
+	model.StatusReview,
+	model.StatusDeferred,
+	model.StatusPinned,
+	model.StatusHooked,
+	model.StatusClosed,
+}
+
+// IssueEditSavedMsg reports the result of writing an edit, or undoing or
+// redoing one, to the beads file. Prev holds the issues as they were
+// before the write, restored on failure.
+type IssueEditSavedMsg struct {
+	IDs    []string
+	Prev   []model.Issue
+	Err    error
+	op     editOp
+	record editRecord
+}
+
+// IssueEditModel is the "e" form for changing the status, priority,
+// assignee and labels of one issue. In bulk mode it edits the marked
+// issues instead: status and priority start out unchanged and labels are
+// added to each issue's own.
+type IssueEditModel struct {
+	issue    model.Issue
+	bulk     []string
+	status   model.Status
+	priority int
+	assignee textinput.Model
+	labels   textinput.Model
+	field    int
+	width    int
+	height   int
+	theme    Theme
+}
+
+// NewIssueEditModel creates an empty edit form
+func NewIssueEditModel(theme Theme) IssueEditModel {
+	assignee := textinput.New()
+	assignee.Placeholder = "unassigned"
+	assignee.CharLimit = 100
+	assignee.Width = 30
+
+	labels := textinput.New()
+	labels.Placeholder = "comma-separated"
+	labels.CharLimit = 300
+	labels.Width = 40
+
+	return IssueEditModel{assignee: assignee, labels: labels, theme: theme}
+}
+
+// SetSize updates the overlay dimensions
+func (m *IssueEditModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// SetIssue fills the form with the issue's current values
+func (m *IssueEditModel) SetIssue(issue model.Issue) {
+	m.issue = issue
+	m.bulk = nil
+	m.status = issue.Status
+	m.priority = issue.Priority
+	m.assignee.SetValue(issue.Assignee)
+	m.labels.SetValue(strings.Join(issue.Labels, ", "))
+	m.labels.Placeholder = "comma-separated"
+	m.field = editFieldStatus
+	m.focusField()
+}
+
+// SetBulk empties the form for editing several issues at once
+func (m *IssueEditModel) SetBulk(ids []string) {
+	m.issue = model.Issue{}
+	m.bulk = ids
+	m.status = ""
+	m.priority = -1
+	m.assignee.SetValue("")
+	m.labels.SetValue("")
+	m.labels.Placeholder = "labels to add"
+	m.field = editFieldStatus
+	m.focusField()
+}
+
+// IssueID returns the ID of the issue being edited, or "" in bulk mode
+func (m IssueEditModel) IssueID() string {
+	return m.issue.ID
+}
+
+// IssueIDs returns the IDs of every issue the form edits
+func (m IssueEditModel) IssueIDs() []string {
+	if m.bulk != nil {
+		return m.bulk
+	}
+	return []string{m.issue.ID}
+}
+
+// IsBulk reports whether the form edits the marked issues
+func (m IssueEditModel) IsBulk() bool {
+	return m.bulk != nil
+}
+
+// Field returns the field being edited
+func (m IssueEditModel) Field() int {
+	return m.field
+}
+
+// NextField moves to the next field, wrapping around
+func (m *IssueEditModel) NextField() {
+	m.field = (m.field + 1) % editFieldCount
+	if m.bulk != nil && m.field == editFieldAssignee {
+		m.field++ // One assignee for many issues is rarely wanted
+	}
+	m.focusField()
+}
+
+// PrevField moves to the previous field, wrapping around
+func (m *IssueEditModel) PrevField() {
+	m.field = (m.field + editFieldCount - 1) % editFieldCount
+	if m.bulk != nil && m.field == editFieldAssignee {
+		m.field--
+	}
+	m.focusField()
+}
+
+func (m *IssueEditModel) focusField() {
+	m.assignee.Blur()
+	m.labels.Blur()
+	switch m.field {
+	case editFieldAssignee:
+		m.assignee.Focus()
+		m.assignee.CursorEnd()
+	case editFieldLabels:
+		m.labels.Focus()
+		m.labels.CursorEnd()
+	}
+}
+
+// Cycle steps the status or priority field by delta
+func (m *IssueEditModel) Cycle(delta int) {
+	switch m.field {
+	case editFieldStatus:
+		choices := editStatuses
+		if m.bulk != nil {
+			choices = append([]model.Status{""}, editStatuses...) // "" leaves it unchanged
+		}
+		idx := -1
+		for i, s := range choices {
+			if s == m.status {
+				idx = i
+			}
+		}
+		if idx < 0 && delta < 0 {
+			idx = 0 // An unlisted status steps back to the last one
+		}
+		n := len(choices)
+		m.status = choices[((idx+delta)%n+n)%n]
+	case editFieldPriority:
+		lowest := 0
+		if m.bulk != nil {
+			lowest = -1 // Unchanged
+		}
+		m.priority = min(max(m.priority+delta, lowest), 4)
+	}
+}
+
+// Update handles a key the form doesn't bind: digits set the priority, the
+// text fields take anything else
+func (m *IssueEditModel) Update(msg tea.KeyMsg) {
+	switch m.field {
+	case editFieldPriority:
+		if s := msg.String(); len(s) == 1 && s[0] >= '0' && s[0] <= '4' {
+			m.priority = int(s[0] - '0')
+		}
+	case editFieldAssignee:
+		m.assignee, _ = m.assignee.Update(msg)
+	case editFieldLabels:
+		m.labels, _ = m.labels.Update(msg)
+	}
+}
+
+// Edit returns the form's values. Labels are split at commas, trimmed and
+// deduplicated in the order typed.
+func (m IssueEditModel) Edit() loader.IssueEdit {
+	var labels []string
+	seen := make(map[string]bool)
+	for _, l := range strings.Split(m.labels.Value(), ",") {
+		l = strings.TrimSpace(l)
+		if l != "" && !seen[l] {
+			seen[l] = true
+			labels = append(labels, l)
+		}
+	}
+	return loader.IssueEdit{
+		Status:   m.status,
+		Priority: m.priority,
+		Assignee: strings.TrimSpace(m.assignee.Value()),
+		Labels:   labels,
+	}
+}
+
+// EditFor returns the edit to write for one of the issues in the form. In
+// bulk mode unchanged fields keep the issue's values and the typed labels
+// are appended to its own.
+func (m IssueEditModel) EditFor(issue model.Issue) loader.IssueEdit {
+	e := m.Edit()
+	if m.bulk == nil {
+		return e
+	}
+	bulk := loader.IssueEdit{
+		Status:   issue.Status,
+		Priority: issue.Priority,
+		Assignee: issue.Assignee,
+		Labels:   append([]string(nil), issue.Labels...),
+	}
+	if e.Status != "" {
+		bulk.Status = e.Status
+	}
+	if e.Priority >= 0 {
+		bulk.Priority = e.Priority
+	}
+	have := make(map[string]bool, len(bulk.Labels))
+	for _, l := range bulk.Labels {
+		have[l] = true
+	}
+	for _, l := range e.Labels {
+		if !have[l] {
+			have[l] = true
+			bulk.Labels = append(bulk.Labels, l)
+		}
+	}
+	return bulk
+}
+
+// Changed reports whether the form differs from the issue, or in bulk
+// mode whether it changes anything at all
+func (m IssueEditModel) Changed() bool {
+	e := m.Edit()
+	if m.bulk != nil {
+		return e.Status != "" || e.Priority >= 0 || len(e.Labels) > 0
+	}
+	return e.Status != m.issue.Status || e.Priority != m.issue.Priority ||
+		e.Assignee != m.issue.Assignee || strings.Join(e.Labels, "\x00") != strings.Join(m.issue.Labels, "\x00")
+}
+
+// View renders the edit form
+func (m *IssueEditModel) View() string {
+	if m.width == 0 {
+		m.width = 80
+	}
+	if m.height == 0 {
+		m.height = 24
+	}
+	t := m.theme
+
+	boxWidth := min(max(m.width-10, 40), 70)
+	inner := boxWidth - 6
+
+	var lines []string
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	subStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	if m.bulk != nil {
+		lines = append(lines,
+			titleStyle.Render(fmt.Sprintf("Edit %d issues", len(m.bulk))),
+			subStyle.Render(truncateRunesHelper(strings.Join(m.bulk, ", "), inner, "…")),
+			"")
+	} else {
+		lines = append(lines,
+			titleStyle.Render("Edit "+m.issue.ID),
+			subStyle.Render(truncateRunesHelper(m.issue.Title, inner, "…")),
+			"")
+	}
+
+	labelW := 11
+	m.assignee.Width = max(inner-labelW-4, 10)
+	m.labels.Width = max(inner-labelW-4, 10)
+	arrows := func(s string) string {
+		return glyph("‹", "<") + " " + s + " " + glyph("›", ">")
+	}
+	values := [editFieldCount]string{
+		editFieldStatus:   arrows(GetStatusIcon(string(m.status)) + " " + string(m.status)),
+		editFieldPriority: arrows(GetPriorityIcon(m.priority) + " P" + itoa(m.priority)),
+		editFieldAssignee: m.assignee.View(),
+		editFieldLabels:   m.labels.View(),
+	}
+	names := [editFieldCount]string{"Status", "Priority", "Assignee", "Labels"}
+	if m.bulk != nil {
+		if m.status == "" {
+			values[editFieldStatus] = arrows("unchanged")
+		}
+		if m.priority < 0 {
+			values[editFieldPriority] = arrows("unchanged")
+		}
+		names[editFieldLabels] = "Add labels"
+	}
+	for f := 0; f < editFieldCount; f++ {
+		if m.bulk != nil && f == editFieldAssignee {
+			continue
+		}
+		nameStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+		prefix := "  "
+		if f == m.field {
+			nameStyle = nameStyle.Foreground(t.Primary).Bold(true)
+			prefix = "> "
+		}
+		lines = append(lines, nameStyle.Render(prefix+padRight(names[f], labelW))+values[f])
+	}
+
+	dimStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true)
+	hint := "tab/↑↓: field | ←/→: change | enter: save | esc: cancel"
+	if m.field == editFieldAssignee || m.field == editFieldLabels {
+		hint = "tab/↑↓: field | enter: save | esc: cancel"
+	}
+	lines = append(lines, "", dimStyle.Render(hint))
+
+	box := t.Renderer.NewStyle().
+		Border(termBorder(lipgloss.RoundedBorder())).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// selectedIssueID returns the issue selected in the current view
+func (m Model) selectedIssueID() string {
+	var issue *model.Issue
+	switch m.focused {
+	case focusBoard:
+		issue = m.board.SelectedIssue()
+	case focusGraph:
+		issue = m.graphView.SelectedIssue()
+	case focusTree:
+		issue = m.tree.SelectedIssue()
+	case focusActionable:
+		return m.actionableView.SelectedIssueID()
+	case focusList, focusDetail:
+		if m.focused == focusDetail && m.splitRightIsGraph() {
+			issue = m.graphView.SelectedIssue()
+		} else if item, ok := m.list.SelectedItem().(IssueItem); ok {
+			return item.Issue.ID
+		}
+	}
+	if issue == nil {
+		return ""
+	}
+	return issue.ID
+}
+
+// openIssueEdit shows the edit form for the issue selected in the current
+// view, or for the marked issues when the view has marks. Edits are
+// written to the beads file, so there has to be exactly one.
+func (m Model) openIssueEdit() Model {
+	if m.focused == focusIssueEdit {
+		return m
+	}
+	if m.beadsPath == "" || m.workspaceMode {
+		m.statusMsg = "❌ Editing needs a single beads file (not available in workspace mode)"
+		m.statusIsError = true
+		return m
+	}
+	if ids := m.markedIDs(); len(ids) > 0 && m.inListScope() {
+		m.issueEdit.SetBulk(ids)
+	} else if issue, ok := m.issueMap[m.selectedIssueID()]; ok {
+		m.issueEdit.SetIssue(issue.Clone())
+	} else {
+		m.statusMsg = "❌ No issue selected"
+		m.statusIsError = true
+		return m
+	}
+	m.issueEdit.SetSize(m.width, m.height-1)
+	m.issueEditFrom = m.focused
+	m.showIssueEdit = true
+	m.focused = focusIssueEdit
+	return m
+}
+
+// handleIssueEditKeys handles keyboard input while the edit form is open.
+// Enter applies the edit at once and writes it in the background.
+func (m Model) handleIssueEditKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	onChoice := m.issueEdit.Field() == editFieldStatus || m.issueEdit.Field() == editFieldPriority
+	switch key := msg.String(); {
+	case key == "esc":
+		m.closeIssueEdit()
+	case key == "tab" || key == "down":
+		m.issueEdit.NextField()
+	case key == "shift+tab" || key == "up":
+		m.issueEdit.PrevField()
+	case onChoice && (key == "left" || key == "h"):
+		m.issueEdit.Cycle(-1)
+	case onChoice && (key == "right" || key == "l" || key == " "):
+		m.issueEdit.Cycle(1)
+	case key == "enter":
+		m.closeIssueEdit()
+		return m.saveIssueEdit()
+	default:
+		m.issueEdit.Update(msg)
+	}
+	return m, nil
+}
+
+func (m *Model) closeIssueEdit() {
+	m.showIssueEdit = false
+	m.focused = m.issueEditFrom
+}
+
+// saveIssueEdit applies the form's edit and records it for undo
+func (m Model) saveIssueEdit() (Model, tea.Cmd) {
+	ids := m.issueEdit.IssueIDs()
+	for _, id := range ids {
+		if _, ok := m.issueMap[id]; !ok {
+			m.statusMsg = fmt.Sprintf("❌ %s is no longer loaded", id)
+			m.statusIsError = true
+			return m, nil
+		}
+	}
+	if !m.issueEdit.Changed() {
+		m.statusMsg = "No changes to " + editTarget(ids)
+		m.statusIsError = false
+		return m, nil
+	}
+
+	record := editRecord{
+		IDs:    ids,
+		Before: make(map[string]loader.IssueEdit, len(ids)),
+		After:  make(map[string]loader.IssueEdit, len(ids)),
+	}
+	var closing []string
+	for _, id := range ids {
+		issue := m.issueMap[id]
+		record.Before[id] = editOf(*issue)
+		record.After[id] = m.issueEdit.EditFor(*issue)
+		if record.After[id].Status.IsClosed() && !issue.Status.IsClosed() {
+			closing = append(closing, id)
+		}
+	}
+
+	bulk := m.issueEdit.IsBulk()
+	write := func(m Model) (Model, tea.Cmd) {
+		for _, id := range ids {
+			if _, ok := m.issueMap[id]; !ok {
+				m.statusMsg = fmt.Sprintf("❌ %s is no longer loaded", id)
+				m.statusIsError = true
+				return m, nil
+			}
+		}
+		if bulk {
+			m.clearMarks()
+		}
+		return m.writeEdits(record.After, editOpSave, record)
+	}
+	// Closing several issues at once is easy to do by mistake
+	if bulk && len(closing) > 1 {
+		m.openModal(confirmModal(
+			fmt.Sprintf("Close %d issues?", len(closing)),
+			formatRepoList(closing, 5)+" will be closed. u undoes it afterwards.",
+			"close them", write))
+		return m, nil
+	}
+	return write(m)
+}
+
+// writeEdits shows edited issues right away and returns the command
+// writing them to the beads file; IssueEditSavedMsg undoes them if that
+// fails. Every issue in edits must be loaded.
+func (m Model) writeEdits(edits map[string]loader.IssueEdit, op editOp, record editRecord) (Model, tea.Cmd) {
+	now := time.Now()
+	ids := record.IDs
+	prev := make([]model.Issue, 0, len(ids))
+	updated := make([]model.Issue, 0, len(ids))
+	for _, id := range ids {
+		issue := m.issueMap[id]
+		prev = append(prev, issue.Clone())
+		updated = append(updated, editedIssue(*issue, edits[id], now))
+	}
+	m.replaceIssueLocally(updated...)
+	m.statusMsg = op.progress() + " " + editTarget(ids) + "..."
+	m.statusIsError = false
+
+	path := m.beadsPath
+	return m, func() tea.Msg {
+		err := loader.UpdateIssuesInFile(path, edits, now)
+		return IssueEditSavedMsg{IDs: ids, Prev: prev, Err: err, op: op, record: record}
+	}
+}
+
+// editedIssue returns a copy of issue with edit applied the way the loader
+// writes it
+func editedIssue(issue model.Issue, edit loader.IssueEdit, now time.Time) model.Issue {
+	updated := issue.Clone()
+	updated.Status = edit.Status
+	updated.Priority = edit.Priority
+	updated.Assignee = edit.Assignee
+	updated.Labels = edit.Labels
+	updated.UpdatedAt = now
+	if edit.Status.IsClosed() && updated.ClosedAt == nil {
+		updated.ClosedAt = &now
+	} else if !edit.Status.IsClosed() {
+		updated.ClosedAt = nil
+	}
+	return updated
+}
+
+// editTarget names the edited issues in status messages
+func editTarget(ids []string) string {
+	if len(ids) == 1 {
+		return ids[0]
+	}
+	return fmt.Sprintf("%d issues", len(ids))
+}
+
+// handleIssueEditSaved moves a write's record along the undo history and
+// toasts its success, or restores the issues when the write failed
+func (m *Model) handleIssueEditSaved(msg IssueEditSavedMsg) tea.Cmd {
+	if msg.Err != nil {
+		m.replaceIssueLocally(msg.Prev...)
+		m.editHistory.restore(msg.op, msg.record)
+		m.statusMsg = fmt.Sprintf("❌ Could not %s %s: %v", msg.op.verb(), editTarget(msg.IDs), msg.Err)
+		m.statusIsError = true
+		// A failed undo or redo stays on its stack, so u or Ctrl+R retries
+		// it; a new edit would be lost without a way to write it again
+		if msg.op == editOpSave {
+			record := msg.record
+			m.openModal(errorModal("Could not save "+editTarget(msg.IDs),
+				"The edit was undone here and the beads file was left as it was.",
+				msg.Err, func(m Model) (Model, tea.Cmd) {
+					for _, id := range record.IDs {
+						if _, ok := m.issueMap[id]; !ok {
+							m.statusMsg = fmt.Sprintf("❌ %s is no longer loaded", id)
+							m.statusIsError = true
+							return m, nil
+						}
+					}
+					return m.writeEdits(record.After, editOpSave, record)
+				}))
+		}
+		return nil
+	}
+	m.editHistory.commit(msg.op, msg.record)
+	m.clearStatus(msg.op.progress() + " " + editTarget(msg.IDs) + "...")
+	text := msg.op.done() + " " + editTarget(msg.IDs)
+	if m.editHistory.CanUndo() && msg.op != editOpUndo {
+		text += " (u to undo)"
+	}
+	return m.notify(toastSuccess, text)
+}
+
+// replaceIssueLocally swaps in changed copies of issues and refreshes the
+// views showing them. The issues slice is copied first, since it may be
+// shared with the snapshot the background worker built. The reload that
+// follows the write brings analysis and counts up to date.
+func (m *Model) replaceIssueLocally(changed ...model.Issue) {
+	byID := make(map[string]model.Issue, len(changed))
+	for _, issue := range changed {
+		byID[issue.ID] = issue
+	}
+	issues := make([]model.Issue, len(m.issues))
+	copy(issues, m.issues)
+	for i := range issues {
+		if issue, ok := byID[issues[i].ID]; ok {
+			issues[i] = issue
+		}
+	}
+	m.setIssuesLocally(issues)
+}
+
+// setIssuesLocally installs issues, a fresh slice, in place of the loaded
+// ones and refreshes the views showing them, keeping their selections
+func (m *Model) setIssuesLocally(issues []model.Issue) {
+	m.issues = issues
+	m.issueMap = make(map[string]*model.Issue, len(issues))
+	for i := range m.issues {
+		m.issueMap[m.issues[i].ID] = &m.issues[i]
+	}
+	m.reindexIssues()
+
+	listID := ""
+	if item, ok := m.list.SelectedItem().(IssueItem); ok {
+		listID = item.Issue.ID
+	}
+	boardID := ""
+	if selected := m.board.SelectedIssue(); selected != nil {
+		boardID = selected.ID
+	}
+
+	if m.activeRecipe != nil {
+		m.applyRecipe(m.activeRecipe)
+	} else {
+		m.applyFilter()
+	}
+	// The snapshot's precomputed board predates the edit
+	m.board.SetIssues(m.FilteredIssues())
+
+	if listID != "" {
+		m.selectInList(listID)
+	}
+	if boardID != "" {
+		m.board.SelectIssueByID(boardID)
+	}
+	if m.focused == focusActionable || m.splitLeftIsActionable() {
+		actionableID := m.actionableView.SelectedIssueID()
+		m.buildActionableView()
+		m.actionableView.SelectByID(actionableID)
+	}
+	if m.focused == focusTree {
+		m.buildTreeView()
+	}
+	m.updateViewportContent()
+}
@@ -0,0 +1,146 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func pressEdit(m Model, keys ...tea.KeyMsg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		var updated tea.Model
+		updated, cmd = m.Update(k)
+		m = updated.(Model)
+	}
+	return m, cmd
+}
+
+func runeKeys(s string) []tea.KeyMsg {
+	var keys []tea.KeyMsg
+	for _, r := range s {
+		keys = append(keys, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return keys
+}
+
+func TestIssueEditSavesToFile(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, Labels: []string{"auth"}},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, Labels: []string{"ui", "theme"}},
+	}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	m.list.Select(1) // bv-2 "Dark mode", labels ui, theme
+
+	m, _ = pressEdit(m, runeKeys("e")...)
+	if m.FocusState() != "issue_edit" || m.issueEdit.IssueID() != "bv-2" {
+		t.Fatalf("e should edit the selected issue, focus=%s id=%s", m.FocusState(), m.issueEdit.IssueID())
+	}
+	if view := m.View(); !strings.Contains(view, "Edit bv-2") || !strings.Contains(view, "ui, theme") {
+		t.Errorf("form should show the issue's values:\n%s", view)
+	}
+
+	keys := []tea.KeyMsg{{Type: tea.KeyRight}, {Type: tea.KeyTab}}
+	keys = append(keys, runeKeys("0")...)
+	keys = append(keys, tea.KeyMsg{Type: tea.KeyTab})
+	keys = append(keys, runeKeys("carol")...)
+	keys = append(keys, tea.KeyMsg{Type: tea.KeyTab})
+	keys = append(keys, runeKeys(", api, ui")...)
+	m, _ = pressEdit(m, keys...)
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter should return the save command")
+	}
+
+	// The edit shows before the write finishes
+	want := func(issue *model.Issue) {
+		t.Helper()
+		if issue.Status != model.StatusInProgress || issue.Priority != 0 || issue.Assignee != "carol" ||
+			strings.Join(issue.Labels, ",") != "ui,theme,api" {
+			t.Errorf("issue = status %s, p%d, assignee %q, labels %v", issue.Status, issue.Priority, issue.Assignee, issue.Labels)
+		}
+	}
+	if m.FocusState() != "list" {
+		t.Errorf("enter should close the form, focus = %s", m.FocusState())
+	}
+	want(m.issueMap["bv-2"])
+	if item := m.list.SelectedItem().(IssueItem); item.Issue.ID != "bv-2" || item.Issue.Assignee != "carol" {
+		t.Errorf("list should keep bv-2 selected with the edit, got %s %q", item.Issue.ID, item.Issue.Assignee)
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if toast := lastToast(m); toast.level != toastSuccess || !strings.Contains(toast.text, "Saved bv-2") || m.statusMsg != "" {
+		t.Errorf("toast = %+v, status = %q", toast, m.statusMsg)
+	}
+	reloaded, err := loader.LoadIssuesFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded) != len(m.issues) {
+		t.Fatalf("reloaded %d issues, want %d", len(reloaded), len(m.issues))
+	}
+	for i := range reloaded {
+		if reloaded[i].ID == "bv-2" {
+			want(&reloaded[i])
+		}
+	}
+}
+
+func TestIssueEditRevertsOnWriteFailure(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+
+	m, _ = pressEdit(m, runeKeys("e")...)
+	id := m.issueEdit.IssueID()
+	before := m.issueMap[id].Status
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyLeft}, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.issueMap[id].Status == before {
+		t.Fatal("edit should apply before the write")
+	}
+
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if m.issueMap[id].Status != before {
+		t.Errorf("failed write should restore status %s, got %s", before, m.issueMap[id].Status)
+	}
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "Could not save "+id) {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+}
+
+func TestIssueEditCancelAndUnavailable(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	m := NewModel(issues, nil, "")
+	m.beadsPath = writeTempBeadsFile(t, t.TempDir(), issues...)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	m, _ = pressEdit(m, runeKeys("e")...)
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyRight}, tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd != nil || m.FocusState() != "list" || m.issueMap["bv-1"].Status != model.StatusOpen {
+		t.Errorf("esc should discard the edit, focus=%s", m.FocusState())
+	}
+
+	// Without a beads file there is nothing to write to
+	m.beadsPath = ""
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlE})
+	if m.FocusState() != "list" || !m.statusIsError {
+		t.Errorf("edit without a beads file should fail with a message, focus=%s status=%q", m.FocusState(), m.statusMsg)
+	}
+}
@@ -0,0 +1,438 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+// issueSearchLimit caps the ranked results kept for display
+const issueSearchLimit = 50
+
+// Searched fields, in ranking order: a match in an ID beats one in a title,
+// which beats labels, which beat the description. IDs, titles and labels
+// match fuzzily; descriptions need the query as a substring.
+const (
+	searchFieldID = iota
+	searchFieldTitle
+	searchFieldLabels
+	searchFieldDescription
+	searchFieldCount
+)
+
+// searchFieldBonus is added to a fuzzy score by field
+var searchFieldBonus = [searchFieldCount]int{60, 40, 20, 0}
+
+var searchFieldNames = [searchFieldCount]string{"id", "title", "labels", "description"}
+
+// IssueSearchResult is one ranked match: the issue, the field that matched
+// best and the byte offsets of the matched characters in that field
+type IssueSearchResult struct {
+	Issue   *model.Issue
+	Field   int
+	Text    string // the matched field's text
+	Matched []int
+	Score   int
+}
+
+// IssueSearchModel is the "/" (ctrl+f) search overlay: it matches the query
+// against every issue's ID, title, labels and description and lists the best
+// matches with the matched characters highlighted.
+type IssueSearchModel struct {
+	issues        []model.Issue
+	fields        [searchFieldCount][]string // per field, one entry per issue
+	results       []IssueSearchResult
+	input         textinput.Model
+	selectedIndex int
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewIssueSearchModel creates an empty search overlay
+func NewIssueSearchModel(theme Theme) IssueSearchModel {
+	ti := textinput.New()
+	ti.Placeholder = "id, title, label or description..."
+	ti.CharLimit = 100
+	ti.Width = 40
+	ti.Focus()
+	return IssueSearchModel{input: ti, theme: theme}
+}
+
+// SetSize updates the overlay dimensions
+func (m *IssueSearchModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// SetIssues replaces the searchable issues and re-runs the query
+func (m *IssueSearchModel) SetIssues(issues []model.Issue) {
+	m.issues = issues
+	for f := range m.fields {
+		m.fields[f] = make([]string, len(issues))
+	}
+	for i, issue := range issues {
+		m.fields[searchFieldID][i] = issue.ID
+		m.fields[searchFieldTitle][i] = issue.Title
+		m.fields[searchFieldLabels][i] = strings.Join(issue.Labels, " ")
+		m.fields[searchFieldDescription][i] = strings.Join(strings.Fields(issue.Description), " ")
+	}
+	m.search()
+}
+
+// Reset clears the query
+func (m *IssueSearchModel) Reset() {
+	m.input.SetValue("")
+	m.search()
+}
+
+// SetQuery replaces the query
+func (m *IssueSearchModel) SetQuery(query string) {
+	m.input.SetValue(query)
+	m.search()
+}
+
+// Query returns the current query
+func (m *IssueSearchModel) Query() string {
+	return m.input.Value()
+}
+
+// UpdateInput processes a key message for the text input
+func (m *IssueSearchModel) UpdateInput(msg interface{}) {
+	before := m.input.Value()
+	m.input, _ = m.input.Update(msg)
+	if m.input.Value() != before {
+		m.search()
+	}
+}
+
+// Results returns the ranked matches, best first
+func (m *IssueSearchModel) Results() []IssueSearchResult {
+	return m.results
+}
+
+// MoveUp moves selection up
+func (m *IssueSearchModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves selection down
+func (m *IssueSearchModel) MoveDown() {
+	if m.selectedIndex < len(m.results)-1 {
+		m.selectedIndex++
+	}
+}
+
+// SelectedIssueID returns the ID of the selected match
+func (m *IssueSearchModel) SelectedIssueID() string {
+	if m.selectedIndex >= len(m.results) {
+		return ""
+	}
+	return m.results[m.selectedIndex].Issue.ID
+}
+
+// search ranks issues by their best-matching field. Ties go to the higher
+// priority issue, then the smaller ID.
+func (m *IssueSearchModel) search() {
+	m.results = nil
+	m.selectedIndex = 0
+	query := strings.TrimSpace(m.input.Value())
+	if query == "" {
+		return
+	}
+
+	best := make(map[int]IssueSearchResult)
+	keep := func(f int, i int, matched []int, score int) {
+		score += searchFieldBonus[f]
+		if prev, ok := best[i]; ok && prev.Score >= score {
+			return
+		}
+		best[i] = IssueSearchResult{
+			Issue:   &m.issues[i],
+			Field:   f,
+			Text:    m.fields[f][i],
+			Matched: matched,
+			Score:   score,
+		}
+	}
+	for f := searchFieldID; f < searchFieldDescription; f++ {
+		for _, match := range fuzzy.FindNoSort(query, m.fields[f]) {
+			keep(f, match.Index, append([]int(nil), match.MatchedIndexes...), match.Score)
+		}
+	}
+	// Long descriptions contain nearly any scattered subsequence, so they
+	// only match the query as written (ignoring case)
+	lowerQuery := strings.ToLower(query)
+	for i, text := range m.fields[searchFieldDescription] {
+		at := strings.Index(strings.ToLower(text), lowerQuery)
+		// Offsets found in the lowered text must line up with the original
+		if at < 0 || len(text) != len(strings.ToLower(text)) {
+			continue
+		}
+		matched := make([]int, len(lowerQuery))
+		for j := range matched {
+			matched[j] = at + j
+		}
+		keep(searchFieldDescription, i, matched, 5*len(lowerQuery))
+	}
+	for _, r := range best {
+		m.results = append(m.results, r)
+	}
+	sort.Slice(m.results, func(i, j int) bool {
+		a, b := m.results[i], m.results[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Issue.Priority != b.Issue.Priority {
+			return a.Issue.Priority < b.Issue.Priority
+		}
+		return a.Issue.ID < b.Issue.ID
+	})
+	if len(m.results) > issueSearchLimit {
+		m.results = m.results[:issueSearchLimit]
+	}
+}
+
+// highlightMatches renders s with the bytes at matched offsets in hl and the
+// rest in base, showing at most maxRunes runes around the first match
+func highlightMatches(s string, matched []int, maxRunes int, base, hl lipgloss.Style) string {
+	isMatch := make(map[int]bool, len(matched))
+	for _, i := range matched {
+		isMatch[i] = true
+	}
+	type piece struct {
+		r  rune
+		hl bool
+	}
+	var pieces []piece
+	first := -1
+	for i, r := range s {
+		if isMatch[i] && first < 0 {
+			first = len(pieces)
+		}
+		pieces = append(pieces, piece{r, isMatch[i]})
+	}
+
+	prefix, suffix := "", ""
+	if len(pieces) > maxRunes && maxRunes > 1 {
+		start := 0
+		if first > maxRunes/2 {
+			start = min(first-maxRunes/4, len(pieces)-maxRunes+1)
+			prefix = "…"
+		}
+		end := start + maxRunes - 1
+		if start > 0 {
+			end--
+		}
+		if end < len(pieces) {
+			suffix = "…"
+		} else {
+			end = len(pieces)
+		}
+		pieces = pieces[start:end]
+	}
+
+	var sb strings.Builder
+	sb.WriteString(base.Render(prefix))
+	for i := 0; i < len(pieces); {
+		j := i
+		var run strings.Builder
+		for j < len(pieces) && pieces[j].hl == pieces[i].hl {
+			run.WriteRune(pieces[j].r)
+			j++
+		}
+		if pieces[i].hl {
+			sb.WriteString(hl.Render(run.String()))
+		} else {
+			sb.WriteString(base.Render(run.String()))
+		}
+		i = j
+	}
+	sb.WriteString(base.Render(suffix))
+	return sb.String()
+}
+
+// View renders the search overlay
+func (m *IssueSearchModel) View() string {
+	if m.width == 0 {
+		m.width = 80
+	}
+	if m.height == 0 {
+		m.height = 24
+	}
+	t := m.theme
+
+	boxWidth := min(max(m.width-10, 30), 90)
+	inner := boxWidth - 6
+	maxVisible := max((m.height-12)/2, 3)
+
+	var lines []string
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	lines = append(lines, titleStyle.Render("Search Issues"), "")
+
+	inputStyle := t.Renderer.NewStyle().
+		Border(termBorder(lipgloss.NormalBorder())).
+		BorderForeground(t.Secondary).
+		Padding(0, 1).
+		Width(inner)
+	lines = append(lines, inputStyle.Render(m.input.View()), "")
+
+	dimStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Italic(true)
+	switch {
+	case strings.TrimSpace(m.input.Value()) == "":
+		lines = append(lines, dimStyle.Render("  Type to search "+itoa(len(m.issues))+" issues"))
+	case len(m.results) == 0:
+		lines = append(lines, dimStyle.Render("  No matching issues"))
+	default:
+		start := 0
+		if m.selectedIndex >= maxVisible {
+			start = m.selectedIndex - maxVisible + 1
+		}
+		end := min(start+maxVisible, len(m.results))
+		hlStyle := t.Renderer.NewStyle().Foreground(t.Feature).Bold(true).Underline(true)
+		for i := start; i < end; i++ {
+			r := m.results[i]
+			selected := i == m.selectedIndex
+			base := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+			idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+			prefix := "  "
+			if selected {
+				base = base.Foreground(t.Primary).Bold(true)
+				idStyle = idStyle.Foreground(t.Primary)
+				prefix = "> "
+			}
+
+			idW := min(len([]rune(r.Issue.ID)), inner/3)
+			titleW := max(inner-idW-6, 10)
+			id := idStyle.Render(truncateRunesHelper(r.Issue.ID, idW, "…"))
+			if r.Field == searchFieldID {
+				id = highlightMatches(r.Issue.ID, r.Matched, idW, idStyle, hlStyle)
+			}
+			title := base.Render(truncateRunesHelper(r.Issue.Title, titleW, "…"))
+			if r.Field == searchFieldTitle {
+				title = highlightMatches(r.Issue.Title, r.Matched, titleW, base, hlStyle)
+			}
+			lines = append(lines, base.Render(prefix+GetPriorityIcon(r.Issue.Priority)+" ")+id+base.Render(" ")+title)
+
+			// Matches outside the ID and title get a line of context
+			if r.Field == searchFieldLabels || r.Field == searchFieldDescription {
+				ctx := t.Renderer.NewStyle().Foreground(t.Subtext)
+				label := "     " + searchFieldNames[r.Field] + ": "
+				lines = append(lines, ctx.Render(label)+highlightMatches(r.Text, r.Matched, inner-len(label), ctx, hlStyle))
+			}
+		}
+		if len(m.results) > maxVisible {
+			lines = append(lines, "", dimStyle.Render("  ("+itoa(m.selectedIndex+1)+"/"+itoa(len(m.results))+")"))
+		}
+	}
+
+	lines = append(lines, "", dimStyle.Render("↑/↓: navigate | enter: jump to issue | esc: cancel"))
+
+	box := t.Renderer.NewStyle().
+		Border(termBorder(lipgloss.RoundedBorder())).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth).
+		Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// openIssueSearch shows the search overlay over the current view
+func (m Model) openIssueSearch() Model {
+	if m.focused == focusIssueSearch {
+		return m
+	}
+	m.issueSearch.SetIssues(m.issues)
+	m.issueSearch.Reset()
+	m.issueSearch.SetSize(m.width, m.height-1)
+	m.issueSearchFrom = m.focused
+	m.showIssueSearch = true
+	m.focused = focusIssueSearch
+	return m
+}
+
+// handleIssueSearchKeys handles keyboard input while the search overlay is open
+func (m Model) handleIssueSearchKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "esc":
+		m.showIssueSearch = false
+		m.focused = m.issueSearchFrom
+	case "down", "ctrl+n":
+		m.issueSearch.MoveDown()
+	case "up", "ctrl+p":
+		m.issueSearch.MoveUp()
+	case "enter":
+		id := m.issueSearch.SelectedIssueID()
+		m.showIssueSearch = false
+		m.focused = m.issueSearchFrom
+		if id != "" {
+			m = m.jumpToIssue(id)
+		}
+	default:
+		m.issueSearch.UpdateInput(msg)
+	}
+	return m
+}
+
+// jumpToIssue selects id in the current view. Views that don't show the
+// issue leave the selection alone and say so; the list clears its filters
+// when they hide the issue.
+func (m Model) jumpToIssue(id string) Model {
+	found := true
+	switch m.focused {
+	case focusGraph:
+		if found = m.graphView.SelectByID(id); found {
+			m.graphView.Center()
+		}
+	case focusBoard:
+		found = m.board.SelectIssueByID(id)
+	case focusTree:
+		found = m.tree.SelectByID(id)
+	case focusActionable:
+		found = m.actionableView.SelectByID(id)
+	case focusTimeline:
+		found = m.timelineView.SelectByID(id)
+	case focusCutLine:
+		found = m.cutLineView.SelectByID(id)
+	case focusDepTree:
+		found = m.depTreeView.SelectByID(id)
+	case focusAssignees:
+		found = m.assigneeView.SelectByID(id)
+	default:
+		found = m.selectInList(id)
+		if !found && m.hasActiveFilters() {
+			m.clearAllFilters()
+			found = m.selectInList(id)
+		}
+		if found && m.focused == focusDetail {
+			m.viewport.GotoTop()
+			m.updateViewportContent()
+		}
+	}
+	if found {
+		m.statusMsg = "Jumped to " + id
+	} else {
+		m.statusMsg = id + " is not shown in this view"
+	}
+	m.statusIsError = false
+	return m
+}
+
+// selectInList selects id in the issue list, reporting whether it is listed
+func (m *Model) selectInList(id string) bool {
+	for i, item := range m.list.Items() {
+		if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == id {
+			m.list.Select(i)
+			return true
+		}
+	}
+	return false
+}
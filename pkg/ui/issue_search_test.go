@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestIssueSearch(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, Labels: []string{"auth"}},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2, Labels: []string{"ui", "theme"}},
+		{ID: "bv-3", Title: "Speed up sync", Status: model.StatusOpen, Priority: 2,
+			Description: "Cache the remote manifest so the login handshake is skipped on warm starts."},
+		{ID: "login-4", Title: "Audit sessions", Status: model.StatusOpen, Priority: 3},
+	}
+
+	t.Run("ranks fields", func(t *testing.T) {
+		m := NewIssueSearchModel(newTestTheme())
+		m.SetIssues(issues)
+
+		m.SetQuery("login")
+		var ids []string
+		for _, r := range m.Results() {
+			ids = append(ids, r.Issue.ID)
+		}
+		if want := "login-4,bv-1,bv-3"; strings.Join(ids, ",") != want {
+			t.Fatalf("results = %v, want %s (id, then title, then description)", ids, want)
+		}
+		if r := m.Results()[2]; r.Field != searchFieldDescription || len(r.Matched) != len("login") {
+			t.Errorf("description match = %+v", r)
+		}
+
+		m.SetQuery("thm")
+		if res := m.Results(); len(res) != 1 || res[0].Issue.ID != "bv-2" || res[0].Field != searchFieldLabels {
+			t.Errorf("fuzzy label match = %+v", res)
+		}
+
+		m.SetQuery("zzz")
+		if len(m.Results()) != 0 || m.SelectedIssueID() != "" {
+			t.Error("expected no results")
+		}
+	})
+
+	t.Run("view highlights", func(t *testing.T) {
+		m := NewIssueSearchModel(newTestTheme())
+		m.SetIssues(issues)
+		m.SetSize(100, 30)
+		m.SetQuery("handshake")
+
+		out := m.View()
+		for _, want := range []string{"Search Issues", "bv-3", "Speed up sync", "description:", "handshake"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("view missing %q:\n%s", want, out)
+			}
+		}
+	})
+
+	t.Run("jumps in current view", func(t *testing.T) {
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+		m = updated.(Model)
+
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+		m = updated.(Model)
+		if m.FocusState() != "issue_search" {
+			t.Fatalf("ctrl+f should open search, focus = %s", m.FocusState())
+		}
+		for _, r := range "dark" {
+			updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+			m = updated.(Model)
+		}
+		if m.FocusState() != "issue_search" {
+			t.Fatalf("typing should stay in search, focus = %s", m.FocusState())
+		}
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		m = updated.(Model)
+
+		if m.FocusState() != "list" {
+			t.Errorf("enter should return to the list, focus = %s", m.FocusState())
+		}
+		if item, ok := m.list.SelectedItem().(IssueItem); !ok || item.Issue.ID != "bv-2" {
+			t.Errorf("expected bv-2 selected in list, got %+v", m.list.SelectedItem())
+		}
+
+		// Esc leaves the view and its selection as they were
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+		m = updated.(Model)
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		m = updated.(Model)
+		if m.FocusState() != "list" || m.showIssueSearch {
+			t.Errorf("esc should close search, focus = %s", m.FocusState())
+		}
+	})
+}
@@ -11,10 +11,12 @@ import (
 type DiffStatus int
 
 const (
-	DiffStatusNone     DiffStatus = iota // No diff or not in time-travel mode
-	DiffStatusNew                        // Issue was added since comparison point
-	DiffStatusClosed                     // Issue was closed since comparison point
-	DiffStatusModified                   // Issue was modified since comparison point
+	DiffStatusNone      DiffStatus = iota // No diff or not in time-travel mode
+	DiffStatusNew                         // Issue was added since comparison point
+	DiffStatusClosed                      // Issue was closed since comparison point
+	DiffStatusModified                    // Issue was modified since comparison point
+	DiffStatusBlocked                     // Issue gained its first open blocker since comparison point
+	DiffStatusUnblocked                   // Issue lost its last open blocker since comparison point
 )
 
 // DiffBadge returns the badge string for a diff status
@@ -26,6 +28,10 @@ func (s DiffStatus) Badge() string {
 		return "✅"
 	case DiffStatusModified:
 		return "~"
+	case DiffStatusBlocked:
+		return "⛔"
+	case DiffStatusUnblocked:
+		return "🔓"
 	default:
 		return ""
 	}
@@ -38,6 +38,16 @@ func TestDiffStatusBadge(t *testing.T) {
 			status:   ui.DiffStatusModified,
 			expected: "~",
 		},
+		{
+			name:     "blocked returns no-entry emoji",
+			status:   ui.DiffStatusBlocked,
+			expected: "⛔",
+		},
+		{
+			name:     "unblocked returns unlocked emoji",
+			status:   ui.DiffStatusUnblocked,
+			expected: "🔓",
+		},
 		{
 			name:     "unknown status returns empty string",
 			status:   ui.DiffStatus(99),
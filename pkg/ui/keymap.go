@@ -0,0 +1,409 @@
+package ui
+
+// keyBinding is one line of the keymap: how its keys are shown, what they
+// do, and the key names tea reports for them
+type keyBinding struct {
+	label string
+	desc  string
+	keys  []string
+}
+
+// keyGroup is a titled set of bindings shown together by the help overlay
+// and the shortcuts sidebar.
+//
+// A view group's keys belong to that view while it is focused: Update hands
+// them to the view instead of the global keys, and the global bindings for
+// the same keys are left out of its help. Listing a key in a view group is
+// therefore what makes it work there, so the help cannot claim a key the
+// view never sees.
+type keyGroup struct {
+	title    string
+	icon     string
+	contexts []Context // where the group applies; empty means everywhere
+	view     bool      // the bindings are the view's own and take its keys
+	bindings []keyBinding
+}
+
+func bind(label, desc string, keys ...string) keyBinding {
+	return keyBinding{label: label, desc: desc, keys: keys}
+}
+
+// listScope is where the issue list, its motions and its filters are live
+var listScope = []Context{ContextList, ContextSplit, ContextFilter, ContextTimeTravel}
+
+// keymap lists every key bv handles, grouped as the help shows them. View
+// groups come first so the focused view leads its help.
+var keymap = []keyGroup{
+	{
+		title: "Board", icon: "📋", contexts: []Context{ContextBoard}, view: true,
+		bindings: []keyBinding{
+			bind("h/l", "Columns ←/→", "h", "l", "left", "right"),
+			bind("j/k", "Cards ↓/↑", "j", "k", "down", "up"),
+			bind("1-4", "Jump to column", "1", "2", "3", "4"),
+			bind("H/L", "First/last column", "H", "L"),
+			bind("0/$", "Top/bottom of column", "0", "$", "home", "G", "end"),
+			bind("Ctrl+d/u", "Page ↓/↑", "ctrl+d", "ctrl+u"),
+			bind("/", "Search cards", "/"),
+			bind("n/N", "Next/prev match", "n", "N"),
+			bind("o/c/r", "Open/closed/ready", "o", "c", "r"),
+			bind("s", "Cycle swimlanes", "s"),
+			bind("e", "Empty columns", "e"),
+			bind("d", "Expand card", "d"),
+			bind("y", "Copy issue ID", "y"),
+			bind("Tab", "Toggle detail", "tab"),
+			bind("Ctrl+j/k", "Scroll detail", "ctrl+j", "ctrl+k"),
+			bind("Enter", "Open issue", "enter"),
+		},
+	},
+	{
+		title: "Graph", icon: "📊", contexts: []Context{ContextGraph}, view: true,
+		bindings: []keyBinding{
+			bind("hjkl", "Navigate nodes", "h", "j", "k", "l", "left", "down", "up", "right"),
+			bind("H/L", "Scroll left/right", "H", "L"),
+			bind("m", "Map of whole graph", "m"),
+			bind("J/K", "Pan map", "J", "K"),
+			bind("+/-", "Zoom map", "+", "=", "-"),
+			bind("c", "Center map", "c"),
+			bind("v", "Image preview", "v"),
+			bind("PgUp/Dn", "Scroll ↑/↓", "pgup", "pgdown", "ctrl+u", "ctrl+d"),
+			bind("/", "Find issue", "/"),
+			bind("Enter", "Jump to issue", "enter"),
+		},
+	},
+	{
+		title: "Tree", icon: "🌳", contexts: []Context{ContextTree}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("h/l", "Collapse/expand", "h", "l", "left", "right"),
+			bind("Enter", "Toggle node", "enter", " "),
+			bind("o/O", "Expand/collapse all", "o", "O"),
+			bind("g/G", "Top/bottom", "g", "G"),
+			bind("Ctrl+d/u", "Page ↓/↑", "ctrl+d", "ctrl+u", "pgdown", "pgup"),
+			bind("/", "Find issue", "/"),
+			bind("Tab", "Detail pane", "tab"),
+			bind("E/Esc", "Back to list", "E", "esc"),
+		},
+	},
+	{
+		title: "Actionable", icon: "✅", contexts: []Context{ContextActionable}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("s/I", "Cycle/reverse sort", "s", "I"),
+			bind("z", "Compact/detailed/minimal", "z"),
+			bind("space/V", "Mark issue/range", " ", "V"),
+			bind("*", "Pin/unpin issue", "*"),
+			bind("J/K", "Move issue ↓/↑", "J", "K"),
+			bind("X", "Reset track order", "X"),
+			bind("e", "Edit issue/marked", "e"),
+			bind("/", "Find issue", "/"),
+			bind("Enter", "Open issue", "enter"),
+		},
+	},
+	{
+		title: "Insights", icon: "💡", contexts: []Context{ContextInsights, ContextAttention}, view: true,
+		bindings: []keyBinding{
+			bind("h/l/Tab", "Switch panels", "h", "l", "left", "right", "tab"),
+			bind("j/k", "Select item", "j", "k", "down", "up"),
+			bind("Ctrl+j/k", "Scroll detail", "ctrl+j", "ctrl+k"),
+			bind("e", "Explanations", "e"),
+			bind("x", "Calc details", "x"),
+			bind("m", "Heatmap", "m"),
+			bind("Enter", "Jump to issue", "enter"),
+			bind("Esc", "Back to list", "esc"),
+		},
+	},
+	{
+		title: "History", icon: "📜", contexts: []Context{ContextHistory}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Navigate ↓/↑", "j", "k", "down", "up"),
+			bind("J/K", "Commits ↓/↑", "J", "K"),
+			bind("Tab", "Cycle focus", "tab"),
+			bind("v", "Bead/git mode", "v"),
+			bind("/", "Search", "/"),
+			bind("c", "Confidence filter", "c"),
+			bind("f", "File tree", "f", "F"),
+			bind("l/h", "Open/close folder", "l", "h"),
+			bind("y", "Copy SHA", "y"),
+			bind("o", "Open in browser", "o"),
+			bind("g", "Bead in graph", "g"),
+			bind("Enter", "Jump to bead", "enter"),
+			bind("h/Esc", "Back to list", "h", "esc"),
+		},
+	},
+	{
+		title: "Flow Matrix", icon: "🔀", contexts: []Context{ContextFlowMatrix}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("g/G", "Top/bottom", "g", "home", "G", "end"),
+			bind("Tab", "Switch pane", "tab"),
+			bind("Enter", "Drill down", "enter"),
+			bind("f/Esc", "Back", "f", "esc"),
+		},
+	},
+	{
+		title: "Labels", icon: "🏷", contexts: []Context{ContextLabelDashboard}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("Enter", "Filter by label", "enter"),
+			bind("h", "Label health", "h"),
+			bind("d", "Drill down", "d"),
+			bind("Esc", "Back to list", "esc"),
+		},
+	},
+	{
+		title: "Cut Line", icon: "✂", contexts: []Context{ContextCutLine}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("/", "Find issue", "/"),
+			bind("Enter", "Open issue", "enter"),
+			bind("R/Esc", "Back to list", "R", "esc"),
+		},
+	},
+	{
+		title: "Dependency Tree", icon: "🌲", contexts: []Context{ContextDepTree}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("h/l", "Collapse/expand", "h", "l", "left", "right"),
+			bind("Space", "Toggle branch", " "),
+			bind("o/O", "Expand/collapse all", "o", "O"),
+			bind("/", "Find issue", "/"),
+			bind("Enter", "Open issue", "enter"),
+			bind("B/Esc", "Back to list", "B", "esc"),
+		},
+	},
+	{
+		title: "Assignees", icon: "👥", contexts: []Context{ContextAssignees}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("/", "Find issue", "/"),
+			bind("Enter", "Open issue", "enter"),
+			bind("A/Esc", "Back to list", "A", "esc"),
+		},
+	},
+	{
+		title: "Facets", icon: "🏷", contexts: []Context{ContextFacets}, view: true,
+		bindings: []keyBinding{
+			bind("h/l", "Column ←/→", "h", "l", "left", "right", "tab", "shift+tab"),
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("Space", "Pick/unpick value", " "),
+			bind("Enter", "Filter list by picks", "enter"),
+			bind("x", "Clear picks", "x"),
+			bind("c", "Include closed", "c"),
+			bind("#/Esc", "Back to list", "#", "esc"),
+		},
+	},
+	{
+		title: "Timeline", icon: "📅", contexts: []Context{ContextTimeline}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("s", "Lanes by track/owner", "s"),
+			bind("/", "Find issue", "/"),
+			bind("Enter", "Open issue", "enter"),
+			bind("L/Esc", "Back to list", "L", "esc"),
+		},
+	},
+	{
+		title: "Sprint", icon: "🏃", contexts: []Context{ContextSprint}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("P/Esc", "Back to list", "P", "esc"),
+		},
+	},
+	{
+		title: "Detail", icon: "📄", contexts: []Context{ContextDetail}, view: true,
+		bindings: []keyBinding{
+			bind("j/k", "Scroll", "j", "k", "down", "up"),
+			bind("1-9", "Follow numbered link", "1", "2", "3", "4", "5", "6", "7", "8", "9"),
+			bind("n/N", "Pick next/prev link", "n", "N"),
+			bind("Enter", "Follow picked link", "enter"),
+			bind("⌫", "Back along trail", "backspace"),
+			bind("Esc", "Back to list", "esc"),
+		},
+	},
+	{
+		title: "Navigation", icon: "🧭", contexts: append([]Context{ContextActionable}, listScope...),
+		bindings: []keyBinding{
+			bind("j/k", "Move ↓/↑", "j", "k", "down", "up"),
+			bind("5j/5k", "Move by a count"),
+			bind("gg/G", "First/last", "G", "home", "end"),
+			bind("5G/5gg", "Go to item 5"),
+			bind("Ctrl+d/u", "Half page ↓/↑", "ctrl+d", "ctrl+u"),
+			bind("m a/' a", "Set/jump to mark a"),
+			bind("Enter", "View details", "enter"),
+			bind("Esc", "Back/clear filters", "esc"),
+		},
+	},
+	{
+		title: "Split", icon: "◫", contexts: []Context{ContextSplit},
+		bindings: []keyBinding{
+			bind("Tab", "Switch pane", "tab"),
+			bind("< / >", "Resize", "<", ">"),
+			bind("\\", "List/actionable", "\\"),
+			bind("|", "Detail/graph", "|"),
+			bind("1-9 n/N", "Follow link (detail)"),
+		},
+	},
+	{
+		title: "Filters & Sort", icon: "🔍", contexts: listScope,
+		bindings: []keyBinding{
+			bind("/", "Fuzzy search", "/"),
+			bind("Ctrl+S", "Semantic search", "ctrl+s"),
+			bind("H", "Hybrid ranking", "H"),
+			bind("Alt+H", "Hybrid preset", "alt+h"),
+			bind("o/c/r", "Open/closed/ready", "o", "c", "r"),
+			bind("s", "Cycle sort field", "s"),
+			bind("I", "Reverse sort", "I"),
+			bind("S", "Triage sort", "S"),
+			bind("D", "Pick for me", "D"),
+		},
+	},
+	{
+		title: "Actions", icon: "⚡", contexts: listScope,
+		bindings: []keyBinding{
+			bind("e", "Edit issue/marked", "e"),
+			bind("space/V", "Mark issue/range", " ", "V"),
+			bind("*", "Pin/unpin issue", "*"),
+			bind("t/T", "Time-travel", "t", "T"),
+			bind("C", "Copy issue", "C"),
+			bind("y", "Copy issue ID", "y"),
+			bind("O", "Open in editor", "O"),
+			bind("R", "Release cut line", "R"),
+			bind("B", "Dependency tree", "B"),
+			bind("L", "Timeline", "L"),
+			bind("A", "Issues by assignee", "A"),
+			bind("#", "Facet browser", "#"),
+			bind("v", "Cass sessions", "v"),
+			bind("U", "Self-update", "U"),
+		},
+	},
+	{
+		title: "Views", icon: "👁",
+		bindings: []keyBinding{
+			bind("b", "Kanban board", "b"),
+			bind("g", "Graph view", "g"),
+			bind("i", "Insights", "i"),
+			bind("h", "History view", "h"),
+			bind("a", "Actionable", "a"),
+			bind("E", "Tree view", "E"),
+			bind("f", "Flow matrix", "f"),
+			bind("[", "Label dashboard", "[", "f3"),
+			bind("]", "Attention view", "]", "f4"),
+		},
+	},
+	{
+		title: "Global", icon: "🌐",
+		bindings: []keyBinding{
+			bind("?", "This help", "?", "f1"),
+			bind("`", "Tutorial", "`"),
+			bind(";", "Shortcuts bar", ";", "f2"),
+			bind("Ctrl+P", "Command palette", "ctrl+p"),
+			bind("Ctrl+F", "Find issue", "ctrl+f"),
+			bind("Q", "Query filter", "Q"),
+			bind("'", "Recipes", "'"),
+			bind("l", "Filter by label", "l"),
+			bind("Alt+1…9", "Saved searches (bare digits are counts)", "alt+1", "alt+2", "alt+3", "alt+4", "alt+5", "alt+6", "alt+7", "alt+8", "alt+9"),
+			bind("Ctrl+E", "Edit issue/marked", "ctrl+e"),
+			bind("Ctrl+N", "New issue", "ctrl+n"),
+			bind("Ctrl+T", "Start/stop timer", "ctrl+t"),
+			bind("u", "Undo edit", "u"),
+			bind("Ctrl+R", "Refresh/redo", "ctrl+r", "f5"),
+			bind("Ctrl+G", "Data source info", "ctrl+g"),
+			bind("p", "Priority hints", "p"),
+			bind("x", "Export markdown", "x"),
+			bind("!", "Alerts panel", "!"),
+			bind("w", "Repo picker", "w"),
+			bind("W", "Next workspace", "W"),
+			bind("q", "Back/quit", "q"),
+			bind("Ctrl+C", "Force quit", "ctrl+c"),
+		},
+	},
+}
+
+// appliesTo reports whether the group is shown in ctx
+func (g keyGroup) appliesTo(ctx Context) bool {
+	if len(g.contexts) == 0 {
+		return true
+	}
+	for _, c := range g.contexts {
+		if c == ctx {
+			return true
+		}
+	}
+	return false
+}
+
+// viewKeys is the set of keys the view shown in ctx takes for itself
+func viewKeys(ctx Context) map[string]bool {
+	keys := map[string]bool{}
+	for _, g := range keymap {
+		if !g.view || !g.appliesTo(ctx) {
+			continue
+		}
+		for _, b := range g.bindings {
+			for _, k := range b.keys {
+				keys[k] = true
+			}
+		}
+	}
+	return keys
+}
+
+// keymapFor returns the groups to show in ctx, leaving out the global
+// bindings whose keys the view takes over. With all set every group is
+// returned as written.
+func keymapFor(ctx Context, all bool) []keyGroup {
+	if all {
+		return keymap
+	}
+	taken := viewKeys(ctx)
+	var groups []keyGroup
+	for _, g := range keymap {
+		if !g.appliesTo(ctx) {
+			continue
+		}
+		if g.view {
+			groups = append(groups, g)
+			continue
+		}
+		kept := g
+		kept.bindings = nil
+		for _, b := range g.bindings {
+			if !b.shadowed(taken) {
+				kept.bindings = append(kept.bindings, b)
+			}
+		}
+		if len(kept.bindings) > 0 {
+			groups = append(groups, kept)
+		}
+	}
+	return groups
+}
+
+// shadowed reports whether all of the binding's keys are taken
+func (b keyBinding) shadowed(taken map[string]bool) bool {
+	if len(b.keys) == 0 {
+		return false
+	}
+	for _, k := range b.keys {
+		if !taken[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// viewOwnsKey reports whether the focused view handles key itself rather
+// than the global keys: it lists the key in its keymap group, or it is
+// taking text for a search
+func (m Model) viewOwnsKey(key string) bool {
+	if key == "ctrl+c" {
+		return false
+	}
+	switch {
+	case m.focused == focusBoard && m.board.IsSearchMode():
+		return true
+	case m.focused == focusHistory && m.historyView.IsSearchActive():
+		return true
+	}
+	return viewKeys(m.CurrentContext())[key]
+}
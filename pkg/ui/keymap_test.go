@@ -0,0 +1,194 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestViewKeysBeatGlobalKeys(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Oldest", Status: model.StatusOpen, Priority: 2, Labels: []string{"api"}},
+		{ID: "bv-2", Title: "Urgent", Status: model.StatusInProgress, Priority: 0},
+		{ID: "bv-3", Title: "Newest", Status: model.StatusOpen, Priority: 3},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 60})
+	m = pressKey(updated.(Model), "b")
+	col := m.board.focusedCol
+	m = pressKey(m, "l")
+	if m.CurrentContext() != ContextBoard || m.board.focusedCol != col+1 {
+		t.Errorf("l on the board should move a column, got %s col %d", m.CurrentContext(), m.board.focusedCol)
+	}
+	m = pressKey(m, "h")
+	if m.CurrentContext() != ContextBoard || m.board.focusedCol != col {
+		t.Errorf("h on the board should move back, got %s col %d", m.CurrentContext(), m.board.focusedCol)
+	}
+	// Keys the board leaves alone still switch views
+	if m = pressKey(m, "g"); m.CurrentContext() != ContextGraph {
+		t.Fatalf("g on the board should open the graph, got %s", m.CurrentContext())
+	}
+
+	for _, tc := range []struct {
+		open, key string
+		want      Context
+	}{
+		{"g", "h", ContextGraph},
+		{"i", "l", ContextInsights},
+		{"E", "l", ContextTree},
+		{"[", "h", ContextLabelDashboard},
+	} {
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 60})
+		m = pressKey(pressKey(updated.(Model), tc.open), tc.key)
+		if got := m.CurrentContext(); got != tc.want && !(tc.want == ContextLabelDashboard && m.showLabelHealthDetail) {
+			t.Errorf("%s then %s: context %s, want %s", tc.open, tc.key, got, tc.want)
+		}
+	}
+
+	// The palette still reaches the global meaning from a view that
+	// rebinds the key
+	m = NewModel(issues, nil, "")
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: 160, Height: 60})
+	m = runPalette(t, pressKey(updated.(Model), "b"), "toggle history")
+	if m.CurrentContext() != ContextHistory {
+		t.Errorf("palette history from the board landed in %s", m.CurrentContext())
+	}
+}
+
+func TestHelpOverlayListsFocusedViewKeys(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "1", Title: "x", Status: model.StatusOpen}}, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 60})
+	m = pressKey(pressKey(updated.(Model), "b"), "?")
+	if m.helpContext != ContextBoard {
+		t.Fatalf("help context = %s", m.helpContext)
+	}
+	out := m.renderHelpOverlay()
+	for _, want := range []string{"Kanban board", "Columns ←/→", "Cycle swimlanes", "Graph view", "Command palette"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("board help missing %q", want)
+		}
+	}
+	// h and l belong to the board here, and list-only keys don't apply
+	for _, unwanted := range []string{"History view", "Filter by label", "Fuzzy search"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("board help should not list %q", unwanted)
+		}
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m = updated.(Model)
+	if !m.showHelp || !m.helpShowAll {
+		t.Fatal("tab should keep help open and list every view")
+	}
+	out = m.renderHelpOverlay()
+	for _, want := range []string{"All views", "History view", "Fuzzy search", "Navigate nodes"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("full help missing %q", want)
+		}
+	}
+}
+
+func TestKeymapCoversPaletteShortcuts(t *testing.T) {
+	known := map[string]bool{}
+	for _, g := range keymap {
+		for _, b := range g.bindings {
+			for _, k := range b.keys {
+				known[k] = true
+			}
+		}
+	}
+	m := NewModel([]model.Issue{{ID: "1", Title: "x", Status: model.StatusOpen}}, nil, "")
+	for _, cmd := range m.paletteCommands() {
+		if cmd.Key != "" && !known[cmd.Key] {
+			t.Errorf("palette shortcut %q (%s) is missing from the keymap", cmd.Key, cmd.Title)
+		}
+	}
+}
+
+// labelKeys returns the key names a binding's label spells out: named keys
+// such as Esc and Enter, single characters, Ctrl+/Alt+ chords (the
+// modifier carries over to the keys after it, as in Ctrl+d/u), digit
+// ranges and runs of letters like hjkl. Counts and sequences such as 5j or
+// gg are left out.
+func labelKeys(label string) []string {
+	named := map[string]string{
+		"Esc": "esc", "Enter": "enter", "Tab": "tab", "Space": " ", "space": " ",
+		"⌫": "backspace", "PgUp": "pgup", "Dn": "pgdown",
+	}
+	var keys []string
+	mod := ""
+	for _, tok := range strings.FieldsFunc(label, func(r rune) bool { return r == '/' || r == ' ' }) {
+		for _, prefix := range []string{"Ctrl+", "Alt+"} {
+			if len(tok) > len(prefix) && strings.HasPrefix(tok, prefix) {
+				mod, tok = strings.ToLower(prefix), tok[len(prefix):]
+			}
+		}
+		key := func(k string) string {
+			if mod != "" {
+				return mod + strings.ToLower(k)
+			}
+			return k
+		}
+		runes := []rune(tok)
+		switch {
+		case named[tok] != "":
+			keys = append(keys, named[tok])
+		case len(runes) == 1:
+			keys = append(keys, key(tok))
+		case len(runes) == 3 && (runes[1] == '-' || runes[1] == '…') && runes[0] >= '0' && runes[2] <= '9':
+			for d := runes[0]; d <= runes[2]; d++ {
+				keys = append(keys, key(string(d)))
+			}
+		case strings.Trim(tok, "abcdefghijklmnopqrstuvwxyz") == "" && strings.Count(tok, tok[:1]) == 1:
+			for _, r := range tok {
+				keys = append(keys, key(string(r)))
+			}
+		}
+	}
+	return keys
+}
+
+func TestKeymapLabelsMatchKeys(t *testing.T) {
+	for _, g := range keymap {
+		for _, b := range g.bindings {
+			have := map[string]bool{}
+			for _, k := range b.keys {
+				have[k] = true
+			}
+			for _, k := range labelKeys(b.label) {
+				// A binding with no keys describes a sequence routed
+				// elsewhere (counts, marks), but named keys must still be
+				// bound
+				if len(b.keys) == 0 && len(k) == 1 {
+					continue
+				}
+				if !have[k] {
+					t.Errorf("%s: %q shows %q but its keys %q lack it", g.title, b.label, k, b.keys)
+				}
+			}
+		}
+	}
+}
+
+func TestEscLeavesViews(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Login", Status: model.StatusOpen, Assignee: "ann", Labels: []string{"api"}},
+		{ID: "bv-2", Title: "Logout", Status: model.StatusOpen, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "bv-2", DependsOnID: "bv-1", Type: model.DepBlocks}}},
+	}
+	for _, open := range []string{"R", "B", "A", "#", "L", "[", "enter"} {
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+		m = pressKey(updated.(Model), open)
+		if m.CurrentContext() == ContextList {
+			t.Fatalf("%s should open a view", open)
+		}
+		if m = pressKey(m, "esc"); m.CurrentContext() != ContextList || m.focused != focusList {
+			t.Errorf("esc after %s should go back to the list, context %s", open, m.CurrentContext())
+		}
+	}
+}
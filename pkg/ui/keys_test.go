@@ -0,0 +1,11 @@
+package ui
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// pressKey sends key to the model as one key press. Named keys such as
+// "enter" or "esc" are sent as the runes spelling them, which String
+// reports the same way, so the handlers see them as the real key.
+func pressKey(m Model, key string) Model {
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	return updated.(Model)
+}
@@ -246,7 +246,7 @@ func (m *LabelPickerModel) View() string {
 
 	// Search input
 	inputStyle := t.Renderer.NewStyle().
-		Border(lipgloss.NormalBorder()).
+		Border(termBorder(lipgloss.NormalBorder())).
 		BorderForeground(t.Secondary).
 		Padding(0, 1).
 		Width(boxWidth - 6)
@@ -324,7 +324,7 @@ func (m *LabelPickerModel) View() string {
 
 	// Box style
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2).
 		Width(boxWidth)
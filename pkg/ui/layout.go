@@ -0,0 +1,224 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Panes the split view can show. The left pane is the one navigated with
+// j/k; the right pane follows its selection.
+const (
+	PaneList       = "list"
+	PaneActionable = "actionable"
+	PaneDetail     = "detail"
+	PaneGraph      = "graph"
+)
+
+const (
+	defaultSplitRatio = 0.4
+	minSplitRatio     = 0.2
+	maxSplitRatio     = 0.8
+	splitRatioStep    = 0.05
+)
+
+// SplitLayout is the arrangement of the split view on wide terminals: which
+// pane sits on each side and the share of the width the left one gets. It
+// persists across sessions so bv reopens with the layout last chosen.
+type SplitLayout struct {
+	Left  string  `json:"left"`  // PaneList or PaneActionable
+	Right string  `json:"right"` // PaneDetail or PaneGraph
+	Ratio float64 `json:"ratio"` // Left pane's share of the width
+}
+
+// DefaultSplitLayout returns the list beside the detail pane at 40/60
+func DefaultSplitLayout() SplitLayout {
+	return SplitLayout{Left: PaneList, Right: PaneDetail, Ratio: defaultSplitRatio}
+}
+
+// normalized replaces unknown panes and out-of-range ratios, as found in a
+// hand-edited or older layout file, with their defaults
+func (l SplitLayout) normalized() SplitLayout {
+	if l.Left != PaneList && l.Left != PaneActionable {
+		l.Left = PaneList
+	}
+	if l.Right != PaneDetail && l.Right != PaneGraph {
+		l.Right = PaneDetail
+	}
+	if l.Ratio < minSplitRatio || l.Ratio > maxSplitRatio {
+		l.Ratio = defaultSplitRatio
+	}
+	return l
+}
+
+// Resize moves the divider by steps of 5% of the width; positive steps
+// widen the left pane. It reports whether the ratio changed.
+func (l *SplitLayout) Resize(steps int) bool {
+	ratio := l.Ratio + float64(steps)*splitRatioStep
+	// Round to whole steps so repeated resizes don't drift
+	ratio = float64(int(ratio/splitRatioStep+0.5)) * splitRatioStep
+	ratio = min(max(ratio, minSplitRatio), maxSplitRatio)
+	if ratio == l.Ratio {
+		return false
+	}
+	l.Ratio = ratio
+	return true
+}
+
+// CycleLeft switches the left pane between the list and the actionable plan
+func (l *SplitLayout) CycleLeft() {
+	if l.Left == PaneActionable {
+		l.Left = PaneList
+	} else {
+		l.Left = PaneActionable
+	}
+}
+
+// CycleRight switches the right pane between the details and the graph
+func (l *SplitLayout) CycleRight() {
+	if l.Right == PaneGraph {
+		l.Right = PaneDetail
+	} else {
+		l.Right = PaneGraph
+	}
+}
+
+// Widths splits avail columns of pane content between the two panes
+func (l SplitLayout) Widths(avail int) (left, right int) {
+	left = int(float64(avail) * l.Ratio)
+	return left, avail - left
+}
+
+// LayoutPath returns the path to the saved split layout.
+func LayoutPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "bv", "layout.json")
+}
+
+// LoadSplitLayout reads the saved split layout, falling back to the default
+// when there is none or it cannot be read.
+func LoadSplitLayout() SplitLayout {
+	path := LayoutPath()
+	if path == "" {
+		return DefaultSplitLayout()
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DefaultSplitLayout()
+	}
+	var l SplitLayout
+	if err := json.Unmarshal(data, &l); err != nil {
+		return DefaultSplitLayout()
+	}
+	return l.normalized()
+}
+
+// SaveSplitLayout writes the split layout to disk.
+func SaveSplitLayout(l SplitLayout) error {
+	path := LayoutPath()
+	if path == "" {
+		return nil // Can't determine path
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write atomically via temp file
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// splitLeftIsActionable reports whether the split view's left pane shows
+// the actionable plan rather than the list
+func (m Model) splitLeftIsActionable() bool {
+	return m.isSplitView && m.splitLayout.Left == PaneActionable
+}
+
+// splitRightIsGraph reports whether the split view's right pane shows the
+// graph rather than the details
+func (m Model) splitRightIsGraph() bool {
+	return m.isSplitView && m.splitLayout.Right == PaneGraph
+}
+
+// layoutSplitPanes sizes the split view's panes from the terminal size and
+// the layout's ratio
+func (m *Model) layoutSplitPanes() {
+	bodyHeight := max(m.height-1, 5) // keep 1 row for footer
+
+	// Each panel has a border (2) and padding (2)
+	availWidth := max(m.width-8, 10)
+	leftWidth, rightWidth := m.splitLayout.Widths(availWidth)
+
+	// listHeight fits header (1) + page line (1) inside a panel with Border (2)
+	m.list.SetSize(leftWidth, max(bodyHeight-4, 3))
+	m.viewport.Width = rightWidth
+	m.viewport.Height = bodyHeight - 2 // Account for border
+
+	m.renderer.SetWidthWithTheme(rightWidth, m.theme)
+}
+
+// adjustSplitLayout handles the split view's layout keys: < and > move the
+// divider, \ and | cycle the left and right panes. The result is saved for
+// the next session.
+func (m Model) adjustSplitLayout(key string) Model {
+	switch key {
+	case "<":
+		if !m.splitLayout.Resize(-1) {
+			return m
+		}
+	case ">":
+		if !m.splitLayout.Resize(1) {
+			return m
+		}
+	case "\\":
+		m.splitLayout.CycleLeft()
+		if m.splitLayout.Left == PaneActionable {
+			m.buildActionableView()
+			// Keep the issue being looked at when it is in the plan
+			if item, ok := m.list.SelectedItem().(IssueItem); ok {
+				m.actionableView.SelectByID(item.Issue.ID)
+			}
+		}
+	case "|":
+		m.splitLayout.CycleRight()
+	}
+
+	m.layoutSplitPanes()
+	m.updateListDelegate()
+	m.syncSplitPanes()
+	if err := SaveSplitLayout(m.splitLayout); err != nil {
+		m.statusMsg = fmt.Sprintf("Layout not saved: %v", err)
+		m.statusIsError = true
+	}
+	return m
+}
+
+// syncSplitPanes points the right pane at the left pane's selection
+func (m *Model) syncSplitPanes() {
+	if m.splitLeftIsActionable() {
+		if id := m.actionableView.SelectedIssueID(); id != "" {
+			m.selectInList(id)
+		}
+	}
+	m.updateViewportContent()
+	if m.splitRightIsGraph() {
+		if item, ok := m.list.SelectedItem().(IssueItem); ok {
+			m.graphView.SelectByID(item.Issue.ID)
+		}
+	}
+}
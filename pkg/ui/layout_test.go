@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSplitLayoutResizeClamps(t *testing.T) {
+	l := DefaultSplitLayout()
+	if !l.Resize(1) || l.Ratio != 0.45 {
+		t.Fatalf("ratio after one step = %v, want 0.45", l.Ratio)
+	}
+	for i := 0; i < 20; i++ {
+		l.Resize(1)
+	}
+	if l.Ratio != maxSplitRatio || l.Resize(1) {
+		t.Errorf("ratio should stop at %v, got %v", maxSplitRatio, l.Ratio)
+	}
+	for i := 0; i < 20; i++ {
+		l.Resize(-1)
+	}
+	if l.Ratio != minSplitRatio {
+		t.Errorf("ratio should stop at %v, got %v", minSplitRatio, l.Ratio)
+	}
+
+	left, right := SplitLayout{Ratio: 0.25}.Widths(100)
+	if left != 25 || right != 75 {
+		t.Errorf("widths = %d/%d, want 25/75", left, right)
+	}
+}
+
+func TestSplitLayoutPersists(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if got := LoadSplitLayout(); got != DefaultSplitLayout() {
+		t.Fatalf("missing file should load the default, got %+v", got)
+	}
+	want := SplitLayout{Left: PaneActionable, Right: PaneGraph, Ratio: 0.6}
+	if err := SaveSplitLayout(want); err != nil {
+		t.Fatal(err)
+	}
+	if got := LoadSplitLayout(); got != want {
+		t.Errorf("loaded %+v, want %+v", got, want)
+	}
+
+	// Unknown panes and ratios fall back to the defaults
+	if err := os.WriteFile(LayoutPath(), []byte(`{"left":"board","right":"graph","ratio":3}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := LoadSplitLayout(); got != (SplitLayout{Left: PaneList, Right: PaneGraph, Ratio: defaultSplitRatio}) {
+		t.Errorf("invalid fields should be reset, got %+v", got)
+	}
+}
+
+func TestSplitLayoutKeys(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2},
+		{ID: "bv-3", Title: "Speed up sync", Status: model.StatusOpen, Priority: 2},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	press := func(key string) {
+		t.Helper()
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(Model)
+	}
+
+	listWidth := m.list.Width()
+	press(">")
+	if m.list.Width() <= listWidth || m.splitLayout.Ratio != 0.45 {
+		t.Fatalf("> should widen the left pane: width %d -> %d, ratio %v", listWidth, m.list.Width(), m.splitLayout.Ratio)
+	}
+	if m.list.Width()+m.viewport.Width != 140-8 {
+		t.Errorf("panes should fill the width: %d + %d", m.list.Width(), m.viewport.Width)
+	}
+
+	// The right pane shows the graph, following the list's selection
+	press("|")
+	press("j")
+	selected := m.list.SelectedItem().(IssueItem).Issue.ID
+	if got := m.graphView.SelectedIssue(); got == nil || got.ID != selected {
+		t.Errorf("graph pane should select %s, got %+v", selected, got)
+	}
+
+	// The left pane shows the actionable plan and drives the right pane
+	press("\\")
+	if !m.splitLeftIsActionable() || m.FocusState() != "list" {
+		t.Fatalf("\\ should put the plan on the left, focus = %s", m.FocusState())
+	}
+	press("j")
+	planID := m.actionableView.SelectedIssueID()
+	if item := m.list.SelectedItem().(IssueItem); item.Issue.ID != planID {
+		t.Errorf("list selection %s should follow the plan's %s", item.Issue.ID, planID)
+	}
+	if view := m.View(); !strings.Contains(view, "ACTIONABLE") {
+		t.Errorf("left pane should render the plan:\n%s", view)
+	}
+
+	if got := LoadSplitLayout(); got != m.splitLayout {
+		t.Errorf("saved layout %+v, want %+v", got, m.splitLayout)
+	}
+	if reopened := NewModel(issues, nil, ""); reopened.splitLayout != m.splitLayout {
+		t.Errorf("a new session should restore %+v, got %+v", m.splitLayout, reopened.splitLayout)
+	}
+}
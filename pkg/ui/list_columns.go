@@ -0,0 +1,214 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+)
+
+// ListColumn is a column of the issue list. Columns before the title sit
+// on the left of the row, columns after it are aligned on the right.
+type ListColumn struct {
+	Name  string
+	Width int // 0 uses the column's default
+}
+
+// listColumnDef describes a column the list can show
+type listColumnDef struct {
+	name     string
+	width    int // Default width; 0 when the content sets it
+	minWidth int // Hidden while the list is this narrow or narrower
+}
+
+// listColumnDefs lists the columns in the order a shown column is slotted
+// back into the row
+var listColumnDefs = []listColumnDef{
+	{name: "type"},
+	{name: "priority"},
+	{name: "status"},
+	{name: "id", width: 35},
+	{name: "title"},
+	{name: "created", width: 8, minWidth: 60},
+	{name: "updated", width: 8, minWidth: 60},
+	{name: "due", width: 10, minWidth: 60},
+	{name: "estimate", width: 5, minWidth: 60},
+	{name: "comments", minWidth: 60},
+	{name: "score", width: 5, minWidth: 120},
+	{name: "triage", width: 4, minWidth: 100},
+	{name: "assignee", width: 12, minWidth: 100},
+	{name: "labels", width: 20, minWidth: 140},
+}
+
+// listColumnAliases maps the names recipes may also use
+var listColumnAliases = map[string]string{
+	"tags":     "labels",
+	"age":      "created",
+	"pagerank": "score",
+}
+
+func listColumnDefFor(name string) (listColumnDef, bool) {
+	for _, def := range listColumnDefs {
+		if def.name == name {
+			return def, true
+		}
+	}
+	return listColumnDef{}, false
+}
+
+// width returns the column's width, its default when none was set
+func (c ListColumn) width() int {
+	if c.Width > 0 {
+		return c.Width
+	}
+	def, _ := listColumnDefFor(c.Name)
+	return def.width
+}
+
+// DefaultListColumns returns the columns the list shows unless a recipe
+// or the user chose others
+func DefaultListColumns() []ListColumn {
+	return []ListColumn{
+		{Name: "type"}, {Name: "priority"}, {Name: "status"}, {Name: "id"}, {Name: "title"},
+		{Name: "created"}, {Name: "comments"}, {Name: "score"}, {Name: "assignee"}, {Name: "labels"},
+	}
+}
+
+// ParseListColumns reads columns written as "name" or "name:width", as in
+// a recipe's view.columns. The title is required: it takes the space the
+// other columns leave.
+func ParseListColumns(specs []string) ([]ListColumn, error) {
+	var cols []ListColumn
+	seen := map[string]bool{}
+	for _, spec := range specs {
+		name, widthStr, hasWidth := strings.Cut(strings.ToLower(strings.TrimSpace(spec)), ":")
+		if alias, ok := listColumnAliases[name]; ok {
+			name = alias
+		}
+		def, ok := listColumnDefFor(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown list column %q", spec)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("list column %q given twice", name)
+		}
+		seen[name] = true
+		col := ListColumn{Name: name}
+		if hasWidth {
+			if def.width == 0 {
+				return nil, fmt.Errorf("list column %q has no width to set", name)
+			}
+			w, err := strconv.Atoi(widthStr)
+			if err != nil || w < 1 || w > 80 {
+				return nil, fmt.Errorf("list column %q: width must be 1-80", name)
+			}
+			col.Width = w
+		}
+		cols = append(cols, col)
+	}
+	if !seen["title"] {
+		return nil, fmt.Errorf("list columns must include title")
+	}
+	return cols, nil
+}
+
+// listColumns returns the columns the list shows: the active recipe's, or
+// else the user's
+func (m Model) listColumns() []ListColumn {
+	if m.recipeColumns != nil {
+		return m.recipeColumns
+	}
+	if m.userColumns != nil {
+		return m.userColumns
+	}
+	return DefaultListColumns()
+}
+
+// setRecipeColumns shows the recipe's view.columns while it is active. A
+// recipe without any leaves the user's columns in place.
+func (m *Model) setRecipeColumns(r *recipe.Recipe) {
+	m.recipeColumns = nil
+	if r == nil || len(r.View.Columns) == 0 {
+		return
+	}
+	cols, err := ParseListColumns(r.View.Columns)
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("Recipe %s: %v", r.Name, err)
+		m.statusIsError = true
+		return
+	}
+	m.recipeColumns = cols
+}
+
+// toggleListColumn shows or hides a column of the list. A shown column
+// goes back to its usual place among the others.
+func (m *Model) toggleListColumn(name string) {
+	cols := append([]ListColumn(nil), m.listColumns()...)
+	shown := false
+	for i, c := range cols {
+		if c.Name == name {
+			cols = append(cols[:i], cols[i+1:]...)
+			shown = true
+			break
+		}
+	}
+	if !shown {
+		cols = insertListColumn(cols, ListColumn{Name: name})
+	}
+	if m.recipeColumns != nil {
+		m.recipeColumns = cols
+	} else {
+		m.userColumns = cols
+	}
+	m.updateListDelegate()
+	if shown {
+		m.statusMsg = "Hid column " + name
+	} else {
+		m.statusMsg = "Showing column " + name
+	}
+	m.statusIsError = false
+}
+
+// resetListColumns goes back to the recipe's columns, or the defaults
+func (m *Model) resetListColumns() {
+	m.userColumns = nil
+	m.setRecipeColumns(m.activeRecipe)
+	m.updateListDelegate()
+	m.statusMsg = "List columns reset"
+	m.statusIsError = false
+}
+
+// insertListColumn puts col before the first column that comes after it
+// in listColumnDefs
+func insertListColumn(cols []ListColumn, col ListColumn) []ListColumn {
+	rank := func(name string) int {
+		for i, def := range listColumnDefs {
+			if def.name == name {
+				return i
+			}
+		}
+		return len(listColumnDefs)
+	}
+	at := len(cols)
+	for i, c := range cols {
+		if rank(c.Name) > rank(col.Name) {
+			at = i
+			break
+		}
+	}
+	cols = append(cols, ListColumn{})
+	copy(cols[at+1:], cols[at:])
+	cols[at] = col
+	return cols
+}
+
+// hasListColumn reports whether the list shows the named column
+func (m Model) hasListColumn(name string) bool {
+	for _, c := range m.listColumns() {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestParseListColumns(t *testing.T) {
+	cols, err := ParseListColumns([]string{"type", "ID", "title", "due", "assignee:16", "tags", "pagerank"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ListColumn{{Name: "type"}, {Name: "id"}, {Name: "title"}, {Name: "due"}, {Name: "assignee", Width: 16}, {Name: "labels"}, {Name: "score"}}
+	if len(cols) != len(want) {
+		t.Fatalf("got %+v", cols)
+	}
+	for i := range want {
+		if cols[i] != want[i] {
+			t.Errorf("column %d = %+v, want %+v", i, cols[i], want[i])
+		}
+	}
+	if cols[4].width() != 16 || cols[3].width() != 10 {
+		t.Errorf("widths = %d, %d", cols[4].width(), cols[3].width())
+	}
+
+	for _, bad := range [][]string{
+		{"id", "status"},
+		{"title", "blockers"},
+		{"title", "id", "id"},
+		{"title", "tags", "labels"},
+		{"title", "status:4"},
+		{"title", "due:0"},
+		{"title", "due:wide"},
+	} {
+		if _, err := ParseListColumns(bad); err == nil {
+			t.Errorf("%v should be rejected", bad)
+		}
+	}
+}
+
+func TestIssueDelegate_RenderColumns(t *testing.T) {
+	item := newTestIssueItem("TASK-1")
+	due := time.Now().Add(-24 * time.Hour)
+	minutes := 90
+	item.Issue.DueDate = &due
+	item.Issue.EstimatedMinutes = &minutes
+
+	render := func(columns []string) string {
+		t.Helper()
+		d := IssueDelegate{Theme: DefaultTheme(lipgloss.NewRenderer(os.Stdout))}
+		if columns != nil {
+			cols, err := ParseListColumns(columns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d.Columns = cols
+		}
+		l := list.New([]list.Item{item}, d, 0, 0)
+		l.SetWidth(160)
+		var buf bytes.Buffer
+		d.Render(&buf, l, 0, item)
+		return buf.String()
+	}
+
+	out := render([]string{"priority", "title", "due", "estimate"})
+	if strings.Contains(out, "TASK-1") || strings.Contains(out, "@alice") || strings.Contains(out, "💬1") {
+		t.Errorf("hidden columns should not render: %q", out)
+	}
+	if !strings.Contains(out, due.Format("2006-01-02")) || !strings.Contains(out, "1h30m") {
+		t.Errorf("due and estimate should render: %q", out)
+	}
+	if !strings.Contains(out, "Short title for testing") {
+		t.Errorf("title missing: %q", out)
+	}
+	if lipgloss.Width(out) != lipgloss.Width(render(nil)) {
+		t.Errorf("rows should fill the same width: %d vs %d", lipgloss.Width(out), lipgloss.Width(render(nil)))
+	}
+
+	if out := render([]string{"title", "assignee:3"}); !strings.Contains(out, "@al…") {
+		t.Errorf("assignee should be cut to its width: %q", out)
+	}
+}
+
+func TestListColumnsFromRecipeAndPalette(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "1", Title: "x", Status: model.StatusOpen}}, nil, "")
+	if got := m.listColumns(); len(got) != len(DefaultListColumns()) {
+		t.Fatalf("default columns = %+v", got)
+	}
+
+	m = runPalette(t, m, "hide column assignee")
+	m = runPalette(t, m, "show column due")
+	if m.hasListColumn("assignee") || !m.hasListColumn("due") {
+		t.Fatalf("palette should toggle columns, got %+v", m.listColumns())
+	}
+	cols := m.listColumns()
+	if cols[6].Name != "due" {
+		t.Errorf("due should slot in after created, got %+v", cols)
+	}
+
+	// A recipe's columns win while it is active, then the user's come back
+	m.setActiveRecipe(&recipe.Recipe{Name: "slim", View: recipe.ViewConfig{Columns: []string{"id", "title", "estimate:6"}}})
+	if got := m.listColumns(); len(got) != 3 || got[2].Width != 6 {
+		t.Fatalf("recipe columns = %+v", got)
+	}
+	m.setActiveRecipe(&recipe.Recipe{Name: "bad", View: recipe.ViewConfig{Columns: []string{"id", "blockers"}}})
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "blockers") || !m.hasListColumn("due") {
+		t.Errorf("bad recipe columns should warn and keep the user's, status %q", m.statusMsg)
+	}
+
+	m = runPalette(t, m, "reset list columns")
+	if m.hasListColumn("due") || !m.hasListColumn("assignee") {
+		t.Errorf("reset should bring back the defaults, got %+v", m.listColumns())
+	}
+}
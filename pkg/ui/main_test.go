@@ -9,6 +9,8 @@ func TestMain(m *testing.M) {
 	// Prevent any test from accidentally opening a browser
 	os.Setenv("BV_NO_BROWSER", "1")
 	os.Setenv("BV_TEST_MODE", "1")
+	// Render with full Unicode regardless of the CI terminal
+	SetCapabilities(Capabilities{Color: ColorTrueColor, Unicode: true, Images: ImageNone})
 
 	os.Exit(m.Run())
 }
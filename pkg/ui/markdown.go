@@ -159,6 +159,13 @@ func (mr *MarkdownRenderer) SetWidthWithTheme(width int, theme Theme) {
 	}
 }
 
+// SetDarkMode switches between the dark and light variants of the theme's
+// colors and recreates the renderer at its current width.
+func (mr *MarkdownRenderer) SetDarkMode(isDark bool, theme Theme) {
+	mr.isDark = isDark
+	mr.SetWidthWithTheme(mr.width, theme)
+}
+
 // IsDarkMode returns whether the renderer is using dark mode styling.
 func (mr *MarkdownRenderer) IsDarkMode() bool {
 	return mr.isDark
@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// modalKind is what a modal asks of the user
+type modalKind int
+
+const (
+	modalInfo    modalKind = iota // A message to read and dismiss
+	modalConfirm                  // A yes/no question before an action
+	modalError                    // A failure, its details, and maybe a retry
+)
+
+// modalAction runs when a modal is accepted: the confirmed action, or the
+// retry of whatever failed. The modal is closed before it runs.
+type modalAction func(Model) (Model, tea.Cmd)
+
+// Modal is a small dialog over the current view that takes every key until
+// it is answered. Build one with infoModal, confirmModal or errorModal and
+// show it with Model.openModal.
+type Modal struct {
+	kind   modalKind
+	title  string
+	body   string
+	detail string      // Error text, shown set apart from the body
+	accept string      // What accepting does, e.g. "close" or "retry"
+	action modalAction // nil for info, and for errors that can't be retried
+}
+
+// infoModal tells the user something; any of Enter, Esc or q dismisses it
+func infoModal(title, body string) Modal {
+	return Modal{kind: modalInfo, title: title, body: body}
+}
+
+// confirmModal asks before a destructive action: y or Enter runs action,
+// n or Esc backs out. accept names the action on the key hint.
+func confirmModal(title, body, accept string, action modalAction) Modal {
+	return Modal{kind: modalConfirm, title: title, body: body, accept: accept, action: action}
+}
+
+// errorModal reports a failure with err's text as the details. With a
+// retry action, r runs it again.
+func errorModal(title, body string, err error, retry modalAction) Modal {
+	md := Modal{kind: modalError, title: title, body: body, action: retry}
+	if err != nil {
+		md.detail = err.Error()
+	}
+	if retry != nil {
+		md.accept = "retry"
+	}
+	return md
+}
+
+// openModal shows md over the current view, replacing any modal already
+// open; closing it returns to the view it opened over
+func (m *Model) openModal(md Modal) {
+	if m.focused != focusModal {
+		m.modalFrom = m.focused
+	}
+	m.modal = &md
+	m.focused = focusModal
+}
+
+func (m *Model) closeModal() {
+	m.modal = nil
+	m.focused = m.modalFrom
+}
+
+// handleModalKeys answers the open modal
+func (m Model) handleModalKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	md := *m.modal
+	key := msg.String()
+	accepted := false
+	switch md.kind {
+	case modalInfo:
+		if key != "enter" && key != "esc" && key != "q" && key != " " {
+			return m, nil
+		}
+	case modalConfirm:
+		switch key {
+		case "y", "Y", "enter":
+			accepted = true
+		case "n", "N", "esc", "q":
+		default:
+			return m, nil
+		}
+	case modalError:
+		switch key {
+		case "r", "R":
+			if md.action == nil {
+				return m, nil
+			}
+			accepted = true
+		case "enter", "esc", "q":
+		default:
+			return m, nil
+		}
+	}
+
+	m.closeModal()
+	if accepted && md.action != nil {
+		return md.action(m)
+	}
+	return m, nil
+}
+
+// modalHints are the footer key hints for the open modal
+func (m Model) modalHints(keyStyle lipgloss.Style) []string {
+	md := m.modal
+	switch md.kind {
+	case modalConfirm:
+		return []string{keyStyle.Render("y/⏎") + " " + md.accept, keyStyle.Render("n/esc") + " cancel"}
+	case modalError:
+		if md.action != nil {
+			return []string{keyStyle.Render("r") + " " + md.accept, keyStyle.Render("esc") + " dismiss"}
+		}
+		return []string{keyStyle.Render("esc") + " dismiss"}
+	}
+	return []string{keyStyle.Render("⏎/esc") + " close"}
+}
+
+// renderModal draws the open modal centered over the screen. Confirmations
+// and errors are framed in the blocked color, information in the primary.
+func (m Model) renderModal() string {
+	md := m.modal
+	t := m.theme
+
+	boxWidth := min(max(m.width-10, 36), 64)
+	inner := boxWidth - 6
+
+	accent := t.Primary
+	if md.kind != modalInfo {
+		accent = t.Blocked
+	}
+	titleStyle := t.Renderer.NewStyle().Foreground(accent).Bold(true)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	detailStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	keyStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	dimStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+
+	lines := []string{titleStyle.Render(truncateRunesHelper(md.title, inner, "…"))}
+	if md.body != "" {
+		lines = append(lines, "")
+		for _, line := range strings.Split(wrapText(md.body, inner), "\n") {
+			lines = append(lines, textStyle.Render(line))
+		}
+	}
+	if md.detail != "" {
+		lines = append(lines, "")
+		detail := strings.Split(wrapText(md.detail, inner-2), "\n")
+		const maxDetailLines = 6
+		if len(detail) > maxDetailLines {
+			detail = append(detail[:maxDetailLines-1], "…")
+		}
+		for _, line := range detail {
+			lines = append(lines, detailStyle.Render("  "+line))
+		}
+	}
+
+	var keys []string
+	switch md.kind {
+	case modalConfirm:
+		keys = []string{keyStyle.Render("y") + dimStyle.Render(" "+md.accept), keyStyle.Render("n") + dimStyle.Render(" cancel")}
+	case modalError:
+		if md.action != nil {
+			keys = append(keys, keyStyle.Render("r")+dimStyle.Render(" "+md.accept))
+		}
+		keys = append(keys, keyStyle.Render("esc")+dimStyle.Render(" dismiss"))
+	default:
+		keys = []string{keyStyle.Render("enter") + dimStyle.Render(" close")}
+	}
+	lines = append(lines, "", strings.Join(keys, dimStyle.Render("   ")))
+
+	box := t.Renderer.NewStyle().
+		Border(termBorder(lipgloss.RoundedBorder())).
+		BorderForeground(accent).
+		Padding(1, 2).
+		Width(boxWidth).
+		Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestModalKinds(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	m := NewModel(issues, nil, "")
+	m.beadsPath = writeTempBeadsFile(t, t.TempDir(), issues...)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	m.focused = focusBoard
+
+	// Info: other keys are swallowed, enter closes back to the view below
+	m.openModal(infoModal("Heads up", "Something worth knowing."))
+	m, _ = pressEdit(m, runeKeys("j")...)
+	if m.FocusState() != "modal" {
+		t.Fatalf("info modal should take j, focus=%s", m.FocusState())
+	}
+	if view := m.View(); !strings.Contains(view, "Heads up") || !strings.Contains(view, "Something worth knowing.") {
+		t.Errorf("modal not drawn:\n%s", view)
+	}
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.FocusState() != "board" || m.modal != nil {
+		t.Errorf("enter should return to the board, focus=%s", m.FocusState())
+	}
+
+	// Confirm: n backs out without running the action, y runs it
+	ran := 0
+	action := func(m Model) (Model, tea.Cmd) {
+		ran++
+		return m, nil
+	}
+	m.openModal(confirmModal("Really?", "", "do it", action))
+	m, _ = pressEdit(m, runeKeys("n")...)
+	if ran != 0 || m.FocusState() != "board" {
+		t.Errorf("n should cancel, ran=%d focus=%s", ran, m.FocusState())
+	}
+	m.openModal(confirmModal("Really?", "", "do it", action))
+	m, _ = pressEdit(m, runeKeys("y")...)
+	if ran != 1 || m.modal != nil {
+		t.Errorf("y should run the action once, ran=%d", ran)
+	}
+
+	// Error: details shown; r does nothing without a retry
+	m.openModal(errorModal("Could not save", "", errors.New("disk full"), nil))
+	if view := m.View(); !strings.Contains(view, "disk full") || strings.Contains(view, "retry") {
+		t.Errorf("error modal should show details and no retry:\n%s", view)
+	}
+	m, _ = pressEdit(m, runeKeys("r")...)
+	if m.FocusState() != "modal" {
+		t.Error("r without a retry should leave the modal open")
+	}
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if m.modal != nil {
+		t.Error("esc should dismiss the error")
+	}
+}
+
+func TestBulkCloseAsksFirst(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, Labels: []string{"auth"}},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, Labels: []string{"ui", "theme"}},
+	}
+	m := NewModel(issues, nil, "")
+	m.beadsPath = writeTempBeadsFile(t, t.TempDir(), issues...)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	m, _ = pressEdit(m, runeKeys("  e")...)
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyLeft}, tea.KeyMsg{Type: tea.KeyEnter}) // unchanged -> closed
+	if cmd != nil || m.FocusState() != "modal" || !strings.Contains(m.View(), "Close 2 issues?") {
+		t.Fatalf("closing marked issues should ask first, focus=%s", m.FocusState())
+	}
+
+	m, cmd = pressEdit(m, tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd != nil || len(m.marked) != 2 || m.issueMap["bv-1"].Status != model.StatusOpen {
+		t.Errorf("backing out should keep the marks and write nothing, marked %v", m.marked)
+	}
+}
+
+func TestFailedEditOffersRetry(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+
+	m, _ = pressEdit(m, runeKeys("e")...)
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyRight}, tea.KeyMsg{Type: tea.KeyEnter})
+	m = runWrite(t, m, cmd)
+	if m.FocusState() != "modal" || m.issueMap["bv-1"].Status != model.StatusOpen {
+		t.Fatalf("failed save should revert and open an error modal, focus=%s", m.FocusState())
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m, cmd = pressEdit(m, runeKeys("r")...)
+	m = runWrite(t, m, cmd)
+	if got := statusInFile(t, path, "bv-1"); got != model.StatusInProgress || !m.editHistory.CanUndo() {
+		t.Errorf("retry should write the edit, file has %s, status %q", got, m.statusMsg)
+	}
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"os"
@@ -61,40 +62,126 @@ const (
 	focusHistory
 	focusAttention
 	focusLabelPicker
-	focusSprint      // Sprint dashboard view (bv-161)
-	focusAgentPrompt // AGENTS.md integration prompt (bv-i8dk)
-	focusFlowMatrix  // Cross-label flow matrix view
-	focusTutorial    // Interactive tutorial (bv-8y31)
-	focusCassModal   // Cass session preview modal (bv-5bqh)
-	focusUpdateModal // Self-update modal (bv-182)
+	focusSprint         // Sprint dashboard view (bv-161)
+	focusAgentPrompt    // AGENTS.md integration prompt (bv-i8dk)
+	focusFlowMatrix     // Cross-label flow matrix view
+	focusTutorial       // Interactive tutorial (bv-8y31)
+	focusCassModal      // Cass session preview modal (bv-5bqh)
+	focusUpdateModal    // Self-update modal (bv-182)
+	focusCutLine        // Release cut-line planning view
+	focusTimeline       // Timeline (Gantt) view of the projected schedule
+	focusIssueSearch    // Fuzzy issue search overlay
+	focusFilterBar      // Query filter bar
+	focusCommandPalette // Command palette overlay
+	focusIssueEdit      // Issue edit form
+	focusDepTree        // Blockers and dependents of one issue
+	focusAssignees      // Open issues grouped by assignee
+	focusIssueCreate    // Quick-add issue form
+	focusModal          // Confirmation, error or info modal
+	focusFacets         // Label, type and assignee facet browser
 )
 
-// SortMode represents the current list sorting mode (bv-3ita)
-type SortMode int
+// SortField is the field the list and actionable view sort by (bv-3ita)
+type SortField int
 
 const (
-	SortDefault     SortMode = iota // Priority asc, then created desc (original default)
-	SortCreatedAsc                  // By creation date, oldest first
-	SortCreatedDesc                 // By creation date, newest first
-	SortPriority                    // By priority only (ascending)
-	SortUpdated                     // By last update, newest first
-	numSortModes                    // Keep this last - used for cycling
+	SortDefault   SortField = iota // Open first, then priority asc, then created desc (original default)
+	SortPriority                   // By priority (P0 first ascending)
+	SortUpdated                    // By last update (newest first descending)
+	SortPageRank                   // By PageRank score (highest first descending)
+	SortCreated                    // By creation date (newest first descending)
+	SortID                         // By issue ID
+	numSortFields                  // Keep this last - used for cycling
 )
 
+// SortMode represents the current sorting: a field and its direction
+type SortMode struct {
+	Field SortField
+	Desc  bool
+}
+
 // String returns a human-readable label for the sort mode
 func (s SortMode) String() string {
-	switch s {
-	case SortCreatedAsc:
-		return "Created ↑"
-	case SortCreatedDesc:
-		return "Created ↓"
+	name := ""
+	switch s.Field {
 	case SortPriority:
-		return "Priority"
+		name = "Priority"
 	case SortUpdated:
-		return "Updated"
+		name = "Updated"
+	case SortPageRank:
+		name = "PageRank"
+	case SortCreated:
+		name = "Created"
+	case SortID:
+		name = "ID"
 	default:
 		return "Default"
 	}
+	if s.Desc {
+		return name + " ↓"
+	}
+	return name + " ↑"
+}
+
+// recipeField names the field as a recipe's sort.field
+func (s SortMode) recipeField() string {
+	switch s.Field {
+	case SortPriority:
+		return "priority"
+	case SortUpdated:
+		return "updated"
+	case SortPageRank:
+		return "pagerank"
+	case SortCreated:
+		return "created"
+	case SortID:
+		return "id"
+	}
+	return ""
+}
+
+// defaultSortMode returns the field with the direction it first sorts in:
+// newest and highest-ranked first, priority and ID ascending
+func defaultSortMode(field SortField) SortMode {
+	return SortMode{Field: field, Desc: field == SortUpdated || field == SortPageRank || field == SortCreated}
+}
+
+// less reports whether a sorts before b. Outside the default order, ties
+// fall back to the ID so equal issues keep a stable order.
+func (s SortMode) less(a, b model.Issue, aRank, bRank float64) bool {
+	c := 0
+	switch s.Field {
+	case SortPriority:
+		c = cmp.Compare(a.Priority, b.Priority)
+	case SortUpdated:
+		c = a.UpdatedAt.Compare(b.UpdatedAt)
+	case SortPageRank:
+		c = cmp.Compare(aRank, bRank)
+	case SortCreated:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	case SortID:
+	default:
+		// Default: Open first, then priority, then newest
+		aClosed := isClosedLikeStatus(a.Status)
+		bClosed := isClosedLikeStatus(b.Status)
+		if aClosed != bClosed {
+			return !aClosed
+		}
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.CreatedAt.After(b.CreatedAt)
+	}
+	if s.Desc {
+		c = -c
+	}
+	if c != 0 {
+		return c < 0
+	}
+	if s.Desc && s.Field == SortID {
+		return a.ID > b.ID
+	}
+	return a.ID < b.ID
 }
 
 // LabelGraphAnalysisResult holds label-specific graph analysis results (bv-109)
@@ -342,7 +429,20 @@ type Model struct {
 	// backgroundWorker manages async data loading (nil if background mode disabled)
 	backgroundWorker *BackgroundWorker
 	workerSpinnerIdx int // Spinner frame for background worker activity (bv-9nfy)
-	lastForceRefresh time.Time
+
+	// Phase 2 progress in the status bar: spinner frame, and how many
+	// metrics the list has been refreshed with
+	analysisSpinnerIdx int
+	analysisSeen       int
+	lastForceRefresh   time.Time
+
+	// Data-source widget (ctrl+g): what is loaded, from where, and whether
+	// the file on disk has moved on since. dataHash is only kept in legacy
+	// (non-background) mode; snapshots carry their own.
+	showDataSource bool
+	dataLoadedAt   time.Time
+	dataHash       string
+	diskModTime    time.Time
 
 	// UI Components
 	list               list.Model
@@ -357,6 +457,9 @@ type Model struct {
 	insightsPanel      InsightsModel
 	flowMatrix         FlowMatrixModel // Cross-label flow matrix
 	theme              Theme
+	themes             ThemeSet // User-defined themes from themes.yaml
+	themeName          string   // Active user theme, "" for the default
+	detectedDark       bool     // Terminal background found at start, for themes that follow it
 
 	// Update State
 	updateAvailable bool
@@ -367,13 +470,16 @@ type Model struct {
 	focused                  focus
 	focusBeforeHelp          focus // Stores focus before opening help overlay
 	isSplitView              bool
+	splitLayout              SplitLayout // Panes and divider of the split view, saved across sessions
 	isBoardView              bool
 	isGraphView              bool
 	isActionableView         bool
 	isHistoryView            bool
 	showDetails              bool
 	showHelp                 bool
-	helpScroll               int // Scroll offset for help overlay
+	helpScroll               int     // Scroll offset for help overlay
+	helpContext              Context // View whose keys the help overlay lists
+	helpShowAll              bool    // Help overlay lists every view's keys
 	showQuitConfirm          bool
 	ready                    bool
 	width                    int
@@ -388,7 +494,14 @@ type Model struct {
 	showLabelGraphAnalysis   bool
 	labelGraphAnalysisResult *LabelGraphAnalysisResult
 	showAttentionView        bool
-	showShortcutsSidebar     bool // bv-3qi5 toggleable shortcuts sidebar
+	showShortcutsSidebar     bool        // bv-3qi5 toggleable shortcuts sidebar
+	showStatusBar            bool        // bottom line with dataset, counts, filter, sort and load age
+	detailTrail              detailTrail // dependencies followed from the detail view
+	detailLinkIssue          string      // issue whose link the n/N cursor is on
+	detailLinkCursor         int
+	dependents               map[string][]detailLink // issues depending on each issue, for the detail links
+	statusCounts             map[model.Status]int    // issues per status, for the status bar
+	openTrend                []int                   // open issues at the end of each recent day, for the status bar
 	labelHealthCached        bool
 	labelHealthCache         analysis.LabelAnalysisResult
 	attentionCached          bool
@@ -396,6 +509,22 @@ type Model struct {
 
 	// Actionable view
 	actionableView ActionableModel
+	planOptions    analysis.PlanOptions // WIP limits and batching for the plan
+
+	// Release cut-line view
+	cutLineView CutLineModel
+
+	// Dependency tree of one issue
+	depTreeView DepTreeModel
+
+	// Open issues grouped by assignee
+	assigneeView AssigneeModel
+
+	// Facet browser (labels, types, assignees with counts)
+	facetView FacetModel
+
+	// Timeline view
+	timelineView TimelineModel
 
 	// History view
 	historyView       HistoryModel
@@ -435,23 +564,92 @@ type Model struct {
 	showRecipePicker bool
 	recipePicker     RecipePickerModel
 	activeRecipe     *recipe.Recipe
+	recipeColumns    []ListColumn // Active recipe's view.columns, nil when it has none
+	userColumns      []ListColumn // Columns chosen at runtime, nil for the defaults
 	recipeLoader     *recipe.Loader
 
 	// Label picker (bv-126)
 	showLabelPicker bool
 	labelPicker     LabelPickerModel
 
+	// Issue search overlay; Enter returns to issueSearchFrom with the match selected
+	showIssueSearch bool
+	issueSearch     IssueSearchModel
+	issueSearchFrom focus
+
+	// Query filter bar; esc restores the recipe/filter in force when it opened
+	showFilterBar       bool
+	filterBar           FilterBarModel
+	filterBarFrom       focus
+	filterBarPrevRecipe *recipe.Recipe
+	filterBarPrevFilter string
+
+	// Command palette; commands run from commandPaletteFrom
+	showCommandPalette bool
+	commandPalette     CommandPaletteModel
+	commandPaletteFrom focus
+
+	// Issue edit form; edits are applied at once and written in the background
+	showIssueEdit bool
+	issueEdit     IssueEditModel
+	issueEditFrom focus
+	editHistory   editHistory
+
+	// Quick-add form; the new issue is appended to the beads file
+	showIssueCreate bool
+	issueCreate     IssueCreateModel
+	issueCreateFrom focus
+
+	// Confirmation, error and info modal over whatever view is open
+	modal     *Modal
+	modalFrom focus
+
+	// Work timer: the issue the current user's timer runs on, and whether
+	// the tick refreshing its elapsed time is scheduled
+	timerIssueID string
+	timerTicking bool
+
+	// Toasts about background results, oldest first
+	toasts   []toast
+	toastSeq int
+
+	// Vim-style motions: a count being typed, a key waiting for its second
+	// half (m, ' or 5g), letter marks to jump to, and when g last left the
+	// list or plan for the graph, so a quick second g makes gg
+	motionCount   int
+	motionPending string
+	jumpMarks     map[string]string
+	ggFrom        focus
+	ggAt          time.Time
+
+	// Issues marked for bulk actions; markAnchor is set while V marks a
+	// range, and markBase holds the marks made before it
+	marked     map[string]bool
+	markAnchor string
+	markBase   map[string]bool
+
+	// Issues pinned to the top of the list and plan, saved to pinsPath
+	// (empty when there is no beads file to keep them beside)
+	pinned   map[string]bool
+	pinsPath string
+
+	// Actionable tracks ordered by hand (J/K), saved to planOrderPath
+	planOrder     [][]string
+	planOrderPath string
+
 	// Repo picker (workspace mode)
 	showRepoPicker bool
 	repoPicker     RepoPickerModel
 
 	// Time-travel mode
-	timeTravelMode   bool
-	timeTravelDiff   *analysis.SnapshotDiff
-	timeTravelSince  string
-	newIssueIDs      map[string]bool // Issues in diff.NewIssues
-	closedIssueIDs   map[string]bool // Issues in diff.ClosedIssues
-	modifiedIssueIDs map[string]bool // Issues in diff.ModifiedIssues
+	timeTravelMode      bool
+	timeTravelDiff      *analysis.SnapshotDiff
+	timeTravelGraphDiff *analysis.GraphDiff
+	timeTravelSince     string
+	newIssueIDs         map[string]bool // Issues in diff.NewIssues
+	closedIssueIDs      map[string]bool // Issues in diff.ClosedIssues
+	modifiedIssueIDs    map[string]bool // Issues in diff.ModifiedIssues
+	blockedFlipIDs      map[string]bool // Issues in graph diff BlockedFlips (true = newly blocked)
 
 	// Time-travel input prompt
 	timeTravelInput      textinput.Model
@@ -633,6 +831,9 @@ func (m *Model) updateListDelegate() {
 		PriorityHints:     m.priorityHints,
 		WorkspaceMode:     m.workspaceMode,
 		ShowSearchScores:  m.shouldShowSearchScores(),
+		Marked:            m.marked,
+		Pinned:            m.pinned,
+		Columns:           m.listColumns(),
 	})
 }
 
@@ -694,6 +895,15 @@ func (m *Model) clearSemanticScores() {
 	}
 }
 
+// reindexIssues rebuilds the lookups that would otherwise scan every issue
+// on each keystroke. Call it whenever m.issues is replaced.
+func (m *Model) reindexIssues() {
+	m.dependents = indexDependents(m.issues)
+	m.statusCounts = countStatuses(m.issues)
+	m.openTrend = openTrend(m.issues, time.Now(), trendDays)
+	m.timerIssueID = findRunningTimer(m.issues)
+}
+
 func (m *Model) issuesForAsync() []model.Issue {
 	if m == nil {
 		return nil
@@ -715,6 +925,13 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	if activeRecipe != nil && activeRecipe.Sort.Field != "" {
 		r := activeRecipe
 		descending := r.Sort.Direction == "desc"
+		var staleness, risk map[string]float64
+		switch r.Sort.Field {
+		case "staleness":
+			staleness = analysis.StalenessScores(issues, time.Now())
+		case "risk":
+			risk = analysis.RiskScoreMap(analysis.RiskScores(issues, time.Now(), analysis.RecipeRiskScoreWeights(r)))
+		}
 
 		sort.Slice(issues, func(i, j int) bool {
 			less := false
@@ -729,6 +946,12 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 				less = graphStats.GetCriticalPathScore(issues[i].ID) < graphStats.GetCriticalPathScore(issues[j].ID)
 			case "pagerank":
 				less = graphStats.GetPageRankScore(issues[i].ID) < graphStats.GetPageRankScore(issues[j].ID)
+			case "staleness":
+				less = staleness[issues[i].ID] < staleness[issues[j].ID]
+			case "risk":
+				less = risk[issues[i].ID] < risk[issues[j].ID]
+			case "id":
+				less = issues[i].ID < issues[j].ID
 			default:
 				less = issues[i].Priority < issues[j].Priority
 			}
@@ -799,8 +1022,17 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		}
 	}
 
-	// Theme
-	theme := DefaultTheme(lipgloss.NewRenderer(os.Stdout))
+	// Theme, limited to what the terminal can display
+	themeRenderer := lipgloss.NewRenderer(os.Stdout)
+	TermCapabilities().LimitRenderer(themeRenderer)
+	themes := LoadThemes()
+	startTheme, _ := themes.Get(themes.Start)
+	detectedDark := DetectDarkBackground(os.Getenv, themeRenderer)
+	dark := startTheme.Background.isDark(detectedDark)
+	themeRenderer.SetHasDarkBackground(dark)
+	lipgloss.SetHasDarkBackground(dark)
+	applyThemeGlobals(startTheme)
+	theme := startTheme.Apply(baseTheme(themeRenderer))
 
 	// Default dimensions for immediate ready state (updated when WindowSizeMsg arrives)
 	// This eliminates the "Initializing..." phase entirely, fixing slow startup issues
@@ -809,7 +1041,27 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	const defaultHeight = 40
 
 	// List setup - initialize with default dimensions so UI is immediately usable
-	delegate := IssueDelegate{Theme: theme, WorkspaceMode: false}
+	marked := make(map[string]bool)
+	timerIssueID := findRunningTimer(issues)
+	// A recipe's view.columns, when it has any, lay out the list
+	var recipeColumns []ListColumn
+	var columnsErr error
+	if activeRecipe != nil && len(activeRecipe.View.Columns) > 0 {
+		recipeColumns, columnsErr = ParseListColumns(activeRecipe.View.Columns)
+	}
+	// Pins and the hand order of the plan persist beside the beads file
+	var pinsPath, planOrderPath string
+	var planOrder [][]string
+	pinned := make(map[string]bool)
+	if beadsPath != "" {
+		pinsPath = PinsPath(filepath.Dir(beadsPath))
+		pinned = loadPins(pinsPath)
+		planOrderPath = PlanOrderPath(filepath.Dir(beadsPath))
+		planOrder = loadPlanOrder(planOrderPath)
+	}
+	pinFirst(items, pinned)
+
+	delegate := IssueDelegate{Theme: theme, WorkspaceMode: false, Marked: marked, Pinned: pinned, Columns: recipeColumns}
 	l := list.New(items, delegate, defaultWidth, defaultHeight-3)
 	l.Title = ""
 	l.SetShowTitle(false)
@@ -897,6 +1149,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	recipeLoader := recipe.NewLoader()
 	_ = recipeLoader.Load() // Load recipes (errors are non-fatal, will just show empty)
 	recipePicker := NewRecipePickerModel(recipeLoader.List(), theme)
+	recipePicker.source = recipeLoader.Source
 
 	// Initialize label picker (bv-126)
 	labelExtraction := analysis.ExtractLabels(issues)
@@ -985,6 +1238,12 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	} else if watcherErr != nil {
 		initialStatus = fmt.Sprintf("Live reload unavailable: %v", watcherErr)
 		initialStatusErr = true
+	} else if themes.Warning != "" {
+		initialStatus = "Themes: " + themes.Warning
+		initialStatusErr = true
+	} else if columnsErr != nil {
+		initialStatus = fmt.Sprintf("Recipe %s: %v", activeRecipe.Name, columnsErr)
+		initialStatusErr = true
 	}
 
 	// Precompute drift/health alerts (bv-168)
@@ -1012,6 +1271,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		analysis:               graphStats,
 		beadsPath:              beadsPath,
 		watcher:                fileWatcher,
+		dataLoadedAt:           time.Now(),
 		snapshotInitPending:    backgroundWorker != nil,
 		backgroundWorker:       backgroundWorker,
 		instanceLock:           instLock,
@@ -1026,6 +1286,9 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		tree:                   treeModel,
 		insightsPanel:          insightsPanel,
 		theme:                  theme,
+		themes:                 themes,
+		themeName:              themes.Start,
+		detectedDark:           detectedDark,
 		currentFilter:          "all",
 		semanticSearch:         semanticSearch,
 		semanticHybridEnabled:  false,
@@ -1052,7 +1315,25 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		recipeLoader:        recipeLoader,
 		recipePicker:        recipePicker,
 		activeRecipe:        activeRecipe,
+		recipeColumns:       recipeColumns,
 		labelPicker:         labelPicker,
+		issueSearch:         NewIssueSearchModel(theme),
+		filterBar:           NewFilterBarModel(theme),
+		commandPalette:      NewCommandPaletteModel(theme),
+		issueEdit:           NewIssueEditModel(theme),
+		issueCreate:         NewIssueCreateModel(theme),
+		marked:              marked,
+		pinned:              pinned,
+		pinsPath:            pinsPath,
+		planOrder:           planOrder,
+		planOrderPath:       planOrderPath,
+		dependents:          indexDependents(issues),
+		statusCounts:        countStatuses(issues),
+		openTrend:           openTrend(issues, time.Now(), trendDays),
+		timerIssueID:        timerIssueID,
+		timerTicking:        timerIssueID != "", // Init schedules the tick
+		splitLayout:         LoadSplitLayout(),
+		showStatusBar:       true,
 		labelDrilldownCache: make(map[string][]model.Issue),
 		timeTravelInput:     ti,
 		statusMsg:           initialStatus,
@@ -1087,6 +1368,7 @@ func (m Model) Init() tea.Cmd {
 	cmds := []tea.Cmd{
 		CheckUpdateCmd(),
 		WaitForPhase2Cmd(m.analysis),
+		analysisProgressCmd(m.analysis),
 	}
 	if m.backgroundWorker != nil {
 		cmds = append(cmds, StartBackgroundWorkerCmd(m.backgroundWorker))
@@ -1095,6 +1377,12 @@ func (m Model) Init() tea.Cmd {
 	} else if m.watcher != nil {
 		cmds = append(cmds, WatchFileCmd(m.watcher))
 	}
+	if m.beadsPath != "" {
+		cmds = append(cmds, dataSourceStatCmd(m.beadsPath))
+	}
+	if m.timerTicking {
+		cmds = append(cmds, timerTickCmd())
+	}
 	// Start loading history in background
 	if len(m.issues) > 0 {
 		cmds = append(cmds, LoadHistoryCmd(m.issuesForAsync(), m.beadsPath))
@@ -1238,6 +1526,23 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case toastExpiredMsg:
+		m.dismissToast(msg.id)
+
+	case analysisProgressMsg:
+		if cmd := m.handleAnalysisProgress(msg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+	case graphPreviewMsg:
+		m.graphView.setPreview(msg)
+
+	case dataSourceStatMsg:
+		m.diskModTime = msg.ModTime
+		if m.beadsPath != "" {
+			cmds = append(cmds, dataSourceStatCmd(m.beadsPath))
+		}
+
 	case workerPollTickMsg:
 		if m.backgroundWorker != nil {
 			state := m.backgroundWorker.State()
@@ -1418,6 +1723,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case IssueEditSavedMsg:
+		cmd := m.handleIssueEditSaved(msg)
+		return m, cmd
+
+	case IssueCreatedMsg:
+		cmd := m.handleIssueCreated(msg)
+		return m, cmd
+
+	case TimerSavedMsg:
+		return m.handleTimerSaved(msg)
+
+	case timerTickMsg:
+		return m.handleTimerTick()
+
 	case HistoryLoadedMsg:
 		// Background history loading completed
 		m.historyLoading = false
@@ -1462,10 +1781,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.timeTravelMode {
 			m.timeTravelMode = false
 			m.timeTravelDiff = nil
+			m.timeTravelGraphDiff = nil
 			m.timeTravelSince = ""
 			m.newIssueIDs = nil
 			m.closedIssueIDs = nil
 			m.modifiedIssueIDs = nil
+			m.blockedFlipIDs = nil
 		}
 
 		// Store selected issue ID to restore position after swap
@@ -1505,6 +1826,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Eventually these will be removed when all code reads from snapshot
 		m.issues = msg.Snapshot.Issues
 		m.issueMap = msg.Snapshot.IssueMap
+		m.reindexIssues()
 		m.analyzer = msg.Snapshot.Analyzer
 		m.analysis = msg.Snapshot.Analysis
 		m.countOpen = msg.Snapshot.CountOpen
@@ -1528,7 +1850,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.labelDrilldownCache = make(map[string][]model.Issue)
 
 		// Recompute alerts for refreshed dataset
-		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
+		if cmd := m.refreshAlerts(); cmd != nil && !firstSnapshot {
+			cmds = append(cmds, cmd)
+		}
 		m.dismissedAlerts = make(map[string]bool)
 		m.showAlertsPanel = false
 
@@ -1575,6 +1899,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					filteredIssues = append(filteredIssues, issue)
 				}
 
+				pinFirst(filteredItems, m.pinned)
 				m.list.SetItems(filteredItems)
 				m.updateSemanticIDs(filteredItems)
 				m.board.SetIssues(filteredIssues)
@@ -1654,6 +1979,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 			m.sortFilteredItems(filteredItems, filteredIssues)
+			pinFirst(filteredItems, m.pinned)
 			m.list.SetItems(filteredItems)
 			m.updateSemanticIDs(filteredItems)
 			if m.snapshot != nil && m.snapshot.BoardState != nil && (!m.workspaceMode || m.activeRepos == nil) && len(filteredIssues) == len(m.snapshot.Issues) {
@@ -1702,8 +2028,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// If the tree view is active, rebuild it from the new snapshot while preserving
 		// user state (selection + persisted expand/collapse) (bv-6n4c).
 		if m.focused == focusTree {
-			m.tree.BuildFromSnapshot(m.snapshot)
-			m.tree.SetSize(m.width, m.height-2)
+			m.buildTreeView()
+		}
+		if m.isActionableView || m.splitLeftIsActionable() {
+			selectedID := m.actionableView.SelectedIssueID()
+			m.buildActionableView()
+			m.actionableView.SelectByID(selectedID)
 		}
 
 		// Refresh detail pane if visible
@@ -1748,16 +2078,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			} else {
 				m.statusMsg = ""
 			}
-		} else if msg.Snapshot.LoadWarningCount > 0 {
-			m.statusMsg = fmt.Sprintf("Reloaded %d issues (%d warnings)", len(m.issues), msg.Snapshot.LoadWarningCount)
+			m.statusIsError = false
 		} else {
-			m.statusMsg = fmt.Sprintf("Reloaded %d issues", len(m.issues))
+			cmds = append(cmds, m.notifyRefresh(len(m.issues), msg.Snapshot.LoadWarningCount, false))
 		}
-		m.statusIsError = false
 
 		// Wait for Phase 2 if not ready
 		if msg.Snapshot.Analysis != nil {
-			cmds = append(cmds, WaitForPhase2Cmd(msg.Snapshot.Analysis))
+			cmds = append(cmds, m.watchAnalysis(msg.Snapshot.Analysis))
 		}
 
 		if m.backgroundWorker != nil {
@@ -1774,11 +2102,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		if msg.Err != nil {
 			if msg.Recoverable {
-				m.statusMsg = fmt.Sprintf("Background reload error (will retry): %v", msg.Err)
+				cmds = append(cmds, m.notify(toastError, fmt.Sprintf("Reload failed (will retry): %v", msg.Err)))
 			} else {
-				m.statusMsg = fmt.Sprintf("Background reload error: %v", msg.Err)
+				cmds = append(cmds, m.notify(toastError, fmt.Sprintf("Reload failed: %v", msg.Err)))
 			}
-			m.statusIsError = true
 		}
 		if m.backgroundWorker != nil {
 			cmds = append(cmds, WaitForBackgroundWorkerMsgCmd(m.backgroundWorker))
@@ -1809,23 +2136,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.timeTravelMode {
 			m.timeTravelMode = false
 			m.timeTravelDiff = nil
+			m.timeTravelGraphDiff = nil
 			m.timeTravelSince = ""
 			m.newIssueIDs = nil
 			m.closedIssueIDs = nil
 			m.modifiedIssueIDs = nil
+			m.blockedFlipIDs = nil
 		}
 
 		// Reload issues from disk
 		// Use custom warning handler to prevent stderr pollution during TUI render (bv-fix)
 		var reloadWarnings []string
+		reloadStart := time.Now()
 		newIssues, err := loader.LoadIssuesFromFileWithOptions(m.beadsPath, loader.ParseOptions{
 			WarningHandler: func(msg string) {
 				reloadWarnings = append(reloadWarnings, msg)
 			},
 		})
 		if err != nil {
-			m.statusMsg = fmt.Sprintf("Reload error: %v", err)
-			m.statusIsError = true
+			cmds = append(cmds, m.notify(toastError, fmt.Sprintf("Reload failed: %v", err)))
 			// Re-start watch for next change
 			if m.watcher != nil {
 				cmds = append(cmds, WatchFileCmd(m.watcher))
@@ -1840,6 +2169,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				selectedID = item.Issue.ID
 			}
 		}
+		var boardSelectedID string
+		if m.focused == focusBoard {
+			if sel := m.board.SelectedIssue(); sel != nil {
+				boardSelectedID = sel.ID
+			}
+		}
 
 		// Apply default sorting (Open first, Priority, Date)
 		sort.Slice(newIssues, func(i, j int) bool {
@@ -1856,6 +2191,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Recompute analysis (async Phase 1/Phase 2) with caching
 		m.issues = newIssues
+		m.dataLoadedAt = reloadStart
+		m.dataHash = ""
+		if m.showDataSource {
+			m.dataHash = analysis.ComputeDataHash(newIssues)
+		}
 		cachedAnalyzer := analysis.NewCachedAnalyzer(newIssues, nil)
 		m.analyzer = cachedAnalyzer.Analyzer
 		m.analysis = cachedAnalyzer.AnalyzeAsync(context.Background())
@@ -1868,6 +2208,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		for i := range m.issues {
 			m.issueMap[m.issues[i].ID] = &m.issues[i]
 		}
+		m.reindexIssues()
 
 		// Clear stale priority hints (will be repopulated after Phase 2)
 		m.priorityHints = make(map[string]*analysis.PriorityRecommendation)
@@ -1901,7 +2242,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Recompute alerts for refreshed dataset
-		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
+		if cmd := m.refreshAlerts(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 		m.dismissedAlerts = make(map[string]bool)
 		m.showAlertsPanel = false
 
@@ -1938,16 +2281,6 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.list.SetItems(items)
 
-		// Restore selection position
-		if selectedID != "" {
-			for i, item := range m.list.Items() {
-				if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
-					m.list.Select(i)
-					break
-				}
-			}
-		}
-
 		// Regenerate sub-views (with Phase 1 data; Phase 2 will update via Phase2ReadyMsg)
 		// Preserve triage data already computed to avoid UI flicker.
 		oldTopPicks := m.insightsPanel.topPicks
@@ -1968,12 +2301,33 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.insightsPanel.SetSize(m.width, bodyHeight)
 		m.graphView.SetIssues(m.issues, &ins)
 
-		// Generate priority recommendations now that Phase 2 is ready
-		m.board = NewBoardModel(m.issues, m.theme)
-
-		// Re-apply recipe filter if active
+		// Update the list, board and graph in place so the filter, sort and
+		// board layout survive the reload
 		if m.activeRecipe != nil {
 			m.applyRecipe(m.activeRecipe)
+		} else {
+			m.applyFilter()
+		}
+
+		// Restore selection position
+		if selectedID != "" {
+			for i, item := range m.list.Items() {
+				if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
+					m.list.Select(i)
+					break
+				}
+			}
+		}
+		if boardSelectedID != "" {
+			_ = m.board.SelectIssueByID(boardSelectedID)
+		}
+		if m.focused == focusTree {
+			m.buildTreeView()
+		}
+		if m.isActionableView || m.splitLeftIsActionable() {
+			actionableID := m.actionableView.SelectedIssueID()
+			m.buildActionableView()
+			m.actionableView.SelectByID(actionableID)
 		}
 
 		// Reload sprints (bv-161)
@@ -2006,15 +2360,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, BuildSemanticIndexCmd(m.issuesForAsync()))
 		}
 
-		if cacheHit {
-			m.statusMsg = fmt.Sprintf("Reloaded %d issues (cached)", len(newIssues))
-		} else {
-			m.statusMsg = fmt.Sprintf("Reloaded %d issues", len(newIssues))
-		}
-		if len(reloadWarnings) > 0 {
-			m.statusMsg += fmt.Sprintf(" (%d warnings)", len(reloadWarnings))
-		}
-		m.statusIsError = false
+		cmds = append(cmds, m.notifyRefresh(len(newIssues), len(reloadWarnings), cacheHit))
 		// Invalidate label-derived caches
 		m.labelHealthCached = false
 		m.labelDrilldownCache = make(map[string][]model.Issue)
@@ -2024,7 +2370,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.watcher != nil {
 			cmds = append(cmds, WatchFileCmd(m.watcher))
 		}
-		cmds = append(cmds, WaitForPhase2Cmd(m.analysis))
+		cmds = append(cmds, m.watchAnalysis(m.analysis))
 		return m, tea.Batch(cmds...)
 
 	case tea.KeyMsg:
@@ -2032,6 +2378,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.statusMsg = ""
 		m.statusIsError = false
 
+		// An open modal takes every key until it is answered
+		if m.focused == focusModal && m.modal != nil {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleModalKeys(msg)
+		}
+
 		// Handle AGENTS.md prompt modal (bv-i8dk)
 		if m.showAgentPrompt {
 			m.agentPromptModal, cmd = m.agentPromptModal.Update(msg)
@@ -2072,7 +2426,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			// Check for dismiss keys
 			switch msg.String() {
-			case "V", "esc", "enter", "q":
+			case "v", "esc", "enter", "q":
 				m.showCassModal = false
 				m.focused = focusList
 				return m, tea.Batch(cmds...)
@@ -2309,6 +2663,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle help overlay toggle (? or F1)
 		if (msg.String() == "?" || msg.String() == "f1") && m.list.FilterState() != list.Filtering {
+			if !m.showHelp {
+				m.helpContext = m.CurrentContext()
+				m.helpShowAll = false
+			}
 			m.showHelp = !m.showHelp
 			if m.showHelp {
 				m.focusBeforeHelp = m.focused // Store current focus before switching to help
@@ -2333,6 +2691,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Right after an undo Ctrl+R redoes, as in vim; F5 always refreshes
+		if msg.String() == "ctrl+r" && m.editHistory.CanRedo() && m.editHistoryKeysFree() {
+			return m.replayEdit(editOpRedo)
+		}
+
 		// Force refresh (bv-4auz): Ctrl+R / F5 triggers an immediate reload.
 		if (msg.String() == "ctrl+r" || msg.String() == "f5") && m.list.FilterState() != list.Filtering {
 			now := time.Now()
@@ -2360,6 +2723,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
+		// Data-source widget: source, freshness, data hash, refresh mode
+		if msg.String() == "ctrl+g" && m.list.FilterState() != list.Filtering {
+			m.showDataSource = !m.showDataSource
+			if m.showDataSource && m.snapshot == nil && m.dataHash == "" {
+				m.dataHash = analysis.ComputeDataHash(m.issues)
+			}
+			return m, nil
+		}
+
 		// Handle shortcuts sidebar toggle (; or F2) - bv-3qi5
 		if (msg.String() == ";" || msg.String() == "f2") && m.list.FilterState() != list.Filtering {
 			m.showShortcutsSidebar = !m.showShortcutsSidebar
@@ -2506,6 +2878,57 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tutorialCmd
 		}
 
+		// The search overlay takes every key while open
+		if m.focused == focusIssueSearch {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleIssueSearchKeys(msg)
+			return m, nil
+		}
+
+		// The command palette takes every key while open
+		if m.focused == focusCommandPalette {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleCommandPaletteKeys(msg)
+		}
+
+		// The edit form takes every key while open
+		if m.focused == focusIssueEdit {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleIssueEditKeys(msg)
+		}
+
+		// The quick-add form takes every key while open
+		if m.focused == focusIssueCreate {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m.handleIssueCreateKeys(msg)
+		}
+
+		// The filter bar takes every key while open
+		if m.focused == focusFilterBar {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleFilterBarKeys(msg)
+			return m, nil
+		}
+
+		// The recipe name prompt takes every key while open
+		if m.focused == focusRecipePicker && m.recipePicker.Saving() {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleRecipePickerKeys(msg)
+			return m, nil
+		}
+
 		// Handle time-travel input first (before global keys intercept letters)
 		// But allow ctrl+c to always quit
 		if m.focused == focusTimeTravelInput {
@@ -2518,7 +2941,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle keys when not filtering
 		if m.list.FilterState() != list.Filtering {
-			switch msg.String() {
+			// The second key of m<letter>, '<letter> and 5gg
+			if m.motionPending != "" && msg.String() != "ctrl+c" {
+				return m.handlePendingMotion(msg), nil
+			}
+
+			// Keys the focused view binds itself skip the global keys and go
+			// straight to it below
+			globalKey := msg.String()
+			if m.viewOwnsKey(globalKey) {
+				globalKey = ""
+			}
+
+			// Alt+1 … Alt+9 recall saved searches from any view
+			if slot := searchSlotKey(globalKey); slot > 0 {
+				m = m.recallSearch(slot)
+				return m, nil
+			}
+
+			switch globalKey {
 			case "ctrl+c":
 				return m, tea.Quit
 
@@ -2533,6 +2974,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.focused = focusList
 					return m, nil
 				}
+				if m.focused == focusCutLine || m.focused == focusTimeline || m.focused == focusDepTree || m.focused == focusAssignees || m.focused == focusFacets {
+					m.focused = focusList
+					return m, nil
+				}
 				if m.focused == focusFlowMatrix {
 					if m.flowMatrix.showDrilldown {
 						m.flowMatrix.showDrilldown = false
@@ -2554,7 +2999,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Quit
 
 			case "esc":
-				// Escape closes modals and goes back
+				// Escape drops marks first, then closes modals and goes back
+				if m.inListScope() && m.cancelMarks() {
+					return m, nil
+				}
 				if m.showDetails && !m.isSplitView {
 					m.showDetails = false
 					m.focused = focusList
@@ -2564,6 +3012,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.focused = focusList
 					return m, nil
 				}
+				if m.focused == focusCutLine || m.focused == focusTimeline || m.focused == focusDepTree || m.focused == focusAssignees || m.focused == focusFacets {
+					m.focused = focusList
+					return m, nil
+				}
 				if m.focused == focusFlowMatrix {
 					if m.flowMatrix.showDrilldown {
 						m.flowMatrix.showDrilldown = false
@@ -2613,6 +3065,54 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.focused = focusQuitConfirm
 				return m, nil
 
+			case "ctrl+f":
+				// Fuzzy issue search from any view
+				m = m.openIssueSearch()
+				return m, nil
+
+			case "Q":
+				// Query filter bar from any view
+				m = m.openFilterBar()
+				return m, nil
+
+			case "ctrl+p":
+				// Command palette from any view; the label picker keeps
+				// ctrl+p for moving up
+				if m.focused != focusLabelPicker {
+					m = m.openCommandPalette()
+					return m, nil
+				}
+
+			case "ctrl+e":
+				// Edit the issue selected in any view
+				m = m.openIssueEdit()
+				return m, nil
+
+			case "ctrl+n":
+				// Quick-add an issue from any view; the label picker keeps
+				// ctrl+n for moving down
+				if m.focused != focusLabelPicker {
+					m = m.openIssueCreate()
+					return m, nil
+				}
+
+			case "ctrl+t":
+				// Start or stop the work timer on the selected issue
+				return m.toggleTimer()
+
+			case "u":
+				// Undo the last edit from any view that isn't taking text
+				if m.editHistoryKeysFree() {
+					return m.replayEdit(editOpUndo)
+				}
+
+			case "<", ">", "\\", "|":
+				// Resize the split view or swap one of its panes
+				if m.isSplitView && (m.focused == focusList || m.focused == focusDetail) {
+					m = m.adjustSplitLayout(msg.String())
+					return m, nil
+				}
+
 			case "tab":
 				if m.isSplitView && !m.isBoardView {
 					if m.focused == focusList {
@@ -2636,7 +3136,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "g":
+				// 5gg in the list, or gg when g follows the g that opened the graph
+				if next, ok := m.startCountedG(); ok {
+					return next, nil
+				}
+				if next, ok := m.finishGG(); ok {
+					return next, nil
+				}
+
 				// Toggle graph view
+				from := m.focused
 				m.clearAttentionOverlay()
 				m.isGraphView = !m.isGraphView
 				m.isBoardView = false
@@ -2644,6 +3153,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.isHistoryView = false
 				if m.isGraphView {
 					m.focused = focusGraph
+					m.leaveForGraph(from)
 				} else {
 					m.focused = focusList
 				}
@@ -2657,11 +3167,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.isBoardView = false
 				m.isHistoryView = false
 				if m.isActionableView {
-					// Build execution plan
-					analyzer := analysis.NewAnalyzer(m.issues)
-					plan := analyzer.GetExecutionPlan()
-					m.actionableView = NewActionableModel(plan, m.theme)
-					m.actionableView.SetSize(m.width, m.height-2)
+					m.buildActionableView()
 					m.focused = focusActionable
 				} else {
 					m.focused = focusList
@@ -2679,12 +3185,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.isActionableView = false
 					m.isHistoryView = false
 					// Build tree from snapshot when available (bv-t435)
-					if m.snapshot != nil {
-						m.tree.BuildFromSnapshot(m.snapshot)
-					} else {
-						m.tree.Build(m.issues)
-					}
-					m.tree.SetSize(m.width, m.height-2)
+					m.buildTreeView()
 					m.focused = focusTree
 				}
 				return m, nil
@@ -2717,6 +3218,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						// Set full recommendations with breakdown for priority radar (bv-93)
 						dataHash := fmt.Sprintf("v%s@%s#%d", triage.Meta.Version, triage.Meta.GeneratedAt.Format("15:04:05"), triage.Meta.IssueCount)
 						m.insightsPanel.SetRecommendations(triage.Recommendations, dataHash)
+						if fc, err := analysis.Forecast(m.issues, analysis.ForecastOptions{}); err == nil {
+							m.insightsPanel.SetForecast(fc)
+						}
 						panelHeight := m.height - 2
 						if panelHeight < 3 {
 							panelHeight = 3
@@ -2846,13 +3350,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 
 			case "'":
+				// Jump to a mark once one is set
+				if next, ok := m.startJumpMark(); ok {
+					return next, nil
+				}
+
 				// Toggle recipe picker overlay
-				m.showRecipePicker = !m.showRecipePicker
 				if m.showRecipePicker {
-					m.recipePicker.SetSize(m.width, m.height-1)
-					m.focused = focusRecipePicker
+					m.closeRecipePicker()
 				} else {
-					m.focused = focusList
+					m = m.openRecipePicker()
 				}
 				return m, nil
 
@@ -2874,10 +3381,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 
+			case "W":
+				// Switch to the next workspace (workspace mode)
+				m.cycleWorkspace()
+				return m, nil
+
 			case "x":
 				// Export to Markdown file
-				m.exportToMarkdown()
-				return m, nil
+				cmd := m.exportToMarkdown()
+				return m, cmd
 
 			case "l":
 				// Open label picker for quick filter (bv-126)
@@ -2896,6 +3408,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			}
 
+			// Vim-style counts and motions in the list and actionable plan
+			if next, ok := m.handleMotionKeys(msg); ok {
+				return next, nil
+			}
+
 			// Focus-specific key handling
 			switch m.focused {
 			case focusRecipePicker:
@@ -2914,6 +3431,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m = m.handleBoardKeys(msg)
 
 			case focusLabelDashboard:
+				if msg.String() == "esc" {
+					m.focused = focusList
+					return m, nil
+				}
 				if selectedLabel, cmd := m.labelDashboard.Update(msg); selectedLabel != "" {
 					// Filter list by selected label and jump back to list view
 					m.currentFilter = "label:" + selectedLabel
@@ -2946,7 +3467,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 
 			case focusGraph:
-				m = m.handleGraphKeys(msg)
+				if msg.String() == "v" {
+					m.graphView.TogglePreview()
+				} else {
+					m = m.handleGraphKeys(msg)
+				}
 
 			case focusTree:
 				m = m.handleTreeKeys(msg)
@@ -2963,12 +3488,37 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case focusFlowMatrix:
 				m = m.handleFlowMatrixKeys(msg)
 
+			case focusCutLine:
+				m = m.handleCutLineKeys(msg)
+
+			case focusDepTree:
+				m = m.handleDepTreeKeys(msg)
+
+			case focusAssignees:
+				m = m.handleAssigneeKeys(msg)
+
+			case focusFacets:
+				m = m.handleFacetKeys(msg)
+
+			case focusTimeline:
+				m = m.handleTimelineKeys(msg)
+
 			case focusList:
-				m = m.handleListKeys(msg)
+				if m.splitLeftIsActionable() {
+					m = m.handleActionableKeys(msg)
+				} else {
+					m = m.handleListKeys(msg)
+				}
 
 			case focusDetail:
-				m.viewport, cmd = m.viewport.Update(msg)
-				cmds = append(cmds, cmd)
+				if m.splitRightIsGraph() {
+					m = m.handleGraphKeys(msg)
+				} else if next, ok := m.handleDetailTrailKeys(msg.String()); ok {
+					m = next
+				} else {
+					m.viewport, cmd = m.viewport.Update(msg)
+					cmds = append(cmds, cmd)
+				}
 			}
 		}
 
@@ -2979,15 +3529,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Scroll up based on current focus
 			switch m.focused {
 			case focusList:
-				if m.list.Index() > 0 {
+				if m.splitLeftIsActionable() {
+					m.actionableView.MoveUp()
+					m.syncSplitPanes()
+				} else if m.list.Index() > 0 {
 					m.list.Select(m.list.Index() - 1)
 					// Sync detail panel in split view mode
 					if m.isSplitView {
-						m.updateViewportContent()
+						m.syncSplitPanes()
 					}
 				}
 			case focusDetail:
-				m.viewport.ScrollUp(3)
+				if m.splitRightIsGraph() {
+					m.graphView.PageUp()
+				} else {
+					m.viewport.ScrollUp(3)
+				}
 			case focusInsights:
 				m.insightsPanel.MoveUp()
 			case focusBoard:
@@ -3002,21 +3559,38 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.historyView.MoveUp()
 			case focusFlowMatrix:
 				m.flowMatrix.MoveUp()
+			case focusCutLine:
+				m.cutLineView.MoveUp()
+			case focusDepTree:
+				m.depTreeView.MoveUp()
+			case focusAssignees:
+				m.assigneeView.MoveUp()
+			case focusFacets:
+				m.facetView.MoveUp()
+			case focusTimeline:
+				m.timelineView.MoveUp()
 			}
 			return m, nil
 		case tea.MouseButtonWheelDown:
 			// Scroll down based on current focus
 			switch m.focused {
 			case focusList:
-				if m.list.Index() < len(m.list.Items())-1 {
+				if m.splitLeftIsActionable() {
+					m.actionableView.MoveDown()
+					m.syncSplitPanes()
+				} else if m.list.Index() < len(m.list.Items())-1 {
 					m.list.Select(m.list.Index() + 1)
 					// Sync detail panel in split view mode
 					if m.isSplitView {
-						m.updateViewportContent()
+						m.syncSplitPanes()
 					}
 				}
 			case focusDetail:
-				m.viewport.ScrollDown(3)
+				if m.splitRightIsGraph() {
+					m.graphView.PageDown()
+				} else {
+					m.viewport.ScrollDown(3)
+				}
 			case focusInsights:
 				m.insightsPanel.MoveDown()
 			case focusBoard:
@@ -3031,13 +3605,23 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.historyView.MoveDown()
 			case focusFlowMatrix:
 				m.flowMatrix.MoveDown()
+			case focusCutLine:
+				m.cutLineView.MoveDown()
+			case focusDepTree:
+				m.depTreeView.MoveDown()
+			case focusAssignees:
+				m.assigneeView.MoveDown()
+			case focusFacets:
+				m.facetView.MoveDown()
+			case focusTimeline:
+				m.timelineView.MoveDown()
 			}
 			return m, nil
 		}
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
-		m.height = msg.Height
+		m.height = msg.Height - m.statusBarRows()
 		m.isSplitView = msg.Width > SplitViewThreshold
 		m.ready = true
 		bodyHeight := m.height - 1 // keep 1 row for footer
@@ -3046,26 +3630,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		if m.isSplitView {
-			// Calculate dimensions accounting for 2 panels with borders(2)+padding(2) = 4 overhead each
-			// Total overhead = 8
-			availWidth := msg.Width - 8
-			if availWidth < 10 {
-				availWidth = 10
+			m.layoutSplitPanes()
+			if m.splitLayout.Left == PaneActionable {
+				m.buildActionableView()
 			}
-
-			listInnerWidth := int(float64(availWidth) * 0.4)
-			detailInnerWidth := availWidth - listInnerWidth
-
-			// listHeight fits header (1) + page line (1) inside a panel with Border (2)
-			listHeight := bodyHeight - 4
-			if listHeight < 3 {
-				listHeight = 3
-			}
-
-			m.list.SetSize(listInnerWidth, listHeight)
-			m.viewport = viewport.New(detailInnerWidth, bodyHeight-2) // Account for border
-
-			m.renderer.SetWidthWithTheme(detailInnerWidth, m.theme)
 		} else {
 			listHeight := bodyHeight - 2
 			if listHeight < 3 {
@@ -3091,7 +3659,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// (we handle sizing ourselves to account for header/footer)
 	// Only forward keyboard messages to list when list has focus (bv-hmkz fix)
 	// This prevents j/k keys in detail view from changing list selection
-	if m.focused == focusList {
+	// The actionable plan takes the keys when it replaces the list in the split view
+	if m.focused == focusList && !m.splitLeftIsActionable() {
 		if _, isWindowSize := msg.(tea.WindowSizeMsg); !isWindowSize {
 			m.list, cmd = m.list.Update(msg)
 			cmds = append(cmds, cmd)
@@ -3111,9 +3680,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.updateListDelegate()
 	}
 
-	// Update viewport if list selection changed in split view
+	// Update the right pane if the left pane's selection changed in split view
 	if m.isSplitView && m.focused == focusList {
-		m.updateViewportContent()
+		m.syncSplitPanes()
+	}
+
+	// An open mark range follows the cursor
+	if m.markAnchor != "" {
+		m.extendMarkRange()
+	}
+
+	// A showing graph preview renders once it's opened or its issues change
+	if m.isGraphView {
+		cmds = append(cmds, m.graphView.previewCmd())
 	}
 
 	// Trigger async semantic computation if needed (debounced)
@@ -3347,6 +3926,24 @@ func (m Model) handleGraphKeys(msg tea.KeyMsg) Model {
 		m.graphView.ScrollLeft()
 	case "L":
 		m.graphView.ScrollRight()
+	case "K":
+		if m.graphView.MapMode() {
+			m.graphView.Pan(0, -1)
+		}
+	case "J":
+		if m.graphView.MapMode() {
+			m.graphView.Pan(0, 1)
+		}
+	case "m":
+		m.graphView.ToggleMap()
+	case "+", "=":
+		m.graphView.ZoomIn()
+	case "-":
+		m.graphView.ZoomOut()
+	case "c":
+		m.graphView.Center()
+	case "/":
+		m = m.openIssueSearch()
 	case "enter":
 		if selected := m.graphView.SelectedIssue(); selected != nil {
 			// Find and select in list
@@ -3378,6 +3975,8 @@ func (m Model) handleTreeKeys(msg tea.KeyMsg) Model {
 		m.tree.MoveDown()
 	case "k", "up":
 		m.tree.MoveUp()
+	case "/":
+		m = m.openIssueSearch()
 	case "enter", " ":
 		m.tree.ToggleExpand()
 	case "h", "left":
@@ -3426,6 +4025,31 @@ func (m Model) handleActionableKeys(msg tea.KeyMsg) Model {
 		m.actionableView.MoveDown()
 	case "k", "up":
 		m.actionableView.MoveUp()
+	case "/":
+		m = m.openIssueSearch()
+	case " ":
+		m = m.toggleMark()
+	case "V":
+		m = m.toggleMarkRange()
+	case "*":
+		m = m.togglePin()
+	case "J":
+		m = m.moveInPlan(1)
+	case "K":
+		m = m.moveInPlan(-1)
+	case "X":
+		m = m.resetTrackOrder()
+	case "e":
+		m = m.openIssueEdit()
+	case "s":
+		m.cycleSortMode()
+	case "I":
+		m.reverseSortMode()
+	case "z":
+		// Zoom: compact, detailed, minimal
+		m.actionableView.SetDensity(m.actionableView.Density().Next())
+		m.statusMsg = "Actionable view: " + m.actionableView.Density().String()
+		m.statusIsError = false
 	case "enter":
 		// Jump to selected issue in list view
 		selectedID := m.actionableView.SelectedIssueID()
@@ -3842,22 +4466,32 @@ func (m Model) handleFlowMatrixKeys(msg tea.KeyMsg) Model {
 
 // handleRecipePickerKeys handles keyboard input when recipe picker is focused
 func (m Model) handleRecipePickerKeys(msg tea.KeyMsg) Model {
+	if m.recipePicker.Saving() {
+		switch msg.String() {
+		case "esc":
+			m.recipePicker.CancelSave()
+		case "enter":
+			m.saveCurrentRecipe(m.recipePicker.SaveName())
+		default:
+			m.recipePicker.UpdateInput(msg)
+		}
+		return m
+	}
 	switch msg.String() {
 	case "j", "down":
 		m.recipePicker.MoveDown()
 	case "k", "up":
 		m.recipePicker.MoveUp()
+	case "s":
+		m = m.openSaveRecipe()
 	case "esc":
-		m.showRecipePicker = false
-		m.focused = focusList
+		m.closeRecipePicker()
 	case "enter":
 		// Apply selected recipe
 		if selected := m.recipePicker.SelectedRecipe(); selected != nil {
-			m.setActiveRecipe(selected)
-			m.applyRecipe(selected)
+			m.useRecipe(selected)
 		}
-		m.showRecipePicker = false
-		m.focused = focusList
+		m.closeRecipePicker()
 	}
 	return m
 }
@@ -3990,33 +4624,10 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 			m.viewport.GotoTop() // Reset scroll position for new issue
 			m.updateViewportContent()
 		}
-	case "home":
-		m.list.Select(0)
-	case "G", "end":
-		if len(m.list.Items()) > 0 {
-			m.list.Select(len(m.list.Items()) - 1)
-		}
-	case "ctrl+d":
-		// Page down
-		itemCount := len(m.list.Items())
-		if itemCount > 0 {
-			currentIdx := m.list.Index()
-			newIdx := currentIdx + m.height/3
-			if newIdx >= itemCount {
-				newIdx = itemCount - 1
-			}
-			m.list.Select(newIdx)
-		}
-	case "ctrl+u":
-		// Page up
-		if len(m.list.Items()) > 0 {
-			currentIdx := m.list.Index()
-			newIdx := currentIdx - m.height/3
-			if newIdx < 0 {
-				newIdx = 0
-			}
-			m.list.Select(newIdx)
-		}
+	case "home", "G", "end", "ctrl+d", "ctrl+u":
+		// Update routes these through handleMotionKeys first; this keeps
+		// them working for direct callers
+		m, _ = m.handleMotionKeys(msg)
 	case "o":
 		m.currentFilter = "open"
 		m.applyFilter()
@@ -4061,18 +4672,54 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 	case "S":
 		// Apply triage recipe - sort by triage score (bv-151)
 		if r := m.recipeLoader.Get("triage"); r != nil {
-			m.setActiveRecipe(r)
-			m.applyRecipe(r)
+			m.useRecipe(r)
 		}
 	case "s":
 		// Cycle sort mode (bv-3ita)
 		m.cycleSortMode()
-	case "V":
+	case "I":
+		// Reverse the sort direction, as in htop
+		m.reverseSortMode()
+	case "v":
 		// Show cass session preview modal (bv-5bqh)
 		m.showCassSessionModal()
+	case " ":
+		// Mark for bulk actions
+		m = m.toggleMark()
+	case "V":
+		// Mark a range for bulk actions
+		m = m.toggleMarkRange()
+	case "*":
+		// Pin to the top of the list and plan
+		m = m.togglePin()
 	case "U":
 		// Show self-update modal (bv-182)
 		m.showSelfUpdateModal()
+	case "R":
+		// Release cut-line planning with the selected issue as target
+		if issueItem, ok := m.list.SelectedItem().(IssueItem); ok {
+			m = m.openCutLine(issueItem.Issue.ID)
+		}
+	case "B":
+		// Blockers and dependents of the selected issue as a tree
+		if issueItem, ok := m.list.SelectedItem().(IssueItem); ok {
+			m = m.openDepTree(issueItem.Issue.ID)
+		}
+	case "L":
+		// Timeline of the projected schedule
+		m = m.openTimeline()
+	case "A":
+		// Open issues grouped by assignee, with WIP against the limits
+		m = m.openAssignees()
+	case "#":
+		// Browse labels, types and assignees with counts and filter by them
+		m = m.openFacets()
+	case "D":
+		// Dice: weighted random pick of something to work on
+		m = m.pickForMe()
+	case "e":
+		// Edit status, priority, assignee and labels
+		m = m.openIssueEdit()
 	case "y":
 		// Copy ID to clipboard (consistent with board view - bv-yg39)
 		selectedItem := m.list.SelectedItem()
@@ -4186,6 +4833,10 @@ func (m Model) handleHelpKeys(msg tea.KeyMsg) Model {
 	case "G", "end":
 		// Will be clamped in render
 		m.helpScroll = 999
+	case "tab":
+		// Switch between the focused view's keys and every view's
+		m.helpShowAll = !m.helpShowAll
+		m.helpScroll = 0
 	case "q", "esc", "?", "f1":
 		// Close help overlay and restore previous focus
 		m.showHelp = false
@@ -4235,10 +4886,13 @@ func (m Model) View() string {
 	}
 
 	var body string
+	imageShown := false
 
 	// Quit confirmation overlay takes highest priority
 	if m.showQuitConfirm {
 		body = m.renderQuitConfirm()
+	} else if m.modal != nil {
+		body = m.renderModal()
 	} else if m.showAgentPrompt {
 		// AGENTS.md prompt modal (bv-i8dk)
 		body = m.agentPromptModal.CenterModal(m.width, m.height-1)
@@ -4264,6 +4918,18 @@ func (m Model) View() string {
 		body = m.repoPicker.View()
 	} else if m.showLabelPicker {
 		body = m.labelPicker.View()
+	} else if m.showIssueSearch {
+		m.issueSearch.SetSize(m.width, m.height-1)
+		body = m.issueSearch.View()
+	} else if m.showCommandPalette {
+		m.commandPalette.SetSize(m.width, m.height-1)
+		body = m.commandPalette.View()
+	} else if m.showIssueEdit {
+		m.issueEdit.SetSize(m.width, m.height-1)
+		body = m.issueEdit.View()
+	} else if m.showIssueCreate {
+		m.issueCreate.SetSize(m.width, m.height-1)
+		body = m.issueCreate.View()
 	} else if m.showHelp {
 		body = m.renderHelpOverlay()
 	} else if m.showTutorial {
@@ -4277,12 +4943,28 @@ func (m Model) View() string {
 	} else if m.focused == focusFlowMatrix {
 		m.flowMatrix.SetSize(m.width, m.height-1)
 		body = m.flowMatrix.View()
+	} else if m.focused == focusCutLine {
+		m.cutLineView.SetSize(m.width, m.height-1)
+		body = m.cutLineView.Render()
+	} else if m.focused == focusDepTree {
+		m.depTreeView.SetSize(m.width, m.height-1)
+		body = m.depTreeView.Render()
+	} else if m.focused == focusAssignees {
+		m.assigneeView.SetSize(m.width, m.height-1)
+		body = m.assigneeView.Render()
+	} else if m.focused == focusFacets {
+		m.facetView.SetSize(m.width, m.height-1)
+		body = m.facetView.Render()
+	} else if m.focused == focusTimeline {
+		m.timelineView.SetSize(m.width, m.height-1)
+		body = m.timelineView.Render()
 	} else if m.focused == focusTree {
 		// Hierarchical tree view (bv-gllx)
 		m.tree.SetSize(m.width, m.height-1)
 		body = m.tree.View()
 	} else if m.isGraphView {
 		body = m.graphView.View(m.width, m.height-1)
+		imageShown = m.graphView.ShowingImage()
 	} else if m.isBoardView {
 		body = m.board.View(m.width, m.height-1)
 	} else if m.isActionableView {
@@ -4310,13 +4992,17 @@ func (m Model) View() string {
 	// Add shortcuts sidebar if enabled (bv-3qi5)
 	if m.showShortcutsSidebar {
 		// Update sidebar context based on current focus
-		m.shortcutsSidebar.SetContext(ContextFromFocus(m.focused))
+		m.shortcutsSidebar.SetContext(string(m.CurrentContext()))
 		m.shortcutsSidebar.SetSize(m.shortcutsSidebar.Width(), m.height-2)
 		sidebar := m.shortcutsSidebar.View()
 		body = lipgloss.JoinHorizontal(lipgloss.Top, body, sidebar)
 	}
+	body = m.overlayToasts(body)
 
 	footer := m.renderFooter()
+	if m.showFilterBar {
+		footer = m.filterBar.View(m.width, len(m.list.Items()))
+	}
 
 	// Ensure the final output fits exactly in the terminal height
 	// This prevents the header from being pushed off the top
@@ -4325,14 +5011,24 @@ func (m Model) View() string {
 		Height(m.height).
 		MaxHeight(m.height)
 
-	return finalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, body, footer))
+	screen := finalStyle.Render(lipgloss.JoinVertical(lipgloss.Left, body, footer))
+	if m.showStatusBar {
+		screen = lipgloss.JoinVertical(lipgloss.Left, screen, m.renderStatusBar())
+	}
+	if TermCapabilities().Plain {
+		screen = plainScreen(screen)
+	}
+	if !imageShown {
+		screen = clearInlineImages() + screen
+	}
+	return screen
 }
 
 func (m Model) renderQuitConfirm() string {
 	t := m.theme
 
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Blocked).
 		Padding(1, 3).
 		Align(lipgloss.Center)
@@ -4380,7 +5076,9 @@ func (m Model) renderListWithHeader() string {
 		Width(m.width - 2)
 
 	headerText := "  TYPE PRI STATUS      ID                                   TITLE"
-	if m.workspaceMode {
+	if TermCapabilities().Plain {
+		headerText = "  Issues"
+	} else if m.workspaceMode {
 		// Account for repo badges like [API] shown in workspace mode.
 		headerText = "  REPO TYPE PRI STATUS      ID                               TITLE"
 	}
@@ -4461,6 +5159,15 @@ func (m Model) renderSplitView() string {
 	listInnerWidth := m.list.Width()
 	panelHeight := m.height - 1
 
+	rightContent := m.viewport.View()
+	if m.splitLayout.Right == PaneGraph {
+		rightContent = m.graphView.View(m.viewport.Width, panelHeight-2)
+	}
+	if m.splitLayout.Left == PaneActionable {
+		m.actionableView.SetSize(listInnerWidth, panelHeight-2)
+		return m.joinSplitPanes(listStyle, detailStyle, m.actionableView.Render(), rightContent)
+	}
+
 	// Create header row for list
 	headerStyle := t.Renderer.NewStyle().
 		Background(t.Primary).
@@ -4506,20 +5213,28 @@ func (m Model) renderSplitView() string {
 	// Combine header + list + page indicator
 	listContent := lipgloss.JoinVertical(lipgloss.Left, header, m.list.View(), pageLine)
 
+	return m.joinSplitPanes(listStyle, detailStyle, listContent, rightContent)
+}
+
+// joinSplitPanes puts the split view's pane contents side by side in their
+// panels, sized by the list and viewport widths set in layoutSplitPanes
+func (m Model) joinSplitPanes(leftStyle, rightStyle lipgloss.Style, left, right string) string {
+	panelHeight := m.height - 1
+
 	// List Panel Width: Inner + 2 (Padding). Border adds another 2.
 	// Use MaxHeight to ensure content doesn't overflow
-	listView := listStyle.
-		Width(listInnerWidth + 2).
+	listView := leftStyle.
+		Width(m.list.Width() + 2).
 		Height(panelHeight).
 		MaxHeight(panelHeight).
-		Render(listContent)
+		Render(left)
 
 	// Detail Panel Width: Inner + 2 (Padding). Border adds another 2.
-	detailView := detailStyle.
+	detailView := rightStyle.
 		Width(m.viewport.Width + 2).
 		Height(panelHeight).
 		MaxHeight(panelHeight).
-		Render(m.viewport.View())
+		Render(right)
 
 	return lipgloss.JoinHorizontal(lipgloss.Top, listView, detailView)
 }
@@ -4557,7 +5272,7 @@ func (m *Model) renderHelpOverlay() string {
 	}
 
 	// Helper to render a section panel
-	renderPanel := func(title string, icon string, colorIdx int, shortcuts []struct{ key, desc string }) string {
+	renderPanel := func(title string, icon string, colorIdx int, shortcuts []keyBinding) string {
 		color := colors[colorIdx%len(colors)]
 
 		headerStyle := t.Renderer.NewStyle().
@@ -4583,13 +5298,13 @@ func (m *Model) renderHelpOverlay() string {
 		content.WriteString("\n")
 
 		for _, s := range shortcuts {
-			content.WriteString(keyStyle.Render(s.key))
+			content.WriteString(keyStyle.Render(s.label))
 			content.WriteString(descStyle.Render(s.desc))
 			content.WriteString("\n")
 		}
 
 		panelStyle := t.Renderer.NewStyle().
-			Border(lipgloss.RoundedBorder()).
+			Border(termBorder(lipgloss.RoundedBorder())).
 			BorderForeground(color).
 			Padding(0, 1).
 			Width(colWidth)
@@ -4597,126 +5312,38 @@ func (m *Model) renderHelpOverlay() string {
 		return panelStyle.Render(content.String())
 	}
 
-	// Define all sections
-	navSection := []struct{ key, desc string }{
-		{"j / ↓", "Move down"},
-		{"k / ↑", "Move up"},
-		{"G/end", "Go to last"},
-		{"Ctrl+d", "Page down"},
-		{"Ctrl+u", "Page up"},
-		{"Tab", "Switch focus"},
-		{"Enter", "View details"},
-		{"Esc", "Back / close"},
-	}
-
-	viewsSection := []struct{ key, desc string }{
-		{"b", "Kanban board"},
-		{"g", "Graph view"},
-		{"i", "Insights"},
-		{"h", "History view"},
-		{"a", "Actionable"},
-		{"f", "Flow matrix"},
-		{"[", "Label dashboard"},
-		{"]", "Attention view"},
-	}
-
-	globalSection := []struct{ key, desc string }{
-		{"?", "This help"},
-		{";", "Shortcuts bar"},
-		{"!", "Alerts panel"},
-		{"'", "Recipes"},
-		{"w", "Repo picker"},
-		{"q", "Back / Quit"},
-		{"Ctrl+c", "Force quit"},
-	}
-
-	filterSection := []struct{ key, desc string }{
-		{"/", "Fuzzy search"},
-		{"Ctrl+S", "Semantic search"},
-		{"H", "Hybrid ranking"},
-		{"Alt+H", "Hybrid preset"},
-		{"o", "Open issues"},
-		{"c", "Closed issues"},
-		{"r", "Ready (unblocked)"},
-		{"l", "Filter by label"},
-		{"s", "Cycle sort"},
-		{"S", "Triage sort"},
-	}
-
-	graphSection := []struct{ key, desc string }{
-		{"hjkl", "Navigate nodes"},
-		{"H/L", "Scroll left/right"},
-		{"PgUp/Dn", "Scroll up/down"},
-		{"Enter", "Jump to issue"},
-	}
-
-	insightsSection := []struct{ key, desc string }{
-		{"h/l/Tab", "Switch panels"},
-		{"j/k", "Navigate items"},
-		{"e", "Explanations"},
-		{"x", "Calc details"},
-		{"m", "Toggle heatmap"},
-		{"Enter", "Jump to issue"},
-	}
-
-	historySection := []struct{ key, desc string }{
-		{"j/k", "Navigate beads"},
-		{"J/K", "Navigate commits"},
-		{"Tab", "Toggle focus"},
-		{"y", "Copy SHA"},
-		{"c", "Confidence filter"},
-	}
-
-	actionsSection := []struct{ key, desc string }{
-		{"p", "Priority hints"},
-		{"Ctrl+R", "Force refresh"},
-		{"F5", "Force refresh"},
-		{"t", "Time-travel"},
-		{"T", "Quick time-travel"},
-		{"x", "Export markdown"},
-		{"C", "Copy to clipboard"},
-		{"O", "Open in editor"},
-	}
-
-	statusSection := []struct{ key, desc string }{
-		{"◌ metrics", "Phase 2 metrics computing"},
-		{"⚠ age", "Snapshot getting stale"},
-		{"⚠ STALE", "Snapshot is stale"},
-		{"✗ bg", "Background worker errors"},
-		{"↻ recov", "Worker self-healed"},
-		{"⚠ dead", "Worker unresponsive"},
-		{"polling", "Live reload uses polling"},
-	}
-
-	// Build panels
-	panels := []string{
-		renderPanel("Navigation", "🧭", 0, navSection),
-		renderPanel("Views", "👁", 1, viewsSection),
-		renderPanel("Global", "🌐", 2, globalSection),
-		renderPanel("Filters & Sort", "🔍", 3, filterSection),
-		renderPanel("Graph View", "📊", 4, graphSection),
-		renderPanel("Insights", "💡", 5, insightsSection),
-		renderPanel("Status", "🩺", 2, statusSection),
-		renderPanel("History", "📜", 0, historySection),
-		renderPanel("Actions", "⚡", 1, actionsSection),
-	}
-
-	// Arrange panels into columns
-	var columns []string
-	panelsPerCol := (len(panels) + numCols - 1) / numCols
-
-	for col := 0; col < numCols; col++ {
-		start := col * panelsPerCol
-		end := start + panelsPerCol
-		if end > len(panels) {
-			end = len(panels)
-		}
-		if start >= len(panels) {
-			break
+	// The panels come from the keymap: the focused view's keys first, then
+	// the shared ones still live there, or every group on Tab
+	ctx := m.helpContext
+	if ctx == "" {
+		ctx = ContextList
+	}
+	var panels []string
+	for i, group := range keymapFor(ctx, m.helpShowAll) {
+		panels = append(panels, renderPanel(group.title, group.icon, i, group.bindings))
+	}
+	if m.helpShowAll {
+		statusSection := []keyBinding{
+			{label: "◌ metrics", desc: "Phase 2 metrics computing"},
+			{label: "⚠ age", desc: "Snapshot getting stale"},
+			{label: "⚠ STALE", desc: "Snapshot is stale"},
+			{label: "✗ bg", desc: "Background worker errors"},
+			{label: "↻ recov", desc: "Worker self-healed"},
+			{label: "⚠ dead", desc: "Worker unresponsive"},
+			{label: "polling", desc: "Live reload uses polling"},
 		}
+		panels = append(panels, renderPanel("Status", "🩺", len(panels), statusSection))
+	}
 
-		colPanels := panels[start:end]
-		columns = append(columns, lipgloss.JoinVertical(lipgloss.Left, colPanels...))
+	// Arrange panels into columns in keymap order, keeping the tallest
+	// column as short as possible
+	heights := make([]int, len(panels))
+	for i, p := range panels {
+		heights[i] = lipgloss.Height(p)
+	}
+	var columns []string
+	for _, col := range splitColumns(heights, numCols) {
+		columns = append(columns, lipgloss.JoinVertical(lipgloss.Left, panels[col[0]:col[1]]...))
 	}
 
 	// Join columns horizontally
@@ -4732,8 +5359,14 @@ func (m *Model) renderHelpOverlay() string {
 		Foreground(t.Secondary).
 		Italic(true)
 
-	title := titleStyle.Render("⌨️  Keyboard Shortcuts")
-	subtitle := subtitleStyle.Render("Space: Tutorial │ ? or Esc to close")
+	heading := "⌨️  Keyboard Shortcuts · " + ctx.Description()
+	hint := "Tab: all views │ Space: Tutorial │ ? or Esc to close"
+	if m.helpShowAll {
+		heading = "⌨️  Keyboard Shortcuts · All views"
+		hint = "Tab: " + ctx.Description() + " only │ Space: Tutorial │ ? or Esc to close"
+	}
+	title := titleStyle.Render(heading)
+	subtitle := subtitleStyle.Render(hint)
 	titleBar := lipgloss.JoinHorizontal(lipgloss.Center, title, "  ", subtitle)
 
 	// Combine title and body
@@ -4741,12 +5374,21 @@ func (m *Model) renderHelpOverlay() string {
 
 	// Outer container
 	containerStyle := t.Renderer.NewStyle().
-		Border(lipgloss.DoubleBorder()).
+		Border(termBorder(lipgloss.DoubleBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2)
 
 	helpBox := containerStyle.Render(content)
 
+	// Scroll when the overlay is taller than the screen
+	if lines := strings.Split(helpBox, "\n"); m.height > 1 && len(lines) > m.height-1 {
+		maxScroll := len(lines) - (m.height - 1)
+		if m.helpScroll > maxScroll {
+			m.helpScroll = maxScroll
+		}
+		helpBox = strings.Join(lines[m.helpScroll:m.helpScroll+m.height-1], "\n")
+	}
+
 	// Center in viewport
 	return lipgloss.Place(
 		m.width,
@@ -4757,6 +5399,45 @@ func (m *Model) renderHelpOverlay() string {
 	)
 }
 
+// splitColumns splits items of the given heights, in order, into at most
+// numCols runs of [start, end) indexes whose tallest run is the shortest
+// possible
+func splitColumns(heights []int, numCols int) [][2]int {
+	// pack fills columns up to limit, returning nil when numCols won't do
+	pack := func(limit int) [][2]int {
+		var cols [][2]int
+		start, height := 0, 0
+		for i, h := range heights {
+			if i > start && height+h > limit {
+				cols = append(cols, [2]int{start, i})
+				start, height = i, 0
+			}
+			height += h
+		}
+		if start < len(heights) {
+			cols = append(cols, [2]int{start, len(heights)})
+		}
+		if len(cols) > numCols {
+			return nil
+		}
+		return cols
+	}
+
+	lo, hi := 0, 0
+	for _, h := range heights {
+		lo = max(lo, h)
+		hi += h
+	}
+	for lo < hi {
+		if mid := (lo + hi) / 2; pack(mid) != nil {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return pack(lo)
+}
+
 func (m Model) renderLabelHealthDetail(lh analysis.LabelHealth) string {
 	t := m.theme
 	innerWidth := m.width - 10
@@ -4766,7 +5447,7 @@ func (m Model) renderLabelHealthDetail(lh analysis.LabelHealth) string {
 
 	// 1. Define styles first so closures can capture them
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2)
 
@@ -4896,7 +5577,7 @@ func (m Model) renderLabelDrilldown() string {
 	t := m.theme
 
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2).
 		Align(lipgloss.Left)
@@ -5064,7 +5745,7 @@ func (m Model) renderLabelGraphAnalysis() string {
 	r := m.labelGraphAnalysisResult
 
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2).
 		Align(lipgloss.Left)
@@ -5277,7 +5958,10 @@ func (m *Model) renderFooter() string {
 			filterTxt = "READY"
 			filterIcon = "🚀"
 		default:
-			if strings.HasPrefix(m.currentFilter, "recipe:") {
+			if r := m.queryRecipe(); r != nil {
+				filterTxt = truncateRunesHelper(r.Description, 40, "…")
+				filterIcon = "🔎"
+			} else if strings.HasPrefix(m.currentFilter, "recipe:") {
 				filterTxt = strings.ToUpper(m.currentFilter[7:])
 				filterIcon = "📑"
 			} else {
@@ -5319,7 +6003,7 @@ func (m *Model) renderFooter() string {
 
 	// Sort badge - only show when not default (bv-3ita)
 	sortBadge := ""
-	if m.sortMode != SortDefault {
+	if m.sortMode.Field != SortDefault {
 		sortBadge = lipgloss.NewStyle().
 			Background(ColorBgHighlight).
 			Foreground(ColorSecondary).
@@ -5378,8 +6062,12 @@ func (m *Model) renderFooter() string {
 			Background(ColorPrioHighBg).
 			Foreground(ColorWarning).
 			Padding(0, 1)
-		statsSection = timeTravelStyle.Render(fmt.Sprintf("⏱ %s: +%d ✅%d ~%d",
-			m.timeTravelSince, d.IssuesAdded, d.IssuesClosed, d.IssuesModified))
+		text := fmt.Sprintf("⏱ %s: +%d ✅%d ~%d",
+			m.timeTravelSince, d.IssuesAdded, d.IssuesClosed, d.IssuesModified)
+		if g := m.timeTravelGraphDiff; g != nil && len(g.BlockedFlips) > 0 {
+			text += fmt.Sprintf(" ⛔%d 🔓%d", len(g.NewlyBlocked()), len(g.Unblocked()))
+		}
+		statsSection = timeTravelStyle.Render(text)
 	} else {
 		// Polished stats with mini indicators
 		statsStyle := lipgloss.NewStyle().
@@ -5409,21 +6097,6 @@ func (m *Model) renderFooter() string {
 	// ─────────────────────────────────────────────────────────────────────────
 	workerSection := ""
 	if m.backgroundWorker != nil {
-		formatAge := func(d time.Duration) string {
-			switch {
-			case d < time.Second:
-				return "<1s"
-			case d < time.Minute:
-				return fmt.Sprintf("%ds", int(d.Seconds()))
-			case d < time.Hour:
-				return fmt.Sprintf("%dm", int(d.Minutes()))
-			case d < 24*time.Hour:
-				return fmt.Sprintf("%dh", int(d.Hours()))
-			default:
-				return fmt.Sprintf("%dd", int(d.Hours()/24))
-			}
-		}
-
 		var snapshotAge time.Duration
 		hasSnapshotAge := false
 		if m.snapshot != nil && !m.snapshot.CreatedAt.IsZero() {
@@ -5470,7 +6143,7 @@ func (m *Model) renderFooter() string {
 				Foreground(ColorWarning).
 				Bold(true).
 				Padding(0, 1)
-			text = fmt.Sprintf("⚠ bg %s (%s)", lastErr.Phase, formatAge(time.Since(lastErr.Time)))
+			text = fmt.Sprintf("⚠ bg %s (%s)", lastErr.Phase, formatDataAge(time.Since(lastErr.Time)))
 
 		case hasSnapshotAge && snapshotAge >= freshnessStaleThreshold():
 			style = lipgloss.NewStyle().
@@ -5478,14 +6151,14 @@ func (m *Model) renderFooter() string {
 				Foreground(ColorDanger).
 				Bold(true).
 				Padding(0, 1)
-			text = fmt.Sprintf("⚠ STALE: %s ago", formatAge(snapshotAge))
+			text = fmt.Sprintf("⚠ STALE: %s ago", formatDataAge(snapshotAge))
 
 		case hasSnapshotAge && snapshotAge >= freshnessWarnThreshold():
 			style = lipgloss.NewStyle().
 				Background(ColorBgHighlight).
 				Foreground(ColorWarning).
 				Padding(0, 1)
-			text = fmt.Sprintf("⚠ %s ago", formatAge(snapshotAge))
+			text = fmt.Sprintf("⚠ %s ago", formatDataAge(snapshotAge))
 
 		default:
 			if health.RecoveryCount > 0 {
@@ -5594,7 +6267,7 @@ func (m *Model) renderFooter() string {
 	activeCritical := 0
 	activeWarning := 0
 	for _, a := range m.alerts {
-		if !m.dismissedAlerts[alertKey(a)] {
+		if len(m.dismissedAlerts) == 0 || !m.dismissedAlerts[alertKey(a)] {
 			activeAlerts++
 			switch a.Severity {
 			case drift.SeverityCritical:
@@ -5666,7 +6339,7 @@ func (m *Model) renderFooter() string {
 	workspaceSection := ""
 	if m.workspaceMode && m.workspaceSummary != "" {
 		workspaceStyle := lipgloss.NewStyle().
-			Background(lipgloss.Color("#45B7D1")).
+			Background(lipgloss.AdaptiveColor{Light: "#006080", Dark: "#45B7D1"}).
 			Foreground(ColorBg).
 			Bold(true).
 			Padding(0, 1)
@@ -5699,7 +6372,9 @@ func (m *Model) renderFooter() string {
 	sep := sepStyle.Render(" │ ")
 
 	var keyHints []string
-	if m.showHelp {
+	if m.modal != nil {
+		keyHints = append(keyHints, m.modalHints(keyStyle)...)
+	} else if m.showHelp {
 		keyHints = append(keyHints, "Press any key to close")
 	} else if m.showRecipePicker {
 		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
@@ -5707,6 +6382,16 @@ func (m *Model) renderFooter() string {
 		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("space")+" toggle", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
 	} else if m.showLabelPicker {
 		keyHints = append(keyHints, "type to filter", keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
+	} else if m.showIssueSearch {
+		keyHints = append(keyHints, "type to search", keyStyle.Render("↑/↓")+" nav", keyStyle.Render("⏎")+" jump", keyStyle.Render("esc")+" cancel")
+	} else if m.showCommandPalette {
+		keyHints = append(keyHints, "type a command", keyStyle.Render("↑/↓")+" nav", keyStyle.Render("⏎")+" run", keyStyle.Render("esc")+" cancel")
+	} else if m.showIssueEdit {
+		keyHints = append(keyHints, keyStyle.Render("tab")+" field", keyStyle.Render("←/→")+" change", keyStyle.Render("⏎")+" save", keyStyle.Render("esc")+" cancel")
+	} else if m.showIssueCreate {
+		keyHints = append(keyHints, keyStyle.Render("tab")+" field/complete", keyStyle.Render("←/→")+" change", keyStyle.Render("⏎")+" create", keyStyle.Render("esc")+" close")
+	} else if len(m.marked) > 0 && m.inListScope() {
+		keyHints = append(keyHints, keyStyle.Render("space")+" mark", keyStyle.Render("V")+" range", keyStyle.Render("e")+" edit marked", keyStyle.Render("x")+" export marked", keyStyle.Render("esc")+" clear")
 	} else if m.focused == focusInsights {
 		keyHints = append(keyHints, keyStyle.Render("h/l")+" panels", keyStyle.Render("e")+" explain", keyStyle.Render("⏎")+" jump", keyStyle.Render("?")+" help")
 		keyHints = append(keyHints, keyStyle.Render("A")+" attention", keyStyle.Render("F")+" flow")
@@ -5738,7 +6423,7 @@ func (m *Model) renderFooter() string {
 		if m.timeTravelMode {
 			keyHints = append(keyHints, keyStyle.Render("t")+" exit diff", keyStyle.Render("C")+" copy", keyStyle.Render("abgi")+" views", keyStyle.Render("?")+" help")
 		} else if m.isSplitView {
-			keyHints = append(keyHints, keyStyle.Render("tab")+" focus", keyStyle.Render("C")+" copy", keyStyle.Render("x")+" export", keyStyle.Render("Ctrl+R")+" refresh", keyStyle.Render("?")+" help")
+			keyHints = append(keyHints, keyStyle.Render("tab")+" focus", keyStyle.Render("</>")+" resize", keyStyle.Render("\\|")+" panes", keyStyle.Render("C")+" copy", keyStyle.Render("x")+" export", keyStyle.Render("?")+" help")
 		} else if m.showDetails {
 			keyHints = append(keyHints, keyStyle.Render("esc")+" back", keyStyle.Render("C")+" copy", keyStyle.Render("O")+" edit", keyStyle.Render("Ctrl+R")+" refresh", keyStyle.Render("?")+" help")
 		} else {
@@ -5760,7 +6445,7 @@ func (m *Model) renderFooter() string {
 	countBadge := lipgloss.NewStyle().
 		Foreground(ColorSecondary).
 		Padding(0, 1).
-		Render(fmt.Sprintf("%d issues", len(m.list.Items())))
+		Render(m.countBadgeText())
 
 	// ─────────────────────────────────────────────────────────────────────────
 	// ASSEMBLE FOOTER with proper spacing
@@ -5775,6 +6460,10 @@ func (m *Model) renderFooter() string {
 	if workerSection != "" {
 		leftWidth += lipgloss.Width(workerSection) + 1
 	}
+	dataSourceSection := m.renderDataSourceBadge()
+	if dataSourceSection != "" {
+		leftWidth += lipgloss.Width(dataSourceSection) + 1
+	}
 	if searchBadge != "" {
 		leftWidth += lipgloss.Width(searchBadge) + 1
 	}
@@ -5790,6 +6479,10 @@ func (m *Model) renderFooter() string {
 	if sessionSection != "" {
 		leftWidth += lipgloss.Width(sessionSection) + 1
 	}
+	timerSection := m.timerBadge()
+	if timerSection != "" {
+		leftWidth += lipgloss.Width(timerSection) + 1
+	}
 	if workspaceSection != "" {
 		leftWidth += lipgloss.Width(workspaceSection) + 1
 	}
@@ -5829,6 +6522,9 @@ func (m *Model) renderFooter() string {
 	if sessionSection != "" {
 		parts = append(parts, sessionSection)
 	}
+	if timerSection != "" {
+		parts = append(parts, timerSection)
+	}
 	if workspaceSection != "" {
 		parts = append(parts, workspaceSection)
 	}
@@ -5851,6 +6547,9 @@ func (m *Model) renderFooter() string {
 	if workerSection != "" {
 		parts = append(parts, workerSection)
 	}
+	if dataSourceSection != "" {
+		parts = append(parts, dataSourceSection)
+	}
 	parts = append(parts, filler, countBadge, keysSection)
 
 	return lipgloss.JoinHorizontal(lipgloss.Bottom, parts...)
@@ -5880,6 +6579,12 @@ func (m Model) getDiffStatus(id string) DiffStatus {
 	if m.closedIssueIDs[id] {
 		return DiffStatusClosed
 	}
+	if blocked, ok := m.blockedFlipIDs[id]; ok {
+		if blocked {
+			return DiffStatusBlocked
+		}
+		return DiffStatusUnblocked
+	}
 	if m.modifiedIssueIDs[id] {
 		return DiffStatusModified
 	}
@@ -5911,6 +6616,8 @@ func (m *Model) clearAllFilters() {
 
 func (m *Model) setActiveRecipe(r *recipe.Recipe) {
 	m.activeRecipe = r
+	m.setRecipeColumns(r)
+	m.updateListDelegate()
 	if m.backgroundWorker != nil {
 		m.backgroundWorker.SetRecipe(r)
 	}
@@ -5989,6 +6696,7 @@ func (m *Model) applyFilter() {
 
 	// Apply sort mode (bv-3ita)
 	m.sortFilteredItems(filteredItems, filteredIssues)
+	pinFirst(filteredItems, m.pinned)
 
 	m.list.SetItems(filteredItems)
 	m.updateSemanticIDs(filteredItems)
@@ -6052,10 +6760,39 @@ func (m *Model) refreshListItemsPhase2() {
 	m.updateViewportContent()
 }
 
-// cycleSortMode cycles through available sort modes (bv-3ita)
+// cycleSortMode moves to the next sort field, in its usual direction
+// (bv-3ita)
 func (m *Model) cycleSortMode() {
-	m.sortMode = (m.sortMode + 1) % numSortModes
-	m.applyFilter() // Re-apply filter with new sort
+	m.setSortMode(defaultSortMode((m.sortMode.Field + 1) % numSortFields))
+}
+
+// reverseSortMode flips the direction of the current sort field
+func (m *Model) reverseSortMode() {
+	if m.sortMode.Field == SortDefault {
+		m.statusMsg = "Default sort has no direction; press s to pick a field"
+		m.statusIsError = false
+		return
+	}
+	m.setSortMode(SortMode{Field: m.sortMode.Field, Desc: !m.sortMode.Desc})
+}
+
+// setSortMode re-sorts the list and the actionable view, keeping the
+// selection
+func (m *Model) setSortMode(mode SortMode) {
+	m.sortMode = mode
+	// Re-apply filter with new sort
+	if m.activeRecipe != nil {
+		m.applyRecipe(m.activeRecipe)
+	} else {
+		m.applyFilter()
+	}
+	if m.isActionableView || m.splitLeftIsActionable() {
+		selectedID := m.actionableView.SelectedIssueID()
+		m.buildActionableView()
+		m.actionableView.SelectByID(selectedID)
+	}
+	m.statusMsg = "Sort: " + mode.String()
+	m.statusIsError = false
 }
 
 // sortFilteredItems sorts the filtered items based on current sortMode (bv-3ita)
@@ -6073,32 +6810,7 @@ func (m *Model) sortFilteredItems(items []list.Item, issues []model.Issue) {
 	sort.Slice(indices, func(i, j int) bool {
 		iItem := items[indices[i]].(IssueItem)
 		jItem := items[indices[j]].(IssueItem)
-
-		switch m.sortMode {
-		case SortCreatedAsc:
-			// Oldest first
-			return iItem.Issue.CreatedAt.Before(jItem.Issue.CreatedAt)
-		case SortCreatedDesc:
-			// Newest first
-			return iItem.Issue.CreatedAt.After(jItem.Issue.CreatedAt)
-		case SortPriority:
-			// Priority ascending (P0 first)
-			return iItem.Issue.Priority < jItem.Issue.Priority
-		case SortUpdated:
-			// Most recently updated first
-			return iItem.Issue.UpdatedAt.After(jItem.Issue.UpdatedAt)
-		default:
-			// Default: Open first, then priority, then newest
-			iClosed := isClosedLikeStatus(iItem.Issue.Status)
-			jClosed := isClosedLikeStatus(jItem.Issue.Status)
-			if iClosed != jClosed {
-				return !iClosed
-			}
-			if iItem.Issue.Priority != jItem.Issue.Priority {
-				return iItem.Issue.Priority < jItem.Issue.Priority
-			}
-			return iItem.Issue.CreatedAt.After(jItem.Issue.CreatedAt)
-		}
+		return m.sortMode.less(iItem.Issue, jItem.Issue, iItem.GraphScore, jItem.GraphScore)
 	})
 
 	// Reorder items and issues based on sorted indices
@@ -6151,59 +6863,8 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 			}
 		}
 
-		// Apply status filter
-		if len(r.Filters.Status) > 0 {
-			statusMatch := false
-			for _, s := range r.Filters.Status {
-				if matchesRecipeStatus(issue.Status, s) {
-					statusMatch = true
-					break
-				}
-			}
-			include = include && statusMatch
-		}
-
-		// Apply priority filter
-		if include && len(r.Filters.Priority) > 0 {
-			prioMatch := false
-			for _, p := range r.Filters.Priority {
-				if issue.Priority == p {
-					prioMatch = true
-					break
-				}
-			}
-			include = include && prioMatch
-		}
-
-		// Apply tags filter (must have ALL specified tags)
-		if include && len(r.Filters.Tags) > 0 {
-			labelSet := make(map[string]bool)
-			for _, l := range issue.Labels {
-				labelSet[l] = true
-			}
-			for _, required := range r.Filters.Tags {
-				if !labelSet[required] {
-					include = false
-					break
-				}
-			}
-		}
-
-		// Apply actionable filter
-		if include && r.Filters.Actionable != nil && *r.Filters.Actionable {
-			// Check if issue is blocked
-			isBlocked := false
-			for _, dep := range issue.Dependencies {
-				if dep == nil || !dep.Type.IsBlocking() {
-					continue
-				}
-				if blocker, exists := m.issueMap[dep.DependsOnID]; exists && !isClosedLikeStatus(blocker.Status) {
-					isBlocked = true
-					break
-				}
-			}
-			include = !isBlocked
-		}
+		// Recipe filters, shared with the background snapshot builder
+		include = include && issueMatchesRecipe(issue, m.issueMap, r)
 
 		if include {
 			item := IssueItem{
@@ -6231,6 +6892,13 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 	field := r.Sort.Field
 	descending := r.Sort.Direction == "desc"
 	if field != "" {
+		var staleness, risk map[string]float64
+		switch field {
+		case "staleness":
+			staleness = analysis.StalenessScores(m.issues, time.Now())
+		case "risk":
+			risk = analysis.RiskScoreMap(analysis.RiskScores(m.issues, time.Now(), analysis.RecipeRiskScoreWeights(r)))
+		}
 		compare := func(a, b model.Issue) int {
 			switch field {
 			case "priority":
@@ -6302,6 +6970,26 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 				default:
 					return 0
 				}
+			case "staleness":
+				switch {
+				case staleness[a.ID] < staleness[b.ID]:
+					return -1
+				case staleness[a.ID] > staleness[b.ID]:
+					return 1
+				default:
+					return 0
+				}
+			case "risk":
+				switch {
+				case risk[a.ID] < risk[b.ID]:
+					return -1
+				case risk[a.ID] > risk[b.ID]:
+					return 1
+				default:
+					return 0
+				}
+			case "id":
+				return strings.Compare(a.ID, b.ID)
 			default:
 				switch {
 				case a.Priority < b.Priority:
@@ -6343,6 +7031,11 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 			return cmp < 0
 		})
 	}
+	// A sort picked with s wins over the recipe's
+	if m.sortMode.Field != SortDefault {
+		m.sortFilteredItems(filteredItems, filteredIssues)
+	}
+	pinFirst(filteredItems, m.pinned)
 
 	m.list.SetItems(filteredItems)
 	m.updateSemanticIDs(filteredItems)
@@ -6375,6 +7068,10 @@ func (m *Model) updateViewportContent() {
 		return
 	}
 	item := issueItem.Issue
+	if TermCapabilities().Plain {
+		m.viewport.SetContent(m.plainIssueDetail(issueItem))
+		return
+	}
 
 	var sb strings.Builder
 
@@ -6382,6 +7079,8 @@ func (m *Model) updateViewportContent() {
 		sb.WriteString(fmt.Sprintf("⭐ **Update Available:** [%s](%s)\n\n", m.updateTag, m.updateURL))
 	}
 
+	sb.WriteString(m.detailTrailMD(item.ID))
+
 	// Title Block
 	sb.WriteString(fmt.Sprintf("# %s %s\n", GetTypeIconMD(string(item.IssueType)), item.Title))
 
@@ -6400,6 +7099,11 @@ func (m *Model) updateViewportContent() {
 		sb.WriteString(fmt.Sprintf("**Labels:** %s\n\n", strings.Join(item.Labels, ", ")))
 	}
 
+	// Work logged with the timer (Ctrl+T)
+	if logged := loggedSummary(item, time.Now()); logged != "" {
+		sb.WriteString(fmt.Sprintf("**Logged:** %s\n\n", logged))
+	}
+
 	// Triage Insights (bv-151)
 	if issueItem.TriageScore > 0 || issueItem.TriageReason != "" || issueItem.UnblocksCount > 0 || issueItem.IsQuickWin || issueItem.IsBlocker {
 		sb.WriteString("### 🎯 Triage Insights\n")
@@ -6496,6 +7200,8 @@ func (m *Model) updateViewportContent() {
 		sb.WriteString(item.Notes + "\n\n")
 	}
 
+	sb.WriteString(m.detailLinksMD(item))
+
 	// Dependency Graph (Tree)
 	if len(item.Dependencies) > 0 {
 		rootNode := BuildDependencyTree(item.ID, m.issueMap, 3) // Max depth 3
@@ -6660,6 +7366,12 @@ func (m Model) FilteredIssues() []model.Issue {
 	return issues
 }
 
+// SetPlanOptions sets the WIP limits and track batching used by the
+// actionable view
+func (m *Model) SetPlanOptions(opts analysis.PlanOptions) {
+	m.planOptions = opts
+}
+
 // EnableWorkspaceMode configures the model for workspace (multi-repo) view
 func (m *Model) EnableWorkspaceMode(info WorkspaceInfo) {
 	m.workspaceMode = info.Enabled
@@ -6763,6 +7475,7 @@ func (m *Model) enterTimeTravelMode(revision string) {
 	fromSnapshot := analysis.NewSnapshot(historicalIssues)
 	toSnapshot := analysis.NewSnapshot(m.issues)
 	diff := analysis.CompareSnapshots(fromSnapshot, toSnapshot)
+	graphDiff := analysis.DiffGraphs(fromSnapshot, toSnapshot)
 
 	// Build lookup sets for badges
 	m.newIssueIDs = make(map[string]bool)
@@ -6780,8 +7493,14 @@ func (m *Model) enterTimeTravelMode(revision string) {
 		m.modifiedIssueIDs[mod.IssueID] = true
 	}
 
+	m.blockedFlipIDs = make(map[string]bool)
+	for _, flip := range graphDiff.BlockedFlips {
+		m.blockedFlipIDs[flip.ID] = flip.Blocked
+	}
+
 	m.timeTravelMode = true
 	m.timeTravelDiff = diff
+	m.timeTravelGraphDiff = graphDiff
 	m.timeTravelSince = revision
 
 	// Success feedback
@@ -6797,10 +7516,12 @@ func (m *Model) enterTimeTravelMode(revision string) {
 func (m *Model) exitTimeTravelMode() {
 	m.timeTravelMode = false
 	m.timeTravelDiff = nil
+	m.timeTravelGraphDiff = nil
 	m.timeTravelSince = ""
 	m.newIssueIDs = nil
 	m.closedIssueIDs = nil
 	m.modifiedIssueIDs = nil
+	m.blockedFlipIDs = nil
 
 	// Feedback
 	m.statusMsg = "⏱️ Time-travel mode disabled"
@@ -6829,6 +7550,11 @@ func (m Model) TimeTravelDiff() *analysis.SnapshotDiff {
 	return m.timeTravelDiff
 }
 
+// TimeTravelGraphDiff returns the current graph diff (nil if not in time-travel mode)
+func (m Model) TimeTravelGraphDiff() *analysis.GraphDiff {
+	return m.timeTravelGraphDiff
+}
+
 // FocusState returns the current focus state as a string for testing (bv-5e5q).
 // This enables testing focus transitions without exposing the internal focus type.
 func (m Model) FocusState() string {
@@ -6877,6 +7603,28 @@ func (m Model) FocusState() string {
 		return "cass_modal"
 	case focusUpdateModal:
 		return "update_modal"
+	case focusCutLine:
+		return "cut_line"
+	case focusTimeline:
+		return "timeline"
+	case focusIssueSearch:
+		return "issue_search"
+	case focusFilterBar:
+		return "filter_bar"
+	case focusCommandPalette:
+		return "command_palette"
+	case focusIssueEdit:
+		return "issue_edit"
+	case focusIssueCreate:
+		return "issue_create"
+	case focusModal:
+		return "modal"
+	case focusDepTree:
+		return "dep_tree"
+	case focusAssignees:
+		return "assignees"
+	case focusFacets:
+		return "facets"
 	default:
 		return "unknown"
 	}
@@ -6902,21 +7650,23 @@ func (m Model) IsHistoryView() bool {
 	return m.isHistoryView
 }
 
-// exportToMarkdown exports all issues to a Markdown file with auto-generated filename
-func (m *Model) exportToMarkdown() {
+// exportToMarkdown exports all issues to a Markdown file with auto-generated
+// filename, returning the toast that reports the outcome
+func (m *Model) exportToMarkdown() tea.Cmd {
+	// Export only the marked issues when there are any
+	if len(m.markedIDs()) > 0 {
+		return m.exportMarked()
+	}
+
 	// Generate smart filename: beads_report_<project>_YYYY-MM-DD.md
 	filename := m.generateExportFilename()
 
 	// Export the issues
 	err := export.SaveMarkdownToFile(m.issues, filename)
 	if err != nil {
-		m.statusMsg = fmt.Sprintf("❌ Export failed: %v", err)
-		m.statusIsError = true
-		return
+		return m.notify(toastError, fmt.Sprintf("Export failed: %v", err))
 	}
-
-	m.statusMsg = fmt.Sprintf("✅ Exported %d issues to %s", len(m.issues), filename)
-	m.statusIsError = false
+	return m.notify(toastSuccess, fmt.Sprintf("Exported %d issues to %s", len(m.issues), filename))
 }
 
 // generateExportFilename creates a smart filename based on project and date
@@ -6944,7 +7694,7 @@ func (m Model) renderTimeTravelPrompt() string {
 	t := m.theme
 
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 3).
 		Align(lipgloss.Center)
@@ -7616,7 +8366,38 @@ func computeAlerts(issues []model.Issue, stats *analysis.GraphStats, analyzer *a
 
 // alertKey generates a unique key for an alert (for dismissal tracking)
 func alertKey(a drift.Alert) string {
-	return fmt.Sprintf("%s:%s:%s", a.Type, a.Severity, a.IssueID)
+	return string(a.Type) + ":" + string(a.Severity) + ":" + a.IssueID
+}
+
+// refreshAlerts recomputes the alerts for reloaded data, returning a toast
+// about critical and warning alerts the previous data didn't raise
+func (m *Model) refreshAlerts() tea.Cmd {
+	seen := make(map[string]bool, len(m.alerts))
+	for _, a := range m.alerts {
+		seen[alertKey(a)] = true
+	}
+	m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
+
+	fresh, critical := 0, false
+	for _, a := range m.alerts {
+		if seen[alertKey(a)] || a.Severity == drift.SeverityInfo {
+			continue
+		}
+		fresh++
+		critical = critical || a.Severity == drift.SeverityCritical
+	}
+	if fresh == 0 {
+		return nil
+	}
+	level := toastWarning
+	if critical {
+		level = toastError
+	}
+	text := "1 new alert · ! to review"
+	if fresh > 1 {
+		text = fmt.Sprintf("%d new alerts · ! to review", fresh)
+	}
+	return m.notify(level, text)
 }
 
 // renderAlertsPanel renders the alerts overlay panel
@@ -7624,7 +8405,7 @@ func (m Model) renderAlertsPanel() string {
 	t := m.theme
 
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2).
 		Width(min(80, m.width-4)).
@@ -0,0 +1,238 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ggWindow is how soon a second g has to follow the g that left the list
+// for the graph to count as gg
+const ggWindow = 500 * time.Millisecond
+
+// maxMotionCount keeps a held-down digit from overflowing the count
+const maxMotionCount = 99999
+
+// motionLen returns the number of issues in the focused list
+func (m Model) motionLen() int {
+	if m.actionableFocused() {
+		return len(m.actionableView.ItemIDs())
+	}
+	return len(m.list.VisibleItems())
+}
+
+// motionIndex returns the cursor position in the focused list
+func (m Model) motionIndex() int {
+	if m.actionableFocused() {
+		return m.actionableView.Index()
+	}
+	return m.list.Index()
+}
+
+// motionSelect moves the cursor of the focused list, clamped to its ends
+func (m *Model) motionSelect(idx int) {
+	n := m.motionLen()
+	if n == 0 {
+		return
+	}
+	idx = min(max(idx, 0), n-1)
+	if m.actionableFocused() {
+		m.actionableView.SelectIndex(idx)
+	} else {
+		m.list.Select(idx)
+	}
+}
+
+// motionHalfPage returns half the rows the focused list shows
+func (m Model) motionHalfPage() int {
+	if m.actionableFocused() {
+		return max(m.actionableView.height/2, 1)
+	}
+	return max(m.list.Height()/2, 1)
+}
+
+// takeCount returns the pending count, or def when there is none, and
+// clears it
+func (m *Model) takeCount(def int) int {
+	n := m.motionCount
+	if n == 0 {
+		return def
+	}
+	m.motionCount = 0
+	m.statusMsg = "" // The count was on show
+	return n
+}
+
+// handleMotionKeys handles vim-style navigation in the list and actionable
+// plan: counts (5j), G and 5G, ctrl+d/ctrl+u half pages and m to set a
+// mark. It reports whether it used the key; the list must not see the ones
+// it did, since it binds some of them itself.
+func (m Model) handleMotionKeys(msg tea.KeyMsg) (Model, bool) {
+	if !m.inListScope() {
+		return m, false
+	}
+	key := msg.String()
+	switch {
+	case len(key) == 1 && key[0] >= '1' && key[0] <= '9', key == "0" && m.motionCount > 0:
+		m.motionCount = min(m.motionCount*10+int(key[0]-'0'), maxMotionCount)
+		m.statusMsg = itoa(m.motionCount)
+		m.statusIsError = false
+		return m, true
+	case (key == "j" || key == "down") && m.motionCount > 0:
+		m.motionSelect(m.motionIndex() + m.takeCount(1))
+	case (key == "k" || key == "up") && m.motionCount > 0:
+		m.motionSelect(m.motionIndex() - m.takeCount(1))
+	case key == "G" || key == "end":
+		if n := m.takeCount(0); n > 0 {
+			m.motionSelect(n - 1)
+		} else {
+			m.motionSelect(m.motionLen() - 1)
+		}
+	case key == "home":
+		m.motionCount = 0
+		m.motionSelect(0)
+	case key == "ctrl+d":
+		m.motionSelect(m.motionIndex() + m.takeCount(1)*m.motionHalfPage())
+	case key == "ctrl+u":
+		m.motionSelect(m.motionIndex() - m.takeCount(1)*m.motionHalfPage())
+	case key == "m":
+		m.motionCount = 0
+		m.motionPending = "m"
+		m.statusMsg = "Set mark: press a letter"
+		m.statusIsError = false
+		return m, true
+	default:
+		m.motionCount = 0 // Any other key drops a count, as in vim
+		return m, false
+	}
+	m.afterMotion()
+	return m, true
+}
+
+// afterMotion keeps what follows the cursor in step after a motion moved it
+func (m *Model) afterMotion() {
+	if m.isSplitView && m.focused == focusList {
+		m.syncSplitPanes()
+	}
+	if m.markAnchor != "" {
+		m.extendMarkRange()
+	}
+}
+
+// startJumpMark makes ' wait for the letter of a mark to jump to. Until a
+// mark is set ' keeps opening the recipe picker, as a doubled ' does after.
+func (m Model) startJumpMark() (Model, bool) {
+	if len(m.jumpMarks) == 0 || !m.inListScope() {
+		return m, false
+	}
+	m.motionCount = 0
+	m.motionPending = "'"
+	m.statusMsg = "Jump to mark: press its letter ('' for recipes)"
+	m.statusIsError = false
+	return m, true
+}
+
+// startCountedG makes g after a count wait for the second g of 5gg
+func (m Model) startCountedG() (Model, bool) {
+	if m.motionCount == 0 || !m.inListScope() {
+		return m, false
+	}
+	m.motionPending = "g"
+	return m, true
+}
+
+// handlePendingMotion finishes a motion waiting for its second key: the
+// letter after m or ', or the second g of 5gg. Any other key cancels it.
+func (m Model) handlePendingMotion(msg tea.KeyMsg) Model {
+	pending := m.motionPending
+	m.motionPending = ""
+	key := msg.String()
+	letter := len(key) == 1 && (key[0] >= 'a' && key[0] <= 'z' || key[0] >= 'A' && key[0] <= 'Z')
+
+	switch {
+	case pending == "g" && key == "g":
+		m.motionSelect(m.takeCount(1) - 1)
+		m.afterMotion()
+		return m
+	case pending == "m" && letter:
+		_, cursor := m.markScope()
+		if cursor == "" {
+			m.statusMsg = "❌ No issue selected"
+			m.statusIsError = true
+			return m
+		}
+		if m.jumpMarks == nil {
+			m.jumpMarks = make(map[string]string)
+		}
+		m.jumpMarks[key] = cursor
+		m.statusMsg = fmt.Sprintf("Mark %s set at %s", key, cursor)
+		m.statusIsError = false
+		return m
+	case pending == "'" && key == "'":
+		return m.openRecipePicker()
+	case pending == "'" && letter:
+		m.jumpToMark(key)
+		return m
+	}
+	m.motionCount = 0
+	m.statusMsg = ""
+	return m
+}
+
+// jumpToMark moves the cursor of the focused list to a mark's issue
+func (m *Model) jumpToMark(letter string) {
+	id, ok := m.jumpMarks[letter]
+	if !ok {
+		m.statusMsg = fmt.Sprintf("❌ Mark %s is not set", letter)
+		m.statusIsError = true
+		return
+	}
+	found := false
+	if m.actionableFocused() {
+		found = m.actionableView.SelectByID(id)
+	} else {
+		for i, item := range m.list.VisibleItems() {
+			if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == id {
+				m.list.Select(i)
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		m.statusMsg = fmt.Sprintf("❌ Mark %s (%s) is not in this view", letter, id)
+		m.statusIsError = true
+		return
+	}
+	m.statusMsg = ""
+	m.afterMotion()
+}
+
+// leaveForGraph remembers when g left the list or plan for the graph, so a
+// quick second g can come back as gg
+func (m *Model) leaveForGraph(from focus) {
+	if from == focusList || from == focusActionable {
+		m.ggFrom, m.ggAt = from, time.Now()
+	} else {
+		m.ggAt = time.Time{}
+	}
+}
+
+// finishGG handles a g in the graph view that follows the g which opened
+// it within ggWindow: together they are gg, so it goes back to the list or
+// plan and jumps to the top instead of just closing the graph
+func (m Model) finishGG() (Model, bool) {
+	if !m.isGraphView || m.ggAt.IsZero() || time.Since(m.ggAt) > ggWindow {
+		return m, false
+	}
+	m.ggAt = time.Time{}
+	m.isGraphView = false
+	m.focused = m.ggFrom
+	if m.ggFrom == focusActionable {
+		m.isActionableView = true
+	}
+	m.motionSelect(0)
+	m.afterMotion()
+	return m, true
+}
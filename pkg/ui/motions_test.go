@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMotionCountsAndJumps(t *testing.T) {
+	m := NewModel(generateFlatIssues(40), nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 30})
+	m = updated.(Model)
+	press := func(keys ...tea.KeyMsg) {
+		t.Helper()
+		m, _ = pressEdit(m, keys...)
+	}
+
+	press(runeKeys("5j")...)
+	if m.list.Index() != 5 || m.motionCount != 0 {
+		t.Fatalf("5j should move five items, index %d count %d", m.list.Index(), m.motionCount)
+	}
+	press(runeKeys("12k")...)
+	if m.list.Index() != 0 {
+		t.Errorf("12k should stop at the top, index %d", m.list.Index())
+	}
+	press(runeKeys("G")...)
+	if m.list.Index() != 39 {
+		t.Errorf("G should go to the last item, index %d", m.list.Index())
+	}
+	press(runeKeys("10G")...)
+	if m.list.Index() != 9 {
+		t.Errorf("10G should go to the 10th item, index %d", m.list.Index())
+	}
+	press(runeKeys("3gg")...)
+	if m.list.Index() != 2 || m.isGraphView {
+		t.Errorf("3gg should go to the 3rd item without opening the graph, index %d", m.list.Index())
+	}
+
+	half := m.motionHalfPage()
+	press(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if m.list.Index() != 2+half {
+		t.Errorf("ctrl+d should move half a page (%d), index %d", half, m.list.Index())
+	}
+	press(append(runeKeys("2"), tea.KeyMsg{Type: tea.KeyCtrlU})...)
+	if m.list.Index() != 0 {
+		t.Errorf("2 ctrl+u should move back a page, index %d", m.list.Index())
+	}
+
+	// gg: a quick second g comes back from the graph to the top
+	press(runeKeys("5j")...)
+	press(runeKeys("g")...)
+	if !m.isGraphView {
+		t.Fatal("a lone g should still open the graph")
+	}
+	press(runeKeys("g")...)
+	if m.isGraphView || m.FocusState() != "list" || m.list.Index() != 0 {
+		t.Errorf("gg should return to the top of the list, focus %s index %d", m.FocusState(), m.list.Index())
+	}
+}
+
+func TestMotionMarks(t *testing.T) {
+	m := NewModel(generateFlatIssues(40), nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 30})
+	m = updated.(Model)
+	press := func(keys ...tea.KeyMsg) {
+		t.Helper()
+		m, _ = pressEdit(m, keys...)
+	}
+
+	// ' opens the recipe picker until a mark is set
+	press(runeKeys("'")...)
+	if m.FocusState() != "recipe_picker" {
+		t.Fatalf("' without marks should open recipes, focus %s", m.FocusState())
+	}
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+
+	want := strings.Split(listIDs(m), ",")[7]
+	press(runeKeys("7jma")...)
+	press(runeKeys("G'a")...)
+	if m.list.Index() != 7 || m.jumpMarks["a"] != want {
+		t.Errorf("'a should jump back to %s, index %d marks %v", want, m.list.Index(), m.jumpMarks)
+	}
+	press(runeKeys("'z")...)
+	if !m.statusIsError {
+		t.Errorf("jumping to an unset mark should fail, status %q", m.statusMsg)
+	}
+	press(runeKeys("''")...)
+	if m.FocusState() != "recipe_picker" {
+		t.Errorf("'' should open recipes, focus %s", m.FocusState())
+	}
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Counts and marks work in the actionable plan too
+	press(runeKeys("a")...)
+	ids := m.actionableView.ItemIDs()
+	if len(ids) < 4 {
+		t.Fatalf("plan should list the open issues, got %v", ids)
+	}
+	press(runeKeys("3jmb")...)
+	if got := m.actionableView.SelectedIssueID(); got != ids[3] || m.jumpMarks["b"] != ids[3] {
+		t.Errorf("3j in the plan should select %s, got %s", ids[3], got)
+	}
+	press(runeKeys("G")...)
+	if got := m.actionableView.SelectedIssueID(); got != ids[len(ids)-1] {
+		t.Errorf("G in the plan should select the last item, got %s", got)
+	}
+	press(runeKeys("'b")...)
+	if got := m.actionableView.SelectedIssueID(); got != ids[3] {
+		t.Errorf("'b should jump back to %s, got %s", ids[3], got)
+	}
+}
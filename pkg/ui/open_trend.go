@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// trendDays is how far back the status bar's open-issue sparkline reaches
+const trendDays = 30
+
+// closedTime returns when issue was closed, falling back to its last
+// update for closed issues without a closed_at; ok is false for issues
+// still open
+func closedTime(issue model.Issue) (at time.Time, ok bool) {
+	if !isClosedLikeStatus(issue.Status) {
+		return time.Time{}, false
+	}
+	if issue.ClosedAt != nil {
+		return *issue.ClosedAt, true
+	}
+	return issue.UpdatedAt, true
+}
+
+// openTrend counts the issues open at the end of each of the last days
+// days, oldest first and ending with today. An issue is open from its
+// created_at until its closed_at; one without a created_at has always been
+// open, and a closed one without any timestamp never was.
+func openTrend(issues []model.Issue, now time.Time, days int) []int {
+	if days <= 0 {
+		return nil
+	}
+	endOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+	start := endOfToday.AddDate(0, 0, -days)
+	counts := make([]int, days)
+	for _, issue := range issues {
+		// The day the issue opened, and the day it closed (days if never)
+		from, to := 0, days
+		if !issue.CreatedAt.IsZero() {
+			if !issue.CreatedAt.Before(endOfToday) {
+				continue
+			}
+			from = max(int(issue.CreatedAt.Sub(start)/(24*time.Hour)), 0)
+		}
+		if at, closed := closedTime(issue); closed {
+			if at.IsZero() || at.Before(start) {
+				continue
+			}
+			to = min(int(at.Sub(start)/(24*time.Hour)), days)
+		}
+		for d := from; d < to; d++ {
+			counts[d]++
+		}
+	}
+	return counts
+}
+
+// trendSparkline draws counts as a row of block characters scaled between
+// their lowest and highest value, so small changes still show
+func trendSparkline(counts []int) string {
+	blocks := []string{"▁", "▂", "▃", "▄", "▅", "▆", "▇", "█"}
+	if !TermCapabilities().Unicode {
+		blocks = []string{"_", ".", ":", "-", "=", "+", "*", "#"}
+	}
+	if len(counts) == 0 {
+		return ""
+	}
+	lo, hi := counts[0], counts[0]
+	for _, c := range counts {
+		lo, hi = min(lo, c), max(hi, c)
+	}
+	var sb strings.Builder
+	for _, c := range counts {
+		level := (len(blocks) - 1) / 2
+		if hi > lo {
+			level = (c - lo) * (len(blocks) - 1) / (hi - lo)
+		}
+		sb.WriteString(blocks[level])
+	}
+	return sb.String()
+}
+
+// statusBarTrend is the status bar section showing the open-issue count
+// over the last trendDays days and its change, e.g. "30d ▁▂▃▅▇ +4"
+func (m Model) statusBarTrend() string {
+	if len(m.openTrend) < 2 {
+		return ""
+	}
+	delta := m.openTrend[len(m.openTrend)-1] - m.openTrend[0]
+	change := "±0"
+	if delta != 0 {
+		change = fmt.Sprintf("%+d", delta)
+	}
+	return fmt.Sprintf("%dd %s %s", len(m.openTrend), trendSparkline(m.openTrend), change)
+}
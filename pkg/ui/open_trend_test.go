@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestOpenTrendCountsOpenIssuesPerDay(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return now.AddDate(0, 0, d) }
+	at := func(d int) *time.Time { tm := day(d); return &tm }
+	issues := []model.Issue{
+		{ID: "old", Status: model.StatusOpen, CreatedAt: day(-40)},
+		{ID: "new", Status: model.StatusOpen, CreatedAt: day(-1)},
+		{ID: "done", Status: model.StatusClosed, CreatedAt: day(-3), ClosedAt: at(-2)},
+		{ID: "no-closed-at", Status: model.StatusClosed, CreatedAt: day(-4), UpdatedAt: day(0)},
+		{ID: "long-gone", Status: model.StatusClosed, CreatedAt: day(-60), ClosedAt: at(-50)},
+		{ID: "undated", Status: model.StatusInProgress},
+	}
+
+	// Four days back to today
+	got := openTrend(issues, now, 5)
+	want := []int{
+		3, // old, undated, no-closed-at
+		4, // + done
+		3, // done closed
+		4, // + new
+		3, // no-closed-at closed today, going by its last update
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("openTrend = %v, want %v", got, want)
+	}
+}
+
+func TestTrendSparklineInStatusBar(t *testing.T) {
+	if got := trendSparkline([]int{3, 3, 3}); got != "▄▄▄" {
+		t.Errorf("a flat trend should sit mid-height, got %q", got)
+	}
+	if got := trendSparkline([]int{10, 12, 17}); got != "▁▃█" {
+		t.Errorf("trend should scale between its extremes, got %q", got)
+	}
+
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "a", Title: "A", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -45)},
+		{ID: "b", Title: "B", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -10)},
+		{ID: "c", Title: "C", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -5)},
+	}
+	m := NewModel(issues, nil, "")
+	m.width = 200
+	if bar := m.renderStatusBar(); !strings.Contains(bar, "30d ") || !strings.Contains(bar, " +2") {
+		t.Errorf("status bar should show the 30-day trend and its change: %q", bar)
+	}
+}
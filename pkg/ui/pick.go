@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+// pickUser is whose work the "pick for me" draw considers: $BV_USER, then $USER
+func pickUser() string {
+	if u := strings.TrimSpace(os.Getenv("BV_USER")); u != "" {
+		return u
+	}
+	return strings.TrimSpace(os.Getenv("USER"))
+}
+
+// pickForMe draws a weighted random actionable issue, selects it in the list
+// and explains the draw in the status bar. The list falls back to the "all"
+// filter when the pick is hidden by the current one.
+func (m Model) pickForMe() Model {
+	pick, err := analysis.PickWeighted(m.issues, analysis.PickOptions{User: pickUser()})
+	if err != nil {
+		m.statusMsg = "🎲 " + err.Error()
+		m.statusIsError = false
+		return m
+	}
+	if !m.selectListIssue(pick.ID) {
+		m.currentFilter = "all"
+		m.applyFilter()
+		m.selectListIssue(pick.ID)
+	}
+	m.updateViewportContent()
+	m.statusMsg = fmt.Sprintf("🎲 %s: %s", pick.ID, strings.Join(pick.Reasons, ", "))
+	m.statusIsError = false
+	return m
+}
+
+// selectListIssue moves the list cursor to id, reporting whether it is listed
+func (m *Model) selectListIssue(id string) bool {
+	for i, item := range m.list.Items() {
+		if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == id {
+			m.list.Select(i)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestPickForMeSelectsActionableIssue(t *testing.T) {
+	t.Setenv("BV_USER", "alice")
+	issues := []model.Issue{
+		{ID: "done", Title: "Done", Status: model.StatusClosed},
+		{ID: "blocked", Title: "Blocked", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "blocked", DependsOnID: "root", Type: model.DepBlocks}}},
+		{ID: "root", Title: "Root", Status: model.StatusOpen, Priority: 1},
+		{ID: "bobs", Title: "Bob's", Status: model.StatusOpen, Assignee: "bob"},
+	}
+	m := NewModel(issues, nil, "")
+	m.currentFilter = "closed"
+	m.applyFilter()
+
+	m = m.pickForMe()
+	item, ok := m.list.SelectedItem().(IssueItem)
+	if !ok || item.Issue.ID != "root" {
+		t.Fatalf("selected %+v, want root", m.list.SelectedItem())
+	}
+	if m.currentFilter != "all" {
+		t.Errorf("filter = %q, want all after the pick was hidden", m.currentFilter)
+	}
+	for _, want := range []string{"root", "unblocks 1 issue", "the only candidate"} {
+		if !strings.Contains(m.statusMsg, want) {
+			t.Errorf("status %q missing %q", m.statusMsg, want)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+
+	"github.com/charmbracelet/bubbles/list"
+)
+
+// PinsVersion is the current schema version for the pins file
+const PinsVersion = 1
+
+// pinsFileName is the filename for persisted pins, kept beside the beads
+// file like tree-state.json
+const pinsFileName = "pins.json"
+
+// pinnedTrackID identifies the section the actionable view gathers pinned
+// items into, ahead of the plan's own tracks
+const pinnedTrackID = "pinned"
+
+// PinsState is the on-disk form of the pinned issues
+type PinsState struct {
+	Version int      `json:"version"`
+	Pinned  []string `json:"pinned"`
+}
+
+// PinsPath returns the path to the pins file in the given .beads directory
+func PinsPath(beadsDir string) string {
+	if beadsDir == "" {
+		beadsDir = ".beads"
+	}
+	return filepath.Join(beadsDir, pinsFileName)
+}
+
+// pinGlyph is drawn next to pinned issues
+func pinGlyph() string {
+	return glyph("📌", "^")
+}
+
+// loadPins reads the pinned issue IDs. A missing file means nothing is
+// pinned yet; a broken one is logged and ignored.
+func loadPins(path string) map[string]bool {
+	pinned := make(map[string]bool)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return pinned
+	}
+	var state PinsState
+	if err := json.Unmarshal(data, &state); err != nil {
+		log.Printf("warning: invalid pins file, ignoring it: %v", err)
+		return pinned
+	}
+	for _, id := range state.Pinned {
+		pinned[id] = true
+	}
+	return pinned
+}
+
+// savePins writes the pinned issue IDs, sorted so the file diffs cleanly.
+// Errors are logged but do not interrupt the user experience.
+func savePins(path string, pinned map[string]bool) {
+	state := PinsState{Version: PinsVersion, Pinned: []string{}}
+	for id := range pinned {
+		state.Pinned = append(state.Pinned, id)
+	}
+	sort.Strings(state.Pinned)
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		log.Printf("warning: failed to marshal pins: %v", err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		log.Printf("warning: failed to create pins directory: %v", err)
+		return
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		log.Printf("warning: failed to save pins: %v", err)
+	}
+}
+
+// togglePin pins or unpins the issue under the cursor in the list or the
+// actionable plan, moving it in or out of the pinned section and keeping
+// the cursor on it
+func (m Model) togglePin() Model {
+	_, cursor := m.markScope()
+	if cursor == "" {
+		return m
+	}
+	if m.pinned == nil {
+		m.pinned = make(map[string]bool)
+		m.updateListDelegate()
+	}
+	if m.pinned[cursor] {
+		delete(m.pinned, cursor)
+		m.statusMsg = "Unpinned " + cursor
+	} else {
+		m.pinned[cursor] = true
+		m.statusMsg = fmt.Sprintf("Pinned %s (%d pinned)", cursor, len(m.pinned))
+	}
+	m.statusIsError = false
+	if m.pinsPath != "" {
+		savePins(m.pinsPath, m.pinned)
+	}
+
+	if m.activeRecipe != nil {
+		m.applyRecipe(m.activeRecipe)
+	} else {
+		m.applyFilter()
+	}
+	m.selectInList(cursor)
+	if m.isActionableView || m.splitLeftIsActionable() {
+		m.buildActionableView()
+		m.actionableView.SelectByID(cursor)
+	}
+	return m
+}
+
+// pinFirst moves pinned items to the front of the list, keeping the order
+// they were sorted into on both sides
+func pinFirst(items []list.Item, pinned map[string]bool) {
+	if len(pinned) == 0 {
+		return
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		a, aok := items[i].(IssueItem)
+		b, bok := items[j].(IssueItem)
+		return aok && bok && pinned[a.Issue.ID] && !pinned[b.Issue.ID]
+	})
+}
+
+// pinPlan pulls the plan's pinned items out of their tracks into a leading
+// pinned track, dropping tracks left empty. Issues that aren't actionable
+// stay out of the plan even when pinned.
+func pinPlan(plan *analysis.ExecutionPlan, pinned map[string]bool) {
+	if len(pinned) == 0 {
+		return
+	}
+	pin := analysis.ExecutionTrack{TrackID: pinnedTrackID, Reason: "Pinned issues"}
+	var tracks []analysis.ExecutionTrack
+	for _, track := range plan.Tracks {
+		var rest []analysis.PlanItem
+		for _, item := range track.Items {
+			if !pinned[item.ID] {
+				rest = append(rest, item)
+				continue
+			}
+			pin.Items = append(pin.Items, item)
+			pin.EstimatedMinutes += item.EstimatedMinutes
+			pin.ChainMinutes = max(pin.ChainMinutes, item.ChainMinutes)
+			track.EstimatedMinutes -= item.EstimatedMinutes
+		}
+		if len(rest) > 0 {
+			track.Items = rest
+			tracks = append(tracks, track)
+		}
+	}
+	if len(pin.Items) == 0 {
+		return
+	}
+	plan.Tracks = append([]analysis.ExecutionTrack{pin}, tracks...)
+}
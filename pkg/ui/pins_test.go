@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPinMovesIssuesToTheTopAndPersists(t *testing.T) {
+	base := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Oldest", Status: model.StatusOpen, Priority: 2, CreatedAt: base, UpdatedAt: base.AddDate(0, 0, 9)},
+		{ID: "bv-2", Title: "Urgent", Status: model.StatusOpen, Priority: 0, CreatedAt: base.AddDate(0, 0, 1), UpdatedAt: base.AddDate(0, 0, 2)},
+		{ID: "bv-3", Title: "Newest", Status: model.StatusOpen, Priority: 3, CreatedAt: base.AddDate(0, 0, 5), UpdatedAt: base.AddDate(0, 0, 3)},
+		{ID: "bv-4", Title: "Tied", Status: model.StatusOpen, Priority: 2, CreatedAt: base.AddDate(0, 0, 2), UpdatedAt: base.AddDate(0, 0, 1)},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updated.(Model)
+	m.pinsPath = filepath.Join(t.TempDir(), ".beads", pinsFileName)
+	if got := listIDs(m); got != "bv-2,bv-4,bv-1,bv-3" {
+		t.Fatalf("list = %s", got)
+	}
+
+	m.selectInList("bv-3")
+	m = pressKey(m, "*")
+	if got := listIDs(m); got != "bv-3,bv-2,bv-4,bv-1" {
+		t.Fatalf("pinned issue should lead the list, got %s", got)
+	}
+	if m.selectedIssueID() != "bv-3" || !strings.Contains(m.statusMsg, "Pinned bv-3") {
+		t.Errorf("cursor should follow the pin, at %s: %q", m.selectedIssueID(), m.statusMsg)
+	}
+	if !strings.Contains(m.View(), pinGlyph()) {
+		t.Error("pinned rows should show the pin")
+	}
+
+	// Pinned issues keep the sort among themselves
+	m.selectInList("bv-1")
+	m = pressKey(m, "*")
+	if got := listIDs(m); got != "bv-1,bv-3,bv-2,bv-4" {
+		t.Errorf("list = %s", got)
+	}
+	if got := loadPins(m.pinsPath); len(got) != 2 || !got["bv-1"] || !got["bv-3"] {
+		t.Errorf("saved pins = %v", got)
+	}
+
+	m.selectInList("bv-3")
+	m = pressKey(m, "*")
+	if got := listIDs(m); got != "bv-1,bv-2,bv-4,bv-3" {
+		t.Errorf("unpinning should return the issue to its place, got %s", got)
+	}
+	if got := loadPins(m.pinsPath); len(got) != 1 || !got["bv-1"] {
+		t.Errorf("saved pins = %v", got)
+	}
+}
+
+func TestPinnedSectionLeadsActionableView(t *testing.T) {
+	m := NewModel(generateFlatIssues(4), nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = pressKey(updated.(Model), "a")
+	if !m.isActionableView {
+		t.Fatal("a should open the actionable view")
+	}
+	ids := m.actionableView.ItemIDs()
+	last := ids[len(ids)-1]
+	m.actionableView.SelectByID(last)
+	m = pressKey(m, "*")
+
+	if got := m.actionableView.ItemIDs(); got[0] != last || len(got) != len(ids) {
+		t.Fatalf("pinned item should lead the plan once, got %v", got)
+	}
+	if m.actionableView.SelectedIssueID() != last {
+		t.Errorf("cursor should follow the pin, at %s", m.actionableView.SelectedIssueID())
+	}
+	if out := m.actionableView.Render(); !strings.Contains(out, "PINNED") {
+		t.Errorf("render missing the pinned section:\n%s", out)
+	}
+}
+
+func TestPinPlanTakesItemsOutOfTheirTracks(t *testing.T) {
+	plan := analysis.ExecutionPlan{Tracks: []analysis.ExecutionTrack{
+		{TrackID: "track-A", Items: []analysis.PlanItem{{ID: "a", EstimatedMinutes: 30}, {ID: "b", EstimatedMinutes: 60}}, EstimatedMinutes: 90},
+		{TrackID: "track-B", Items: []analysis.PlanItem{{ID: "c", EstimatedMinutes: 15}}, EstimatedMinutes: 15},
+	}}
+	pinPlan(&plan, map[string]bool{"b": true, "c": true, "gone": true})
+
+	if len(plan.Tracks) != 2 || plan.Tracks[0].TrackID != pinnedTrackID {
+		t.Fatalf("tracks = %+v", plan.Tracks)
+	}
+	pinned, rest := plan.Tracks[0], plan.Tracks[1]
+	if len(pinned.Items) != 2 || pinned.Items[0].ID != "b" || pinned.Items[1].ID != "c" || pinned.EstimatedMinutes != 75 {
+		t.Errorf("pinned track = %+v", pinned)
+	}
+	if rest.TrackID != "track-A" || len(rest.Items) != 1 || rest.EstimatedMinutes != 30 {
+		t.Errorf("emptied tracks should go and the rest keep their other items: %+v", rest)
+	}
+}
+
+func TestLoadPinsToleratesMissingAndBrokenFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := PinsPath(dir)
+	if got := loadPins(path); len(got) != 0 {
+		t.Errorf("missing file should pin nothing, got %v", got)
+	}
+	if err := os.WriteFile(path, []byte("{not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got := loadPins(path); len(got) != 0 {
+		t.Errorf("broken file should pin nothing, got %v", got)
+	}
+	savePins(path, map[string]bool{"bv-2": true, "bv-1": true})
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), `"pinned": [`+"\n    \"bv-1\",\n    \"bv-2\"") {
+		t.Errorf("pins should be saved sorted:\n%s", data)
+	}
+}
@@ -0,0 +1,202 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Screen-reader mode (--plain, BV_PLAIN=1): the list and the detail view
+// are laid out as plain text with a label on every field, and whatever
+// other views draw passes through plainScreen, which drops emoji and box
+// drawing and spells out the arrows and marks that carry meaning.
+
+// plainSymbols spells out the symbols that carry meaning; other emoji,
+// box drawing and block elements are dropped
+var plainSymbols = map[rune]string{
+	'▸': ">", '▶': ">", '►': ">", '▾': "v", '▼': "v",
+	'→': "->", '←': "<-", '↑': "^", '↓': "v", '↳': "->",
+	'⚠': "!", '✓': "ok", '✔': "ok", '✗': "x", '✘': "x", '⊘': "x",
+	'•': "-", '…': "...", '—': "-", '–': "-",
+}
+
+// plainScreen strips a rendered screen down to plain text: emoji and box
+// drawing removed, separator-only lines left blank, and trailing spaces
+// trimmed. Colors are already gone, the renderer having no color profile
+// in this mode.
+func plainScreen(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		line = strings.ReplaceAll(line, " · ", ", ")
+		var sb strings.Builder
+		for _, r := range line {
+			if sym, ok := plainSymbols[r]; ok {
+				sb.WriteString(sym)
+			} else if isDecoration(r) {
+				sb.WriteByte(' ')
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+		line = strings.TrimRightFunc(sb.String(), unicode.IsSpace)
+		if strings.Trim(line, " -=|+.:_*") == "" {
+			line = ""
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isDecoration reports whether r is drawing or pictures rather than text:
+// box drawing, block elements, shapes, emoji and their joiners
+func isDecoration(r rune) bool {
+	switch {
+	case r >= 0x2500 && r <= 0x25FF: // box drawing, blocks, geometric shapes
+		return true
+	case r >= 0x2190 && r <= 0x21FF: // arrows not spelled out
+		return true
+	case r >= 0x2300 && r <= 0x23FF: // technical symbols (⏱ ⏸ ⌨)
+		return true
+	case r >= 0x2600 && r <= 0x27FF: // miscellaneous symbols, dingbats, arrows (⟳)
+		return true
+	case r >= 0x2800 && r <= 0x28FF: // braille, drawn as spinners
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // arrows and stars (⭐)
+		return true
+	case r >= 0x1F000 && r <= 0x1FAFF: // emoji
+		return true
+	case r == 0x200D || r == 0xFE0F || r == 0x20E3: // joiners, selectors
+		return true
+	case r == '·' || r == '°':
+		return true
+	}
+	return false
+}
+
+// plainRow renders a list row as labelled fields, > marking the cursor
+// and * the bulk-selection marks
+func (d IssueDelegate) plainRow(i IssueItem, isSelected bool, width int) string {
+	prefix := "  "
+	switch {
+	case d.Marked[i.Issue.ID] && isSelected:
+		prefix = ">*"
+	case d.Marked[i.Issue.ID]:
+		prefix = " *"
+	case isSelected:
+		prefix = "> "
+	}
+
+	issue := i.Issue
+	fields := []string{
+		"status " + string(issue.Status),
+		"priority " + GetPriorityLabel(issue.Priority),
+		"type " + string(issue.IssueType),
+	}
+	if issue.Assignee != "" {
+		fields = append(fields, "assignee "+issue.Assignee)
+	}
+	if d.Pinned[issue.ID] {
+		fields = append(fields, "pinned")
+	}
+	row := fmt.Sprintf("%s%s: %s. %s.", prefix, issue.ID, issue.Title, strings.Join(fields, ", "))
+	return truncateRunesHelper(row, width, "...")
+}
+
+// plainIssueDetail renders the detail view as labelled fields with the
+// longer texts indented under their headings
+func (m Model) plainIssueDetail(issueItem IssueItem) string {
+	item := issueItem.Issue
+	width := max(m.viewport.Width-4, 20)
+	var sb strings.Builder
+	field := func(label, value string) {
+		if value != "" {
+			sb.WriteString("  " + label + ": " + value + "\n")
+		}
+	}
+	section := func(heading, text string) {
+		if strings.TrimSpace(text) == "" {
+			return
+		}
+		sb.WriteString("\n" + heading + ":\n")
+		for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+			for _, wrapped := range strings.Split(wrapText(line, width), "\n") {
+				sb.WriteString("  " + wrapped + "\n")
+			}
+		}
+	}
+
+	sb.WriteString("Issue " + item.ID + ": " + item.Title + "\n")
+	field("Type", string(item.IssueType))
+	field("Status", string(item.Status))
+	field("Priority", GetPriorityLabel(item.Priority))
+	field("Assignee", item.Assignee)
+	field("Labels", strings.Join(item.Labels, ", "))
+	if item.EstimatedMinutes != nil && *item.EstimatedMinutes > 0 {
+		field("Estimate", fmt.Sprintf("%d minutes", *item.EstimatedMinutes))
+	}
+	field("Logged", loggedSummary(item, time.Now()))
+	if item.DueDate != nil {
+		field("Due", item.DueDate.Format("2006-01-02"))
+	}
+	if !item.CreatedAt.IsZero() {
+		field("Created", item.CreatedAt.Format("2006-01-02"))
+	}
+	if !item.UpdatedAt.IsZero() {
+		field("Updated", item.UpdatedAt.Format("2006-01-02"))
+	}
+	if issueItem.TriageScore > 0 {
+		field("Triage score", fmt.Sprintf("%.2f of 1.00", issueItem.TriageScore))
+	}
+	field("Triage reason", issueItem.TriageReason)
+	if issueItem.UnblocksCount > 0 {
+		field("Unblocks", fmt.Sprintf("%d issues", issueItem.UnblocksCount))
+	}
+
+	section("Description", item.Description)
+	section("Design notes", item.Design)
+	section("Acceptance criteria", item.AcceptanceCriteria)
+	section("Notes", item.Notes)
+
+	// Links, numbered as 1-9 follows them
+	var dependsOn, neededBy []string
+	for n, link := range m.detailLinks(item) {
+		kind := string(link.kind)
+		if kind == "" {
+			kind = string(model.DepBlocks)
+		}
+		num := "-"
+		if n < maxDetailJumps {
+			num = fmt.Sprintf("%d.", n+1)
+		}
+		line := fmt.Sprintf("%s %s, %s", num, link.id, kind)
+		if target, ok := m.issueMap[link.id]; ok {
+			line = fmt.Sprintf("%s %s: %s, status %s, %s", num, link.id, target.Title, target.Status, kind)
+		}
+		if link.dependent {
+			neededBy = append(neededBy, line)
+		} else {
+			dependsOn = append(dependsOn, line)
+		}
+	}
+	section("Depends on", strings.Join(dependsOn, "\n"))
+	section("Needed by", strings.Join(neededBy, "\n"))
+
+	if len(item.Comments) > 0 {
+		sb.WriteString(fmt.Sprintf("\nComments (%d):\n", len(item.Comments)))
+		for _, c := range item.Comments {
+			if c == nil {
+				continue
+			}
+			sb.WriteString("  " + c.Author + ", " + FormatTimeRel(c.CreatedAt) + ":\n")
+			for _, line := range strings.Split(strings.TrimSpace(c.Text), "\n") {
+				for _, wrapped := range strings.Split(wrapText(line, width-2), "\n") {
+					sb.WriteString("    " + wrapped + "\n")
+				}
+			}
+		}
+	}
+	return sb.String()
+}
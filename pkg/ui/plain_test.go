@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPlainModeRendersLabelledText(t *testing.T) {
+	t.Cleanup(func() { SetCapabilities(Capabilities{Color: ColorTrueColor, Unicode: true, Images: ImageNone}) })
+	SetCapabilities(TermCapabilities().WithPlain())
+
+	issues := []model.Issue{
+		{ID: "p1", Title: "Fix login", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeBug, Assignee: "ann",
+			Labels: []string{"auth"}, Description: "Users get logged out.",
+			Dependencies: []*model.Dependency{{IssueID: "p1", DependsOnID: "p2", Type: model.DepBlocks}}},
+		{ID: "p2", Title: "Session store", Status: model.StatusInProgress, Priority: 2, IssueType: model.TypeTask},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 30})
+	m = updated.(Model)
+
+	out := m.View()
+	for _, r := range out {
+		if r > 127 {
+			t.Fatalf("non-ASCII rune %q in plain output:\n%s", r, out)
+		}
+	}
+	if !strings.Contains(out, "> p1: Fix login. status open, priority P1, type bug, assignee ann.") {
+		t.Errorf("list row should spell out its fields:\n%s", out)
+	}
+	if strings.Contains(out, "\x1b[") {
+		t.Error("plain output should carry no color")
+	}
+
+	m = pressKey(m, "enter")
+	out = m.View()
+	for _, want := range []string{"Issue p1: Fix login", "  Status: open", "  Labels: auth",
+		"Description:\n  Users get logged out.", "Depends on:\n  1. p2: Session store, status in_progress, blocks"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("detail missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestPlainScreenDropsDecoration(t *testing.T) {
+	in := "╭──────╮\n│ ⚡ ACTIONABLE  │  3 items · 2 tracks\n▸ ├─ 🔥 A1 Title →2 ⚠ over\n·······"
+	want := "\n    ACTIONABLE     3 items, 2 tracks\n>      A1 Title ->2 ! over\n"
+	if got := plainScreen(in); got != want {
+		t.Errorf("plainScreen() = %q, want %q", got, want)
+	}
+}
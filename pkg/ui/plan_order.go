@@ -0,0 +1,319 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+)
+
+// The actionable plan's tracks come from the dependency graph, but the
+// order inside them (and which track a loose item joins) is often a
+// judgment call. J and K move the selected item down and up, across track
+// boundaries at either end, and the order given by hand is saved and laid
+// over every plan built after it. Track IDs are positional and change as
+// the graph does, so a hand-ordered track is stored as the issue IDs it
+// holds; it is matched back to whichever generated track holds most of
+// them.
+
+// PlanOrderVersion is the current schema version for the plan order file
+const PlanOrderVersion = 1
+
+// planOrderFileName is the filename for the hand-ordered tracks, kept
+// beside the beads file like pins.json
+const planOrderFileName = "plan-order.json"
+
+// PlanOrderState is the on-disk form of the hand-ordered tracks. Each
+// entry is one track's issue IDs in the order given; an ID appears in at
+// most one of them.
+type PlanOrderState struct {
+	Version int        `json:"version"`
+	Tracks  [][]string `json:"tracks"`
+}
+
+// PlanOrderPath returns the path to the plan order file in the given
+// .beads directory
+func PlanOrderPath(beadsDir string) string {
+	if beadsDir == "" {
+		beadsDir = ".beads"
+	}
+	return filepath.Join(beadsDir, planOrderFileName)
+}
+
+// loadPlanOrder reads the hand-ordered tracks. A missing file means none
+// yet; a broken one is logged and ignored.
+func loadPlanOrder(path string) [][]string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var state PlanOrderState
+	if err := json.Unmarshal(data, &state); err != nil {
+		log.Printf("warning: invalid plan order file, ignoring it: %v", err)
+		return nil
+	}
+	return state.Tracks
+}
+
+// savePlanOrder writes the hand-ordered tracks over the file, an empty
+// list once the last one is reset. A failed save is only logged: the order
+// in memory still applies to this session's plans.
+func savePlanOrder(path string, order [][]string) {
+	state := PlanOrderState{Version: PlanOrderVersion, Tracks: order}
+	if state.Tracks == nil {
+		state.Tracks = [][]string{}
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		log.Printf("warning: failed to marshal plan order: %v", err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		log.Printf("warning: failed to create plan order directory: %v", err)
+		return
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		log.Printf("warning: failed to save plan order: %v", err)
+	}
+}
+
+// orderPlan lays the hand-ordered tracks over a generated plan. Each one
+// claims the track holding most of its items (the earliest on a tie),
+// pulls its items found elsewhere into it and puts them first in the given
+// order, ahead of the track's other items in their generated order. Tracks
+// left empty are dropped; items no longer in the plan are skipped.
+func orderPlan(plan *analysis.ExecutionPlan, order [][]string) {
+	if len(order) == 0 || len(plan.Tracks) == 0 {
+		return
+	}
+	// ordered counts the hand-placed items leading each track
+	ordered := make([]int, len(plan.Tracks))
+	touched := make([]bool, len(plan.Tracks))
+	for _, seq := range order {
+		at := make(map[string]int)
+		for t, track := range plan.Tracks {
+			for _, item := range track.Items {
+				at[item.ID] = t
+			}
+		}
+		votes := make([]int, len(plan.Tracks))
+		var ids []string
+		for _, id := range seq {
+			if t, ok := at[id]; ok {
+				votes[t]++
+				ids = append(ids, id)
+				delete(at, id) // repeated IDs count once
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		target := 0
+		for t, v := range votes {
+			if v > votes[target] {
+				target = t
+			}
+		}
+
+		// Take the items out wherever they are, then put them back in order
+		picked := make(map[string]analysis.PlanItem, len(ids))
+		for _, id := range ids {
+			picked[id] = analysis.PlanItem{}
+		}
+		for t := range plan.Tracks {
+			track := &plan.Tracks[t]
+			lead := ordered[t]
+			var rest []analysis.PlanItem
+			for i, item := range track.Items {
+				if _, ok := picked[item.ID]; !ok {
+					rest = append(rest, item)
+					continue
+				}
+				picked[item.ID] = item
+				touched[t] = true
+				if i < lead {
+					ordered[t]--
+				}
+			}
+			track.Items = rest
+		}
+		dst := &plan.Tracks[target]
+		items := make([]analysis.PlanItem, 0, len(dst.Items)+len(ids))
+		items = append(items, dst.Items[:ordered[target]]...)
+		for _, id := range ids {
+			items = append(items, picked[id])
+		}
+		dst.Items = append(items, dst.Items[ordered[target]:]...)
+		ordered[target] += len(ids)
+		touched[target] = true
+	}
+
+	var tracks []analysis.ExecutionTrack
+	for t, track := range plan.Tracks {
+		if len(track.Items) == 0 {
+			continue
+		}
+		if ordered[t] > 0 {
+			track.Reason += " · ordered by hand"
+		}
+		if touched[t] {
+			track.EstimatedMinutes, track.ChainMinutes = 0, 0
+			for _, item := range track.Items {
+				track.EstimatedMinutes += item.EstimatedMinutes
+				track.ChainMinutes = max(track.ChainMinutes, item.ChainMinutes)
+			}
+		}
+		tracks = append(tracks, track)
+	}
+	plan.Tracks = tracks
+}
+
+// moveInPlan moves the selected actionable item delta places (±1) within
+// its track, or into the end of the neighbouring track when it is already
+// at that end, and saves the order of the tracks it changed. The pinned
+// section keeps the order the sort gives it.
+func (m Model) moveInPlan(delta int) Model {
+	av := &m.actionableView
+	tracks := av.plan.Tracks
+	if len(tracks) == 0 {
+		return m
+	}
+	t, i := av.selectedTrack, av.selectedItem
+	if tracks[t].TrackID == pinnedTrackID {
+		m.statusMsg = "Pinned issues follow the sort; unpin with * to place one by hand"
+		m.statusIsError = true
+		return m
+	}
+	id := tracks[t].Items[i].ID
+	dir, end := "down", "bottom"
+	if delta < 0 {
+		dir, end = "up", "top"
+	}
+	changed := []int{t}
+	switch j := i + delta; {
+	case j >= 0 && j < len(tracks[t].Items):
+		items := tracks[t].Items
+		items[i], items[j] = items[j], items[i]
+	default:
+		n := t + delta
+		if n < 0 || n >= len(tracks) || tracks[n].TrackID == pinnedTrackID {
+			m.statusMsg = "Already at the " + end + " of the plan"
+			m.statusIsError = false
+			return m
+		}
+		item := tracks[t].Items[i]
+		tracks[t].Items = append(tracks[t].Items[:i:i], tracks[t].Items[i+1:]...)
+		if delta < 0 {
+			tracks[n].Items = append(tracks[n].Items, item)
+		} else {
+			tracks[n].Items = append([]analysis.PlanItem{item}, tracks[n].Items...)
+		}
+		changed = append(changed, n)
+	}
+
+	// The tracks changed are saved whole. Items the filter hides keep
+	// their place at the end of the hand order they were in, and the IDs
+	// saved are taken out of every other hand order.
+	shown := make(map[string]bool)
+	for _, track := range tracks {
+		for _, item := range track.Items {
+			shown[item.ID] = true
+		}
+	}
+	var saved [][]string
+	claimed := make(map[string]bool)
+	for _, c := range changed {
+		var seq []string
+		for _, item := range tracks[c].Items {
+			seq = append(seq, item.ID)
+		}
+		for _, old := range m.planOrder {
+			if !overlaps(old, seq) {
+				continue
+			}
+			for _, oid := range old {
+				if !shown[oid] {
+					seq = append(seq, oid)
+				}
+			}
+		}
+		for _, sid := range seq {
+			claimed[sid] = true
+		}
+		if len(seq) > 0 {
+			saved = append(saved, seq)
+		}
+	}
+	for _, old := range m.planOrder {
+		var rest []string
+		for _, oid := range old {
+			if !claimed[oid] {
+				rest = append(rest, oid)
+			}
+		}
+		if len(rest) > 0 {
+			saved = append(saved, rest)
+		}
+	}
+	m.planOrder = saved
+	if m.planOrderPath != "" {
+		savePlanOrder(m.planOrderPath, m.planOrder)
+	}
+
+	m.buildActionableView()
+	m.actionableView.SelectByID(id)
+	m.statusMsg = fmt.Sprintf("Moved %s %s", id, dir)
+	m.statusIsError = false
+	return m
+}
+
+// resetTrackOrder drops the hand order of the selected actionable track,
+// giving it back the generated order
+func (m Model) resetTrackOrder() Model {
+	id := m.actionableView.SelectedIssueID()
+	tracks := m.actionableView.plan.Tracks
+	if id == "" {
+		return m
+	}
+	var seq []string
+	for _, item := range tracks[m.actionableView.selectedTrack].Items {
+		seq = append(seq, item.ID)
+	}
+	var kept [][]string
+	for _, old := range m.planOrder {
+		if !overlaps(old, seq) {
+			kept = append(kept, old)
+		}
+	}
+	if len(kept) == len(m.planOrder) {
+		m.statusMsg = "This track isn't ordered by hand"
+		m.statusIsError = false
+		return m
+	}
+	m.planOrder = kept
+	if m.planOrderPath != "" {
+		savePlanOrder(m.planOrderPath, m.planOrder)
+	}
+	m.buildActionableView()
+	m.actionableView.SelectByID(id)
+	m.statusMsg = "Track back in plan order"
+	m.statusIsError = false
+	return m
+}
+
+// overlaps reports whether a and b share an ID
+func overlaps(a, b []string) bool {
+	in := make(map[string]bool, len(a))
+	for _, id := range a {
+		in[id] = true
+	}
+	for _, id := range b {
+		if in[id] {
+			return true
+		}
+	}
+	return false
+}
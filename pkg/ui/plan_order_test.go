@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func planTracks(plan analysis.ExecutionPlan) [][]string {
+	var tracks [][]string
+	for _, track := range plan.Tracks {
+		var ids []string
+		for _, item := range track.Items {
+			ids = append(ids, item.ID)
+		}
+		tracks = append(tracks, ids)
+	}
+	return tracks
+}
+
+func TestOrderPlanLaysHandOrderOverTracks(t *testing.T) {
+	plan := analysis.ExecutionPlan{Tracks: []analysis.ExecutionTrack{
+		{TrackID: "track-A", Reason: "Auth", Items: []analysis.PlanItem{{ID: "a", EstimatedMinutes: 30}, {ID: "b"}, {ID: "c"}}, EstimatedMinutes: 30},
+		{TrackID: "track-B", Reason: "UI", Items: []analysis.PlanItem{{ID: "d", EstimatedMinutes: 15}}, EstimatedMinutes: 15},
+		{TrackID: "track-C", Reason: "Docs", Items: []analysis.PlanItem{{ID: "e"}, {ID: "f"}}},
+	}}
+	orderPlan(&plan, [][]string{
+		{"c", "gone", "d", "a"}, // Most of it is in track A, so d joins it
+		{"f"},
+	})
+
+	want := [][]string{{"c", "d", "a", "b"}, {"f", "e"}}
+	if got := planTracks(plan); !reflect.DeepEqual(got, want) {
+		t.Fatalf("tracks = %v, want %v", got, want)
+	}
+	a := plan.Tracks[0]
+	if a.TrackID != "track-A" || !strings.Contains(a.Reason, "by hand") || a.EstimatedMinutes != 45 {
+		t.Errorf("hand-ordered track should say so and carry d's estimate: %+v", a)
+	}
+}
+
+func TestMoveInPlanReordersAndPersists(t *testing.T) {
+	// x and y both gate z, so they share a track; w is on its own
+	issues := []model.Issue{
+		{ID: "x", Title: "X", Status: model.StatusOpen, Priority: 1},
+		{ID: "y", Title: "Y", Status: model.StatusOpen, Priority: 2},
+		{ID: "z", Title: "Z", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{IssueID: "z", DependsOnID: "x", Type: model.DepBlocks},
+			{IssueID: "z", DependsOnID: "y", Type: model.DepBlocks},
+		}},
+		{ID: "w", Title: "W", Status: model.StatusOpen, Priority: 3},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = pressKey(updated.(Model), "a")
+	m.planOrderPath = filepath.Join(t.TempDir(), ".beads", planOrderFileName)
+	if got := planTracks(m.actionableView.plan); !reflect.DeepEqual(got, [][]string{{"w"}, {"x", "y"}}) {
+		t.Fatalf("generated tracks = %v", got)
+	}
+
+	m.actionableView.SelectByID("y")
+	m = pressKey(m, "K")
+	if got := planTracks(m.actionableView.plan); !reflect.DeepEqual(got, [][]string{{"w"}, {"y", "x"}}) {
+		t.Fatalf("K should move y up, got %v", got)
+	}
+	if m.actionableView.SelectedIssueID() != "y" || !strings.Contains(m.actionableView.Render(), "ordered by hand") {
+		t.Errorf("cursor should follow y and the track say it is hand-ordered, at %s", m.actionableView.SelectedIssueID())
+	}
+
+	// Past the top of a track, K carries the item to the end of the one above
+	m = pressKey(m, "K")
+	if got := planTracks(m.actionableView.plan); !reflect.DeepEqual(got, [][]string{{"w", "y"}, {"x"}}) {
+		t.Fatalf("K at the top of a track should move y into the previous, got %v", got)
+	}
+	if got := loadPlanOrder(m.planOrderPath); !reflect.DeepEqual(got, [][]string{{"x"}, {"w", "y"}}) {
+		t.Errorf("saved order = %v", got)
+	}
+	m.actionableView.SelectByID("w")
+	if m = pressKey(m, "K"); !strings.Contains(m.statusMsg, "top of the plan") {
+		t.Errorf("K at the top should say so, status %q", m.statusMsg)
+	}
+
+	// X gives a track its plan order back
+	m.actionableView.SelectByID("y")
+	m = pressKey(m, "X")
+	if got := planTracks(m.actionableView.plan); !reflect.DeepEqual(got, [][]string{{"w"}, {"x", "y"}}) {
+		t.Errorf("resetting y's new track should send it home, got %v", got)
+	}
+	if got := loadPlanOrder(m.planOrderPath); !reflect.DeepEqual(got, [][]string{{"x"}}) {
+		t.Errorf("saved order after reset = %v", got)
+	}
+}
@@ -3,28 +3,106 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
 
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
-// RecipePickerModel represents the recipe picker overlay
+// RecipePickerModel represents the recipe picker overlay. In save mode it
+// asks for the name to save the current filters under instead.
 type RecipePickerModel struct {
 	recipes       []recipe.Recipe
+	source        func(name string) string // builtin, user or project; nil hides it
+	active        string                   // Name of the recipe in force
 	selectedIndex int
 	width         int
 	height        int
 	theme         Theme
+
+	saving    bool
+	nameInput textinput.Model
+	savePath  string // Where a saved recipe is written, for the prompt
 }
 
 // NewRecipePickerModel creates a new recipe picker
 func NewRecipePickerModel(recipes []recipe.Recipe, theme Theme) RecipePickerModel {
+	ti := textinput.New()
+	ti.Placeholder = "my-recipe"
+	ti.CharLimit = 64
+	ti.Prompt = "Name: "
 	return RecipePickerModel{
 		recipes:       recipes,
 		selectedIndex: 0,
 		theme:         theme,
+		nameInput:     ti,
+	}
+}
+
+// SetRecipes replaces the recipes listed, e.g. after a reload, keeping the
+// selection on the same recipe when it is still there
+func (m *RecipePickerModel) SetRecipes(recipes []recipe.Recipe, source func(name string) string) {
+	selected := ""
+	if r := m.SelectedRecipe(); r != nil {
+		selected = r.Name
 	}
+	m.recipes = recipes
+	m.source = source
+	m.selectedIndex = 0
+	m.Select(selected)
+}
+
+// SetActive marks the recipe in force and selects it
+func (m *RecipePickerModel) SetActive(name string) {
+	m.active = name
+	m.Select(name)
+}
+
+// Select selects the named recipe, reporting whether it is listed
+func (m *RecipePickerModel) Select(name string) bool {
+	for i, r := range m.recipes {
+		if r.Name == name {
+			m.selectedIndex = i
+			return true
+		}
+	}
+	return false
+}
+
+// StartSave switches to the name prompt, filled in with suggested
+func (m *RecipePickerModel) StartSave(suggested, path string) {
+	m.saving = true
+	m.savePath = path
+	m.nameInput.SetValue(suggested)
+	m.nameInput.CursorEnd()
+	m.nameInput.Focus()
+}
+
+// CancelSave goes back to the list
+func (m *RecipePickerModel) CancelSave() {
+	m.saving = false
+	m.nameInput.Blur()
+}
+
+// Saving reports whether the name prompt is showing
+func (m *RecipePickerModel) Saving() bool {
+	return m.saving
+}
+
+// SaveName returns the name typed into the prompt
+func (m *RecipePickerModel) SaveName() string {
+	return strings.TrimSpace(m.nameInput.Value())
+}
+
+// UpdateInput forwards a key to the name prompt
+func (m *RecipePickerModel) UpdateInput(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	m.nameInput, cmd = m.nameInput.Update(msg)
+	return cmd
 }
 
 // SetSize updates the picker dimensions
@@ -88,10 +166,56 @@ func (m *RecipePickerModel) View() string {
 		Foreground(t.Primary).
 		Bold(true).
 		MarginBottom(1)
-	lines = append(lines, titleStyle.Render("Select Recipe"))
-	lines = append(lines, "")
+	footerStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+
+	if m.saving {
+		lines = append(lines, titleStyle.Render("Save Current Filters as Recipe"))
+		lines = append(lines, "")
+		lines = append(lines, m.nameInput.View())
+		if m.savePath != "" {
+			lines = append(lines, "")
+			lines = append(lines, footerStyle.Render("Writes "+truncateRunesHelper(m.savePath, boxWidth-11, "…")))
+		}
+		lines = append(lines, "")
+		lines = append(lines, footerStyle.Render("enter: save • esc: back"))
+	} else {
+		lines = append(lines, titleStyle.Render("Select Recipe"))
+		lines = append(lines, "")
+		lines = append(lines, m.recipeLines(boxWidth)...)
+
+		// Footer with keybindings
+		lines = append(lines, "")
+		lines = append(lines, footerStyle.Render("j/k: navigate • enter: apply • s: save current • esc: cancel"))
+	}
 
-	// Recipe list
+	content := strings.Join(lines, "\n")
+
+	// Box style
+	boxStyle := t.Renderer.NewStyle().
+		Border(termBorder(lipgloss.RoundedBorder())).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	box := boxStyle.Render(content)
+
+	// Center in viewport
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		box,
+	)
+}
+
+// recipeLines renders the recipes, scrolled so the selection fits the
+// height
+func (m *RecipePickerModel) recipeLines(boxWidth int) []string {
+	t := m.theme
+	var blocks [][]string
 	for i, r := range m.recipes {
 		isSelected := i == m.selectedIndex
 
@@ -108,8 +232,16 @@ func (m *RecipePickerModel) View() string {
 			prefix = "▸ "
 		}
 
-		name := prefix + r.Name
-		lines = append(lines, nameStyle.Render(name))
+		name := nameStyle.Render(prefix + r.Name)
+		if m.source != nil {
+			if src := m.source(r.Name); src != "" {
+				name += " " + t.MutedText.Render("("+src+")")
+			}
+		}
+		if r.Name == m.active {
+			name += " " + t.Renderer.NewStyle().Foreground(ColorSuccess).Render("✓")
+		}
+		block := []string{name}
 
 		// Description (indented, dimmed)
 		if r.Description != "" {
@@ -117,41 +249,37 @@ func (m *RecipePickerModel) View() string {
 				Foreground(t.Secondary).
 				Italic(true)
 			desc := "    " + truncateRunesHelper(r.Description, boxWidth-8, "…")
-			lines = append(lines, descStyle.Render(desc))
+			block = append(block, descStyle.Render(desc))
 		}
 
 		// Add blank line between recipes
 		if i < len(m.recipes)-1 {
-			lines = append(lines, "")
+			block = append(block, "")
 		}
+		blocks = append(blocks, block)
 	}
 
-	// Footer with keybindings
-	lines = append(lines, "")
-	footerStyle := t.Renderer.NewStyle().
-		Foreground(t.Secondary).
-		Italic(true)
-	lines = append(lines, footerStyle.Render("j/k: navigate • enter: apply • esc: cancel"))
-
-	content := strings.Join(lines, "\n")
-
-	// Box style
-	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(t.Primary).
-		Padding(1, 2).
-		Width(boxWidth)
-
-	box := boxStyle.Render(content)
-
-	// Center in viewport
-	return lipgloss.Place(
-		m.width,
-		m.height,
-		lipgloss.Center,
-		lipgloss.Center,
-		box,
-	)
+	// Title, footer, border and padding take 10 lines
+	room := max(m.height-10, 3)
+	height := func(from, to int) int {
+		n := 0
+		for _, b := range blocks[from : to+1] {
+			n += len(b)
+		}
+		return n
+	}
+	start := 0
+	for start < m.selectedIndex && height(start, m.selectedIndex) > room {
+		start++
+	}
+	var lines []string
+	for i := start; i < len(blocks); i++ {
+		if i > m.selectedIndex && len(lines)+len(blocks[i]) > room {
+			break
+		}
+		lines = append(lines, blocks[i]...)
+	}
+	return lines
 }
 
 // RecipeCount returns the number of recipes
@@ -166,3 +294,147 @@ func FormatRecipeInfo(r *recipe.Recipe) string {
 	}
 	return fmt.Sprintf("Recipe: %s", r.Name)
 }
+
+// openRecipePicker shows the recipe picker, on the recipe in force
+func (m Model) openRecipePicker() Model {
+	m.showRecipePicker = true
+	m.recipePicker.SetSize(m.width, m.height-1)
+	active := ""
+	if m.activeRecipe != nil {
+		active = m.activeRecipe.Name
+	}
+	m.recipePicker.SetActive(active)
+	m.focused = focusRecipePicker
+	return m
+}
+
+// openSaveRecipe shows the recipe picker's prompt for saving the current
+// filters, suggesting the active recipe's name
+func (m Model) openSaveRecipe() Model {
+	m = m.openRecipePicker()
+	suggested := ""
+	if m.activeRecipe != nil && m.activeRecipe.Name != queryRecipeName && m.recipeLoader.Source(m.activeRecipe.Name) != "builtin" {
+		suggested = m.activeRecipe.Name
+	}
+	m.recipePicker.StartSave(suggested, m.recipeLoader.ProjectPath())
+	return m
+}
+
+// closeRecipePicker hides the picker and goes back to the list
+func (m *Model) closeRecipePicker() {
+	m.recipePicker.CancelSave()
+	m.showRecipePicker = false
+	m.focused = focusList
+}
+
+// useRecipe applies a recipe to the loaded issues. Its own sort replaces
+// the one picked with s.
+func (m *Model) useRecipe(r *recipe.Recipe) {
+	m.sortMode = SortMode{}
+	m.setActiveRecipe(r)
+	m.applyRecipe(r)
+	m.statusMsg = FormatRecipeInfo(r)
+	m.statusIsError = false
+}
+
+// currentFiltersRecipe describes what the list shows as a recipe: the
+// active recipe or query, or else the o/c/r/label filter, with the sort
+// picked with s and the list columns
+func (m Model) currentFiltersRecipe(name string) recipe.Recipe {
+	var r recipe.Recipe
+	switch {
+	case m.queryRecipe() != nil:
+		r = *m.queryRecipe()
+		r.Description = "Query: " + r.Description
+	case m.activeRecipe != nil:
+		r = *m.activeRecipe
+		r.Description = "Based on " + m.activeRecipe.Name
+	default:
+		yes := true
+		switch f := m.currentFilter; {
+		case f == "open":
+			r.Filters.ExcludeStatus = []string{string(model.StatusClosed), string(model.StatusTombstone)}
+			r.Description = "Open issues"
+		case f == "closed":
+			r.Filters.Status = []string{string(model.StatusClosed)}
+			r.Description = "Closed issues"
+		case f == "ready":
+			r.Filters.Status = []string{string(model.StatusOpen), string(model.StatusInProgress)}
+			r.Filters.Actionable = &yes
+			r.Description = "Ready issues (no open blockers)"
+		case strings.HasPrefix(f, "label:"):
+			label := strings.TrimPrefix(f, "label:")
+			r.Filters.Tags = []string{label}
+			r.Description = "Issues labeled " + label
+		default:
+			r.Description = "All issues"
+		}
+	}
+	r.Name = name
+
+	if m.sortMode.Field != SortDefault {
+		r.Sort = recipe.SortConfig{Field: m.sortMode.recipeField(), Direction: "asc"}
+		if m.sortMode.Desc {
+			r.Sort.Direction = "desc"
+		}
+	}
+
+	if m.recipeColumns != nil || m.userColumns != nil {
+		r.View.Columns = nil
+		for _, c := range m.listColumns() {
+			if c.Width > 0 {
+				r.View.Columns = append(r.View.Columns, fmt.Sprintf("%s:%d", c.Name, c.Width))
+			} else {
+				r.View.Columns = append(r.View.Columns, c.Name)
+			}
+		}
+	}
+	return r
+}
+
+// saveCurrentRecipe writes the current filters to the project recipes
+// file under name and switches to the saved recipe
+func (m *Model) saveCurrentRecipe(name string) {
+	if !validRecipeName(name) {
+		m.statusMsg = "Recipe names use letters, digits, - and _"
+		m.statusIsError = true
+		return
+	}
+	path := m.recipeLoader.ProjectPath()
+	if path == "" {
+		m.statusMsg = "No project directory to save recipes in"
+		m.statusIsError = true
+		return
+	}
+	if err := recipe.SaveRecipe(path, m.currentFiltersRecipe(name)); err != nil {
+		m.statusMsg = fmt.Sprintf("Saving recipe: %v", err)
+		m.statusIsError = true
+		return
+	}
+	if err := m.recipeLoader.Reload(); err != nil {
+		m.statusMsg = fmt.Sprintf("Reloading recipes: %v", err)
+		m.statusIsError = true
+		return
+	}
+	m.recipePicker.SetRecipes(m.recipeLoader.List(), m.recipeLoader.Source)
+	m.closeRecipePicker()
+	if r := m.recipeLoader.Get(name); r != nil {
+		m.useRecipe(r)
+	}
+	m.statusMsg = fmt.Sprintf("Saved recipe %s to %s", name, path)
+	m.statusIsError = false
+}
+
+// validRecipeName reports whether name can be saved as a recipe: letters,
+// digits, - and _, and not the filter bar's own recipe
+func validRecipeName(name string) bool {
+	if name == "" || name == queryRecipeName {
+		return false
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+			return false
+		}
+	}
+	return true
+}
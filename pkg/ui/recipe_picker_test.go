@@ -1,11 +1,14 @@
 package ui
 
 import (
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -59,3 +62,114 @@ func TestFormatRecipeInfo(t *testing.T) {
 		t.Fatalf("unexpected format: %s", got)
 	}
 }
+
+func TestRecipePickerScrollsToSelection(t *testing.T) {
+	var recipes []recipe.Recipe
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		recipes = append(recipes, recipe.Recipe{Name: "recipe-" + name, Description: "About " + name})
+	}
+	m := NewRecipePickerModel(recipes, DefaultTheme(lipgloss.NewRenderer(nil)))
+	m.SetSize(80, 20)
+	for range recipes {
+		m.MoveDown()
+	}
+	out := m.View()
+	if !strings.Contains(out, "recipe-h") || strings.Contains(out, "recipe-a") {
+		t.Errorf("the last recipe should be scrolled into view:\n%s", out)
+	}
+}
+
+func TestRecipePicker(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2},
+		{ID: "bv-3", Title: "Speed up sync", Status: model.StatusOpen, Priority: 2},
+		{ID: "login-4", Title: "Audit sessions", Status: model.StatusOpen, Priority: 3},
+	}
+
+	t.Run("save current filters", func(t *testing.T) {
+		dir := t.TempDir()
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+		m = updated.(Model)
+		m.recipeLoader = recipe.NewLoader(recipe.WithUserPath(filepath.Join(dir, "none.yaml")), recipe.WithProjectDir(dir))
+		if err := m.recipeLoader.Load(); err != nil {
+			t.Fatal(err)
+		}
+		m.recipePicker.SetRecipes(m.recipeLoader.List(), m.recipeLoader.Source)
+		m, _ = pressEdit(m, runeKeys("o")...)
+		m = pressKey(m, "s") // Priority ↑
+		m = runPalette(t, m, "save current filters")
+		if !m.recipePicker.Saving() || m.focused != focusRecipePicker {
+			t.Fatal("palette should open the save prompt")
+		}
+
+		// Letters go to the name, not to the list's shortcuts
+		m, _ = pressEdit(m, runeKeys("my work")...)
+		m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyEnter})
+		if !m.statusIsError || !m.recipePicker.Saving() {
+			t.Fatalf("a name with a space should be refused, status %q", m.statusMsg)
+		}
+		m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlU})
+		m, _ = pressEdit(m, runeKeys("mine")...)
+		m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyEnter})
+		if m.statusIsError || m.showRecipePicker || !strings.Contains(m.statusMsg, "mine") {
+			t.Fatalf("save failed: %q", m.statusMsg)
+		}
+
+		saved := m.recipeLoader.Get("mine")
+		if saved == nil || m.recipeLoader.Source("mine") != "project" {
+			t.Fatalf("recipe not saved to %s", filepath.Join(dir, ".bv", "recipes.yaml"))
+		}
+		if saved.Sort.Field != "priority" || saved.Sort.Direction != "asc" || len(saved.Filters.ExcludeStatus) == 0 {
+			t.Errorf("saved recipe = %+v", saved)
+		}
+		if m.activeRecipe == nil || m.activeRecipe.Name != "mine" || m.sortMode.Field != SortDefault {
+			t.Error("the saved recipe should become the active one")
+		}
+
+		// The picker lists it, marked active and with its source
+		m = m.openRecipePicker()
+		if sel := m.recipePicker.SelectedRecipe(); sel == nil || sel.Name != "mine" {
+			t.Fatalf("picker should open on the active recipe, got %+v", sel)
+		}
+		if out := m.recipePicker.View(); !strings.Contains(out, "(project)") || !strings.Contains(out, "✓") {
+			t.Errorf("picker should show the source and active mark:\n%s", out)
+		}
+	})
+
+	t.Run("applies live", func(t *testing.T) {
+		dir := t.TempDir()
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+		m = updated.(Model)
+		m.recipeLoader = recipe.NewLoader(recipe.WithUserPath(filepath.Join(dir, "none.yaml")), recipe.WithProjectDir(dir))
+		if err := m.recipeLoader.Load(); err != nil {
+			t.Fatal(err)
+		}
+		m.recipePicker.SetRecipes(m.recipeLoader.List(), m.recipeLoader.Source)
+		m = pressKey(m, "s")
+		m = m.openRecipePicker()
+		if !m.recipePicker.Select("closed") {
+			t.Fatal("closed recipe missing")
+		}
+		m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyEnter})
+		if m.activeRecipe == nil || m.activeRecipe.Name != "closed" || m.focused != focusList {
+			t.Fatalf("enter should apply the recipe, got %+v", m.activeRecipe)
+		}
+		if m.sortMode.Field != SortDefault || listIDs(m) != "" {
+			t.Errorf("recipe should re-filter the issues with its own sort, got %q", listIDs(m))
+		}
+
+		// Sorting on top of a recipe keeps its filter
+		m.useRecipe(m.recipeLoader.Get("default"))
+		m = pressKey(m, "s")
+		m = pressKey(m, "s")
+		m = pressKey(m, "s")
+		m = pressKey(m, "s")
+		m = pressKey(m, "s") // ID ↑
+		if listIDs(m) != "bv-1,bv-2,bv-3,login-4" {
+			t.Errorf("sort over a recipe = %q", listIDs(m))
+		}
+	})
+}
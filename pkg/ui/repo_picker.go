@@ -164,7 +164,7 @@ func (m *RepoPickerModel) View() string {
 	content := strings.Join(lines, "\n")
 
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2).
 		Width(boxWidth)
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+)
+
+// Saved searches are filter bar queries bound to Alt+1 … Alt+9 under
+// "searches:" in a recipes file. The bare digits already mean something:
+// counts for the list motions (5j), board columns and detail links, so the
+// searches take the Alt variants, which no view claims. Recalling one
+// applies its query like Q would; recalling the one in force clears it.
+
+// searchSlotKey returns the number key of an alt+digit key, 0 for others
+func searchSlotKey(key string) int {
+	digit, ok := strings.CutPrefix(key, "alt+")
+	if !ok || len(digit) != 1 || digit[0] < '1' || digit[0] > '0'+recipe.MaxSearchSlot {
+		return 0
+	}
+	return int(digit[0] - '0')
+}
+
+// activeSearchSlot returns the slot of the saved search whose query is the
+// filter in force, 0 when none is. Editing the query in the filter bar
+// leaves the slot.
+func (m Model) activeSearchSlot() int {
+	r := m.queryRecipe()
+	if r == nil || m.recipeLoader == nil {
+		return 0
+	}
+	for _, slot := range m.recipeLoader.SearchSlots() {
+		if s, _ := m.recipeLoader.Search(slot); strings.TrimSpace(s.Query) == strings.TrimSpace(r.Description) {
+			return slot
+		}
+	}
+	return 0
+}
+
+// recallSearch applies the saved search bound to slot, or clears it when
+// it is already in force
+func (m Model) recallSearch(slot int) Model {
+	var s recipe.SavedSearch
+	ok := false
+	if m.recipeLoader != nil {
+		s, ok = m.recipeLoader.Search(slot)
+	}
+	if !ok {
+		m.statusMsg = fmt.Sprintf("❌ No saved search on Alt+%d (add one under searches: in .bv/recipes.yaml)", slot)
+		m.statusIsError = true
+		return m
+	}
+	if m.activeSearchSlot() == slot {
+		m.applyQuery("", recipe.FilterConfig{})
+		m.statusMsg = fmt.Sprintf("Search %d off: %s", slot, s.Label())
+		m.statusIsError = false
+		return m
+	}
+	f, err := recipe.ParseQuery(s.Query)
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Search %d: %v", slot, err)
+		m.statusIsError = true
+		return m
+	}
+	m.applyQuery(s.Query, f)
+	m.statusMsg = fmt.Sprintf("Search %d: %s (%d issues)", slot, s.Label(), len(m.list.Items()))
+	m.statusIsError = false
+	return m
+}
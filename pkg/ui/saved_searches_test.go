@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func pressAltDigit(m Model, digit rune) Model {
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{digit}, Alt: true})
+	return updated.(Model)
+}
+
+func TestSavedSearchSlots(t *testing.T) {
+	dir := t.TempDir()
+	config := `
+searches:
+  1: {name: Ann's bugs, query: "type:bug assignee:ann"}
+  2: "label:ui"
+`
+	if err := os.WriteFile(filepath.Join(dir, "recipes.yaml"), []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	issues := []model.Issue{
+		{ID: "f1", Title: "Login crash", Status: model.StatusOpen, IssueType: model.TypeBug, Assignee: "ann", Labels: []string{"api", "urgent"}},
+		{ID: "f2", Title: "Sync drops rows", Status: model.StatusInProgress, IssueType: model.TypeBug, Assignee: "bob", Labels: []string{"api"}},
+		{ID: "f3", Title: "Dark mode", Status: model.StatusOpen, IssueType: model.TypeFeature, Assignee: "ann", Labels: []string{"ui"}},
+		{ID: "f4", Title: "Old api bug", Status: model.StatusClosed, IssueType: model.TypeBug, Assignee: "ann", Labels: []string{"api"}},
+		{ID: "f5", Title: "Tidy up", Status: model.StatusOpen, IssueType: model.TypeChore, Labels: []string{"api", "needs review"}},
+	}
+	m := NewModel(issues, nil, "")
+	m.recipeLoader = recipe.NewLoader(recipe.WithUserPath(filepath.Join(dir, "recipes.yaml")), recipe.WithProjectDir(""))
+	if err := m.recipeLoader.Load(); err != nil {
+		t.Fatal(err)
+	}
+	m.showStatusBar = true
+	m.width = 120
+
+	m = pressAltDigit(m, '1')
+	if got := len(m.list.Items()); got != 2 {
+		t.Errorf("search 1 should show ann's two bugs, got %d", got)
+	}
+	if m.activeSearchSlot() != 1 || !strings.Contains(m.renderStatusBar(), "search 1 Ann's bugs") {
+		t.Errorf("status bar should name the active slot: %q", m.renderStatusBar())
+	}
+
+	// Another slot replaces it; the active one again clears it
+	m = pressAltDigit(m, '2')
+	if got := len(m.list.Items()); got != 1 || m.activeSearchSlot() != 2 {
+		t.Errorf("search 2 should show the ui issue, got %d (slot %d)", got, m.activeSearchSlot())
+	}
+	m = pressAltDigit(m, '2')
+	if m.queryRecipe() != nil || len(m.list.Items()) != len(issues) {
+		t.Errorf("recalling the active search should clear it, query %v", m.queryRecipe())
+	}
+
+	m = pressAltDigit(m, '7')
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "Alt+7") {
+		t.Errorf("an empty slot should say so, status %q", m.statusMsg)
+	}
+
+	// Bare digits are still counts
+	m = pressKey(m, "2")
+	if m.motionCount != 2 || m.queryRecipe() != nil {
+		t.Errorf("2 should start a count, count %d", m.motionCount)
+	}
+}
+
+func TestPlainDigitsSkipSavedSearches(t *testing.T) {
+	dir := t.TempDir()
+	config := "searches:\n  1: \"label:api\"\n  2: \"label:ui\"\n"
+	if err := os.WriteFile(filepath.Join(dir, "recipes.yaml"), []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Login", Status: model.StatusOpen, Labels: []string{"api"},
+			Dependencies: []*model.Dependency{{IssueID: "bv-1", DependsOnID: "bv-2", Type: model.DepBlocks}}},
+		{ID: "bv-2", Title: "Session store", Status: model.StatusInProgress, Labels: []string{"api"}},
+		{ID: "bv-3", Title: "Dark mode", Status: model.StatusOpen, Labels: []string{"ui"}},
+	}
+	newModel := func() Model {
+		m := NewModel(issues, nil, "")
+		m.recipeLoader = recipe.NewLoader(recipe.WithUserPath(filepath.Join(dir, "recipes.yaml")), recipe.WithProjectDir(""))
+		if err := m.recipeLoader.Load(); err != nil {
+			t.Fatal(err)
+		}
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+		return updated.(Model)
+	}
+
+	t.Run("list counts", func(t *testing.T) {
+		m := pressKey(newModel(), "2")
+		if m = pressKey(m, "j"); m.list.Index() != 2 {
+			t.Errorf("2j should move two rows, at %d", m.list.Index())
+		}
+		if m.queryRecipe() != nil {
+			t.Errorf("a plain digit shouldn't recall a search")
+		}
+	})
+
+	t.Run("board columns", func(t *testing.T) {
+		m := pressKey(newModel(), "b")
+		if m = pressKey(m, "2"); m.board.focusedCol != 1 {
+			t.Errorf("2 on the board should jump to the second column, at %d", m.board.focusedCol)
+		}
+		if m.queryRecipe() != nil || len(m.list.Items()) != len(issues) {
+			t.Errorf("a plain digit shouldn't recall a search")
+		}
+	})
+
+	t.Run("detail links", func(t *testing.T) {
+		m := newModel()
+		m.selectInList("bv-1")
+		m = pressKey(m, "enter")
+		if m = pressKey(m, "1"); m.selectedIssueID() != "bv-2" {
+			t.Errorf("1 in the detail view should follow bv-1's dependency, shows %s", m.selectedIssueID())
+		}
+		if m.queryRecipe() != nil {
+			t.Errorf("a plain digit shouldn't recall a search")
+		}
+	})
+}
@@ -17,19 +17,6 @@ type ShortcutsSidebar struct {
 	context      string // Current context for filtering shortcuts
 }
 
-// shortcutItem represents a single keyboard shortcut
-type shortcutItem struct {
-	key  string
-	desc string
-}
-
-// shortcutSection groups shortcuts by category
-type shortcutSection struct {
-	title    string
-	items    []shortcutItem
-	contexts []string // Which contexts this section applies to (empty = all)
-}
-
 // NewShortcutsSidebar creates a new shortcuts sidebar
 func NewShortcutsSidebar(theme Theme) ShortcutsSidebar {
 	return ShortcutsSidebar{
@@ -85,110 +72,6 @@ func (s *ShortcutsSidebar) Width() int {
 	return s.width
 }
 
-// allSections returns all shortcut sections with their contexts
-func (s *ShortcutsSidebar) allSections() []shortcutSection {
-	return []shortcutSection{
-		{
-			title:    "Navigation",
-			contexts: []string{}, // All contexts
-			items: []shortcutItem{
-				{"j/k", "Move ↓/↑"},
-				{"G/gg", "End/Start"},
-				{"^d/^u", "Page ↓/↑"},
-				{"Enter", "Details"},
-				{"Esc", "Back"},
-			},
-		},
-		{
-			title:    "Views",
-			contexts: []string{"list", "detail", "split"},
-			items: []shortcutItem{
-				{"a", "Actionable"},
-				{"b", "Board"},
-				{"g", "Graph"},
-				{"h", "History"},
-				{"i", "Insights"},
-				{"?", "Help"},
-				{";", "This sidebar"},
-				{"p", "Priority hints"},
-			},
-		},
-		{
-			title:    "Graph",
-			contexts: []string{"graph"},
-			items: []shortcutItem{
-				{"hjkl", "Navigate"},
-				{"H/L", "Scroll ←/→"},
-				{"PgUp/Dn", "Scroll ↑/↓"},
-				{"Enter", "Jump to issue"},
-			},
-		},
-		{
-			title:    "Insights",
-			contexts: []string{"insights"},
-			items: []shortcutItem{
-				{"h/l", "Switch panel"},
-				{"j/k", "Select item"},
-				{"^j/^k", "Scroll detail"},
-				{"e", "Explanations"},
-				{"x", "Calc proof"},
-				{"m", "Heatmap"},
-				{"Enter", "Jump to issue"},
-			},
-		},
-		{
-			title:    "History",
-			contexts: []string{"history"},
-			items: []shortcutItem{
-				{"v", "Git/Bead mode"},
-				{"/", "Search"},
-				{"j/k", "Navigate ↓/↑"},
-				{"J/K", "Detail ↓/↑"},
-				{"Tab", "Focus toggle"},
-				{"y", "Copy SHA"},
-				{"o", "Open in browser"},
-				{"g", "Graph view"},
-				{"c", "Cycle filter"},
-			},
-		},
-		{
-			title:    "Board",
-			contexts: []string{"board"},
-			items: []shortcutItem{
-				{"h/l", "Columns ←/→"},
-				{"j/k", "Items ↓/↑"},
-				{"Tab", "Toggle detail"},
-				{"^j/^k", "Scroll detail"},
-				{"Enter", "Full view"},
-			},
-		},
-		{
-			title:    "Filters",
-			contexts: []string{"list", "split"},
-			items: []shortcutItem{
-				{"o", "Open only"},
-				{"c", "Closed only"},
-				{"r", "Ready (no blocks)"},
-				{"l", "Label picker"},
-				{"/", "Search"},
-			},
-		},
-		{
-			title:    "Actions",
-			contexts: []string{"list", "detail", "split"},
-			items: []shortcutItem{
-				{"t/T", "Time-travel"},
-				{"x", "Export .md"},
-				{"C", "Copy"},
-				{"O", "Open in $EDITOR"},
-				{"'", "Recipe picker"},
-				{"U", "Self-update"},
-				{"V", "Cass sessions"},
-			},
-		},
-	}
-}
-
 // View renders the sidebar
 func (s *ShortcutsSidebar) View() string {
 	t := s.theme
@@ -208,7 +91,7 @@ func (s *ShortcutsSidebar) View() string {
 	keyStyle := t.Renderer.NewStyle().
 		Foreground(lipgloss.AdaptiveColor{Light: "#7D56F4", Dark: "#BD93F9"}).
 		Bold(true).
-		Width(8)
+		Width(10)
 
 	descStyle := t.Renderer.NewStyle().
 		Foreground(t.Base.GetForeground())
@@ -223,28 +106,13 @@ func (s *ShortcutsSidebar) View() string {
 	sb.WriteString(titleStyle.Render("Shortcuts"))
 	sb.WriteString("\n")
 
-	// Filter sections by context
-	sections := s.allSections()
-	for _, section := range sections {
-		// Check if this section applies to current context
-		if len(section.contexts) > 0 {
-			found := false
-			for _, ctx := range section.contexts {
-				if ctx == s.context {
-					found = true
-					break
-				}
-			}
-			if !found {
-				continue
-			}
-		}
-
-		sb.WriteString(sectionStyle.Render(section.title))
+	// The keymap groups for this context, as the help overlay shows them
+	for _, group := range keymapFor(Context(s.context), false) {
+		sb.WriteString(sectionStyle.Render(group.title))
 		sb.WriteString("\n")
 
-		for _, item := range section.items {
-			line := keyStyle.Render(item.key) + descStyle.Render(item.desc)
+		for _, b := range group.bindings {
+			line := keyStyle.Render(b.label) + descStyle.Render(b.desc)
 			sb.WriteString(line + "\n")
 		}
 	}
@@ -295,7 +163,7 @@ func (s *ShortcutsSidebar) View() string {
 
 	// Create the sidebar box
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Secondary).
 		Padding(0, 1).
 		Width(s.width).
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
@@ -224,6 +225,9 @@ type GraphLayout struct {
 	RankCriticalPath map[string]int
 	RankInDegree     map[string]int
 	RankOutDegree    map[string]int
+
+	// CriticalPath is the longest chain of open blocking dependencies
+	CriticalPath analysis.CriticalPathResult
 }
 
 // BoardState contains precomputed Kanban columns for each swimlane mode.
@@ -663,6 +667,9 @@ func issueMatchesRecipe(issue model.Issue, issueMap map[string]*model.Issue, r *
 			return false
 		}
 	}
+	if !r.Filters.InPriorityRange(issue.Priority) {
+		return false
+	}
 
 	// Tags filter (must have ALL specified tags)
 	if len(r.Filters.Tags) > 0 {
@@ -680,16 +687,102 @@ func issueMatchesRecipe(issue model.Issue, issueMap map[string]*model.Issue, r *
 		}
 	}
 
-	// Actionable filter (true = no open blockers)
-	if r.Filters.Actionable != nil && *r.Filters.Actionable {
+	// Status and tag exclusions
+	for _, s := range r.Filters.ExcludeStatus {
+		if matchesRecipeStatus(issue.Status, s) {
+			return false
+		}
+	}
+	for _, excluded := range r.Filters.ExcludeTags {
+		for _, label := range issue.Labels {
+			if label == excluded {
+				return false
+			}
+		}
+	}
+	if r.Filters.Labels != "" && !recipe.MatchLabelExpr(r.Filters.Labels, issue.Labels) {
+		return false
+	}
+
+	// Assignee filter (any of)
+	if len(r.Filters.Assignee) > 0 && !recipe.MatchAssignee(issue.Assignee, r.Filters.Assignee) {
+		return false
+	}
+	if recipe.MatchAssignee(issue.Assignee, r.Filters.ExcludeAssignee) {
+		return false
+	}
+
+	// Type filter (any of)
+	if len(r.Filters.Types) > 0 {
+		typed := false
+		for _, t := range r.Filters.Types {
+			if strings.EqualFold(string(issue.IssueType), t) {
+				typed = true
+				break
+			}
+		}
+		if !typed {
+			return false
+		}
+	}
+	for _, t := range r.Filters.ExcludeTypes {
+		if strings.EqualFold(string(issue.IssueType), t) {
+			return false
+		}
+	}
+
+	// Date filters; unparseable bounds and unset timestamps don't filter
+	now := time.Now()
+	for _, bound := range []struct {
+		value string
+		at    time.Time
+		after bool
+	}{
+		{r.Filters.CreatedAfter, issue.CreatedAt, true},
+		{r.Filters.CreatedBefore, issue.CreatedAt, false},
+		{r.Filters.UpdatedAfter, issue.UpdatedAt, true},
+		{r.Filters.UpdatedBefore, issue.UpdatedAt, false},
+	} {
+		if bound.value == "" || bound.at.IsZero() {
+			continue
+		}
+		threshold, err := recipe.ParseRelativeTime(bound.value, now)
+		if err != nil {
+			continue
+		}
+		if bound.after && bound.at.Before(threshold) || !bound.after && bound.at.After(threshold) {
+			return false
+		}
+	}
+
+	if r.Filters.TitleContains != "" && !strings.Contains(strings.ToLower(issue.Title), strings.ToLower(r.Filters.TitleContains)) {
+		return false
+	}
+	if r.Filters.Query != "" && !recipe.MatchText(issue, r.Filters.Query) {
+		return false
+	}
+	if r.Filters.IDPrefix != "" && !strings.HasPrefix(issue.ID, r.Filters.IDPrefix) {
+		return false
+	}
+
+	// Actionable (true = no open blockers) and HasBlockers filters
+	if (r.Filters.Actionable != nil && *r.Filters.Actionable) || r.Filters.HasBlockers != nil {
+		blocked := false
 		for _, dep := range issue.Dependencies {
 			if dep == nil || !dep.Type.IsBlocking() {
 				continue
 			}
 			if blocker, exists := issueMap[dep.DependsOnID]; exists && !isClosedLikeStatus(blocker.Status) {
-				return false
+				blocked = true
+				break
 			}
 		}
+		if r.Filters.Actionable != nil && *r.Filters.Actionable && blocked {
+			return false
+		}
+		if r.Filters.HasBlockers != nil && *r.Filters.HasBlockers != blocked {
+			return false
+		}
 	}
 
 	return true
@@ -702,6 +795,13 @@ func sortIssuesByRecipe(issues []model.Issue, stats *analysis.GraphStats, r *rec
 
 	desc := r.Sort.Direction == "desc"
 	field := r.Sort.Field
+	var staleness, risk map[string]float64
+	switch field {
+	case "staleness":
+		staleness = analysis.StalenessScores(issues, time.Now())
+	case "risk":
+		risk = analysis.RiskScoreMap(analysis.RiskScores(issues, time.Now(), analysis.RecipeRiskScoreWeights(r)))
+	}
 
 	sort.Slice(issues, func(i, j int) bool {
 		ii := issues[i]
@@ -766,6 +866,22 @@ func sortIssuesByRecipe(issues []model.Issue, stats *analysis.GraphStats, r *rec
 			case iScore > jScore:
 				cmp = 1
 			}
+		case "staleness":
+			switch {
+			case staleness[ii.ID] < staleness[jj.ID]:
+				cmp = -1
+			case staleness[ii.ID] > staleness[jj.ID]:
+				cmp = 1
+			}
+		case "risk":
+			switch {
+			case risk[ii.ID] < risk[jj.ID]:
+				cmp = -1
+			case risk[ii.ID] > risk[jj.ID]:
+				cmp = 1
+			}
+		case "id":
+			cmp = strings.Compare(ii.ID, jj.ID)
 		default:
 			switch {
 			case ii.Priority < jj.Priority:
@@ -807,8 +923,9 @@ func buildGraphLayout(issues []model.Issue, stats *analysis.GraphStats) *GraphLa
 	}
 
 	layout := &GraphLayout{
-		Blockers:   blockers,
-		Dependents: dependents,
+		Blockers:     blockers,
+		Dependents:   dependents,
+		CriticalPath: analysis.ComputeCriticalPath(issues),
 	}
 
 	if stats != nil {
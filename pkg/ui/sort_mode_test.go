@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSortMode(t *testing.T) {
+	base := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Oldest", Status: model.StatusOpen, Priority: 2, CreatedAt: base, UpdatedAt: base.AddDate(0, 0, 9)},
+		{ID: "bv-2", Title: "Urgent", Status: model.StatusOpen, Priority: 0, CreatedAt: base.AddDate(0, 0, 1), UpdatedAt: base.AddDate(0, 0, 2)},
+		{ID: "bv-3", Title: "Newest", Status: model.StatusOpen, Priority: 3, CreatedAt: base.AddDate(0, 0, 5), UpdatedAt: base.AddDate(0, 0, 3)},
+		{ID: "bv-4", Title: "Tied", Status: model.StatusOpen, Priority: 2, CreatedAt: base.AddDate(0, 0, 2), UpdatedAt: base.AddDate(0, 0, 1)},
+	}
+
+	t.Run("cycles fields and direction", func(t *testing.T) {
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+		m = updated.(Model)
+
+		steps := []struct {
+			key, mode, ids string
+		}{
+			{"s", "Priority ↑", "bv-2,bv-1,bv-4,bv-3"},
+			{"I", "Priority ↓", "bv-3,bv-1,bv-4,bv-2"},
+			{"s", "Updated ↓", "bv-1,bv-3,bv-2,bv-4"},
+			{"s", "PageRank ↓", ""},
+			{"s", "Created ↓", "bv-3,bv-4,bv-2,bv-1"},
+			{"I", "Created ↑", "bv-1,bv-2,bv-4,bv-3"},
+			{"s", "ID ↑", "bv-1,bv-2,bv-3,bv-4"},
+			{"s", "Default", "bv-2,bv-4,bv-1,bv-3"},
+		}
+		for _, step := range steps {
+			m = pressKey(m, step.key)
+			if got := m.sortMode.String(); got != step.mode {
+				t.Fatalf("after %q sort = %q, want %q", step.key, got, step.mode)
+			}
+			if step.ids != "" && listIDs(m) != step.ids {
+				t.Errorf("%s order = %s, want %s", step.mode, listIDs(m), step.ids)
+			}
+			if m.statusMsg != "Sort: "+step.mode {
+				t.Errorf("status = %q", m.statusMsg)
+			}
+		}
+
+		// The default order has no direction to reverse
+		m = pressKey(m, "I")
+		if m.sortMode.Field != SortDefault || m.statusIsError {
+			t.Errorf("I on the default sort should leave it, got %q", m.sortMode)
+		}
+	})
+
+	t.Run("applies to actionable view", func(t *testing.T) {
+		// bv-5 waits on the others, which puts them in one track
+		issues := append(issues, model.Issue{ID: "bv-5", Title: "Release", Status: model.StatusOpen, Priority: 1,
+			Dependencies: []*model.Dependency{
+				{IssueID: "bv-5", DependsOnID: "bv-1", Type: model.DepBlocks},
+				{IssueID: "bv-5", DependsOnID: "bv-3", Type: model.DepBlocks},
+				{IssueID: "bv-5", DependsOnID: "bv-4", Type: model.DepBlocks},
+			}})
+		m := NewModel(issues, nil, "")
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+		m = updated.(Model)
+		m = pressKey(m, "a")
+		if !m.isActionableView {
+			t.Fatal("a should open the actionable view")
+		}
+
+		m = pressKey(m, "s")
+		m = pressKey(m, "s")
+		m = pressKey(m, "s")
+		m = pressKey(m, "s")
+		if m.sortMode.String() != "Created ↓" {
+			t.Fatalf("sort = %q", m.sortMode)
+		}
+		created := map[string]time.Time{}
+		for _, issue := range issues {
+			created[issue.ID] = issue.CreatedAt
+		}
+		sorted := false
+		for _, track := range m.actionableView.plan.Tracks {
+			sorted = sorted || len(track.Items) > 1
+			for i := 1; i < len(track.Items); i++ {
+				if created[track.Items[i].ID].After(created[track.Items[i-1].ID]) {
+					t.Errorf("track %s not newest first: %s before %s", track.TrackID, track.Items[i-1].ID, track.Items[i].ID)
+				}
+			}
+		}
+		if !sorted {
+			t.Errorf("expected a track with several items, got %+v", m.actionableView.plan.Tracks)
+		}
+	})
+}
@@ -236,7 +236,7 @@ func (m Model) renderSprintDashboard() string {
 
 	// Wrap in a box
 	boxStyle := t.Renderer.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(t.Primary).
 		Padding(1, 2).
 		Width(min(80, m.width-4)).
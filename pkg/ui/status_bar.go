@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// statusBarStatuses is the order the status bar counts issues in. Statuses
+// with no issues are left out.
+var statusBarStatuses = []model.Status{
+	model.StatusOpen,
+	model.StatusInProgress,
+	model.StatusBlocked,
+	model.StatusReview,
+	model.StatusHooked,
+	model.StatusPinned,
+	model.StatusDeferred,
+	model.StatusClosed,
+}
+
+// statusBarRows is how many screen rows the status bar takes. The rest of
+// the UI lays itself out in m.height, which excludes them.
+func (m Model) statusBarRows() int {
+	if m.showStatusBar {
+		return 1
+	}
+	return 0
+}
+
+// toggleStatusBar shows or hides the status bar and relays out the views
+// for the rows it frees or takes
+func (m Model) toggleStatusBar() (Model, tea.Cmd) {
+	screen := m.height + m.statusBarRows()
+	m.showStatusBar = !m.showStatusBar
+	updated, cmd := m.Update(tea.WindowSizeMsg{Width: m.width, Height: screen})
+	return updated.(Model), cmd
+}
+
+// statusBarCounts summarizes the loaded issues by status, e.g.
+// "3 open · 1 in progress · 5 closed"
+func (m Model) statusBarCounts() string {
+	var parts []string
+	for _, s := range statusBarStatuses {
+		if n := m.statusCounts[s]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, strings.ReplaceAll(string(s), "_", " ")))
+		}
+	}
+	if len(parts) == 0 {
+		return "no issues"
+	}
+	return strings.Join(parts, " · ")
+}
+
+// countStatuses counts issues by status for the status bar
+func countStatuses(issues []model.Issue) map[model.Status]int {
+	counts := make(map[model.Status]int, len(statusBarStatuses))
+	for i := range issues {
+		counts[issues[i].Status]++
+	}
+	return counts
+}
+
+// statusBarFilter names the recipe or filter deciding which issues are shown
+func (m Model) statusBarFilter() string {
+	switch {
+	case m.activeSearchSlot() > 0:
+		slot := m.activeSearchSlot()
+		s, _ := m.recipeLoader.Search(slot)
+		return fmt.Sprintf("search %d %s", slot, truncateRunesHelper(s.Label(), 30, "…"))
+	case m.queryRecipe() != nil:
+		return "query " + truncateRunesHelper(m.queryRecipe().Description, 30, "…")
+	case strings.HasPrefix(m.currentFilter, "recipe:"):
+		return "recipe " + strings.TrimPrefix(m.currentFilter, "recipe:")
+	case m.currentFilter == "":
+		return "filter all"
+	}
+	return "filter " + m.currentFilter
+}
+
+// renderStatusBar renders the bottom line shown under every view: dataset,
+// counts by status, active recipe or filter, sort, the open-issue trend,
+// time since the last load, and Phase 2 progress while metrics compute. Unlike the footer it stays put while status messages are shown.
+func (m Model) renderStatusBar() string {
+	source := m.dataSourceLabel()
+	if runes := []rune(source); len(runes) > 30 {
+		source = "…" + string(runes[len(runes)-29:])
+	}
+	sections := []string{
+		"⛁ " + source,
+		m.statusBarCounts(),
+		m.statusBarFilter(),
+		"sort " + m.sortMode.String(),
+	}
+	if trend := m.statusBarTrend(); trend != "" {
+		sections = append(sections, trend)
+	}
+	if loaded := m.loadedAt(); !loaded.IsZero() {
+		sections = append(sections, "⟳ "+formatDataAge(time.Since(loaded))+" ago")
+	}
+	if progress := m.analysisProgressLabel(); progress != "" {
+		sections = append(sections, progress)
+	}
+
+	text := strings.Join(sections, " │ ")
+	if m.width > 2 {
+		text = truncateRunesHelper(text, m.width-2, "…")
+	}
+	return lipgloss.NewStyle().
+		Background(ColorBgHighlight).
+		Foreground(ColorMuted).
+		Width(m.width).
+		MaxWidth(m.width).
+		Padding(0, 1).
+		Render(text)
+}
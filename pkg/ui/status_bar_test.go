@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func lastLine(s string) string {
+	lines := strings.Split(s, "\n")
+	return lines[len(lines)-1]
+}
+
+func TestStatusBarShownUnderEveryView(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Oldest", Status: model.StatusOpen, Priority: 2},
+		{ID: "bv-2", Title: "Urgent", Status: model.StatusInProgress, Priority: 0},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 60})
+	m = updated.(Model)
+	if m.height != 59 {
+		t.Fatalf("views should lay out above the status bar, height = %d", m.height)
+	}
+
+	out := m.View()
+	if n := strings.Count(out, "\n") + 1; n != 60 {
+		t.Errorf("view is %d lines, want the full 60", n)
+	}
+	bar := lastLine(out)
+	for _, want := range []string{"in-memory", "open", "1 in progress", "filter all", "sort Default", "ago"} {
+		if !strings.Contains(bar, want) {
+			t.Errorf("status bar %q missing %q", bar, want)
+		}
+	}
+
+	m = pressKey(m, "s")
+	m.statusMsg = "Copied"
+	if bar := lastLine(m.View()); !strings.Contains(bar, "sort Priority") {
+		t.Errorf("status bar should follow the sort and outlast status messages: %q", bar)
+	}
+
+	for _, key := range []string{"b", "i", "E"} {
+		v := pressKey(m, key)
+		if bar := lastLine(v.View()); !strings.Contains(bar, "1 in progress") {
+			t.Errorf("status bar missing in %s: %q", v.CurrentContext(), bar)
+		}
+	}
+}
+
+func TestStatusBarFollowsRecipeAndToggles(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "1", Title: "x", Status: model.StatusOpen}}, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 60})
+	m = updated.(Model)
+	m.currentFilter = "recipe:triage"
+	if got := m.statusBarFilter(); got != "recipe triage" {
+		t.Errorf("filter label = %q", got)
+	}
+
+	m = runPalette(t, m, "show/hide status bar")
+	if m.showStatusBar || m.height != 60 {
+		t.Fatalf("hiding the bar should give its row back, height = %d", m.height)
+	}
+	if strings.Contains(m.View(), "recipe triage") {
+		t.Error("hidden status bar still rendered")
+	}
+	m = runPalette(t, m, "show/hide status bar")
+	if !m.showStatusBar || m.height != 59 {
+		t.Errorf("showing the bar again should take a row, height = %d", m.height)
+	}
+}
@@ -202,7 +202,7 @@ func RenderMiniBar(value float64, width int, t Theme) string {
 		barColor = t.Secondary // Muted
 	}
 
-	bar := strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+	bar := strings.Repeat(glyph("█", "#"), filled) + strings.Repeat(glyph("░", "-"), width-filled)
 	return t.Renderer.NewStyle().Foreground(barColor).Render(bar)
 }
 
@@ -241,7 +241,7 @@ func RenderDivider(width int) string {
 	}
 	return lipgloss.NewStyle().
 		Foreground(ColorBgHighlight).
-		Render(strings.Repeat("─", width))
+		Render(strings.Repeat(glyph("─", "-"), width))
 }
 
 // RenderSubtleDivider renders a more subtle divider using dots
@@ -251,5 +251,5 @@ func RenderSubtleDivider(width int) string {
 	}
 	return lipgloss.NewStyle().
 		Foreground(ColorMuted).
-		Render(strings.Repeat("·", width))
+		Render(strings.Repeat(glyph("·", "."), width))
 }
@@ -85,11 +85,18 @@ func DefaultTheme(r *lipgloss.Renderer) Theme {
 		Muted:     lipgloss.AdaptiveColor{Light: "#555555", Dark: "#6272A4"}, // Dimmed text (was #888888, now ~7:1)
 	}
 
+	return t.withStyles()
+}
+
+// withStyles derives the theme's styles from its colors, so a theme whose
+// colors were replaced (see ThemeSpec.Apply) gets matching styles
+func (t Theme) withStyles() Theme {
+	r := t.Renderer
 	t.Base = r.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#000000", Dark: "#F8F8F2"})
 
 	t.Selected = r.NewStyle().
 		Background(t.Highlight).
-		Border(lipgloss.ThickBorder(), false, false, false, true).
+		Border(termBorder(lipgloss.ThickBorder()), false, false, false, true).
 		BorderForeground(t.Primary).
 		PaddingLeft(1).
 		Bold(true)
@@ -107,11 +114,11 @@ func DefaultTheme(r *lipgloss.Renderer) Theme {
 	t.InfoBold = r.NewStyle().Foreground(ColorInfo).Bold(true)
 	t.SecondaryText = r.NewStyle().Foreground(t.Secondary)
 	t.PrimaryBold = r.NewStyle().Foreground(t.Primary).Bold(true)
-	t.PriorityUpArrow = r.NewStyle().Foreground(lipgloss.Color("#FF6B6B")).Bold(true)
-	t.PriorityDownArrow = r.NewStyle().Foreground(lipgloss.Color("#4ECDC4")).Bold(true)
-	t.TriageStar = r.NewStyle().Foreground(lipgloss.Color("#FFD700"))
-	t.TriageUnblocks = r.NewStyle().Foreground(lipgloss.Color("#50FA7B"))
-	t.TriageUnblocksAlt = r.NewStyle().Foreground(lipgloss.Color("#6272A4"))
+	t.PriorityUpArrow = r.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#CC0000", Dark: "#FF6B6B"}).Bold(true)
+	t.PriorityDownArrow = r.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#00796B", Dark: "#4ECDC4"}).Bold(true)
+	t.TriageStar = r.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#B8860B", Dark: "#FFD700"})
+	t.TriageUnblocks = r.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#007700", Dark: "#50FA7B"})
+	t.TriageUnblocksAlt = r.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#555555", Dark: "#6272A4"})
 
 	return t
 }
@@ -134,19 +141,19 @@ func (t Theme) GetStatusColor(s string) lipgloss.AdaptiveColor {
 func (t Theme) GetTypeIcon(typ string) (string, lipgloss.AdaptiveColor) {
 	switch typ {
 	case "bug":
-		return "🐛", t.Bug
+		return glyph("🐛", "B "), t.Bug
 	case "feature":
-		return "✨", t.Feature
+		return glyph("✨", "F "), t.Feature
 	case "task":
-		return "📋", t.Task
+		return glyph("📋", "T "), t.Task
 	case "epic":
 		// Use 🚀 instead of 🏔️ - the snow-capped mountain has a variation selector
 		// (U+FE0F) that causes inconsistent width calculations across terminals
-		return "🚀", t.Epic
+		return glyph("🚀", "E "), t.Epic
 	case "chore":
-		return "🧹", t.Chore
+		return glyph("🧹", "C "), t.Chore
 	default:
-		return "•", t.Subtext
+		return glyph("•", "-"), t.Subtext
 	}
 }
 
@@ -0,0 +1,477 @@
+package ui
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultThemeName is the built-in theme that follows the terminal's
+// background; the built-in light and dark themes pin it instead
+const DefaultThemeName = "default"
+
+var builtinThemes = []ThemeSpec{
+	{Name: DefaultThemeName, Source: "built-in"},
+	{Name: "light", Source: "built-in", Background: BackgroundLight},
+	{Name: "dark", Source: "built-in", Background: BackgroundDark},
+}
+
+// basicPalette recolors the default theme in the 16 ANSI colors for
+// terminals that show no more, keeping statuses and types apart where
+// matching each hex color to its nearest ANSI color would merge them.
+// Themes are applied on top of it.
+var basicPalette = ThemeSpec{
+	Primary:   &ThemeColor{Light: "5", Dark: "13"},
+	Secondary: &ThemeColor{Light: "8", Dark: "8"},
+	Subtext:   &ThemeColor{Light: "8", Dark: "7"},
+	Highlight: &ThemeColor{Light: "7", Dark: "8"},
+	Border:    &ThemeColor{Light: "8", Dark: "8"},
+	Muted:     &ThemeColor{Light: "8", Dark: "8"},
+	Status: map[string]ThemeColor{
+		"open":        {Light: "2", Dark: "10"},
+		"in_progress": {Light: "6", Dark: "14"},
+		"blocked":     {Light: "1", Dark: "9"},
+		"deferred":    {Light: "3", Dark: "11"},
+		"pinned":      {Light: "4", Dark: "12"},
+		"hooked":      {Light: "6", Dark: "6"},
+		"closed":      {Light: "8", Dark: "8"},
+		"tombstone":   {Light: "8", Dark: "8"},
+	},
+	Types: map[string]ThemeColor{
+		"bug":     {Light: "1", Dark: "9"},
+		"feature": {Light: "3", Dark: "11"},
+		"task":    {Light: "4", Dark: "12"},
+		"epic":    {Light: "5", Dark: "13"},
+		"chore":   {Light: "6", Dark: "14"},
+	},
+}
+
+// baseTheme is the default theme themes are applied to, in the 16-color
+// palette when the terminal shows no more
+func baseTheme(r *lipgloss.Renderer) Theme {
+	t := DefaultTheme(r)
+	if TermCapabilities().Color == ColorBasic {
+		t = basicPalette.Apply(t)
+	}
+	return t
+}
+
+// ThemeColor is a color from a theme file. It is written either as one
+// color used on dark and light backgrounds alike ("#88C0D0", or an ANSI
+// number such as "33") or as a {light, dark} pair.
+type ThemeColor struct {
+	Light string
+	Dark  string
+}
+
+var hexColorRe = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// UnmarshalYAML accepts a single color or a {light, dark} mapping; a pair
+// with one side missing uses the other for both
+func (c *ThemeColor) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		c.Light, c.Dark = node.Value, node.Value
+	case yaml.MappingNode:
+		var pair struct {
+			Light string `yaml:"light"`
+			Dark  string `yaml:"dark"`
+		}
+		if err := node.Decode(&pair); err != nil {
+			return err
+		}
+		c.Light, c.Dark = pair.Light, pair.Dark
+		if c.Light == "" {
+			c.Light = c.Dark
+		}
+		if c.Dark == "" {
+			c.Dark = c.Light
+		}
+	default:
+		return fmt.Errorf("line %d: color must be a string or {light, dark}", node.Line)
+	}
+	for _, v := range []string{c.Light, c.Dark} {
+		if !validColor(v) {
+			return fmt.Errorf("line %d: invalid color %q (want #RGB, #RRGGBB or 0-255)", node.Line, v)
+		}
+	}
+	return nil
+}
+
+func validColor(v string) bool {
+	if hexColorRe.MatchString(v) {
+		return true
+	}
+	n, err := strconv.Atoi(v)
+	return err == nil && n >= 0 && n <= 255
+}
+
+func (c ThemeColor) adaptive() lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{Light: c.Light, Dark: c.Dark}
+}
+
+// ThemeSpec is one theme from a themes file. Colors it leaves out keep
+// their value from the default theme.
+type ThemeSpec struct {
+	Name   string `yaml:"-"`
+	Source string `yaml:"-"` // "built-in", "user" or "project"
+
+	// Background pins the variant of {light, dark} colors to use; auto
+	// follows the terminal
+	Background Background `yaml:"background"`
+
+	Primary   *ThemeColor           `yaml:"primary"`
+	Secondary *ThemeColor           `yaml:"secondary"`
+	Subtext   *ThemeColor           `yaml:"subtext"`
+	Highlight *ThemeColor           `yaml:"highlight"`
+	Border    *ThemeColor           `yaml:"border"`
+	Muted     *ThemeColor           `yaml:"muted"`
+	Status    map[string]ThemeColor `yaml:"status"` // open, in_progress, blocked, ...
+	Types     map[string]ThemeColor `yaml:"types"`  // bug, feature, task, epic, chore
+}
+
+// ThemeFile is the structure of a themes YAML file. Theme names the theme
+// to start with.
+type ThemeFile struct {
+	Theme  string               `yaml:"theme"`
+	Themes map[string]ThemeSpec `yaml:"themes"`
+}
+
+// themeSlot is a color a theme can set: its Theme field and the package
+// color the shared styles in styles.go render with
+type themeSlot struct {
+	field  func(t *Theme) *lipgloss.AdaptiveColor
+	global *lipgloss.AdaptiveColor
+}
+
+var themeStatusSlots = map[string]themeSlot{
+	"open":        {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Open }, &ColorStatusOpen},
+	"in_progress": {func(t *Theme) *lipgloss.AdaptiveColor { return &t.InProgress }, &ColorStatusInProgress},
+	"blocked":     {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Blocked }, &ColorStatusBlocked},
+	"deferred":    {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Deferred }, &ColorStatusDeferred},
+	"pinned":      {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Pinned }, &ColorStatusPinned},
+	"hooked":      {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Hooked }, &ColorStatusHooked},
+	"closed":      {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Closed }, &ColorStatusClosed},
+	"tombstone":   {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Tombstone }, &ColorStatusTombstone},
+}
+
+var themeTypeSlots = map[string]themeSlot{
+	"bug":     {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Bug }, &ColorTypeBug},
+	"feature": {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Feature }, &ColorTypeFeature},
+	"task":    {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Task }, &ColorTypeTask},
+	"epic":    {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Epic }, &ColorTypeEpic},
+	"chore":   {func(t *Theme) *lipgloss.AdaptiveColor { return &t.Chore }, &ColorTypeChore},
+}
+
+// themeAssignment is a color the spec sets and the slot it goes in
+type themeAssignment struct {
+	themeSlot
+	color ThemeColor
+}
+
+// assignments lists the colors the spec sets
+func (s ThemeSpec) assignments() []themeAssignment {
+	var out []themeAssignment
+	add := func(c *ThemeColor, field func(t *Theme) *lipgloss.AdaptiveColor, global *lipgloss.AdaptiveColor) {
+		if c != nil {
+			out = append(out, themeAssignment{themeSlot{field, global}, *c})
+		}
+	}
+	add(s.Primary, func(t *Theme) *lipgloss.AdaptiveColor { return &t.Primary }, &ColorPrimary)
+	add(s.Secondary, func(t *Theme) *lipgloss.AdaptiveColor { return &t.Secondary }, &ColorSecondary)
+	add(s.Subtext, func(t *Theme) *lipgloss.AdaptiveColor { return &t.Subtext }, &ColorSubtext)
+	add(s.Highlight, func(t *Theme) *lipgloss.AdaptiveColor { return &t.Highlight }, &ColorBgHighlight)
+	add(s.Border, func(t *Theme) *lipgloss.AdaptiveColor { return &t.Border }, nil)
+	add(s.Muted, func(t *Theme) *lipgloss.AdaptiveColor { return &t.Muted }, &ColorMuted)
+	for _, group := range []struct {
+		colors map[string]ThemeColor
+		slots  map[string]themeSlot
+	}{{s.Status, themeStatusSlots}, {s.Types, themeTypeSlots}} {
+		keys := make([]string, 0, len(group.colors))
+		for k := range group.colors {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			out = append(out, themeAssignment{group.slots[k], group.colors[k]})
+		}
+	}
+	return out
+}
+
+// validate rejects status and type keys the theme can't color
+func (s ThemeSpec) validate() error {
+	for k := range s.Status {
+		if _, ok := themeStatusSlots[k]; !ok {
+			return fmt.Errorf("unknown status %q", k)
+		}
+	}
+	for k := range s.Types {
+		if _, ok := themeTypeSlots[k]; !ok {
+			return fmt.Errorf("unknown type %q", k)
+		}
+	}
+	return nil
+}
+
+// Apply returns base with the spec's colors in place of its own and the
+// styles derived from them rebuilt
+func (s ThemeSpec) Apply(base Theme) Theme {
+	for _, a := range s.assignments() {
+		*a.field(&base) = a.color.adaptive()
+	}
+	return base.withStyles()
+}
+
+// themeGlobals is every package color a theme can set, with its value
+// before any theme was applied
+var themeGlobals = func() map[*lipgloss.AdaptiveColor]lipgloss.AdaptiveColor {
+	out := map[*lipgloss.AdaptiveColor]lipgloss.AdaptiveColor{}
+	for _, p := range []*lipgloss.AdaptiveColor{&ColorPrimary, &ColorSecondary, &ColorSubtext, &ColorBgHighlight, &ColorMuted} {
+		out[p] = *p
+	}
+	for _, group := range []map[string]themeSlot{themeStatusSlots, themeTypeSlots} {
+		for _, slot := range group {
+			out[slot.global] = *slot.global
+		}
+	}
+	return out
+}()
+
+// applyThemeGlobals points the package colors, and the panel styles built
+// from them, at the spec's colors. Colors it leaves out return to their
+// defaults, so switching back to the default theme restores everything.
+func applyThemeGlobals(s ThemeSpec) {
+	for p, v := range themeGlobals {
+		*p = v
+	}
+	assignments := s.assignments()
+	if TermCapabilities().Color == ColorBasic {
+		assignments = append(basicPalette.assignments(), assignments...)
+	}
+	for _, a := range assignments {
+		if a.global != nil {
+			*a.global = a.color.adaptive()
+		}
+	}
+	PanelStyle = PanelStyle.BorderForeground(ColorBgHighlight)
+	FocusedPanelStyle = FocusedPanelStyle.BorderForeground(ColorPrimary)
+}
+
+// ThemesPath returns the path to the user's themes file
+func ThemesPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "bv", "themes.yaml")
+}
+
+// ThemeSet is the themes found in the user and project themes files
+type ThemeSet struct {
+	Themes  []ThemeSpec // Sorted by name, without the built-in ones
+	Start   string      // Theme to start with, "" for the default
+	Warning string      // Why a file was skipped, if one was
+
+	userPath, projectPath string // Where the themes were read from, for Reload
+}
+
+// All returns the built-in themes followed by the user-defined ones
+func (ts ThemeSet) All() []ThemeSpec {
+	return append(append([]ThemeSpec(nil), builtinThemes...), ts.Themes...)
+}
+
+// Get returns the theme with the given name; "" is the default theme
+func (ts ThemeSet) Get(name string) (ThemeSpec, bool) {
+	if name == "" {
+		name = DefaultThemeName
+	}
+	for _, s := range ts.All() {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return ThemeSpec{}, false
+}
+
+// LoadThemes reads ~/.config/bv/themes.yaml and then .bv/themes.yaml in
+// the working directory; project themes replace user themes of the same
+// name. Missing files are fine; a file that can't be parsed is skipped
+// with a warning. BV_THEME overrides the theme the files start with.
+func LoadThemes() ThemeSet {
+	projectPath := ""
+	if wd, err := os.Getwd(); err == nil {
+		projectPath = filepath.Join(wd, ".bv", "themes.yaml")
+	}
+	return loadThemeFiles(ThemesPath(), projectPath).startWith(os.Getenv("BV_THEME"))
+}
+
+// Reload reads the themes again from the files they came from
+func (ts ThemeSet) Reload() ThemeSet {
+	return loadThemeFiles(ts.userPath, ts.projectPath)
+}
+
+// startWith makes name, when set, the theme to start with
+func (ts ThemeSet) startWith(name string) ThemeSet {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ts
+	}
+	if _, ok := ts.Get(name); !ok {
+		ts.Warning = joinWarnings(ts.Warning, fmt.Sprintf("theme %q not found", name))
+		return ts
+	}
+	ts.Start = name
+	if name == DefaultThemeName {
+		ts.Start = ""
+	}
+	return ts
+}
+
+func joinWarnings(a, b string) string {
+	if a == "" {
+		return b
+	}
+	return a + "; " + b
+}
+
+func loadThemeFiles(userPath, projectPath string) ThemeSet {
+	set := ThemeSet{userPath: userPath, projectPath: projectPath}
+	byName := map[string]ThemeSpec{}
+	var warnings []string
+	var start string
+	for _, src := range []struct{ path, source string }{{userPath, "user"}, {projectPath, "project"}} {
+		if src.path == "" {
+			continue
+		}
+		file, err := readThemeFile(src.path)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				warnings = append(warnings, fmt.Sprintf("%s themes: %v", src.source, err))
+			}
+			continue
+		}
+		for name, spec := range file.Themes {
+			spec.Name, spec.Source = name, src.source
+			byName[name] = spec
+		}
+		if file.Theme != "" {
+			start = file.Theme
+		}
+	}
+	for _, spec := range byName {
+		set.Themes = append(set.Themes, spec)
+	}
+	sort.Slice(set.Themes, func(i, j int) bool { return set.Themes[i].Name < set.Themes[j].Name })
+	set.Warning = strings.Join(warnings, "; ")
+	return set.startWith(start)
+}
+
+func readThemeFile(path string) (ThemeFile, error) {
+	var file ThemeFile
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return file, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&file); err != nil && err != io.EOF {
+		return file, err
+	}
+	for name, spec := range file.Themes {
+		for _, builtin := range builtinThemes {
+			if name == builtin.Name {
+				return file, fmt.Errorf("%q is the name of a built-in theme", name)
+			}
+		}
+		if err := spec.validate(); err != nil {
+			return file, fmt.Errorf("theme %q: %w", name, err)
+		}
+	}
+	return file, nil
+}
+
+// switchTheme makes the named theme the active one. Views keep their
+// state; only their colors change.
+func (m *Model) switchTheme(name string) {
+	spec, ok := m.themes.Get(name)
+	if !ok {
+		m.statusMsg = fmt.Sprintf("❌ Unknown theme %q", name)
+		m.statusIsError = true
+		return
+	}
+	m.setDarkBackground(spec.Background.isDark(m.detectedDark))
+	applyThemeGlobals(spec)
+	m.setTheme(spec.Apply(baseTheme(m.theme.Renderer)))
+	m.themeName = spec.Name
+	if spec.Name == DefaultThemeName {
+		m.themeName = ""
+	}
+	m.statusMsg = "Theme: " + spec.Name
+	m.statusIsError = false
+}
+
+// reloadThemes rereads the themes files, picking up edits without a
+// restart, and reapplies the active theme
+func (m *Model) reloadThemes() {
+	m.themes = m.themes.Reload()
+	name := m.themeName
+	if _, ok := m.themes.Get(name); !ok {
+		name = DefaultThemeName
+	}
+	m.switchTheme(name)
+	if m.themes.Warning != "" {
+		m.statusMsg = "Themes: " + m.themes.Warning
+		m.statusIsError = true
+		return
+	}
+	m.statusMsg = fmt.Sprintf("Reloaded %d themes", len(m.themes.Themes))
+}
+
+// setTheme hands t to every view that renders with the theme
+func (m *Model) setTheme(t Theme) {
+	m.theme = t
+	m.board.theme = t
+	m.labelDashboard.theme = t
+	m.velocityComparison.theme = t
+	m.shortcutsSidebar.theme = t
+	m.graphView.theme = t
+	m.tree.theme = t
+	m.insightsPanel.theme = t
+	m.flowMatrix.theme = t
+	m.actionableView.theme = t
+	m.cutLineView.theme = t
+	m.timelineView.theme = t
+	m.assigneeView.theme = t
+	m.facetView.theme = t
+	m.historyView.theme = t
+	m.recipePicker.theme = t
+	m.labelPicker.theme = t
+	m.issueSearch.theme = t
+	m.filterBar.theme = t
+	m.commandPalette.theme = t
+	m.issueEdit.theme = t
+	m.repoPicker.theme = t
+	m.agentPromptModal.theme = t
+	m.tutorialModel.theme = t
+	m.cassModal.theme = t
+	m.updateModal.theme = t
+
+	m.list.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(t.Primary)
+	m.list.Styles.FilterCursor = lipgloss.NewStyle().Foreground(t.Primary)
+	m.timeTravelInput.PromptStyle = lipgloss.NewStyle().Foreground(t.Primary).Bold(true)
+	m.timeTravelInput.TextStyle = lipgloss.NewStyle().Foreground(t.Base.GetForeground())
+	m.updateListDelegate()
+	m.renderer.SetDarkMode(t.Renderer.HasDarkBackground(), t)
+	m.updateViewportContent()
+}
@@ -0,0 +1,134 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func writeThemeFile(t *testing.T, dir, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, "themes.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadThemeFiles(t *testing.T) {
+	user := writeThemeFile(t, t.TempDir(), `
+theme: nord
+themes:
+  nord:
+    primary: "#88C0D0"
+    highlight: {light: "#D8DEE9", dark: "#3B4252"}
+    status:
+      open: "#A3BE8C"
+      blocked: "1"
+  mono:
+    primary: "#FFFFFF"
+`)
+	project := writeThemeFile(t, t.TempDir(), `
+themes:
+  mono:
+    primary: {dark: "#AAAAAA"}
+`)
+
+	set := loadThemeFiles(user, project)
+	if set.Warning != "" {
+		t.Fatalf("unexpected warning: %s", set.Warning)
+	}
+	if len(set.Themes) != 2 || set.Themes[0].Name != "mono" || set.Start != "nord" {
+		t.Fatalf("themes = %+v, start %q", set.Themes, set.Start)
+	}
+	mono, _ := set.Get("mono")
+	if mono.Source != "project" || *mono.Primary != (ThemeColor{Light: "#AAAAAA", Dark: "#AAAAAA"}) {
+		t.Errorf("project theme should replace the user one, got %+v", mono)
+	}
+
+	nord, _ := set.Get("nord")
+	base := DefaultTheme(lipgloss.NewRenderer(nil))
+	got := nord.Apply(base)
+	if got.Primary.Dark != "#88C0D0" || got.Highlight.Light != "#D8DEE9" || got.Blocked.Dark != "1" {
+		t.Errorf("colors not applied: primary %v highlight %v blocked %v", got.Primary, got.Highlight, got.Blocked)
+	}
+	if got.Closed != base.Closed || got.Secondary != base.Secondary {
+		t.Error("colors the theme leaves out should keep their defaults")
+	}
+	if got.Selected.GetBorderLeftForeground() != got.Primary {
+		t.Error("styles should be rebuilt from the new colors")
+	}
+
+	// BV_THEME picks the start theme over the file
+	if got := set.startWith("light"); got.Start != "light" || got.Warning != "" {
+		t.Errorf("startWith(light) = %q, warning %q", got.Start, got.Warning)
+	}
+	if got := set.startWith("nope"); got.Start != "nord" || got.Warning == "" {
+		t.Errorf("unknown start theme should warn and keep nord, got %q", got.Start)
+	}
+
+	// Missing files are fine; broken ones are skipped with a warning
+	if set := loadThemeFiles(filepath.Join(t.TempDir(), "none.yaml"), ""); set.Warning != "" || len(set.Themes) != 0 {
+		t.Errorf("missing file: %+v", set)
+	}
+	for _, bad := range []string{
+		"themes:\n  x:\n    primary: purple\n",
+		"themes:\n  x:\n    status:\n      review: \"#FFFFFF\"\n",
+		"themes:\n  x:\n    accent: \"#FFFFFF\"\n",
+		"themes:\n  default:\n    primary: \"#FFFFFF\"\n",
+		"themes:\n  light:\n    primary: \"#FFFFFF\"\n",
+		"themes:\n  x:\n    background: sepia\n",
+		"theme: missing\n",
+	} {
+		set := loadThemeFiles(writeThemeFile(t, t.TempDir(), bad), "")
+		if set.Warning == "" || len(set.Themes) != 0 || set.Start != "" {
+			t.Errorf("%q should be rejected, got %+v", bad, set)
+		}
+	}
+}
+
+func TestSwitchThemeAtRuntime(t *testing.T) {
+	t.Cleanup(func() { applyThemeGlobals(ThemeSpec{}) })
+	defaultOpen := ColorStatusOpen
+
+	m := NewModel([]model.Issue{{ID: "1", Title: "x", Status: model.StatusOpen}}, nil, "")
+	path := writeThemeFile(t, t.TempDir(), `
+themes:
+  nord:
+    primary: "#88C0D0"
+    status:
+      open: "#A3BE8C"
+`)
+	m.themes = loadThemeFiles(path, "")
+
+	m = runPalette(t, m, "theme nord")
+	if m.themeName != "nord" || m.theme.Primary.Dark != "#88C0D0" {
+		t.Fatalf("palette should switch to nord, got %q %v", m.themeName, m.theme.Primary)
+	}
+	if m.board.theme.Primary.Dark != "#88C0D0" || m.issueEdit.theme.Open.Dark != "#A3BE8C" {
+		t.Error("views should pick up the new theme")
+	}
+	if ColorStatusOpen.Dark != "#A3BE8C" || ColorPrimary.Dark != "#88C0D0" {
+		t.Error("shared badge colors should follow the theme")
+	}
+	if m.statusIsError || !strings.Contains(m.statusMsg, "nord") {
+		t.Errorf("status = %q", m.statusMsg)
+	}
+
+	// Edits to the file apply on reload without a restart
+	writeThemeFile(t, filepath.Dir(path), "themes:\n  nord:\n    primary: \"#5E81AC\"\n")
+	m = runPalette(t, m, "reload themes")
+	if m.theme.Primary.Dark != "#5E81AC" || ColorStatusOpen != defaultOpen {
+		t.Errorf("reload should reapply nord as edited, primary %v open %v", m.theme.Primary, ColorStatusOpen)
+	}
+
+	m = runPalette(t, m, "theme default")
+	if m.themeName != "" || m.theme.Primary != DefaultTheme(m.theme.Renderer).Primary {
+		t.Errorf("default theme should restore the colors, got %v", m.theme.Primary)
+	}
+}
@@ -0,0 +1,541 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Timeline lane grouping modes
+const (
+	TimelineByTrack    = "track"    // one lane per scheduled agent lane
+	TimelineByAssignee = "assignee" // one lane per assignee, unassigned last
+)
+
+// timelineLane is one titled group of bars
+type timelineLane struct {
+	title string
+	items []analysis.ScheduledIssue
+}
+
+// timelineGlyphs are the characters bars and markers are drawn with
+type timelineGlyphs struct {
+	planned, active, open, close, single, activeSingle     string
+	connector, arrow, due, today, rule, tick, marker, from string
+}
+
+var (
+	unicodeTimelineGlyphs = timelineGlyphs{
+		planned: "─", active: "━", open: "├", close: "┤", single: "┼", activeSingle: "╋",
+		connector: "┄", arrow: "▶", due: "◆", today: "┊", rule: "─", tick: "┬", marker: "▼", from: "←",
+	}
+	plainTimelineGlyphs = timelineGlyphs{
+		planned: "-", active: "=", open: "[", close: "]", single: "|", activeSingle: "#",
+		connector: ".", arrow: ">", due: "!", today: ":", rule: "-", tick: "+", marker: "v", from: "<-",
+	}
+)
+
+// TimelineModel renders the projected schedule as a Gantt chart: one bar per
+// open issue across a date axis, lanes per agent track or assignee, and a
+// connector leading into bars that wait on a dependency.
+type TimelineModel struct {
+	sched        analysis.Schedule
+	assignees    map[string]string
+	groupBy      string
+	lanes        []timelineLane
+	rows         []analysis.ScheduledIssue // selection order, lane by lane
+	selected     int
+	scrollOffset int
+	width        int
+	height       int
+	theme        Theme
+}
+
+// NewTimelineModel creates a timeline of sched, taking assignees from issues
+func NewTimelineModel(sched analysis.Schedule, issues []model.Issue, theme Theme) TimelineModel {
+	m := TimelineModel{
+		sched:     sched,
+		assignees: make(map[string]string, len(issues)),
+		groupBy:   TimelineByTrack,
+		theme:     theme,
+	}
+	for _, issue := range issues {
+		m.assignees[issue.ID] = issue.Assignee
+	}
+	m.buildLanes()
+	return m
+}
+
+// SetSize updates the view dimensions
+func (m *TimelineModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// GroupBy returns the current lane grouping
+func (m *TimelineModel) GroupBy() string {
+	return m.groupBy
+}
+
+// ToggleGroupBy switches lanes between agent tracks and assignees, keeping
+// the selected issue selected
+func (m *TimelineModel) ToggleGroupBy() {
+	selectedID := m.SelectedIssueID()
+	if m.groupBy == TimelineByTrack {
+		m.groupBy = TimelineByAssignee
+	} else {
+		m.groupBy = TimelineByTrack
+	}
+	m.buildLanes()
+	m.selected = 0
+	for i, it := range m.rows {
+		if it.ID == selectedID {
+			m.selected = i
+			break
+		}
+	}
+	m.scrollOffset = 0
+	m.ensureVisible()
+}
+
+func (m *TimelineModel) buildLanes() {
+	m.lanes = nil
+	if m.groupBy == TimelineByAssignee {
+		index := make(map[string]int)
+		for _, it := range m.sched.Items {
+			who := m.assignees[it.ID]
+			idx, ok := index[who]
+			if !ok {
+				title := "@" + who
+				if who == "" {
+					title = "Unassigned"
+				}
+				idx = len(m.lanes)
+				index[who] = idx
+				m.lanes = append(m.lanes, timelineLane{title: title})
+			}
+			m.lanes[idx].items = append(m.lanes[idx].items, it)
+		}
+		// Named assignees alphabetically, unassigned work last
+		sort.SliceStable(m.lanes, func(a, b int) bool {
+			if (m.lanes[a].title == "Unassigned") != (m.lanes[b].title == "Unassigned") {
+				return m.lanes[b].title == "Unassigned"
+			}
+			return m.lanes[a].title < m.lanes[b].title
+		})
+	} else {
+		byAgent := make([][]analysis.ScheduledIssue, m.sched.Agents)
+		for _, it := range m.sched.Items {
+			if it.Agent >= 1 && it.Agent <= m.sched.Agents {
+				byAgent[it.Agent-1] = append(byAgent[it.Agent-1], it)
+			}
+		}
+		for i, items := range byAgent {
+			if len(items) > 0 {
+				m.lanes = append(m.lanes, timelineLane{title: fmt.Sprintf("Track %d", i+1), items: items})
+			}
+		}
+	}
+
+	m.rows = m.rows[:0]
+	for _, lane := range m.lanes {
+		m.rows = append(m.rows, lane.items...)
+	}
+}
+
+// MoveDown moves selection down
+func (m *TimelineModel) MoveDown() {
+	if m.selected < len(m.rows)-1 {
+		m.selected++
+	}
+	m.ensureVisible()
+}
+
+// MoveUp moves selection up
+func (m *TimelineModel) MoveUp() {
+	if m.selected > 0 {
+		m.selected--
+	}
+	m.ensureVisible()
+}
+
+// SelectedIssueID returns the ID of the currently selected issue
+func (m *TimelineModel) SelectedIssueID() string {
+	if m.selected < 0 || m.selected >= len(m.rows) {
+		return ""
+	}
+	return m.rows[m.selected].ID
+}
+
+// SelectByID selects the bar of the given issue, reporting whether it is
+// scheduled
+func (m *TimelineModel) SelectByID(id string) bool {
+	for i, it := range m.rows {
+		if it.ID == id {
+			m.selected = i
+			m.ensureVisible()
+			return true
+		}
+	}
+	return false
+}
+
+// lineOf returns the body line index of row idx; each lane adds a title line
+func (m *TimelineModel) lineOf(idx int) int {
+	line := 0
+	for _, lane := range m.lanes {
+		line++
+		if idx < len(lane.items) {
+			return line + idx
+		}
+		idx -= len(lane.items)
+		line += len(lane.items)
+	}
+	return line
+}
+
+func (m *TimelineModel) ensureVisible() {
+	visible := m.height - 5
+	if visible < 3 {
+		visible = 3
+	}
+	line := m.lineOf(m.selected)
+	// Keep the lane title in view when its first row is selected
+	if line-1 < m.scrollOffset {
+		m.scrollOffset = max(line-1, 0)
+	}
+	if line >= m.scrollOffset+visible {
+		m.scrollOffset = line - visible + 1
+	}
+}
+
+// Render renders the timeline view
+func (m *TimelineModel) Render() string {
+	if m.width == 0 || m.height == 0 {
+		return ""
+	}
+	t := m.theme
+	g := plainTimelineGlyphs
+	if TermCapabilities().Unicode {
+		g = unicodeTimelineGlyphs
+	}
+
+	headerStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Base.GetForeground()).
+		Background(t.Primary).
+		Padding(0, 2).
+		Width(m.width - 4)
+
+	if len(m.sched.Items) == 0 {
+		msg := "No open issues to schedule"
+		if len(m.sched.Unscheduled) > 0 {
+			msg += "; in a dependency cycle: " + strings.Join(m.sched.Unscheduled, ", ")
+		}
+		return headerStyle.Render("TIMELINE") + "\n\n" +
+			t.Renderer.NewStyle().Foreground(t.Subtext).Render(msg)
+	}
+
+	end := m.sched.Items[0].Finish
+	for _, it := range m.sched.Items {
+		if it.Finish.After(end) {
+			end = it.Finish
+		}
+	}
+	header := fmt.Sprintf("TIMELINE  │  %d open  │  by %s  │  done ~%s", len(m.sched.Items), m.groupBy, end.Format("Mon Jan 2"))
+	if len(m.sched.Unscheduled) > 0 {
+		header += fmt.Sprintf("  │  %d in cycles", len(m.sched.Unscheduled))
+	}
+
+	labelW := m.width / 3
+	labelW = min(max(labelW, 16), 32)
+	// Wide views name blockers after each bar; narrow ones keep room for "late"
+	suffixW, reserve := 0, 5
+	if m.width >= 100 {
+		suffixW, reserve = 16, 16
+	}
+	chartW := m.width - 4 - labelW - reserve
+	if chartW < 10 {
+		chartW = 10
+	}
+
+	// Time axis: start of today through the last finish or due date.
+	y, mo, d := m.sched.Now.Date()
+	t0 := time.Date(y, mo, d, 0, 0, 0, 0, m.sched.Now.Location())
+	t1 := t0.Add(24 * time.Hour)
+	for _, it := range m.sched.Items {
+		if it.Finish.After(t1) {
+			t1 = it.Finish
+		}
+		if it.DueDate != nil && it.DueDate.After(t1) {
+			t1 = *it.DueDate
+		}
+	}
+	span := t1.Sub(t0)
+	col := func(at time.Time) int {
+		c := int(float64(at.Sub(t0)) / float64(span) * float64(chartW-1))
+		return min(max(c, 0), chartW-1)
+	}
+	todayCol := col(m.sched.Now)
+
+	labels := []rune(strings.Repeat(" ", chartW))
+	ticks := make([]string, chartW)
+	for i := range ticks {
+		ticks[i] = g.rule
+	}
+	next := 0
+	for day := t0; !day.After(t1); day = day.AddDate(0, 0, 1) {
+		c := col(day)
+		text := []rune(day.Format("Jan 2"))
+		if c < next {
+			continue
+		}
+		if c+len(text) > chartW {
+			break
+		}
+		copy(labels[c:], text)
+		ticks[c] = g.tick
+		next = c + len(text) + 3
+	}
+	ticks[todayCol] = g.marker
+	axisStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	pad := strings.Repeat(" ", labelW+2)
+	axis := pad + axisStyle.Render(strings.TrimRight(string(labels), " ")) + "\n" +
+		pad + axisStyle.Render(strings.Join(ticks, ""))
+
+	// Rows the selected issue waits on get a marker
+	waitsOn := make(map[string]bool)
+	if m.selected < len(m.rows) {
+		for _, id := range m.rows[m.selected].Blockers {
+			waitsOn[id] = true
+		}
+	}
+	finish := make(map[string]time.Time, len(m.sched.Items))
+	for _, it := range m.sched.Items {
+		finish[it.ID] = it.Finish
+	}
+
+	laneStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	var body []string
+	idx := 0
+	for _, lane := range m.lanes {
+		body = append(body, laneStyle.Render(fmt.Sprintf("%s (%d)", lane.title, len(lane.items))))
+		for _, it := range lane.items {
+			body = append(body, m.renderRow(idx, it, g, labelW, chartW, suffixW, col, todayCol, finish, waitsOn[it.ID]))
+			idx++
+		}
+	}
+
+	visible := m.height - 5
+	if visible < 1 {
+		visible = 1
+	}
+	start := min(m.scrollOffset, len(body))
+	stop := min(start+visible, len(body))
+
+	legend := t.Renderer.NewStyle().Foreground(t.Subtext).Italic(true).Render(truncateRunesHelper(fmt.Sprintf(
+		"%s in progress  %s planned  %s%s waits on dependency  %s due  %s today  •  j/k move  •  s lanes  •  enter open  •  esc back",
+		g.active, g.planned, g.connector, g.arrow, g.due, g.today), m.width-2, "…"))
+
+	return headerStyle.Render(header) + "\n" + axis + "\n" + strings.Join(body[start:stop], "\n") + "\n" + legend
+}
+
+// timeline cell kinds, for coloring runs of the chart
+const (
+	cellEmpty = iota
+	cellPlanned
+	cellActive
+	cellLink
+	cellDue
+	cellLate
+)
+
+func (m *TimelineModel) renderRow(idx int, it analysis.ScheduledIssue, g timelineGlyphs, labelW, chartW, suffixW int,
+	col func(time.Time) int, todayCol int, finish map[string]time.Time, waitedOn bool) string {
+	t := m.theme
+
+	cells := make([]string, chartW)
+	kinds := make([]int, chartW)
+	for i := range cells {
+		cells[i] = " "
+	}
+	cells[todayCol] = g.today
+
+	active := it.Status == string(model.StatusInProgress)
+	fill, kind := g.planned, cellPlanned
+	if active {
+		fill, kind = g.active, cellActive
+	}
+	s, f := col(it.Start), col(it.Finish)
+	if f <= s {
+		f = s + 1
+	}
+	f = min(f, chartW)
+	for c := s; c < f; c++ {
+		cells[c], kinds[c] = fill, kind
+	}
+	switch {
+	case f-s == 1 && active:
+		cells[s] = g.activeSingle
+	case f-s == 1:
+		cells[s] = g.single
+	case !active:
+		cells[s], cells[f-1] = g.open, g.close
+	}
+
+	// Connector from the latest blocker's finish into the bar
+	if len(it.Blockers) > 0 && s > 0 {
+		from := s - 1
+		for _, id := range it.Blockers {
+			if at, ok := finish[id]; ok {
+				from = min(from, col(at))
+			}
+		}
+		for c := from; c < s-1; c++ {
+			if kinds[c] == cellEmpty {
+				cells[c], kinds[c] = g.connector, cellLink
+			}
+		}
+		cells[s-1], kinds[s-1] = g.arrow, cellLink
+	}
+
+	late := false
+	if it.DueDate != nil {
+		late = it.Finish.After(*it.DueDate)
+		c := col(*it.DueDate)
+		cells[c], kinds[c] = g.due, cellDue
+		if late {
+			kinds[c] = cellLate
+		}
+	}
+
+	styles := map[int]lipgloss.Style{
+		cellEmpty:   t.Renderer.NewStyle().Foreground(t.Subtext),
+		cellPlanned: t.Renderer.NewStyle().Foreground(t.Open),
+		cellActive:  t.Renderer.NewStyle().Foreground(t.InProgress).Bold(true),
+		cellLink:    t.Renderer.NewStyle().Foreground(t.Secondary),
+		cellDue:     t.Renderer.NewStyle().Foreground(t.Feature),
+		cellLate:    t.Renderer.NewStyle().Foreground(t.Blocked).Bold(true),
+	}
+	var chart strings.Builder
+	for c := 0; c < chartW; {
+		run := c
+		for run < chartW && kinds[run] == kinds[c] {
+			run++
+		}
+		chart.WriteString(styles[kinds[c]].Render(strings.Join(cells[c:run], "")))
+		c = run
+	}
+
+	var sb strings.Builder
+	switch {
+	case idx == m.selected:
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ "))
+	case waitedOn:
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Secondary).Render(g.from + " "))
+	default:
+		sb.WriteString("  ")
+	}
+	label := it.ID
+	if title := strings.Join(strings.Fields(it.Title), " "); title != "" {
+		label += " " + title
+	}
+	sb.WriteString(padRight(truncateRunesHelper(label, labelW-1, "…"), labelW))
+	sb.WriteString(chart.String())
+
+	if suffixW > 0 {
+		var notes []string
+		if late {
+			notes = append(notes, "late")
+		}
+		if len(it.Blockers) > 0 {
+			notes = append(notes, g.from+" "+strings.Join(it.Blockers, ","))
+		}
+		if len(notes) > 0 {
+			sb.WriteString(" " + t.Renderer.NewStyle().Foreground(t.Subtext).
+				Render(truncateRunesHelper(strings.Join(notes, " "), suffixW-1, "…")))
+		}
+	} else if late {
+		sb.WriteString(" " + t.Renderer.NewStyle().Foreground(t.Blocked).Render("late"))
+	}
+
+	lineStyle := t.Renderer.NewStyle().Width(m.width - 2)
+	if idx == m.selected {
+		lineStyle = lineStyle.Background(t.Highlight)
+	}
+	return lineStyle.Render(sb.String())
+}
+
+// openTimeline schedules open work across one lane per assignee (at least
+// one) and focuses the timeline view
+func (m Model) openTimeline() Model {
+	m.clearAttentionOverlay()
+	m.isGraphView = false
+	m.isBoardView = false
+	m.isActionableView = false
+	m.isHistoryView = false
+	people := make(map[string]bool)
+	for _, issue := range m.issues {
+		if issue.Assignee != "" && !isClosedLikeStatus(issue.Status) {
+			people[issue.Assignee] = true
+		}
+	}
+	sched := analysis.ComputeSchedule(m.issues, analysis.ScheduleOptions{Agents: max(len(people), 1), Now: time.Now()})
+	if m.queryRecipe() != nil {
+		// Schedule everything so hidden blockers still delay their
+		// dependents, then show only what the filter bar's query keeps
+		var items []analysis.ScheduledIssue
+		for _, item := range sched.Items {
+			if issue, ok := m.issueMap[item.ID]; ok && m.queryKeeps(*issue) {
+				items = append(items, item)
+			}
+		}
+		sched.Items = items
+	}
+	m.timelineView = NewTimelineModel(sched, m.issues, m.theme)
+	m.timelineView.SetSize(m.width, m.height-1)
+	m.focused = focusTimeline
+	return m
+}
+
+// handleTimelineKeys handles keyboard input when the timeline view is focused
+func (m Model) handleTimelineKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.timelineView.MoveDown()
+	case "k", "up":
+		m.timelineView.MoveUp()
+	case "s":
+		m.timelineView.ToggleGroupBy()
+	case "/":
+		m = m.openIssueSearch()
+	case "L", "esc":
+		m.focused = focusList
+	case "enter":
+		// Jump to selected issue in list view
+		selectedID := m.timelineView.SelectedIssueID()
+		if selectedID == "" {
+			return m
+		}
+		for i, item := range m.list.Items() {
+			if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
+				m.list.Select(i)
+				break
+			}
+		}
+		m.focused = focusDetail
+		if !m.isSplitView {
+			m.showDetails = true
+			m.viewport.GotoTop()
+		}
+		m.updateViewportContent()
+	}
+	return m
+}
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestTimeline(t *testing.T) {
+	now := time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)
+	est120, est240, est480 := 120, 240, 480
+	due := now.Add(4 * time.Hour)
+	issues := []model.Issue{
+		{ID: "api", Title: "Build API", Status: model.StatusInProgress, Assignee: "bob", Priority: 1, EstimatedMinutes: &est480},
+		{ID: "ui", Title: "Build UI", Status: model.StatusOpen, Assignee: "ann", Priority: 2, EstimatedMinutes: &est240, DueDate: &due,
+			Dependencies: []*model.Dependency{{IssueID: "ui", DependsOnID: "api", Type: model.DepBlocks}}},
+		{ID: "misc", Title: "Tidy", Status: model.StatusOpen, Priority: 3, EstimatedMinutes: &est120},
+	}
+	sched := analysis.ComputeSchedule(issues, analysis.ScheduleOptions{Agents: 2, Now: now})
+
+	t.Run("render tracks", func(t *testing.T) {
+		m := NewTimelineModel(sched, issues, newTestTheme())
+		m.SetSize(120, 30)
+
+		out := m.Render()
+		for _, want := range []string{"TIMELINE", "3 open", "by track", "Track 1", "Track 2", "Jan 6", "┬", "━", "▶", "◆", "← api", "late"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("render missing %q:\n%s", want, out)
+			}
+		}
+		if got := m.SelectedIssueID(); got != sched.Items[0].ID {
+			t.Errorf("initial selection = %q, want first scheduled issue", got)
+		}
+	})
+
+	t.Run("group by assignee", func(t *testing.T) {
+		m := NewTimelineModel(sched, issues, newTestTheme())
+		m.SetSize(120, 30)
+
+		for m.SelectedIssueID() != "ui" {
+			m.MoveDown()
+		}
+		m.ToggleGroupBy()
+		if m.GroupBy() != TimelineByAssignee {
+			t.Fatalf("group = %q, want assignee", m.GroupBy())
+		}
+		if got := m.SelectedIssueID(); got != "ui" {
+			t.Errorf("selection should follow the issue across regrouping, got %q", got)
+		}
+
+		out := m.Render()
+		ann, bob, none := strings.Index(out, "@ann (1)"), strings.Index(out, "@bob (1)"), strings.Index(out, "Unassigned (1)")
+		if ann < 0 || bob < 0 || none < 0 || !(ann < bob && bob < none) {
+			t.Errorf("expected lanes @ann, @bob, Unassigned in order:\n%s", out)
+		}
+
+		m.MoveDown()
+		m.MoveDown()
+		m.MoveDown()
+		if got := m.SelectedIssueID(); got != "misc" {
+			t.Errorf("selection should stop at the last row, got %q", got)
+		}
+	})
+}
+
+func TestTimelineRenderEmpty(t *testing.T) {
+	m := NewTimelineModel(analysis.Schedule{Agents: 1}, nil, newTestTheme())
+	m.SetSize(80, 10)
+	if out := m.Render(); !strings.Contains(out, "No open issues") {
+		t.Errorf("expected empty message, got:\n%s", out)
+	}
+}
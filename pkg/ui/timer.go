@@ -0,0 +1,205 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Work timer (Ctrl+T): starting it on an issue opens a time entry there,
+// stopping it closes the entry, and starting it on another issue stops the
+// one running first. Entries belong to whoever pickUser names, so a
+// teammate's running timer is left alone. Each toggle is written to the
+// beads file straight away; there is nothing to undo.
+
+// TimerSavedMsg reports the result of writing the time entries of a timer
+// start or stop
+type TimerSavedMsg struct {
+	Before []model.Issue // The issues as they were, restored on failure
+	After  []model.Issue
+	Status string // Reported once the write succeeds
+	Err    error
+}
+
+// timerTickMsg refreshes the running timer's elapsed time in the footer
+type timerTickMsg struct{}
+
+func timerTickCmd() tea.Cmd {
+	return tea.Tick(time.Minute, func(time.Time) tea.Msg {
+		return timerTickMsg{}
+	})
+}
+
+// runningEntry is the index of author's running time entry on issue, or -1
+func runningEntry(issue *model.Issue, author string) int {
+	for i, e := range issue.TimeEntries {
+		if e.End == nil && e.Author == author {
+			return i
+		}
+	}
+	return -1
+}
+
+// findRunningTimer returns the issue where the current user's timer runs
+func findRunningTimer(issues []model.Issue) string {
+	author := pickUser()
+	for i := range issues {
+		if runningEntry(&issues[i], author) >= 0 {
+			return issues[i].ID
+		}
+	}
+	return ""
+}
+
+// toggleTimer starts the work timer on the selected issue, or stops it if
+// it is running there
+func (m Model) toggleTimer() (Model, tea.Cmd) {
+	if m.beadsPath == "" || m.workspaceMode {
+		m.statusMsg = "❌ Time tracking needs a single beads file (not available in workspace mode)"
+		m.statusIsError = true
+		return m, nil
+	}
+	id := m.selectedIssueID()
+	issue, ok := m.issueMap[id]
+	if !ok {
+		m.statusMsg = "❌ No issue selected"
+		m.statusIsError = true
+		return m, nil
+	}
+
+	author := pickUser()
+	now := time.Now().UTC().Truncate(time.Second)
+	stop := func(issue *model.Issue) model.Issue {
+		stopped := issue.Clone()
+		if i := runningEntry(&stopped, author); i >= 0 {
+			stopped.TimeEntries[i].End = &now
+		}
+		return stopped
+	}
+
+	var before, after []model.Issue
+	var status string
+	if runningEntry(issue, author) >= 0 {
+		before = append(before, *issue)
+		stopped := stop(issue)
+		after = append(after, stopped)
+		status = fmt.Sprintf("⏱ Stopped %s: %s logged", id, formatLogged(stopped.LoggedTime()))
+	} else {
+		if other, ok := m.issueMap[m.timerIssueID]; ok && other.ID != id {
+			before = append(before, *other)
+			after = append(after, stop(other))
+		}
+		started := issue.Clone()
+		started.TimeEntries = append(started.TimeEntries, model.TimeEntry{Start: now, Author: author})
+		before = append(before, *issue)
+		after = append(after, started)
+		status = "⏱ Timer started on " + id
+		if len(before) > 1 {
+			status += " (stopped " + before[0].ID + ")"
+		}
+	}
+	return m.writeTimers(before, after, status)
+}
+
+// writeTimers shows after at once and returns the command writing its
+// time entries to the beads file
+func (m Model) writeTimers(before, after []model.Issue, status string) (Model, tea.Cmd) {
+	m.replaceIssueLocally(after...)
+	m.updateViewportContent()
+	m.statusMsg = status
+	m.statusIsError = false
+
+	entries := make(map[string][]model.TimeEntry, len(after))
+	for _, issue := range after {
+		entries[issue.ID] = issue.TimeEntries
+	}
+	path := m.beadsPath
+	return m, func() tea.Msg {
+		return TimerSavedMsg{Before: before, After: after, Status: status, Err: loader.UpdateTimeEntriesInFile(path, entries)}
+	}
+}
+
+// handleTimerSaved starts the footer's tick once a started timer is
+// written, or reports a failed write, putting the entries back as they
+// were and offering to retry
+func (m Model) handleTimerSaved(msg TimerSavedMsg) (Model, tea.Cmd) {
+	if msg.Err == nil {
+		if m.timerIssueID == "" || m.timerTicking {
+			return m, nil
+		}
+		m.timerTicking = true
+		return m, timerTickCmd()
+	}
+	m.replaceIssueLocally(msg.Before...)
+	m.updateViewportContent()
+	m.statusMsg = fmt.Sprintf("❌ Could not save the timer: %v", msg.Err)
+	m.statusIsError = true
+	m.openModal(errorModal("Could not save the timer",
+		"The time entries were not written to the beads file.",
+		msg.Err, func(m Model) (Model, tea.Cmd) {
+			return m.writeTimers(msg.Before, msg.After, msg.Status)
+		}))
+	return m, nil
+}
+
+// handleTimerTick keeps the footer's elapsed time current while a timer runs
+func (m Model) handleTimerTick() (Model, tea.Cmd) {
+	if m.timerIssueID == "" {
+		m.timerTicking = false
+		return m, nil
+	}
+	return m, timerTickCmd()
+}
+
+// formatLogged renders a duration of logged work as hours and minutes
+func formatLogged(d time.Duration) string {
+	minutes := int(d.Minutes())
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh%02dm", minutes/60, minutes%60)
+}
+
+// loggedSummary describes the work logged on issue for the detail view,
+// empty when there is none
+func loggedSummary(issue model.Issue, now time.Time) string {
+	var summary string
+	if logged := issue.LoggedTime(); logged > 0 {
+		summary = formatLogged(logged)
+	}
+	if running := issue.RunningTimer(); running != nil {
+		elapsed := "⏱ running " + formatLogged(now.Sub(running.Start))
+		if running.Author != "" {
+			elapsed += " (" + running.Author + ")"
+		}
+		if summary != "" {
+			summary += " · "
+		}
+		summary += elapsed
+	}
+	return summary
+}
+
+// timerBadge is the footer badge for the running timer, empty when there
+// is none
+func (m Model) timerBadge() string {
+	issue, ok := m.issueMap[m.timerIssueID]
+	if !ok {
+		return ""
+	}
+	i := runningEntry(issue, pickUser())
+	if i < 0 {
+		return ""
+	}
+	return lipgloss.NewStyle().
+		Background(ColorBgHighlight).
+		Foreground(ColorWarning).
+		Bold(true).
+		Padding(0, 1).
+		Render(fmt.Sprintf("⏱ %s %s", issue.ID, formatLogged(time.Since(issue.TimeEntries[i].Start))))
+}
@@ -0,0 +1,172 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func entriesInFile(t *testing.T, path, id string) []model.TimeEntry {
+	t.Helper()
+	issues, err := loader.LoadIssuesFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, issue := range issues {
+		if issue.ID == id {
+			return issue.TimeEntries
+		}
+	}
+	t.Fatalf("%s not in %s", id, path)
+	return nil
+}
+
+func TestTimerStartSwitchStop(t *testing.T) {
+	t.Setenv("BV_USER", "ann")
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, Labels: []string{"auth"}},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, Labels: []string{"ui", "theme"}},
+	}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlT})
+	if m.timerIssueID != "bv-1" {
+		t.Fatalf("timer should run on bv-1 before the write finishes, got %q", m.timerIssueID)
+	}
+	if badge := m.timerBadge(); !strings.Contains(badge, "⏱ bv-1 0m") {
+		t.Errorf("footer badge = %q", badge)
+	}
+	m = runWrite(t, m, cmd)
+	if !m.timerTicking {
+		t.Error("a started timer should schedule the footer tick")
+	}
+	entries := entriesInFile(t, path, "bv-1")
+	if len(entries) != 1 || entries[0].End != nil || entries[0].Author != "ann" {
+		t.Fatalf("bv-1 entries in file = %+v", entries)
+	}
+
+	// Starting on another issue stops the running timer in the same write
+	m.list.Select(1)
+	m, cmd = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlT})
+	if m.timerIssueID != "bv-2" || !strings.Contains(m.statusMsg, "stopped bv-1") {
+		t.Errorf("timer = %q, status = %q", m.timerIssueID, m.statusMsg)
+	}
+	m = runWrite(t, m, cmd)
+	if entries := entriesInFile(t, path, "bv-1"); len(entries) != 1 || entries[0].End == nil {
+		t.Errorf("bv-1 should be stopped in the file: %+v", entries)
+	}
+	if entries := entriesInFile(t, path, "bv-2"); len(entries) != 1 || entries[0].End != nil {
+		t.Errorf("bv-2 should be running in the file: %+v", entries)
+	}
+
+	m, cmd = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlT})
+	if m.timerIssueID != "" || m.timerBadge() != "" || !strings.HasPrefix(m.statusMsg, "⏱ Stopped bv-2") {
+		t.Errorf("ctrl+t should stop the timer, timer = %q, status = %q", m.timerIssueID, m.statusMsg)
+	}
+	m = runWrite(t, m, cmd)
+	if entries := entriesInFile(t, path, "bv-2"); len(entries) != 1 || entries[0].End == nil {
+		t.Errorf("bv-2 should be stopped in the file: %+v", entries)
+	}
+	if _, cmd = m.handleTimerTick(); cmd != nil {
+		t.Error("the tick should stop once no timer runs")
+	}
+}
+
+func TestTimerLeavesTeammatesAlone(t *testing.T) {
+	t.Setenv("BV_USER", "ann")
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	m := NewModel(issues, nil, "")
+	m.beadsPath = writeTempBeadsFile(t, t.TempDir(), issues...)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	started := time.Now().Add(-90 * time.Minute)
+	bob := m.issueMap["bv-1"].Clone()
+	bob.TimeEntries = []model.TimeEntry{{Start: started, Author: "bob"}}
+	m.replaceIssueLocally(bob)
+	if m.timerIssueID != "" {
+		t.Fatalf("bob's timer is not ann's, got %q", m.timerIssueID)
+	}
+
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlT})
+	entries := m.issueMap["bv-1"].TimeEntries
+	if len(entries) != 2 || entries[0].End != nil || entries[1].Author != "ann" {
+		t.Errorf("ann's timer should start beside bob's: %+v", entries)
+	}
+	if got := loggedSummary(*m.issueMap["bv-1"], started.Add(30*time.Minute)); got != "⏱ running 30m (bob)" {
+		t.Errorf("loggedSummary = %q", got)
+	}
+}
+
+func TestTimerWriteFailureReverts(t *testing.T) {
+	t.Setenv("BV_USER", "ann")
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlT})
+	m = runWrite(t, m, cmd)
+	if m.timerIssueID != "" || len(m.issueMap["bv-1"].TimeEntries) != 0 {
+		t.Errorf("a failed write should put the entries back: %+v", m.issueMap["bv-1"].TimeEntries)
+	}
+	if m.FocusState() != "modal" || !m.statusIsError {
+		t.Fatalf("a failed write should open the error modal, focus = %s", m.FocusState())
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m, cmd = pressEdit(m, runeKeys("r")...)
+	if m.timerIssueID != "bv-1" {
+		t.Errorf("retry should start the timer again, got %q", m.timerIssueID)
+	}
+	runWrite(t, m, cmd)
+	if entries := entriesInFile(t, path, "bv-1"); len(entries) != 1 {
+		t.Errorf("retry should write the entry: %+v", entries)
+	}
+}
+
+func TestTimerNeedsBeadsFile(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	m := NewModel(issues, nil, "")
+	m.beadsPath = writeTempBeadsFile(t, t.TempDir(), issues...)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	m.workspaceMode = true
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlT})
+	if cmd != nil || m.timerIssueID != "" || !m.statusIsError {
+		t.Errorf("ctrl+t should be refused in workspace mode, status = %q", m.statusMsg)
+	}
+}
+
+func TestFormatLogged(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		0:                               "0m",
+		59*time.Second + 12*time.Minute: "12m",
+		time.Hour + 5*time.Minute:       "1h05m",
+		26 * time.Hour:                  "26h00m",
+	} {
+		if got := formatLogged(d); got != want {
+			t.Errorf("formatLogged(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
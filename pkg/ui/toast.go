@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Toasts are short notes about things that finish in the background:
+// reloads, exports, writes to the beads file and new alerts. They stack in
+// the bottom-right corner above the footer, newest last, and dismiss
+// themselves; the status line stays for feedback on the key just pressed.
+
+// toastLevel is how serious a toast is, which sets its color and how long
+// it stays
+type toastLevel int
+
+const (
+	toastInfo toastLevel = iota
+	toastSuccess
+	toastWarning
+	toastError
+)
+
+const (
+	toastDuration      = 4 * time.Second
+	toastErrorDuration = 8 * time.Second // Long enough to read the error
+	maxToasts          = 4               // Older toasts are dropped past this
+)
+
+// look is the color and icon a toast of this level is drawn with
+func (l toastLevel) look() (lipgloss.AdaptiveColor, string) {
+	switch l {
+	case toastSuccess:
+		return ColorSuccess, "✓"
+	case toastWarning:
+		return ColorWarning, "⚠"
+	case toastError:
+		return ColorPrioCritical, "✗"
+	}
+	return ColorInfo, "ℹ"
+}
+
+// toast is one note in the stack
+type toast struct {
+	id    int
+	level toastLevel
+	text  string
+}
+
+// toastExpiredMsg dismisses the toast with the given id
+type toastExpiredMsg struct {
+	id int
+}
+
+// notify shows a toast and returns the command that dismisses it again
+func (m *Model) notify(level toastLevel, text string) tea.Cmd {
+	m.toastSeq++
+	id := m.toastSeq
+	toasts := append(make([]toast, 0, len(m.toasts)+1), m.toasts...)
+	toasts = append(toasts, toast{id: id, level: level, text: text})
+	if len(toasts) > maxToasts {
+		toasts = toasts[len(toasts)-maxToasts:]
+	}
+	m.toasts = toasts
+
+	d := toastDuration
+	if level == toastError {
+		d = toastErrorDuration
+	}
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return toastExpiredMsg{id: id}
+	})
+}
+
+// dismissToast removes the toast with the given id, if it is still shown
+func (m *Model) dismissToast(id int) {
+	toasts := make([]toast, 0, len(m.toasts))
+	for _, t := range m.toasts {
+		if t.id != id {
+			toasts = append(toasts, t)
+		}
+	}
+	m.toasts = toasts
+}
+
+// clearStatus clears the status line if it still shows text, so a
+// progress note doesn't outlive the toast reporting the result
+func (m *Model) clearStatus(text string) {
+	if m.statusMsg == text {
+		m.statusMsg = ""
+		m.statusIsError = false
+	}
+}
+
+// renderToasts draws the stack, one line per toast, all as wide as the
+// widest and at most width wide
+func (m Model) renderToasts(width int) []string {
+	texts := make([]string, len(m.toasts))
+	inner := 0
+	for i, t := range m.toasts {
+		_, icon := t.level.look()
+		texts[i] = truncateRunesHelper(icon+" "+t.text, width-3, "…")
+		inner = max(inner, lipgloss.Width(texts[i]))
+	}
+
+	lines := make([]string, 0, len(m.toasts))
+	for i, t := range m.toasts {
+		color, _ := t.level.look()
+		style := lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(color).
+			Bold(t.level == toastError).
+			Border(termBorder(lipgloss.ThickBorder()), false, false, false, true).
+			BorderForeground(color).
+			Padding(0, 1).
+			Width(inner + 2)
+		lines = append(lines, style.Render(texts[i]))
+	}
+	return lines
+}
+
+// overlayToasts draws the toast stack over the bottom-right corner of body,
+// leaving a blank row below it
+func (m Model) overlayToasts(body string) string {
+	if len(m.toasts) == 0 || m.width < 20 {
+		return body
+	}
+	toasts := m.renderToasts(min(m.width/2+10, 64))
+	rows := strings.Split(body, "\n")
+	bottom := len(rows) - 2
+	for i := len(toasts) - 1; i >= 0 && bottom >= 0; i-- {
+		toast := toasts[i]
+		x := max(m.width-lipgloss.Width(toast)-1, 0)
+		row := rows[bottom]
+		left := ansi.Truncate(row, x, "")
+		if w := lipgloss.Width(left); w < x {
+			left += strings.Repeat(" ", x-w)
+		}
+		right := ansi.TruncateLeft(row, x+lipgloss.Width(toast), "")
+		rows[bottom] = left + toast + right
+		bottom--
+	}
+	return strings.Join(rows, "\n")
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func lastToast(m Model) toast {
+	if len(m.toasts) == 0 {
+		return toast{}
+	}
+	return m.toasts[len(m.toasts)-1]
+}
+
+func TestToastStackAndDismiss(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	m := NewModel(issues, nil, "")
+	m.beadsPath = writeTempBeadsFile(t, t.TempDir(), issues...)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	var cmds []tea.Cmd
+	for _, text := range []string{"one", "two", "three", "four", "five"} {
+		cmds = append(cmds, m.notify(toastInfo, text))
+	}
+	if len(m.toasts) != maxToasts || m.toasts[0].text != "two" || lastToast(m).text != "five" {
+		t.Fatalf("stack should keep the newest %d toasts, got %+v", maxToasts, m.toasts)
+	}
+	for _, cmd := range cmds {
+		if cmd == nil {
+			t.Fatal("every toast should schedule its dismissal")
+		}
+	}
+
+	view := m.View()
+	if !strings.Contains(view, "two") || !strings.Contains(view, "five") {
+		t.Errorf("toasts should be drawn over the view:\n%s", view)
+	}
+	if strings.Index(view, "two") > strings.Index(view, "five") {
+		t.Error("newest toast should be at the bottom of the stack")
+	}
+
+	// Dismissing one that was already dropped is harmless
+	updated, _ = m.Update(toastExpiredMsg{id: 1})
+	m = updated.(Model)
+	updated, _ = m.Update(toastExpiredMsg{id: m.toasts[0].id})
+	m = updated.(Model)
+	if len(m.toasts) != maxToasts-1 || m.toasts[0].text != "three" {
+		t.Errorf("expired toast should be dismissed, got %+v", m.toasts)
+	}
+}
+
+func TestToastOverlayKeepsScreenSize(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	m := NewModel(issues, nil, "")
+	m.beadsPath = writeTempBeadsFile(t, t.TempDir(), issues...)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	before := m.View()
+	m.notify(toastError, "Reload failed: "+errors.New("disk on fire").Error())
+	after := m.View()
+	if got, want := strings.Count(after, "\n"), strings.Count(before, "\n"); got != want {
+		t.Errorf("toast changed the screen height: %d lines, want %d", got, want)
+	}
+	for i, line := range strings.Split(m.overlayToasts(strings.Repeat(strings.Repeat("x", m.width)+"\n", 5)), "\n") {
+		if w := lipgloss.Width(line); w > m.width {
+			t.Errorf("line %d is %d wide, screen is %d", i, w, m.width)
+		}
+	}
+	if !strings.Contains(after, "disk on fire") {
+		t.Errorf("error toast missing:\n%s", after)
+	}
+}
+
+func TestRefreshAlertsToastsNewAlerts(t *testing.T) {
+	now := time.Now()
+	m := NewModel([]model.Issue{
+		{ID: "bv-1", Title: "Fresh", Status: model.StatusOpen, IssueType: model.TypeTask, CreatedAt: now, UpdatedAt: now},
+	}, nil, "")
+	if cmd := m.refreshAlerts(); cmd != nil {
+		t.Errorf("no alerts should mean no toast, got %+v", m.toasts)
+	}
+
+	// A reload brings in an issue untouched for a month
+	m.issues = append(m.issues, model.Issue{ID: "bv-2", Title: "Stale", Status: model.StatusOpen, IssueType: model.TypeTask,
+		CreatedAt: now.AddDate(0, -2, 0), UpdatedAt: now.AddDate(0, -1, 0)})
+	if cmd := m.refreshAlerts(); cmd == nil || !strings.Contains(lastToast(m).text, "new alert") || lastToast(m).level < toastWarning {
+		t.Fatalf("a new stale alert should toast, got %+v (alerts %+v)", m.toasts, m.alerts)
+	}
+
+	// The same alerts after the next reload aren't new
+	if cmd := m.refreshAlerts(); cmd != nil {
+		t.Errorf("known alerts should not toast again, got %+v", m.toasts)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,22 +12,25 @@ import (
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
-func writeTempBeadsFile(t *testing.T, dir string, issue model.Issue) string {
+func writeTempBeadsFile(t *testing.T, dir string, issues ...model.Issue) string {
 	t.Helper()
 
 	created := time.Now().UTC().Format(time.RFC3339)
-	line := fmt.Sprintf(
-		`{"id":"%s","title":"%s","status":"%s","priority":%d,"issue_type":"%s","created_at":"%s","updated_at":"%s"}`,
-		issue.ID,
-		issue.Title,
-		issue.Status,
-		issue.Priority,
-		issue.IssueType,
-		created,
-		created,
-	)
+	var sb strings.Builder
+	for _, issue := range issues {
+		fmt.Fprintf(&sb,
+			`{"id":"%s","title":"%s","status":"%s","priority":%d,"issue_type":"%s","created_at":"%s","updated_at":"%s"}`+"\n",
+			issue.ID,
+			issue.Title,
+			issue.Status,
+			issue.Priority,
+			issue.IssueType,
+			created,
+			created,
+		)
+	}
 	path := filepath.Join(dir, "beads.jsonl")
-	if err := os.WriteFile(path, []byte(line), 0o644); err != nil {
+	if err := os.WriteFile(path, []byte(sb.String()), 0o644); err != nil {
 		t.Fatalf("write beads.jsonl: %v", err)
 	}
 	return path
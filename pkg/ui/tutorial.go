@@ -281,7 +281,7 @@ func (m TutorialModel) View() string {
 
 	// Wrap in modal style
 	modalStyle := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(m.theme.Primary).
 		Padding(1, 2).
 		Width(m.width).
@@ -476,7 +476,7 @@ func (m TutorialModel) renderTOC(pages []TutorialPage) string {
 	}
 
 	tocStyle := r.NewStyle().
-		Border(lipgloss.NormalBorder()).
+		Border(termBorder(lipgloss.NormalBorder())).
 		BorderForeground(borderColor).
 		Padding(0, 1).
 		Width(22)
@@ -600,7 +600,7 @@ func (m TutorialModel) renderEmptyState() string {
 	r := m.theme.Renderer
 
 	style := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(m.theme.Primary).
 		Padding(2, 4).
 		Width(m.width)
@@ -1539,8 +1539,8 @@ Quickly narrow down what you see:
 
 ### Sorting
 
-Press **s** to cycle through sort modes: priority → created → updated.
-Press **S** (shift+s) to reverse the current sort order.
+Press **s** to cycle the sort field: default → priority → updated → pagerank → created → id.
+Press **I** to reverse the current sort order. The actionable view sorts the same way.
 
 ### When to Use List View
 
@@ -114,7 +114,7 @@ func (t Tip) Render(theme Theme, width int) string {
 	r := theme.Renderer
 
 	boxStyle := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(theme.Feature).
 		Padding(0, 1).
 		Width(width - 2).
@@ -156,7 +156,7 @@ func (sf StatusFlow) Render(theme Theme, width int) string {
 	var boxes []string
 	for i, step := range sf.Steps {
 		boxStyle := r.NewStyle().
-			Border(lipgloss.RoundedBorder()).
+			Border(termBorder(lipgloss.RoundedBorder())).
 			BorderForeground(step.Color).
 			Foreground(step.Color).
 			Padding(0, 1).
@@ -325,7 +325,7 @@ func (ib InfoBox) Render(theme Theme, width int) string {
 		Foreground(theme.Base.GetForeground())
 
 	boxStyle := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(ib.Color).
 		Padding(0, 1).
 		Width(width - 2)
@@ -366,7 +366,7 @@ func (w Warning) Render(theme Theme, width int) string {
 	r := theme.Renderer
 
 	boxStyle := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(theme.Blocked).
 		Padding(0, 1).
 		Width(width - 2).
@@ -390,7 +390,7 @@ func (n Note) Render(theme Theme, width int) string {
 	r := theme.Renderer
 
 	boxStyle := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(theme.InProgress).
 		Padding(0, 1).
 		Width(width - 2).
@@ -419,7 +419,7 @@ func (st StyledTable) Render(theme Theme, width int) string {
 	t := table.New().
 		Headers(st.Headers...).
 		Rows(st.Rows...).
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderStyle(theme.Renderer.NewStyle().Foreground(theme.Border)).
 		Width(width - 2).
 		StyleFunc(func(row, col int) lipgloss.Style {
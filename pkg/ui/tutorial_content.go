@@ -358,7 +358,7 @@ func structuredTutorialPages() []StructuredTutorialPage {
 				}},
 				Spacer{Lines: 1},
 				Section{Title: "Sorting"},
-				Paragraph{Text: "Press s to cycle: priority -> created -> updated. Press S to reverse."},
+				Paragraph{Text: "Press s to cycle: priority -> updated -> pagerank -> created -> id. Press I to reverse."},
 				Spacer{Lines: 1},
 				Tip{Text: "Filter to r (ready) and work top-down for daily triage"},
 			},
@@ -681,7 +681,7 @@ func structuredTutorialPages() []StructuredTutorialPage {
 				Section{Title: "Navigation"},
 				KeyTable{Bindings: []KeyBinding{
 					{Key: "w", Desc: "Toggle workspace picker"},
-					{Key: "W", Desc: "Workspace-wide search"},
+					{Key: "W", Desc: "Next workspace (all, then each repo)"},
 				}},
 				Spacer{Lines: 1},
 				Section{Title: "Cross-Repo Dependencies"},
@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// editHistoryLimit caps how many edits can be undone
+const editHistoryLimit = 100
+
+// editOp is what a write to the beads file is for
+type editOp int
+
+const (
+	editOpSave editOp = iota
+	editOpUndo
+	editOpRedo
+)
+
+func (op editOp) verb() string {
+	switch op {
+	case editOpUndo:
+		return "undo"
+	case editOpRedo:
+		return "redo"
+	}
+	return "save"
+}
+
+// progress is shown while the write runs
+func (op editOp) progress() string {
+	switch op {
+	case editOpUndo:
+		return "Undoing"
+	case editOpRedo:
+		return "Redoing"
+	}
+	return "Saving"
+}
+
+// done is toasted once the write succeeded
+func (op editOp) done() string {
+	switch op {
+	case editOpUndo:
+		return "↶ Undid edit of"
+	case editOpRedo:
+		return "↷ Redid edit of"
+	}
+	return "Saved"
+}
+
+// editRecord is one saved edit, single or bulk: the edited fields of each
+// issue before and after it
+type editRecord struct {
+	IDs    []string
+	Before map[string]loader.IssueEdit
+	After  map[string]loader.IssueEdit
+}
+
+// editHistory is the undo/redo stack of saved edits. It lives on the Model
+// rather than a view, so it survives switching views and reloads.
+type editHistory struct {
+	undo []editRecord
+	redo []editRecord
+}
+
+// CanUndo reports whether there is an edit to undo
+func (h editHistory) CanUndo() bool {
+	return len(h.undo) > 0
+}
+
+// CanRedo reports whether there is an undone edit to redo
+func (h editHistory) CanRedo() bool {
+	return len(h.redo) > 0
+}
+
+// pop takes the record an undo or redo works on off its stack. It stays
+// off while the write runs, so a second keypress moves on to the next one.
+func (h *editHistory) pop(op editOp) (editRecord, bool) {
+	stack := &h.undo
+	if op == editOpRedo {
+		stack = &h.redo
+	}
+	if len(*stack) == 0 {
+		return editRecord{}, false
+	}
+	r := (*stack)[len(*stack)-1]
+	*stack = (*stack)[:len(*stack)-1]
+	return r, true
+}
+
+// commit files the record of a successful write: a new edit can be undone
+// and clears what was undone before it, an undo can be redone and a redo
+// undone again
+func (h *editHistory) commit(op editOp, r editRecord) {
+	switch op {
+	case editOpSave:
+		h.undo = appendLimited(h.undo, r)
+		h.redo = nil
+	case editOpUndo:
+		h.redo = appendLimited(h.redo, r)
+	case editOpRedo:
+		h.undo = appendLimited(h.undo, r)
+	}
+}
+
+// restore puts back the record of a failed undo or redo
+func (h *editHistory) restore(op editOp, r editRecord) {
+	switch op {
+	case editOpUndo:
+		h.undo = appendLimited(h.undo, r)
+	case editOpRedo:
+		h.redo = appendLimited(h.redo, r)
+	}
+}
+
+func appendLimited(stack []editRecord, r editRecord) []editRecord {
+	stack = append(stack, r)
+	if len(stack) > editHistoryLimit {
+		stack = stack[len(stack)-editHistoryLimit:]
+	}
+	return stack
+}
+
+// editOf returns an issue's editable fields
+func editOf(issue model.Issue) loader.IssueEdit {
+	return loader.IssueEdit{
+		Status:   issue.Status,
+		Priority: issue.Priority,
+		Assignee: issue.Assignee,
+		Labels:   append([]string(nil), issue.Labels...),
+	}
+}
+
+func sameEdit(a, b loader.IssueEdit) bool {
+	return a.Status == b.Status && a.Priority == b.Priority && a.Assignee == b.Assignee &&
+		strings.Join(a.Labels, "\x00") == strings.Join(b.Labels, "\x00")
+}
+
+// editHistoryKeysFree reports whether u and ctrl+r may act on the edit
+// history, which they can't while the focused view takes text
+func (m Model) editHistoryKeysFree() bool {
+	switch m.focused {
+	case focusIssueEdit, focusIssueCreate, focusModal, focusFilterBar, focusCommandPalette, focusIssueSearch, focusTimeTravelInput, focusLabelPicker:
+		return false
+	case focusHistory:
+		return !m.historyView.IsSearchActive()
+	}
+	return m.list.FilterState() != list.Filtering
+}
+
+// replayEdit undoes the last edit or redoes the last undone one, writing
+// it through the same path as a new edit. An issue changed since then, by
+// another edit or on disk, is not overwritten: the record is dropped
+// instead.
+func (m Model) replayEdit(op editOp) (Model, tea.Cmd) {
+	record, ok := m.editHistory.pop(op)
+	if !ok {
+		m.statusMsg = "Nothing to " + op.verb()
+		m.statusIsError = false
+		return m, nil
+	}
+	from, to := record.After, record.Before
+	if op == editOpRedo {
+		from, to = to, from
+	}
+	for _, id := range record.IDs {
+		issue, ok := m.issueMap[id]
+		if !ok {
+			m.statusMsg = fmt.Sprintf("❌ Can't %s: %s is no longer loaded", op.verb(), id)
+			m.statusIsError = true
+			return m, nil
+		}
+		if !sameEdit(editOf(*issue), from[id]) {
+			m.statusMsg = fmt.Sprintf("❌ Can't %s: %s changed since", op.verb(), id)
+			m.statusIsError = true
+			return m, nil
+		}
+	}
+	return m.writeEdits(to, op, record)
+}
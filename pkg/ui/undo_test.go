@@ -0,0 +1,149 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// runWrite feeds the result of a write command back into the model
+func runWrite(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+	if cmd == nil {
+		t.Fatalf("expected a write command, status %q", m.statusMsg)
+	}
+	updated, _ := m.Update(cmd())
+	return updated.(Model)
+}
+
+func statusInFile(t *testing.T, path, id string) model.Status {
+	t.Helper()
+	issues, err := loader.LoadIssuesFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, issue := range issues {
+		if issue.ID == id {
+			return issue.Status
+		}
+	}
+	t.Fatalf("%s not in %s", id, path)
+	return ""
+}
+
+func TestUndoRedoEdit(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	m, _ = pressEdit(m, runeKeys("e")...)
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyRight}, tea.KeyMsg{Type: tea.KeyEnter})
+	m = runWrite(t, m, cmd)
+	if m.issueMap["bv-1"].Status != model.StatusInProgress {
+		t.Fatalf("edit not applied: %s", m.issueMap["bv-1"].Status)
+	}
+
+	// The history survives a trip through another view
+	m, _ = pressEdit(m, runeKeys("b")...)
+	m, cmd = pressEdit(m, runeKeys("u")...)
+	if m.issueMap["bv-1"].Status != model.StatusOpen {
+		t.Errorf("u should restore the status at once, got %s", m.issueMap["bv-1"].Status)
+	}
+	m = runWrite(t, m, cmd)
+	if got := statusInFile(t, path, "bv-1"); got != model.StatusOpen || !strings.Contains(lastToast(m).text, "Undid edit of bv-1") {
+		t.Errorf("undo should be written, file has %s, toast %+v", got, lastToast(m))
+	}
+
+	m, cmd = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlR})
+	m = runWrite(t, m, cmd)
+	if got := statusInFile(t, path, "bv-1"); got != model.StatusInProgress || m.issueMap["bv-1"].Status != got {
+		t.Errorf("ctrl+r should redo the edit, file has %s", got)
+	}
+	if m.editHistory.CanRedo() || !m.editHistory.CanUndo() {
+		t.Error("redo should move the edit back onto the undo stack")
+	}
+
+	// With nothing left to redo ctrl+r refreshes instead
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyCtrlR})
+	if m.issueMap["bv-1"].Status != model.StatusInProgress {
+		t.Error("ctrl+r without a redo should not touch the edit")
+	}
+}
+
+func TestUndoBulkEdit(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask, Labels: []string{"auth"}},
+		{ID: "bv-2", Title: "Dark mode", Status: model.StatusOpen, Priority: 2, IssueType: model.TypeTask, Labels: []string{"ui", "theme"}},
+	}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	m, _ = pressEdit(m, runeKeys("  e")...)
+	m, _ = pressEdit(m, tea.KeyMsg{Type: tea.KeyLeft}, tea.KeyMsg{Type: tea.KeyEnter}) // unchanged -> closed
+	m, cmd := pressEdit(m, runeKeys("y")...)                                           // confirm closing both
+	m = runWrite(t, m, cmd)
+	if statusInFile(t, path, "bv-1") != model.StatusClosed || statusInFile(t, path, "bv-2") != model.StatusClosed {
+		t.Fatal("bulk edit should close both issues")
+	}
+
+	m, cmd = pressEdit(m, runeKeys("u")...)
+	m = runWrite(t, m, cmd)
+	for _, id := range []string{"bv-1", "bv-2"} {
+		if got := statusInFile(t, path, id); got != model.StatusOpen {
+			t.Errorf("undo should reopen %s, got %s", id, got)
+		}
+	}
+	if !strings.Contains(lastToast(m).text, "Undid edit of 2 issues") {
+		t.Errorf("toast = %+v", lastToast(m))
+	}
+}
+
+func TestUndoSkipsChangedIssuesAndKeepsFailedOnes(t *testing.T) {
+	issues := []model.Issue{{ID: "bv-1", Title: "Fix login redirect", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeTask}}
+	path := writeTempBeadsFile(t, t.TempDir(), issues...)
+	m := NewModel(issues, nil, "")
+	m.beadsPath = path
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 40})
+	m = updated.(Model)
+	m, _ = pressEdit(m, runeKeys("e")...)
+	m, cmd := pressEdit(m, tea.KeyMsg{Type: tea.KeyRight}, tea.KeyMsg{Type: tea.KeyEnter})
+	m = runWrite(t, m, cmd)
+
+	// A failed write leaves the undo where it was
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	m, cmd = pressEdit(m, runeKeys("u")...)
+	m = runWrite(t, m, cmd)
+	if !m.statusIsError || m.issueMap["bv-1"].Status != model.StatusInProgress || !m.editHistory.CanUndo() {
+		t.Errorf("failed undo should revert and stay undoable, status %q", m.statusMsg)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The issue changed since the edit, so the undo would clobber it
+	changed := m.issueMap["bv-1"].Clone()
+	changed.Status = model.StatusBlocked
+	m.replaceIssueLocally(changed)
+	m, cmd = pressEdit(m, runeKeys("u")...)
+	if cmd != nil || !m.statusIsError || !strings.Contains(m.statusMsg, "bv-1 changed since") {
+		t.Errorf("undo over a changed issue should be refused, status %q", m.statusMsg)
+	}
+	if m.issueMap["bv-1"].Status != model.StatusBlocked || m.editHistory.CanUndo() {
+		t.Error("refused undo should leave the issue and drop the record")
+	}
+}
@@ -186,7 +186,7 @@ func (m UpdateModal) View() string {
 
 	// Modal container style
 	modalStyle := r.NewStyle().
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(m.theme.Primary).
 		Padding(1, 2).
 		Width(m.width)
@@ -207,12 +207,12 @@ func (m UpdateModal) View() string {
 	// Button styles
 	buttonStyle := r.NewStyle().
 		Padding(0, 2).
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(m.theme.Border)
 
 	selectedButtonStyle := r.NewStyle().
 		Padding(0, 2).
-		Border(lipgloss.RoundedBorder()).
+		Border(termBorder(lipgloss.RoundedBorder())).
 		BorderForeground(m.theme.Primary).
 		Background(m.theme.Primary).
 		Foreground(ColorBg).
@@ -242,7 +242,7 @@ func (m UpdateModal) View() string {
 		b.WriteString("\n")
 
 		b.WriteString("New version:     ")
-		b.WriteString(newVersionStyle.Render(m.newVersion))
+		b.WriteString(TermCapabilities().Hyperlink(m.releaseURL, newVersionStyle.Render(m.newVersion)))
 		b.WriteString("\n\n")
 
 		b.WriteString("Would you like to update now?\n\n")
@@ -3,11 +3,13 @@ package ui
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 // exercise Phase2Ready and FileChanged branches of Update for coverage.
@@ -108,6 +110,68 @@ func TestUpdateFileChangedReloadsSelection(t *testing.T) {
 	}
 }
 
+func TestUpdateFileChangedKeepsViewState(t *testing.T) {
+	beads := filepath.Join(t.TempDir(), "beads.jsonl")
+	write := func(lines ...string) {
+		t.Helper()
+		for i, line := range lines {
+			lines[i] = strings.TrimSuffix(line, "}") + `,"issue_type":"task","created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}`
+		}
+		if err := os.WriteFile(beads, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+			t.Fatalf("write beads: %v", err)
+		}
+	}
+	write(
+		`{"id":"bv-1","title":"One","status":"open","priority":1}`,
+		`{"id":"bv-2","title":"Two","status":"open","priority":2}`,
+		`{"id":"bv-3","title":"Three","status":"closed","priority":0}`,
+	)
+	m := NewModel(nil, nil, beads)
+	if m.watcher != nil {
+		m.watcher.Stop()
+	}
+	updated, _ := m.Update(FileChangedMsg{})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updated.(Model)
+	for _, key := range []string{"o", "j", "s"} {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(Model)
+	}
+	if listIDs(m) != "bv-1,bv-2" || m.list.SelectedItem().(IssueItem).Issue.ID != "bv-2" {
+		t.Fatalf("setup: list = %s", listIDs(m))
+	}
+
+	// bv-4 jumps ahead of the selection; bv-1 is closed elsewhere
+	write(
+		`{"id":"bv-1","title":"One","status":"closed","priority":1}`,
+		`{"id":"bv-2","title":"Two","status":"open","priority":2}`,
+		`{"id":"bv-3","title":"Three","status":"closed","priority":0}`,
+		`{"id":"bv-4","title":"Four","status":"open","priority":0}`,
+	)
+	updated, cmd := m.Update(FileChangedMsg{})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("reload should re-arm the watcher and schedule the toast's dismissal")
+	}
+	if got := listIDs(m); got != "bv-4,bv-2" {
+		t.Errorf("open filter and priority sort should survive the reload, got %s", got)
+	}
+	if got := m.list.SelectedItem().(IssueItem).Issue.ID; got != "bv-2" {
+		t.Errorf("selection = %s, want bv-2", got)
+	}
+	if toast := lastToast(m); toast.level != toastInfo || toast.text != "Data refreshed · 4 issues" {
+		t.Errorf("toast = %+v", toast)
+	}
+
+	// The toast dismisses itself
+	shown := len(m.toasts)
+	updated, _ = m.Update(toastExpiredMsg{id: lastToast(m).id})
+	if got := updated.(Model).toasts; len(got) != shown-1 || lastToast(updated.(Model)).text == "Data refreshed · 4 issues" {
+		t.Errorf("toast should be dismissed, got %+v", got)
+	}
+}
+
 func TestNewModel_SetsTreeBeadsDirFromBeadsPath(t *testing.T) {
 	tmp := t.TempDir()
 	beads := filepath.Join(tmp, "beads.jsonl")
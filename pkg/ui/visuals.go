@@ -16,6 +16,9 @@ func RenderSparkline(val float64, width int) string {
 	}
 
 	chars := []string{" ", "▂", "▃", "▄", "▅", "▆", "▇", "█"}
+	if !TermCapabilities().Unicode {
+		chars = []string{" ", ".", ":", "-", "=", "+", "*", "#"}
+	}
 	
 	if math.IsNaN(val) {
 		val = 0
@@ -33,7 +36,7 @@ func RenderSparkline(val float64, width int) string {
 
 	var sb strings.Builder
 	for i := 0; i < fullChars; i++ {
-		sb.WriteString("█")
+		sb.WriteString(chars[len(chars)-1])
 	}
 
 	if fullChars < width {
@@ -46,3 +46,55 @@ func TestApplyFilterRespectsWorkspaceRepoFilter(t *testing.T) {
 		t.Fatalf("expected 2 visible items with no repo filter, got %d", got)
 	}
 }
+
+func TestCycleWorkspaceSwitchesBetweenReposAndAll(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api-AUTH-1", Title: "API", Status: model.StatusOpen},
+		{ID: "api-AUTH-2", Title: "API 2", Status: model.StatusOpen},
+		{ID: "web-UI-1", Title: "Web", Status: model.StatusOpen},
+	}
+
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 140, Height: 40})
+	m = updated.(Model)
+	m.EnableWorkspaceMode(WorkspaceInfo{
+		Enabled:      true,
+		RepoCount:    2,
+		RepoPrefixes: []string{"api-", "web-"},
+	})
+
+	steps := []struct {
+		label string
+		items int
+	}{
+		{"api", 2},
+		{"web", 1},
+		{"all", 3},
+		{"api", 2},
+	}
+	for i, step := range steps {
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'W'}})
+		m = updated.(Model)
+		if got := m.workspaceLabel(); got != step.label {
+			t.Fatalf("step %d: workspace = %q, want %q", i, got, step.label)
+		}
+		if got := len(m.list.Items()); got != step.items {
+			t.Fatalf("step %d: expected %d items in %s, got %d", i, step.items, step.label, got)
+		}
+	}
+
+	// A multi-repo filter from the picker moves on to the repo after its first
+	m.activeRepos = map[string]bool{"api": true, "web": true}
+	m.cycleWorkspace()
+	if got := m.workspaceLabel(); got != "web" {
+		t.Fatalf("after picker filter: workspace = %q, want web", got)
+	}
+}
+
+func TestCycleWorkspaceOutsideWorkspaceMode(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "A-1", Title: "A", Status: model.StatusOpen}}, nil, "")
+	m.cycleWorkspace()
+	if m.activeRepos != nil || m.statusMsg == "" {
+		t.Fatalf("expected a hint and no filter outside workspace mode, got %v %q", m.activeRepos, m.statusMsg)
+	}
+}
@@ -61,3 +61,51 @@ func formatRepoList(repos []string, maxNames int) string {
 	head := strings.Join(repos[:maxNames], ",")
 	return fmt.Sprintf("%s+%d", head, len(repos)-maxNames)
 }
+
+// workspaceLabel names the active workspace: the single repo shown, or
+// "all" when every repo is merged
+func (m Model) workspaceLabel() string {
+	switch active := sortedRepoKeys(m.activeRepos); len(active) {
+	case 0:
+		return "all"
+	case 1:
+		return active[0]
+	default:
+		return formatRepoList(active, 3)
+	}
+}
+
+// cycleWorkspace switches to the next workspace in the order all, then
+// each repo on its own. A multi-repo filter from the picker moves on to
+// the repo after its first.
+func (m *Model) cycleWorkspace() {
+	if !m.workspaceMode || len(m.availableRepos) == 0 {
+		m.statusMsg = "Workspace switching available only with --workspace or --dataset"
+		m.statusIsError = false
+		return
+	}
+
+	next := 0 // index into availableRepos; len means "all"
+	if active := sortedRepoKeys(m.activeRepos); len(active) > 0 {
+		next = len(m.availableRepos)
+		for i, r := range m.availableRepos {
+			if r == active[0] {
+				next = i + 1
+				break
+			}
+		}
+	}
+	if next >= len(m.availableRepos) {
+		m.activeRepos = nil
+	} else {
+		m.activeRepos = map[string]bool{m.availableRepos[next]: true}
+	}
+
+	if m.activeRecipe != nil {
+		m.applyRecipe(m.activeRecipe)
+	} else {
+		m.applyFilter()
+	}
+	m.statusMsg = fmt.Sprintf("Workspace: %s (%d issues)", m.workspaceLabel(), len(m.list.Items()))
+	m.statusIsError = false
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
 	"path/filepath"
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/limits"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
@@ -103,7 +105,7 @@ func (l *AggregateLoader) loadReposParallel(ctx context.Context, repos []RepoCon
 
 	g, ctx := errgroup.WithContext(ctx)
 	// Limit concurrency to avoid resource exhaustion (file descriptors, memory)
-	g.SetLimit(32)
+	g.SetLimit(limits.Current().Workers(32))
 
 	for i, repo := range repos {
 		i, repo := i, repo // capture loop variables
@@ -153,11 +155,19 @@ func (l *AggregateLoader) loadSingleRepo(repo RepoConfig) ([]model.Issue, error)
 		repoPath = filepath.Join(l.workspaceRoot, repoPath)
 	}
 
-	// Load raw issues from the repo, respecting custom beads path if provided
-	beadsDir := filepath.Join(repoPath, repo.GetBeadsPath())
-	jsonlPath, err := loader.FindJSONLPath(beadsDir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load issues from %s: %w", repo.GetName(), err)
+	// Load raw issues from the repo, respecting custom beads path if provided.
+	// A dataset may point at the beads file or .beads directory itself.
+	jsonlPath := repoPath
+	if info, statErr := os.Stat(repoPath); statErr != nil || info.IsDir() {
+		beadsDir := filepath.Join(repoPath, repo.GetBeadsPath())
+		if filepath.Base(repoPath) == ".beads" {
+			beadsDir = repoPath
+		}
+		var err error
+		jsonlPath, err = loader.FindJSONLPath(beadsDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load issues from %s: %w", repo.GetName(), err)
+		}
 	}
 	issues, err := loader.LoadIssuesFromFile(jsonlPath)
 	if err != nil {
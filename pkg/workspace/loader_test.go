@@ -489,3 +489,46 @@ func TestAggregateLoaderCustomBeadsPath(t *testing.T) {
 		t.Errorf("expected namespaced ID svc-CUST-1, got %s", issues[0].ID)
 	}
 }
+
+func TestAggregateLoaderDatasets(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// One dataset given as a repo directory, one as the beads file itself
+	apiRepo := filepath.Join(tmpDir, "api")
+	createTestBeadsFile(t, apiRepo, []model.Issue{
+		{ID: "AUTH-1", Title: "Auth feature"},
+	})
+	webRepo := filepath.Join(tmpDir, "web")
+	createTestBeadsFile(t, webRepo, []model.Issue{
+		{ID: "UI-1", Title: "Login page"},
+		{ID: "UI-2", Title: "Logout button"},
+	})
+
+	config, err := workspace.DatasetConfig([]string{
+		"api=" + apiRepo,
+		filepath.Join(webRepo, ".beads", "beads.jsonl"),
+	})
+	if err != nil {
+		t.Fatalf("DatasetConfig() error = %v", err)
+	}
+
+	issues, results, err := workspace.NewAggregateLoader(config, tmpDir).LoadAll(context.Background())
+	if err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+	for _, r := range results {
+		if r.Error != nil {
+			t.Errorf("dataset %s failed: %v", r.RepoName, r.Error)
+		}
+	}
+
+	ids := make(map[string]bool)
+	for _, issue := range issues {
+		ids[issue.ID] = true
+	}
+	for _, want := range []string{"api-AUTH-1", "web-UI-1", "web-UI-2"} {
+		if !ids[want] {
+			t.Errorf("missing namespaced issue %s (got %v)", want, ids)
+		}
+	}
+}
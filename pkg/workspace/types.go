@@ -208,6 +208,63 @@ func FindWorkspaceConfig(dir string) (string, error) {
 	return "", os.ErrNotExist
 }
 
+// ParseDataset parses a --dataset spec of the form name=path into a repo
+// entry. The path may be a repo directory, its .beads directory, or a beads
+// JSONL file; without a name=, the name is taken from the path.
+func ParseDataset(spec string) (RepoConfig, error) {
+	name, path, found := strings.Cut(spec, "=")
+	if !found {
+		name, path = "", spec
+	}
+	name = strings.TrimSpace(name)
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return RepoConfig{}, fmt.Errorf("dataset %q: path is required", spec)
+	}
+	if found && name == "" {
+		return RepoConfig{}, fmt.Errorf("dataset %q: name is empty", spec)
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return RepoConfig{}, fmt.Errorf("dataset %q: %w", spec, err)
+	}
+	if name == "" {
+		name = datasetName(abs)
+	}
+	return RepoConfig{Name: name, Path: abs}, nil
+}
+
+// datasetName names a dataset after its repo: the directory holding .beads
+// for a beads file or .beads directory, otherwise the file or directory name
+func datasetName(path string) string {
+	dir, base := filepath.Dir(path), filepath.Base(path)
+	if filepath.Base(dir) == ".beads" {
+		return filepath.Base(filepath.Dir(dir))
+	}
+	if base == ".beads" {
+		return filepath.Base(dir)
+	}
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// DatasetConfig builds a workspace from --dataset specs, one repo per
+// spec, each namespaced by its name
+func DatasetConfig(specs []string) (*Config, error) {
+	config := &Config{Name: "datasets"}
+	for _, spec := range specs {
+		repo, err := ParseDataset(spec)
+		if err != nil {
+			return nil, err
+		}
+		config.Repos = append(config.Repos, repo)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid datasets: %w", err)
+	}
+	return config, nil
+}
+
 // DefaultConfig returns a sensible default configuration for a single-repo workspace
 func DefaultConfig() Config {
 	return Config{
@@ -587,3 +587,62 @@ func TestIDResolverDisabledRepos(t *testing.T) {
 		t.Error("Disabled repo prefix should not be recognized")
 	}
 }
+
+func TestParseDataset(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		wantName string
+		wantBase string
+		wantErr  bool
+	}{
+		{name: "named file", spec: "api=services/api/.beads/beads.jsonl", wantName: "api", wantBase: "beads.jsonl"},
+		{name: "named dir", spec: "web=apps/web", wantName: "web", wantBase: "web"},
+		{name: "file in .beads", spec: "services/api/.beads/issues.jsonl", wantName: "api", wantBase: "issues.jsonl"},
+		{name: ".beads dir", spec: "apps/web/.beads", wantName: "web", wantBase: ".beads"},
+		{name: "bare file", spec: "exports/ops.jsonl", wantName: "ops", wantBase: "ops.jsonl"},
+		{name: "empty name", spec: "=apps/web", wantErr: true},
+		{name: "empty path", spec: "web=", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, err := workspace.ParseDataset(tt.spec)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDataset(%q) expected error, got %+v", tt.spec, repo)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDataset(%q) error = %v", tt.spec, err)
+			}
+			if repo.Name != tt.wantName {
+				t.Errorf("Name = %q, want %q", repo.Name, tt.wantName)
+			}
+			if !filepath.IsAbs(repo.Path) || filepath.Base(repo.Path) != tt.wantBase {
+				t.Errorf("Path = %q, want absolute path ending in %q", repo.Path, tt.wantBase)
+			}
+		})
+	}
+}
+
+func TestDatasetConfig(t *testing.T) {
+	config, err := workspace.DatasetConfig([]string{"api=a.jsonl", "web=b.jsonl"})
+	if err != nil {
+		t.Fatalf("DatasetConfig() error = %v", err)
+	}
+	if len(config.Repos) != 2 {
+		t.Fatalf("expected 2 repos, got %d", len(config.Repos))
+	}
+	if got := config.Repos[1].GetPrefix(); got != "web-" {
+		t.Errorf("prefix = %q, want web-", got)
+	}
+
+	if _, err := workspace.DatasetConfig([]string{"api=a.jsonl", "API=b.jsonl"}); err == nil {
+		t.Error("expected error for datasets sharing a name")
+	}
+	if _, err := workspace.DatasetConfig(nil); err == nil {
+		t.Error("expected error for no datasets")
+	}
+}